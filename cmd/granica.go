@@ -7,12 +7,13 @@ import (
 	"syscall"
 
 	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
 	"gitlab.com/mikrowezel/backend/granica/internal/migration"
+	"gitlab.com/mikrowezel/backend/granica/internal/outbox"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth"
 	"gitlab.com/mikrowezel/backend/log"
 	svc "gitlab.com/mikrowezel/backend/service"
-	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
 )
 
 type contextKey string
@@ -43,6 +44,10 @@ func main() {
 	mlh, err := mailer.NewHandler(ctx, cfg, log, "mailer-handler")
 	s.AddHandler(mlh)
 
+	// Add Outbox handler
+	obh, err := outbox.NewHandler(ctx, cfg, log, "outbox-handler")
+	s.AddHandler(obh)
+
 	// Set service worker
 	auth, err := auth.NewWorker(ctx, cfg, log, "auth-worker")
 	if err != nil {
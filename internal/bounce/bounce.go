@@ -0,0 +1,223 @@
+package bounce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+type (
+	// Event is a bounce or complaint reported for some recipients.
+	Event struct {
+		Reason     string
+		Kind       string
+		Source     string
+		FeedbackID string
+		Diagnostic string
+		Recipients []string
+		// Permanent is true for hard bounces and complaints,
+		// only these ones suppress recipients.
+		Permanent bool
+	}
+
+	// sesNotification is the SES bounce and complaint notification content.
+	// Event publishing, using configuration sets, sends 'eventType'
+	// instead of 'notificationType'.
+	sesNotification struct {
+		NotificationType string `json:"notificationType"`
+		EventType        string `json:"eventType"`
+		Bounce           struct {
+			BounceType        string `json:"bounceType"`
+			BounceSubType     string `json:"bounceSubType"`
+			FeedbackID        string `json:"feedbackId"`
+			BouncedRecipients []struct {
+				EmailAddress   string `json:"emailAddress"`
+				DiagnosticCode string `json:"diagnosticCode"`
+			} `json:"bouncedRecipients"`
+		} `json:"bounce"`
+		Complaint struct {
+			ComplaintFeedbackType string `json:"complaintFeedbackType"`
+			FeedbackID            string `json:"feedbackId"`
+			ComplainedRecipients  []struct {
+				EmailAddress string `json:"emailAddress"`
+			} `json:"complainedRecipients"`
+		} `json:"complaint"`
+	}
+
+	// genericNotification is the provider agnostic notification format.
+	genericNotification struct {
+		Type       string   `json:"type"`
+		Recipients []string `json:"recipients"`
+		Kind       string   `json:"kind"`
+		ID         string   `json:"id"`
+		Diagnostic string   `json:"diagnostic"`
+	}
+)
+
+const (
+	// Sources
+	SESSource     = "ses"
+	GenericSource = "generic"
+	// SignaturePrefix of generic notifications signature header value.
+	SignaturePrefix = "sha256="
+)
+
+var (
+	// ErrNotSuppressible is returned for notifications that do not
+	// require any action, i.e. deliveries or transient bounces.
+	ErrNotSuppressible = errors.New("notification does not suppress recipients")
+)
+
+// ParseSES reads an SES notification, the 'Message' of an SNS notification.
+func ParseSES(msg string) (Event, error) {
+	var n sesNotification
+
+	err := json.Unmarshal([]byte(msg), &n)
+	if err != nil {
+		return Event{}, err
+	}
+
+	t := n.NotificationType
+	if t == "" {
+		t = n.EventType
+	}
+
+	switch t {
+	case "Bounce":
+		e := Event{
+			Reason:     model.BounceReason,
+			Kind:       kind(n.Bounce.BounceType, n.Bounce.BounceSubType),
+			Source:     SESSource,
+			FeedbackID: n.Bounce.FeedbackID,
+			Permanent:  n.Bounce.BounceType == "Permanent",
+		}
+		for _, r := range n.Bounce.BouncedRecipients {
+			e.Recipients = append(e.Recipients, r.EmailAddress)
+			if e.Diagnostic == "" {
+				e.Diagnostic = r.DiagnosticCode
+			}
+		}
+		return e, nil
+
+	case "Complaint":
+		e := Event{
+			Reason:     model.ComplaintReason,
+			Kind:       n.Complaint.ComplaintFeedbackType,
+			Source:     SESSource,
+			FeedbackID: n.Complaint.FeedbackID,
+			Permanent:  true,
+		}
+		for _, r := range n.Complaint.ComplainedRecipients {
+			e.Recipients = append(e.Recipients, r.EmailAddress)
+		}
+		return e, nil
+
+	default:
+		return Event{}, fmt.Errorf("%w: %s", ErrNotSuppressible, t)
+	}
+}
+
+// ParseGeneric reads a provider agnostic notification:
+//
+//	{"type": "bounce", "recipients": ["user@mail.com"], "kind": "permanent", "id": "...", "diagnostic": "..."}
+//
+// type is 'bounce' or 'complaint', bounces other than 'permanent' ones
+// do not suppress recipients.
+func ParseGeneric(body []byte) (Event, error) {
+	var n genericNotification
+
+	err := json.Unmarshal(body, &n)
+	if err != nil {
+		return Event{}, err
+	}
+
+	if len(n.Recipients) == 0 {
+		return Event{}, errors.New("notification has no recipients")
+	}
+
+	e := Event{
+		Kind:       n.Kind,
+		Source:     GenericSource,
+		FeedbackID: n.ID,
+		Diagnostic: n.Diagnostic,
+		Recipients: n.Recipients,
+	}
+
+	switch strings.ToLower(n.Type) {
+	case model.BounceReason:
+		e.Reason = model.BounceReason
+		e.Permanent = strings.EqualFold(n.Kind, "permanent")
+
+	case model.ComplaintReason:
+		e.Reason = model.ComplaintReason
+		e.Permanent = true
+
+	default:
+		return Event{}, fmt.Errorf("unknown notification type '%s'", n.Type)
+	}
+
+	return e, nil
+}
+
+// VerifyHMAC checks that signature, 'sha256=' followed by the hex encoded
+// HMAC-SHA256 of body using secret, is valid.
+func VerifyHMAC(secret string, body []byte, signature string) error {
+	if secret == "" {
+		return errors.New("no webhook secret configured")
+	}
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, SignaturePrefix))
+	if err != nil || !strings.HasPrefix(signature, SignaturePrefix) {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Suppressions returns the suppressions an event produces,
+// none if it is not permanent.
+func (e Event) Suppressions() []model.Suppression {
+	if !e.Permanent {
+		return nil
+	}
+
+	var sps []model.Suppression
+	for _, r := range e.Recipients {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+
+		sps = append(sps, model.Suppression{
+			Email:      r,
+			Reason:     e.Reason,
+			Kind:       db.ToNullString(e.Kind),
+			Source:     db.ToNullString(e.Source),
+			FeedbackID: db.ToNullString(e.FeedbackID),
+			Diagnostic: db.ToNullString(e.Diagnostic),
+		})
+	}
+
+	return sps
+}
+
+func kind(t, st string) string {
+	if st == "" {
+		return t
+	}
+	return t + "/" + st
+}
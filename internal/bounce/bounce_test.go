@@ -0,0 +1,160 @@
+package bounce
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+const (
+	testCertURL = "https://sns.eu-west-1.amazonaws.com/SimpleNotificationService-0000.pem"
+
+	sesBounce = `{"notificationType":"Bounce","bounce":{"bounceType":"Permanent","bounceSubType":"General","feedbackId":"0102-abc",
+"bouncedRecipients":[{"emailAddress":"gone@mail.com","diagnosticCode":"smtp; 550 5.1.1 user unknown"}]}}`
+
+	sesTransient = `{"notificationType":"Bounce","bounce":{"bounceType":"Transient","bounceSubType":"MailboxFull",
+"bouncedRecipients":[{"emailAddress":"full@mail.com"}]}}`
+
+	sesComplaint = `{"eventType":"Complaint","complaint":{"complaintFeedbackType":"abuse","feedbackId":"0102-def",
+"complainedRecipients":[{"emailAddress":"angry@mail.com"}]}}`
+)
+
+func TestParseSES(t *testing.T) {
+	e, err := ParseSES(sesBounce)
+	if err != nil {
+		t.Fatalf("parse error: %s", err.Error())
+	}
+
+	sps := e.Suppressions()
+	if len(sps) != 1 || sps[0].Email != "gone@mail.com" || sps[0].Reason != "bounce" || sps[0].Kind.String != "Permanent/General" {
+		t.Errorf("unexpected suppressions: %+v", sps)
+	}
+
+	e, err = ParseSES(sesTransient)
+	if err != nil {
+		t.Fatalf("parse error: %s", err.Error())
+	}
+
+	if sps := e.Suppressions(); len(sps) != 0 {
+		t.Errorf("transient bounces should not suppress: %+v", sps)
+	}
+
+	e, err = ParseSES(sesComplaint)
+	if err != nil {
+		t.Fatalf("parse error: %s", err.Error())
+	}
+
+	sps = e.Suppressions()
+	if len(sps) != 1 || sps[0].Email != "angry@mail.com" || sps[0].Reason != "complaint" {
+		t.Errorf("unexpected suppressions: %+v", sps)
+	}
+
+	_, err = ParseSES(`{"notificationType":"Delivery"}`)
+	if !errors.Is(err, ErrNotSuppressible) {
+		t.Errorf("expected ErrNotSuppressible, got %v", err)
+	}
+}
+
+func TestParseGenericAndVerifyHMAC(t *testing.T) {
+	body := []byte(`{"type":"bounce","recipients":["gone@mail.com"],"kind":"permanent"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	sig := SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+
+	if err := VerifyHMAC("secret", body, sig); err != nil {
+		t.Errorf("valid signature rejected: %s", err.Error())
+	}
+
+	if err := VerifyHMAC("other", body, sig); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature, got %v", err)
+	}
+
+	if err := VerifyHMAC("", body, sig); err == nil {
+		t.Error("signature accepted without secret")
+	}
+
+	e, err := ParseGeneric(body)
+	if err != nil {
+		t.Fatalf("parse error: %s", err.Error())
+	}
+
+	if sps := e.Suppressions(); len(sps) != 1 || sps[0].Source.String != GenericSource {
+		t.Errorf("unexpected suppressions: %+v", sps)
+	}
+}
+
+func TestSNSVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := NewSNSVerifier()
+	v.certs[testCertURL] = cert
+
+	m := SNSMessage{
+		Type:             SNSNotification,
+		MessageID:        "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+		TopicArn:         "arn:aws:sns:eu-west-1:123456789012:ses-bounces",
+		Message:          sesBounce,
+		Timestamp:        "2020-01-15T09:30:00.000Z",
+		SignatureVersion: "2",
+		SigningCertURL:   testCertURL,
+	}
+
+	sts, err := m.StringToSign()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256([]byte(sts))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Signature = base64.StdEncoding.EncodeToString(sig)
+
+	if err := v.Verify(m); err != nil {
+		t.Errorf("valid message rejected: %s", err.Error())
+	}
+
+	tampered := m
+	tampered.Message = sesComplaint
+	if err := v.Verify(tampered); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature, got %v", err)
+	}
+
+	forged := m
+	forged.SigningCertURL = "https://sns.attacker.com/cert.pem"
+	if err := v.Verify(forged); !errors.Is(err, ErrInvalidSNSURL) {
+		t.Errorf("expected ErrInvalidSNSURL, got %v", err)
+	}
+}
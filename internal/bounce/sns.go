@@ -0,0 +1,251 @@
+package bounce
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// SNSMessage is an Amazon SNS HTTP(S) delivery.
+	SNSMessage struct {
+		Type             string `json:"Type"`
+		MessageID        string `json:"MessageId"`
+		Token            string `json:"Token"`
+		TopicArn         string `json:"TopicArn"`
+		Subject          string `json:"Subject"`
+		Message          string `json:"Message"`
+		Timestamp        string `json:"Timestamp"`
+		SignatureVersion string `json:"SignatureVersion"`
+		Signature        string `json:"Signature"`
+		SigningCertURL   string `json:"SigningCertURL"`
+		SubscribeURL     string `json:"SubscribeURL"`
+		UnsubscribeURL   string `json:"UnsubscribeURL"`
+	}
+
+	// SNSVerifier checks SNS messages signatures.
+	// Signing certificates are downloaded once and cached.
+	SNSVerifier struct {
+		Client *http.Client
+		mu     sync.Mutex
+		certs  map[string]*x509.Certificate
+	}
+)
+
+const (
+	// SNS message types
+	SNSNotification             = "Notification"
+	SNSSubscriptionConfirmation = "SubscriptionConfirmation"
+	SNSUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+var (
+	// ErrInvalidSignature is returned when a message signature does not match.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrInvalidSNSURL is returned for certificate or subscription URLs not served by SNS.
+	ErrInvalidSNSURL = errors.New("invalid SNS URL")
+
+	snsHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+)
+
+// ParseSNSMessage decodes an SNS delivery body.
+func ParseSNSMessage(body []byte) (SNSMessage, error) {
+	var m SNSMessage
+
+	err := json.Unmarshal(body, &m)
+	if err != nil {
+		return m, err
+	}
+
+	if m.Type == "" || m.MessageID == "" {
+		return m, errors.New("not an SNS message")
+	}
+
+	return m, nil
+}
+
+// NewSNSVerifier creates a verifier.
+func NewSNSVerifier() *SNSVerifier {
+	return &SNSVerifier{
+		Client: &http.Client{Timeout: 10 * time.Second},
+		certs:  make(map[string]*x509.Certificate),
+	}
+}
+
+// Verify m signature.
+func (v *SNSVerifier) Verify(m SNSMessage) error {
+	var h hash.Hash
+	var alg crypto.Hash
+
+	switch m.SignatureVersion {
+	case "1":
+		h, alg = sha1.New(), crypto.SHA1
+	case "2":
+		h, alg = sha256.New(), crypto.SHA256
+	default:
+		return fmt.Errorf("%w: unsupported version '%s'", ErrInvalidSignature, m.SignatureVersion)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(m.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	sts, err := m.StringToSign()
+	if err != nil {
+		return err
+	}
+
+	cert, err := v.cert(m.SigningCertURL)
+	if err != nil {
+		return err
+	}
+
+	pub, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: not an RSA certificate", ErrInvalidSignature)
+	}
+
+	h.Write([]byte(sts))
+
+	err = rsa.VerifyPKCS1v15(pub, alg, h.Sum(nil), sig)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Confirm a subscription visiting its subscribe URL.
+// Only call it for verified messages.
+func (v *SNSVerifier) Confirm(m SNSMessage) error {
+	if m.Type != SNSSubscriptionConfirmation {
+		return fmt.Errorf("not a subscription confirmation: %s", m.Type)
+	}
+
+	err := checkSNSURL(m.SubscribeURL, "")
+	if err != nil {
+		return err
+	}
+
+	r, err := v.Client.Get(m.SubscribeURL)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("subscription confirmation failed: %s", r.Status)
+	}
+
+	return nil
+}
+
+// StringToSign builds the canonical representation of m that SNS signs.
+func (m SNSMessage) StringToSign() (string, error) {
+	var b strings.Builder
+
+	add := func(k, v string) {
+		b.WriteString(k)
+		b.WriteString("\n")
+		b.WriteString(v)
+		b.WriteString("\n")
+	}
+
+	switch m.Type {
+	case SNSNotification:
+		add("Message", m.Message)
+		add("MessageId", m.MessageID)
+		if m.Subject != "" {
+			add("Subject", m.Subject)
+		}
+		add("Timestamp", m.Timestamp)
+		add("TopicArn", m.TopicArn)
+		add("Type", m.Type)
+
+	case SNSSubscriptionConfirmation, SNSUnsubscribeConfirmation:
+		add("Message", m.Message)
+		add("MessageId", m.MessageID)
+		add("SubscribeURL", m.SubscribeURL)
+		add("Timestamp", m.Timestamp)
+		add("Token", m.Token)
+		add("TopicArn", m.TopicArn)
+		add("Type", m.Type)
+
+	default:
+		return "", fmt.Errorf("unknown SNS message type '%s'", m.Type)
+	}
+
+	return b.String(), nil
+}
+
+func (v *SNSVerifier) cert(certURL string) (*x509.Certificate, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if c, ok := v.certs[certURL]; ok {
+		return c, nil
+	}
+
+	err := checkSNSURL(certURL, ".pem")
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := v.Client.Get(certURL)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot get signing certificate: %s", r.Status)
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, _ := pem.Decode(data)
+	if blk == nil {
+		return nil, errors.New("invalid signing certificate")
+	}
+
+	c, err := x509.ParseCertificate(blk.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	v.certs[certURL] = c
+	return c, nil
+}
+
+// checkSNSURL avoids fetching anything not served over HTTPS by SNS.
+func checkSNSURL(raw, ext string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSNSURL, err.Error())
+	}
+
+	if u.Scheme != "https" || !snsHost.MatchString(u.Hostname()) || !strings.HasSuffix(u.Path, ext) {
+		return fmt.Errorf("%w: %s", ErrInvalidSNSURL, raw)
+	}
+
+	return nil
+}
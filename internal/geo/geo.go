@@ -0,0 +1,60 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+type (
+	// Location resolved for an IP address.
+	Location struct {
+		Lat     float64
+		Lng     float64
+		Country string
+		City    string
+	}
+
+	// Resolver resolves IP addresses to locations.
+	Resolver interface {
+		Resolve(ip string) (Location, error)
+	}
+
+	// NopResolver never resolves a location.
+	NopResolver struct{}
+)
+
+var (
+	// ErrNotFound is returned when no location is known for an IP.
+	ErrNotFound = errors.New("location not found")
+)
+
+// Resolve always returns ErrNotFound.
+func (r NopResolver) Resolve(ip string) (Location, error) {
+	return Location{}, ErrNotFound
+}
+
+// Coarse returns a copy of the location with coordinates rounded
+// to one decimal place, roughly 10 km, enough to identify a city
+// without storing a precise position.
+func (l Location) Coarse() Location {
+	l.Lat = math.Round(l.Lat*10) / 10
+	l.Lng = math.Round(l.Lng*10) / 10
+	return l
+}
+
+// earthRadiusKm is the mean Earth radius.
+const earthRadiusKm = 6371.0
+
+// Distance returns the great-circle distance in kilometers
+// between two locations.
+func Distance(a, b Location) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
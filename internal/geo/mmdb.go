@@ -0,0 +1,395 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+)
+
+// MMDBResolver resolves locations using an offline MaxMind DB file,
+// i.e. GeoLite2 / GeoIP2 City databases.
+// The whole file is loaded in memory and never modified
+// so a resolver can be safely shared between goroutines.
+type MMDBResolver struct {
+	tree       []byte
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint
+}
+
+const (
+	// Data section is separated from the search tree by 16 zero bytes.
+	mmdbDataSep = 16
+)
+
+var (
+	mmdbMetaMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+)
+
+var (
+	// ErrInvalidMMDB is returned when the database file cannot be parsed.
+	ErrInvalidMMDB = errors.New("invalid MaxMind DB")
+)
+
+// OpenMMDB loads a MaxMind DB file.
+func OpenMMDB(path string) (*MMDBResolver, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMMDBResolver(b)
+}
+
+// NewMMDBResolver returns a resolver for a MaxMind DB file content.
+func NewMMDBResolver(b []byte) (*MMDBResolver, error) {
+	mi := bytes.LastIndex(b, mmdbMetaMarker)
+	if mi < 0 {
+		return nil, ErrInvalidMMDB
+	}
+
+	d := mmdbDecoder{b: b[mi+len(mmdbMetaMarker):]}
+	v, _, err := d.decode(0)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidMMDB
+	}
+
+	r := &MMDBResolver{
+		nodeCount:  uint(asUint(meta["node_count"])),
+		recordSize: uint(asUint(meta["record_size"])),
+		ipVersion:  uint(asUint(meta["ip_version"])),
+	}
+
+	if r.recordSize != 24 && r.recordSize != 28 && r.recordSize != 32 {
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalidMMDB, r.recordSize)
+	}
+
+	treeSize := r.nodeCount * r.recordSize / 4
+	if treeSize+mmdbDataSep > uint(mi) {
+		return nil, ErrInvalidMMDB
+	}
+
+	r.tree = b[:treeSize]
+	r.data = b[treeSize+mmdbDataSep : mi]
+
+	// IPv4 addresses live in the ::/96 subtree of IPv6 databases.
+	if r.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < r.nodeCount; i++ {
+			node = r.readNode(node, 0)
+		}
+		r.ipv4Start = node
+	}
+
+	return r, nil
+}
+
+// Resolve returns the location for an IP address.
+func (r *MMDBResolver) Resolve(ip string) (Location, error) {
+	rec, err := r.lookup(net.ParseIP(ip))
+	if err != nil {
+		return Location{}, err
+	}
+
+	var l Location
+	found := false
+
+	if loc, ok := rec["location"].(map[string]interface{}); ok {
+		lat, okLat := loc["latitude"].(float64)
+		lng, okLng := loc["longitude"].(float64)
+		if okLat && okLng {
+			l.Lat, l.Lng = lat, lng
+			found = true
+		}
+	}
+
+	if c, ok := rec["country"].(map[string]interface{}); ok {
+		l.Country, _ = c["iso_code"].(string)
+		found = found || l.Country != ""
+	}
+
+	if c, ok := rec["city"].(map[string]interface{}); ok {
+		if names, ok := c["names"].(map[string]interface{}); ok {
+			l.City, _ = names["en"].(string)
+		}
+	}
+
+	if !found {
+		return Location{}, ErrNotFound
+	}
+
+	return l, nil
+}
+
+func (r *MMDBResolver) lookup(ip net.IP) (map[string]interface{}, error) {
+	if ip == nil {
+		return nil, ErrNotFound
+	}
+
+	node := uint(0)
+	bits := 128
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 32
+		if r.ipVersion == 6 {
+			node = r.ipv4Start
+		}
+	} else if r.ipVersion == 4 {
+		return nil, ErrNotFound
+	}
+
+	for i := 0; i < bits && node < r.nodeCount; i++ {
+		bit := uint(ip[i>>3]>>(7-uint(i%8))) & 1
+		node = r.readNode(node, bit)
+	}
+
+	if node <= r.nodeCount {
+		return nil, ErrNotFound
+	}
+
+	off := node - r.nodeCount - mmdbDataSep
+	if off >= uint(len(r.data)) {
+		return nil, ErrInvalidMMDB
+	}
+
+	d := mmdbDecoder{b: r.data}
+	v, _, err := d.decode(off)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return rec, nil
+}
+
+// readNode returns the left (bit 0) or right (bit 1) record of a node.
+func (r *MMDBResolver) readNode(node, bit uint) uint {
+	b := r.tree
+
+	switch r.recordSize {
+	case 24:
+		off := node*6 + bit*3
+		return uint(b[off])<<16 | uint(b[off+1])<<8 | uint(b[off+2])
+
+	case 28:
+		off := node * 7
+		if bit == 0 {
+			return uint(b[off+3]&0xF0)<<20 | uint(b[off])<<16 | uint(b[off+1])<<8 | uint(b[off+2])
+		}
+		return uint(b[off+3]&0x0F)<<24 | uint(b[off+4])<<16 | uint(b[off+5])<<8 | uint(b[off+6])
+
+	default:
+		off := node*8 + bit*4
+		return uint(binary.BigEndian.Uint32(b[off:]))
+	}
+}
+
+// mmdbDecoder decodes MaxMind DB data section values.
+type mmdbDecoder struct {
+	b []byte
+}
+
+const (
+	mmdbPointer = 1
+	mmdbString  = 2
+	mmdbDouble  = 3
+	mmdbBytes   = 4
+	mmdbUint16  = 5
+	mmdbUint32  = 6
+	mmdbMap     = 7
+	mmdbInt32   = 8
+	mmdbUint64  = 9
+	mmdbUint128 = 10
+	mmdbArray   = 11
+	mmdbBool    = 14
+	mmdbFloat   = 15
+)
+
+// decode the value at off returning it along with the offset of the next one.
+func (d *mmdbDecoder) decode(off uint) (interface{}, uint, error) {
+	ctrl, off, err := d.byte(off)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	typ := uint(ctrl >> 5)
+	if typ == mmdbPointer {
+		return d.decodePointer(ctrl, off)
+	}
+
+	if typ == 0 {
+		var ext byte
+		ext, off, err = d.byte(off)
+		if err != nil {
+			return nil, 0, err
+		}
+		typ = 7 + uint(ext)
+	}
+
+	size, off, err := d.size(ctrl, off)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch typ {
+	case mmdbMap:
+		m := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			var k, v interface{}
+			k, off, err = d.decode(off)
+			if err != nil {
+				return nil, 0, err
+			}
+			v, off, err = d.decode(off)
+			if err != nil {
+				return nil, 0, err
+			}
+			ks, ok := k.(string)
+			if !ok {
+				return nil, 0, ErrInvalidMMDB
+			}
+			m[ks] = v
+		}
+		return m, off, nil
+
+	case mmdbArray:
+		a := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			var v interface{}
+			v, off, err = d.decode(off)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+		}
+		return a, off, nil
+
+	case mmdbBool:
+		return size != 0, off, nil
+	}
+
+	if off+size > uint(len(d.b)) {
+		return nil, 0, ErrInvalidMMDB
+	}
+	p := d.b[off : off+size]
+	next := off + size
+
+	switch typ {
+	case mmdbString:
+		return string(p), next, nil
+
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, ErrInvalidMMDB
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(p)), next, nil
+
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, ErrInvalidMMDB
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(p))), next, nil
+
+	case mmdbUint16, mmdbUint32, mmdbUint64:
+		var u uint64
+		for _, c := range p {
+			u = u<<8 | uint64(c)
+		}
+		return u, next, nil
+
+	case mmdbInt32:
+		var u uint32
+		for _, c := range p {
+			u = u<<8 | uint32(c)
+		}
+		return int64(int32(u)), next, nil
+
+	case mmdbBytes, mmdbUint128:
+		return p, next, nil
+	}
+
+	return nil, 0, fmt.Errorf("%w: unknown data type %d", ErrInvalidMMDB, typ)
+}
+
+func (d *mmdbDecoder) decodePointer(ctrl byte, off uint) (interface{}, uint, error) {
+	ss := uint(ctrl>>3) & 0x3
+	vvv := uint(ctrl & 0x7)
+
+	n := ss + 1
+	if off+n > uint(len(d.b)) {
+		return nil, 0, ErrInvalidMMDB
+	}
+
+	var ptr uint
+	for _, c := range d.b[off : off+n] {
+		ptr = ptr<<8 | uint(c)
+	}
+
+	switch ss {
+	case 0:
+		ptr = vvv<<8 | ptr
+	case 1:
+		ptr = (vvv<<16 | ptr) + 2048
+	case 2:
+		ptr = (vvv<<24 | ptr) + 526336
+	}
+
+	v, _, err := d.decode(ptr)
+	return v, off + n, err
+}
+
+func (d *mmdbDecoder) size(ctrl byte, off uint) (uint, uint, error) {
+	size := uint(ctrl & 0x1f)
+	if size < 29 {
+		return size, off, nil
+	}
+
+	n := size - 28
+	if off+n > uint(len(d.b)) {
+		return 0, 0, ErrInvalidMMDB
+	}
+
+	var ext uint
+	for _, c := range d.b[off : off+n] {
+		ext = ext<<8 | uint(c)
+	}
+
+	switch size {
+	case 29:
+		size = 29 + ext
+	case 30:
+		size = 285 + ext
+	default:
+		size = 65821 + ext
+	}
+
+	return size, off + n, nil
+}
+
+func (d *mmdbDecoder) byte(off uint) (byte, uint, error) {
+	if off >= uint(len(d.b)) {
+		return 0, 0, ErrInvalidMMDB
+	}
+	return d.b[off], off + 1, nil
+}
+
+func asUint(v interface{}) uint64 {
+	u, _ := v.(uint64)
+	return u
+}
@@ -0,0 +1,115 @@
+package geo
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestMMDBResolve(t *testing.T) {
+	r, err := NewMMDBResolver(sampleMMDB())
+	if err != nil {
+		t.Fatalf("cannot open database: %s", err.Error())
+	}
+
+	loc, err := r.Resolve("10.1.2.3")
+	if err != nil {
+		t.Fatalf("cannot resolve location: %s", err.Error())
+	}
+
+	if loc.Country != "PL" || loc.City != "Warsaw" {
+		t.Errorf("unexpected location: %+v", loc)
+	}
+
+	if loc.Lat != 52.23 || loc.Lng != 21.01 {
+		t.Errorf("unexpected coordinates: %+v", loc)
+	}
+}
+
+func TestMMDBResolveNotFound(t *testing.T) {
+	r, err := NewMMDBResolver(sampleMMDB())
+	if err != nil {
+		t.Fatalf("cannot open database: %s", err.Error())
+	}
+
+	for _, ip := range []string{"200.1.2.3", "2001:db8::1", "not-an-ip"} {
+		_, err = r.Resolve(ip)
+		if err != ErrNotFound {
+			t.Errorf("expected not found for '%s', got: %v", ip, err)
+		}
+	}
+}
+
+func TestMMDBInvalid(t *testing.T) {
+	_, err := NewMMDBResolver([]byte("not a database"))
+	if err == nil {
+		t.Error("invalid database should not be opened")
+	}
+}
+
+func TestDistance(t *testing.T) {
+	warsaw := Location{Lat: 52.23, Lng: 21.01}
+	berlin := Location{Lat: 52.52, Lng: 13.40}
+
+	d := Distance(warsaw, berlin)
+	if d < 510 || d > 530 {
+		t.Errorf("unexpected distance: %f", d)
+	}
+}
+
+// sampleMMDB builds an IPv4 database with a single node:
+// 0.0.0.0/1 is located in Warsaw, 128.0.0.0/1 is unknown.
+func sampleMMDB() []byte {
+	nodeCount := uint32(1)
+
+	var b []byte
+	// Search tree: left record points to data offset 0,
+	// right record equals node count, that is, no data.
+	b = append(b, uint24(nodeCount+16)...)
+	b = append(b, uint24(nodeCount)...)
+	// Data section separator
+	b = append(b, make([]byte, 16)...)
+	// Data section
+	b = append(b, mmdbMapOf(
+		mmdbStr("city"), mmdbMapOf(
+			mmdbStr("names"), mmdbMapOf(mmdbStr("en"), mmdbStr("Warsaw")),
+		),
+		mmdbStr("country"), mmdbMapOf(mmdbStr("iso_code"), mmdbStr("PL")),
+		mmdbStr("location"), mmdbMapOf(
+			mmdbStr("latitude"), mmdbDbl(52.23),
+			mmdbStr("longitude"), mmdbDbl(21.01),
+		),
+	)...)
+	// Metadata
+	b = append(b, mmdbMetaMarker...)
+	b = append(b, mmdbMapOf(
+		mmdbStr("node_count"), []byte{mmdbUint32<<5 | 1, byte(nodeCount)},
+		mmdbStr("record_size"), []byte{mmdbUint16<<5 | 1, 24},
+		mmdbStr("ip_version"), []byte{mmdbUint16<<5 | 1, 4},
+	)...)
+
+	return b
+}
+
+func uint24(v uint32) []byte {
+	return []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+func mmdbStr(s string) []byte {
+	return append([]byte{mmdbString<<5 | byte(len(s))}, s...)
+}
+
+func mmdbDbl(f float64) []byte {
+	b := make([]byte, 9)
+	b[0] = mmdbDouble<<5 | 8
+	binary.BigEndian.PutUint64(b[1:], math.Float64bits(f))
+	return b
+}
+
+func mmdbMapOf(kvs ...[]byte) []byte {
+	b := []byte{mmdbMap<<5 | byte(len(kvs)/2)}
+	for _, kv := range kvs {
+		b = append(b, kv...)
+	}
+	return b
+}
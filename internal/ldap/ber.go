@@ -0,0 +1,285 @@
+package ldap
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Minimal BER encoding of the subset of ASN.1 used by LDAP (RFC 4511, section 5.1).
+
+const (
+	classUniversal   byte = 0x00
+	classApplication byte = 0x40
+	classContext     byte = 0x80
+
+	constructedBit byte = 0x20
+)
+
+const (
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10
+	tagSet         = 0x11
+)
+
+const (
+	// Limit on received messages size.
+	maxPacketSize = 16 << 20
+)
+
+var (
+	errMalformed = errors.New("malformed BER packet")
+)
+
+type (
+	packet struct {
+		class       byte
+		constructed bool
+		tag         byte
+		// Contents of primitive packets.
+		value []byte
+		// Elements of constructed packets.
+		children []*packet
+	}
+)
+
+func newPacket(class byte, tag byte, value []byte) *packet {
+	return &packet{class: class, tag: tag, value: value}
+}
+
+func newConstructed(class byte, tag byte, children ...*packet) *packet {
+	return &packet{class: class, tag: tag, constructed: true, children: children}
+}
+
+func newSequence(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSequence, children...)
+}
+
+func newSet(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSet, children...)
+}
+
+func newString(s string) *packet {
+	return newPacket(classUniversal, tagOctetString, []byte(s))
+}
+
+func newInteger(tag byte, v int64) *packet {
+	return newPacket(classUniversal, tag, encodeInt(v))
+}
+
+func newBoolean(v bool) *packet {
+	b := byte(0x00)
+	if v {
+		b = 0xff
+	}
+	return newPacket(classUniversal, tagBoolean, []byte{b})
+}
+
+func (p *packet) append(children ...*packet) *packet {
+	p.children = append(p.children, children...)
+	return p
+}
+
+func (p *packet) is(class byte, tag byte) bool {
+	return p.class == class && p.tag == tag
+}
+
+func (p *packet) child(i int) (*packet, error) {
+	if i >= len(p.children) {
+		return nil, errMalformed
+	}
+	return p.children[i], nil
+}
+
+func (p *packet) str() string {
+	return string(p.value)
+}
+
+func (p *packet) int() (int64, error) {
+	return decodeInt(p.value)
+}
+
+// bytes returns the BER encoding of p.
+func (p *packet) bytes() []byte {
+	contents := p.value
+	if p.constructed {
+		contents = nil
+		for _, c := range p.children {
+			contents = append(contents, c.bytes()...)
+		}
+	}
+
+	id := p.class | p.tag
+	if p.constructed {
+		id |= constructedBit
+	}
+
+	b := []byte{id}
+	b = append(b, encodeLength(len(contents))...)
+	return append(b, contents...)
+}
+
+// readPacket reads a full packet from r.
+func readPacket(r *bufio.Reader) (*packet, error) {
+	id, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if l > maxPacketSize {
+		return nil, fmt.Errorf("packet too large: %d bytes", l)
+	}
+
+	contents := make([]byte, l)
+	_, err = io.ReadFull(r, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePacket(id, contents)
+}
+
+// decodePacket parses the first packet in b and returns the remaining bytes.
+func decodePacket(b []byte) (*packet, []byte, error) {
+	if len(b) < 2 {
+		return nil, nil, errMalformed
+	}
+
+	id := b[0]
+	b = b[1:]
+
+	var l int
+	if b[0]&0x80 == 0 {
+		l = int(b[0])
+		b = b[1:]
+	} else {
+		n := int(b[0] & 0x7f)
+		if n == 0 || n > 4 || len(b) < n+1 {
+			return nil, nil, errMalformed
+		}
+		for _, c := range b[1 : n+1] {
+			l = l<<8 | int(c)
+		}
+		b = b[n+1:]
+	}
+
+	if l < 0 || l > len(b) {
+		return nil, nil, errMalformed
+	}
+
+	p, err := parsePacket(id, b[:l])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p, b[l:], nil
+}
+
+func parsePacket(id byte, contents []byte) (*packet, error) {
+	if id&0x1f == 0x1f {
+		return nil, errors.New("high tag numbers not supported")
+	}
+
+	p := &packet{
+		class:       id & 0xc0,
+		constructed: id&constructedBit != 0,
+		tag:         id & 0x1f,
+	}
+
+	if !p.constructed {
+		p.value = contents
+		return p, nil
+	}
+
+	for len(contents) > 0 {
+		c, rest, err := decodePacket(contents)
+		if err != nil {
+			return nil, err
+		}
+		p.children = append(p.children, c)
+		contents = rest
+	}
+
+	return p, nil
+}
+
+func readLength(r *bufio.Reader) (int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+
+	if b&0x80 == 0 {
+		return int(b), nil
+	}
+
+	n := int(b & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, errMalformed
+	}
+
+	var l int
+	for i := 0; i < n; i++ {
+		b, err = r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		l = l<<8 | int(b)
+	}
+
+	return l, nil
+}
+
+func encodeLength(l int) []byte {
+	if l < 0x80 {
+		return []byte{byte(l)}
+	}
+
+	var b []byte
+	for v := l; v > 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+// encodeInt returns the minimal two's complement encoding of v.
+func encodeInt(v int64) []byte {
+	b := []byte{byte(v)}
+	for {
+		next := v >> 8
+		last := b[0]
+		// Stop when remaining bytes are just sign extension.
+		if (next == 0 && last&0x80 == 0) || (next == -1 && last&0x80 != 0) {
+			return b
+		}
+		v = next
+		b = append([]byte{byte(v)}, b...)
+	}
+}
+
+func decodeInt(b []byte) (int64, error) {
+	if len(b) == 0 || len(b) > 8 {
+		return 0, errMalformed
+	}
+
+	var v int64
+	if b[0]&0x80 != 0 {
+		v = -1
+	}
+
+	for _, c := range b {
+		v = v<<8 | int64(c)
+	}
+
+	return v, nil
+}
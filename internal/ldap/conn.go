@@ -0,0 +1,410 @@
+package ldap
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Protocol operation tags (RFC 4511, section 4.2 onwards).
+const (
+	opBindRequest       = 0
+	opBindResponse      = 1
+	opUnbindRequest     = 2
+	opSearchRequest     = 3
+	opSearchResultEntry = 4
+	opSearchResultDone  = 5
+	opSearchResultRef   = 19
+	opExtendedRequest   = 23
+	opExtendedResponse  = 24
+)
+
+// Result codes.
+const (
+	ResultSuccess            = 0
+	ResultSizeLimitExceeded  = 4
+	ResultNoSuchObject       = 32
+	ResultInvalidCredentials = 49
+)
+
+// Search scopes.
+const (
+	ScopeBaseObject   = 0
+	ScopeSingleLevel  = 1
+	ScopeWholeSubtree = 2
+)
+
+const (
+	protocolVersion = 3
+	startTLSOID     = "1.3.6.1.4.1.1466.20037"
+)
+
+type (
+	// Conn is a synchronous LDAPv3 client connection.
+	Conn struct {
+		conn    net.Conn
+		r       *bufio.Reader
+		msgID   int64
+		timeout time.Duration
+	}
+
+	// SearchRequest parameters.
+	SearchRequest struct {
+		BaseDN     string
+		Scope      int
+		Filter     string
+		Attributes []string
+		SizeLimit  int64
+	}
+
+	// Entry returned by a search.
+	// Attribute names are kept lowercased, they are case insensitive.
+	Entry struct {
+		DN         string
+		Attributes map[string][]string
+	}
+
+	// Error returned by the server.
+	Error struct {
+		Code    int64
+		Message string
+	}
+)
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap result code %d", e.Code)
+	}
+	return fmt.Sprintf("ldap result code %d: %s", e.Code, e.Message)
+}
+
+// IsResultCode returns true if err is an LDAP error with result code.
+func IsResultCode(err error, code int64) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == code
+}
+
+// Dial connects to an 'ldap://' or 'ldaps://' URL.
+// If startTLS is set a plain connection is upgraded before returning.
+func Dial(ctx context.Context, rawURL string, tlsCfg *tls.Config, startTLS bool, timeout time.Duration) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := u.Host
+	d := &net.Dialer{Timeout: timeout}
+
+	var nc net.Conn
+	switch strings.ToLower(u.Scheme) {
+	case "ldap":
+		if u.Port() == "" {
+			host = net.JoinHostPort(host, "389")
+		}
+		nc, err = d.DialContext(ctx, "tcp", host)
+
+	case "ldaps":
+		if u.Port() == "" {
+			host = net.JoinHostPort(host, "636")
+		}
+		nc, err = d.DialContext(ctx, "tcp", host)
+		if err == nil {
+			tc := tls.Client(nc, withServerName(tlsCfg, u.Hostname()))
+			err = tc.Handshake()
+			nc = tc
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported LDAP URL scheme: '%s'", u.Scheme)
+	}
+
+	if err != nil {
+		if nc != nil {
+			nc.Close()
+		}
+		return nil, err
+	}
+
+	c := NewConn(nc, timeout)
+
+	if startTLS && strings.ToLower(u.Scheme) == "ldap" {
+		err = c.StartTLS(withServerName(tlsCfg, u.Hostname()))
+		if err != nil {
+			c.conn.Close()
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// NewConn wraps an established connection.
+func NewConn(nc net.Conn, timeout time.Duration) *Conn {
+	return &Conn{
+		conn:    nc,
+		r:       bufio.NewReader(nc),
+		timeout: timeout,
+	}
+}
+
+// StartTLS upgrades the connection to TLS (RFC 4511, section 4.14).
+func (c *Conn) StartTLS(cfg *tls.Config) error {
+	req := newConstructed(classApplication, opExtendedRequest,
+		newPacket(classContext, 0, []byte(startTLSOID)))
+
+	res, err := c.request(req, opExtendedResponse)
+	if err != nil {
+		return err
+	}
+
+	err = resultError(res)
+	if err != nil {
+		return err
+	}
+
+	tc := tls.Client(c.conn, cfg)
+	err = tc.Handshake()
+	if err != nil {
+		return err
+	}
+
+	c.conn = tc
+	c.r = bufio.NewReader(tc)
+	return nil
+}
+
+// Bind authenticates as dn using a simple bind.
+// An empty password is rejected, servers treat it as an unauthenticated bind
+// that always succeeds (RFC 4513, section 5.1.2).
+func (c *Conn) Bind(dn, password string) error {
+	if password == "" {
+		return &Error{Code: ResultInvalidCredentials, Message: "empty password"}
+	}
+
+	req := newConstructed(classApplication, opBindRequest,
+		newInteger(tagInteger, protocolVersion),
+		newString(dn),
+		newPacket(classContext, 0, []byte(password)))
+
+	res, err := c.request(req, opBindResponse)
+	if err != nil {
+		return err
+	}
+
+	return resultError(res)
+}
+
+// Search returns the entries matching req.
+func (c *Conn) Search(req SearchRequest) ([]Entry, error) {
+	filter, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := newSequence()
+	for _, a := range req.Attributes {
+		attrs.append(newString(a))
+	}
+
+	op := newConstructed(classApplication, opSearchRequest,
+		newString(req.BaseDN),
+		newInteger(tagEnumerated, int64(req.Scope)),
+		newInteger(tagEnumerated, 0), // neverDerefAliases
+		newInteger(tagInteger, req.SizeLimit),
+		newInteger(tagInteger, int64(c.timeout/time.Second)),
+		newBoolean(false),
+		filter,
+		attrs)
+
+	id, err := c.send(op)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for {
+		res, err := c.receive(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case res.is(classApplication, opSearchResultEntry):
+			e, err := parseEntry(res)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+
+		case res.is(classApplication, opSearchResultRef):
+			// Referrals are not followed.
+
+		case res.is(classApplication, opSearchResultDone):
+			return entries, resultError(res)
+
+		default:
+			return nil, fmt.Errorf("unexpected search response tag %d", res.tag)
+		}
+	}
+}
+
+// Close sends an unbind request and closes the connection.
+func (c *Conn) Close() error {
+	c.send(newPacket(classApplication, opUnbindRequest, nil))
+	return c.conn.Close()
+}
+
+func (c *Conn) request(op *packet, resTag byte) (*packet, error) {
+	id, err := c.send(op)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.receive(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.is(classApplication, resTag) {
+		return nil, fmt.Errorf("unexpected response tag %d", res.tag)
+	}
+
+	return res, nil
+}
+
+func (c *Conn) send(op *packet) (int64, error) {
+	c.msgID++
+
+	msg := newSequence(newInteger(tagInteger, c.msgID), op)
+
+	c.setDeadline()
+	_, err := c.conn.Write(msg.bytes())
+	return c.msgID, err
+}
+
+// receive returns the protocol operation of the next message,
+// which must belong to request id.
+func (c *Conn) receive(id int64) (*packet, error) {
+	c.setDeadline()
+	msg, err := readPacket(c.r)
+	if err != nil {
+		return nil, err
+	}
+
+	mid, err := msg.child(0)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := mid.int()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != id {
+		return nil, fmt.Errorf("unexpected message ID %d, want %d", n, id)
+	}
+
+	return msg.child(1)
+}
+
+func (c *Conn) setDeadline() {
+	if c.timeout > 0 {
+		c.conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+}
+
+// resultError returns the error described by an LDAPResult, if any.
+func resultError(res *packet) error {
+	code, err := res.child(0)
+	if err != nil {
+		return err
+	}
+
+	n, err := code.int()
+	if err != nil {
+		return err
+	}
+
+	if n == ResultSuccess {
+		return nil
+	}
+
+	e := &Error{Code: n}
+	if msg, err := res.child(2); err == nil {
+		e.Message = msg.str()
+	}
+
+	return e
+}
+
+func parseEntry(p *packet) (Entry, error) {
+	dn, err := p.child(0)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	attrs, err := p.child(1)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	e := Entry{
+		DN:         dn.str(),
+		Attributes: make(map[string][]string),
+	}
+
+	for _, a := range attrs.children {
+		name, err := a.child(0)
+		if err != nil {
+			return Entry{}, err
+		}
+
+		vals, err := a.child(1)
+		if err != nil {
+			return Entry{}, err
+		}
+
+		key := strings.ToLower(name.str())
+		for _, v := range vals.children {
+			e.Attributes[key] = append(e.Attributes[key], v.str())
+		}
+	}
+
+	return e, nil
+}
+
+// Values of attribute name.
+func (e Entry) Values(name string) []string {
+	return e.Attributes[strings.ToLower(name)]
+}
+
+// Value returns the first value of attribute name.
+func (e Entry) Value(name string) string {
+	vs := e.Values(name)
+	if len(vs) == 0 {
+		return ""
+	}
+	return vs[0]
+}
+
+func withServerName(cfg *tls.Config, name string) *tls.Config {
+	if cfg == nil {
+		cfg = &tls.Config{}
+	}
+
+	if cfg.ServerName != "" {
+		return cfg
+	}
+
+	cfg = cfg.Clone()
+	cfg.ServerName = name
+	return cfg
+}
@@ -0,0 +1,480 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const (
+	// ModeBefore directories are checked first, users not found
+	// in them fall back to local passwords.
+	ModeBefore = "before"
+	// ModeInstead directories replace local passwords.
+	ModeInstead = "instead"
+)
+
+const (
+	defaultUserFilter = "(&(objectClass=person)(uid=%s))"
+	defaultSyncFilter = "(objectClass=person)"
+	defaultTimeout    = 10 * time.Second
+	sourcePrefix      = "ldap:"
+)
+
+var (
+	// ErrUserNotFound is returned when no directory entry matches a username.
+	ErrUserNotFound = errors.New("user not found in directory")
+	// ErrAmbiguousUser is returned when more than one entry matches a username.
+	ErrAmbiguousUser = errors.New("more than one directory entry matches user")
+	// ErrInvalidCredentials is returned when the directory rejects a password.
+	ErrInvalidCredentials = errors.New("invalid directory credentials")
+)
+
+type (
+	// Config of a directory.
+	// Every '%s' in UserFilter is replaced by the escaped username.
+	Config struct {
+		Name               string
+		URL                string
+		StartTLS           bool
+		InsecureSkipVerify bool
+		BindDN             string
+		BindPassword       string
+		BaseDN             string
+		UserFilter         string
+		SyncFilter         string
+		Timeout            time.Duration
+		// Mode is either ModeBefore or ModeInstead.
+		Mode string
+		// Tenants using this directory, all of them if empty.
+		Tenants []string
+		// Provision creates local users for directory ones on their first sign-in.
+		Provision bool
+		// Attribute names.
+		IDAttr         string
+		UsernameAttr   string
+		EmailAttr      string
+		GivenNameAttr  string
+		FamilyNameAttr string
+		GroupsAttr     string
+		// GroupRoles maps group DNs or common names, lowercased, to role names.
+		GroupRoles map[string]string
+	}
+
+	// Directory authenticates users against an LDAP server.
+	Directory struct {
+		Config
+		// Dial opens connections, replaceable in tests.
+		Dial func(ctx context.Context) (*Conn, error)
+	}
+
+	// User as found in a directory.
+	User struct {
+		// ID is a stable identifier of the entry, it survives renames.
+		ID         string
+		DN         string
+		Username   string
+		Email      string
+		GivenName  string
+		FamilyName string
+		Groups     []string
+		// Roles the user groups map to.
+		Roles []string
+	}
+
+	// Directories configured, in configuration order.
+	Directories struct {
+		dirs []*Directory
+	}
+)
+
+// NewDirectory for cfg, setting defaults for unset values.
+func NewDirectory(cfg Config) *Directory {
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = defaultUserFilter
+	}
+	if cfg.SyncFilter == "" {
+		cfg.SyncFilter = defaultSyncFilter
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.Mode != ModeInstead {
+		cfg.Mode = ModeBefore
+	}
+	if cfg.IDAttr == "" {
+		cfg.IDAttr = "entryUUID"
+	}
+	if cfg.UsernameAttr == "" {
+		cfg.UsernameAttr = "uid"
+	}
+	if cfg.EmailAttr == "" {
+		cfg.EmailAttr = "mail"
+	}
+	if cfg.GivenNameAttr == "" {
+		cfg.GivenNameAttr = "givenName"
+	}
+	if cfg.FamilyNameAttr == "" {
+		cfg.FamilyNameAttr = "sn"
+	}
+	if cfg.GroupsAttr == "" {
+		cfg.GroupsAttr = "memberOf"
+	}
+
+	d := &Directory{Config: cfg}
+	d.Dial = d.dial
+	return d
+}
+
+// Authenticate checks username and password against the directory.
+// It returns ErrUserNotFound if there is no entry for username
+// and ErrInvalidCredentials if the password is rejected.
+func (d *Directory) Authenticate(ctx context.Context, username, password string) (User, error) {
+	if password == "" {
+		return User{}, ErrInvalidCredentials
+	}
+
+	c, err := d.connect(ctx)
+	if err != nil {
+		return User{}, err
+	}
+	defer c.Close()
+
+	u, err := d.find(c, username)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = c.Bind(u.DN, password)
+	if IsResultCode(err, ResultInvalidCredentials) {
+		return User{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return User{}, err
+	}
+
+	return u, nil
+}
+
+// Users returns all directory users matching the sync filter.
+func (d *Directory) Users(ctx context.Context) ([]User, error) {
+	c, err := d.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	entries, err := c.Search(d.searchRequest(d.SyncFilter, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	us := make([]User, 0, len(entries))
+	for _, e := range entries {
+		u := d.toUser(e)
+		if u.Username == "" {
+			continue
+		}
+		us = append(us, u)
+	}
+
+	return us, nil
+}
+
+// AppliesTo returns true if tenantID uses this directory.
+func (d *Directory) AppliesTo(tenantID string) bool {
+	if len(d.Tenants) == 0 {
+		return true
+	}
+
+	for _, t := range d.Tenants {
+		if t == tenantID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Source identifies the directory where users and roles come from.
+func (d *Directory) Source() string {
+	return sourcePrefix + d.Name
+}
+
+// IsSource returns true if source identifies a directory.
+func IsSource(source string) bool {
+	return strings.HasPrefix(source, sourcePrefix)
+}
+
+// connect dials and binds as the service user, if any.
+func (d *Directory) connect(ctx context.Context) (*Conn, error) {
+	c, err := d.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.BindDN != "" {
+		err = c.Bind(d.BindDN, d.BindPassword)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("directory service bind: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func (d *Directory) dial(ctx context.Context) (*Conn, error) {
+	tc := &tls.Config{
+		InsecureSkipVerify: d.InsecureSkipVerify,
+	}
+
+	return Dial(ctx, d.URL, tc, d.StartTLS, d.Timeout)
+}
+
+func (d *Directory) find(c *Conn, username string) (User, error) {
+	filter := strings.Replace(d.UserFilter, "%s", EscapeFilter(username), -1)
+
+	entries, err := c.Search(d.searchRequest(filter, 2))
+	if IsResultCode(err, ResultSizeLimitExceeded) {
+		return User{}, ErrAmbiguousUser
+	}
+	if err != nil {
+		return User{}, err
+	}
+
+	switch len(entries) {
+	case 0:
+		return User{}, ErrUserNotFound
+	case 1:
+		return d.toUser(entries[0]), nil
+	default:
+		return User{}, ErrAmbiguousUser
+	}
+}
+
+func (d *Directory) searchRequest(filter string, limit int64) SearchRequest {
+	return SearchRequest{
+		BaseDN: d.BaseDN,
+		Scope:  ScopeWholeSubtree,
+		Filter: filter,
+		Attributes: []string{
+			d.IDAttr,
+			d.UsernameAttr,
+			d.EmailAttr,
+			d.GivenNameAttr,
+			d.FamilyNameAttr,
+			d.GroupsAttr,
+		},
+		SizeLimit: limit,
+	}
+}
+
+func (d *Directory) toUser(e Entry) User {
+	u := User{
+		ID:         entryID(e.Value(d.IDAttr)),
+		DN:         e.DN,
+		Username:   e.Value(d.UsernameAttr),
+		Email:      e.Value(d.EmailAttr),
+		GivenName:  e.Value(d.GivenNameAttr),
+		FamilyName: e.Value(d.FamilyNameAttr),
+		Groups:     e.Values(d.GroupsAttr),
+	}
+
+	if u.ID == "" {
+		u.ID = strings.ToLower(e.DN)
+	}
+
+	u.Roles = d.roles(u.Groups)
+	return u
+}
+
+// roles returns the roles groups map to, matching
+// either the full group DN or its common name.
+func (d *Directory) roles(groups []string) []string {
+	var roles []string
+	seen := map[string]bool{}
+
+	for _, g := range groups {
+		g = strings.ToLower(strings.TrimSpace(g))
+
+		role, ok := d.GroupRoles[g]
+		if !ok {
+			role, ok = d.GroupRoles[commonName(g)]
+		}
+
+		if ok && !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// commonName returns the value of the first RDN of dn if it is a 'cn' one.
+func commonName(dn string) string {
+	rdn := strings.SplitN(dn, ",", 2)[0]
+	kv := strings.SplitN(rdn, "=", 2)
+	if len(kv) != 2 || strings.TrimSpace(kv[0]) != "cn" {
+		return ""
+	}
+	return strings.TrimSpace(kv[1])
+}
+
+// entryID returns binary identifiers, like Active Directory objectGUID, hex encoded.
+func entryID(v string) string {
+	if utf8.ValidString(v) {
+		return v
+	}
+	return hex.EncodeToString([]byte(v))
+}
+
+// LoadDirectories builds the directories listed in 'app.ldap.directories',
+// a comma separated list of names.
+// Each one is configured through 'app.ldap.<name>.*' values, i.e. for 'corp':
+//
+//	app.ldap.corp.url                   ldap://host:389 or ldaps://host:636
+//	app.ldap.corp.start.tls             Upgrade ldap:// connections
+//	app.ldap.corp.insecure.skip.verify  Do not verify server certificate
+//	app.ldap.corp.bind.dn               Service user used to find users
+//	app.ldap.corp.bind.password
+//	app.ldap.corp.base.dn
+//	app.ldap.corp.user.filter           i.e. (&(objectClass=user)(|(sAMAccountName=%s)(mail=%s)))
+//	app.ldap.corp.sync.filter           Users kept in sync
+//	app.ldap.corp.timeout.seconds
+//	app.ldap.corp.mode                  'before' or 'instead' of local passwords
+//	app.ldap.corp.tenants               Comma separated, all tenants if empty
+//	app.ldap.corp.provision             Create local users on first sign-in
+//	app.ldap.corp.attr.id               Attribute names
+//	app.ldap.corp.attr.username
+//	app.ldap.corp.attr.email
+//	app.ldap.corp.attr.given.name
+//	app.ldap.corp.attr.family.name
+//	app.ldap.corp.attr.groups
+//	app.ldap.corp.group.roles           'group:role' pairs separated by ';'
+func LoadDirectories(cfg *config.Config) (*Directories, error) {
+	var dirs []*Directory
+
+	names := cfg.ValOrDef("app.ldap.directories", "")
+	for _, name := range splitList(names) {
+		name = strings.ToLower(name)
+		key := func(k string) string {
+			return fmt.Sprintf("app.ldap.%s.%s", name, k)
+		}
+		val := func(k string) string {
+			return cfg.ValOrDef(key(k), "")
+		}
+
+		dc := Config{
+			Name:               name,
+			URL:                val("url"),
+			StartTLS:           cfg.ValAsBool(key("start.tls"), false),
+			InsecureSkipVerify: cfg.ValAsBool(key("insecure.skip.verify"), false),
+			BindDN:             val("bind.dn"),
+			BindPassword:       val("bind.password"),
+			BaseDN:             val("base.dn"),
+			UserFilter:         val("user.filter"),
+			SyncFilter:         val("sync.filter"),
+			Timeout:            time.Duration(cfg.ValAsInt(key("timeout.seconds"), 10)) * time.Second,
+			Mode:               val("mode"),
+			Tenants:            splitList(val("tenants")),
+			Provision:          cfg.ValAsBool(key("provision"), true),
+			IDAttr:             val("attr.id"),
+			UsernameAttr:       val("attr.username"),
+			EmailAttr:          val("attr.email"),
+			GivenNameAttr:      val("attr.given.name"),
+			FamilyNameAttr:     val("attr.family.name"),
+			GroupsAttr:         val("attr.groups"),
+		}
+
+		if dc.URL == "" || dc.BaseDN == "" {
+			return nil, fmt.Errorf("directory '%s' needs URL and base DN", name)
+		}
+
+		if dc.UserFilter != "" && !strings.Contains(dc.UserFilter, "%s") {
+			return nil, fmt.Errorf("directory '%s' user filter must contain '%%s'", name)
+		}
+
+		if dc.Mode != "" && dc.Mode != ModeBefore && dc.Mode != ModeInstead {
+			return nil, fmt.Errorf("directory '%s' mode must be '%s' or '%s'", name, ModeBefore, ModeInstead)
+		}
+
+		gr, err := parseGroupRoles(val("group.roles"))
+		if err != nil {
+			return nil, fmt.Errorf("directory '%s': %w", name, err)
+		}
+		dc.GroupRoles = gr
+
+		dirs = append(dirs, NewDirectory(dc))
+	}
+
+	return NewDirectories(dirs...), nil
+}
+
+// NewDirectories from dirs.
+func NewDirectories(dirs ...*Directory) *Directories {
+	return &Directories{dirs: dirs}
+}
+
+// For returns the first directory tenantID uses, if any.
+func (dd *Directories) For(tenantID string) (*Directory, bool) {
+	if dd == nil {
+		return nil, false
+	}
+
+	for _, d := range dd.dirs {
+		if d.AppliesTo(tenantID) {
+			return d, true
+		}
+	}
+
+	return nil, false
+}
+
+// List returns all configured directories.
+func (dd *Directories) List() []*Directory {
+	if dd == nil {
+		return nil
+	}
+
+	return dd.dirs
+}
+
+// parseGroupRoles parses 'group:role' pairs separated by ';'.
+// Group DNs contain commas, role is taken after the last colon.
+func parseGroupRoles(s string) (map[string]string, error) {
+	gr := make(map[string]string)
+
+	for _, pair := range strings.Split(s, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		i := strings.LastIndex(pair, ":")
+		if i < 1 || i == len(pair)-1 {
+			return nil, fmt.Errorf("invalid group role mapping: '%s'", pair)
+		}
+
+		group := strings.ToLower(strings.TrimSpace(pair[:i]))
+		gr[group] = strings.TrimSpace(pair[i+1:])
+	}
+
+	return gr, nil
+}
+
+func splitList(s string) []string {
+	f := func(r rune) bool {
+		return r == ',' || r == ' '
+	}
+
+	return strings.FieldsFunc(s, f)
+}
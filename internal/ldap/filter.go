@@ -0,0 +1,223 @@
+package ldap
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Filter choice tags (RFC 4511, section 4.5.1).
+const (
+	filterAnd            = 0
+	filterOr             = 1
+	filterNot            = 2
+	filterEqualityMatch  = 3
+	filterSubstrings     = 4
+	filterGreaterOrEqual = 5
+	filterLessOrEqual    = 6
+	filterPresent        = 7
+	filterApproxMatch    = 8
+)
+
+// Substring choice tags.
+const (
+	substringInitial = 0
+	substringAny     = 1
+	substringFinal   = 2
+)
+
+// EscapeFilter escapes the characters with a special meaning
+// in search filter values (RFC 4515, section 3).
+// Use it for every user provided value interpolated into a filter.
+func EscapeFilter(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '*', '(', ')', '\\', 0:
+			fmt.Fprintf(&b, "\\%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// compileFilter parses a string search filter (RFC 4515) into its BER form.
+func compileFilter(s string) (*packet, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty filter")
+	}
+
+	if !strings.HasPrefix(s, "(") {
+		s = "(" + s + ")"
+	}
+
+	p, rest, err := parseFilter(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		return nil, fmt.Errorf("unexpected '%s' after filter", rest)
+	}
+
+	return p, nil
+}
+
+// parseFilter parses the parenthesized filter at the start of s
+// and returns the remaining string.
+func parseFilter(s string) (*packet, string, error) {
+	if len(s) < 2 || s[0] != '(' {
+		return nil, "", fmt.Errorf("filter must start with '(': '%s'", s)
+	}
+
+	switch s[1] {
+	case '&', '|':
+		tag := byte(filterAnd)
+		if s[1] == '|' {
+			tag = filterOr
+		}
+
+		p := newConstructed(classContext, tag)
+		rest := s[2:]
+		for strings.HasPrefix(rest, "(") {
+			c, r, err := parseFilter(rest)
+			if err != nil {
+				return nil, "", err
+			}
+			p.append(c)
+			rest = r
+		}
+
+		if !strings.HasPrefix(rest, ")") {
+			return nil, "", fmt.Errorf("unterminated filter: '%s'", s)
+		}
+
+		return p, rest[1:], nil
+
+	case '!':
+		c, rest, err := parseFilter(s[2:])
+		if err != nil {
+			return nil, "", err
+		}
+
+		if !strings.HasPrefix(rest, ")") {
+			return nil, "", fmt.Errorf("unterminated filter: '%s'", s)
+		}
+
+		return newConstructed(classContext, filterNot, c), rest[1:], nil
+	}
+
+	end := strings.IndexByte(s, ')')
+	if end < 0 {
+		return nil, "", fmt.Errorf("unterminated filter: '%s'", s)
+	}
+
+	p, err := parseItem(s[1:end])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return p, s[end+1:], nil
+}
+
+// parseItem parses a simple, presence or substring filter without parentheses.
+func parseItem(s string) (*packet, error) {
+	eq := strings.IndexByte(s, '=')
+	if eq < 1 {
+		return nil, fmt.Errorf("invalid filter item: '%s'", s)
+	}
+
+	attr, value := s[:eq], s[eq+1:]
+
+	tag := byte(filterEqualityMatch)
+	switch attr[len(attr)-1] {
+	case '>':
+		tag = filterGreaterOrEqual
+	case '<':
+		tag = filterLessOrEqual
+	case '~':
+		tag = filterApproxMatch
+	}
+
+	if tag != filterEqualityMatch {
+		attr = attr[:len(attr)-1]
+	}
+
+	if attr == "" {
+		return nil, fmt.Errorf("invalid filter item: '%s'", s)
+	}
+
+	if tag == filterEqualityMatch && value == "*" {
+		return newPacket(classContext, filterPresent, []byte(attr)), nil
+	}
+
+	if tag == filterEqualityMatch && strings.Contains(value, "*") {
+		return parseSubstrings(attr, value)
+	}
+
+	v, err := unescapeFilter(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConstructed(classContext, tag, newString(attr), newString(v)), nil
+}
+
+func parseSubstrings(attr, value string) (*packet, error) {
+	parts := strings.Split(value, "*")
+	subs := newSequence()
+
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		v, err := unescapeFilter(part)
+		if err != nil {
+			return nil, err
+		}
+
+		tag := byte(substringAny)
+		switch i {
+		case 0:
+			tag = substringInitial
+		case len(parts) - 1:
+			tag = substringFinal
+		}
+
+		subs.append(newPacket(classContext, tag, []byte(v)))
+	}
+
+	return newConstructed(classContext, filterSubstrings, newString(attr), subs), nil
+}
+
+func unescapeFilter(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i+3 > len(s) {
+			return "", fmt.Errorf("invalid escape in filter value: '%s'", s)
+		}
+
+		c, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape in filter value: '%s'", s)
+		}
+
+		b.Write(c)
+		i += 2
+	}
+
+	return b.String(), nil
+}
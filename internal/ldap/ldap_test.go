@@ -0,0 +1,520 @@
+package ldap
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const (
+	testBaseDN       = "dc=example,dc=com"
+	testBindDN       = "cn=granica,ou=services,dc=example,dc=com"
+	testBindPassword = "s3rv1c3"
+)
+
+type (
+	// mockServer is a minimal in-process LDAP server.
+	mockServer struct {
+		ln      net.Listener
+		mu      sync.Mutex
+		entries []Entry
+		// Passwords by DN.
+		passwords map[string]string
+		// Binds received, by DN.
+		binds []string
+	}
+)
+
+func newMockServer(t *testing.T) *mockServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &mockServer{
+		ln: ln,
+		passwords: map[string]string{
+			testBindDN:                               testBindPassword,
+			"uid=jdoe,ou=people,dc=example,dc=com":   "jdoe-pass",
+			"uid=asmith,ou=people,dc=example,dc=com": "asmith-pass",
+		},
+	}
+
+	s.addEntry("uid=jdoe,ou=people,dc=example,dc=com", map[string][]string{
+		"objectClass": {"person"},
+		"entryUUID":   {"7d1c0b4a-1111-4d1e-8a3e-000000000001"},
+		"uid":         {"jdoe"},
+		"mail":        {"jdoe@example.com"},
+		"givenName":   {"John"},
+		"sn":          {"Doe"},
+		"memberOf":    {"cn=Admins,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+	})
+	s.addEntry("uid=asmith,ou=people,dc=example,dc=com", map[string][]string{
+		"objectClass": {"person"},
+		"entryUUID":   {"7d1c0b4a-1111-4d1e-8a3e-000000000002"},
+		"uid":         {"asmith"},
+		"mail":        {"asmith@example.com"},
+		"givenName":   {"Anna"},
+		"sn":          {"Smith"},
+	})
+	s.addEntry("cn=printer,ou=devices,dc=example,dc=com", map[string][]string{
+		"objectClass": {"device"},
+		"cn":          {"printer"},
+	})
+
+	go s.serve()
+
+	return s
+}
+
+func (s *mockServer) addEntry(dn string, attrs map[string][]string) {
+	e := Entry{DN: dn, Attributes: map[string][]string{}}
+	for k, v := range attrs {
+		e.Attributes[strings.ToLower(k)] = v
+	}
+	s.entries = append(s.entries, e)
+}
+
+func (s *mockServer) url() string {
+	return "ldap://" + s.ln.Addr().String()
+}
+
+func (s *mockServer) serve() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(c)
+	}
+}
+
+func (s *mockServer) handle(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+
+	for {
+		msg, err := readPacket(r)
+		if err != nil {
+			return
+		}
+
+		id := msg.children[0]
+		op := msg.children[1]
+
+		switch {
+		case op.is(classApplication, opBindRequest):
+			dn := op.children[1].str()
+			pass := op.children[2].str()
+
+			s.mu.Lock()
+			s.binds = append(s.binds, dn)
+			want, ok := s.passwords[dn]
+			s.mu.Unlock()
+
+			code := int64(ResultSuccess)
+			if !ok || want != pass {
+				code = ResultInvalidCredentials
+			}
+			s.reply(c, id, ldapResult(opBindResponse, code))
+
+		case op.is(classApplication, opSearchRequest):
+			base := strings.ToLower(op.children[0].str())
+			limit, _ := op.children[3].int()
+			filter := op.children[6]
+
+			var n int64
+			code := int64(ResultSuccess)
+			for _, e := range s.entries {
+				if !strings.HasSuffix(strings.ToLower(e.DN), base) || !matches(filter, e) {
+					continue
+				}
+
+				if limit > 0 && n == limit {
+					code = ResultSizeLimitExceeded
+					break
+				}
+
+				s.reply(c, id, entryPacket(e))
+				n++
+			}
+			s.reply(c, id, ldapResult(opSearchResultDone, code))
+
+		case op.is(classApplication, opUnbindRequest):
+			return
+		}
+	}
+}
+
+func (s *mockServer) reply(c net.Conn, id, op *packet) {
+	c.Write(newSequence(id, op).bytes())
+}
+
+func (s *mockServer) bindsTo(dn string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int
+	for _, b := range s.binds {
+		if b == dn {
+			n++
+		}
+	}
+	return n
+}
+
+func ldapResult(tag byte, code int64) *packet {
+	return newConstructed(classApplication, tag,
+		newInteger(tagEnumerated, code),
+		newString(""),
+		newString(""))
+}
+
+func entryPacket(e Entry) *packet {
+	attrs := newSequence()
+	for k, vs := range e.Attributes {
+		vals := newSet()
+		for _, v := range vs {
+			vals.append(newString(v))
+		}
+		attrs.append(newSequence(newString(k), vals))
+	}
+
+	return newConstructed(classApplication, opSearchResultEntry, newString(e.DN), attrs)
+}
+
+// matches evaluates a BER encoded filter against e.
+func matches(f *packet, e Entry) bool {
+	switch f.tag {
+	case filterAnd:
+		for _, c := range f.children {
+			if !matches(c, e) {
+				return false
+			}
+		}
+		return true
+
+	case filterOr:
+		for _, c := range f.children {
+			if matches(c, e) {
+				return true
+			}
+		}
+		return false
+
+	case filterNot:
+		return !matches(f.children[0], e)
+
+	case filterEqualityMatch:
+		want := strings.ToLower(f.children[1].str())
+		for _, v := range e.Values(f.children[0].str()) {
+			if strings.ToLower(v) == want {
+				return true
+			}
+		}
+		return false
+
+	case filterSubstrings:
+		for _, v := range e.Values(f.children[0].str()) {
+			v = strings.ToLower(v)
+			ok := true
+			for _, sub := range f.children[1].children {
+				part := strings.ToLower(sub.str())
+				switch sub.tag {
+				case substringInitial:
+					ok = ok && strings.HasPrefix(v, part)
+				case substringFinal:
+					ok = ok && strings.HasSuffix(v, part)
+				default:
+					ok = ok && strings.Contains(v, part)
+				}
+			}
+			if ok {
+				return true
+			}
+		}
+		return false
+
+	case filterPresent:
+		return len(e.Values(f.str())) > 0
+	}
+
+	return false
+}
+
+func newTestDirectory(s *mockServer) *Directory {
+	return NewDirectory(Config{
+		Name:         "corp",
+		URL:          s.url(),
+		BindDN:       testBindDN,
+		BindPassword: testBindPassword,
+		BaseDN:       testBaseDN,
+		Timeout:      2 * time.Second,
+		GroupRoles: map[string]string{
+			"admins":                               "admin",
+			"cn=staff,ou=groups,dc=example,dc=com": "member",
+		},
+	})
+}
+
+func TestEncodeInt(t *testing.T) {
+	for _, v := range []int64{0, 1, 127, 128, 255, 256, 65535, -1, -128, -129, 1 << 40} {
+		b := encodeInt(v)
+
+		got, err := decodeInt(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != v {
+			t.Errorf("round trip of %d: got %d (% x)", v, got, b)
+		}
+	}
+
+	if b := encodeInt(128); len(b) != 2 || b[0] != 0x00 {
+		t.Errorf("128 must be encoded with a leading zero: % x", b)
+	}
+}
+
+func TestPacketLongLength(t *testing.T) {
+	p := newSequence(newString(strings.Repeat("x", 300)), newBoolean(true))
+
+	got, rest, err := decodePacket(p.bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rest) != 0 || len(got.children) != 2 || len(got.children[0].value) != 300 {
+		t.Errorf("unexpected decoded packet: %+v", got)
+	}
+}
+
+func TestCompileFilter(t *testing.T) {
+	e := Entry{DN: "uid=jdoe", Attributes: map[string][]string{
+		"uid":         {"jdoe"},
+		"mail":        {"jdoe@example.com"},
+		"objectclass": {"person"},
+		"cn":          {"John (Jr) Doe*"},
+	}}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"(uid=jdoe)", true},
+		{"uid=jdoe", true},
+		{"(uid=other)", false},
+		{"(&(objectClass=person)(uid=jdoe))", true},
+		{"(&(objectClass=person)(uid=other))", false},
+		{"(|(uid=other)(mail=jdoe@example.com))", true},
+		{"(!(uid=other))", true},
+		{"(mail=*)", true},
+		{"(telephoneNumber=*)", false},
+		{"(mail=jdoe@*)", true},
+		{"(mail=*@example.com)", true},
+		{"(mail=j*@*.com)", true},
+		{"(mail=x*)", false},
+		{"(cn=" + EscapeFilter("John (Jr) Doe*") + ")", true},
+	}
+
+	for _, tt := range tests {
+		f, err := compileFilter(tt.filter)
+		if err != nil {
+			t.Errorf("%s: %v", tt.filter, err)
+			continue
+		}
+
+		if got := matches(f, e); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.filter, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "(uid=jdoe", "(&(uid=jdoe)", "(=jdoe)", "(uid=\\zz)", "(uid=a)(uid=b)"} {
+		if _, err := compileFilter(bad); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+}
+
+func TestEscapeFilter(t *testing.T) {
+	got := EscapeFilter("*)(uid=*))(|(uid=*")
+	want := `\2a\29\28uid=\2a\29\29\28|\28uid=\2a`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := newMockServer(t)
+	defer s.ln.Close()
+	d := newTestDirectory(s)
+
+	u, err := d.Authenticate(context.Background(), "jdoe", "jdoe-pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Username != "jdoe" || u.Email != "jdoe@example.com" || u.GivenName != "John" || u.FamilyName != "Doe" {
+		t.Errorf("unexpected user: %+v", u)
+	}
+
+	if u.ID != "7d1c0b4a-1111-4d1e-8a3e-000000000001" {
+		t.Errorf("unexpected ID: %s", u.ID)
+	}
+
+	sort.Strings(u.Roles)
+	if !reflect.DeepEqual(u.Roles, []string{"admin", "member"}) {
+		t.Errorf("unexpected roles: %v", u.Roles)
+	}
+
+	if s.bindsTo(testBindDN) != 1 || s.bindsTo(u.DN) != 1 {
+		t.Errorf("expected a service and a user bind, got %v", s.binds)
+	}
+}
+
+func TestAuthenticateFailures(t *testing.T) {
+	s := newMockServer(t)
+	defer s.ln.Close()
+	d := newTestDirectory(s)
+	ctx := context.Background()
+
+	_, err := d.Authenticate(ctx, "jdoe", "wrong")
+	if err != ErrInvalidCredentials {
+		t.Errorf("wrong password: got %v", err)
+	}
+
+	_, err = d.Authenticate(ctx, "jdoe", "")
+	if err != ErrInvalidCredentials {
+		t.Errorf("empty password: got %v", err)
+	}
+
+	_, err = d.Authenticate(ctx, "nobody", "pass")
+	if err != ErrUserNotFound {
+		t.Errorf("unknown user: got %v", err)
+	}
+
+	// Injected filter would match every person.
+	_, err = d.Authenticate(ctx, "*", "jdoe-pass")
+	if err != ErrUserNotFound {
+		t.Errorf("wildcard username: got %v", err)
+	}
+
+	d.UserFilter = "(&(objectClass=person)(mail=*%s))"
+	_, err = d.Authenticate(ctx, "example.com", "jdoe-pass")
+	if err != ErrAmbiguousUser {
+		t.Errorf("ambiguous user: got %v", err)
+	}
+
+	d = newTestDirectory(s)
+	d.BindPassword = "wrong"
+	_, err = d.Authenticate(ctx, "jdoe", "jdoe-pass")
+	if err == nil || !IsResultCode(err, ResultInvalidCredentials) {
+		t.Errorf("service bind: got %v", err)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	s := newMockServer(t)
+	defer s.ln.Close()
+	d := newTestDirectory(s)
+
+	us, err := d.Users(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, u := range us {
+		names = append(names, u.Username)
+	}
+	sort.Strings(names)
+
+	if !reflect.DeepEqual(names, []string{"asmith", "jdoe"}) {
+		t.Errorf("unexpected users: %v", names)
+	}
+}
+
+func TestEntryID(t *testing.T) {
+	if got := entryID(string([]byte{0xff, 0x00, 0x10})); got != "ff0010" {
+		t.Errorf("binary ID: got %s", got)
+	}
+
+	if got := entryID("abc"); got != "abc" {
+		t.Errorf("text ID: got %s", got)
+	}
+}
+
+func TestLoadDirectories(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.SetNamespace("grc")
+	cfg.SetValues(map[string]string{
+		"app.ldap.directories":        "corp, ad",
+		"app.ldap.corp.url":           "ldaps://ldap.example.com",
+		"app.ldap.corp.base.dn":       testBaseDN,
+		"app.ldap.corp.tenants":       "t1,t2",
+		"app.ldap.corp.group.roles":   "cn=admins,ou=groups,dc=example,dc=com:admin; staff:member",
+		"app.ldap.ad.url":             "ldap://ad.example.com",
+		"app.ldap.ad.base.dn":         "dc=corp,dc=local",
+		"app.ldap.ad.mode":            "instead",
+		"app.ldap.ad.user.filter":     "(&(objectClass=user)(sAMAccountName=%s))",
+		"app.ldap.ad.attr.id":         "objectGUID",
+		"app.ldap.ad.attr.username":   "sAMAccountName",
+		"app.ldap.ad.timeout.seconds": "3",
+	})
+
+	dd, err := LoadDirectories(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dd.List()) != 2 {
+		t.Fatalf("unexpected directories: %+v", dd.List())
+	}
+
+	corp := dd.List()[0]
+	if corp.Mode != ModeBefore || !corp.Provision || corp.UserFilter != defaultUserFilter {
+		t.Errorf("unexpected corp defaults: %+v", corp.Config)
+	}
+
+	if corp.GroupRoles["cn=admins,ou=groups,dc=example,dc=com"] != "admin" || corp.GroupRoles["staff"] != "member" {
+		t.Errorf("unexpected group roles: %v", corp.GroupRoles)
+	}
+
+	ad := dd.List()[1]
+	if ad.Mode != ModeInstead || ad.IDAttr != "objectGUID" || ad.Timeout != 3*time.Second {
+		t.Errorf("unexpected ad config: %+v", ad.Config)
+	}
+
+	if d, _ := dd.For("t2"); d != corp {
+		t.Errorf("tenant t2 must use corp")
+	}
+
+	if d, _ := dd.For("t3"); d != ad {
+		t.Errorf("tenant t3 must use ad")
+	}
+
+	var none *Directories
+	if _, ok := none.For(""); ok {
+		t.Errorf("nil directories must have none")
+	}
+
+	cfg.SetValues(map[string]string{
+		"app.ldap.directories": "bad",
+		"app.ldap.bad.url":     "ldap://ldap.example.com",
+		"app.ldap.bad.base.dn": testBaseDN,
+		"app.ldap.bad.mode":    "after",
+	})
+
+	_, err = LoadDirectories(cfg)
+	if err == nil {
+		t.Errorf("expected invalid mode error")
+	}
+}
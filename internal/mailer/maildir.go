@@ -0,0 +1,74 @@
+package mailer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+// MaildirMailer drops emails into a maildir directory
+// instead of sending them, mainly for development.
+// Messages can be read with any maildir aware client (i.e. mutt -f <dir>).
+type MaildirMailer struct {
+	dir string
+	seq uint64
+}
+
+// NewMaildirMailer returns a maildir mailer
+// creating 'tmp', 'new' and 'cur' subdirectories if needed.
+func NewMaildirMailer(dir string) (*MaildirMailer, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		err := os.MkdirAll(filepath.Join(dir, sub), 0700)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MaildirMailer{dir: dir}, nil
+}
+
+// Send an email.
+func (m *MaildirMailer) Send(em model.Email) (resend bool, err error) {
+	msg, err := buildMessage(em)
+	if err != nil {
+		return false, err
+	}
+
+	name := m.uniqueName()
+	tmp := filepath.Join(m.dir, "tmp", name)
+
+	// Write into 'tmp' and then move to 'new'
+	// so that readers never see partial messages.
+	err = ioutil.WriteFile(tmp, msg, 0600)
+	if err != nil {
+		return true, err
+	}
+
+	err = os.Rename(tmp, filepath.Join(m.dir, "new", name))
+	if err != nil {
+		os.Remove(tmp)
+		return true, err
+	}
+
+	return false, nil
+}
+
+// Dir returns the maildir path.
+func (m *MaildirMailer) Dir() string {
+	return m.dir
+}
+
+func (m *MaildirMailer) uniqueName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "localhost"
+	}
+
+	seq := atomic.AddUint64(&m.seq, 1)
+	return fmt.Sprintf("%d.P%dQ%d.%s", time.Now().UnixNano(), os.Getpid(), seq, host)
+}
@@ -0,0 +1,117 @@
+package mailer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func TestMaildirSend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "granica-maildir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, err := NewMaildirMailer(dir)
+	if err != nil {
+		t.Fatalf("cannot create maildir: %s", err.Error())
+	}
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "", "Zażółć gęślą jaźń", "<p>Hi!</p>")
+
+	for i := 0; i < 2; i++ {
+		_, err = m.Send(em)
+		if err != nil {
+			t.Fatalf("send error: %s", err.Error())
+		}
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(files))
+	}
+
+	tmp, _ := ioutil.ReadDir(filepath.Join(dir, "tmp"))
+	if len(tmp) != 0 {
+		t.Errorf("no message should be left in 'tmp', found %d", len(tmp))
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "new", files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := string(b)
+	if !strings.Contains(msg, "To: user@mail.com") || !strings.Contains(msg, "Subject: =?UTF-8?q?") {
+		t.Errorf("unexpected message:\n%s", msg)
+	}
+}
+
+func TestMemorySend(t *testing.T) {
+	m := NewMemoryMailer()
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "", "Welcome", "<p>Hi!</p>")
+
+	_, err := m.Send(em)
+	if err != nil {
+		t.Fatalf("send error: %s", err.Error())
+	}
+
+	sent := m.Sent()
+	if len(sent) != 1 || sent[0].To != "user@mail.com" {
+		t.Errorf("unexpected sent emails: %+v", sent)
+	}
+
+	m.FailWith(errors.New("unavailable"))
+
+	resend, err := m.Send(em)
+	if err == nil || !resend {
+		t.Error("failing mailer should return a resendable error")
+	}
+
+	m.Reset()
+	if len(m.Sent()) != 0 {
+		t.Error("reset should discard sent emails")
+	}
+}
+
+type suppressionList map[string]bool
+
+func (sl suppressionList) IsSuppressed(email string) (bool, error) {
+	return sl[email], nil
+}
+
+func TestSuppressingMailer(t *testing.T) {
+	mm := NewMemoryMailer()
+	m := NewSuppressingMailer(mm, suppressionList{"bounced@mail.com": true})
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "", "Hi", "<p>Hi!</p>")
+	_, err := m.Send(em)
+	if err != nil {
+		t.Fatalf("send error: %s", err.Error())
+	}
+
+	em = model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "Bounced <bounced@mail.com>", "", "Hi", "<p>Hi!</p>")
+	resend, err := m.Send(em)
+	if !errors.Is(err, ErrSuppressed) {
+		t.Fatalf("expected ErrSuppressed, got %v", err)
+	}
+
+	if resend {
+		t.Error("suppressed emails should not be resendable")
+	}
+
+	if n := len(mm.Sent()); n != 1 {
+		t.Errorf("expected 1 sent email, got %d", n)
+	}
+}
@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"sync"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+// MemoryMailer records emails instead of sending them, mainly for tests.
+type MemoryMailer struct {
+	mu   sync.Mutex
+	sent []model.Email
+	err  error
+}
+
+// NewMemoryMailer returns an in-memory mailer.
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+// Send records an email.
+func (m *MemoryMailer) Send(em model.Email) (resend bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return true, m.err
+	}
+
+	m.sent = append(m.sent, em)
+	return false, nil
+}
+
+// Sent returns a copy of the recorded emails.
+func (m *MemoryMailer) Sent() []model.Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sent := make([]model.Email, len(m.sent))
+	copy(sent, m.sent)
+	return sent
+}
+
+// FailWith makes following sends fail with err, nil restores normal behavior.
+func (m *MemoryMailer) FailWith(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.err = err
+}
+
+// Reset discards recorded emails.
+func (m *MemoryMailer) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = nil
+}
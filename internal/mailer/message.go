@@ -0,0 +1,129 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"strings"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+// buildMessage renders an email as an RFC 5322 message.
+// If the email has a plain text version a multipart/alternative
+// message is built, otherwise just the HTML body is sent.
+func buildMessage(em model.Email) ([]byte, error) {
+	var b bytes.Buffer
+
+	charset := em.Charset
+	if charset == "" {
+		charset = "UTF-8"
+	}
+
+	from := em.From
+	if em.Name != "" {
+		from = (&mail.Address{Name: em.Name, Address: em.From}).String()
+	}
+
+	writeHeader(&b, "From", from)
+	writeHeader(&b, "To", em.To)
+	if em.CC != "" {
+		writeHeader(&b, "Cc", em.CC)
+	}
+	writeHeader(&b, "Subject", mime.QEncoding.Encode(charset, em.Subject))
+	writeHeader(&b, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(&b, "Message-ID", messageID(em))
+	writeHeader(&b, "MIME-Version", "1.0")
+
+	if em.Text == "" {
+		writeHeader(&b, "Content-Type", fmt.Sprintf("text/html; charset=%s", charset))
+		writeHeader(&b, "Content-Transfer-Encoding", "quoted-printable")
+		b.WriteString("\r\n")
+
+		err := writeQP(&b, em.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return b.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&b)
+	writeHeader(&b, "Content-Type", fmt.Sprintf("multipart/alternative; boundary=%s", mw.Boundary()))
+	b.WriteString("\r\n")
+
+	// Preferred alternative goes last.
+	parts := []struct{ ctype, body string }{
+		{"text/plain", em.Text},
+		{"text/html", em.Body},
+	}
+
+	for _, p := range parts {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", fmt.Sprintf("%s; charset=%s", p.ctype, charset))
+		h.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		pw, err := mw.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+
+		err = writeQP(pw, p.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := mw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func writeQP(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	_, err := qp.Write([]byte(body))
+	if err != nil {
+		return err
+	}
+
+	return qp.Close()
+}
+
+// recipients returns all envelope recipients, BCC included.
+func recipients(em model.Email) []string {
+	var rcpts []string
+	for _, list := range []string{em.To, em.CC, em.BCC} {
+		for _, addr := range strings.Split(list, ",") {
+			addr = strings.TrimSpace(addr)
+			if addr != "" {
+				rcpts = append(rcpts, addr)
+			}
+		}
+	}
+	return rcpts
+}
+
+func writeHeader(b *bytes.Buffer, key, value string) {
+	b.WriteString(key)
+	b.WriteString(": ")
+	b.WriteString(value)
+	b.WriteString("\r\n")
+}
+
+func messageID(em model.Email) string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "localhost"
+	}
+	return fmt.Sprintf("<%s@%s>", em.ID.String(), host)
+}
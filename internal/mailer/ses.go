@@ -0,0 +1,128 @@
+package mailer
+
+import (
+	"fmt"
+
+	//go get -u github.com/aws/aws-sdk-go
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/log"
+)
+
+// SESMailer sends emails through Amazon SES.
+type SESMailer struct {
+	client *ses.SES
+	log    *log.Logger
+}
+
+// NewSESMailer returns an Amazon SES mailer.
+// If region is empty AWS_REGION envar is used.
+func NewSESMailer(region string, log *log.Logger) (*SESMailer, error) {
+	awsCfg := &aws.Config{}
+	if region != "" {
+		awsCfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a SES session.
+	clt := ses.New(sess)
+
+	return &SESMailer{
+		client: clt,
+		log:    log,
+	}, nil
+}
+
+// Send an email.
+func (m *SESMailer) Send(em model.Email) (resend bool, err error) {
+	email := newSESEmail(em.From, em.To, em.CC, em.BCC, em.Subject, em.Body, em.Text, em.Charset)
+	result, err := m.client.SendEmail(email)
+
+	// Actually, all error cases are solved in the same way.
+	// In case that, eventually, it is not required to modify
+	// this behavior for some particular case, the following block
+	// could be replaced by a single line of code:
+	// return true, err
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+
+			case ses.ErrCodeMessageRejected:
+				// SES mail sending not succeed
+				// It probably does not exist but we can try again
+				return true, fmt.Errorf("cannot send the email: %s", err.Error())
+
+			case ses.ErrCodeMailFromDomainNotVerifiedException:
+				// SES cannot read MX record.
+				// It probably does not exist but we can try again
+				// just in cae it was a temporary failure
+				return true, fmt.Errorf("target domain not verified: %s", err.Error())
+
+			case ses.ErrCodeConfigurationSetDoesNotExistException:
+				// Configuration error, try a resend.
+				return true, fmt.Errorf("configuration error: %s", err.Error())
+
+			default:
+				// Default condition for SES related errors.
+				return true, fmt.Errorf("cannot send the email: %s", err.Error())
+			}
+		}
+		// Default condition for SES non codified errors.
+		return true, fmt.Errorf("cannot send the email: %s", err.Error())
+	}
+
+	m.log.Info("SES mailer mail sending", "result", result.GoString())
+
+	return false, nil
+}
+
+// Client return the provider client.
+func (m *SESMailer) Client() interface{} {
+	return m.client
+}
+
+func newSESEmail(from, to, cc, bcc, subject, html, text, charset string) *ses.SendEmailInput {
+	// Assemble the email.
+	email := &ses.SendEmailInput{
+		Destination: &ses.Destination{
+			BccAddresses: []*string{
+				aws.String(bcc),
+			},
+			CcAddresses: []*string{
+				aws.String(cc),
+			},
+			ToAddresses: []*string{
+				aws.String(to),
+			},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Html: &ses.Content{
+					Charset: aws.String(charset),
+					Data:    aws.String(html),
+				},
+			},
+			Subject: &ses.Content{
+				Charset: aws.String(charset),
+				Data:    aws.String(subject),
+			},
+		},
+		Source: aws.String(from),
+	}
+
+	if text != "" {
+		email.Message.Body.Text = &ses.Content{
+			Charset: aws.String(charset),
+			Data:    aws.String(text),
+		}
+	}
+
+	return email
+}
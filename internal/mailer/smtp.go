@@ -0,0 +1,186 @@
+package mailer
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+const (
+	// StartTLS modes
+	// StartTLSAuto upgrades the connection if server supports it.
+	StartTLSAuto = "auto"
+	// StartTLSAlways fails if server does not support STARTTLS.
+	StartTLSAlways = "always"
+	// StartTLSNever sends emails unencrypted.
+	StartTLSNever = "never"
+)
+
+type (
+	// SMTPOptions to connect to an SMTP server.
+	SMTPOptions struct {
+		Host     string
+		Port     int
+		Username string
+		Password string
+		StartTLS string
+		// Insecure skips server certificate verification.
+		Insecure bool
+		// TLSConfig overrides the default TLS configuration.
+		TLSConfig *tls.Config
+	}
+
+	// SMTPMailer sends emails through an SMTP server.
+	SMTPMailer struct {
+		opts SMTPOptions
+	}
+)
+
+var (
+	// ErrStartTLSNotSupported is returned when STARTTLS is required
+	// but not offered by the server.
+	ErrStartTLSNotSupported = errors.New("server does not support STARTTLS")
+)
+
+// NewSMTPMailer returns an SMTP mailer.
+func NewSMTPMailer(opts SMTPOptions) (*SMTPMailer, error) {
+	if opts.Host == "" {
+		return nil, errors.New("no SMTP host provided")
+	}
+
+	if opts.Port == 0 {
+		opts.Port = 25
+	}
+
+	switch opts.StartTLS {
+	case "":
+		opts.StartTLS = StartTLSAuto
+	case StartTLSAuto, StartTLSAlways, StartTLSNever:
+	default:
+		return nil, fmt.Errorf("invalid STARTTLS mode '%s'", opts.StartTLS)
+	}
+
+	return &SMTPMailer{opts: opts}, nil
+}
+
+// Send an email.
+func (m *SMTPMailer) Send(em model.Email) (resend bool, err error) {
+	msg, err := buildMessage(em)
+	if err != nil {
+		return false, err
+	}
+
+	rcpts := recipients(em)
+	if len(rcpts) == 0 {
+		return false, errors.New("no recipients")
+	}
+
+	addr := net.JoinHostPort(m.opts.Host, strconv.Itoa(m.opts.Port))
+
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		// Server may be temporarily unavailable.
+		return true, fmt.Errorf("cannot connect to SMTP server: %s", err.Error())
+	}
+	defer c.Close()
+
+	err = m.startTLS(c)
+	if err != nil {
+		return resendable(err), err
+	}
+
+	err = m.auth(c)
+	if err != nil {
+		return resendable(err), err
+	}
+
+	err = c.Mail(em.From)
+	if err != nil {
+		return resendable(err), err
+	}
+
+	for _, rcpt := range rcpts {
+		err = c.Rcpt(rcpt)
+		if err != nil {
+			return resendable(err), err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return resendable(err), err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return true, err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return resendable(err), err
+	}
+
+	err = c.Quit()
+	if err != nil {
+		// Message was already accepted.
+		return false, nil
+	}
+
+	return false, nil
+}
+
+func (m *SMTPMailer) startTLS(c *smtp.Client) error {
+	if m.opts.StartTLS == StartTLSNever {
+		return nil
+	}
+
+	ok, _ := c.Extension("STARTTLS")
+	if !ok {
+		if m.opts.StartTLS == StartTLSAlways {
+			return ErrStartTLSNotSupported
+		}
+		return nil
+	}
+
+	tc := m.opts.TLSConfig
+	if tc == nil {
+		tc = &tls.Config{
+			ServerName:         m.opts.Host,
+			InsecureSkipVerify: m.opts.Insecure,
+		}
+	}
+
+	return c.StartTLS(tc)
+}
+
+func (m *SMTPMailer) auth(c *smtp.Client) error {
+	if m.opts.Username == "" {
+		return nil
+	}
+
+	ok, _ := c.Extension("AUTH")
+	if !ok {
+		return errors.New("server does not support authentication")
+	}
+
+	// PlainAuth refuses to send credentials over unencrypted connections
+	// to hosts other than localhost.
+	a := smtp.PlainAuth("", m.opts.Username, m.opts.Password, m.opts.Host)
+	return c.Auth(a)
+}
+
+// resendable returns false for permanent (5xx) SMTP errors.
+func resendable(err error) bool {
+	var perr *textproto.Error
+	if errors.As(err, &perr) {
+		return perr.Code < 500
+	}
+	return true
+}
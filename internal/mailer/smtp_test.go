@@ -0,0 +1,325 @@
+package mailer
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+type (
+	// fakeSMTPServer is a minimal SMTP server accepting a single session.
+	fakeSMTPServer struct {
+		ln       net.Listener
+		tls      *tls.Config
+		username string
+		password string
+		reject   bool
+
+		mu       sync.Mutex
+		tlsUsed  bool
+		authUser string
+		from     string
+		rcpts    []string
+		data     string
+		done     chan struct{}
+	}
+)
+
+func TestSMTPSendStartTLSAndAuth(t *testing.T) {
+	srv, pool := startFakeSMTPServer(t, false)
+	defer srv.ln.Close()
+
+	m := newTestSMTPMailer(t, srv, pool, StartTLSAlways)
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "audit@mail.com", "Welcome", "<p>Hi!</p>")
+
+	resend, err := m.Send(em)
+	if err != nil {
+		t.Fatalf("send error: %s", err.Error())
+	}
+
+	if resend {
+		t.Error("successful send should not ask for resend")
+	}
+
+	srv.wait(t)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if !srv.tlsUsed {
+		t.Error("connection was not upgraded with STARTTLS")
+	}
+
+	if srv.authUser != "granica" {
+		t.Errorf("expected authenticated user 'granica', got '%s'", srv.authUser)
+	}
+
+	if srv.from != "dontreply@localhost" {
+		t.Errorf("unexpected sender '%s'", srv.from)
+	}
+
+	if strings.Join(srv.rcpts, ",") != "user@mail.com,audit@mail.com" {
+		t.Errorf("unexpected recipients %v", srv.rcpts)
+	}
+
+	if !strings.Contains(srv.data, "Subject: Welcome") || !strings.Contains(srv.data, "<p>Hi!</p>") {
+		t.Errorf("unexpected message:\n%s", srv.data)
+	}
+
+	if strings.Contains(srv.data, "audit@mail.com") {
+		t.Error("BCC recipient should not be visible in message headers")
+	}
+}
+
+func TestSMTPSendRejected(t *testing.T) {
+	srv, pool := startFakeSMTPServer(t, true)
+	defer srv.ln.Close()
+
+	m := newTestSMTPMailer(t, srv, pool, StartTLSAuto)
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "nobody@mail.com", "", "", "Welcome", "<p>Hi!</p>")
+
+	resend, err := m.Send(em)
+	if err == nil {
+		t.Fatal("rejected recipient should return an error")
+	}
+
+	if resend {
+		t.Error("permanent failure should not ask for resend")
+	}
+}
+
+func TestSMTPSendServerDown(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+	ln.Close()
+
+	m, err := NewSMTPMailer(SMTPOptions{Host: "127.0.0.1", Port: addr.Port})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "", "Welcome", "<p>Hi!</p>")
+
+	resend, err := m.Send(em)
+	if err == nil {
+		t.Fatal("unreachable server should return an error")
+	}
+
+	if !resend {
+		t.Error("unreachable server should ask for resend")
+	}
+}
+
+func TestSMTPInvalidStartTLSMode(t *testing.T) {
+	_, err := NewSMTPMailer(SMTPOptions{Host: "localhost", StartTLS: "sometimes"})
+	if err == nil {
+		t.Error("invalid STARTTLS mode should not be accepted")
+	}
+}
+
+func newTestSMTPMailer(t *testing.T, srv *fakeSMTPServer, pool *x509.CertPool, mode string) *SMTPMailer {
+	addr := srv.ln.Addr().(*net.TCPAddr)
+
+	m, err := NewSMTPMailer(SMTPOptions{
+		Host:      "127.0.0.1",
+		Port:      addr.Port,
+		Username:  srv.username,
+		Password:  srv.password,
+		StartTLS:  mode,
+		TLSConfig: &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func startFakeSMTPServer(t *testing.T, reject bool) (*fakeSMTPServer, *x509.CertPool) {
+	cert, pool := selfSignedCert(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := &fakeSMTPServer{
+		ln:       ln,
+		tls:      &tls.Config{Certificates: []tls.Certificate{cert}},
+		username: "granica",
+		password: "secret",
+		reject:   reject,
+		done:     make(chan struct{}),
+	}
+
+	go srv.serve()
+
+	return srv, pool
+}
+
+func (s *fakeSMTPServer) wait(t *testing.T) {
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP session did not finish")
+	}
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	reply := func(lines ...string) {
+		for _, l := range lines {
+			rw.WriteString(l + "\r\n")
+		}
+		rw.Flush()
+	}
+
+	reply("220 localhost fake SMTP")
+
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			s.mu.Lock()
+			secure := s.tlsUsed
+			s.mu.Unlock()
+			if secure {
+				reply("250-localhost", "250 AUTH PLAIN")
+			} else {
+				reply("250-localhost", "250 STARTTLS")
+			}
+
+		case cmd == "STARTTLS":
+			reply("220 ready to start TLS")
+			tc := tls.Server(conn, s.tls)
+			if tc.Handshake() != nil {
+				return
+			}
+			conn = tc
+			rw = bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+			s.mu.Lock()
+			s.tlsUsed = true
+			s.mu.Unlock()
+
+		case strings.HasPrefix(cmd, "AUTH PLAIN"):
+			fields := strings.Fields(line)
+			b, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			parts := strings.Split(string(b), "\x00")
+			if len(parts) != 3 || parts[1] != s.username || parts[2] != s.password {
+				reply("535 authentication failed")
+				continue
+			}
+			s.mu.Lock()
+			s.authUser = parts[1]
+			s.mu.Unlock()
+			reply("235 authenticated")
+
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			s.mu.Unlock()
+			reply("250 ok")
+
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			if s.reject {
+				reply("550 no such user")
+				continue
+			}
+			s.mu.Lock()
+			s.rcpts = append(s.rcpts, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			s.mu.Unlock()
+			reply("250 ok")
+
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := rw.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.mu.Lock()
+			s.data = data.String()
+			s.mu.Unlock()
+			reply("250 queued")
+
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(c)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
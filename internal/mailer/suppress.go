@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+type (
+	// SuppressionList tells which addresses must not receive emails,
+	// i.e. because they bounced or complained.
+	SuppressionList interface {
+		IsSuppressed(email string) (bool, error)
+	}
+
+	// SuppressingMailer is a mailer that refuses to send emails
+	// to suppressed addresses.
+	SuppressingMailer struct {
+		Mailer
+		list SuppressionList
+	}
+)
+
+var (
+	// ErrSuppressed is returned when an email recipient is suppressed.
+	ErrSuppressed = errors.New("recipient is suppressed")
+)
+
+// NewSuppressingMailer wraps m so that suppressed addresses are not mailed.
+func NewSuppressingMailer(m Mailer, list SuppressionList) *SuppressingMailer {
+	return &SuppressingMailer{
+		Mailer: m,
+		list:   list,
+	}
+}
+
+// Send the email unless any of its recipients is suppressed.
+// Suppressed emails are not resendable.
+func (sm *SuppressingMailer) Send(em model.Email) (resend bool, err error) {
+	for _, rcpt := range recipients(em) {
+		addr := rcpt
+		if a, err := mail.ParseAddress(rcpt); err == nil {
+			addr = a.Address
+		}
+
+		ok, err := sm.list.IsSuppressed(addr)
+		if err != nil {
+			return true, err
+		}
+
+		if ok {
+			return false, fmt.Errorf("%w: %s", ErrSuppressed, addr)
+		}
+	}
+
+	return sm.Mailer.Send(em)
+}
@@ -0,0 +1,230 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/markbates/pkger"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+type (
+	// Templates renders localized emails from templates embedded
+	// under '/assets/web/embed/mail'.
+	// Each email has an HTML ('<name>.html.tmpl') and a plain text
+	// ('<name>.txt.tmpl') version, both define a 'content' block that is
+	// rendered inside the shared layout ('layout/base.html.tmpl' and
+	// 'layout/base.txt.tmpl').
+	// Subject is the localized message '<name>_mail_subject'.
+	Templates struct {
+		html   map[string]*htmltemplate.Template
+		text   map[string]*texttemplate.Template
+		bundle *i18n.Bundle
+	}
+
+	// MailData is made available to templates and localized messages.
+	MailData map[string]interface{}
+)
+
+const (
+	mailTemplateDir = "/assets/web/embed/mail"
+	mailLayoutDir   = "layout"
+	htmlExt         = ".html.tmpl"
+	textExt         = ".txt.tmpl"
+)
+
+var (
+	// ErrNoMailTemplate is returned when a template does not exist.
+	ErrNoMailTemplate = errors.New("mail template not found")
+)
+
+// LoadTemplates parses embedded mail templates.
+func LoadTemplates(bundle *i18n.Bundle) (*Templates, error) {
+	files, err := readMailTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTemplates(files, bundle)
+}
+
+// ParseTemplates parses mail templates from a map of file paths,
+// relative to mail templates dir, to their content.
+func ParseTemplates(files map[string]string, bundle *i18n.Bundle) (*Templates, error) {
+	ts := &Templates{
+		html:   make(map[string]*htmltemplate.Template),
+		text:   make(map[string]*texttemplate.Template),
+		bundle: bundle,
+	}
+
+	htmlLayout, ok := files[path.Join(mailLayoutDir, "base"+htmlExt)]
+	if !ok {
+		return nil, fmt.Errorf("%w: HTML layout", ErrNoMailTemplate)
+	}
+
+	textLayout, ok := files[path.Join(mailLayoutDir, "base"+textExt)]
+	if !ok {
+		return nil, fmt.Errorf("%w: text layout", ErrNoMailTemplate)
+	}
+
+	// Placeholder, replaced by a localizer on each render.
+	fx := map[string]interface{}{"t": func(id string, data ...interface{}) string { return id }}
+
+	for p, content := range files {
+		if path.Dir(p) == mailLayoutDir {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(p, htmlExt):
+			name := strings.TrimSuffix(p, htmlExt)
+			t, err := htmltemplate.New(name).Funcs(fx).Parse(htmlLayout)
+			if err == nil {
+				t, err = t.Parse(content)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse mail template '%s': %s", p, err.Error())
+			}
+			ts.html[name] = t
+
+		case strings.HasSuffix(p, textExt):
+			name := strings.TrimSuffix(p, textExt)
+			t, err := texttemplate.New(name).Funcs(fx).Parse(textLayout)
+			if err == nil {
+				t, err = t.Parse(content)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse mail template '%s': %s", p, err.Error())
+			}
+			ts.text[name] = t
+		}
+	}
+
+	return ts, nil
+}
+
+// Render the email 'name' in lang.
+// Text is empty if there is no plain text version.
+func (ts *Templates) Render(name, lang string, data MailData) (subject, html, text string, err error) {
+	ht, ok := ts.html[name]
+	if !ok {
+		return "", "", "", fmt.Errorf("%w: %s", ErrNoMailTemplate, name)
+	}
+
+	if data == nil {
+		data = MailData{}
+	}
+	data["Lang"] = lang
+
+	l := i18n.NewLocalizer(ts.bundle, lang)
+	t := ts.localizeFx(l, data)
+	fx := htmltemplate.FuncMap{"t": t}
+
+	subject = t(name+"_mail_subject", data)
+
+	hc, err := ht.Clone()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var b bytes.Buffer
+	err = hc.Funcs(fx).ExecuteTemplate(&b, "base", data)
+	if err != nil {
+		return "", "", "", err
+	}
+	html = b.String()
+
+	tt, ok := ts.text[name]
+	if !ok {
+		return subject, html, "", nil
+	}
+
+	tc, err := tt.Clone()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	b.Reset()
+	err = tc.Funcs(texttemplate.FuncMap{"t": t}).ExecuteTemplate(&b, "base", data)
+	if err != nil {
+		return "", "", "", err
+	}
+	text = b.String()
+
+	return subject, html, text, nil
+}
+
+// Names returns available email names.
+func (ts *Templates) Names() []string {
+	names := make([]string, 0, len(ts.html))
+	for n := range ts.html {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// localizeFx returns a function that localizes a message ID using
+// data, or the provided one, as template data.
+func (ts *Templates) localizeFx(l *i18n.Localizer, data MailData) func(id string, args ...interface{}) string {
+	return func(id string, args ...interface{}) string {
+		var td interface{} = data
+		if len(args) > 0 {
+			td = args[0]
+		}
+
+		s, err := l.Localize(&i18n.LocalizeConfig{
+			MessageID:    id,
+			TemplateData: td,
+		})
+		if err != nil {
+			return id
+		}
+
+		return s
+	}
+}
+
+func readMailTemplates() (map[string]string, error) {
+	files := make(map[string]string)
+
+	err := pkger.Walk("/assets/web/embed/mail", func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || !strings.HasSuffix(p, ".tmpl") {
+			return nil
+		}
+
+		// Walk paths are prefixed by module name, i.e. 'module:/assets/...'
+		if i := strings.Index(p, ":"); i >= 0 {
+			p = p[i+1:]
+		}
+
+		f, err := pkger.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		b, err := ioutil.ReadAll(f)
+		if err != nil {
+			return err
+		}
+
+		rel := strings.TrimPrefix(p, mailTemplateDir+"/")
+		files[rel] = string(b)
+		return nil
+	})
+
+	return files, err
+}
@@ -0,0 +1,106 @@
+package mailer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"golang.org/x/text/language"
+)
+
+var testMailFiles = map[string]string{
+	"layout/base.html.tmpl": `{{define "base"}}<p>{{t "mail_greeting"}}</p>{{template "content" .}}{{end}}`,
+	"layout/base.txt.tmpl":  `{{define "base"}}{{t "mail_greeting"}}` + "\n" + `{{template "content" .}}{{end}}`,
+	"welcome.html.tmpl":     `{{define "content"}}<a href="{{.Link}}">{{t "welcome_mail_action"}}</a>{{end}}`,
+	"welcome.txt.tmpl":      `{{define "content"}}{{t "welcome_mail_action"}}: {{.Link}}{{end}}`,
+}
+
+func testBundle() *i18n.Bundle {
+	b := i18n.NewBundle(language.English)
+	b.AddMessages(language.English,
+		&i18n.Message{ID: "mail_greeting", Other: "Hi {{.Username}},"},
+		&i18n.Message{ID: "welcome_mail_subject", Other: "Welcome"},
+		&i18n.Message{ID: "welcome_mail_action", Other: "Start"},
+	)
+	b.AddMessages(language.Polish,
+		&i18n.Message{ID: "mail_greeting", Other: "Cześć {{.Username}},"},
+		&i18n.Message{ID: "welcome_mail_subject", Other: "Witamy"},
+		&i18n.Message{ID: "welcome_mail_action", Other: "Zacznij"},
+	)
+	return b
+}
+
+func TestRenderLocalized(t *testing.T) {
+	ts, err := ParseTemplates(testMailFiles, testBundle())
+	if err != nil {
+		t.Fatalf("cannot parse templates: %s", err.Error())
+	}
+
+	data := MailData{"Username": "john", "Link": "https://localhost/a?b=1&c=2"}
+
+	subject, html, text, err := ts.Render("welcome", "pl", data)
+	if err != nil {
+		t.Fatalf("render error: %s", err.Error())
+	}
+
+	if subject != "Witamy" {
+		t.Errorf("expected subject 'Witamy', got '%s'", subject)
+	}
+
+	if !strings.Contains(html, "<p>Cześć john,</p>") || !strings.Contains(html, `href="https://localhost/a?b=1&amp;c=2"`) {
+		t.Errorf("unexpected HTML body: %s", html)
+	}
+
+	if text != "Cześć john,\nZacznij: https://localhost/a?b=1&c=2" {
+		t.Errorf("unexpected text body: %q", text)
+	}
+
+	// Unknown languages fall back to default one.
+	subject, _, _, err = ts.Render("welcome", "xx", data)
+	if err != nil {
+		t.Fatalf("render error: %s", err.Error())
+	}
+
+	if subject != "Welcome" {
+		t.Errorf("expected subject 'Welcome', got '%s'", subject)
+	}
+}
+
+func TestRenderUnknownMail(t *testing.T) {
+	ts, err := ParseTemplates(testMailFiles, testBundle())
+	if err != nil {
+		t.Fatalf("cannot parse templates: %s", err.Error())
+	}
+
+	if names := ts.Names(); len(names) != 1 || names[0] != "welcome" {
+		t.Errorf("unexpected names: %v", names)
+	}
+
+	_, _, _, err = ts.Render("farewell", "en", nil)
+	if !errors.Is(err, ErrNoMailTemplate) {
+		t.Errorf("expected ErrNoMailTemplate, got %v", err)
+	}
+}
+
+func TestBuildMultipartMessage(t *testing.T) {
+	em := model.MakeEmail("Granica", "dontreply@localhost", "user@mail.com", "", "", "Hi", "<p>Hi!</p>")
+	em.Text = "Hi!"
+
+	msg, err := buildMessage(em)
+	if err != nil {
+		t.Fatalf("cannot build message: %s", err.Error())
+	}
+
+	s := string(msg)
+	for _, want := range []string{"multipart/alternative", "Content-Type: text/plain", "Content-Type: text/html"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("message has no '%s':\n%s", want, s)
+		}
+	}
+
+	if strings.Index(s, "text/plain") > strings.Index(s, "text/html") {
+		t.Error("plain text part should precede HTML one")
+	}
+}
@@ -0,0 +1,62 @@
+package migration
+
+import "log"
+
+// CreateUsersSearchIndexes migration
+func (m *mig) CreateUsersSearchIndexes() error {
+	tx := m.GetTx()
+
+	st := `CREATE EXTENSION IF NOT EXISTS pg_trgm;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX users_username_trgm_idx ON users USING GIN (username gin_trgm_ops);
+		CREATE INDEX users_email_trgm_idx ON users USING GIN (email gin_trgm_ops);
+		CREATE INDEX users_given_name_trgm_idx ON users USING GIN (given_name gin_trgm_ops);
+		CREATE INDEX users_family_name_trgm_idx ON users USING GIN (family_name gin_trgm_ops);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX users_fts_idx ON users USING GIN (
+			to_tsvector('simple',
+				coalesce(username, '') || ' ' ||
+				coalesce(email, '') || ' ' ||
+				coalesce(given_name, '') || ' ' ||
+				coalesce(middle_names, '') || ' ' ||
+				coalesce(family_name, '')));`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUsersSearchIndexes rollback
+func (m *mig) DropUsersSearchIndexes() error {
+	tx := m.GetTx()
+
+	st := `
+		DROP INDEX IF EXISTS users_fts_idx;
+		DROP INDEX IF EXISTS users_family_name_trgm_idx;
+		DROP INDEX IF EXISTS users_given_name_trgm_idx;
+		DROP INDEX IF EXISTS users_email_trgm_idx;
+		DROP INDEX IF EXISTS users_username_trgm_idx;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
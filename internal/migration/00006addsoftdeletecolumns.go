@@ -0,0 +1,62 @@
+package migration
+
+import "log"
+
+// AddSoftDeleteColumns migration
+func (m *mig) AddSoftDeleteColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE users
+		ADD COLUMN deleted_by_id UUID,
+		ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		ALTER TABLE accounts
+		ADD COLUMN deleted_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX users_deleted_at_idx ON users (deleted_at) WHERE is_deleted;
+		CREATE INDEX accounts_deleted_at_idx ON accounts (deleted_at) WHERE is_deleted;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropSoftDeleteColumns rollback
+func (m *mig) DropSoftDeleteColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		DROP INDEX IF EXISTS accounts_deleted_at_idx;
+		DROP INDEX IF EXISTS users_deleted_at_idx;
+		ALTER TABLE accounts
+		DROP COLUMN IF EXISTS deleted_at,
+		DROP COLUMN IF EXISTS deleted_by_id;
+		ALTER TABLE users
+		DROP COLUMN IF EXISTS deleted_at,
+		DROP COLUMN IF EXISTS deleted_by_id;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package migration
+
+import "log"
+
+// CreateAuditEventsTable migration
+func (m *mig) CreateAuditEventsTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE audit_events
+	(
+		id UUID PRIMARY KEY,
+		tenant_id VARCHAR(128),
+		actor_id UUID,
+		target_type VARCHAR(32),
+		target_id VARCHAR(255),
+		action VARCHAR(64) NOT NULL,
+		ip INET,
+		user_agent TEXT,
+		request_id VARCHAR(128),
+		metadata JSONB,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX audit_events_created_at_idx ON audit_events (created_at DESC);
+		CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id);
+		CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id);
+		CREATE INDEX audit_events_action_idx ON audit_events (action);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	// Events are append-only.
+	st = `
+		CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_events is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+
+		CREATE TRIGGER audit_events_append_only
+		BEFORE UPDATE OR DELETE ON audit_events
+		FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropAuditEventsTable rollback
+func (m *mig) DropAuditEventsTable() error {
+	tx := m.GetTx()
+
+	st := `
+		DROP TABLE audit_events;
+		DROP FUNCTION IF EXISTS audit_events_append_only();`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
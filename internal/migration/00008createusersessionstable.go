@@ -0,0 +1,51 @@
+package migration
+
+import "log"
+
+// CreateUserSessionsTable migration
+func (m *mig) CreateUserSessionsTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_sessions
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		token_digest CHAR(64) UNIQUE NOT NULL,
+		ip INET,
+		user_agent TEXT,
+		geolocation geography (Point,4326),
+		last_seen_at TIMESTAMP WITH TIME ZONE,
+		expires_at TIMESTAMP WITH TIME ZONE,
+		revoked_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX user_sessions_user_id_idx ON user_sessions (user_id, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserSessionsTable rollback
+func (m *mig) DropUserSessionsTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_sessions;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
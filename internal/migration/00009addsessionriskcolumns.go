@@ -0,0 +1,44 @@
+package migration
+
+import "log"
+
+// AddSessionRiskColumns migration
+func (m *mig) AddSessionRiskColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE user_sessions
+		ADD COLUMN country VARCHAR(2),
+		ADD COLUMN risk_score INTEGER NOT NULL DEFAULT 0,
+		ADD COLUMN risk_reasons VARCHAR(255),
+		ADD COLUMN verification_digest CHAR(64) UNIQUE,
+		ADD COLUMN verified_at TIMESTAMP WITH TIME ZONE;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropSessionRiskColumns rollback
+func (m *mig) DropSessionRiskColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE user_sessions
+		DROP COLUMN IF EXISTS verified_at,
+		DROP COLUMN IF EXISTS verification_digest,
+		DROP COLUMN IF EXISTS risk_reasons,
+		DROP COLUMN IF EXISTS risk_score,
+		DROP COLUMN IF EXISTS country;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package migration
+
+import "log"
+
+// CreateEmailOutboxTable migration
+func (m *mig) CreateEmailOutboxTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE email_outbox
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		kind VARCHAR(64),
+		sender_name VARCHAR(255),
+		sender_email VARCHAR(255) NOT NULL,
+		recipient VARCHAR(255) NOT NULL,
+		cc TEXT,
+		bcc TEXT,
+		subject TEXT NOT NULL,
+		html_body TEXT,
+		text_body TEXT,
+		charset VARCHAR(32),
+		status VARCHAR(16) NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		last_error TEXT,
+		sent_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX email_outbox_due_idx ON email_outbox (next_attempt_at) WHERE status IN ('pending', 'sending');`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX email_outbox_status_idx ON email_outbox (status, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropEmailOutboxTable rollback
+func (m *mig) DropEmailOutboxTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE email_outbox;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package migration
+
+import "log"
+
+// CreateEmailSuppressionsTable migration
+func (m *mig) CreateEmailSuppressionsTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE email_suppressions
+	(
+		id UUID PRIMARY KEY,
+		email VARCHAR(255) UNIQUE NOT NULL,
+		reason VARCHAR(16) NOT NULL,
+		kind VARCHAR(64),
+		source VARCHAR(16),
+		feedback_id VARCHAR(255),
+		diagnostic TEXT,
+		occurrences INTEGER NOT NULL DEFAULT 1,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `ALTER TABLE users ADD COLUMN email_undeliverable_at TIMESTAMP WITH TIME ZONE;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropEmailSuppressionsTable rollback
+func (m *mig) DropEmailSuppressionsTable() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE users DROP COLUMN IF EXISTS email_undeliverable_at;
+		DROP TABLE email_suppressions;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
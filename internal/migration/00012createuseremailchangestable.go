@@ -0,0 +1,55 @@
+package migration
+
+import "log"
+
+// CreateUserEmailChangesTable migration
+func (m *mig) CreateUserEmailChangesTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_email_changes
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		old_email VARCHAR(255) NOT NULL,
+		new_email VARCHAR(255) NOT NULL,
+		confirm_digest VARCHAR(64) NOT NULL,
+		cancel_digest VARCHAR(64) NOT NULL,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		cancel_until TIMESTAMP WITH TIME ZONE NOT NULL,
+		confirmed_at TIMESTAMP WITH TIME ZONE,
+		cancelled_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX user_email_changes_user_id_idx ON user_email_changes (user_id);
+		CREATE UNIQUE INDEX user_email_changes_confirm_digest_idx ON user_email_changes (confirm_digest);
+		CREATE UNIQUE INDEX user_email_changes_cancel_digest_idx ON user_email_changes (cancel_digest);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserEmailChangesTable rollback
+func (m *mig) DropUserEmailChangesTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_email_changes;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package migration
+
+import "log"
+
+// CreateUserPasswordHistoryTable migration
+func (m *mig) CreateUserPasswordHistoryTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_password_history
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		password_digest VARCHAR(128) NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX user_password_history_user_id_created_at_idx ON user_password_history (user_id, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserPasswordHistoryTable rollback
+func (m *mig) DropUserPasswordHistoryTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_password_history;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package migration
+
+import "log"
+
+// CreateUserMagicLinksTable migration
+func (m *mig) CreateUserMagicLinksTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_magic_links
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		token_digest VARCHAR(64) NOT NULL,
+		binding_digest VARCHAR(64) NOT NULL,
+		ip VARCHAR(64),
+		user_agent TEXT,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		used_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX user_magic_links_user_id_idx ON user_magic_links (user_id);
+		CREATE UNIQUE INDEX user_magic_links_token_digest_idx ON user_magic_links (token_digest);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserMagicLinksTable rollback
+func (m *mig) DropUserMagicLinksTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_magic_links;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
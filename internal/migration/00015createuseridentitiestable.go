@@ -0,0 +1,51 @@
+package migration
+
+import "log"
+
+// CreateUserIdentitiesTable migration
+func (m *mig) CreateUserIdentitiesTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_identities
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		provider VARCHAR(64) NOT NULL,
+		subject VARCHAR(255) NOT NULL,
+		email VARCHAR(255),
+		provisioned BOOLEAN NOT NULL DEFAULT FALSE,
+		last_used_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE UNIQUE INDEX user_identities_provider_subject_idx ON user_identities (provider, subject);
+		CREATE UNIQUE INDEX user_identities_user_id_provider_idx ON user_identities (user_id, provider);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserIdentitiesTable rollback
+func (m *mig) DropUserIdentitiesTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_identities;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
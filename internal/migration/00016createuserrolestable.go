@@ -0,0 +1,46 @@
+package migration
+
+import "log"
+
+// CreateUserRolesTable migration
+func (m *mig) CreateUserRolesTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE user_roles
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		name VARCHAR(64) NOT NULL,
+		source VARCHAR(64) NOT NULL DEFAULT 'local',
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE UNIQUE INDEX user_roles_user_id_name_idx ON user_roles (user_id, name);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropUserRolesTable rollback
+func (m *mig) DropUserRolesTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE user_roles;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
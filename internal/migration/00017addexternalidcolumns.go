@@ -0,0 +1,64 @@
+package migration
+
+import "log"
+
+// AddExternalIDColumns migration
+// Users are scoped to tenants, users and accounts can be
+// identified by the ID a provisioning client knows them by.
+func (m *mig) AddExternalIDColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE users
+		ADD COLUMN tenant_id VARCHAR(128),
+		ADD COLUMN external_id VARCHAR(255);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		ALTER TABLE accounts
+		ADD COLUMN external_id VARCHAR(255);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX users_tenant_id_idx ON users (tenant_id);
+		CREATE UNIQUE INDEX users_tenant_id_external_id_idx ON users (tenant_id, external_id) WHERE external_id IS NOT NULL AND is_deleted IS NOT TRUE;
+		CREATE UNIQUE INDEX accounts_tenant_id_external_id_idx ON accounts (tenant_id, external_id) WHERE external_id IS NOT NULL AND is_deleted IS NOT TRUE;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropExternalIDColumns rollback
+func (m *mig) DropExternalIDColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		DROP INDEX IF EXISTS accounts_tenant_id_external_id_idx;
+		DROP INDEX IF EXISTS users_tenant_id_external_id_idx;
+		DROP INDEX IF EXISTS users_tenant_id_idx;
+		ALTER TABLE accounts
+		DROP COLUMN IF EXISTS external_id;
+		ALTER TABLE users
+		DROP COLUMN IF EXISTS external_id,
+		DROP COLUMN IF EXISTS tenant_id;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
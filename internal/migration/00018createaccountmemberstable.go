@@ -0,0 +1,45 @@
+package migration
+
+import "log"
+
+// CreateAccountMembersTable migration
+func (m *mig) CreateAccountMembersTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE account_members
+	(
+		account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (account_id, user_id)
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX account_members_user_id_idx ON account_members (user_id);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropAccountMembersTable rollback
+func (m *mig) DropAccountMembersTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE account_members;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
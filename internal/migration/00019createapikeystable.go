@@ -0,0 +1,63 @@
+package migration
+
+import "log"
+
+// CreateAPIKeysTable migration
+func (m *mig) CreateAPIKeysTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE api_keys
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+		account_id UUID REFERENCES accounts(id) ON DELETE CASCADE,
+		created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		name VARCHAR(64) NOT NULL,
+		prefix VARCHAR(16) NOT NULL,
+		key_digest CHAR(64) UNIQUE NOT NULL,
+		scopes VARCHAR(255) NOT NULL,
+		allowed_ips TEXT,
+		expires_at TIMESTAMP WITH TIME ZONE,
+		last_used_at TIMESTAMP WITH TIME ZONE,
+		last_used_ip INET,
+		revoked_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		CHECK ((user_id IS NULL) <> (account_id IS NULL))
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX api_keys_user_id_idx ON api_keys (user_id, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX api_keys_account_id_idx ON api_keys (account_id, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropAPIKeysTable rollback
+func (m *mig) DropAPIKeysTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE api_keys;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package migration
+
+import "log"
+
+// CreateServiceAccounts migration
+// Users are either humans or service accounts, the latter belong to
+// an account and authenticate with client credentials.
+func (m *mig) CreateServiceAccounts() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE users
+		ADD COLUMN user_type VARCHAR(16) NOT NULL DEFAULT 'human',
+		ADD COLUMN account_id UUID REFERENCES accounts(id) ON DELETE CASCADE;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX users_account_id_idx ON users (account_id) WHERE account_id IS NOT NULL;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE TABLE client_credentials
+	(
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		kind VARCHAR(16) NOT NULL,
+		secret_digest CHAR(64) UNIQUE,
+		public_key TEXT,
+		key_id VARCHAR(64),
+		scopes VARCHAR(1024) NOT NULL DEFAULT '',
+		expires_at TIMESTAMP WITH TIME ZONE,
+		last_used_at TIMESTAMP WITH TIME ZONE,
+		last_used_ip INET,
+		revoked_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		CHECK ((secret_digest IS NULL) <> (public_key IS NULL))
+	);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX client_credentials_user_id_idx ON client_credentials (user_id, created_at DESC);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropServiceAccounts rollback
+func (m *mig) DropServiceAccounts() error {
+	tx := m.GetTx()
+
+	st := `
+		DROP TABLE client_credentials;
+		DROP INDEX IF EXISTS users_account_id_idx;
+		ALTER TABLE users
+		DROP COLUMN IF EXISTS account_id,
+		DROP COLUMN IF EXISTS user_type;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
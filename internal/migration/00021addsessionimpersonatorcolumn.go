@@ -0,0 +1,43 @@
+package migration
+
+import "log"
+
+// AddSessionImpersonatorColumn migration
+func (m *mig) AddSessionImpersonatorColumn() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE user_sessions
+		ADD COLUMN impersonator_id UUID REFERENCES users(id) ON DELETE CASCADE;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `CREATE INDEX user_sessions_impersonator_id_idx ON user_sessions (impersonator_id) WHERE impersonator_id IS NOT NULL;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropSessionImpersonatorColumn rollback
+func (m *mig) DropSessionImpersonatorColumn() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE user_sessions
+		DROP COLUMN IF EXISTS impersonator_id;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package migration
+
+import "log"
+
+// AddAccountSubscriptionColumns migration
+func (m *mig) AddAccountSubscriptionColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE accounts
+		ADD COLUMN subscription_status VARCHAR(16),
+		ADD COLUMN expiry_warned_at TIMESTAMP WITH TIME ZONE;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropAccountSubscriptionColumns rollback
+func (m *mig) DropAccountSubscriptionColumns() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE accounts
+		DROP COLUMN IF EXISTS subscription_status,
+		DROP COLUMN IF EXISTS expiry_warned_at;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package migration
+
+import "log"
+
+// CreateAccountTransfersTable migration
+func (m *mig) CreateAccountTransfersTable() error {
+	tx := m.GetTx()
+
+	st := `CREATE TABLE account_transfers
+	(
+		id UUID PRIMARY KEY,
+		account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+		from_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		to_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		initiated_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		confirm_digest VARCHAR(64) NOT NULL,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		confirmed_at TIMESTAMP WITH TIME ZONE,
+		approved_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		approved_at TIMESTAMP WITH TIME ZONE,
+		completed_at TIMESTAMP WITH TIME ZONE,
+		cancelled_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		CREATE INDEX account_transfers_account_id_idx ON account_transfers (account_id);
+		CREATE UNIQUE INDEX account_transfers_confirm_digest_idx ON account_transfers (confirm_digest);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropAccountTransfersTable rollback
+func (m *mig) DropAccountTransfersTable() error {
+	tx := m.GetTx()
+
+	st := `DROP TABLE account_transfers;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package migration
+
+import "log"
+
+// SetAuthorColumnsNullOnDelete migration
+func (m *mig) SetAuthorColumnsNullOnDelete() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE accounts
+		DROP CONSTRAINT IF EXISTS accounts_created_by_id_fkey,
+		DROP CONSTRAINT IF EXISTS accounts_updated_by_id_fkey,
+		ADD CONSTRAINT accounts_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE SET NULL,
+		ADD CONSTRAINT accounts_updated_by_id_fkey FOREIGN KEY (updated_by_id) REFERENCES users(id) ON DELETE SET NULL;`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	st = `
+		ALTER TABLE profiles
+		DROP CONSTRAINT IF EXISTS profiles_created_by_id_fkey,
+		DROP CONSTRAINT IF EXISTS profiles_updated_by_id_fkey,
+		ADD CONSTRAINT profiles_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE SET NULL,
+		ADD CONSTRAINT profiles_updated_by_id_fkey FOREIGN KEY (updated_by_id) REFERENCES users(id) ON DELETE SET NULL;`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResetAuthorColumnsOnDelete rollback
+func (m *mig) ResetAuthorColumnsOnDelete() error {
+	tx := m.GetTx()
+
+	st := `
+		ALTER TABLE accounts
+		DROP CONSTRAINT IF EXISTS accounts_created_by_id_fkey,
+		DROP CONSTRAINT IF EXISTS accounts_updated_by_id_fkey,
+		ADD CONSTRAINT accounts_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users(id),
+		ADD CONSTRAINT accounts_updated_by_id_fkey FOREIGN KEY (updated_by_id) REFERENCES users(id);`
+
+	_, err := tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	st = `
+		ALTER TABLE profiles
+		DROP CONSTRAINT IF EXISTS profiles_created_by_id_fkey,
+		DROP CONSTRAINT IF EXISTS profiles_updated_by_id_fkey,
+		ADD CONSTRAINT profiles_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users(id),
+		ADD CONSTRAINT profiles_updated_by_id_fkey FOREIGN KEY (updated_by_id) REFERENCES users(id);`
+
+	_, err = tx.Exec(st)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
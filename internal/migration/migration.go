@@ -100,5 +100,105 @@ func GetMigrator(cfg *config.Config) *migration.Migrator {
 	//mg.Config(mg.CreateProfilesTable, mg.DropProfilesTable)
 	//m.AddMigration(mg)
 
+	// CreateUsersSearchIndexes
+	mg = &mig{}
+	mg.Config(mg.CreateUsersSearchIndexes, mg.DropUsersSearchIndexes)
+	m.AddMigration(mg)
+
+	// AddSoftDeleteColumns
+	mg = &mig{}
+	mg.Config(mg.AddSoftDeleteColumns, mg.DropSoftDeleteColumns)
+	m.AddMigration(mg)
+
+	// CreateAuditEventsTable
+	mg = &mig{}
+	mg.Config(mg.CreateAuditEventsTable, mg.DropAuditEventsTable)
+	m.AddMigration(mg)
+
+	// CreateUserSessionsTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserSessionsTable, mg.DropUserSessionsTable)
+	m.AddMigration(mg)
+
+	// AddSessionRiskColumns
+	mg = &mig{}
+	mg.Config(mg.AddSessionRiskColumns, mg.DropSessionRiskColumns)
+	m.AddMigration(mg)
+
+	// CreateEmailOutboxTable
+	mg = &mig{}
+	mg.Config(mg.CreateEmailOutboxTable, mg.DropEmailOutboxTable)
+	m.AddMigration(mg)
+
+	// CreateEmailSuppressionsTable
+	mg = &mig{}
+	mg.Config(mg.CreateEmailSuppressionsTable, mg.DropEmailSuppressionsTable)
+	m.AddMigration(mg)
+
+	// CreateUserEmailChangesTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserEmailChangesTable, mg.DropUserEmailChangesTable)
+	m.AddMigration(mg)
+
+	// CreateUserPasswordHistoryTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserPasswordHistoryTable, mg.DropUserPasswordHistoryTable)
+	m.AddMigration(mg)
+
+	// CreateUserMagicLinksTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserMagicLinksTable, mg.DropUserMagicLinksTable)
+	m.AddMigration(mg)
+
+	// CreateUserIdentitiesTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserIdentitiesTable, mg.DropUserIdentitiesTable)
+	m.AddMigration(mg)
+
+	// CreateUserRolesTable
+	mg = &mig{}
+	mg.Config(mg.CreateUserRolesTable, mg.DropUserRolesTable)
+	m.AddMigration(mg)
+
+	// AddExternalIDColumns
+	mg = &mig{}
+	mg.Config(mg.AddExternalIDColumns, mg.DropExternalIDColumns)
+	m.AddMigration(mg)
+
+	// CreateAccountMembersTable
+	mg = &mig{}
+	mg.Config(mg.CreateAccountMembersTable, mg.DropAccountMembersTable)
+	m.AddMigration(mg)
+
+	// CreateAPIKeysTable
+	mg = &mig{}
+	mg.Config(mg.CreateAPIKeysTable, mg.DropAPIKeysTable)
+	m.AddMigration(mg)
+
+	// CreateServiceAccounts
+	mg = &mig{}
+	mg.Config(mg.CreateServiceAccounts, mg.DropServiceAccounts)
+	m.AddMigration(mg)
+
+	// AddSessionImpersonatorColumn
+	mg = &mig{}
+	mg.Config(mg.AddSessionImpersonatorColumn, mg.DropSessionImpersonatorColumn)
+	m.AddMigration(mg)
+
+	// AddAccountSubscriptionColumns
+	mg = &mig{}
+	mg.Config(mg.AddAccountSubscriptionColumns, mg.DropAccountSubscriptionColumns)
+	m.AddMigration(mg)
+
+	// CreateAccountTransfersTable
+	mg = &mig{}
+	mg.Config(mg.CreateAccountTransfersTable, mg.DropAccountTransfersTable)
+	m.AddMigration(mg)
+
+	// SetAuthorColumnsNullOnDelete
+	mg = &mig{}
+	mg.Config(mg.SetAuthorColumnsNullOnDelete, mg.ResetAuthorColumnsOnDelete)
+	m.AddMigration(mg)
+
 	return m
 }
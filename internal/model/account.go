@@ -2,11 +2,38 @@ package model
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/lib/pq"
 	m "gitlab.com/mikrowezel/backend/model"
 )
 
+const (
+	// AccountTypeUser accounts belong to a single user.
+	AccountTypeUser = "user"
+	// AccountTypeOrganization accounts are shared by the members of a company or team.
+	AccountTypeOrganization = "organization"
+	// AccountTypeGroup accounts gather users as members.
+	AccountTypeGroup = "group"
+)
+
+// Subscription states, see Account.SubscriptionState.
+const (
+	// SubscriptionScheduled accounts have not started yet.
+	SubscriptionScheduled = "scheduled"
+	// SubscriptionActive accounts are within their subscription window.
+	SubscriptionActive = "active"
+	// SubscriptionGrace accounts have ended but keep working for a while.
+	SubscriptionGrace = "grace"
+	// SubscriptionExpired accounts have ended and their grace period is over.
+	SubscriptionExpired = "expired"
+)
+
+var (
+	// AccountTypes lists the types an account can have.
+	AccountTypes = []string{AccountTypeUser, AccountTypeOrganization, AccountTypeGroup}
+)
+
 type (
 	// Account model
 	Account struct {
@@ -23,6 +50,12 @@ type (
 		EndsAt      pq.NullTime    `db:"ends_at" json:"endsAt"`
 		IsActive    sql.NullBool   `db:"is_active" json:"isActive"`
 		IsDeleted   sql.NullBool   `db:"is_deleted" json:"isDeleted"`
+		DeletedByID sql.NullString `db:"deleted_by_id" json:"deletedByID"`
+		DeletedAt   pq.NullTime    `db:"deleted_at" json:"deletedAt"`
+		ExternalID  sql.NullString `db:"external_id" json:"externalID"`
+		// SubscriptionStatus is the state last enforced, see SubscriptionState.
+		SubscriptionStatus sql.NullString `db:"subscription_status" json:"subscriptionStatus"`
+		ExpiryWarnedAt     pq.NullTime    `db:"expiry_warned_at" json:"expiryWarnedAt"`
 		m.Audit
 	}
 )
@@ -41,6 +74,44 @@ func (account *Account) SetUpdateValues() error {
 	return nil
 }
 
+// SubscriptionState returns the state of the account subscription at t.
+// Accounts without dates are always active,
+// once ended they keep working during the grace period.
+func (account *Account) SubscriptionState(t time.Time, grace time.Duration) string {
+	if account.StartsAt.Valid && t.Before(account.StartsAt.Time) {
+		return SubscriptionScheduled
+	}
+
+	if !account.EndsAt.Valid || t.Before(account.EndsAt.Time) {
+		return SubscriptionActive
+	}
+
+	if t.Before(account.EndsAt.Time.Add(grace)) {
+		return SubscriptionGrace
+	}
+
+	return SubscriptionExpired
+}
+
+// InSubscription returns true if the account can be used at t.
+func (account *Account) InSubscription(t time.Time, grace time.Duration) bool {
+	switch account.SubscriptionState(t, grace) {
+	case SubscriptionActive, SubscriptionGrace:
+		return true
+	}
+	return false
+}
+
+// IsAccountType returns true if t is a known account type.
+func IsAccountType(t string) bool {
+	for _, at := range AccountTypes {
+		if at == t {
+			return true
+		}
+	}
+	return false
+}
+
 // Match condition for model.
 func (account *Account) Match(tc *Account) bool {
 	r := account.Identification.Match(tc.Identification) &&
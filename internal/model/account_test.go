@@ -0,0 +1,68 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func TestIsAccountType(t *testing.T) {
+	tests := []struct {
+		accountType string
+		want        bool
+	}{
+		{AccountTypeUser, true},
+		{AccountTypeOrganization, true},
+		{AccountTypeGroup, true},
+		{"Organization", false},
+		{"", false},
+		{"none", false},
+	}
+
+	for _, tc := range tests {
+		if got := IsAccountType(tc.accountType); got != tc.want {
+			t.Errorf("'%s': expected %t, got %t", tc.accountType, tc.want, got)
+		}
+	}
+}
+
+func TestSubscriptionState(t *testing.T) {
+	now := time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC)
+	grace := 7 * 24 * time.Hour
+	day := 24 * time.Hour
+
+	at := func(d time.Duration) pq.NullTime {
+		return pq.NullTime{Time: now.Add(d), Valid: true}
+	}
+
+	tests := []struct {
+		name     string
+		startsAt pq.NullTime
+		endsAt   pq.NullTime
+		want     string
+		usable   bool
+	}{
+		{"no dates", pq.NullTime{}, pq.NullTime{}, SubscriptionActive, true},
+		{"not started", at(day), pq.NullTime{}, SubscriptionScheduled, false},
+		{"started", at(-day), pq.NullTime{}, SubscriptionActive, true},
+		{"starts now", at(0), at(day), SubscriptionActive, true},
+		{"not ended", at(-day), at(day), SubscriptionActive, true},
+		{"ends now", pq.NullTime{}, at(0), SubscriptionGrace, true},
+		{"in grace", at(-30 * day), at(-day), SubscriptionGrace, true},
+		{"grace over", at(-30 * day), at(-grace), SubscriptionExpired, false},
+		{"long expired", pq.NullTime{}, at(-30 * day), SubscriptionExpired, false},
+	}
+
+	for _, tc := range tests {
+		a := Account{StartsAt: tc.startsAt, EndsAt: tc.endsAt}
+
+		if got := a.SubscriptionState(now, grace); got != tc.want {
+			t.Errorf("%s: expected '%s', got '%s'", tc.name, tc.want, got)
+		}
+
+		if got := a.InSubscription(now, grace); got != tc.usable {
+			t.Errorf("%s: expected in subscription %t, got %t", tc.name, tc.usable, got)
+		}
+	}
+}
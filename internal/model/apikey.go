@@ -0,0 +1,181 @@
+package model
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+const (
+	// APIKeyPrefix starts every API key so that leaked ones are easy to spot.
+	APIKeyPrefix = "grn_"
+)
+
+// API key scopes, write access implies read access.
+const (
+	ScopeUsersRead     = "users:read"
+	ScopeUsersWrite    = "users:write"
+	ScopeAccountsRead  = "accounts:read"
+	ScopeAccountsWrite = "accounts:write"
+	ScopeAuditRead     = "audit:read"
+	ScopeOutboxRead    = "outbox:read"
+	ScopeOutboxWrite   = "outbox:write"
+)
+
+var (
+	// APIKeyScopes lists the scopes an API key can be granted.
+	APIKeyScopes = []string{
+		ScopeUsersRead,
+		ScopeUsersWrite,
+		ScopeAccountsRead,
+		ScopeAccountsWrite,
+		ScopeAuditRead,
+		ScopeOutboxRead,
+		ScopeOutboxWrite,
+	}
+)
+
+type (
+	// APIKey model
+	// Non-interactive credential owned either by a user or by an account.
+	// Only its digest is stored, the key is shown once when created.
+	APIKey struct {
+		ID          uuid.UUID      `db:"id" json:"id"`
+		UserID      sql.NullString `db:"user_id" json:"userID"`
+		AccountID   sql.NullString `db:"account_id" json:"accountID"`
+		CreatedByID sql.NullString `db:"created_by_id" json:"createdByID"`
+		Name        string         `db:"name" json:"name"`
+		// Prefix is the non secret start of the key, it identifies it in lists.
+		Prefix    string `db:"prefix" json:"prefix"`
+		KeyDigest string `db:"key_digest" json:"-"`
+		// Scopes are space separated.
+		Scopes string `db:"scopes" json:"scopes"`
+		// AllowedIPs are comma separated addresses or CIDR ranges, any if empty.
+		AllowedIPs sql.NullString `db:"allowed_ips" json:"allowedIPs"`
+		ExpiresAt  pq.NullTime    `db:"expires_at" json:"expiresAt"`
+		LastUsedAt pq.NullTime    `db:"last_used_at" json:"lastUsedAt"`
+		LastUsedIP sql.NullString `db:"last_used_ip" json:"lastUsedIP"`
+		RevokedAt  pq.NullTime    `db:"revoked_at" json:"revokedAt"`
+		CreatedAt  pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+func (k *APIKey) SetCreateValues(ttl time.Duration) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	k.CreatedAt = pg.ToNullTime(now)
+	k.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	return nil
+}
+
+// GenKey generates a new random key.
+// Only its prefix and digest are stored, the key itself is handed to the owner.
+func (k *APIKey) GenKey() (key string, err error) {
+	b := make([]byte, 4)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	k.Prefix = APIKeyPrefix + hex.EncodeToString(b)
+	key = k.Prefix + "_" + secret
+	k.KeyDigest = TokenDigest(key)
+	return key, nil
+}
+
+// IsActive returns true if key was not revoked nor expired.
+func (k *APIKey) IsActive() bool {
+	return !k.RevokedAt.Valid &&
+		(!k.ExpiresAt.Valid || k.ExpiresAt.Time.After(time.Now()))
+}
+
+// ScopeList returns key scopes.
+func (k *APIKey) ScopeList() []string {
+	return strings.Fields(k.Scopes)
+}
+
+// HasScope returns true if key grants scope.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.ScopeList() {
+		if s == scope || s == writeScope(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIP returns true if requests from ip can use the key.
+func (k *APIKey) AllowsIP(ip string) bool {
+	entries := SplitIPList(k.AllowedIPs.String)
+	if len(entries) == 0 {
+		return true
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, e := range entries {
+		if _, n, err := net.ParseCIDR(e); err == nil {
+			if n.Contains(addr) {
+				return true
+			}
+			continue
+		}
+
+		if a := net.ParseIP(e); a != nil && a.Equal(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAPIKeyScope returns true if scope can be granted to API keys.
+func IsAPIKeyScope(scope string) bool {
+	for _, s := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIPOrCIDR returns true if entry is an IP address or a CIDR range.
+func IsIPOrCIDR(entry string) bool {
+	if _, _, err := net.ParseCIDR(entry); err == nil {
+		return true
+	}
+	return net.ParseIP(entry) != nil
+}
+
+// SplitIPList splits a comma or space separated address list.
+func SplitIPList(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+}
+
+// writeScope returns the write scope implying a read one.
+func writeScope(scope string) string {
+	if !strings.HasSuffix(scope, ":read") {
+		return scope
+	}
+	return strings.TrimSuffix(scope, ":read") + ":write"
+}
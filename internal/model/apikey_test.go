@@ -0,0 +1,93 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+func TestAPIKeyGenKey(t *testing.T) {
+	var k APIKey
+
+	key, err := k.GenKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(key, k.Prefix+"_") || !strings.HasPrefix(k.Prefix, APIKeyPrefix) {
+		t.Errorf("key '%s' does not start with prefix '%s'", key, k.Prefix)
+	}
+
+	if k.KeyDigest != TokenDigest(key) {
+		t.Errorf("digest does not match key")
+	}
+}
+
+func TestAPIKeyHasScope(t *testing.T) {
+	k := APIKey{Scopes: ScopeUsersWrite + " " + ScopeAuditRead}
+
+	tests := []struct {
+		scope string
+		want  bool
+	}{
+		{ScopeUsersRead, true},
+		{ScopeUsersWrite, true},
+		{ScopeAuditRead, true},
+		{"audit:write", false},
+		{ScopeAccountsRead, false},
+		{"none", false},
+	}
+
+	for _, tc := range tests {
+		if got := k.HasScope(tc.scope); got != tc.want {
+			t.Errorf("%s: expected %t, got %t", tc.scope, tc.want, got)
+		}
+	}
+}
+
+func TestAPIKeyAllowsIP(t *testing.T) {
+	tests := []struct {
+		allowed string
+		ip      string
+		want    bool
+	}{
+		{"", "203.0.113.7", true},
+		{"203.0.113.7", "203.0.113.7", true},
+		{"203.0.113.7", "203.0.113.8", false},
+		{"10.0.0.0/8, 192.168.1.1", "10.20.30.40", true},
+		{"10.0.0.0/8, 192.168.1.1", "192.168.1.1", true},
+		{"10.0.0.0/8, 192.168.1.1", "192.168.1.2", false},
+		{"2001:db8::/32", "2001:db8::1", true},
+		{"10.0.0.0/8", "", false},
+	}
+
+	for _, tc := range tests {
+		k := APIKey{AllowedIPs: sql.NullString{String: tc.allowed, Valid: tc.allowed != ""}}
+		if got := k.AllowsIP(tc.ip); got != tc.want {
+			t.Errorf("'%s' from '%s': expected %t, got %t", tc.allowed, tc.ip, tc.want, got)
+		}
+	}
+}
+
+func TestAPIKeyIsActive(t *testing.T) {
+	var k APIKey
+	k.SetCreateValues(time.Hour)
+
+	if !k.IsActive() {
+		t.Errorf("new key should be active")
+	}
+
+	k.ExpiresAt = pg.ToNullTime(time.Now().Add(-time.Minute))
+	if k.IsActive() {
+		t.Errorf("expired key should not be active")
+	}
+
+	k.SetCreateValues(time.Hour)
+	k.RevokedAt = pg.ToNullTime(time.Now())
+	if k.IsActive() {
+		t.Errorf("revoked key should not be active")
+	}
+}
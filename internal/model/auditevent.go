@@ -0,0 +1,48 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// AuditEvent model
+	AuditEvent struct {
+		ID         uuid.UUID      `db:"id" json:"id"`
+		TenantID   sql.NullString `db:"tenant_id" json:"tenantID"`
+		ActorID    sql.NullString `db:"actor_id" json:"actorID"`
+		TargetType sql.NullString `db:"target_type" json:"targetType"`
+		TargetID   sql.NullString `db:"target_id" json:"targetID"`
+		Action     string         `db:"action" json:"action"`
+		IP         sql.NullString `db:"ip" json:"ip"`
+		UserAgent  sql.NullString `db:"user_agent" json:"userAgent"`
+		RequestID  sql.NullString `db:"request_id" json:"requestID"`
+		Metadata   []byte         `db:"metadata" json:"metadata"`
+		CreatedAt  pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+
+	// AuditFilter narrows audit event queries.
+	// Empty values are not used as filter.
+	AuditFilter struct {
+		ActorID    string
+		TargetType string
+		TargetID   string
+		Action     string
+		From       time.Time
+		To         time.Time
+		Limit      int
+	}
+)
+
+// SetCreateValues sets ID and creation time.
+func (event *AuditEvent) SetCreateValues() error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.NewV4()
+	}
+	event.CreatedAt = pg.ToNullTime(time.Now())
+	return nil
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+const (
+	// ClientSecretPrefix starts every client secret so that leaked ones are easy to spot.
+	ClientSecretPrefix = "grn_cs_"
+)
+
+// Client credential kinds, named after the token endpoint
+// authentication method they are used with.
+const (
+	CredentialKindSecret    = "client_secret"
+	CredentialKindPublicKey = "private_key_jwt"
+)
+
+type (
+	// ClientCredential model
+	// Secret or public key a service account authenticates with at the
+	// token endpoint. Only secret digests are stored, the secret is shown
+	// once when created. Public keys are the ones the client signs its
+	// assertions with, their private part never reaches the service.
+	ClientCredential struct {
+		ID           uuid.UUID      `db:"id" json:"id"`
+		UserID       string         `db:"user_id" json:"userID"`
+		CreatedByID  sql.NullString `db:"created_by_id" json:"createdByID"`
+		Kind         string         `db:"kind" json:"kind"`
+		SecretDigest sql.NullString `db:"secret_digest" json:"-"`
+		// PublicKey is PEM encoded.
+		PublicKey sql.NullString `db:"public_key" json:"publicKey"`
+		// KeyID is the public key JWK thumbprint.
+		KeyID sql.NullString `db:"key_id" json:"keyID"`
+		// Scopes are space separated, tokens can be requested for any of them.
+		Scopes     string         `db:"scopes" json:"scopes"`
+		ExpiresAt  pq.NullTime    `db:"expires_at" json:"expiresAt"`
+		LastUsedAt pq.NullTime    `db:"last_used_at" json:"lastUsedAt"`
+		LastUsedIP sql.NullString `db:"last_used_ip" json:"lastUsedIP"`
+		RevokedAt  pq.NullTime    `db:"revoked_at" json:"revokedAt"`
+		CreatedAt  pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+func (c *ClientCredential) SetCreateValues(ttl time.Duration) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	c.CreatedAt = pg.ToNullTime(now)
+	c.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	return nil
+}
+
+// GenSecret generates a new random client secret.
+// Only its digest is stored, the secret itself is handed to the owner.
+func (c *ClientCredential) GenSecret() (secret string, err error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	secret = ClientSecretPrefix + token
+	c.Kind = CredentialKindSecret
+	c.SecretDigest = sql.NullString{String: TokenDigest(secret), Valid: true}
+	return secret, nil
+}
+
+// SecretMatches returns true if secret is the credential one.
+func (c *ClientCredential) SecretMatches(secret string) bool {
+	if c.Kind != CredentialKindSecret || !c.SecretDigest.Valid {
+		return false
+	}
+
+	d := TokenDigest(secret)
+	return subtle.ConstantTimeCompare([]byte(d), []byte(c.SecretDigest.String)) == 1
+}
+
+// IsActive returns true if credential was not revoked nor expired.
+func (c *ClientCredential) IsActive() bool {
+	return !c.RevokedAt.Valid &&
+		(!c.ExpiresAt.Valid || c.ExpiresAt.Time.After(time.Now()))
+}
+
+// ScopeList returns credential scopes.
+func (c *ClientCredential) ScopeList() []string {
+	return strings.Fields(c.Scopes)
+}
+
+// GrantScopes returns the scopes a token requested for scopes gets,
+// all the credential ones if none is requested.
+// It returns false if any of them is not granted.
+func (c *ClientCredential) GrantScopes(scopes []string) ([]string, bool) {
+	granted := c.ScopeList()
+	if len(scopes) == 0 {
+		return granted, true
+	}
+
+	for _, s := range scopes {
+		found := false
+		for _, g := range granted {
+			if s == g {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	return scopes, true
+}
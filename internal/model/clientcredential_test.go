@@ -0,0 +1,80 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+func TestClientCredentialGenSecret(t *testing.T) {
+	var c ClientCredential
+
+	secret, err := c.GenSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(secret, ClientSecretPrefix) || c.Kind != CredentialKindSecret {
+		t.Errorf("unexpected secret '%s' of kind '%s'", secret, c.Kind)
+	}
+
+	if c.SecretDigest.String == secret || c.SecretDigest.String != TokenDigest(secret) {
+		t.Error("secret digest not stored")
+	}
+
+	if !c.SecretMatches(secret) {
+		t.Error("secret does not match")
+	}
+
+	if c.SecretMatches(secret + "x") {
+		t.Error("wrong secret matches")
+	}
+
+	pk := ClientCredential{Kind: CredentialKindPublicKey, SecretDigest: c.SecretDigest}
+	if pk.SecretMatches(secret) {
+		t.Error("public key credential accepts a secret")
+	}
+}
+
+func TestClientCredentialGrantScopes(t *testing.T) {
+	c := ClientCredential{Scopes: "invoices:read invoices:write"}
+
+	tests := []struct {
+		requested []string
+		granted   string
+		ok        bool
+	}{
+		{nil, "invoices:read invoices:write", true},
+		{[]string{"invoices:read"}, "invoices:read", true},
+		{[]string{"invoices:read", "reports:read"}, "", false},
+	}
+
+	for _, tt := range tests {
+		granted, ok := c.GrantScopes(tt.requested)
+		if ok != tt.ok || strings.Join(granted, " ") != tt.granted {
+			t.Errorf("%v: expected '%s' (%t), got '%s' (%t)", tt.requested, tt.granted, tt.ok, strings.Join(granted, " "), ok)
+		}
+	}
+}
+
+func TestClientCredentialIsActive(t *testing.T) {
+	var c ClientCredential
+	c.SetCreateValues(time.Hour)
+
+	if !c.IsActive() {
+		t.Error("new credential not active")
+	}
+
+	c.ExpiresAt = pg.ToNullTime(time.Now().Add(-time.Minute))
+	if c.IsActive() {
+		t.Error("expired credential active")
+	}
+
+	c.SetCreateValues(time.Hour)
+	c.RevokedAt = pg.ToNullTime(time.Now())
+	if c.IsActive() {
+		t.Error("revoked credential active")
+	}
+}
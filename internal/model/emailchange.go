@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// EmailChange model
+	// A request to replace the email address of a user.
+	// New address is only set after it has been confirmed
+	// and the old one can still revert the change for a while.
+	EmailChange struct {
+		ID            uuid.UUID   `db:"id" json:"id"`
+		UserID        string      `db:"user_id" json:"userID"`
+		OldEmail      string      `db:"old_email" json:"oldEmail"`
+		NewEmail      string      `db:"new_email" json:"newEmail"`
+		ConfirmDigest string      `db:"confirm_digest" json:"-"`
+		CancelDigest  string      `db:"cancel_digest" json:"-"`
+		ExpiresAt     pq.NullTime `db:"expires_at" json:"expiresAt"`
+		CancelUntil   pq.NullTime `db:"cancel_until" json:"cancelUntil"`
+		ConfirmedAt   pq.NullTime `db:"confirmed_at" json:"confirmedAt"`
+		CancelledAt   pq.NullTime `db:"cancelled_at" json:"cancelledAt"`
+		CreatedAt     pq.NullTime `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+// ttl is how long the new address has to confirm the change,
+// window how long the old one can cancel it.
+func (ec *EmailChange) SetCreateValues(ttl, window time.Duration) error {
+	if ec.ID == uuid.Nil {
+		ec.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	ec.CreatedAt = pg.ToNullTime(now)
+	ec.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	ec.CancelUntil = pg.ToNullTime(now.Add(window))
+	return nil
+}
+
+// GenTokens generates the tokens sent to the new address, to confirm the change,
+// and to the old one, to cancel it.
+// Only their digests are stored.
+func (ec *EmailChange) GenTokens() (confirm, cancel string, err error) {
+	confirm, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	cancel, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	ec.ConfirmDigest = TokenDigest(confirm)
+	ec.CancelDigest = TokenDigest(cancel)
+	return confirm, cancel, nil
+}
+
+// IsConfirmed returns true if new address has been confirmed.
+func (ec *EmailChange) IsConfirmed() bool {
+	return ec.ConfirmedAt.Valid
+}
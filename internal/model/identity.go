@@ -0,0 +1,37 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// Identity model
+	// Links a user to the subject ID an external identity provider knows it by.
+	// Provisioned is set when the user was created on its first sign-in.
+	Identity struct {
+		ID          uuid.UUID      `db:"id" json:"id"`
+		UserID      string         `db:"user_id" json:"userID"`
+		Provider    string         `db:"provider" json:"provider"`
+		Subject     string         `db:"subject" json:"subject"`
+		Email       sql.NullString `db:"email" json:"email"`
+		Provisioned bool           `db:"provisioned" json:"provisioned"`
+		LastUsedAt  pq.NullTime    `db:"last_used_at" json:"lastUsedAt"`
+		CreatedAt   pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+func (i *Identity) SetCreateValues() error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	i.CreatedAt = pg.ToNullTime(now)
+	i.LastUsedAt = pg.ToNullTime(now)
+	return nil
+}
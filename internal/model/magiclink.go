@@ -0,0 +1,68 @@
+package model
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// MagicLink model
+	// A single use link, emailed to the user, that signs in without password.
+	// It is bound to the browser that requested it through a second token
+	// kept by the client, only digests of both are stored.
+	MagicLink struct {
+		ID            uuid.UUID      `db:"id" json:"id"`
+		UserID        string         `db:"user_id" json:"userID"`
+		TokenDigest   string         `db:"token_digest" json:"-"`
+		BindingDigest string         `db:"binding_digest" json:"-"`
+		IP            sql.NullString `db:"ip" json:"ip"`
+		UserAgent     sql.NullString `db:"user_agent" json:"userAgent"`
+		ExpiresAt     pq.NullTime    `db:"expires_at" json:"expiresAt"`
+		UsedAt        pq.NullTime    `db:"used_at" json:"usedAt"`
+		CreatedAt     pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+func (ml *MagicLink) SetCreateValues(ttl time.Duration) error {
+	if ml.ID == uuid.Nil {
+		ml.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	ml.CreatedAt = pg.ToNullTime(now)
+	ml.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	return nil
+}
+
+// GenTokens generates the token sent by email
+// and the binding kept by the requesting client.
+func (ml *MagicLink) GenTokens() (token, binding string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	binding, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	ml.TokenDigest = TokenDigest(token)
+	ml.BindingDigest = TokenDigest(binding)
+	return token, binding, nil
+}
+
+// IsBoundTo returns true if binding is the one handed to the requesting client.
+func (ml *MagicLink) IsBoundTo(binding string) bool {
+	if binding == "" {
+		return false
+	}
+
+	d := TokenDigest(binding)
+	return subtle.ConstantTimeCompare([]byte(d), []byte(ml.BindingDigest)) == 1
+}
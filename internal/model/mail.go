@@ -14,7 +14,10 @@ type Email struct {
 	CC      string
 	BCC     string
 	Subject string
-	Body    string
+	// Body in HTML.
+	Body string
+	// Text is the plain text alternative of Body, optional.
+	Text    string
 	Charset string
 }
 
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// Member model
+	// A user belonging to an account.
+	Member struct {
+		AccountID string      `db:"account_id" json:"accountID"`
+		UserID    string      `db:"user_id" json:"userID"`
+		CreatedAt pq.NullTime `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets timestamps.
+func (m *Member) SetCreateValues() error {
+	m.CreatedAt = pg.ToNullTime(time.Now())
+	return nil
+}
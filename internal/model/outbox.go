@@ -0,0 +1,104 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"gitlab.com/mikrowezel/backend/db"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// OutboxEmail model
+	// An email waiting to be delivered, or already delivered,
+	// by the outbox dispatcher.
+	OutboxEmail struct {
+		ID            uuid.UUID      `db:"id" json:"id"`
+		UserID        sql.NullString `db:"user_id" json:"userID"`
+		Kind          sql.NullString `db:"kind" json:"kind"`
+		SenderName    sql.NullString `db:"sender_name" json:"senderName"`
+		SenderEmail   string         `db:"sender_email" json:"senderEmail"`
+		Recipient     string         `db:"recipient" json:"recipient"`
+		CC            sql.NullString `db:"cc" json:"cc"`
+		BCC           sql.NullString `db:"bcc" json:"bcc"`
+		Subject       string         `db:"subject" json:"subject"`
+		HTMLBody      sql.NullString `db:"html_body" json:"htmlBody"`
+		TextBody      sql.NullString `db:"text_body" json:"textBody"`
+		Charset       sql.NullString `db:"charset" json:"charset"`
+		Status        string         `db:"status" json:"status"`
+		Attempts      int            `db:"attempts" json:"attempts"`
+		NextAttemptAt pq.NullTime    `db:"next_attempt_at" json:"nextAttemptAt"`
+		LastError     sql.NullString `db:"last_error" json:"lastError"`
+		SentAt        pq.NullTime    `db:"sent_at" json:"sentAt"`
+		CreatedAt     pq.NullTime    `db:"created_at" json:"createdAt"`
+		UpdatedAt     pq.NullTime    `db:"updated_at" json:"updatedAt"`
+	}
+
+	// OutboxFilter narrows outbox queries.
+	// Empty values are not used as filter.
+	OutboxFilter struct {
+		Status    string
+		Recipient string
+		Limit     int
+	}
+)
+
+const (
+	// Outbox statuses
+	OutboxPending = "pending"
+	OutboxSending = "sending"
+	OutboxSent    = "sent"
+	OutboxDead    = "dead"
+)
+
+// MakeOutboxEmail wraps an email to be stored in the outbox.
+// kind is the email template name, userID the user it is sent to, if any.
+func MakeOutboxEmail(em Email, kind, userID string) OutboxEmail {
+	return OutboxEmail{
+		ID:          uuid.NewV4(),
+		UserID:      db.ToNullString(userID),
+		Kind:        db.ToNullString(kind),
+		SenderName:  db.ToNullString(em.Name),
+		SenderEmail: em.From,
+		Recipient:   em.To,
+		CC:          db.ToNullString(em.CC),
+		BCC:         db.ToNullString(em.BCC),
+		Subject:     em.Subject,
+		HTMLBody:    db.ToNullString(em.Body),
+		TextBody:    db.ToNullString(em.Text),
+		Charset:     db.ToNullString(em.Charset),
+		Status:      OutboxPending,
+	}
+}
+
+// SetCreateValues sets ID and timestamps.
+// New emails are due right away.
+func (oe *OutboxEmail) SetCreateValues() error {
+	if oe.ID == uuid.Nil {
+		oe.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	oe.Status = OutboxPending
+	oe.NextAttemptAt = pg.ToNullTime(now)
+	oe.CreatedAt = pg.ToNullTime(now)
+	oe.UpdatedAt = pg.ToNullTime(now)
+	return nil
+}
+
+// ToEmail returns the email to be sent.
+func (oe *OutboxEmail) ToEmail() Email {
+	em := MakeEmail(oe.SenderName.String, oe.SenderEmail, oe.Recipient, oe.CC.String, oe.BCC.String, oe.Subject, oe.HTMLBody.String)
+	em.ID = oe.ID
+	em.Text = oe.TextBody.String
+	if oe.Charset.String != "" {
+		em.Charset = oe.Charset.String
+	}
+	return em
+}
+
+// IsDead returns true if no more delivery attempts will be made.
+func (oe *OutboxEmail) IsDead() bool {
+	return oe.Status == OutboxDead
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+const (
+	// RoleSourceLocal marks roles granted from Granica itself,
+	// other sources name the directory that grants them.
+	RoleSourceLocal = "local"
+	// RoleAdmin is held by users allowed to act on behalf of others.
+	RoleAdmin = "admin"
+)
+
+type (
+	// Role model
+	// A named permission set granted to a user.
+	Role struct {
+		ID        uuid.UUID   `db:"id" json:"id"`
+		UserID    string      `db:"user_id" json:"userID"`
+		Name      string      `db:"name" json:"name"`
+		Source    string      `db:"source" json:"source"`
+		CreatedAt pq.NullTime `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID, source and timestamps.
+func (r *Role) SetCreateValues() error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.NewV4()
+	}
+	if r.Source == "" {
+		r.Source = RoleSourceLocal
+	}
+	r.CreatedAt = pg.ToNullTime(time.Now())
+	return nil
+}
+
+// RoleNames returns the names of roles.
+func RoleNames(roles []Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
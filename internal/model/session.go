@@ -0,0 +1,113 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"gitlab.com/mikrowezel/backend/db"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// Session model
+	// A session is created on each successful sign-in,
+	// so sessions are also the user login history.
+	Session struct {
+		ID          uuid.UUID      `db:"id" json:"id"`
+		UserID      sql.NullString `db:"user_id" json:"userID"`
+		TokenDigest string         `db:"token_digest" json:"-"`
+		IP          sql.NullString `db:"ip" json:"ip"`
+		UserAgent   sql.NullString `db:"user_agent" json:"userAgent"`
+		Geolocation db.NullPoint   `db:"geolocation" json:"geolocation"`
+		Country     sql.NullString `db:"country" json:"country"`
+		RiskScore   int            `db:"risk_score" json:"riskScore"`
+		RiskReasons sql.NullString `db:"risk_reasons" json:"riskReasons"`
+		// VerificationDigest is set while a risky sign-in
+		// waits to be verified by email.
+		VerificationDigest sql.NullString `db:"verification_digest" json:"-"`
+		VerifiedAt         pq.NullTime    `db:"verified_at" json:"verifiedAt"`
+		LastSeenAt         pq.NullTime    `db:"last_seen_at" json:"lastSeenAt"`
+		ExpiresAt          pq.NullTime    `db:"expires_at" json:"expiresAt"`
+		RevokedAt          pq.NullTime    `db:"revoked_at" json:"revokedAt"`
+		CreatedAt          pq.NullTime    `db:"created_at" json:"createdAt"`
+		// ImpersonatorID is the admin acting as the user
+		// when the session was opened through impersonation.
+		ImpersonatorID sql.NullString `db:"impersonator_id" json:"impersonatorID"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+func (session *Session) SetCreateValues(ttl time.Duration) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	session.CreatedAt = pg.ToNullTime(now)
+	session.LastSeenAt = pg.ToNullTime(now)
+	session.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	return nil
+}
+
+// GenToken generates a new random session token.
+// Only its digest is stored, the token itself is handed to the client.
+func (session *Session) GenToken() (token string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	session.TokenDigest = TokenDigest(token)
+	return token, nil
+}
+
+// GenVerificationToken generates a token to verify a risky sign-in.
+// Session is not active until verified.
+func (session *Session) GenVerificationToken() (token string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	session.VerificationDigest = sql.NullString{String: TokenDigest(token), Valid: true}
+	return token, nil
+}
+
+// IsPending returns true if session waits for sign-in verification.
+func (session *Session) IsPending() bool {
+	return session.VerificationDigest.Valid
+}
+
+// IsImpersonation returns true if session was opened by an admin
+// to act as the user.
+func (session *Session) IsImpersonation() bool {
+	return session.ImpersonatorID.Valid
+}
+
+// IsActive returns true if session was not revoked nor expired
+// and does not wait for verification.
+func (session *Session) IsActive() bool {
+	return !session.RevokedAt.Valid && !session.IsPending() &&
+		(!session.ExpiresAt.Valid || session.ExpiresAt.Time.After(time.Now()))
+}
+
+// TokenDigest returns the hex encoded SHA-256 digest of a token.
+func TokenDigest(token string) string {
+	d := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(d[:])
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+type (
+	// Totals of users and accounts shown in the admin dashboard.
+	// Service accounts are not counted as users.
+	Totals struct {
+		Users     int64 `db:"users" json:"users"`
+		Confirmed int64 `db:"confirmed" json:"confirmed"`
+		Inactive  int64 `db:"inactive" json:"inactive"`
+		Deleted   int64 `db:"deleted" json:"deleted"`
+		Accounts  int64 `db:"accounts" json:"accounts"`
+	}
+
+	// DailyCount of an audited action on a day.
+	DailyCount struct {
+		Day    time.Time `db:"day" json:"day"`
+		Action string    `db:"action" json:"action"`
+		Count  int64     `db:"count" json:"count"`
+	}
+
+	// DailyStat groups the dashboard counts of a day.
+	DailyStat struct {
+		Day           time.Time `json:"day"`
+		SignUps       int64     `json:"signUps"`
+		Confirmations int64     `json:"confirmations"`
+		FailedSignIns int64     `json:"failedSignIns"`
+	}
+)
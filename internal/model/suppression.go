@@ -0,0 +1,50 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// Suppression model
+	// Emails are not sent to suppressed addresses.
+	Suppression struct {
+		ID    uuid.UUID `db:"id" json:"id"`
+		Email string    `db:"email" json:"email"`
+		// Reason is either bounce or complaint.
+		Reason string `db:"reason" json:"reason"`
+		// Kind is the bounce type or complaint feedback type reported by provider.
+		Kind        sql.NullString `db:"kind" json:"kind"`
+		Source      sql.NullString `db:"source" json:"source"`
+		FeedbackID  sql.NullString `db:"feedback_id" json:"feedbackID"`
+		Diagnostic  sql.NullString `db:"diagnostic" json:"diagnostic"`
+		Occurrences int            `db:"occurrences" json:"occurrences"`
+		CreatedAt   pq.NullTime    `db:"created_at" json:"createdAt"`
+		UpdatedAt   pq.NullTime    `db:"updated_at" json:"updatedAt"`
+	}
+)
+
+const (
+	// Suppression reasons
+	BounceReason    = "bounce"
+	ComplaintReason = "complaint"
+)
+
+// SetCreateValues sets ID and timestamps.
+// Email is stored lowercased so that lookups are case insensitive.
+func (sp *Suppression) SetCreateValues() error {
+	if sp.ID == uuid.Nil {
+		sp.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	sp.Email = strings.ToLower(strings.TrimSpace(sp.Email))
+	sp.Occurrences = 1
+	sp.CreatedAt = pg.ToNullTime(now)
+	sp.UpdatedAt = pg.ToNullTime(now)
+	return nil
+}
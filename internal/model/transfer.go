@@ -0,0 +1,77 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+type (
+	// Transfer model
+	// A request to hand the ownership of an account to one of its members.
+	// Owner only changes once the recipient confirms it
+	// and, if required, an admin approves it.
+	Transfer struct {
+		ID            uuid.UUID      `db:"id" json:"id"`
+		AccountID     string         `db:"account_id" json:"accountID"`
+		FromID        string         `db:"from_id" json:"fromID"`
+		ToID          string         `db:"to_id" json:"toID"`
+		InitiatedByID sql.NullString `db:"initiated_by_id" json:"initiatedByID"`
+		ConfirmDigest string         `db:"confirm_digest" json:"-"`
+		ExpiresAt     pq.NullTime    `db:"expires_at" json:"expiresAt"`
+		ConfirmedAt   pq.NullTime    `db:"confirmed_at" json:"confirmedAt"`
+		ApprovedByID  sql.NullString `db:"approved_by_id" json:"approvedByID"`
+		ApprovedAt    pq.NullTime    `db:"approved_at" json:"approvedAt"`
+		CompletedAt   pq.NullTime    `db:"completed_at" json:"completedAt"`
+		CancelledAt   pq.NullTime    `db:"cancelled_at" json:"cancelledAt"`
+		CreatedAt     pq.NullTime    `db:"created_at" json:"createdAt"`
+	}
+)
+
+// SetCreateValues sets ID and timestamps.
+// ttl is how long the recipient has to confirm the transfer.
+func (t *Transfer) SetCreateValues(ttl time.Duration) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.NewV4()
+	}
+	now := time.Now()
+	t.CreatedAt = pg.ToNullTime(now)
+	t.ExpiresAt = pg.ToNullTime(now.Add(ttl))
+	return nil
+}
+
+// GenToken generates the token sent to the recipient to confirm the transfer.
+// Only its digest is stored.
+func (t *Transfer) GenToken() (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	t.ConfirmDigest = TokenDigest(token)
+	return token, nil
+}
+
+// IsConfirmed returns true if the recipient has confirmed the transfer.
+func (t *Transfer) IsConfirmed() bool {
+	return t.ConfirmedAt.Valid
+}
+
+// IsApproved returns true if an admin has approved the transfer.
+func (t *Transfer) IsApproved() bool {
+	return t.ApprovedAt.Valid
+}
+
+// IsOpen returns true while the transfer can still be completed.
+func (t *Transfer) IsOpen() bool {
+	return !t.CompletedAt.Valid && !t.CancelledAt.Valid
+}
+
+// IsReady returns true if the transfer can be completed,
+// approval tells whether it has to be approved first.
+func (t *Transfer) IsReady(approval bool) bool {
+	return t.IsOpen() && t.IsConfirmed() && (!approval || t.IsApproved())
+}
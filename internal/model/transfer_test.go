@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	pg "gitlab.com/mikrowezel/backend/db/postgres"
+)
+
+func TestTransferGenToken(t *testing.T) {
+	var tr Transfer
+
+	token, err := tr.GenToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if token == "" || tr.ConfirmDigest != TokenDigest(token) {
+		t.Errorf("digest does not match token")
+	}
+}
+
+func TestTransferIsReady(t *testing.T) {
+	now := pg.ToNullTime(time.Now())
+
+	tests := []struct {
+		name     string
+		transfer Transfer
+		approval bool
+		want     bool
+	}{
+		{"pending", Transfer{}, false, false},
+		{"confirmed", Transfer{ConfirmedAt: now}, false, true},
+		{"confirmed, not approved", Transfer{ConfirmedAt: now}, true, false},
+		{"confirmed and approved", Transfer{ConfirmedAt: now, ApprovedAt: now}, true, true},
+		{"cancelled", Transfer{ConfirmedAt: now, CancelledAt: now}, false, false},
+		{"completed", Transfer{ConfirmedAt: now, CompletedAt: now}, false, false},
+	}
+
+	for _, tc := range tests {
+		if got := tc.transfer.IsReady(tc.approval); got != tc.want {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.want, got)
+		}
+	}
+}
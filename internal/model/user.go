@@ -10,6 +10,13 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// UserTypeHuman users sign in interactively.
+	UserTypeHuman = "human"
+	// UserTypeService users are non-human principals owned by an account.
+	UserTypeService = "service"
+)
+
 type (
 	// User model
 	User struct {
@@ -33,8 +40,25 @@ type (
 		EndsAt            pq.NullTime    `db:"ends_at" json:"endsAt"`
 		IsActive          sql.NullBool   `db:"is_active" json:"isActive"`
 		IsDeleted         sql.NullBool   `db:"is_deleted" json:"isDeleted"`
+		DeletedByID       sql.NullString `db:"deleted_by_id" json:"deletedByID"`
+		DeletedAt         pq.NullTime    `db:"deleted_at" json:"deletedAt"`
+		// EmailUndeliverableAt is set when mails sent to Email bounce
+		// or are reported as spam.
+		EmailUndeliverableAt pq.NullTime `db:"email_undeliverable_at" json:"emailUndeliverableAt"`
+		// ExternalID is the one a provisioning client knows the user by.
+		ExternalID sql.NullString `db:"external_id" json:"externalID"`
+		// UserType is either human or service, service accounts belong
+		// to AccountID and authenticate with client credentials only.
+		UserType  string         `db:"user_type" json:"userType"`
+		AccountID sql.NullString `db:"account_id" json:"accountID"`
 		m.Audit
 	}
+
+	// UserMatch is a user search result.
+	UserMatch struct {
+		User
+		Rank float64 `db:"rank" json:"rank"`
+	}
 )
 
 // UpdatePasswordDigest if password changed.
@@ -51,9 +75,18 @@ func (user *User) UpdatePasswordDigest() (digest string, err error) {
 	return user.PasswordDigest.String, nil
 }
 
+// PasswordMatches returns true if password matches the stored digest.
+func (user *User) PasswordMatches(password string) bool {
+	err := bcrypt.CompareHashAndPassword([]byte(user.PasswordDigest.String), []byte(password))
+	return err == nil
+}
+
 // SetCreateValues sets de ID and slug.
 func (user *User) SetCreateValues() error {
 	pfx := user.Username.String
+	if user.UserType == "" {
+		user.UserType = UserTypeHuman
+	}
 	user.Identification.SetCreateValues(pfx)
 	user.Audit.SetCreateValues()
 	user.UpdatePasswordDigest()
@@ -79,6 +112,16 @@ func (user *User) GenAutoConfirmationToken() {
 	user.IsConfirmed = db.ToNullBool(true)
 }
 
+// IsEmailUndeliverable returns true if mails can not be delivered to user email.
+func (user *User) IsEmailUndeliverable() bool {
+	return user.EmailUndeliverableAt.Valid
+}
+
+// IsServiceAccount returns true if user is a non-human principal.
+func (user *User) IsServiceAccount() bool {
+	return user.UserType == UserTypeService
+}
+
 // Match condition for model.
 func (user *User) Match(tc *User) bool {
 	r := user.Identification.Match(tc.Identification) &&
@@ -0,0 +1,76 @@
+package oauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+type (
+	// Claims of a client assertion or of an access token.
+	Claims map[string]interface{}
+)
+
+// decodeClaims keeps numbers as json.Number so that timestamps
+// are not rendered in exponent notation.
+func decodeClaims(b []byte) (Claims, error) {
+	c := Claims{}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	err := d.Decode(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// String value of claim k, numbers are formatted.
+func (c Claims) String(k string) string {
+	switch v := c[k].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+// Strings value of claim k that can be either a string or a list of them.
+func (c Claims) Strings(k string) []string {
+	switch v := c[k].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				ss = append(ss, s)
+			}
+		}
+		return ss
+	}
+	return nil
+}
+
+// Time value of claim k, expressed in seconds since epoch.
+func (c Claims) Time(k string) (time.Time, bool) {
+	n, ok := c[k].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(f), 0), true
+}
+
+// Scopes granted by an access token, space separated in 'scope'.
+func (c Claims) Scopes() []string {
+	return splitScope(c.String("scope"))
+}
@@ -0,0 +1,330 @@
+package oauth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const (
+	// leeway tolerated on token timestamps for clock skew.
+	leeway = time.Minute
+	// maxAssertionTTL is the longest lifetime accepted for client assertions,
+	// used jti values are remembered for that long.
+	maxAssertionTTL = 10 * time.Minute
+	// accessTokenType header of issued access tokens, see RFC 9068.
+	accessTokenType = "at+jwt"
+)
+
+type (
+	// IssuerConfig of the token endpoint.
+	IssuerConfig struct {
+		// URL identifies the issuer in the 'iss' claim.
+		URL string
+		// TokenURL is the audience client assertions are made for.
+		TokenURL string
+		// Audiences access tokens can be requested for,
+		// the first one is used if none is requested.
+		// Tokens are issued for URL if empty.
+		Audiences []string
+		// TTL of access tokens.
+		TTL time.Duration
+	}
+
+	// Issuer signs access tokens and verifies client assertions.
+	Issuer struct {
+		IssuerConfig
+		Key   *rsa.PrivateKey
+		KeyID string
+		jtis  *replayCache
+	}
+
+	// AccessToken to issue.
+	AccessToken struct {
+		Subject  string
+		ClientID string
+		Audience string
+		Scopes   []string
+		// Extra claims, i.e. the account the client belongs to.
+		Extra Claims
+	}
+
+	// replayCache remembers the jti of the assertions already used
+	// until they expire. It is kept in memory, instances behind
+	// a load balancer only detect replays they see themselves.
+	replayCache struct {
+		mu   sync.Mutex
+		seen map[string]time.Time
+	}
+)
+
+// LoadIssuer reads the token endpoint configuration,
+// it returns nil if 'app.oauth.enabled' is not set.
+func LoadIssuer(cfg *config.Config) (*Issuer, error) {
+	if !cfg.ValAsBool("app.oauth.enabled", false) {
+		return nil, nil
+	}
+
+	ic := IssuerConfig{
+		URL:       cfg.ValOrDef("app.oauth.issuer", strings.TrimSuffix(siteURL(cfg, ""), "/")),
+		TokenURL:  siteURL(cfg, "oauth/token"),
+		Audiences: splitList(cfg.ValOrDef("app.oauth.audiences", "")),
+		TTL:       time.Duration(cfg.ValAsInt("app.oauth.token.ttl.minutes", 15)) * time.Minute,
+	}
+
+	key, err := LoadOrCreateKey(cfg.ValOrDef("app.oauth.key.file", "oauth.key"))
+	if err != nil {
+		return nil, fmt.Errorf("OAuth token signing key: %w", err)
+	}
+
+	return NewIssuer(ic, key)
+}
+
+// NewIssuer returns an issuer signing with key.
+func NewIssuer(ic IssuerConfig, key *rsa.PrivateKey) (*Issuer, error) {
+	kid, err := KeyID(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issuer{
+		IssuerConfig: ic,
+		Key:          key,
+		KeyID:        kid,
+		jtis:         &replayCache{seen: map[string]time.Time{}},
+	}, nil
+}
+
+// Audience returns the audience of a token requested for aud.
+func (i *Issuer) Audience(aud string) (string, error) {
+	if aud == "" {
+		if len(i.Audiences) > 0 {
+			return i.Audiences[0], nil
+		}
+		return i.URL, nil
+	}
+
+	for _, a := range i.Audiences {
+		if a == aud {
+			return a, nil
+		}
+	}
+
+	return "", NewError(InvalidTarget, "unknown audience '%s'", aud)
+}
+
+// Issue signs an access token, it returns the token and its lifetime.
+func (i *Issuer) Issue(t AccessToken, now time.Time) (token string, ttl time.Duration, err error) {
+	jti, err := randomID()
+	if err != nil {
+		return "", 0, err
+	}
+
+	c := Claims{}
+	for k, v := range t.Extra {
+		c[k] = v
+	}
+
+	c["iss"] = i.URL
+	c["sub"] = t.Subject
+	c["aud"] = t.Audience
+	c["client_id"] = t.ClientID
+	c["iat"] = now.Unix()
+	c["exp"] = now.Add(i.TTL).Unix()
+	c["jti"] = jti
+	if len(t.Scopes) > 0 {
+		c["scope"] = strings.Join(t.Scopes, " ")
+	}
+
+	token, err = sign(i.Key, jwsHeader{Alg: signingAlg, Typ: accessTokenType, Kid: i.KeyID}, c)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, i.TTL, nil
+}
+
+// Verify checks an access token issued by i and returns its claims.
+func (i *Issuer) Verify(token string, now time.Time) (Claims, error) {
+	h, payload, signed, sig, err := splitJWS(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.Alg != signingAlg || h.Kid != i.KeyID {
+		return nil, fmt.Errorf("%w: unknown key", ErrInvalidToken)
+	}
+
+	err = verifySignature(h.Alg, &i.Key.PublicKey, signed, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := decodeClaims(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	if c.String("iss") != i.URL {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+
+	exp, ok := c.Time("exp")
+	if !ok || now.After(exp.Add(leeway)) {
+		return nil, fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+
+	return c, nil
+}
+
+// JWKS returns the JWK set other services verify access tokens with.
+func (i *Issuer) JWKS() ([]byte, error) {
+	k, err := publicJWK(&i.Key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Kid = i.KeyID
+	k.Use = "sig"
+	k.Alg = signingAlg
+
+	return json.Marshal(jwks{Keys: []jwk{k}})
+}
+
+// VerifyAssertion checks a private_key_jwt client assertion signed
+// with key, see RFC 7523 section 3. Issuer and subject must be the
+// client, the token endpoint must be among the audiences and the
+// assertion must be short lived and used only once.
+func (i *Issuer) VerifyAssertion(assertion string, key crypto.PublicKey, clientID string, now time.Time) error {
+	h, payload, signed, sig, err := splitJWS(assertion)
+	if err != nil {
+		return err
+	}
+
+	err = verifySignature(h.Alg, key, signed, sig)
+	if err != nil {
+		return err
+	}
+
+	c, err := decodeClaims(payload)
+	if err != nil {
+		return fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	if c.String("iss") != clientID || c.String("sub") != clientID {
+		return fmt.Errorf("%w: not issued by the client", ErrInvalidToken)
+	}
+
+	if !contains(c.Strings("aud"), i.TokenURL) && !contains(c.Strings("aud"), i.URL) {
+		return fmt.Errorf("%w: not issued for this token endpoint", ErrInvalidToken)
+	}
+
+	exp, ok := c.Time("exp")
+	if !ok || now.After(exp.Add(leeway)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+
+	if exp.After(now.Add(maxAssertionTTL + leeway)) {
+		return fmt.Errorf("%w: lifetime too long", ErrInvalidToken)
+	}
+
+	if nbf, ok := c.Time("nbf"); ok && nbf.After(now.Add(leeway)) {
+		return fmt.Errorf("%w: not yet valid", ErrInvalidToken)
+	}
+
+	jti := c.String("jti")
+	if jti == "" {
+		return fmt.Errorf("%w: missing 'jti' claim", ErrInvalidToken)
+	}
+
+	if !i.jtis.add(clientID+" "+jti, exp.Add(leeway), now) {
+		return fmt.Errorf("%w: replayed", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+// AssertionClientID returns the client an assertion claims to come from,
+// without verifying it, so that the client keys can be looked up.
+func AssertionClientID(assertion string) (clientID, keyID string, err error) {
+	h, payload, _, _, err := splitJWS(assertion)
+	if err != nil {
+		return "", "", err
+	}
+
+	c, err := decodeClaims(payload)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	sub := c.String("sub")
+	if sub == "" {
+		return "", "", fmt.Errorf("%w: missing 'sub' claim", ErrInvalidToken)
+	}
+
+	return sub, h.Kid, nil
+}
+
+// add returns false if key was already seen,
+// expired entries are dropped on the way.
+func (rc *replayCache) add(key string, exp, now time.Time) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for k, e := range rc.seen {
+		if now.After(e) {
+			delete(rc.seen, k)
+		}
+	}
+
+	if _, ok := rc.seen[key]; ok {
+		return false
+	}
+
+	rc.seen[key] = exp
+	return true
+}
+
+// siteURL mirrors the links the service builds for site.url.
+func siteURL(cfg *config.Config, path string) string {
+	site := cfg.ValOrDef("site.url", "localhost")
+	return fmt.Sprintf("https://%s/%s", site, strings.TrimPrefix(path, "/"))
+}
+
+func splitList(s string) []string {
+	f := func(r rune) bool {
+		return r == ',' || r == ' '
+	}
+	return strings.FieldsFunc(s, f)
+}
+
+// splitScope returns the space separated scopes in s.
+func splitScope(s string) []string {
+	return strings.Fields(s)
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func contains(vals []string, s string) bool {
+	for _, v := range vals {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
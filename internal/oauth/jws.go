@@ -0,0 +1,155 @@
+package oauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256" // SHA-256 hash
+	_ "crypto/sha512" // SHA-384 and SHA-512 hashes
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type (
+	jwsHeader struct {
+		Alg string `json:"alg"`
+		Typ string `json:"typ,omitempty"`
+		Kid string `json:"kid,omitempty"`
+	}
+)
+
+// signingAlg of the issued access tokens.
+const signingAlg = "RS256"
+
+var (
+	// ErrInvalidToken is returned when a JWT cannot be trusted.
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// sign returns the compact serialization of claims signed with key.
+func sign(key *rsa.PrivateKey, h jwsHeader, c Claims) (string, error) {
+	hb, err := json.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+
+	cb, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(hb) + "." + base64.RawURLEncoding.EncodeToString(cb)
+
+	sum := crypto.SHA256.New()
+	sum.Write([]byte(signed))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// splitJWS returns the decoded header, the payload, the signed input and the signature.
+func splitJWS(token string) (h jwsHeader, payload, signed, sig []byte, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	hb, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+
+	err = json.Unmarshal(hb, &h)
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+
+	payload, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed payload", ErrInvalidToken)
+	}
+
+	sig, err = base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+
+	signed = []byte(parts[0] + "." + parts[1])
+	return h, payload, signed, sig, nil
+}
+
+// verifySignature checks sig over signed using alg and key.
+// Only asymmetric algorithms are accepted, a client secret
+// is never used as an HMAC key.
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var h crypto.Hash
+
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		h = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		h = crypto.SHA384
+	case "RS512", "PS512":
+		h = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: unsupported algorithm '%s'", ErrInvalidToken, alg)
+	}
+
+	hh := h.New()
+	hh.Write(signed)
+	sum := hh.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		var err error
+		switch alg[:2] {
+		case "RS":
+			err = rsa.VerifyPKCS1v15(pub, h, sum, sig)
+		case "PS":
+			err = rsa.VerifyPSS(pub, h, sum, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		default:
+			return fmt.Errorf("%w: key does not match algorithm '%s'", ErrInvalidToken, alg)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+
+	case *ecdsa.PublicKey:
+		if alg[:2] != "ES" {
+			break
+		}
+
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size || hashFor(pub) != h {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, sum, r, s) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: key does not match algorithm '%s'", ErrInvalidToken, alg)
+}
+
+// hashFor returns the hash ES algorithms use with the curve of pub.
+func hashFor(pub *ecdsa.PublicKey) crypto.Hash {
+	if pub.Curve.Params().BitSize > 256 {
+		return crypto.SHA384
+	}
+	return crypto.SHA256
+}
@@ -0,0 +1,214 @@
+package oauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+)
+
+const (
+	keyBits = 2048
+	// minKeyBits of the RSA keys clients register.
+	minKeyBits = 2048
+)
+
+type (
+	// jwk is a public JSON Web Key, see RFC 7517.
+	jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid,omitempty"`
+		Use string `json:"use,omitempty"`
+		Alg string `json:"alg,omitempty"`
+		// RSA
+		N string `json:"n,omitempty"`
+		E string `json:"e,omitempty"`
+		// EC
+		Crv string `json:"crv,omitempty"`
+		X   string `json:"x,omitempty"`
+		Y   string `json:"y,omitempty"`
+	}
+
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+)
+
+// LoadOrCreateKey reads a PEM encoded RSA signing key.
+// If the file does not exist a new key is generated and saved,
+// so that tokens issued before a restart can still be verified.
+func LoadOrCreateKey(keyFile string) (*rsa.PrivateKey, error) {
+	_, err := os.Stat(keyFile)
+	if os.IsNotExist(err) {
+		key, err := rsa.GenerateKey(rand.Reader, keyBits)
+		if err != nil {
+			return nil, err
+		}
+
+		kb := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+		err = ioutil.WriteFile(keyFile, kb, 0600)
+		if err != nil {
+			return nil, err
+		}
+
+		return key, nil
+	}
+
+	return LoadKey(keyFile)
+}
+
+// LoadKey reads a PEM encoded RSA signing key.
+func LoadKey(keyFile string) (*rsa.PrivateKey, error) {
+	kb, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(kb)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in '%s'", keyFile)
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only RSA keys are supported")
+	}
+
+	return key, nil
+}
+
+// ParsePublicKey decodes the PEM encoded key a client registers to
+// sign its assertions, either a bare public key or a certificate.
+// RSA keys of at least 2048 bits and P-256 or P-384 EC keys are accepted.
+func ParsePublicKey(b []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	var pub interface{}
+	var err error
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var c *x509.Certificate
+		c, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			pub = c.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("unexpected PEM block '%s'", block.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minKeyBits {
+			return nil, fmt.Errorf("RSA keys need at least %d bits", minKeyBits)
+		}
+		return k, nil
+
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() && k.Curve != elliptic.P384() {
+			return nil, errors.New("only P-256 and P-384 EC keys are supported")
+		}
+		return k, nil
+	}
+
+	return nil, errors.New("only RSA and EC keys are supported")
+}
+
+// KeyID returns the JWK thumbprint of a public key, see RFC 7638.
+// It identifies registered keys in the 'kid' header of assertions.
+func KeyID(pub crypto.PublicKey) (string, error) {
+	k, err := publicJWK(pub)
+	if err != nil {
+		return "", err
+	}
+
+	// Required members only, in lexicographic order.
+	var m interface{}
+	switch k.Kty {
+	case "RSA":
+		m = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{k.E, k.Kty, k.N}
+	default:
+		m = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{k.Crv, k.Kty, k.X, k.Y}
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// publicJWK returns the JWK representation of pub.
+func publicJWK(pub crypto.PublicKey) (jwk, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return jwk{
+			Kty: "RSA",
+			N:   b64Int(k.N, 0),
+			E:   b64Int(big.NewInt(int64(k.E)), 0),
+		}, nil
+
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return jwk{
+			Kty: "EC",
+			Crv: k.Curve.Params().Name,
+			X:   b64Int(k.X, size),
+			Y:   b64Int(k.Y, size),
+		}, nil
+	}
+
+	return jwk{}, errors.New("unsupported key type")
+}
+
+// b64Int encodes n big-endian, left padded with zeros to size bytes.
+func b64Int(n *big.Int, size int) string {
+	b := n.Bytes()
+	if len(b) < size {
+		b = append(make([]byte, size-len(b)), b...)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
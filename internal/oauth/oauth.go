@@ -0,0 +1,97 @@
+// Package oauth issues access tokens to service accounts through the
+// OAuth 2.0 client credentials grant, see RFC 6749 section 4.4.
+// Clients authenticate with a client secret or with a JWT assertion
+// signed with a key they registered (private_key_jwt), see RFC 7523.
+// Access tokens are JWTs, see RFC 9068, other services verify them
+// with the keys published as a JWK set.
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	// GrantClientCredentials is the only grant type supported.
+	GrantClientCredentials = "client_credentials"
+	// ClientAssertionType of private_key_jwt client authentication.
+	ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// TokenTypeBearer is the type of the issued access tokens.
+	TokenTypeBearer = "Bearer"
+)
+
+// Error codes, see RFC 6749 section 5.2 and RFC 8707 section 2.
+const (
+	InvalidRequest       = "invalid_request"
+	InvalidClient        = "invalid_client"
+	InvalidScope         = "invalid_scope"
+	InvalidTarget        = "invalid_target"
+	UnauthorizedClient   = "unauthorized_client"
+	UnsupportedGrantType = "unsupported_grant_type"
+	ServerError          = "server_error"
+)
+
+type (
+	// Error response.
+	Error struct {
+		Code        string `json:"error"`
+		Description string `json:"error_description,omitempty"`
+		// status code of the response.
+		status int
+	}
+
+	// TokenResponse of a successful request, see RFC 6749 section 5.1.
+	TokenResponse struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Scope       string `json:"scope,omitempty"`
+	}
+)
+
+// NewError returns an error response of code.
+// Failed client authentications are answered with 401,
+// server errors with 500, anything else with 400.
+func NewError(code, format string, a ...interface{}) *Error {
+	status := http.StatusBadRequest
+	switch code {
+	case InvalidClient:
+		status = http.StatusUnauthorized
+	case ServerError:
+		status = http.StatusInternalServerError
+	}
+
+	return &Error{
+		Code:        code,
+		Description: fmt.Sprintf(format, a...),
+		status:      status,
+	}
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("oauth %s", e.Code)
+	}
+	return fmt.Sprintf("oauth %s: %s", e.Code, e.Description)
+}
+
+// StatusCode of the response.
+func (e *Error) StatusCode() int {
+	return e.status
+}
+
+// IsScopeToken returns true if s can be used as a scope,
+// see RFC 6749 section 3.3.
+func IsScopeToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < 0x21 || r > 0x7e || r == '"' || r == '\\' {
+			return false
+		}
+	}
+
+	return true
+}
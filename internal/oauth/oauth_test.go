@@ -0,0 +1,313 @@
+package oauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://localhost"
+	testTokenURL = "https://localhost/oauth/token"
+	testClientID = "0c7b4e0e-8f1b-4a43-9a4e-2f3b1d7b5a10"
+)
+
+func newTestIssuer(t *testing.T) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ic := IssuerConfig{
+		URL:       testIssuer,
+		TokenURL:  testTokenURL,
+		Audiences: []string{"https://billing.localhost", "https://reports.localhost"},
+		TTL:       15 * time.Minute,
+	}
+
+	i, err := NewIssuer(ic, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return i
+}
+
+// signES256 signs claims the way a client would sign its assertions.
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, c map[string]interface{}) string {
+	hb, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": kid})
+	cb, _ := json.Marshal(c)
+	signed := base64.RawURLEncoding.EncodeToString(hb) + "." + base64.RawURLEncoding.EncodeToString(cb)
+
+	h := crypto.SHA256.New()
+	h.Write([]byte(signed))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig := make([]byte, 64)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):], sb)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func assertionClaims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss": testClientID,
+		"sub": testClientID,
+		"aud": testTokenURL,
+		"exp": now.Add(5 * time.Minute).Unix(),
+		"jti": "a1b2c3",
+	}
+}
+
+func TestIssueAndVerify(t *testing.T) {
+	i := newTestIssuer(t)
+	now := time.Now()
+
+	at := AccessToken{
+		Subject:  testClientID,
+		ClientID: testClientID,
+		Audience: "https://billing.localhost",
+		Scopes:   []string{"invoices:read", "invoices:write"},
+		Extra:    Claims{"account": "acme"},
+	}
+
+	token, ttl, err := i.Issue(at, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ttl != 15*time.Minute {
+		t.Errorf("expected 15m lifetime, got %s", ttl)
+	}
+
+	c, err := i.Verify(token, now)
+	if err != nil {
+		t.Fatalf("valid token rejected: %s", err.Error())
+	}
+
+	if c.String("sub") != testClientID || c.String("client_id") != testClientID ||
+		c.String("aud") != "https://billing.localhost" || c.String("account") != "acme" {
+		t.Errorf("unexpected claims: %+v", c)
+	}
+
+	if s := c.Scopes(); len(s) != 2 || s[0] != "invoices:read" || s[1] != "invoices:write" {
+		t.Errorf("unexpected scopes: %v", s)
+	}
+
+	_, err = i.Verify(token, now.Add(time.Hour))
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expired token: expected ErrInvalidToken, got %v", err)
+	}
+
+	other := newTestIssuer(t)
+	_, err = other.Verify(token, now)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("foreign token: expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestJWKSVerifiesTokens(t *testing.T) {
+	i := newTestIssuer(t)
+
+	b, err := i.JWKS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+
+	err = json.Unmarshal(b, &set)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(set.Keys) != 1 || set.Keys[0].Kid != i.KeyID || set.Keys[0].Alg != "RS256" {
+		t.Fatalf("unexpected JWK set: %s", b)
+	}
+
+	nb, _ := base64.RawURLEncoding.DecodeString(set.Keys[0].N)
+	pub := &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: 65537}
+
+	token, _, err := i.Issue(AccessToken{Subject: testClientID, Audience: testIssuer}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, signed, sig, err := splitJWS(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = verifySignature("RS256", pub, signed, sig)
+	if err != nil {
+		t.Errorf("token not verifiable with published key: %s", err.Error())
+	}
+}
+
+func TestKeyIDThumbprint(t *testing.T) {
+	// RFC 7638 section 3.1 example.
+	n := "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kid, err := KeyID(&rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: 65537})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kid != "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs" {
+		t.Errorf("unexpected thumbprint '%s'", kid)
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	i := newTestIssuer(t)
+	now := time.Now()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := signES256(t, key, "k1", assertionClaims(now))
+
+	sub, kid, err := AssertionClientID(a)
+	if err != nil || sub != testClientID || kid != "k1" {
+		t.Fatalf("unexpected client '%s', key '%s': %v", sub, kid, err)
+	}
+
+	err = i.VerifyAssertion(a, &key.PublicKey, testClientID, now)
+	if err != nil {
+		t.Fatalf("valid assertion rejected: %s", err.Error())
+	}
+
+	err = i.VerifyAssertion(a, &key.PublicKey, testClientID, now)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("replayed assertion: expected ErrInvalidToken, got %v", err)
+	}
+
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c := assertionClaims(now)
+	c["jti"] = "d4e5f6"
+	err = i.VerifyAssertion(signES256(t, key, "k1", c), &other.PublicKey, testClientID, now)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unknown key: expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestAssertionRejected(t *testing.T) {
+	now := time.Now()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c map[string]interface{})
+	}{
+		{"other client", func(c map[string]interface{}) { c["sub"] = "someone-else" }},
+		{"other audience", func(c map[string]interface{}) { c["aud"] = "https://other.localhost/token" }},
+		{"expired", func(c map[string]interface{}) { c["exp"] = now.Add(-5 * time.Minute).Unix() }},
+		{"no expiration", func(c map[string]interface{}) { delete(c, "exp") }},
+		{"long lived", func(c map[string]interface{}) { c["exp"] = now.Add(24 * time.Hour).Unix() }},
+		{"not yet valid", func(c map[string]interface{}) { c["nbf"] = now.Add(5 * time.Minute).Unix() }},
+		{"no jti", func(c map[string]interface{}) { delete(c, "jti") }},
+	}
+
+	for _, tt := range tests {
+		i := newTestIssuer(t)
+		c := assertionClaims(now)
+		tt.modify(c)
+
+		err := i.VerifyAssertion(signES256(t, key, "", c), &key.PublicKey, testClientID, now)
+		if !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	ec, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	small, _ := rsa.GenerateKey(rand.Reader, 1024)
+
+	encode := func(pub interface{}) []byte {
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}
+
+	_, err := ParsePublicKey(encode(&ec.PublicKey))
+	if err != nil {
+		t.Errorf("EC key rejected: %s", err.Error())
+	}
+
+	_, err = ParsePublicKey(encode(&small.PublicKey))
+	if err == nil {
+		t.Error("1024 bit RSA key accepted")
+	}
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	if err == nil {
+		t.Error("garbage accepted")
+	}
+}
+
+func TestAudience(t *testing.T) {
+	i := newTestIssuer(t)
+
+	aud, err := i.Audience("")
+	if err != nil || aud != "https://billing.localhost" {
+		t.Errorf("expected default audience, got '%s': %v", aud, err)
+	}
+
+	aud, err = i.Audience("https://reports.localhost")
+	if err != nil || aud != "https://reports.localhost" {
+		t.Errorf("expected requested audience, got '%s': %v", aud, err)
+	}
+
+	_, err = i.Audience("https://unknown.localhost")
+	var oe *Error
+	if !errors.As(err, &oe) || oe.Code != InvalidTarget || oe.StatusCode() != http.StatusBadRequest {
+		t.Errorf("expected invalid_target, got %v", err)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	if s := NewError(InvalidClient, "unknown client").StatusCode(); s != http.StatusUnauthorized {
+		t.Errorf("invalid_client: expected 401, got %d", s)
+	}
+
+	if s := NewError(UnsupportedGrantType, "").StatusCode(); s != http.StatusBadRequest {
+		t.Errorf("unsupported_grant_type: expected 400, got %d", s)
+	}
+}
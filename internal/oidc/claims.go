@@ -0,0 +1,85 @@
+package oidc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// Claims of an ID token or returned by a user info endpoint.
+	Claims map[string]interface{}
+)
+
+// decodeClaims keeps numbers as json.Number so that numeric IDs,
+// i.e. GitHub user IDs, are not rendered in exponent notation.
+func decodeClaims(b []byte) (Claims, error) {
+	c := Claims{}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	err := d.Decode(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// String value of claim k, numbers and booleans are formatted.
+func (c Claims) String(k string) string {
+	switch v := c[k].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprintf("%t", v)
+	}
+	return ""
+}
+
+// Strings value of claim k that can be either a string or a list of them.
+func (c Claims) Strings(k string) []string {
+	switch v := c[k].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				ss = append(ss, s)
+			}
+		}
+		return ss
+	}
+	return nil
+}
+
+// Bool value of claim k, some providers send "true" as a string.
+func (c Claims) Bool(k string) bool {
+	switch v := c[k].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// Time value of claim k, expressed in seconds since epoch.
+func (c Claims) Time(k string) (time.Time, bool) {
+	n, ok := c[k].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(f), 0), true
+}
@@ -0,0 +1,480 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Config of an identity provider.
+	// If Issuer is set the provider is treated as an OpenID Connect one:
+	// missing endpoints are discovered and ID tokens are required and verified.
+	// Otherwise it is a plain OAuth2 provider and identity is read from UserInfoURL.
+	Config struct {
+		Name         string
+		Label        string
+		Issuer       string
+		AuthURL      string
+		TokenURL     string
+		UserInfoURL  string
+		JWKSURL      string
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+		// Claim names used to build an Identity.
+		SubjectClaim       string
+		EmailClaim         string
+		EmailVerifiedClaim string
+		UsernameClaim      string
+		NameClaim          string
+		GivenNameClaim     string
+		FamilyNameClaim    string
+	}
+
+	// Client performs the authorization code flow against a provider.
+	Client struct {
+		Config
+		HTTP *http.Client
+
+		mu         sync.Mutex
+		discovered bool
+		keys       map[string]crypto.PublicKey
+		now        func() time.Time
+	}
+
+	// AuthRequest holds the per sign-in secrets that the caller
+	// has to keep until the provider redirects back.
+	AuthRequest struct {
+		State    string
+		Nonce    string
+		Verifier string
+	}
+
+	// Token endpoint response.
+	Token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		IDToken     string `json:"id_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+
+	// Identity of a user as asserted by a provider.
+	Identity struct {
+		Provider      string
+		Subject       string
+		Email         string
+		EmailVerified bool
+		Username      string
+		Name          string
+		GivenName     string
+		FamilyName    string
+	}
+
+	discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+
+	tokenError struct {
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+)
+
+const (
+	// Limit on provider responses size.
+	maxBodySize = 1 << 20
+)
+
+var (
+	// ErrNoIDToken is returned when an OpenID Connect provider does not return an ID token.
+	ErrNoIDToken = errors.New("no ID token returned")
+	// ErrNoSubject is returned when the provider identity has no subject.
+	ErrNoSubject = errors.New("no subject returned")
+)
+
+// NewClient for a provider.
+// Unset claim names take their OpenID Connect standard values.
+func NewClient(cfg Config) *Client {
+	def := func(v *string, d string) {
+		if *v == "" {
+			*v = d
+		}
+	}
+
+	def(&cfg.Label, cfg.Name)
+	def(&cfg.SubjectClaim, "sub")
+	def(&cfg.EmailClaim, "email")
+	def(&cfg.EmailVerifiedClaim, "email_verified")
+	def(&cfg.UsernameClaim, "preferred_username")
+	def(&cfg.NameClaim, "name")
+	def(&cfg.GivenNameClaim, "given_name")
+	def(&cfg.FamilyNameClaim, "family_name")
+
+	if len(cfg.Scopes) == 0 && cfg.IsOIDC() {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &Client{
+		Config: cfg,
+		HTTP:   &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}
+}
+
+// IsOIDC returns true for OpenID Connect providers.
+func (cfg Config) IsOIDC() bool {
+	return cfg.Issuer != ""
+}
+
+// NewAuthRequest generates the state, nonce and PKCE verifier of a sign-in.
+func NewAuthRequest() (AuthRequest, error) {
+	var ar AuthRequest
+	var err error
+
+	for _, v := range []*string{&ar.State, &ar.Nonce, &ar.Verifier} {
+		*v, err = randomString()
+		if err != nil {
+			return AuthRequest{}, err
+		}
+	}
+
+	return ar, nil
+}
+
+// AuthCodeURL returns the provider URL the user has to be sent to.
+func (c *Client) AuthCodeURL(ctx context.Context, redirectURL string, ar AuthRequest) (string, error) {
+	err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(c.AuthURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.ClientID)
+	q.Set("redirect_uri", redirectURL)
+	q.Set("state", ar.State)
+	q.Set("code_challenge", challenge(ar.Verifier))
+	q.Set("code_challenge_method", "S256")
+	if len(c.Scopes) > 0 {
+		q.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	if c.IsOIDC() {
+		q.Set("nonce", ar.Nonce)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange an authorization code for tokens.
+// redirectURL has to be the one used to build the authorization URL.
+func (c *Client) Exchange(ctx context.Context, redirectURL, code, verifier string) (Token, error) {
+	var tok Token
+
+	err := c.discover(ctx)
+	if err != nil {
+		return tok, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURL)
+	form.Set("code_verifier", verifier)
+	form.Set("client_id", c.ClientID)
+	form.Set("client_secret", c.ClientSecret)
+
+	req, err := http.NewRequest(http.MethodPost, c.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return tok, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	b, status, err := c.do(ctx, req)
+	if err != nil {
+		return tok, err
+	}
+
+	if status != http.StatusOK {
+		var te tokenError
+		json.Unmarshal(b, &te)
+		return tok, fmt.Errorf("token exchange failed: %d %s %s", status, te.Error, te.Description)
+	}
+
+	err = json.Unmarshal(b, &tok)
+	if err != nil {
+		return tok, fmt.Errorf("invalid token response: %s", err.Error())
+	}
+
+	// Some OAuth2 providers report errors with a 200 status.
+	if tok.AccessToken == "" {
+		var te tokenError
+		json.Unmarshal(b, &te)
+		return tok, fmt.Errorf("token exchange failed: %s %s", te.Error, te.Description)
+	}
+
+	return tok, nil
+}
+
+// Identity returns the user identity asserted by tok.
+// For OpenID Connect providers the ID token is verified against nonce,
+// user info claims, if available, complete the ones it carries.
+func (c *Client) Identity(ctx context.Context, tok Token, nonce string) (Identity, error) {
+	claims := Claims{}
+
+	if c.IsOIDC() {
+		if tok.IDToken == "" {
+			return Identity{}, ErrNoIDToken
+		}
+
+		var err error
+		claims, err = c.verifyIDToken(ctx, tok.IDToken, nonce)
+		if err != nil {
+			return Identity{}, err
+		}
+	}
+
+	if c.UserInfoURL != "" {
+		info, err := c.userInfo(ctx, tok.AccessToken)
+		if err != nil {
+			return Identity{}, err
+		}
+
+		// User info has to be about the ID token subject.
+		if c.IsOIDC() && info.String("sub") != claims.String("sub") {
+			return Identity{}, fmt.Errorf("%w: user info subject mismatch", ErrInvalidToken)
+		}
+
+		for k, v := range info {
+			if _, ok := claims[k]; !ok {
+				claims[k] = v
+			}
+		}
+	}
+
+	id := Identity{
+		Provider:      c.Name,
+		Subject:       claims.String(c.SubjectClaim),
+		Email:         claims.String(c.EmailClaim),
+		EmailVerified: claims.Bool(c.EmailVerifiedClaim),
+		Username:      claims.String(c.UsernameClaim),
+		Name:          claims.String(c.NameClaim),
+		GivenName:     claims.String(c.GivenNameClaim),
+		FamilyName:    claims.String(c.FamilyNameClaim),
+	}
+
+	if id.Subject == "" {
+		return Identity{}, ErrNoSubject
+	}
+
+	return id, nil
+}
+
+func (c *Client) verifyIDToken(ctx context.Context, raw, nonce string) (Claims, error) {
+	err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h, payload, signed, sig, err := splitJWS(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.key(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifySignature(h.Alg, key, signed, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := decodeClaims(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	err = checkClaims(claims, c.Issuer, c.ClientID, nonce, c.now())
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// key returns the provider signing key kid.
+// Keys are fetched again once if kid is unknown, providers rotate them.
+func (c *Client) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	key, ok := c.keys[kid]
+	c.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	if c.JWKSURL == "" {
+		return nil, fmt.Errorf("%w: no JWKS URL", ErrInvalidToken)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b, status, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("cannot get JWKS: %d", status)
+	}
+
+	keys, err := parseJWKS(b)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key '%s'", ErrInvalidToken, kid)
+	}
+
+	return key, nil
+}
+
+func (c *Client) userInfo(ctx context.Context, accessToken string) (Claims, error) {
+	req, err := http.NewRequest(http.MethodGet, c.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	b, status, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("cannot get user info: %d", status)
+	}
+
+	return decodeClaims(b)
+}
+
+// discover fills missing endpoints from the provider discovery document.
+func (c *Client) discover(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovered || !c.IsOIDC() {
+		return nil
+	}
+
+	if c.AuthURL != "" && c.TokenURL != "" && c.JWKSURL != "" {
+		c.discovered = true
+		return nil
+	}
+
+	wk := strings.TrimSuffix(c.Issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequest(http.MethodGet, wk, nil)
+	if err != nil {
+		return err
+	}
+
+	b, status, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK {
+		return fmt.Errorf("cannot discover provider '%s': %d", c.Name, status)
+	}
+
+	var d discovery
+	err = json.Unmarshal(b, &d)
+	if err != nil {
+		return fmt.Errorf("invalid discovery document: %s", err.Error())
+	}
+
+	if d.Issuer != c.Issuer {
+		return fmt.Errorf("discovered issuer '%s' does not match '%s'", d.Issuer, c.Issuer)
+	}
+
+	set := func(v *string, d string) {
+		if *v == "" {
+			*v = d
+		}
+	}
+
+	set(&c.AuthURL, d.AuthorizationEndpoint)
+	set(&c.TokenURL, d.TokenEndpoint)
+	set(&c.UserInfoURL, d.UserInfoEndpoint)
+	set(&c.JWKSURL, d.JWKSURI)
+
+	c.discovered = true
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request) (body []byte, status int, err error) {
+	r, err := c.HTTP.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer r.Body.Close()
+
+	body, err = ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return body, r.StatusCode, nil
+}
+
+// challenge derives the PKCE S256 code challenge of verifier.
+func challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
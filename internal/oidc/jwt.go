@@ -0,0 +1,261 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // SHA-256 and SHA-384 hashes
+	_ "crypto/sha512" // SHA-512 hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+type (
+	// jwk is a JSON Web Key, only public RSA and EC keys are supported.
+	jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		// RSA
+		N string `json:"n"`
+		E string `json:"e"`
+		// EC
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	jwsHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+)
+
+// leeway tolerated on token timestamps for clock skew.
+const leeway = 2 * time.Minute
+
+var (
+	// ErrInvalidToken is returned when an ID token cannot be trusted.
+	ErrInvalidToken = errors.New("invalid ID token")
+)
+
+// parseJWKS returns the usable signing keys of a JWK set by key ID.
+// Keys with an unsupported type or meant for encryption are skipped.
+func parseJWKS(b []byte) (map[string]crypto.PublicKey, error) {
+	var set jwks
+
+	err := json.Unmarshal(b, &set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWK set: %s", err.Error())
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[k.Kid] = pub
+	}
+
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64Int(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := b64Int(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%s'", k.Crv)
+		}
+
+		x, err := b64Int(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := b64Int(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point not on curve")
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type '%s'", k.Kty)
+}
+
+// splitJWS returns the decoded header, the payload, the signed input and the signature.
+func splitJWS(token string) (h jwsHeader, payload, signed, sig []byte, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	hb, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+
+	err = json.Unmarshal(hb, &h)
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+
+	payload, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed payload", ErrInvalidToken)
+	}
+
+	sig, err = base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return h, nil, nil, nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+
+	signed = []byte(parts[0] + "." + parts[1])
+	return h, payload, signed, sig, nil
+}
+
+// verifySignature checks sig over signed using alg and key.
+// Only asymmetric algorithms are accepted.
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var h crypto.Hash
+
+	switch alg {
+	case "RS256", "ES256":
+		h = crypto.SHA256
+	case "RS384", "ES384":
+		h = crypto.SHA384
+	case "RS512":
+		h = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: unsupported algorithm '%s'", ErrInvalidToken, alg)
+	}
+
+	hh := h.New()
+	hh.Write(signed)
+	sum := hh.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if alg[:2] != "RS" {
+			break
+		}
+
+		err := rsa.VerifyPKCS1v15(pub, h, sum, sig)
+		if err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+
+	case *ecdsa.PublicKey:
+		if alg[:2] != "ES" {
+			break
+		}
+
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, sum, r, s) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: key does not match algorithm '%s'", ErrInvalidToken, alg)
+}
+
+// checkClaims validates the standard ID token claims.
+func checkClaims(c Claims, issuer, clientID, nonce string, now time.Time) error {
+	if c.String("iss") != issuer {
+		return fmt.Errorf("%w: unexpected issuer '%s'", ErrInvalidToken, c.String("iss"))
+	}
+
+	auds := c.Strings("aud")
+	if !contains(auds, clientID) {
+		return fmt.Errorf("%w: not issued for this client", ErrInvalidToken)
+	}
+
+	if len(auds) > 1 && c.String("azp") != clientID {
+		return fmt.Errorf("%w: unexpected authorized party", ErrInvalidToken)
+	}
+
+	exp, ok := c.Time("exp")
+	if !ok || now.After(exp.Add(leeway)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+
+	if iat, ok := c.Time("iat"); ok && iat.After(now.Add(leeway)) {
+		return fmt.Errorf("%w: issued in the future", ErrInvalidToken)
+	}
+
+	if c.String("nonce") != nonce {
+		return fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+func b64Int(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+func contains(vals []string, s string) bool {
+	for _, v := range vals {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,514 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const (
+	testClientID     = "granica"
+	testClientSecret = "s3cr3t"
+	testRedirectURL  = "https://localhost/users/oidc/mock/callback"
+	testKid          = "key-1"
+)
+
+type (
+	// mockIdP is a minimal OpenID Connect provider.
+	mockIdP struct {
+		*httptest.Server
+		key *rsa.PrivateKey
+		mu  sync.Mutex
+		// Issued codes and the values they were requested with.
+		codes map[string]url.Values
+		// Overrides applied to ID token claims.
+		claims map[string]interface{}
+		// Issuer puts no ID token in responses when set.
+		noIDToken bool
+	}
+)
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mockIdP{
+		key:    key,
+		codes:  make(map[string]url.Values),
+		claims: make(map[string]interface{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("/token", m.token)
+	mux.HandleFunc("/jwks", m.jwks)
+	mux.HandleFunc("/userinfo", m.userInfo)
+	mux.HandleFunc("/user", m.oauth2User)
+
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+// authorize simulates the user signing in at the provider,
+// it returns the code the provider would redirect back with.
+func (m *mockIdP) authorize(t *testing.T, authURL string) string {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := fmt.Sprintf("code-%d", len(m.codes)+1)
+
+	m.mu.Lock()
+	m.codes[code] = u.Query()
+	m.mu.Unlock()
+
+	return code
+}
+
+func (m *mockIdP) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 m.URL,
+		"authorization_endpoint": m.URL + "/authorize",
+		"token_endpoint":         m.URL + "/token",
+		"userinfo_endpoint":      m.URL + "/userinfo",
+		"jwks_uri":               m.URL + "/jwks",
+	})
+}
+
+func (m *mockIdP) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	m.mu.Lock()
+	q, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+
+	fail := func(e string) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": e})
+	}
+
+	switch {
+	case !ok:
+		fail("invalid_grant")
+		return
+	case r.PostForm.Get("client_id") != testClientID || r.PostForm.Get("client_secret") != testClientSecret:
+		fail("invalid_client")
+		return
+	case r.PostForm.Get("redirect_uri") != q.Get("redirect_uri"):
+		fail("invalid_grant")
+		return
+	case challenge(r.PostForm.Get("code_verifier")) != q.Get("code_challenge"):
+		fail("invalid_grant")
+		return
+	}
+
+	res := map[string]interface{}{
+		"access_token": "access-" + q.Get("state"),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	}
+
+	if !m.noIDToken {
+		claims := map[string]interface{}{
+			"iss":            m.URL,
+			"sub":            "248289761001",
+			"aud":            testClientID,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          q.Get("nonce"),
+			"email":          "jane@example.com",
+			"email_verified": true,
+		}
+		for k, v := range m.claims {
+			claims[k] = v
+		}
+		res["id_token"] = m.sign(claims)
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (m *mockIdP) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := m.key.PublicKey
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": testKid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *mockIdP) userInfo(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer access-") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sub":                "248289761001",
+		"preferred_username": "jane",
+		"given_name":         "Jane",
+		"family_name":        "Doe",
+		// ID token value prevails.
+		"email": "other@example.com",
+	})
+}
+
+// oauth2User mimics GitHub user API.
+func (m *mockIdP) oauth2User(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer access-") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.Write([]byte(`{"id": 12345678901, "login": "octocat", "name": "The Octocat", "email": "octocat@example.com"}`))
+}
+
+func (m *mockIdP) sign(claims map[string]interface{}) string {
+	return signRS256(m.key, testKid, claims)
+}
+
+func signRS256(key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	h, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	p, _ := json.Marshal(claims)
+
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+	sum := sha256.Sum256([]byte(signed))
+
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (m *mockIdP) client() *Client {
+	return NewClient(Config{
+		Name:         "mock",
+		Issuer:       m.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+	})
+}
+
+// signIn runs the whole flow and returns the resulting identity.
+func signIn(t *testing.T, m *mockIdP, c *Client) (Identity, error) {
+	ctx := context.Background()
+
+	ar, err := NewAuthRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authURL, err := c.AuthCodeURL(ctx, testRedirectURL, ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := m.authorize(t, authURL)
+
+	tok, err := c.Exchange(ctx, testRedirectURL, code, ar.Verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c.Identity(ctx, tok, ar.Nonce)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+
+	c := m.client()
+	ar := AuthRequest{State: "state", Nonce: "nonce", Verifier: "verifier"}
+
+	authURL, err := c.AuthCodeURL(context.Background(), testRedirectURL, ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(authURL, m.URL+"/authorize?") {
+		t.Fatalf("authorization endpoint not discovered: %s", authURL)
+	}
+
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURL,
+		"state":                 "state",
+		"nonce":                 "nonce",
+		"scope":                 "openid email profile",
+		"code_challenge":        challenge("verifier"),
+		"code_challenge_method": "S256",
+	}
+
+	for k, v := range want {
+		if q.Get(k) != v {
+			t.Errorf("%s: expected '%s', got '%s'", k, v, q.Get(k))
+		}
+	}
+}
+
+func TestOIDCSignIn(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+
+	id, err := signIn(t, m, m.client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Identity{
+		Provider:      "mock",
+		Subject:       "248289761001",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Username:      "jane",
+		GivenName:     "Jane",
+		FamilyName:    "Doe",
+	}
+
+	if id != want {
+		t.Errorf("expected %+v, got %+v", want, id)
+	}
+}
+
+func TestIDTokenRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+	}{
+		{"wrong audience", map[string]interface{}{"aud": "someone-else"}},
+		{"wrong issuer", map[string]interface{}{"iss": "https://evil.example.com"}},
+		{"expired", map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()}},
+		{"replayed nonce", map[string]interface{}{"nonce": "other"}},
+		{"foreign azp", map[string]interface{}{"aud": []string{testClientID, "other"}, "azp": "other"}},
+	}
+
+	for _, tt := range tests {
+		m := newMockIdP(t)
+		m.claims = tt.claims
+
+		_, err := signIn(t, m, m.client())
+		if !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", tt.name, err)
+		}
+
+		m.Close()
+	}
+}
+
+func TestIDTokenSignature(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+
+	c := m.client()
+	ctx := context.Background()
+
+	claims := map[string]interface{}{
+		"iss":   m.URL,
+		"sub":   "1",
+		"aud":   testClientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": "n",
+	}
+
+	valid := m.sign(claims)
+	if _, err := c.verifyIDToken(ctx, valid, "n"); err != nil {
+		t.Fatalf("valid token rejected: %s", err.Error())
+	}
+
+	// Other key, same kid.
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged := signRS256(other, testKid, claims)
+	if _, err := c.verifyIDToken(ctx, forged, "n"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("forged token: expected ErrInvalidToken, got %v", err)
+	}
+
+	// Unsigned.
+	parts := strings.Split(valid, ".")
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"key-1"}`)) + "." + parts[1] + "."
+	if _, err := c.verifyIDToken(ctx, none, "n"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unsigned token: expected ErrInvalidToken, got %v", err)
+	}
+
+	// Unknown key.
+	unknown := signRS256(m.key, "key-2", claims)
+	if _, err := c.verifyIDToken(ctx, unknown, "n"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unknown key: expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestES256Signature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := []byte("header.payload")
+	sum := sha256.Sum256(signed)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig := make([]byte, 64)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):], sb)
+
+	if err := verifySignature("ES256", &key.PublicKey, signed, sig); err != nil {
+		t.Errorf("valid signature rejected: %s", err.Error())
+	}
+
+	if err := verifySignature("RS256", &key.PublicKey, signed, sig); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("algorithm confusion: expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestPKCEAndState(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+
+	c := m.client()
+	ctx := context.Background()
+
+	ar, _ := NewAuthRequest()
+	authURL, err := c.AuthCodeURL(ctx, testRedirectURL, ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := m.authorize(t, authURL)
+
+	_, err = c.Exchange(ctx, testRedirectURL, code, "intercepted-code-wrong-verifier")
+	if err == nil {
+		t.Error("exchange with a wrong verifier accepted")
+	}
+
+	// Codes are single use.
+	_, err = c.Exchange(ctx, testRedirectURL, code, ar.Verifier)
+	if err == nil {
+		t.Error("reused code accepted")
+	}
+}
+
+func TestNoIDToken(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+	m.noIDToken = true
+
+	_, err := signIn(t, m, m.client())
+	if err != ErrNoIDToken {
+		t.Errorf("expected ErrNoIDToken, got %v", err)
+	}
+}
+
+func TestOAuth2SignIn(t *testing.T) {
+	m := newMockIdP(t)
+	defer m.Close()
+	m.noIDToken = true
+
+	// GitHub like provider.
+	c := NewClient(Config{
+		Name:          "github",
+		AuthURL:       m.URL + "/authorize",
+		TokenURL:      m.URL + "/token",
+		UserInfoURL:   m.URL + "/user",
+		ClientID:      testClientID,
+		ClientSecret:  testClientSecret,
+		Scopes:        []string{"read:user", "user:email"},
+		SubjectClaim:  "id",
+		UsernameClaim: "login",
+	})
+
+	id, err := signIn(t, m, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id.Subject != "12345678901" || id.Username != "octocat" || id.Email != "octocat@example.com" {
+		t.Errorf("unexpected identity: %+v", id)
+	}
+
+	if id.EmailVerified {
+		t.Error("unverified email reported as verified")
+	}
+}
+
+func TestLoadProviders(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.SetNamespace("grc")
+	cfg.SetValues(map[string]string{
+		"app.oidc.providers":            "google, github",
+		"app.oidc.google.label":         "Google",
+		"app.oidc.google.issuer":        "https://accounts.google.com",
+		"app.oidc.google.client.id":     "google-id",
+		"app.oidc.github.client.id":     "github-id",
+		"app.oidc.github.auth.url":      "https://github.com/login/oauth/authorize",
+		"app.oidc.github.token.url":     "https://github.com/login/oauth/access_token",
+		"app.oidc.github.userinfo.url":  "https://api.github.com/user",
+		"app.oidc.github.scopes":        "read:user user:email",
+		"app.oidc.github.claim.subject": "id",
+	})
+
+	pp, err := LoadProviders(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pp.List()) != 2 || pp.List()[0].Name != "google" {
+		t.Fatalf("unexpected providers: %+v", pp.List())
+	}
+
+	g, _ := pp.Get("google")
+	if g.Label != "Google" || strings.Join(g.Scopes, " ") != "openid email profile" {
+		t.Errorf("unexpected google config: %+v", g.Config)
+	}
+
+	gh, _ := pp.Get("github")
+	if gh.Label != "github" || gh.SubjectClaim != "id" || len(gh.Scopes) != 2 {
+		t.Errorf("unexpected github config: %+v", gh.Config)
+	}
+
+	cfg.SetValues(map[string]string{
+		"app.oidc.providers":        "broken",
+		"app.oidc.broken.client.id": "id",
+	})
+
+	if _, err := LoadProviders(cfg); err == nil {
+		t.Error("OAuth2 provider without endpoints accepted")
+	}
+
+	var none *Providers
+	if _, ok := none.Get("google"); ok || none.List() != nil {
+		t.Error("nil providers should be empty")
+	}
+}
@@ -0,0 +1,121 @@
+package oidc
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+type (
+	// Providers available to sign in with, in configuration order.
+	Providers struct {
+		clients []*Client
+		byName  map[string]*Client
+	}
+)
+
+// LoadProviders builds the clients of the providers listed
+// in 'app.oidc.providers', a comma separated list of names.
+// Each one is configured through 'app.oidc.<name>.*' values, i.e. for 'corp':
+//
+//	app.oidc.corp.label          Sign in button text
+//	app.oidc.corp.issuer         OpenID Connect issuer, empty for plain OAuth2
+//	app.oidc.corp.auth.url       Endpoints, discovered from issuer if empty
+//	app.oidc.corp.token.url
+//	app.oidc.corp.userinfo.url
+//	app.oidc.corp.jwks.url
+//	app.oidc.corp.client.id
+//	app.oidc.corp.client.secret
+//	app.oidc.corp.scopes         Space or comma separated
+//	app.oidc.corp.claim.subject  Claim names, OpenID Connect ones by default
+//	app.oidc.corp.claim.email
+//	app.oidc.corp.claim.email.verified
+//	app.oidc.corp.claim.username
+//	app.oidc.corp.claim.name
+//	app.oidc.corp.claim.given.name
+//	app.oidc.corp.claim.family.name
+func LoadProviders(cfg *config.Config) (*Providers, error) {
+	var clients []*Client
+
+	names := cfg.ValOrDef("app.oidc.providers", "")
+	for _, name := range splitList(names) {
+		name = strings.ToLower(name)
+		val := func(key string) string {
+			return cfg.ValOrDef(fmt.Sprintf("app.oidc.%s.%s", name, key), "")
+		}
+
+		pc := Config{
+			Name:               name,
+			Label:              val("label"),
+			Issuer:             val("issuer"),
+			AuthURL:            val("auth.url"),
+			TokenURL:           val("token.url"),
+			UserInfoURL:        val("userinfo.url"),
+			JWKSURL:            val("jwks.url"),
+			ClientID:           val("client.id"),
+			ClientSecret:       val("client.secret"),
+			Scopes:             splitList(val("scopes")),
+			SubjectClaim:       val("claim.subject"),
+			EmailClaim:         val("claim.email"),
+			EmailVerifiedClaim: val("claim.email.verified"),
+			UsernameClaim:      val("claim.username"),
+			NameClaim:          val("claim.name"),
+			GivenNameClaim:     val("claim.given.name"),
+			FamilyNameClaim:    val("claim.family.name"),
+		}
+
+		if pc.ClientID == "" {
+			return nil, fmt.Errorf("no client ID for identity provider '%s'", name)
+		}
+
+		if !pc.IsOIDC() && (pc.AuthURL == "" || pc.TokenURL == "" || pc.UserInfoURL == "") {
+			return nil, fmt.Errorf("OAuth2 identity provider '%s' needs auth, token and user info URLs", name)
+		}
+
+		clients = append(clients, NewClient(pc))
+	}
+
+	return NewProviders(clients...), nil
+}
+
+// NewProviders from clients.
+func NewProviders(clients ...*Client) *Providers {
+	pp := &Providers{
+		clients: clients,
+		byName:  make(map[string]*Client),
+	}
+
+	for _, c := range clients {
+		pp.byName[c.Name] = c
+	}
+
+	return pp
+}
+
+// Get the client of provider name.
+func (pp *Providers) Get(name string) (*Client, bool) {
+	if pp == nil {
+		return nil, false
+	}
+
+	c, ok := pp.byName[name]
+	return c, ok
+}
+
+// List returns all configured provider clients.
+func (pp *Providers) List() []*Client {
+	if pp == nil {
+		return nil
+	}
+
+	return pp.clients
+}
+
+func splitList(s string) []string {
+	f := func(r rune) bool {
+		return r == ',' || r == ' '
+	}
+
+	return strings.FieldsFunc(s, f)
+}
@@ -0,0 +1,257 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/log"
+	svc "gitlab.com/mikrowezel/backend/service"
+)
+
+type (
+	// Dispatcher is an outbox handler.
+	// It periodically delivers the emails queued in the outbox.
+	Dispatcher struct {
+		*svc.BaseHandler
+		opts   Options
+		repo   *repo.Repo
+		mailer mailer.Mailer
+	}
+
+	// Options for the dispatcher.
+	Options struct {
+		// Interval between dispatches, dispatcher is disabled if not positive.
+		Interval time.Duration
+		// BatchSize is the maximum number of emails sent on each dispatch.
+		BatchSize int
+		// MaxAttempts before an email is moved to dead-letter.
+		MaxAttempts int
+		// Lease is how long a claimed email is reserved for a dispatch.
+		Lease time.Duration
+		// BackoffBase is the delay after the first failed attempt,
+		// it doubles on each one up to BackoffMax.
+		BackoffBase time.Duration
+		BackoffMax  time.Duration
+	}
+
+	// Result of a dispatch.
+	Result struct {
+		Sent    int
+		Retried int
+		Dead    int
+	}
+)
+
+var (
+	// Handler is a package level outbox handler instance.
+	Handler *Dispatcher
+)
+
+// NewHandler creates and returns a new outbox handler.
+func NewHandler(ctx context.Context, cfg *config.Config, log *log.Logger, name string) (*Dispatcher, error) {
+	if name == "" {
+		name = fmt.Sprintf("outbox-handler-%s", svc.NameSufix())
+	}
+
+	h := &Dispatcher{
+		BaseHandler: svc.NewBaseHandler(ctx, cfg, log, name),
+		opts:        makeOptions(cfg),
+	}
+
+	log.Info("New handler", "name", name)
+
+	return h, nil
+}
+
+// makeOptions reads dispatcher options from config.
+// Set envars GRN_MAILER_OUTBOX_* to change them.
+func makeOptions(cfg *config.Config) Options {
+	return Options{
+		Interval:    time.Duration(cfg.ValAsInt("mailer.outbox.interval.seconds", 10)) * time.Second,
+		BatchSize:   int(cfg.ValAsInt("mailer.outbox.batch.size", 20)),
+		MaxAttempts: int(cfg.ValAsInt("mailer.outbox.max.attempts", 8)),
+		Lease:       time.Duration(cfg.ValAsInt("mailer.outbox.lease.seconds", 300)) * time.Second,
+		BackoffBase: time.Duration(cfg.ValAsInt("mailer.outbox.backoff.base.seconds", 30)) * time.Second,
+		BackoffMax:  time.Duration(cfg.ValAsInt("mailer.outbox.backoff.max.seconds", 3600)) * time.Second,
+	}
+}
+
+// Init a new outbox handler.
+// it also stores it as the package default handler.
+func (h *Dispatcher) Init(s svc.Service) chan bool {
+	// Set package default handler.
+	// TODO: See if this could be avoided.
+	Handler = h
+
+	ok := make(chan bool)
+	go func() {
+		defer close(ok)
+		s.Lock()
+		for _, sh := range s.Handlers() {
+			switch v := sh.(type) {
+			case *repo.Repo:
+				h.repo = v
+			case *mailer.MailHandler:
+				h.mailer = v
+			}
+		}
+		s.AddHandler(h)
+		s.Unlock()
+
+		if h.repo == nil || h.mailer == nil {
+			h.Log().Error(errors.New("outbox requires repo and mailer handlers"), "name", h.Name())
+			ok <- false
+			return
+		}
+
+		// Suppressed addresses, i.e. bounced ones, are not mailed.
+		h.mailer = mailer.NewSuppressingMailer(h.mailer, h.repo)
+
+		h.Log().Info("Outbox initializated", "name", h.Name())
+		ok <- true
+	}()
+	return ok
+}
+
+// Start dispatching emails in background.
+func (h *Dispatcher) Start() error {
+	if h.opts.Interval <= 0 {
+		h.Log().Info("Outbox dispatcher disabled")
+		return nil
+	}
+
+	h.Log().Info("Outbox dispatcher initializing", "interval", h.opts.Interval)
+
+	go h.run()
+	return nil
+}
+
+func (h *Dispatcher) run() {
+	t := time.NewTicker(h.opts.Interval)
+	defer t.Stop()
+
+	for {
+		res, err := h.Dispatch()
+		if err != nil {
+			h.Log().Error(err, "job", "outbox")
+		} else if res.Sent+res.Retried+res.Dead > 0 {
+			h.Log().Info("Outbox dispatch done", "sent", res.Sent, "retried", res.Retried, "dead", res.Dead)
+		}
+
+		select {
+		case <-t.C:
+		case <-h.Ctx().Done():
+			return
+		}
+	}
+}
+
+// Dispatch sends a batch of due emails.
+func (h *Dispatcher) Dispatch() (res Result, err error) {
+	ob, err := h.repo.OutboxRepoNewTx()
+	if err != nil {
+		return res, err
+	}
+
+	emails, err := ob.Claim(h.opts.BatchSize, h.opts.Lease)
+	if err != nil {
+		ob.Tx.Rollback()
+		return res, err
+	}
+
+	err = ob.Commit()
+	if err != nil {
+		return res, err
+	}
+
+	for _, oe := range emails {
+		resend, sendErr := h.mailer.Send(oe.ToEmail())
+
+		status, next := h.opts.outcome(oe.Attempts, resend, sendErr, time.Now())
+
+		err := h.update(oe, status, next, sendErr)
+		if err != nil {
+			h.Log().Error(err, "email", oe.ID.String())
+			continue
+		}
+
+		switch status {
+		case model.OutboxSent:
+			res.Sent++
+		case model.OutboxPending:
+			res.Retried++
+		case model.OutboxDead:
+			h.Log().Info("Outbox email dead-lettered", "email", oe.ID.String(), "attempts", oe.Attempts, "error", sendErr.Error())
+			res.Dead++
+		}
+	}
+
+	return res, nil
+}
+
+func (h *Dispatcher) update(oe model.OutboxEmail, status string, next time.Time, sendErr error) error {
+	ob, err := h.repo.OutboxRepoNewTx()
+	if err != nil {
+		return err
+	}
+
+	id := oe.ID.String()
+
+	switch status {
+	case model.OutboxSent:
+		err = ob.MarkSent(id)
+	case model.OutboxPending:
+		err = ob.MarkRetry(id, next, sendErr.Error())
+	default:
+		err = ob.MarkDead(id, sendErr.Error())
+	}
+
+	if err != nil {
+		ob.Tx.Rollback()
+		return err
+	}
+
+	return ob.Commit()
+}
+
+// outcome decides the status of an email after a delivery attempt
+// and, if it has to be retried, when.
+func (o Options) outcome(attempts int, resend bool, sendErr error, now time.Time) (status string, next time.Time) {
+	if sendErr == nil {
+		return model.OutboxSent, now
+	}
+
+	if !resend || attempts >= o.MaxAttempts {
+		return model.OutboxDead, now
+	}
+
+	return model.OutboxPending, now.Add(Backoff(attempts, o.BackoffBase, o.BackoffMax))
+}
+
+// Backoff returns the delay before the next attempt after
+// attempt failed ones: base, 2*base, 4*base... up to max.
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max || d <= 0 {
+			return max
+		}
+	}
+
+	if d > max {
+		return max
+	}
+
+	return d
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func TestBackoff(t *testing.T) {
+	base := 30 * time.Second
+	max := 10 * time.Minute
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{5, 8 * time.Minute},
+		{6, 10 * time.Minute},
+		{100, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		got := Backoff(tt.attempt, base, max)
+		if got != tt.want {
+			t.Errorf("attempt %d: expected %s, got %s", tt.attempt, tt.want, got)
+		}
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	o := Options{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: time.Hour}
+	now := time.Date(2020, 1, 15, 9, 30, 0, 0, time.UTC)
+	sendErr := errors.New("send error")
+
+	tests := []struct {
+		name     string
+		attempts int
+		resend   bool
+		err      error
+		status   string
+		next     time.Time
+	}{
+		{"sent", 1, false, nil, model.OutboxSent, now},
+		{"transient", 1, true, sendErr, model.OutboxPending, now.Add(time.Minute)},
+		{"transient again", 2, true, sendErr, model.OutboxPending, now.Add(2 * time.Minute)},
+		{"permanent", 1, false, sendErr, model.OutboxDead, now},
+		{"exhausted", 3, true, sendErr, model.OutboxDead, now},
+	}
+
+	for _, tt := range tests {
+		status, next := o.outcome(tt.attempts, tt.resend, tt.err, now)
+		if status != tt.status || !next.Equal(tt.next) {
+			t.Errorf("%s: expected %s at %s, got %s at %s", tt.name, tt.status, tt.next, status, next)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package password
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+type (
+	// Corpus of breached password hashes.
+	// It is queried by SHA-1 prefix so that a full hash is never
+	// handed out (k-anonymity), a remote service can back it as well.
+	Corpus interface {
+		// Range returns the upper case hex SHA-1 suffixes of the breached
+		// passwords whose hash starts with prefix.
+		Range(prefix string) ([]string, error)
+	}
+
+	// NopCorpus never finds a breached password.
+	NopCorpus struct{}
+
+	// FileCorpus is a local copy of a breached password list,
+	// i.e. "Pwned Passwords" SHA-1 ordered by hash,
+	// one 'HASH:COUNT' or 'HASH' per line.
+	// Lookups are binary searches over the file, it is never fully loaded.
+	FileCorpus struct {
+		r    io.ReaderAt
+		size int64
+		f    *os.File
+	}
+)
+
+const (
+	// PrefixLen is the number of hash chars sent to a corpus.
+	PrefixLen = 5
+	// maxLineLen bounds the bytes read to find a line.
+	maxLineLen = 128
+)
+
+var (
+	// ErrInvalidPrefix is returned for prefixes not made of PrefixLen hex chars.
+	ErrInvalidPrefix = errors.New("invalid hash prefix")
+)
+
+// Range always returns no suffixes.
+func (c NopCorpus) Range(prefix string) ([]string, error) {
+	return nil, nil
+}
+
+// OpenCorpus opens the corpus file at path.
+func OpenCorpus(path string) (*FileCorpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	c := NewCorpus(f, fi.Size())
+	c.f = f
+	return c, nil
+}
+
+// NewCorpus returns a corpus reading size bytes from r.
+func NewCorpus(r io.ReaderAt, size int64) *FileCorpus {
+	return &FileCorpus{r: r, size: size}
+}
+
+// Close the corpus file, if any.
+func (c *FileCorpus) Close() error {
+	if c.f == nil {
+		return nil
+	}
+	return c.f.Close()
+}
+
+// Range returns the suffixes of the hashes starting with prefix.
+func (c *FileCorpus) Range(prefix string) ([]string, error) {
+	prefix = strings.ToUpper(prefix)
+	if len(prefix) != PrefixLen || !isHex(prefix) {
+		return nil, ErrInvalidPrefix
+	}
+
+	// Find the first line whose hash is not lower than prefix.
+	lo, hi := int64(0), c.size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		start, err := c.lineStart(mid)
+		if err != nil {
+			return nil, err
+		}
+
+		if start >= c.size {
+			hi = mid
+			continue
+		}
+
+		line, err := c.lineAt(start)
+		if err != nil {
+			return nil, err
+		}
+
+		if hashOf(line) >= prefix {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	start, err := c.lineStart(lo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect matching lines.
+	var suffixes []string
+
+	s := bufio.NewScanner(io.NewSectionReader(c.r, start, c.size-start))
+	for s.Scan() {
+		h := hashOf(s.Text())
+		if !strings.HasPrefix(h, prefix) {
+			break
+		}
+		suffixes = append(suffixes, h[PrefixLen:])
+	}
+
+	return suffixes, s.Err()
+}
+
+// lineStart returns the offset of the first line starting at or after off.
+func (c *FileCorpus) lineStart(off int64) (int64, error) {
+	if off <= 0 {
+		return 0, nil
+	}
+
+	buf := make([]byte, maxLineLen)
+	for pos := off - 1; pos < c.size; pos += maxLineLen {
+		n, err := c.r.ReadAt(buf, pos)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return pos + int64(i) + 1, nil
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return c.size, nil
+}
+
+// lineAt returns the line starting at off.
+func (c *FileCorpus) lineAt(off int64) (string, error) {
+	buf := make([]byte, maxLineLen)
+
+	n, err := c.r.ReadAt(buf, off)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	line := buf[:n]
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+
+	return string(line), nil
+}
+
+// Breached returns true if password is found in corpus.
+// Only the first PrefixLen chars of its SHA-1 hash are sent to it.
+func Breached(c Corpus, pw string) (bool, error) {
+	sum := sha1.Sum([]byte(pw))
+	h := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, err := c.Range(h[:PrefixLen])
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range suffixes {
+		if s == h[PrefixLen:] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// hashOf returns the upper case hash of a corpus line.
+func hashOf(line string) string {
+	if i := strings.IndexByte(line, ':'); i >= 0 {
+		line = line[:i]
+	}
+	return strings.ToUpper(strings.TrimSpace(line))
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789ABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
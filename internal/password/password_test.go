@@ -0,0 +1,192 @@
+package password
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCheckLengthAndClasses(t *testing.T) {
+	p := Policy{MinLength: 10, MaxLength: 16, RequireLower: true, RequireUpper: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		pw   string
+		want []string
+	}{
+		{"short", []string{TooShortErrMsg, NoUpperErrMsg, NoDigitErrMsg, NoSymbolErrMsg}},
+		{"waytoolongforthispolicy", []string{TooLongErrMsg, NoUpperErrMsg, NoDigitErrMsg, NoSymbolErrMsg}},
+		{"Kx9#mQ2!vLp", nil},
+	}
+
+	for _, tc := range tests {
+		got, err := p.Check(Input{Password: tc.pw}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("%s: expected %v, got %v", tc.pw, tc.want, got)
+		}
+	}
+}
+
+func TestCheckPersonalInfo(t *testing.T) {
+	p := Policy{DisallowPersonal: true}
+	in := Input{Username: "jdoe", Email: "john.smith@mail.com"}
+
+	for _, pw := range []string{"xxJDOExx", "my john.smith pass", "john.smith@mail.com!"} {
+		in.Password = pw
+		got, _ := p.Check(in, nil)
+		if len(got) != 1 || got[0] != PersonalInfoErrMsg {
+			t.Errorf("%s: expected personal info violation, got %v", pw, got)
+		}
+	}
+
+	in.Password = "unrelated words here"
+	if got, _ := p.Check(in, nil); len(got) != 0 {
+		t.Errorf("unexpected violations: %v", got)
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		pw  string
+		max int
+		min int
+	}{
+		{"password", 0, 0},
+		{"P@ssw0rd", 0, 0},
+		{"12345678", 1, 0},
+		{"aaaaaaaaaa", 1, 0},
+		{"qwertyuiop", 1, 0},
+		{"Password1!", 1, 0},
+		{"summer2020", 1, 0},
+		{"correct horse battery staple", 4, 4},
+		{"x7!Kq9#vZ2", 4, 4},
+	}
+
+	for _, tc := range tests {
+		s := Score(tc.pw)
+		if s > tc.max || s < tc.min {
+			t.Errorf("%s: expected score in [%d, %d], got %d", tc.pw, tc.min, tc.max, s)
+		}
+	}
+
+	if Score("jdoe2020jdoe", "jdoe") >= Score("jdoe2020jdoe") {
+		t.Error("user inputs should lower the score")
+	}
+}
+
+func TestCheckHistory(t *testing.T) {
+	d1, _ := bcrypt.GenerateFromPassword([]byte("first-secret"), bcrypt.MinCost)
+	d2, _ := bcrypt.GenerateFromPassword([]byte("second-secret"), bcrypt.MinCost)
+	in := Input{History: []string{string(d2), string(d1)}}
+
+	p := Policy{History: 2}
+
+	in.Password = "first-secret"
+	if got, _ := p.Check(in, nil); len(got) != 1 || got[0] != ReusedErrMsg {
+		t.Errorf("expected reuse violation, got %v", got)
+	}
+
+	// Only the latest one is remembered
+	p.History = 1
+	if got, _ := p.Check(in, nil); len(got) != 0 {
+		t.Errorf("unexpected violations: %v", got)
+	}
+}
+
+func TestFileCorpus(t *testing.T) {
+	breached := []string{"123456", "password", "qwerty", "letmein", "dragon", "monkey"}
+
+	var lines []string
+	for i, pw := range breached {
+		lines = append(lines, testHash(pw)+":"+strings.Repeat("7", i+1))
+	}
+	// Filler, so that search is not trivial.
+	for i := 0; i < 500; i++ {
+		lines = append(lines, testHash(strings.Repeat("f", i+1))+":1")
+	}
+	sort.Strings(lines)
+
+	data := strings.Join(lines, "\r\n") + "\r\n"
+	c := NewCorpus(strings.NewReader(data), int64(len(data)))
+
+	for _, pw := range breached {
+		found, err := Breached(c, pw)
+		if err != nil {
+			t.Fatalf("lookup error: %s", err.Error())
+		}
+		if !found {
+			t.Errorf("%s should be breached", pw)
+		}
+	}
+
+	for _, pw := range []string{"not-in-corpus", "zz", ""} {
+		if found, _ := Breached(c, pw); found {
+			t.Errorf("%s should not be breached", pw)
+		}
+	}
+
+	// Boundaries
+	first, last := lines[0], lines[len(lines)-1]
+	for _, l := range []string{first, last} {
+		sfx, err := c.Range(l[:PrefixLen])
+		if err != nil || len(sfx) == 0 || sfx[0] != hashOf(l)[PrefixLen:] {
+			t.Errorf("cannot find %s: %v %v", l, sfx, err)
+		}
+	}
+
+	if _, err := c.Range("XYZ"); err != ErrInvalidPrefix {
+		t.Errorf("expected invalid prefix error, got %v", err)
+	}
+}
+
+func TestCheckBreached(t *testing.T) {
+	data := testHash("Summer2020!") + ":42\n"
+	c := NewCorpus(strings.NewReader(data), int64(len(data)))
+
+	p := Policy{CheckBreached: true}
+
+	got, err := p.Check(Input{Password: "Summer2020!"}, c)
+	if err != nil || len(got) != 1 || got[0] != BreachedErrMsg {
+		t.Errorf("expected breached violation, got %v %v", got, err)
+	}
+
+	got, _ = p.Check(Input{Password: "Summer2020!"}, NopCorpus{})
+	if len(got) != 0 {
+		t.Errorf("unexpected violations: %v", got)
+	}
+}
+
+func TestPoliciesFor(t *testing.T) {
+	pp := &Policies{Default: Policy{MinLength: 8, MinScore: 2}, Tenants: map[string]Policy{}}
+
+	err := pp.parseTenants([]byte(`{"acme": {"minLength": 12, "requireSymbol": true}}`))
+	if err != nil {
+		t.Fatalf("parse error: %s", err.Error())
+	}
+
+	p := pp.For("acme")
+	if p.MinLength != 12 || !p.RequireSymbol || p.MinScore != 2 {
+		t.Errorf("unexpected tenant policy: %+v", p)
+	}
+
+	if pp.For("other").MinLength != 8 {
+		t.Error("default policy expected for unknown tenant")
+	}
+
+	var none *Policies
+	if none.For("acme") != DefaultPolicy {
+		t.Error("default policy expected when no policies are loaded")
+	}
+}
+
+func testHash(pw string) string {
+	sum := sha1.Sum([]byte(pw))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
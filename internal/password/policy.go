@@ -0,0 +1,279 @@
+package password
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gitlab.com/mikrowezel/backend/config"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type (
+	// Policy a password has to comply with.
+	// Zero values disable the related check.
+	Policy struct {
+		MinLength int `json:"minLength"`
+		MaxLength int `json:"maxLength"`
+		// Character classes
+		RequireLower  bool `json:"requireLower"`
+		RequireUpper  bool `json:"requireUpper"`
+		RequireDigit  bool `json:"requireDigit"`
+		RequireSymbol bool `json:"requireSymbol"`
+		// MinScore is the minimum strength score, from 0 to 4, see Score.
+		MinScore int `json:"minScore"`
+		// DisallowPersonal rejects passwords containing username or email.
+		DisallowPersonal bool `json:"disallowPersonal"`
+		// History is the number of previous passwords that cannot be reused.
+		History int `json:"history"`
+		// CheckBreached rejects passwords found in the breached corpus.
+		CheckBreached bool `json:"checkBreached"`
+	}
+
+	// Policies holds the default policy and the ones overridden by tenant.
+	Policies struct {
+		Default Policy
+		Tenants map[string]Policy
+	}
+
+	// Input is a password to check along with the user data it is checked against.
+	Input struct {
+		Password string
+		Username string
+		Email    string
+		// History digests of current and previous passwords, most recent first.
+		History []string
+	}
+)
+
+const (
+	// Violations, defined in 'assets/web/embed/i18n/xx.json'
+	TooShortErrMsg     = "password_too_short_err_msg"
+	TooLongErrMsg      = "password_too_long_err_msg"
+	NoLowerErrMsg      = "password_no_lower_err_msg"
+	NoUpperErrMsg      = "password_no_upper_err_msg"
+	NoDigitErrMsg      = "password_no_digit_err_msg"
+	NoSymbolErrMsg     = "password_no_symbol_err_msg"
+	TooWeakErrMsg      = "password_too_weak_err_msg"
+	PersonalInfoErrMsg = "password_personal_info_err_msg"
+	ReusedErrMsg       = "password_reused_err_msg"
+	BreachedErrMsg     = "password_breached_err_msg"
+)
+
+const (
+	// Shorter user values are not checked, they would reject too many passwords.
+	minPersonalTokenLen = 3
+)
+
+// DefaultPolicy matches the validations used before policies were configurable.
+var DefaultPolicy = Policy{
+	MinLength: 8,
+	MaxLength: 32,
+}
+
+// MakePolicy reads the default policy from config.
+// Set envars GRN_APP_PASSWORD_* to change it.
+func MakePolicy(cfg *config.Config) Policy {
+	return Policy{
+		MinLength:        int(cfg.ValAsInt("app.password.min.length", int64(DefaultPolicy.MinLength))),
+		MaxLength:        int(cfg.ValAsInt("app.password.max.length", int64(DefaultPolicy.MaxLength))),
+		RequireLower:     cfg.ValAsBool("app.password.require.lower", false),
+		RequireUpper:     cfg.ValAsBool("app.password.require.upper", false),
+		RequireDigit:     cfg.ValAsBool("app.password.require.digit", false),
+		RequireSymbol:    cfg.ValAsBool("app.password.require.symbol", false),
+		MinScore:         int(cfg.ValAsInt("app.password.min.score", 2)),
+		DisallowPersonal: cfg.ValAsBool("app.password.disallow.personal", true),
+		History:          int(cfg.ValAsInt("app.password.history", 5)),
+		CheckBreached:    cfg.ValAsBool("app.password.check.breached", true),
+	}
+}
+
+// LoadPolicies returns the config default policy along with the tenant ones
+// read from the JSON file at 'app.password.policy.file', if set.
+// The file maps tenant IDs to policies, unset values are taken from the default one:
+//
+//	{"7b1c...": {"minLength": 12, "requireSymbol": true}}
+func LoadPolicies(cfg *config.Config) (*Policies, error) {
+	pp := &Policies{
+		Default: MakePolicy(cfg),
+		Tenants: map[string]Policy{},
+	}
+
+	path := cfg.ValOrDef("app.password.policy.file", "")
+	if path == "" {
+		return pp, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return pp, pp.parseTenants(b)
+}
+
+func (pp *Policies) parseTenants(b []byte) error {
+	raw := map[string]json.RawMessage{}
+
+	err := json.Unmarshal(b, &raw)
+	if err != nil {
+		return fmt.Errorf("invalid password policy file: %s", err.Error())
+	}
+
+	for tenant, r := range raw {
+		p := pp.Default
+		err = json.Unmarshal(r, &p)
+		if err != nil {
+			return fmt.Errorf("invalid password policy for tenant '%s': %s", tenant, err.Error())
+		}
+		pp.Tenants[tenant] = p
+	}
+
+	return nil
+}
+
+// For returns the policy of a tenant, or the default one if it has none.
+func (pp *Policies) For(tenantID string) Policy {
+	if pp == nil {
+		return DefaultPolicy
+	}
+
+	if p, ok := pp.Tenants[tenantID]; ok {
+		return p
+	}
+
+	return pp.Default
+}
+
+// Check returns the violations of the policy found in password,
+// as localizable message IDs.
+// Breached corpus lookup errors are returned along with the violations found,
+// the password is not considered breached then.
+func (p Policy) Check(in Input, c Corpus) (violations []string, err error) {
+	pw := in.Password
+	n := utf8.RuneCountInString(pw)
+
+	if p.MinLength > 0 && n < p.MinLength {
+		violations = append(violations, TooShortErrMsg)
+	}
+
+	if p.MaxLength > 0 && n > p.MaxLength {
+		violations = append(violations, TooLongErrMsg)
+	}
+
+	lower, upper, digit, symbol := classes(pw)
+
+	if p.RequireLower && !lower {
+		violations = append(violations, NoLowerErrMsg)
+	}
+
+	if p.RequireUpper && !upper {
+		violations = append(violations, NoUpperErrMsg)
+	}
+
+	if p.RequireDigit && !digit {
+		violations = append(violations, NoDigitErrMsg)
+	}
+
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, NoSymbolErrMsg)
+	}
+
+	if p.DisallowPersonal && containsPersonal(pw, in.Username, in.Email) {
+		violations = append(violations, PersonalInfoErrMsg)
+	}
+
+	if p.MinScore > 0 && Score(pw, in.Username, in.Email) < p.MinScore {
+		violations = append(violations, TooWeakErrMsg)
+	}
+
+	if p.History > 0 && reused(pw, in.History, p.History) {
+		violations = append(violations, ReusedErrMsg)
+	}
+
+	if p.CheckBreached && c != nil {
+		var found bool
+		found, err = Breached(c, pw)
+		if found {
+			violations = append(violations, BreachedErrMsg)
+		}
+	}
+
+	return violations, err
+}
+
+// classes reports which character classes are present in s.
+func classes(s string) (lower, upper, digit, symbol bool) {
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	return lower, upper, digit, symbol
+}
+
+// containsPersonal returns true if password contains any of the user values,
+// or the local part of an email, ignoring case.
+func containsPersonal(pw string, vals ...string) bool {
+	pw = strings.ToLower(pw)
+
+	for _, t := range personalTokens(vals...) {
+		if strings.Contains(pw, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// personalTokens returns the lowercased user values a password should not contain,
+// emails also contribute their local part.
+func personalTokens(vals ...string) []string {
+	var ts []string
+
+	add := func(v string) {
+		if utf8.RuneCountInString(v) >= minPersonalTokenLen {
+			ts = append(ts, v)
+		}
+	}
+
+	for _, v := range vals {
+		v = strings.ToLower(strings.TrimSpace(v))
+		add(v)
+
+		if i := strings.Index(v, "@"); i > 0 {
+			add(v[:i])
+		}
+	}
+
+	return ts
+}
+
+// reused returns true if password matches any of the latest max digests.
+func reused(pw string, history []string, max int) bool {
+	if len(history) > max {
+		history = history[:max]
+	}
+
+	for _, d := range history {
+		if d == "" {
+			continue
+		}
+
+		if bcrypt.CompareHashAndPassword([]byte(d), []byte(pw)) == nil {
+			return true
+		}
+	}
+
+	return false
+}
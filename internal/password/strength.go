@@ -0,0 +1,236 @@
+package password
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+type (
+	dictWord struct {
+		word   []rune
+		weight float64
+	}
+)
+
+const (
+	// Guesses contributed, as log10, by each matched token.
+	commonWordGuesses = 2.0
+	userInputGuesses  = 1.0
+	yearGuesses       = 2.0
+	relatedGuesses    = 0.30103 // log10(2)
+)
+
+var (
+	// commonWords are among the most used in leaked passwords.
+	// They are matched after leet substitutions, see unleet.
+	commonWords = []string{
+		"password", "passwort", "contraseña", "haslo", "welcome", "letmein", "monkey",
+		"dragon", "master", "admin", "login", "princess", "sunshine", "shadow",
+		"football", "baseball", "soccer", "hockey", "iloveyou", "trustno1",
+		"superman", "batman", "michael", "jessica", "charlie", "freedom",
+		"whatever", "starwars", "summer", "winter", "spring", "autumn", "secret",
+		"hello", "flower", "cookie", "computer", "internet", "qwerty", "azerty",
+		"qwertz", "qazwsx", "zaq1", "abc123", "asdf", "zxcv", "test", "guest",
+		"changeme", "default", "granica",
+	}
+
+	// keyboardRows are used to detect keyboard walks like 'qwerty' or 'asdf'.
+	keyboardRows = []string{
+		"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm",
+	}
+
+	leet = strings.NewReplacer(
+		"0", "o", "1", "l", "3", "e", "4", "a", "5", "s", "7", "t",
+		"@", "a", "$", "s", "!", "i",
+	)
+)
+
+// Score estimates password strength in the manner of zxcvbn:
+// the number of guesses needed to find it is estimated and
+// bucketed using the same thresholds, from 0 (too guessable)
+// to 4 (very unguessable).
+// userInputs, like username or email, are considered known to the attacker.
+func Score(pw string, userInputs ...string) int {
+	g := guessesLog10(pw, userInputs)
+
+	switch {
+	case g < 3:
+		return 0
+	case g < 6:
+		return 1
+	case g < 8:
+		return 2
+	case g < 10:
+		return 3
+	}
+
+	return 4
+}
+
+// guessesLog10 returns the estimated number of guesses as log10.
+// Dictionary tokens and years are matched first, each one counting as a small
+// number of guesses, remaining characters add the log10 of the character set size
+// unless they repeat or follow the previous one (sequences and keyboard walks).
+func guessesLog10(pw string, userInputs []string) float64 {
+	rs := []rune(strings.ToLower(pw))
+	if len(rs) == 0 {
+		return 0
+	}
+
+	norm := []rune(unleet(string(rs)))
+	used := make([]bool, len(rs))
+
+	var g float64
+	for _, w := range dictionary(userInputs) {
+		g += float64(markAll(norm, used, w.word)) * w.weight
+	}
+
+	g += float64(markYears(rs, used)) * yearGuesses
+
+	card := math.Log10(cardinality(pw))
+
+	var prev rune
+	hasPrev := false
+	for i, r := range rs {
+		if used[i] {
+			hasPrev = false
+			continue
+		}
+
+		if hasPrev && related(prev, r) {
+			g += relatedGuesses
+		} else {
+			g += card
+		}
+
+		prev, hasPrev = r, true
+	}
+
+	return g
+}
+
+// dictionary returns user inputs and common words, longest first
+// so that the most specific token wins.
+func dictionary(userInputs []string) []dictWord {
+	var ws []dictWord
+
+	for _, t := range personalTokens(userInputs...) {
+		ws = append(ws, dictWord{word: []rune(unleet(t)), weight: userInputGuesses})
+	}
+
+	for _, w := range commonWords {
+		ws = append(ws, dictWord{word: []rune(unleet(w)), weight: commonWordGuesses})
+	}
+
+	// Insertion sort, the list is short.
+	for i := 1; i < len(ws); i++ {
+		for j := i; j > 0 && len(ws[j].word) > len(ws[j-1].word); j-- {
+			ws[j], ws[j-1] = ws[j-1], ws[j]
+		}
+	}
+
+	return ws
+}
+
+// markAll marks the non overlapping occurrences of w in s not already used
+// and returns how many were found.
+func markAll(s []rune, used []bool, w []rune) int {
+	n := 0
+
+	for i := 0; i+len(w) <= len(s); {
+		if matchAt(s, used, w, i) {
+			for j := range w {
+				used[i+j] = true
+			}
+			n++
+			i += len(w)
+			continue
+		}
+		i++
+	}
+
+	return n
+}
+
+// markYears marks the years, from 1900 to 2099, not already used
+// and returns how many were found.
+func markYears(s []rune, used []bool) int {
+	n := 0
+
+	for i := 0; i+4 <= len(s); {
+		y := s[i : i+4]
+		if !used[i] && !used[i+1] && !used[i+2] && !used[i+3] &&
+			((y[0] == '1' && y[1] == '9') || (y[0] == '2' && y[1] == '0')) &&
+			unicode.IsDigit(y[2]) && unicode.IsDigit(y[3]) {
+			for j := 0; j < 4; j++ {
+				used[i+j] = true
+			}
+			n++
+			i += 4
+			continue
+		}
+		i++
+	}
+
+	return n
+}
+
+func matchAt(s []rune, used []bool, w []rune, i int) bool {
+	for j, r := range w {
+		if used[i+j] || s[i+j] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// unleet reverts common character substitutions, rune count is kept.
+func unleet(s string) string {
+	return leet.Replace(s)
+}
+
+// related returns true if b repeats a or follows it in
+// alphabetical, numerical or keyboard order, in any direction.
+func related(a, b rune) bool {
+	if a == b {
+		return true
+	}
+
+	if (unicode.IsLetter(a) && unicode.IsLetter(b)) || (unicode.IsDigit(a) && unicode.IsDigit(b)) {
+		if a-b == 1 || b-a == 1 {
+			return true
+		}
+	}
+
+	for _, row := range keyboardRows {
+		i := strings.IndexRune(row, a)
+		j := strings.IndexRune(row, b)
+		if i >= 0 && j >= 0 && (i-j == 1 || j-i == 1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cardinality returns the size of the character set used by s.
+func cardinality(s string) float64 {
+	lower, upper, digit, symbol := classes(s)
+
+	var c float64
+	if lower {
+		c += 26
+	}
+	if upper {
+		c += 26
+	}
+	if digit {
+		c += 10
+	}
+	if symbol {
+		c += 33
+	}
+
+	return math.Max(c, 10)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/db"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	logger "gitlab.com/mikrowezel/backend/log"
 )
@@ -33,8 +35,8 @@ func makeAccountRepo(ctx context.Context, cfg *config.Config, log *logger.Logger
 func (ur *AccountRepo) Create(account *model.Account) error {
 	account.SetCreateValues()
 
-	st := `INSERT INTO accounts (id, tenant_id, slug, owner_id, parent_id, account_type, name, email, locale, base_tz, current_tz, starts_at, ends_at, is_active, is_deleted, created_by_id, updated_by_id, created_at, updated_at)
-VALUES (:id, :tenant_id, :slug, :owner_id, :parent_id, :account_type, :name, :email, :locale, :base_tz, :current_tz, :starts_at, :ends_at, :is_active, :is_deleted, :created_by_id, :updated_by_id, :created_at, :updated_at)`
+	st := `INSERT INTO accounts (id, tenant_id, slug, owner_id, parent_id, account_type, name, email, locale, base_tz, current_tz, starts_at, ends_at, is_active, is_deleted, external_id, created_by_id, updated_by_id, created_at, updated_at)
+VALUES (:id, :tenant_id, :slug, :owner_id, :parent_id, :account_type, :name, :email, :locale, :base_tz, :current_tz, :starts_at, :ends_at, :is_active, :is_deleted, :external_id, :created_by_id, :updated_by_id, :created_at, :updated_at)`
 
 	_, err := ur.Tx.NamedExec(st, account)
 
@@ -43,7 +45,8 @@ VALUES (:id, :tenant_id, :slug, :owner_id, :parent_id, :account_type, :name, :em
 
 // GetAll accounts from repo.
 func (ur *AccountRepo) GetAll() (accounts []model.Account, err error) {
-	st := `SELECT * FROM accounts;`
+	st := `SELECT * FROM accounts WHERE %s;`
+	st = fmt.Sprintf(st, notDeleted)
 
 	err = ur.Tx.Select(&accounts, st)
 
@@ -54,10 +57,9 @@ func (ur *AccountRepo) GetAll() (accounts []model.Account, err error) {
 func (ur *AccountRepo) Get(id interface{}) (model.Account, error) {
 	var account model.Account
 
-	st := `SELECT * FROM ACCOUNTS WHERE id = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, id.(string))
+	st := `SELECT * FROM ACCOUNTS WHERE id = $1 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&account, st)
+	err := ur.Tx.Get(&account, st, id.(string))
 
 	return account, err
 }
@@ -66,10 +68,40 @@ func (ur *AccountRepo) Get(id interface{}) (model.Account, error) {
 func (ur *AccountRepo) GetBySlug(slug string) (model.Account, error) {
 	var account model.Account
 
-	st := `SELECT * FROM ACCOUNTS WHERE slug = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, slug)
+	st := `SELECT * FROM ACCOUNTS WHERE slug = $1 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&account, st)
+	err := ur.Tx.Get(&account, st, slug)
+
+	return account, err
+}
+
+// GetAllByType accounts of a tenant from repo, oldest first.
+func (ur *AccountRepo) GetAllByType(tenantID, accountType string) (accounts []model.Account, err error) {
+	st := `SELECT * FROM accounts WHERE tenant_id = $1 AND account_type = $2 AND ` + notDeleted + ` ORDER BY created_at, id;`
+
+	err = ur.Tx.Select(&accounts, st, tenantID, accountType)
+
+	return accounts, err
+}
+
+// GetByType account of a tenant from repo by ID.
+func (ur *AccountRepo) GetByType(tenantID, accountType, id string) (model.Account, error) {
+	var account model.Account
+
+	st := `SELECT * FROM accounts WHERE tenant_id = $1 AND account_type = $2 AND id = $3 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&account, st, tenantID, accountType, id)
+
+	return account, err
+}
+
+// GetByExternalID account from repo by the ID a tenant provisioning client knows it by.
+func (ur *AccountRepo) GetByExternalID(tenantID, externalID string) (model.Account, error) {
+	var account model.Account
+
+	st := `SELECT * FROM accounts WHERE tenant_id = $1 AND external_id = $2 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&account, st, tenantID, externalID)
 
 	return account, err
 }
@@ -118,35 +150,171 @@ func (ur *AccountRepo) Update(account *model.Account) error {
 		pcu = true
 	}
 
+	if !sameTime(account.StartsAt, ref.StartsAt) {
+		st.WriteString(preDelimiter(pcu))
+		st.WriteString(strUpd("starts_at", "starts_at"))
+		pcu = true
+	}
+
+	if !sameTime(account.EndsAt, ref.EndsAt) {
+		st.WriteString(preDelimiter(pcu))
+		st.WriteString(strUpd("ends_at", "ends_at"))
+		// A new end date deserves a new expiry warning.
+		st.WriteString(", expiry_warned_at = NULL")
+		pcu = true
+	}
+
+	if account.ExternalID != ref.ExternalID {
+		st.WriteString(preDelimiter(pcu))
+		st.WriteString(strUpd("external_id", "external_id"))
+		pcu = true
+	}
+
+	if pcu {
+		st.WriteString(auditUpd())
+	}
+
 	st.WriteString(" ")
 	st.WriteString(whereID(ref.ID.String()))
 	st.WriteString(";")
 
 	//fmt.Println(st.String())
 
+	if !pcu {
+		return ErrNoChanges
+	}
+
 	_, err = ur.Tx.NamedExec(st.String(), account)
 
 	return err
 }
 
 // Delete account from repo by ID.
-func (ur *AccountRepo) Delete(id string) error {
-	st := `DELETE FROM ACCOUNTS WHERE id = '%s';`
-	st = fmt.Sprintf(st, id)
+// Accounts are only marked as deleted, see Purge for definitive removal.
+func (ur *AccountRepo) Delete(id, deletedByID string) error {
+	return ur.softDelete("id", id, deletedByID)
+}
 
-	_, err := ur.Tx.Exec(st)
+// DeleteBySlug account from repo by slug.
+func (ur *AccountRepo) DeleteBySlug(slug, deletedByID string) error {
+	return ur.softDelete("slug", slug, deletedByID)
+}
 
-	return err
+// GetAllDeleted accounts from repo.
+func (ur *AccountRepo) GetAllDeleted() (accounts []model.Account, err error) {
+	st := `SELECT * FROM accounts WHERE is_deleted ORDER BY deleted_at DESC;`
+
+	err = ur.Tx.Select(&accounts, st)
+
+	return accounts, err
 }
 
-// DeleteBySlug account from repo by slug.
-func (ur *AccountRepo) DeleteBySlug(slug string) error {
-	st := `DELETE FROM ACCOUNTS WHERE slug = '%s';`
-	st = fmt.Sprintf(st, slug)
+// RestoreBySlug a deleted account.
+func (ur *AccountRepo) RestoreBySlug(slug string) error {
+	st := `UPDATE ACCOUNTS SET is_deleted = FALSE, deleted_by_id = NULL, deleted_at = NULL, updated_at = NOW() WHERE slug = $1 AND is_deleted;`
+
+	return execOne(ur.Tx, st, slug)
+}
 
-	_, err := ur.Tx.Exec(st)
+// Purge accounts deleted before the given time.
+func (ur *AccountRepo) Purge(before time.Time) (int64, error) {
+	st := `DELETE FROM ACCOUNTS WHERE is_deleted AND deleted_at < $1;`
 
-	return err
+	r, err := ur.Tx.Exec(st, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+func (ur *AccountRepo) softDelete(col, val, deletedByID string) error {
+	st := `UPDATE ACCOUNTS SET is_deleted = TRUE, deleted_by_id = $1, deleted_at = NOW() WHERE %s = $2 AND %s;`
+	st = fmt.Sprintf(st, col, notDeleted)
+
+	r, err := ur.Tx.Exec(st, db.ToNullString(deletedByID), val)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// SetActive activates or deactivates an account.
+func (ur *AccountRepo) SetActive(id string, active bool) error {
+	st := `UPDATE accounts SET is_active = $1, updated_at = NOW() WHERE id = $2;`
+
+	r, err := ur.Tx.Exec(st, active, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// GetOwnedBy returns the accounts a user owns.
+func (ur *AccountRepo) GetOwnedBy(userID string) (accounts []model.Account, err error) {
+	st := `SELECT * FROM accounts WHERE owner_id = $1 AND ` + notDeleted + ` ORDER BY created_at, id;`
+
+	err = ur.Tx.Select(&accounts, st, userID)
+
+	return accounts, err
+}
+
+// SwapOwner hands an account from its current owner to another user.
+// It fails if fromID no longer owns the account.
+func (ur *AccountRepo) SwapOwner(id, fromID, toID string) error {
+	st := `UPDATE accounts SET owner_id = $1, updated_at = NOW() WHERE id = $2 AND owner_id = $3 AND ` + notDeleted + `;`
+
+	r, err := ur.Tx.Exec(st, toID, id, fromID)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// GetWithSubscription returns accounts having a subscription window
+// or whose subscription state was already enforced.
+func (ur *AccountRepo) GetWithSubscription() (accounts []model.Account, err error) {
+	st := `SELECT * FROM accounts WHERE (starts_at IS NOT NULL OR ends_at IS NOT NULL OR subscription_status IS NOT NULL) AND ` + notDeleted + ` ORDER BY created_at, id;`
+
+	err = ur.Tx.Select(&accounts, st)
+
+	return accounts, err
+}
+
+// GetByMember returns the accounts a user owns or is member of.
+func (ur *AccountRepo) GetByMember(userID string) (accounts []model.Account, err error) {
+	st := `SELECT * FROM accounts WHERE (owner_id = $1 OR id IN (SELECT account_id FROM account_members WHERE user_id = $1)) AND ` + notDeleted + ` ORDER BY created_at, id;`
+
+	err = ur.Tx.Select(&accounts, st, userID)
+
+	return accounts, err
+}
+
+// SetSubscriptionStatus records the subscription state last enforced on an account.
+func (ur *AccountRepo) SetSubscriptionStatus(id, status string) error {
+	st := `UPDATE accounts SET subscription_status = $1, updated_at = NOW() WHERE id = $2;`
+
+	r, err := ur.Tx.Exec(st, status, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// SetExpiryWarned records that the owner of an account was warned about its expiry.
+func (ur *AccountRepo) SetExpiryWarned(id string) error {
+	st := `UPDATE accounts SET expiry_warned_at = NOW() WHERE id = $1;`
+
+	r, err := ur.Tx.Exec(st, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
 }
 
 // Commit transaction
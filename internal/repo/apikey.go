@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	APIKeyRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeAPIKeyRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *APIKeyRepo {
+	return &APIKeyRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create an API key in repo.
+func (kr *APIKeyRepo) Create(key *model.APIKey) error {
+	st := `INSERT INTO api_keys (id, user_id, account_id, created_by_id, name, prefix, key_digest, scopes, allowed_ips, expires_at, created_at)
+VALUES (:id, :user_id, :account_id, :created_by_id, :name, :prefix, :key_digest, :scopes, :allowed_ips, :expires_at, :created_at)`
+
+	_, err := kr.Tx.NamedExec(st, key)
+
+	return err
+}
+
+// GetActiveByKey returns the non revoked, non expired API key for a key digest.
+func (kr *APIKeyRepo) GetActiveByKey(digest string) (model.APIKey, error) {
+	var key model.APIKey
+
+	st := `SELECT * FROM api_keys WHERE key_digest = $1 AND %s LIMIT 1;`
+	st = fmt.Sprintf(st, activeCredential)
+
+	err := kr.Tx.Get(&key, st, digest)
+
+	return key, err
+}
+
+// GetByUser returns the non revoked API keys of a user, newest first.
+// Expired ones are included so that their owner can see them.
+func (kr *APIKeyRepo) GetByUser(userID string) (keys []model.APIKey, err error) {
+	st := `SELECT * FROM api_keys WHERE user_id = $1 AND revoked_at IS NULL ORDER BY created_at DESC;`
+
+	err = kr.Tx.Select(&keys, st, userID)
+
+	return keys, err
+}
+
+// GetByAccount returns the non revoked API keys of an account, newest first.
+func (kr *APIKeyRepo) GetByAccount(accountID string) (keys []model.APIKey, err error) {
+	st := `SELECT * FROM api_keys WHERE account_id = $1 AND revoked_at IS NULL ORDER BY created_at DESC;`
+
+	err = kr.Tx.Select(&keys, st, accountID)
+
+	return keys, err
+}
+
+// Touch updates API key last use.
+func (kr *APIKeyRepo) Touch(id string, ip sql.NullString) error {
+	st := `UPDATE api_keys SET last_used_at = NOW(), last_used_ip = $2 WHERE id = $1;`
+
+	_, err := kr.Tx.Exec(st, id, ip)
+
+	return err
+}
+
+// RevokeByUser revokes an API key of a user.
+func (kr *APIKeyRepo) RevokeByUser(userID, id string) error {
+	st := `UPDATE api_keys SET revoked_at = NOW() WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL;`
+
+	r, err := kr.Tx.Exec(st, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// RevokeByAccount revokes an API key of an account.
+func (kr *APIKeyRepo) RevokeByAccount(accountID, id string) error {
+	st := `UPDATE api_keys SET revoked_at = NOW() WHERE account_id = $1 AND id = $2 AND revoked_at IS NULL;`
+
+	r, err := kr.Tx.Exec(st, accountID, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Commit transaction
+func (kr *APIKeyRepo) Commit() error {
+	return kr.Tx.Commit()
+}
+
+// activeCredential filters out revoked and expired API keys and client credentials.
+const activeCredential = `revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())`
+
+// Misc
+
+// APIKeyRepo from repo.
+func (r *Repo) APIKeyRepo(tx *sqlx.Tx) *APIKeyRepo {
+	return makeAPIKeyRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// APIKeyRepoNewTx returns an API key repo initialized with a new transaction
+func (r *Repo) APIKeyRepoNewTx() (*APIKeyRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeAPIKeyRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	AuditEventRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeAuditEventRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *AuditEventRepo {
+	return &AuditEventRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create an audit event in repo.
+// Events are append-only, there is no update nor delete.
+func (ar *AuditEventRepo) Create(event *model.AuditEvent) error {
+	event.SetCreateValues()
+
+	st := `INSERT INTO audit_events (id, tenant_id, actor_id, target_type, target_id, action, ip, user_agent, request_id, metadata, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::jsonb, $11);`
+
+	_, err := ar.Tx.Exec(st, event.ID, event.TenantID, event.ActorID, event.TargetType, event.TargetID,
+		event.Action, event.IP, event.UserAgent, event.RequestID, jsonOrNull(event.Metadata), event.CreatedAt)
+
+	return err
+}
+
+// GetAll audit events matching filter, newest first.
+func (ar *AuditEventRepo) GetAll(filter model.AuditFilter) (events []model.AuditEvent, err error) {
+	var where []string
+	var args []interface{}
+
+	add := func(cond string, val interface{}) {
+		args = append(args, val)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.ActorID != "" {
+		add("actor_id::text = $%d", filter.ActorID)
+	}
+
+	if filter.TargetType != "" {
+		add("target_type = $%d", filter.TargetType)
+	}
+
+	if filter.TargetID != "" {
+		add("target_id = $%d", filter.TargetID)
+	}
+
+	if filter.Action != "" {
+		add("action LIKE $%d", escapeLike(filter.Action)+"%")
+	}
+
+	if !filter.From.IsZero() {
+		add("created_at >= $%d", filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		add("created_at < $%d", filter.To)
+	}
+
+	var st strings.Builder
+	st.WriteString("SELECT * FROM audit_events")
+
+	if len(where) > 0 {
+		st.WriteString(" WHERE ")
+		st.WriteString(strings.Join(where, " AND "))
+	}
+
+	st.WriteString(" ORDER BY created_at DESC")
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		st.WriteString(fmt.Sprintf(" LIMIT $%d", len(args)))
+	}
+
+	st.WriteString(";")
+
+	err = ar.Tx.Select(&events, st.String(), args...)
+
+	return events, err
+}
+
+// Commit transaction
+func (ar *AuditEventRepo) Commit() error {
+	return ar.Tx.Commit()
+}
+
+// jsonOrNull returns a value suitable for a JSONB parameter.
+func jsonOrNull(val []byte) interface{} {
+	if len(val) == 0 {
+		return nil
+	}
+	return string(val)
+}
+
+// Misc
+
+// AuditEventRepo from repo.
+func (r *Repo) AuditEventRepo(tx *sqlx.Tx) *AuditEventRepo {
+	return makeAuditEventRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// AuditEventRepoNewTx returns an audit event repo initialized with a new transaction
+func (r *Repo) AuditEventRepoNewTx() (*AuditEventRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeAuditEventRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	ClientCredentialRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeClientCredentialRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *ClientCredentialRepo {
+	return &ClientCredentialRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create a client credential in repo.
+func (cr *ClientCredentialRepo) Create(c *model.ClientCredential) error {
+	st := `INSERT INTO client_credentials (id, user_id, created_by_id, kind, secret_digest, public_key, key_id, scopes, expires_at, created_at)
+VALUES (:id, :user_id, :created_by_id, :kind, :secret_digest, :public_key, :key_id, :scopes, :expires_at, :created_at)`
+
+	_, err := cr.Tx.NamedExec(st, c)
+
+	return err
+}
+
+// GetActiveByUser returns the non revoked, non expired credentials of a service account.
+func (cr *ClientCredentialRepo) GetActiveByUser(userID string) (creds []model.ClientCredential, err error) {
+	st := `SELECT * FROM client_credentials WHERE user_id = $1 AND %s ORDER BY created_at DESC;`
+	st = fmt.Sprintf(st, activeCredential)
+
+	err = cr.Tx.Select(&creds, st, userID)
+
+	return creds, err
+}
+
+// GetByUser returns the non revoked credentials of a service account, newest first.
+// Expired ones are included so that their owner can see them.
+func (cr *ClientCredentialRepo) GetByUser(userID string) (creds []model.ClientCredential, err error) {
+	st := `SELECT * FROM client_credentials WHERE user_id = $1 AND revoked_at IS NULL ORDER BY created_at DESC;`
+
+	err = cr.Tx.Select(&creds, st, userID)
+
+	return creds, err
+}
+
+// Touch updates credential last use.
+func (cr *ClientCredentialRepo) Touch(id string, ip sql.NullString) error {
+	st := `UPDATE client_credentials SET last_used_at = NOW(), last_used_ip = $2 WHERE id = $1;`
+
+	_, err := cr.Tx.Exec(st, id, ip)
+
+	return err
+}
+
+// RevokeByUser revokes a credential of a service account.
+func (cr *ClientCredentialRepo) RevokeByUser(userID, id string) error {
+	st := `UPDATE client_credentials SET revoked_at = NOW() WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL;`
+
+	r, err := cr.Tx.Exec(st, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// RevokeAllByUser revokes all the credentials of a service account.
+func (cr *ClientCredentialRepo) RevokeAllByUser(userID string) error {
+	st := `UPDATE client_credentials SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL;`
+
+	_, err := cr.Tx.Exec(st, userID)
+
+	return err
+}
+
+// Commit transaction
+func (cr *ClientCredentialRepo) Commit() error {
+	return cr.Tx.Commit()
+}
+
+// Misc
+
+// ClientCredentialRepo from repo.
+func (r *Repo) ClientCredentialRepo(tx *sqlx.Tx) *ClientCredentialRepo {
+	return makeClientCredentialRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// ClientCredentialRepoNewTx returns a client credential repo initialized with a new transaction
+func (r *Repo) ClientCredentialRepoNewTx() (*ClientCredentialRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeClientCredentialRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	EmailChangeRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeEmailChangeRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *EmailChangeRepo {
+	return &EmailChangeRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create an email change in repo.
+func (er *EmailChangeRepo) Create(ec *model.EmailChange) error {
+	st := `INSERT INTO user_email_changes (id, user_id, old_email, new_email, confirm_digest, cancel_digest, expires_at, cancel_until, created_at)
+VALUES (:id, :user_id, :old_email, :new_email, :confirm_digest, :cancel_digest, :expires_at, :cancel_until, :created_at)`
+
+	_, err := er.Tx.NamedExec(st, ec)
+
+	return err
+}
+
+// CancelPending cancels the not yet confirmed changes of a user.
+// Only the latest requested change can be confirmed.
+func (er *EmailChangeRepo) CancelPending(userID string) (int64, error) {
+	st := `UPDATE user_email_changes SET cancelled_at = NOW()
+WHERE user_id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL;`
+
+	r, err := er.Tx.Exec(st, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+// Confirm a pending, non expired, change.
+func (er *EmailChangeRepo) Confirm(userID, digest string) (model.EmailChange, error) {
+	var ec model.EmailChange
+
+	st := `UPDATE user_email_changes SET confirmed_at = NOW()
+WHERE user_id = $1 AND confirm_digest = $2 AND confirmed_at IS NULL AND cancelled_at IS NULL AND expires_at > NOW()
+RETURNING *;`
+
+	err := er.Tx.Get(&ec, st, userID, digest)
+
+	return ec, err
+}
+
+// Cancel a change, confirmed or not, while its cancel window is open.
+func (er *EmailChangeRepo) Cancel(userID, digest string) (model.EmailChange, error) {
+	var ec model.EmailChange
+
+	st := `UPDATE user_email_changes SET cancelled_at = NOW()
+WHERE user_id = $1 AND cancel_digest = $2 AND cancelled_at IS NULL AND cancel_until > NOW()
+RETURNING *;`
+
+	err := er.Tx.Get(&ec, st, userID, digest)
+
+	return ec, err
+}
+
+// Commit transaction
+func (er *EmailChangeRepo) Commit() error {
+	return er.Tx.Commit()
+}
+
+// Misc
+
+// EmailChangeRepo from repo.
+func (r *Repo) EmailChangeRepo(tx *sqlx.Tx) *EmailChangeRepo {
+	return makeEmailChangeRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// EmailChangeRepoNewTx returns an email change repo initialized with a new transaction
+func (r *Repo) EmailChangeRepoNewTx() (*EmailChangeRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeEmailChangeRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
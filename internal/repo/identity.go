@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	IdentityRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeIdentityRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *IdentityRepo {
+	return &IdentityRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create an identity in repo.
+func (ir *IdentityRepo) Create(i *model.Identity) error {
+	st := `INSERT INTO user_identities (id, user_id, provider, subject, email, provisioned, last_used_at, created_at)
+VALUES (:id, :user_id, :provider, :subject, :email, :provisioned, :last_used_at, :created_at)`
+
+	_, err := ir.Tx.NamedExec(st, i)
+
+	return err
+}
+
+// GetBySubject returns the identity a provider knows by subject.
+func (ir *IdentityRepo) GetBySubject(provider, subject string) (model.Identity, error) {
+	var i model.Identity
+
+	st := `SELECT * FROM user_identities WHERE provider = $1 AND subject = $2;`
+
+	err := ir.Tx.Get(&i, st, provider, subject)
+
+	return i, err
+}
+
+// GetByUser returns the identities linked to a user.
+func (ir *IdentityRepo) GetByUser(userID string) ([]model.Identity, error) {
+	var is []model.Identity
+
+	st := `SELECT * FROM user_identities WHERE user_id = $1 ORDER BY created_at;`
+
+	err := ir.Tx.Select(&is, st, userID)
+
+	return is, err
+}
+
+// GetByProvider returns all identities of a provider.
+func (ir *IdentityRepo) GetByProvider(provider string) ([]model.Identity, error) {
+	var is []model.Identity
+
+	st := `SELECT * FROM user_identities WHERE provider = $1;`
+
+	err := ir.Tx.Select(&is, st, provider)
+
+	return is, err
+}
+
+// Touch records an identity use.
+func (ir *IdentityRepo) Touch(id, email string) error {
+	st := `UPDATE user_identities SET last_used_at = NOW(), email = $1 WHERE id = $2;`
+
+	r, err := ir.Tx.Exec(st, email, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Delete unlinks a provider from a user.
+func (ir *IdentityRepo) Delete(userID, provider string) error {
+	st := `DELETE FROM user_identities WHERE user_id = $1 AND provider = $2;`
+
+	r, err := ir.Tx.Exec(st, userID, provider)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Commit transaction
+func (ir *IdentityRepo) Commit() error {
+	return ir.Tx.Commit()
+}
+
+// Misc
+
+// IdentityRepo from repo.
+func (r *Repo) IdentityRepo(tx *sqlx.Tx) *IdentityRepo {
+	return makeIdentityRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// IdentityRepoNewTx returns an identity repo initialized with a new transaction
+func (r *Repo) IdentityRepoNewTx() (*IdentityRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeIdentityRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	MagicLinkRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeMagicLinkRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *MagicLinkRepo {
+	return &MagicLinkRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create a magic link in repo.
+func (mr *MagicLinkRepo) Create(ml *model.MagicLink) error {
+	st := `INSERT INTO user_magic_links (id, user_id, token_digest, binding_digest, ip, user_agent, expires_at, created_at)
+VALUES (:id, :user_id, :token_digest, :binding_digest, :ip, :user_agent, :expires_at, :created_at)`
+
+	_, err := mr.Tx.NamedExec(st, ml)
+
+	return err
+}
+
+// ExpirePending expires the not yet used links of a user.
+// Only the latest requested link can be used.
+func (mr *MagicLinkRepo) ExpirePending(userID string) (int64, error) {
+	st := `UPDATE user_magic_links SET expires_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL AND expires_at > NOW();`
+
+	r, err := mr.Tx.Exec(st, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+// GetUsable returns a non used, non expired, link.
+// The row is locked until the transaction ends.
+func (mr *MagicLinkRepo) GetUsable(userID, digest string) (model.MagicLink, error) {
+	var ml model.MagicLink
+
+	st := `SELECT * FROM user_magic_links
+WHERE user_id = $1 AND token_digest = $2 AND used_at IS NULL AND expires_at > NOW()
+FOR UPDATE;`
+
+	err := mr.Tx.Get(&ml, st, userID, digest)
+
+	return ml, err
+}
+
+// Use marks a link as used, it cannot be used again.
+func (mr *MagicLinkRepo) Use(id string) error {
+	st := `UPDATE user_magic_links SET used_at = NOW() WHERE id = $1 AND used_at IS NULL;`
+
+	r, err := mr.Tx.Exec(st, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Commit transaction
+func (mr *MagicLinkRepo) Commit() error {
+	return mr.Tx.Commit()
+}
+
+// Misc
+
+// MagicLinkRepo from repo.
+func (r *Repo) MagicLinkRepo(tx *sqlx.Tx) *MagicLinkRepo {
+	return makeMagicLinkRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// MagicLinkRepoNewTx returns a magic link repo initialized with a new transaction
+func (r *Repo) MagicLinkRepoNewTx() (*MagicLinkRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeMagicLinkRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
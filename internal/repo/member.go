@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	MemberRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeMemberRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *MemberRepo {
+	return &MemberRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Add a user to an account, adding an existing member is a no-op.
+func (mr *MemberRepo) Add(accountID, userID string) error {
+	m := model.Member{AccountID: accountID, UserID: userID}
+	m.SetCreateValues()
+
+	st := `INSERT INTO account_members (account_id, user_id, created_at)
+VALUES (:account_id, :user_id, :created_at)
+ON CONFLICT (account_id, user_id) DO NOTHING;`
+
+	_, err := mr.Tx.NamedExec(st, &m)
+
+	return err
+}
+
+// Remove a user from an account.
+func (mr *MemberRepo) Remove(accountID, userID string) error {
+	st := `DELETE FROM account_members WHERE account_id = $1 AND user_id = $2;`
+
+	_, err := mr.Tx.Exec(st, accountID, userID)
+
+	return err
+}
+
+// Replace makes userIDs the members of an account.
+func (mr *MemberRepo) Replace(accountID string, userIDs []string) error {
+	if userIDs == nil {
+		// A nil array is sent as NULL and would match nothing.
+		userIDs = []string{}
+	}
+
+	st := `DELETE FROM account_members WHERE account_id = $1 AND NOT (user_id::text = ANY($2));`
+
+	_, err := mr.Tx.Exec(st, accountID, pq.Array(userIDs))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range userIDs {
+		err = mr.Add(accountID, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByAccount returns the members of an account, oldest first.
+func (mr *MemberRepo) GetByAccount(accountID string) ([]model.Member, error) {
+	var ms []model.Member
+
+	st := `SELECT * FROM account_members WHERE account_id = $1 ORDER BY created_at, user_id;`
+
+	err := mr.Tx.Select(&ms, st, accountID)
+
+	return ms, err
+}
+
+// GetByUser returns the accounts a user is member of.
+func (mr *MemberRepo) GetByUser(userID string) ([]model.Member, error) {
+	var ms []model.Member
+
+	st := `SELECT * FROM account_members WHERE user_id = $1 ORDER BY created_at, account_id;`
+
+	err := mr.Tx.Select(&ms, st, userID)
+
+	return ms, err
+}
+
+// IsMember returns true if a user belongs to an account.
+func (mr *MemberRepo) IsMember(accountID, userID string) (bool, error) {
+	var ok bool
+
+	st := `SELECT EXISTS (SELECT 1 FROM account_members WHERE account_id = $1 AND user_id = $2);`
+
+	err := mr.Tx.Get(&ok, st, accountID, userID)
+
+	return ok, err
+}
+
+// Commit transaction
+func (mr *MemberRepo) Commit() error {
+	return mr.Tx.Commit()
+}
+
+// Misc
+
+// MemberRepo from repo.
+func (r *Repo) MemberRepo(tx *sqlx.Tx) *MemberRepo {
+	return makeMemberRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// MemberRepoNewTx returns a member repo initialized with a new transaction
+func (r *Repo) MemberRepoNewTx() (*MemberRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeMemberRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
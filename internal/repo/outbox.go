@@ -0,0 +1,187 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	OutboxRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeOutboxRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *OutboxRepo {
+	return &OutboxRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Enqueue an email in the outbox.
+// Use the transaction of the change that originates the email
+// so that both are committed, or discarded, together.
+func (ob *OutboxRepo) Enqueue(oe *model.OutboxEmail) error {
+	oe.SetCreateValues()
+
+	st := `INSERT INTO email_outbox (id, user_id, kind, sender_name, sender_email, recipient, cc, bcc, subject, html_body, text_body, charset, status, attempts, next_attempt_at, created_at, updated_at)
+VALUES (:id, :user_id, :kind, :sender_name, :sender_email, :recipient, :cc, :bcc, :subject, :html_body, :text_body, :charset, :status, :attempts, :next_attempt_at, :created_at, :updated_at)`
+
+	_, err := ob.Tx.NamedExec(st, oe)
+
+	return err
+}
+
+// Claim due emails for delivery.
+// Claimed emails are marked as sending and their attempt counter incremented.
+// lease is how long they are reserved, if not marked as sent, retried or dead
+// before that, i.e. the dispatcher stopped while sending, they are claimed again.
+func (ob *OutboxRepo) Claim(limit int, lease time.Duration) (emails []model.OutboxEmail, err error) {
+	st := `UPDATE email_outbox SET status = $1, attempts = attempts + 1, next_attempt_at = $2, updated_at = NOW()
+WHERE id IN (
+	SELECT id FROM email_outbox
+	WHERE status IN ($3, $1) AND next_attempt_at <= NOW()
+	ORDER BY next_attempt_at
+	LIMIT $4
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *;`
+
+	err = ob.Tx.Select(&emails, st, model.OutboxSending, time.Now().Add(lease), model.OutboxPending, limit)
+
+	return emails, err
+}
+
+// MarkSent sets an email as delivered.
+func (ob *OutboxRepo) MarkSent(id string) error {
+	st := `UPDATE email_outbox SET status = $1, sent_at = NOW(), last_error = NULL, updated_at = NOW() WHERE id = $2;`
+
+	r, err := ob.Tx.Exec(st, model.OutboxSent, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// MarkRetry sets an email as pending for a new attempt at next.
+func (ob *OutboxRepo) MarkRetry(id string, next time.Time, lastErr string) error {
+	st := `UPDATE email_outbox SET status = $1, next_attempt_at = $2, last_error = $3, updated_at = NOW() WHERE id = $4;`
+
+	r, err := ob.Tx.Exec(st, model.OutboxPending, next, lastErr, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// MarkDead moves an email to the dead-letter state,
+// no more delivery attempts are made unless it is requeued.
+func (ob *OutboxRepo) MarkDead(id string, lastErr string) error {
+	st := `UPDATE email_outbox SET status = $1, last_error = $2, updated_at = NOW() WHERE id = $3;`
+
+	r, err := ob.Tx.Exec(st, model.OutboxDead, lastErr, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Requeue a dead email resetting its attempt counter.
+func (ob *OutboxRepo) Requeue(id string) (model.OutboxEmail, error) {
+	var oe model.OutboxEmail
+
+	st := `UPDATE email_outbox SET status = $1, attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+WHERE id = $2 AND status = $3
+RETURNING *;`
+
+	err := ob.Tx.Get(&oe, st, model.OutboxPending, id, model.OutboxDead)
+
+	return oe, err
+}
+
+// Get an outbox email by ID.
+func (ob *OutboxRepo) Get(id string) (model.OutboxEmail, error) {
+	var oe model.OutboxEmail
+
+	st := `SELECT * FROM email_outbox WHERE id = $1 LIMIT 1;`
+
+	err := ob.Tx.Get(&oe, st, id)
+
+	return oe, err
+}
+
+// GetAll outbox emails matching filter, newest first.
+func (ob *OutboxRepo) GetAll(filter model.OutboxFilter) (emails []model.OutboxEmail, err error) {
+	var where []string
+	var args []interface{}
+
+	add := func(cond string, val interface{}) {
+		args = append(args, val)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Status != "" {
+		add("status = $%d", filter.Status)
+	}
+
+	if filter.Recipient != "" {
+		add("recipient LIKE $%d", escapeLike(filter.Recipient)+"%")
+	}
+
+	var st strings.Builder
+	st.WriteString("SELECT * FROM email_outbox")
+
+	if len(where) > 0 {
+		st.WriteString(" WHERE ")
+		st.WriteString(strings.Join(where, " AND "))
+	}
+
+	st.WriteString(" ORDER BY created_at DESC")
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		st.WriteString(fmt.Sprintf(" LIMIT $%d", len(args)))
+	}
+
+	st.WriteString(";")
+
+	err = ob.Tx.Select(&emails, st.String(), args...)
+
+	return emails, err
+}
+
+// Commit transaction
+func (ob *OutboxRepo) Commit() error {
+	return ob.Tx.Commit()
+}
+
+// Misc
+
+// OutboxRepo from repo.
+func (r *Repo) OutboxRepo(tx *sqlx.Tx) *OutboxRepo {
+	return makeOutboxRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// OutboxRepoNewTx returns an outbox repo initialized with a new transaction
+func (r *Repo) OutboxRepoNewTx() (*OutboxRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeOutboxRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
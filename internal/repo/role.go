@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	RoleRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeRoleRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *RoleRepo {
+	return &RoleRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Grant a role, granting an already held one is a no-op.
+func (rr *RoleRepo) Grant(r *model.Role) error {
+	st := `INSERT INTO user_roles (id, user_id, name, source, created_at)
+VALUES (:id, :user_id, :name, :source, :created_at)
+ON CONFLICT (user_id, name) DO NOTHING;`
+
+	_, err := rr.Tx.NamedExec(st, r)
+
+	return err
+}
+
+// Revoke a role.
+func (rr *RoleRepo) Revoke(userID, name string) error {
+	st := `DELETE FROM user_roles WHERE user_id = $1 AND name = $2;`
+
+	r, err := rr.Tx.Exec(st, userID, name)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// GetByUser returns the roles of a user.
+func (rr *RoleRepo) GetByUser(userID string) ([]model.Role, error) {
+	var rs []model.Role
+
+	st := `SELECT * FROM user_roles WHERE user_id = $1 ORDER BY name;`
+
+	err := rr.Tx.Select(&rs, st, userID)
+
+	return rs, err
+}
+
+// GetByUsers returns the roles of several users.
+func (rr *RoleRepo) GetByUsers(userIDs []string) ([]model.Role, error) {
+	var rs []model.Role
+
+	st := `SELECT * FROM user_roles WHERE user_id::text = ANY($1) ORDER BY name;`
+
+	err := rr.Tx.Select(&rs, st, pq.Array(userIDs))
+
+	return rs, err
+}
+
+// Has returns true if a user holds the role, whatever its source.
+func (rr *RoleRepo) Has(userID, name string) (bool, error) {
+	var has bool
+
+	st := `SELECT EXISTS (SELECT 1 FROM user_roles WHERE user_id = $1 AND name = $2);`
+
+	err := rr.Tx.Get(&has, st, userID, name)
+
+	return has, err
+}
+
+// ReplaceFromSource makes names the roles a user holds from source.
+// Roles granted by other sources are kept.
+// It returns true if any role has been granted or revoked.
+func (rr *RoleRepo) ReplaceFromSource(userID, source string, names []string) (changed bool, err error) {
+	if names == nil {
+		// A nil array is sent as NULL and would match nothing.
+		names = []string{}
+	}
+
+	st := `DELETE FROM user_roles WHERE user_id = $1 AND source = $2 AND NOT (name = ANY($3));`
+
+	r, err := rr.Tx.Exec(st, userID, source, pq.Array(names))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	changed = n > 0
+
+	for _, name := range names {
+		role := model.Role{UserID: userID, Name: name, Source: source}
+		role.SetCreateValues()
+
+		st = `INSERT INTO user_roles (id, user_id, name, source, created_at)
+VALUES (:id, :user_id, :name, :source, :created_at)
+ON CONFLICT (user_id, name) DO NOTHING;`
+
+		r, err = rr.Tx.NamedExec(st, &role)
+		if err != nil {
+			return changed, err
+		}
+
+		n, err = r.RowsAffected()
+		if err != nil {
+			return changed, err
+		}
+		changed = changed || n > 0
+	}
+
+	return changed, nil
+}
+
+// Commit transaction
+func (rr *RoleRepo) Commit() error {
+	return rr.Tx.Commit()
+}
+
+// Misc
+
+// RoleRepo from repo.
+func (r *Repo) RoleRepo(tx *sqlx.Tx) *RoleRepo {
+	return makeRoleRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// RoleRepoNewTx returns a role repo initialized with a new transaction
+func (r *Repo) RoleRepoNewTx() (*RoleRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeRoleRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	SessionRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeSessionRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *SessionRepo {
+	return &SessionRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create a session in repo.
+func (sr *SessionRepo) Create(session *model.Session) error {
+	st := `INSERT INTO user_sessions (id, user_id, token_digest, ip, user_agent, geolocation, country, risk_score, risk_reasons, verification_digest, impersonator_id, last_seen_at, expires_at, created_at)
+VALUES (:id, :user_id, :token_digest, :ip, :user_agent, :geolocation, :country, :risk_score, :risk_reasons, :verification_digest, :impersonator_id, :last_seen_at, :expires_at, :created_at)`
+
+	_, err := sr.Tx.NamedExec(st, session)
+
+	return err
+}
+
+// GetActiveByToken returns the non revoked, non expired session for a token digest.
+func (sr *SessionRepo) GetActiveByToken(digest string) (model.Session, error) {
+	var session model.Session
+
+	st := `SELECT * FROM user_sessions WHERE token_digest = $1 AND %s LIMIT 1;`
+	st = fmt.Sprintf(st, activeSession)
+
+	err := sr.Tx.Get(&session, st, digest)
+
+	return session, err
+}
+
+// GetActiveByUser returns all active sessions for a user, most recently used first.
+func (sr *SessionRepo) GetActiveByUser(userID string) (sessions []model.Session, err error) {
+	st := `SELECT * FROM user_sessions WHERE user_id = $1 AND %s ORDER BY last_seen_at DESC;`
+	st = fmt.Sprintf(st, activeSession)
+
+	err = sr.Tx.Select(&sessions, st, userID)
+
+	return sessions, err
+}
+
+// GetRecentByUser returns the latest sign-ins of a user, including ended sessions.
+func (sr *SessionRepo) GetRecentByUser(userID string, limit int) (sessions []model.Session, err error) {
+	st := `SELECT * FROM user_sessions WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2;`
+
+	err = sr.Tx.Select(&sessions, st, userID, limit)
+
+	return sessions, err
+}
+
+// Touch updates session last seen time.
+func (sr *SessionRepo) Touch(id string) error {
+	st := `UPDATE user_sessions SET last_seen_at = NOW() WHERE id = $1;`
+
+	_, err := sr.Tx.Exec(st, id)
+
+	return err
+}
+
+// Revoke a user session.
+func (sr *SessionRepo) Revoke(userID, id string) error {
+	st := `UPDATE user_sessions SET revoked_at = NOW() WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL;`
+
+	r, err := sr.Tx.Exec(st, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// RevokeAll active sessions of a user except the ones listed in keep.
+func (sr *SessionRepo) RevokeAll(userID string, keep ...string) (int64, error) {
+	st := `UPDATE user_sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL AND NOT (id::text = ANY($2));`
+
+	r, err := sr.Tx.Exec(st, userID, pq.Array(keep))
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+// Verify a sign-in waiting for verification, making its session active.
+func (sr *SessionRepo) Verify(userID, digest string) (model.Session, error) {
+	var session model.Session
+
+	st := `UPDATE user_sessions SET verification_digest = NULL, verified_at = NOW()
+WHERE user_id = $1 AND verification_digest = $2 AND revoked_at IS NULL AND expires_at > NOW()
+RETURNING *;`
+
+	err := sr.Tx.Get(&session, st, userID, digest)
+
+	return session, err
+}
+
+// Commit transaction
+func (sr *SessionRepo) Commit() error {
+	return sr.Tx.Commit()
+}
+
+// activeSession filters out revoked, expired and not yet verified sessions.
+const activeSession = `revoked_at IS NULL AND verification_digest IS NULL AND (expires_at IS NULL OR expires_at > NOW())`
+
+// Misc
+
+// SessionRepo from repo.
+func (r *Repo) SessionRepo(tx *sqlx.Tx) *SessionRepo {
+	return makeSessionRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// SessionRepoNewTx returns a session repo initialized with a new transaction
+func (r *Repo) SessionRepoNewTx() (*SessionRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeSessionRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
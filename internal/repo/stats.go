@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	// StatsRepo runs the aggregate queries behind the admin dashboard.
+	StatsRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeStatsRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *StatsRepo {
+	return &StatsRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Totals counts users by state and accounts not deleted.
+func (sr *StatsRepo) Totals() (model.Totals, error) {
+	var t model.Totals
+
+	st := `SELECT COUNT(*) AS users,
+COUNT(*) FILTER (WHERE is_confirmed AND is_deleted IS NOT TRUE) AS confirmed,
+COUNT(*) FILTER (WHERE is_active = FALSE AND is_deleted IS NOT TRUE) AS inactive,
+COUNT(*) FILTER (WHERE is_deleted) AS deleted,
+(SELECT COUNT(*) FROM accounts WHERE is_deleted IS NOT TRUE) AS accounts
+FROM users WHERE user_type = $1;`
+
+	err := sr.Tx.Get(&t, st, model.UserTypeHuman)
+
+	return t, err
+}
+
+// CountByDay counts the audit events of each action since from, grouped by UTC day.
+// Days without events are not returned.
+func (sr *StatsRepo) CountByDay(actions []string, from time.Time) (counts []model.DailyCount, err error) {
+	st := `SELECT date_trunc('day', created_at AT TIME ZONE 'UTC') AS day, action, COUNT(*) AS count
+FROM audit_events WHERE action = ANY($1) AND created_at >= $2
+GROUP BY day, action ORDER BY day;`
+
+	err = sr.Tx.Select(&counts, st, pq.Array(actions), from)
+
+	return counts, err
+}
+
+// Commit transaction
+func (sr *StatsRepo) Commit() error {
+	return sr.Tx.Commit()
+}
+
+// Misc
+
+// StatsRepo from repo.
+func (r *Repo) StatsRepo(tx *sqlx.Tx) *StatsRepo {
+	return makeStatsRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// StatsRepoNewTx returns a stats repo initialized with a new transaction
+func (r *Repo) StatsRepoNewTx() (*StatsRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeStatsRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
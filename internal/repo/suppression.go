@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	SuppressionRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+func makeSuppressionRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *SuppressionRepo {
+	return &SuppressionRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Add a suppression to repo.
+// If the address is already suppressed its details are refreshed
+// and its occurrences counter incremented.
+func (sr *SuppressionRepo) Add(sp *model.Suppression) error {
+	sp.SetCreateValues()
+
+	st := `INSERT INTO email_suppressions (id, email, reason, kind, source, feedback_id, diagnostic, occurrences, created_at, updated_at)
+VALUES (:id, :email, :reason, :kind, :source, :feedback_id, :diagnostic, :occurrences, :created_at, :updated_at)
+ON CONFLICT (email) DO UPDATE SET
+	reason = EXCLUDED.reason,
+	kind = EXCLUDED.kind,
+	source = EXCLUDED.source,
+	feedback_id = EXCLUDED.feedback_id,
+	diagnostic = EXCLUDED.diagnostic,
+	occurrences = email_suppressions.occurrences + 1,
+	updated_at = EXCLUDED.updated_at;`
+
+	_, err := sr.Tx.NamedExec(st, sp)
+
+	return err
+}
+
+// GetByEmail returns the suppression for an address.
+func (sr *SuppressionRepo) GetByEmail(email string) (model.Suppression, error) {
+	var sp model.Suppression
+
+	st := `SELECT * FROM email_suppressions WHERE email = $1 LIMIT 1;`
+
+	err := sr.Tx.Get(&sp, st, normEmail(email))
+
+	return sp, err
+}
+
+// Commit transaction
+func (sr *SuppressionRepo) Commit() error {
+	return sr.Tx.Commit()
+}
+
+func normEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Misc
+
+// SuppressionRepo from repo.
+func (r *Repo) SuppressionRepo(tx *sqlx.Tx) *SuppressionRepo {
+	return makeSuppressionRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// SuppressionRepoNewTx returns a suppression repo initialized with a new transaction
+func (r *Repo) SuppressionRepoNewTx() (*SuppressionRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeSuppressionRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
+
+// IsSuppressed returns true if emails must not be sent to address.
+func (r *Repo) IsSuppressed(email string) (bool, error) {
+	var n int
+
+	st := `SELECT COUNT(*) FROM email_suppressions WHERE email = $1;`
+
+	err := r.Conn.Get(&n, st, normEmail(email))
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	logger "gitlab.com/mikrowezel/backend/log"
+)
+
+type (
+	TransferRepo struct {
+		ctx context.Context
+		cfg *config.Config
+		log *logger.Logger
+		Tx  *sqlx.Tx
+	}
+)
+
+const (
+	openTransfer = "completed_at IS NULL AND cancelled_at IS NULL"
+)
+
+func makeTransferRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *TransferRepo {
+	return &TransferRepo{
+		ctx: ctx,
+		cfg: cfg,
+		log: log,
+		Tx:  tx,
+	}
+}
+
+// Create an account transfer in repo.
+func (tr *TransferRepo) Create(t *model.Transfer) error {
+	st := `INSERT INTO account_transfers (id, account_id, from_id, to_id, initiated_by_id, confirm_digest, expires_at, created_at)
+VALUES (:id, :account_id, :from_id, :to_id, :initiated_by_id, :confirm_digest, :expires_at, :created_at)`
+
+	_, err := tr.Tx.NamedExec(st, t)
+
+	return err
+}
+
+// GetOpen returns the transfer of an account that is neither completed nor cancelled.
+func (tr *TransferRepo) GetOpen(accountID string) (model.Transfer, error) {
+	var t model.Transfer
+
+	st := `SELECT * FROM account_transfers WHERE account_id = $1 AND ` + openTransfer + ` ORDER BY created_at DESC LIMIT 1;`
+
+	err := tr.Tx.Get(&t, st, accountID)
+
+	return t, err
+}
+
+// GetAwaitingApproval returns the confirmed transfers not yet approved, oldest first.
+func (tr *TransferRepo) GetAwaitingApproval() (ts []model.Transfer, err error) {
+	st := `SELECT * FROM account_transfers WHERE confirmed_at IS NOT NULL AND approved_at IS NULL AND ` + openTransfer + ` ORDER BY confirmed_at, id;`
+
+	err = tr.Tx.Select(&ts, st)
+
+	return ts, err
+}
+
+// CancelOpen cancels the open transfers of an account.
+// Only the latest initiated transfer can be completed.
+func (tr *TransferRepo) CancelOpen(accountID string) (int64, error) {
+	st := `UPDATE account_transfers SET cancelled_at = NOW() WHERE account_id = $1 AND ` + openTransfer + `;`
+
+	r, err := tr.Tx.Exec(st, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+// Confirm a pending, non expired, transfer.
+func (tr *TransferRepo) Confirm(accountID, digest string) (model.Transfer, error) {
+	var t model.Transfer
+
+	st := `UPDATE account_transfers SET confirmed_at = NOW()
+WHERE account_id = $1 AND confirm_digest = $2 AND confirmed_at IS NULL AND ` + openTransfer + ` AND expires_at > NOW()
+RETURNING *;`
+
+	err := tr.Tx.Get(&t, st, accountID, digest)
+
+	return t, err
+}
+
+// Approve the confirmed transfer of an account.
+func (tr *TransferRepo) Approve(accountID, approvedByID string) (model.Transfer, error) {
+	var t model.Transfer
+
+	st := `UPDATE account_transfers SET approved_by_id = $1, approved_at = NOW()
+WHERE account_id = $2 AND confirmed_at IS NOT NULL AND approved_at IS NULL AND ` + openTransfer + `
+RETURNING *;`
+
+	err := tr.Tx.Get(&t, st, approvedByID, accountID)
+
+	return t, err
+}
+
+// Complete marks a transfer as done.
+func (tr *TransferRepo) Complete(id string) error {
+	st := `UPDATE account_transfers SET completed_at = NOW() WHERE id = $1 AND ` + openTransfer + `;`
+
+	r, err := tr.Tx.Exec(st, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// Commit transaction
+func (tr *TransferRepo) Commit() error {
+	return tr.Tx.Commit()
+}
+
+// Misc
+
+// TransferRepo from repo.
+func (r *Repo) TransferRepo(tx *sqlx.Tx) *TransferRepo {
+	return makeTransferRepo(context.Background(), r.Cfg(), r.Log(), tx)
+}
+
+// TransferRepoNewTx returns a transfer repo initialized with a new transaction
+func (r *Repo) TransferRepoNewTx() (*TransferRepo, error) {
+	tx, err := r.NewTx()
+	if err != nil {
+		return nil, err
+	}
+	return makeTransferRepo(context.Background(), r.Cfg(), r.Log(), tx), nil
+}
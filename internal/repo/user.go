@@ -2,12 +2,17 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
 	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/db"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	logger "gitlab.com/mikrowezel/backend/log"
 	"golang.org/x/crypto/bcrypt"
@@ -22,6 +27,11 @@ type (
 	}
 )
 
+var (
+	// ErrNoChanges is returned by Update when no column differs from stored values.
+	ErrNoChanges = errors.New("no fields to update")
+)
+
 func makeUserRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, tx *sqlx.Tx) *UserRepo {
 	return &UserRepo{
 		ctx: ctx,
@@ -35,8 +45,8 @@ func makeUserRepo(ctx context.Context, cfg *config.Config, log *logger.Logger, t
 func (ur *UserRepo) Create(user *model.User) error {
 	user.SetCreateValues()
 
-	st := `INSERT INTO users (id, slug, username, password_digest, email, given_name, middle_names, family_name, last_ip,  confirmation_token, is_confirmed, geolocation, locale, base_tz, current_tz, starts_at, ends_at, is_active, is_deleted, created_by_id, updated_by_id, created_at, updated_at)
-VALUES (:id, :slug, :username, :password_digest, :email, :given_name, :middle_names, :family_name, :last_ip, :confirmation_token, :is_confirmed, :geolocation, :locale, :base_tz, :current_tz, :starts_at, :ends_at, :is_active, :is_deleted, :created_by_id, :updated_by_id, :created_at, :updated_at)`
+	st := `INSERT INTO users (id, tenant_id, slug, username, password_digest, email, given_name, middle_names, family_name, last_ip,  confirmation_token, is_confirmed, geolocation, locale, base_tz, current_tz, starts_at, ends_at, is_active, is_deleted, external_id, user_type, account_id, created_by_id, updated_by_id, created_at, updated_at)
+VALUES (:id, :tenant_id, :slug, :username, :password_digest, :email, :given_name, :middle_names, :family_name, :last_ip, :confirmation_token, :is_confirmed, :geolocation, :locale, :base_tz, :current_tz, :starts_at, :ends_at, :is_active, :is_deleted, :external_id, :user_type, :account_id, :created_by_id, :updated_by_id, :created_at, :updated_at)`
 
 	_, err := ur.Tx.NamedExec(st, user)
 
@@ -45,7 +55,8 @@ VALUES (:id, :slug, :username, :password_digest, :email, :given_name, :middle_na
 
 // GetAll users from repo.
 func (ur *UserRepo) GetAll() (users []model.User, err error) {
-	st := `SELECT * FROM users;`
+	st := `SELECT * FROM users WHERE %s;`
+	st = fmt.Sprintf(st, notDeleted)
 
 	err = ur.Tx.Select(&users, st)
 
@@ -56,10 +67,9 @@ func (ur *UserRepo) GetAll() (users []model.User, err error) {
 func (ur *UserRepo) Get(id interface{}) (model.User, error) {
 	var user model.User
 
-	st := `SELECT * FROM USERS WHERE id = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, id.(string))
+	st := `SELECT * FROM USERS WHERE id = $1 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&user, st)
+	err := ur.Tx.Get(&user, st, id.(string))
 
 	return user, err
 }
@@ -68,10 +78,9 @@ func (ur *UserRepo) Get(id interface{}) (model.User, error) {
 func (ur *UserRepo) GetBySlug(slug string) (model.User, error) {
 	var user model.User
 
-	st := `SELECT * FROM USERS WHERE slug = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, slug)
+	st := `SELECT * FROM USERS WHERE slug = $1 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&user, st)
+	err := ur.Tx.Get(&user, st, slug)
 
 	return user, err
 }
@@ -80,10 +89,108 @@ func (ur *UserRepo) GetBySlug(slug string) (model.User, error) {
 func (ur *UserRepo) GetByUsername(username string) (model.User, error) {
 	var user model.User
 
-	st := `SELECT * FROM USERS WHERE username = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, username)
+	st := `SELECT * FROM users WHERE username = $1 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&user, st, username)
+
+	return user, err
+}
+
+// GetByEmail user from repo by email.
+func (ur *UserRepo) GetByEmail(email string) (model.User, error) {
+	var user model.User
+
+	st := `SELECT * FROM users WHERE email = $1 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&user, st, email)
+
+	return user, err
+}
+
+// GetByLogin user from repo by username or email,
+// the identifier users sign in with.
+func (ur *UserRepo) GetByLogin(login string) (model.User, error) {
+	var user model.User
+
+	st := `SELECT * FROM users WHERE (username = $1 OR email = $1) AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&user, st, login)
+
+	return user, err
+}
+
+// GetServiceAccounts returns the service accounts of an account.
+func (ur *UserRepo) GetServiceAccounts(accountID string) (users []model.User, err error) {
+	st := `SELECT * FROM users WHERE account_id = $1 AND user_type = $2 AND ` + notDeleted + ` ORDER BY username;`
+
+	err = ur.Tx.Select(&users, st, accountID, model.UserTypeService)
+
+	return users, err
+}
+
+// GetServiceAccountBySlug returns a service account of an account by slug.
+func (ur *UserRepo) GetServiceAccountBySlug(accountID, slug string) (model.User, error) {
+	var user model.User
+
+	st := `SELECT * FROM users WHERE account_id = $1 AND slug = $2 AND user_type = $3 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&user, st, accountID, slug, model.UserTypeService)
+
+	return user, err
+}
+
+// Search users by partial username, email or name.
+// Full-text matches and trigram similarity are combined into a single rank,
+// best matches first.
+func (ur *UserRepo) Search(query string, limit int) (matches []model.UserMatch, err error) {
+	st := `SELECT * FROM (
+	SELECT users.*,
+		ts_rank(to_tsvector('simple', %[1]s), plainto_tsquery('simple', $1)) +
+		GREATEST(similarity(coalesce(username, ''), $1),
+			similarity(coalesce(email, ''), $1),
+			similarity(coalesce(given_name, ''), $1),
+			similarity(coalesce(family_name, ''), $1)) AS rank
+	FROM users
+	WHERE %[2]s AND (to_tsvector('simple', %[1]s) @@ plainto_tsquery('simple', $1)
+		OR username %% $1 OR email %% $1 OR given_name %% $1 OR family_name %% $1
+		OR username ILIKE $2 OR email ILIKE $2 OR given_name ILIKE $2 OR family_name ILIKE $2)
+) AS matches
+ORDER BY rank DESC, username ASC
+LIMIT $3;`
+	st = fmt.Sprintf(st, searchDocument, notDeleted)
+
+	err = ur.Tx.Select(&matches, st, query, "%"+escapeLike(query)+"%", limit)
+
+	return matches, err
+}
+
+// GetAllByTenant users from repo, oldest first.
+func (ur *UserRepo) GetAllByTenant(tenantID string) (users []model.User, err error) {
+	st := `SELECT * FROM users WHERE tenant_id = $1 AND ` + notDeleted + ` ORDER BY created_at, id;`
+
+	err = ur.Tx.Select(&users, st, tenantID)
+
+	return users, err
+}
+
+// GetByTenant user from repo by ID, only if it belongs to tenant.
+func (ur *UserRepo) GetByTenant(tenantID, id string) (model.User, error) {
+	var user model.User
+
+	st := `SELECT * FROM users WHERE tenant_id = $1 AND id = $2 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&user, st)
+	err := ur.Tx.Get(&user, st, tenantID, id)
+
+	return user, err
+}
+
+// GetByExternalID user from repo by the ID a tenant provisioning client knows it by.
+func (ur *UserRepo) GetByExternalID(tenantID, externalID string) (model.User, error) {
+	var user model.User
+
+	st := `SELECT * FROM users WHERE tenant_id = $1 AND external_id = $2 AND ` + notDeleted + ` LIMIT 1;`
+
+	err := ur.Tx.Get(&user, st, tenantID, externalID)
 
 	return user, err
 }
@@ -116,6 +223,8 @@ func (ur *UserRepo) Update(user *model.User) error {
 	if user.Email.String != ref.Email.String {
 		st.WriteString(preDelimiter(pcu))
 		st.WriteString(strUpd("email", "email"))
+		// A new address has not bounced yet.
+		st.WriteString(", email_undeliverable_at = NULL")
 		pcu = true
 	}
 
@@ -155,6 +264,16 @@ func (ur *UserRepo) Update(user *model.User) error {
 		pcu = true
 	}
 
+	if user.ExternalID != ref.ExternalID {
+		st.WriteString(preDelimiter(pcu))
+		st.WriteString(strUpd("external_id", "external_id"))
+		pcu = true
+	}
+
+	if pcu {
+		st.WriteString(auditUpd())
+	}
+
 	st.WriteString(" ")
 	st.WriteString(whereID(ref.ID.String()))
 	st.WriteString(";")
@@ -162,7 +281,7 @@ func (ur *UserRepo) Update(user *model.User) error {
 	//fmt.Println(st.String())
 
 	if pcu == false {
-		return errors.New("no fields to update")
+		return ErrNoChanges
 	}
 
 	_, err = ur.Tx.NamedExec(st.String(), user)
@@ -171,43 +290,81 @@ func (ur *UserRepo) Update(user *model.User) error {
 }
 
 // Delete user from repo by ID.
-func (ur *UserRepo) Delete(id string) error {
-	st := `DELETE FROM USERS WHERE id = '%s';`
-	st = fmt.Sprintf(st, id)
+// Users are only marked as deleted, see Purge for definitive removal.
+func (ur *UserRepo) Delete(id, deletedByID string) error {
+	return ur.softDelete("id", id, deletedByID)
+}
+
+// DeleteBySlug user from repo by slug.
+func (ur *UserRepo) DeleteBySlug(slug, deletedByID string) error {
+	return ur.softDelete("slug", slug, deletedByID)
+}
 
-	_, err := ur.Tx.Exec(st)
+// DeleteByusername user from repo by username.
+func (ur *UserRepo) DeleteByUsername(username, deletedByID string) error {
+	return ur.softDelete("username", username, deletedByID)
+}
 
-	return err
+// GetAllDeleted users from repo.
+func (ur *UserRepo) GetAllDeleted() (users []model.User, err error) {
+	st := `SELECT * FROM users WHERE is_deleted ORDER BY deleted_at DESC;`
+
+	err = ur.Tx.Select(&users, st)
+
+	return users, err
 }
 
-// DeleteBySlug:w user from repo by slug.
-func (ur *UserRepo) DeleteBySlug(slug string) error {
-	st := `DELETE FROM USERS WHERE slug = '%s';`
-	st = fmt.Sprintf(st, slug)
+// GetDeletedBySlug deleted user from repo by slug.
+func (ur *UserRepo) GetDeletedBySlug(slug string) (model.User, error) {
+	var user model.User
 
-	_, err := ur.Tx.Exec(st)
+	st := `SELECT * FROM USERS WHERE slug = $1 AND is_deleted LIMIT 1;`
 
-	return err
+	err := ur.Tx.Get(&user, st, slug)
+
+	return user, err
 }
 
-// DeleteByusername user from repo by username.
-func (ur *UserRepo) DeleteByUsername(username string) error {
-	st := `DELETE FROM USERS WHERE username = '%s';`
-	st = fmt.Sprintf(st, username)
+// RestoreBySlug a deleted user.
+func (ur *UserRepo) RestoreBySlug(slug string) error {
+	st := `UPDATE USERS SET is_deleted = FALSE, deleted_by_id = NULL, deleted_at = NULL, updated_at = NOW() WHERE slug = $1 AND is_deleted;`
 
-	_, err := ur.Tx.Exec(st)
+	return execOne(ur.Tx, st, slug)
+}
 
-	return err
+// Purge users deleted before the given time.
+// Users still owning accounts are kept, deleting them
+// would remove their accounts through the database cascade.
+func (ur *UserRepo) Purge(before time.Time) (int64, error) {
+	st := `DELETE FROM USERS WHERE is_deleted AND deleted_at < $1 AND NOT EXISTS (SELECT 1 FROM accounts WHERE accounts.owner_id = users.id);`
+
+	r, err := ur.Tx.Exec(st, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+func (ur *UserRepo) softDelete(col, val, deletedByID string) error {
+	st := `UPDATE USERS SET is_deleted = TRUE, deleted_by_id = $1, deleted_at = NOW() WHERE %s = $2 AND %s;`
+	st = fmt.Sprintf(st, col, notDeleted)
+
+	r, err := ur.Tx.Exec(st, db.ToNullString(deletedByID), val)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
 }
 
 // GetBySlug user from repo by slug token.
 func (ur *UserRepo) GetBySlugAndToken(slug, token string) (model.User, error) {
 	var user model.User
 
-	st := `SELECT * FROM USERS WHERE slug = '%s' AND confirmation_token = '%s' LIMIT 1;`
-	st = fmt.Sprintf(st, slug, token)
+	st := `SELECT * FROM USERS WHERE slug = $1 AND confirmation_token = $2 AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&user, st)
+	err := ur.Tx.Get(&user, st, slug, token)
 
 	return user, err
 }
@@ -216,10 +373,9 @@ func (ur *UserRepo) GetBySlugAndToken(slug, token string) (model.User, error) {
 func (ur *UserRepo) ConfirmUser(slug, token string) (model.User, error) {
 	var user model.User
 
-	st := `UPDATE USERS SET is_confirmed = TRUE WHERE slug = '%s' AND confirmation_token = '%s';`
-	st = fmt.Sprintf(st, slug, token)
+	st := `UPDATE USERS SET is_confirmed = TRUE WHERE slug = $1 AND confirmation_token = $2 AND ` + notDeleted + `;`
 
-	_, err := ur.Tx.NamedExec(st, user)
+	_, err := ur.Tx.Exec(st, slug, token)
 
 	return user, err
 }
@@ -228,11 +384,9 @@ func (ur *UserRepo) ConfirmUser(slug, token string) (model.User, error) {
 func (ur *UserRepo) SignIn(username, password string) (model.User, error) {
 	var u model.User
 
-	st := `SELECT * FROM users WHERE username = '%s' OR email = '%s' LIMIT 1;`
-
-	st = fmt.Sprintf(st, username, username)
+	st := `SELECT * FROM users WHERE (username = $1 OR email = $1) AND ` + notDeleted + ` LIMIT 1;`
 
-	err := ur.Tx.Get(&u, st)
+	err := ur.Tx.Get(&u, st, username)
 
 	// Validate password
 	err = bcrypt.CompareHashAndPassword([]byte(u.PasswordDigest.String), []byte(password))
@@ -243,6 +397,141 @@ func (ur *UserRepo) SignIn(username, password string) (model.User, error) {
 	return u, nil
 }
 
+// UpdateSignInLocation stores the IP and coarse location of the last sign-in.
+func (ur *UserRepo) UpdateSignInLocation(id string, ip sql.NullString, geolocation db.NullPoint) error {
+	st := `UPDATE users SET last_ip = $1, geolocation = COALESCE($2, geolocation) WHERE id = $3;`
+
+	_, err := ur.Tx.Exec(st, ip, geolocation, id)
+
+	return err
+}
+
+// MarkEmailUndeliverable flags the users using email as unreachable.
+func (ur *UserRepo) MarkEmailUndeliverable(email string) (int64, error) {
+	st := `UPDATE users SET email_undeliverable_at = NOW() WHERE lower(email) = lower($1) AND email_undeliverable_at IS NULL;`
+
+	r, err := ur.Tx.Exec(st, email)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
+// UpdatePassword sets the password digest of a user,
+// updatedByID is who set it, empty if unknown.
+func (ur *UserRepo) UpdatePassword(id, digest, updatedByID string) error {
+	st := `UPDATE users SET password_digest = $1, updated_by_id = $2, updated_at = NOW() WHERE id = $3;`
+
+	r, err := ur.Tx.Exec(st, digest, db.ToNullString(updatedByID), id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// SetActive activates or deactivates a user.
+func (ur *UserRepo) SetActive(id string, active bool) error {
+	st := `UPDATE users SET is_active = $1, updated_at = NOW() WHERE id = $2;`
+
+	r, err := ur.Tx.Exec(st, active, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// SetConfirmed marks a user email as confirmed without a confirmation token.
+func (ur *UserRepo) SetConfirmed(id string) error {
+	st := `UPDATE users SET is_confirmed = TRUE, updated_at = NOW() WHERE id = $1 AND is_confirmed IS NOT TRUE;`
+
+	r, err := ur.Tx.Exec(st, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// AddPasswordHistory keeps a replaced password digest
+// so that it cannot be reused later.
+func (ur *UserRepo) AddPasswordHistory(userID, digest string) error {
+	st := `INSERT INTO user_password_history (id, user_id, password_digest, created_at)
+VALUES ($1, $2, $3, NOW());`
+
+	_, err := ur.Tx.Exec(st, uuid.NewV4(), userID, digest)
+	return err
+}
+
+// GetPasswordHistory returns the last limit password digests of a user, newest first.
+func (ur *UserRepo) GetPasswordHistory(userID string, limit int) ([]string, error) {
+	var digests []string
+	if limit <= 0 {
+		return digests, nil
+	}
+
+	st := `SELECT password_digest FROM user_password_history WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2;`
+
+	err := ur.Tx.Select(&digests, st, userID, limit)
+	if err != nil {
+		return digests, err
+	}
+
+	return digests, nil
+}
+
+// UpdateEmail sets the email address of a user.
+// Used by the email change flow, once the new address has been confirmed.
+func (ur *UserRepo) UpdateEmail(id, email string) error {
+	st := `UPDATE users SET email = $1, email_undeliverable_at = NULL, updated_at = NOW() WHERE id = $2;`
+
+	r, err := ur.Tx.Exec(st, email, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// notDeleted filters out soft deleted rows.
+const notDeleted = `is_deleted IS NOT TRUE`
+
+// searchDocument is the expression indexed by 'users_fts_idx'.
+// It must match the migration definition for the index to be used.
+const searchDocument = `coalesce(username, '') || ' ' || coalesce(email, '') || ' ' || coalesce(given_name, '') || ' ' || coalesce(middle_names, '') || ' ' || coalesce(family_name, '')`
+
+// escapeLike escapes LIKE wildcards in user provided values.
+func escapeLike(val string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(val)
+}
+
+// execOne runs a statement expected to affect exactly one row.
+func execOne(tx *sqlx.Tx, st string, args ...interface{}) error {
+	r, err := tx.Exec(st, args...)
+	if err != nil {
+		return err
+	}
+
+	return checkOne(r)
+}
+
+// checkOne returns an error if the statement did not affect exactly one row.
+func checkOne(r sql.Result) error {
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n != 1 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 // preDelimiter selects a comma or space
 // for each field in update statements.
 func preDelimiter(upc bool) string {
@@ -257,6 +546,19 @@ func strUpd(colName, fieldName string) string {
 	return fmt.Sprintf("%s = :%s", colName, fieldName)
 }
 
+// sameTime compares nullable times ignoring their location.
+func sameTime(t1, t2 pq.NullTime) bool {
+	if t1.Valid != t2.Valid {
+		return false
+	}
+	return !t1.Valid || t1.Time.Equal(t2.Time)
+}
+
+// auditUpd build the update fragment for audit columns.
+func auditUpd() string {
+	return ", updated_by_id = :updated_by_id, updated_at = :updated_at"
+}
+
 // whereID build an SQL where clause for ID.
 func whereID(id string) string {
 	return fmt.Sprintf("WHERE id = '%s'", id)
@@ -1,4 +1,4 @@
-package repo
+package repo_test
 
 import (
 	"context"
@@ -6,17 +6,18 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	//"github.com/davecgh/go-spew/spew"
 
 	"github.com/jmoiron/sqlx"
 	"gitlab.com/mikrowezel/backend/config"
 	"gitlab.com/mikrowezel/backend/db"
-	"gitlab.com/mikrowezel/backend/log"
-	mwmig "gitlab.com/mikrowezel/backend/migration"
 	"gitlab.com/mikrowezel/backend/granica/internal/migration"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/log"
+	mwmig "gitlab.com/mikrowezel/backend/migration"
 )
 
 var (
@@ -287,6 +288,49 @@ func TestGetUserByUsername(t *testing.T) {
 	}
 }
 
+// TestSearchUsers tests search users by partial values.
+func TestSearchUsers(t *testing.T) {
+	// Create some sample users
+	_, err := createSampleUsers()
+	if err != nil {
+		t.Errorf("error creating sample users: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Errorf("cannot initialize repo handler: %s", err.Error())
+	}
+	r.Connect()
+
+	userRepo, err := r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+
+	matches, err := userRepo.Search("username2@mail", 10)
+	if err != nil {
+		t.Errorf("search users error: %s", err.Error())
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		t.Log(err)
+		t.Errorf("search users commit error: %s", err.Error())
+	}
+
+	if len(matches) == 0 {
+		t.Fatal("expecting at least one match got none")
+	}
+
+	if matches[0].Username.String != userSample2["username"] {
+		t.Errorf("expecting '%s' as best match got '%s'", userSample2["username"], matches[0].Username.String)
+	}
+}
+
 // TestUpdateUser user repo update.
 func TestUpdateUser(t *testing.T) {
 	// Create some sample users
@@ -367,7 +411,7 @@ func TestDeleteUser(t *testing.T) {
 	}
 
 	user := users[0]
-	err = userRepo.DeleteBySlug(user.Slug.String)
+	err = userRepo.DeleteBySlug(user.Slug.String, "")
 	if err != nil {
 		t.Errorf("delete user error: %s", err.Error())
 	}
@@ -380,17 +424,251 @@ func TestDeleteUser(t *testing.T) {
 
 	userVerify, err := getUserBySlug(user.Slug.String, cfg)
 	if err != nil {
+		t.Errorf("cannot get user from database: %s", err.Error())
 		return
 	}
 
-	t.Errorf("user was not deleted")
+	if !userVerify.IsDeleted.Bool || !userVerify.DeletedAt.Valid {
+		t.Error("user was not marked as deleted")
+	}
 
-	if userVerify == nil {
-		t.Errorf("cannot get user from database")
+	// Deleted users are excluded from reads
+	userRepo, err = r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+	defer userRepo.Commit()
+
+	_, err = userRepo.GetBySlug(user.Slug.String)
+	if err == nil {
+		t.Error("deleted user should not be retrieved")
+	}
+}
+
+// TestRestoreUser tests restore deleted users from repo.
+func TestRestoreUser(t *testing.T) {
+	// Create some sample users
+	users, err := createSampleUsers()
+	if err != nil {
+		t.Errorf("error creating sample users: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Errorf("cannot initialize repo handler: %s", err.Error())
+	}
+	r.Connect()
+
+	userRepo, err := r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+
+	user := users[0]
+	err = userRepo.DeleteBySlug(user.Slug.String, "")
+	if err != nil {
+		t.Errorf("delete user error: %s", err.Error())
+	}
+
+	err = userRepo.RestoreBySlug(user.Slug.String)
+	if err != nil {
+		t.Errorf("restore user error: %s", err.Error())
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		t.Errorf("restore user commit error: %s", err.Error())
+	}
+
+	userVerify, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Errorf("cannot get user from database: %s", err.Error())
+		return
+	}
+
+	if userVerify.IsDeleted.Bool || userVerify.DeletedAt.Valid {
+		t.Error("user was not restored")
+	}
+}
+
+// TestPurgeUsers tests definitive removal of deleted users from repo.
+func TestPurgeUsers(t *testing.T) {
+	// Create some sample users
+	users, err := createSampleUsers()
+	if err != nil {
+		t.Errorf("error creating sample users: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Errorf("cannot initialize repo handler: %s", err.Error())
+	}
+	r.Connect()
+
+	userRepo, err := r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+
+	user := users[0]
+	err = userRepo.DeleteBySlug(user.Slug.String, "")
+	if err != nil {
+		t.Errorf("delete user error: %s", err.Error())
+	}
+
+	n, err := userRepo.Purge(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Errorf("purge users error: %s", err.Error())
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		t.Errorf("purge users commit error: %s", err.Error())
+	}
+
+	if n != 1 {
+		t.Errorf("expected 1 purged user, got %d", n)
+	}
+
+	_, err = getUserBySlug(user.Slug.String, cfg)
+	if err == nil {
+		t.Error("user was not purged from database")
+	}
+
+	_, err = getUserBySlug(users[1].Slug.String, cfg)
+	if err != nil {
+		t.Error("non deleted user should not be purged")
+	}
+}
+
+// TestPurgeAccountCreator tests that purging a user
+// keeps the accounts it created for others.
+func TestPurgeAccountCreator(t *testing.T) {
+	// Create some sample users
+	users, err := createSampleUsers()
+	if err != nil {
+		t.Errorf("error creating sample users: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Errorf("cannot initialize repo handler: %s", err.Error())
+	}
+	r.Connect()
+
+	userRepo, err := r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+
+	creator, owner := users[0], users[1]
+	account := &model.Account{
+		Name:        db.ToNullString("name"),
+		OwnerID:     db.ToNullString(owner.ID.String()),
+		AccountType: db.ToNullString("organization"),
+		Email:       db.ToNullString(owner.Email.String),
+	}
+	account.CreatedByID = db.ToNullString(creator.ID.String())
+	account.UpdatedByID = db.ToNullString(creator.ID.String())
+
+	err = r.AccountRepo(userRepo.Tx).Create(account)
+	if err != nil {
+		t.Errorf("create account error: %s", err.Error())
+	}
+
+	err = userRepo.DeleteBySlug(creator.Slug.String, "")
+	if err != nil {
+		t.Errorf("delete user error: %s", err.Error())
+	}
+
+	n, err := userRepo.Purge(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Errorf("purge users error: %s", err.Error())
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		t.Errorf("purge users commit error: %s", err.Error())
+	}
+
+	if n != 1 {
+		t.Errorf("expected 1 purged user, got %d", n)
+	}
+
+	accountRepo, err := r.AccountRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize account repo: %s", err.Error())
+	}
+	defer accountRepo.Tx.Rollback()
+
+	a, err := accountRepo.Get(account.ID.String())
+	if err != nil {
+		t.Errorf("account should not be purged along with its creator: %s", err.Error())
+	}
+
+	if a.CreatedByID.Valid || a.UpdatedByID.Valid {
+		t.Error("account creator and updater should be cleared")
+	}
+}
+
+// TestUpdatePasswordActor tests that password updates record who made them.
+func TestUpdatePasswordActor(t *testing.T) {
+	// Create some sample users
+	users, err := createSampleUsers()
+	if err != nil {
+		t.Errorf("error creating sample users: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Errorf("cannot initialize repo handler: %s", err.Error())
+	}
+	r.Connect()
+
+	userRepo, err := r.UserRepoNewTx()
+	if err != nil {
+		t.Errorf("cannot initialize user repo: %s", err.Error())
+	}
+
+	user, actor := users[0], users[1]
+
+	err = userRepo.UpdatePassword(user.ID.String(), "digest", actor.ID.String())
+	if err != nil {
+		t.Errorf("update password error: %s", err.Error())
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		t.Errorf("update password commit error: %s", err.Error())
+	}
+
+	userVerify, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Errorf("cannot get user from database: %s", err.Error())
+	}
+
+	if userVerify.PasswordDigest.String != "digest" {
+		t.Error("password digest was not updated")
 	}
 
-	if userVerify.Username.String == user.Username.String {
-		t.Error("user was not deleted from database")
+	if userVerify.UpdatedByID.String != actor.ID.String() {
+		t.Errorf("expecting updated by %s got %s", actor.ID.String(), userVerify.UpdatedByID.String)
 	}
 }
 
@@ -0,0 +1,149 @@
+package saml
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// Exclusive XML canonicalization without comments
+// (https://www.w3.org/TR/xml-exc-c14n/).
+// Comments and processing instructions are already dropped while parsing.
+
+// canonicalize returns the canonical form of e as a document subset apex.
+// inclusive lists prefixes handled as in inclusive canonicalization,
+// '#default' stands for the default namespace.
+func canonicalize(e *Element, inclusive []string) []byte {
+	incl := map[string]bool{}
+	for _, p := range inclusive {
+		if p == "#default" {
+			p = ""
+		}
+		incl[p] = true
+	}
+
+	var b bytes.Buffer
+	writeCanonical(&b, e, map[string]string{}, incl)
+	return b.Bytes()
+}
+
+// writeCanonical writes e, rendered holds the namespace declarations
+// already in effect from output ancestors.
+func writeCanonical(b *bytes.Buffer, e *Element, rendered map[string]string, incl map[string]bool) {
+	// Visibly utilized prefixes
+	used := map[string]bool{e.Prefix: true}
+	for _, a := range e.Attrs {
+		if a.Prefix != "" && a.Prefix != "xml" {
+			used[a.Prefix] = true
+		}
+	}
+
+	for p := range incl {
+		if _, ok := e.lookupNS(p); ok {
+			used[p] = true
+		}
+	}
+
+	var prefixes []string
+	scope := rendered
+	for p := range used {
+		uri, _ := e.lookupNS(p)
+		prev, ok := rendered[p]
+
+		if p == "" {
+			// No default namespace is the same as an empty one.
+			if uri == prev {
+				continue
+			}
+		} else if ok && prev == uri {
+			continue
+		}
+
+		if len(prefixes) == 0 {
+			scope = make(map[string]string, len(rendered)+1)
+			for k, v := range rendered {
+				scope[k] = v
+			}
+		}
+
+		scope[p] = uri
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	attrs := make([]Attr, len(e.Attrs))
+	copy(attrs, e.Attrs)
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].Space != attrs[j].Space {
+			return attrs[i].Space < attrs[j].Space
+		}
+		return attrs[i].Local < attrs[j].Local
+	})
+
+	name := qname(e.Prefix, e.Local)
+
+	b.WriteByte('<')
+	b.WriteString(name)
+
+	for _, p := range prefixes {
+		if p == "" {
+			b.WriteString(` xmlns="`)
+		} else {
+			b.WriteString(` xmlns:` + p + `="`)
+		}
+		b.WriteString(escapeAttr(scope[p]))
+		b.WriteByte('"')
+	}
+
+	for _, a := range attrs {
+		b.WriteString(" " + qname(a.Prefix, a.Local) + `="`)
+		b.WriteString(escapeAttr(a.Value))
+		b.WriteByte('"')
+	}
+
+	b.WriteByte('>')
+
+	for _, c := range e.Children {
+		switch n := c.(type) {
+		case *Element:
+			writeCanonical(b, n, scope, incl)
+		case Text:
+			b.WriteString(escapeText(string(n)))
+		}
+	}
+
+	b.WriteString("</" + name + ">")
+}
+
+func qname(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+var (
+	textEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		"\r", "&#xD;",
+	)
+
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		`"`, "&quot;",
+		"\t", "&#x9;",
+		"\n", "&#xA;",
+		"\r", "&#xD;",
+	)
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
@@ -0,0 +1,147 @@
+package saml
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+// LoadServiceProviders reads the identity providers Granica accepts
+// assertions from, listed in 'app.saml.providers'.
+// For a provider named 'acme':
+//
+//	app.saml.acme.label
+//	app.saml.acme.entity.id             Defaults to the metadata URL
+//	app.saml.acme.idp.entity.id
+//	app.saml.acme.idp.sso.url
+//	app.saml.acme.idp.cert.file         PEM, more than one while rotating
+//	app.saml.acme.name.id.format        persistent (default), email or a URN
+//	app.saml.acme.link.by.email
+//	app.saml.acme.provision
+//	app.saml.acme.attr.email            Attribute names
+//	app.saml.acme.attr.username
+//	app.saml.acme.attr.given.name
+//	app.saml.acme.attr.family.name
+//	app.saml.acme.clock.skew.seconds
+func LoadServiceProviders(cfg *config.Config) (*ServiceProviders, error) {
+	var sps []*ServiceProvider
+
+	names := cfg.ValOrDef("app.saml.providers", "")
+	for _, name := range splitList(names) {
+		name = strings.ToLower(name)
+		if name == "idp" {
+			return nil, fmt.Errorf("'%s' is reserved, choose another SAML provider name", name)
+		}
+
+		key := func(k string) string {
+			return fmt.Sprintf("app.saml.%s.%s", name, k)
+		}
+		val := func(k string) string {
+			return cfg.ValOrDef(key(k), "")
+		}
+
+		sc := SPConfig{
+			Name:           name,
+			Label:          val("label"),
+			EntityID:       val("entity.id"),
+			ACSURL:         siteURL(cfg, fmt.Sprintf("users/saml/%s/acs", name)),
+			IdPEntityID:    val("idp.entity.id"),
+			IdPSSOURL:      val("idp.sso.url"),
+			NameIDFormat:   val("name.id.format"),
+			LinkByEmail:    cfg.ValAsBool(key("link.by.email"), false),
+			Provision:      cfg.ValAsBool(key("provision"), true),
+			EmailAttr:      val("attr.email"),
+			UsernameAttr:   val("attr.username"),
+			GivenNameAttr:  val("attr.given.name"),
+			FamilyNameAttr: val("attr.family.name"),
+			ClockSkew:      time.Duration(cfg.ValAsInt(key("clock.skew.seconds"), 120)) * time.Second,
+		}
+
+		if sc.EntityID == "" {
+			sc.EntityID = siteURL(cfg, fmt.Sprintf("users/saml/%s/metadata", name))
+		}
+
+		if sc.IdPEntityID == "" || sc.IdPSSOURL == "" || val("idp.cert.file") == "" {
+			return nil, fmt.Errorf("SAML provider '%s' needs identity provider entity ID, SSO URL and certificate", name)
+		}
+
+		certs, err := LoadCertificates(val("idp.cert.file"))
+		if err != nil {
+			return nil, fmt.Errorf("SAML provider '%s': %w", name, err)
+		}
+		sc.IdPCerts = certs
+
+		sps = append(sps, NewServiceProvider(sc))
+	}
+
+	return NewServiceProviders(sps...), nil
+}
+
+// LoadIdentityProvider reads the identity provider configuration.
+// It returns nil if 'app.saml.idp.enabled' is not set.
+// Service providers allowed to use it are listed in 'app.saml.idp.peers'.
+// For a peer named 'wiki':
+//
+//	app.saml.idp.peer.wiki.entity.id
+//	app.saml.idp.peer.wiki.acs.url
+//	app.saml.idp.peer.wiki.name.id.format    persistent (default), email or a URN
+//
+// The signing key is read from 'app.saml.idp.key.file' and 'app.saml.idp.cert.file',
+// both are generated on first start if missing.
+func LoadIdentityProvider(cfg *config.Config) (*IdentityProvider, error) {
+	if !cfg.ValAsBool("app.saml.idp.enabled", false) {
+		return nil, nil
+	}
+
+	ic := IdPConfig{
+		EntityID:     cfg.ValOrDef("app.saml.idp.entity.id", siteURL(cfg, "saml/idp/metadata")),
+		SSOURL:       siteURL(cfg, "saml/idp/sso"),
+		AssertionTTL: time.Duration(cfg.ValAsInt("app.saml.idp.assertion.ttl.minutes", 5)) * time.Minute,
+	}
+
+	for _, name := range splitList(cfg.ValOrDef("app.saml.idp.peers", "")) {
+		name = strings.ToLower(name)
+		val := func(k string) string {
+			return cfg.ValOrDef(fmt.Sprintf("app.saml.idp.peer.%s.%s", name, k), "")
+		}
+
+		p := Peer{
+			Name:         name,
+			EntityID:     val("entity.id"),
+			ACSURL:       val("acs.url"),
+			NameIDFormat: val("name.id.format"),
+		}
+
+		if p.EntityID == "" || p.ACSURL == "" {
+			return nil, fmt.Errorf("SAML service provider '%s' needs entity ID and ACS URL", name)
+		}
+
+		ic.Peers = append(ic.Peers, p)
+	}
+
+	keyFile := cfg.ValOrDef("app.saml.idp.key.file", "saml-idp.key")
+	certFile := cfg.ValOrDef("app.saml.idp.cert.file", "saml-idp.crt")
+
+	kp, err := LoadOrCreateKeyPair(keyFile, certFile, ic.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("SAML identity provider key: %w", err)
+	}
+
+	return NewIdentityProvider(ic, kp), nil
+}
+
+// siteURL mirrors the links the service builds for site.url.
+func siteURL(cfg *config.Config, path string) string {
+	site := cfg.ValOrDef("site.url", "localhost")
+	return fmt.Sprintf("https://%s/%s", site, strings.TrimPrefix(path, "/"))
+}
+
+func splitList(s string) []string {
+	f := func(r rune) bool {
+		return r == ',' || r == ' '
+	}
+
+	return strings.FieldsFunc(s, f)
+}
@@ -0,0 +1,252 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Digest algorithms
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// Enveloped XML signatures (https://www.w3.org/TR/xmldsig-core1/)
+// restricted to the profile SAML uses: one reference to the signed element
+// by ID, enveloped signature and exclusive canonicalization transforms
+// and RSA keys.
+
+const (
+	dsigNS = "http://www.w3.org/2000/09/xmldsig#"
+
+	dsigPrefix = "ds"
+
+	algExcC14N   = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	algEnveloped = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	algSHA256    = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algSHA512    = "http://www.w3.org/2001/04/xmlenc#sha512"
+	algRSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algRSASHA512 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+)
+
+var (
+	// ErrNotSigned is returned when verifying an element without signature.
+	ErrNotSigned = errors.New("element is not signed")
+	// ErrInvalidSignature is returned when a signature does not verify.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+var (
+	digestAlgs = map[string]crypto.Hash{
+		algSHA256: crypto.SHA256,
+		algSHA512: crypto.SHA512,
+	}
+
+	signatureAlgs = map[string]crypto.Hash{
+		algRSASHA256: crypto.SHA256,
+		algRSASHA512: crypto.SHA512,
+	}
+)
+
+// Sign adds an enveloped signature to e using RSA-SHA256.
+// The signature is placed after the Issuer child, if any, as SAML schemas require.
+// e needs an ID attribute.
+func Sign(e *Element, kp *KeyPair) error {
+	id := e.Attr("ID")
+	if id == "" {
+		return errors.New("signed element needs an ID")
+	}
+
+	digest := hash(crypto.SHA256, canonicalize(e, nil))
+
+	sig := NewElement(dsigNS, dsigPrefix, "Signature")
+	si := sig.newChild("SignedInfo")
+	si.newChild("CanonicalizationMethod").SetAttr("Algorithm", algExcC14N)
+	si.newChild("SignatureMethod").SetAttr("Algorithm", algRSASHA256)
+
+	ref := si.newChild("Reference").SetAttr("URI", "#"+id)
+	ts := ref.newChild("Transforms")
+	ts.newChild("Transform").SetAttr("Algorithm", algEnveloped)
+	ts.newChild("Transform").SetAttr("Algorithm", algExcC14N)
+	ref.newChild("DigestMethod").SetAttr("Algorithm", algSHA256)
+	ref.newChild("DigestValue").SetText(base64.StdEncoding.EncodeToString(digest))
+
+	// SignedInfo is canonicalized in its final context.
+	pos := 0
+	for i, c := range e.Children {
+		if ce, ok := c.(*Element); ok && ce.Local == "Issuer" {
+			pos = i + 1
+			break
+		}
+	}
+	e.insertChild(pos, sig)
+
+	sv, err := rsa.SignPKCS1v15(rand.Reader, kp.Key, crypto.SHA256, hash(crypto.SHA256, canonicalize(si, nil)))
+	if err != nil {
+		return err
+	}
+
+	sig.newChild("SignatureValue").SetText(base64.StdEncoding.EncodeToString(sv))
+	sig.newChild("KeyInfo").newChild("X509Data").newChild("X509Certificate").
+		SetText(base64.StdEncoding.EncodeToString(kp.Cert.Raw))
+
+	return nil
+}
+
+// Verify checks the enveloped signature of e against certs.
+// Only the signature that is a direct child of e and references e itself
+// is considered, callers must only trust data read from e afterwards.
+func Verify(e *Element, certs []*x509.Certificate) error {
+	sigs := e.ChildrenNamed(dsigNS, "Signature")
+	if len(sigs) == 0 {
+		return ErrNotSigned
+	}
+	if len(sigs) > 1 {
+		return fmt.Errorf("%w: more than one signature", ErrInvalidSignature)
+	}
+	sig := sigs[0]
+
+	si := sig.Child(dsigNS, "SignedInfo")
+	if si == nil {
+		return fmt.Errorf("%w: no signed info", ErrInvalidSignature)
+	}
+
+	cm := si.Child(dsigNS, "CanonicalizationMethod")
+	if cm == nil || cm.Attr("Algorithm") != algExcC14N {
+		return fmt.Errorf("%w: unsupported canonicalization", ErrInvalidSignature)
+	}
+
+	sm := si.Child(dsigNS, "SignatureMethod")
+	if sm == nil {
+		return fmt.Errorf("%w: no signature method", ErrInvalidSignature)
+	}
+
+	sh, ok := signatureAlgs[sm.Attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported signature method '%s'", ErrInvalidSignature, sm.Attr("Algorithm"))
+	}
+
+	err := verifyReference(e, sig, si)
+	if err != nil {
+		return err
+	}
+
+	sv := sig.Child(dsigNS, "SignatureValue")
+	if sv == nil {
+		return fmt.Errorf("%w: no signature value", ErrInvalidSignature)
+	}
+
+	sb, err := decodeBase64(sv.Text())
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	signed := hash(sh, canonicalize(si, inclusivePrefixes(cm)))
+	for _, c := range certs {
+		pub, ok := c.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+
+		if rsa.VerifyPKCS1v15(pub, sh, signed, sb) == nil {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// verifyReference checks that the only reference points to e
+// and its digest matches.
+func verifyReference(e, sig, si *Element) error {
+	refs := si.ChildrenNamed(dsigNS, "Reference")
+	if len(refs) != 1 {
+		return fmt.Errorf("%w: exactly one reference expected", ErrInvalidSignature)
+	}
+	ref := refs[0]
+
+	id := e.Attr("ID")
+	if id == "" || ref.Attr("URI") != "#"+id {
+		return fmt.Errorf("%w: reference does not point to signed element", ErrInvalidSignature)
+	}
+
+	var inclusive []string
+	var c14n bool
+	if ts := ref.Child(dsigNS, "Transforms"); ts != nil {
+		for _, t := range ts.ChildrenNamed(dsigNS, "Transform") {
+			switch t.Attr("Algorithm") {
+			case algEnveloped:
+			case algExcC14N:
+				c14n = true
+				inclusive = inclusivePrefixes(t)
+			default:
+				return fmt.Errorf("%w: unsupported transform '%s'", ErrInvalidSignature, t.Attr("Algorithm"))
+			}
+		}
+	}
+
+	if !c14n {
+		return fmt.Errorf("%w: exclusive canonicalization transform required", ErrInvalidSignature)
+	}
+
+	dm := ref.Child(dsigNS, "DigestMethod")
+	if dm == nil {
+		return fmt.Errorf("%w: no digest method", ErrInvalidSignature)
+	}
+
+	dh, ok := digestAlgs[dm.Attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported digest method '%s'", ErrInvalidSignature, dm.Attr("Algorithm"))
+	}
+
+	dv := ref.Child(dsigNS, "DigestValue")
+	if dv == nil {
+		return fmt.Errorf("%w: no digest value", ErrInvalidSignature)
+	}
+
+	expected, err := decodeBase64(dv.Text())
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	digest := hash(dh, canonicalize(e.without(sig), inclusive))
+	if subtle.ConstantTimeCompare(digest, expected) != 1 {
+		return fmt.Errorf("%w: digest mismatch", ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+// inclusivePrefixes returns the PrefixList of an exclusive
+// canonicalization method or transform.
+func inclusivePrefixes(e *Element) []string {
+	in := e.Child(algExcC14N, "InclusiveNamespaces")
+	if in == nil {
+		return nil
+	}
+	return strings.Fields(in.Attr("PrefixList"))
+}
+
+func hash(h crypto.Hash, b []byte) []byte {
+	hh := h.New()
+	hh.Write(b)
+	return hh.Sum(nil)
+}
+
+// decodeBase64 ignores the whitespace base64 values are usually wrapped with.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		}
+		return r
+	}, s)
+
+	return base64.StdEncoding.DecodeString(s)
+}
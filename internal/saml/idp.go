@@ -0,0 +1,287 @@
+package saml
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultAssertionTTL = 5 * time.Minute
+	// Age limit of received authentication requests.
+	maxRequestAge = 10 * time.Minute
+)
+
+var (
+	// ErrUnknownServiceProvider is returned for requests of service providers not registered.
+	ErrUnknownServiceProvider = errors.New("unknown service provider")
+	// ErrInvalidRequest is returned for malformed or stale requests.
+	ErrInvalidRequest = errors.New("invalid SAML request")
+)
+
+type (
+	// IdPConfig of Granica as identity provider.
+	IdPConfig struct {
+		// EntityID of the identity provider, defaults to its metadata URL.
+		EntityID string
+		// SSOURL receives authentication requests.
+		SSOURL string
+		// AssertionTTL is how long issued assertions are valid.
+		AssertionTTL time.Duration
+		// Peers are the service providers allowed to request assertions.
+		Peers []Peer
+	}
+
+	// Peer is a service provider registered with the identity provider.
+	Peer struct {
+		Name     string
+		EntityID string
+		// ACSURL responses are posted to, requests can not override it.
+		ACSURL       string
+		NameIDFormat string
+	}
+
+	// IdentityProvider issues signed assertions for signed in users.
+	IdentityProvider struct {
+		IdPConfig
+		KeyPair *KeyPair
+		Now     func() time.Time
+	}
+
+	// Request received from a service provider.
+	Request struct {
+		ID   string
+		Peer Peer
+		// RelayState to send back untouched.
+		RelayState string
+	}
+
+	// Subject asserted to a service provider.
+	Subject struct {
+		// ID is the stable user identifier used as persistent name ID.
+		ID         string
+		Email      string
+		Username   string
+		GivenName  string
+		FamilyName string
+		Roles      []string
+		// SessionIndex identifies the session the user authenticated with.
+		SessionIndex string
+		AuthnInstant time.Time
+	}
+
+	// Response to post to a service provider.
+	Response struct {
+		ACSURL string
+		// SAMLResponse is the base64 encoded response document.
+		SAMLResponse string
+		RelayState   string
+	}
+)
+
+// NewIdentityProvider from cfg signing with kp.
+func NewIdentityProvider(cfg IdPConfig, kp *KeyPair) *IdentityProvider {
+	if cfg.AssertionTTL == 0 {
+		cfg.AssertionTTL = defaultAssertionTTL
+	}
+
+	for i := range cfg.Peers {
+		cfg.Peers[i].NameIDFormat = NameIDFormat(cfg.Peers[i].NameIDFormat)
+	}
+
+	return &IdentityProvider{
+		IdPConfig: cfg,
+		KeyPair:   kp,
+		Now:       time.Now,
+	}
+}
+
+// Peer returns the registered service provider with entityID.
+func (idp *IdentityProvider) Peer(entityID string) (Peer, bool) {
+	for _, p := range idp.Peers {
+		if p.EntityID == entityID {
+			return p, true
+		}
+	}
+	return Peer{}, false
+}
+
+// ParseRequest reads an authentication request received
+// through the HTTP-Redirect binding.
+func (idp *IdentityProvider) ParseRequest(encoded, relayState string) (Request, error) {
+	msg, err := decodeRedirect(encoded)
+	if err != nil {
+		return Request{}, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	}
+
+	ar, err := Parse(msg)
+	if err != nil {
+		return Request{}, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	}
+
+	if !ar.Is(samlpNS, "AuthnRequest") || ar.Attr("ID") == "" {
+		return Request{}, fmt.Errorf("%w: not an authentication request", ErrInvalidRequest)
+	}
+
+	if d := ar.Attr("Destination"); d != "" && d != idp.SSOURL {
+		return Request{}, fmt.Errorf("%w: unexpected destination '%s'", ErrInvalidRequest, d)
+	}
+
+	ii, err := parseTime(ar.Attr("IssueInstant"))
+	if err != nil || idp.Now().Sub(ii) > maxRequestAge {
+		return Request{}, fmt.Errorf("%w: stale request", ErrInvalidRequest)
+	}
+
+	iss := ar.Child(samlNS, "Issuer")
+	if iss == nil {
+		return Request{}, fmt.Errorf("%w: no issuer", ErrInvalidRequest)
+	}
+
+	p, ok := idp.Peer(iss.Text())
+	if !ok {
+		return Request{}, ErrUnknownServiceProvider
+	}
+
+	// Responses only go to registered endpoints.
+	if acs := ar.Attr("AssertionConsumerServiceURL"); acs != "" && acs != p.ACSURL {
+		return Request{}, fmt.Errorf("%w: unregistered assertion consumer service '%s'", ErrInvalidRequest, acs)
+	}
+
+	return Request{ID: ar.Attr("ID"), Peer: p, RelayState: relayState}, nil
+}
+
+// Respond returns a response with a signed assertion about s for req.
+func (idp *IdentityProvider) Respond(req Request, s Subject) (Response, error) {
+	now := idp.Now()
+	expires := now.Add(idp.AssertionTTL)
+
+	resID, err := newID()
+	if err != nil {
+		return Response{}, err
+	}
+
+	aID, err := newID()
+	if err != nil {
+		return Response{}, err
+	}
+
+	res := NewElement(samlpNS, "samlp", "Response")
+	res.Namespaces["saml"] = samlNS
+	res.SetAttr("ID", resID).
+		SetAttr("Version", "2.0").
+		SetAttr("IssueInstant", formatTime(now)).
+		SetAttr("Destination", req.Peer.ACSURL)
+	if req.ID != "" {
+		res.SetAttr("InResponseTo", req.ID)
+	}
+
+	res.AddChild(newSAMLElement("Issuer").SetText(idp.EntityID))
+	res.newChild("Status").newChild("StatusCode").SetAttr("Value", statusSuccess)
+
+	a := newSAMLElement("Assertion")
+	res.AddChild(a)
+	a.SetAttr("ID", aID).
+		SetAttr("Version", "2.0").
+		SetAttr("IssueInstant", formatTime(now))
+
+	a.newChild("Issuer").SetText(idp.EntityID)
+
+	// Subject
+	sub := a.newChild("Subject")
+	nameID := s.ID
+	if req.Peer.NameIDFormat == NameIDEmail {
+		nameID = s.Email
+	}
+
+	if nameID == "" {
+		return Response{}, fmt.Errorf("no name ID of format '%s' for subject", req.Peer.NameIDFormat)
+	}
+
+	sub.newChild("NameID").
+		SetAttr("Format", req.Peer.NameIDFormat).
+		SetText(nameID)
+
+	scd := sub.newChild("SubjectConfirmation").
+		SetAttr("Method", confirmationBearer).
+		newChild("SubjectConfirmationData").
+		SetAttr("NotOnOrAfter", formatTime(expires)).
+		SetAttr("Recipient", req.Peer.ACSURL)
+	if req.ID != "" {
+		scd.SetAttr("InResponseTo", req.ID)
+	}
+
+	// Conditions
+	a.newChild("Conditions").
+		SetAttr("NotBefore", formatTime(now)).
+		SetAttr("NotOnOrAfter", formatTime(expires)).
+		newChild("AudienceRestriction").
+		newChild("Audience").SetText(req.Peer.EntityID)
+
+	// Authentication
+	authnInstant := s.AuthnInstant
+	if authnInstant.IsZero() {
+		authnInstant = now
+	}
+
+	as := a.newChild("AuthnStatement").SetAttr("AuthnInstant", formatTime(authnInstant))
+	if s.SessionIndex != "" {
+		as.SetAttr("SessionIndex", s.SessionIndex)
+	}
+	as.newChild("AuthnContext").newChild("AuthnContextClassRef").SetText(authnContextPPT)
+
+	// Attributes
+	st := a.newChild("AttributeStatement")
+	addAttr := func(name string, vals ...string) {
+		if len(vals) == 0 || (len(vals) == 1 && vals[0] == "") {
+			return
+		}
+
+		at := st.newChild("Attribute").
+			SetAttr("Name", name).
+			SetAttr("NameFormat", attrNameFormatBasic)
+		for _, v := range vals {
+			at.newChild("AttributeValue").SetText(v)
+		}
+	}
+
+	addAttr(AttrEmail, s.Email)
+	addAttr(AttrUsername, s.Username)
+	addAttr(AttrGivenName, s.GivenName)
+	addAttr(AttrFamilyName, s.FamilyName)
+	addAttr(AttrRoles, s.Roles...)
+
+	if len(st.Children) == 0 {
+		a.Children = a.Children[:len(a.Children)-1]
+	}
+
+	err = Sign(a, idp.KeyPair)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return Response{
+		ACSURL:       req.Peer.ACSURL,
+		SAMLResponse: base64.StdEncoding.EncodeToString(res.Bytes()),
+		RelayState:   req.RelayState,
+	}, nil
+}
+
+// Metadata describes the identity provider to service providers.
+func (idp *IdentityProvider) Metadata() []byte {
+	ed := NewElement(mdNS, "md", "EntityDescriptor")
+	ed.SetAttr("entityID", idp.EntityID)
+
+	id := ed.newChild("IDPSSODescriptor")
+	id.SetAttr("WantAuthnRequestsSigned", "false").
+		SetAttr("protocolSupportEnumeration", samlpNS)
+
+	id.AddChild(keyDescriptor(idp.KeyPair))
+	id.newChild("NameIDFormat").SetText(NameIDPersistent)
+	id.newChild("NameIDFormat").SetText(NameIDEmail)
+	id.newChild("SingleSignOnService").
+		SetAttr("Binding", bindingRedirect).
+		SetAttr("Location", idp.SSOURL)
+
+	return metadata(ed)
+}
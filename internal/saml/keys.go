@@ -0,0 +1,187 @@
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"time"
+)
+
+const (
+	keyBits = 2048
+	// Validity of generated certificates.
+	// SAML peers pin the certificate, its dates are usually not checked.
+	certValidity = 10 * 365 * 24 * time.Hour
+)
+
+type (
+	// KeyPair used to sign messages and published in metadata.
+	KeyPair struct {
+		Key  *rsa.PrivateKey
+		Cert *x509.Certificate
+	}
+)
+
+// NewKeyPair generates an RSA key and a self-signed certificate for it.
+func NewKeyPair(commonName string) (*KeyPair, error) {
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{Key: key, Cert: cert}, nil
+}
+
+// LoadOrCreateKeyPair reads a PEM encoded key and certificate.
+// If neither file exists a new pair is generated and saved,
+// so that the published certificate survives restarts.
+func LoadOrCreateKeyPair(keyFile, certFile, commonName string) (*KeyPair, error) {
+	_, kerr := os.Stat(keyFile)
+	_, cerr := os.Stat(certFile)
+
+	if os.IsNotExist(kerr) && os.IsNotExist(cerr) {
+		kp, err := NewKeyPair(commonName)
+		if err != nil {
+			return nil, err
+		}
+
+		err = kp.Save(keyFile, certFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return kp, nil
+	}
+
+	return LoadKeyPair(keyFile, certFile)
+}
+
+// LoadKeyPair reads a PEM encoded key and certificate.
+func LoadKeyPair(keyFile, certFile string) (*KeyPair, error) {
+	kb, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(kb)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in '%s'", keyFile)
+	}
+
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+
+	default:
+		var k interface{}
+		k, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err == nil {
+			var ok bool
+			key, ok = k.(*rsa.PrivateKey)
+			if !ok {
+				err = errors.New("only RSA keys are supported")
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := LoadCertificates(certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{Key: key, Cert: certs[0]}, nil
+}
+
+// Save writes key and certificate PEM encoded.
+func (kp *KeyPair) Save(keyFile, certFile string) error {
+	kb := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(kp.Key)})
+
+	err := ioutil.WriteFile(keyFile, kb, 0600)
+	if err != nil {
+		return err
+	}
+
+	cb := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: kp.Cert.Raw})
+
+	return ioutil.WriteFile(certFile, cb, 0644)
+}
+
+// LoadCertificates reads the PEM encoded certificates in file.
+func LoadCertificates(file string) ([]*x509.Certificate, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := ParseCertificates(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return certs, nil
+}
+
+// ParseCertificates decodes PEM encoded certificates.
+func ParseCertificates(b []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,154 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+const (
+	samlNS  = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlpNS = "urn:oasis:names:tc:SAML:2.0:protocol"
+	mdNS    = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	bindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	bindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+
+	statusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	attrNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	authnContextPPT     = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+
+	timeFormat = "2006-01-02T15:04:05Z"
+)
+
+// Name ID formats.
+const (
+	NameIDPersistent  = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	NameIDEmail       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDUnspecified = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+)
+
+// Default attribute names, the ones Granica asserts as identity provider.
+const (
+	AttrEmail      = "email"
+	AttrUsername   = "username"
+	AttrGivenName  = "givenName"
+	AttrFamilyName = "familyName"
+	AttrRoles      = "roles"
+)
+
+const (
+	metadataHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+	// Limit on inflated redirect binding messages.
+	maxRedirectSize = 64 << 10
+)
+
+// NameIDFormat returns the format URN for a short name
+// ('persistent', 'email' or 'unspecified'), full URNs are returned as is.
+func NameIDFormat(name string) string {
+	switch strings.ToLower(name) {
+	case "", "persistent":
+		return NameIDPersistent
+	case "email":
+		return NameIDEmail
+	case "unspecified":
+		return NameIDUnspecified
+	}
+	return name
+}
+
+// newID returns a random message ID.
+// IDs are XML names, they can not start with a digit.
+func newID() (string, error) {
+	b := make([]byte, 20)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
+
+func newSAMLElement(local string) *Element {
+	return &Element{Space: samlNS, Prefix: "saml", Local: local}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
+
+func parseTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
+}
+
+// encodeRedirect deflates and encodes a message for the HTTP-Redirect binding.
+func encodeRedirect(msg []byte) (string, error) {
+	var b bytes.Buffer
+
+	w, err := flate.NewWriter(&b, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return "", err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// decodeRedirect reverses encodeRedirect.
+func decodeRedirect(s string) ([]byte, error) {
+	b, err := decodeBase64(s)
+	if err != nil {
+		return nil, err
+	}
+
+	r := flate.NewReader(bytes.NewReader(b))
+	defer r.Close()
+
+	msg, err := ioutil.ReadAll(io.LimitReader(r, maxRedirectSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg) > maxRedirectSize {
+		return nil, errors.New("redirect binding message too large")
+	}
+
+	return msg, nil
+}
+
+// keyDescriptor returns the metadata element publishing cert for signing.
+func keyDescriptor(kp *KeyPair) *Element {
+	kd := &Element{Space: mdNS, Prefix: "md", Local: "KeyDescriptor"}
+	kd.SetAttr("use", "signing")
+
+	ki := NewElement(dsigNS, dsigPrefix, "KeyInfo")
+	ki.newChild("X509Data").newChild("X509Certificate").
+		SetText(base64.StdEncoding.EncodeToString(kp.Cert.Raw))
+
+	return kd.AddChild(ki)
+}
+
+func metadata(e *Element) []byte {
+	return append([]byte(metadataHeader), e.Bytes()...)
+}
+
+func invalidf(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidResponse, fmt.Sprintf(format, a...))
+}
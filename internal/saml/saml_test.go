@@ -0,0 +1,510 @@
+package saml
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const (
+	testIdPEntityID = "https://idp.example.com/metadata"
+	testSSOURL      = "https://idp.example.com/sso"
+	testSPEntityID  = "https://sp.example.com/metadata"
+	testACSURL      = "https://sp.example.com/acs"
+)
+
+var (
+	testKeysOnce sync.Once
+	testKeys     [2]*KeyPair
+)
+
+// keys returns two generated key pairs, shared by all tests.
+func keys(t *testing.T) (*KeyPair, *KeyPair) {
+	testKeysOnce.Do(func() {
+		for i := range testKeys {
+			kp, err := NewKeyPair("test")
+			if err != nil {
+				t.Fatalf("cannot generate key pair: %s", err.Error())
+			}
+			testKeys[i] = kp
+		}
+	})
+	return testKeys[0], testKeys[1]
+}
+
+func newTestPair(t *testing.T) (*IdentityProvider, *ServiceProvider) {
+	kp, _ := keys(t)
+
+	idp := NewIdentityProvider(IdPConfig{
+		EntityID: testIdPEntityID,
+		SSOURL:   testSSOURL,
+		Peers: []Peer{
+			{Name: "sp", EntityID: testSPEntityID, ACSURL: testACSURL},
+		},
+	}, kp)
+
+	sp := NewServiceProvider(SPConfig{
+		Name:        "acme",
+		EntityID:    testSPEntityID,
+		ACSURL:      testACSURL,
+		IdPEntityID: testIdPEntityID,
+		IdPSSOURL:   testSSOURL,
+		IdPCerts:    []*x509.Certificate{kp.Cert},
+	})
+
+	return idp, sp
+}
+
+var testSubject = Subject{
+	ID:         "a8c5a6b2-3f1e-4d6a-9b1e-0f2d4c6e8a10",
+	Email:      "jdoe@example.com",
+	Username:   "jdoe",
+	GivenName:  "John",
+	FamilyName: "Doe & Sons <Ltd>",
+	Roles:      []string{"admin", "staff"},
+}
+
+// roundTrip sends an authentication request from sp to idp
+// and returns the request ID and the encoded response.
+func roundTrip(t *testing.T, idp *IdentityProvider, sp *ServiceProvider) (string, string) {
+	ar, err := sp.AuthnRequest()
+	if err != nil {
+		t.Fatalf("cannot create request: %s", err.Error())
+	}
+
+	u, err := url.Parse(ar.URL)
+	if err != nil {
+		t.Fatalf("invalid request URL: %s", err.Error())
+	}
+
+	req, err := idp.ParseRequest(u.Query().Get("SAMLRequest"), "relay")
+	if err != nil {
+		t.Fatalf("cannot parse request: %s", err.Error())
+	}
+
+	if req.ID != ar.ID || req.RelayState != "relay" {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+
+	res, err := idp.Respond(req, testSubject)
+	if err != nil {
+		t.Fatalf("cannot respond: %s", err.Error())
+	}
+
+	if res.ACSURL != testACSURL {
+		t.Fatalf("unexpected ACS URL '%s'", res.ACSURL)
+	}
+
+	return ar.ID, res.SAMLResponse
+}
+
+// edit decodes a response, applies f to it and encodes it back.
+func edit(t *testing.T, encoded string, f func(res *Element)) string {
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f(res)
+
+	return base64.StdEncoding.EncodeToString(res.Bytes())
+}
+
+func TestCanonicalize(t *testing.T) {
+	in := `<?xml version="1.0"?>
+<a:root xmlns:a="urn:a" xmlns:b="urn:b" xmlns:unused="urn:u" z="1" a:y="2" b:x="3">
+  <!-- comment -->
+  <a:child b:w="&quot;x&#10;y&quot;" >t&gt; &amp; &lt;</a:child>
+  <c xmlns="urn:c"><d xmlns=""/></c>
+</a:root>`
+
+	root, err := Parse([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<a:root xmlns:a="urn:a" xmlns:b="urn:b" z="1" a:y="2" b:x="3">
+  ` + `
+  <a:child b:w="&quot;x&#xA;y&quot;">t&gt; &amp; &lt;</a:child>
+  <c xmlns="urn:c"><d xmlns=""></d></c>
+</a:root>`
+
+	got := string(canonicalize(root, nil))
+	if got != want {
+		t.Errorf("unexpected canonical form:\n%s\nwant:\n%s", got, want)
+	}
+
+	// As subset apex namespaces are declared where used.
+	child := root.Elements()[0]
+	want = `<a:child xmlns:a="urn:a" xmlns:b="urn:b" b:w="&quot;x&#xA;y&quot;">t&gt; &amp; &lt;</a:child>`
+
+	got = string(canonicalize(child, nil))
+	if got != want {
+		t.Errorf("unexpected canonical subset:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Inclusive prefixes are rendered even if not used.
+	c := root.Elements()[1]
+	want = `<c xmlns="urn:c" xmlns:unused="urn:u"><d xmlns=""></d></c>`
+
+	got = string(canonicalize(c, []string{"unused"}))
+	if got != want {
+		t.Errorf("unexpected canonical subset with inclusive prefixes:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParseRejectsDoctype(t *testing.T) {
+	in := `<!DOCTYPE r [<!ENTITY e "x">]><r>&e;</r>`
+
+	_, err := Parse([]byte(in))
+	if err == nil {
+		t.Error("document type declaration should be rejected")
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	kp, other := keys(t)
+
+	e := NewElement(samlNS, "saml", "Assertion")
+	e.SetAttr("ID", "_1")
+	e.newChild("Issuer").SetText("issuer")
+	e.newChild("Subject").SetText("subject")
+
+	err := Sign(e, kp)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err.Error())
+	}
+
+	if c := e.Elements()[1]; !c.Is(dsigNS, "Signature") {
+		t.Errorf("signature should follow issuer, found '%s'", c.Local)
+	}
+
+	signed, err := Parse(e.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Verify(signed, []*x509.Certificate{other.Cert, kp.Cert})
+	if err != nil {
+		t.Errorf("signature should verify: %s", err.Error())
+	}
+
+	err = Verify(signed, []*x509.Certificate{other.Cert})
+	if err != ErrInvalidSignature {
+		t.Errorf("signature should not verify with other key, got: %v", err)
+	}
+
+	signed.Child(samlNS, "Subject").SetText("other")
+	err = Verify(signed, []*x509.Certificate{kp.Cert})
+	if err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+		t.Errorf("tampered element should not verify, got: %v", err)
+	}
+
+	err = Verify(NewElement(samlNS, "saml", "Assertion"), []*x509.Certificate{kp.Cert})
+	if err != ErrNotSigned {
+		t.Errorf("expected not signed error, got: %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	idp, sp := newTestPair(t)
+
+	reqID, res := roundTrip(t, idp, sp)
+
+	id, err := sp.ParseResponse(res, reqID)
+	if err != nil {
+		t.Fatalf("response should be valid: %s", err.Error())
+	}
+
+	if id.Provider != "saml:acme" || id.Subject != testSubject.ID || id.NameIDFormat != NameIDPersistent {
+		t.Errorf("unexpected subject: %+v", id)
+	}
+
+	if id.Email != testSubject.Email || id.Username != testSubject.Username ||
+		id.GivenName != testSubject.GivenName || id.FamilyName != testSubject.FamilyName {
+		t.Errorf("unexpected attributes: %+v", id)
+	}
+
+	if roles := id.Attributes[AttrRoles]; len(roles) != 2 || roles[0] != "admin" || roles[1] != "staff" {
+		t.Errorf("unexpected roles: %v", roles)
+	}
+
+	_, err = sp.ParseResponse(res, reqID)
+	if err != ErrReplayed {
+		t.Errorf("expected replay error, got: %v", err)
+	}
+}
+
+func TestEmailNameID(t *testing.T) {
+	idp, sp := newTestPair(t)
+	idp.Peers[0].NameIDFormat = NameIDEmail
+
+	reqID, res := roundTrip(t, idp, sp)
+
+	id, err := sp.ParseResponse(res, reqID)
+	if err != nil {
+		t.Fatalf("response should be valid: %s", err.Error())
+	}
+
+	if id.Subject != testSubject.Email || id.NameIDFormat != NameIDEmail {
+		t.Errorf("unexpected subject: %+v", id)
+	}
+}
+
+func TestResponseValidation(t *testing.T) {
+	_, other := keys(t)
+
+	tests := []struct {
+		name  string
+		setup func(idp *IdentityProvider, sp *ServiceProvider)
+		reqID func(reqID string) string
+		edit  func(res *Element)
+	}{
+		{
+			name: "other request",
+			reqID: func(string) string {
+				return "_other"
+			},
+		},
+		{
+			name: "unsolicited",
+			reqID: func(string) string {
+				return ""
+			},
+		},
+		{
+			name: "other audience",
+			setup: func(idp *IdentityProvider, sp *ServiceProvider) {
+				sp.EntityID = "https://other.example.com"
+			},
+		},
+		{
+			name: "other issuer",
+			setup: func(idp *IdentityProvider, sp *ServiceProvider) {
+				sp.IdPEntityID = "https://other.example.com"
+			},
+		},
+		{
+			name: "untrusted key",
+			setup: func(idp *IdentityProvider, sp *ServiceProvider) {
+				sp.IdPCerts = []*x509.Certificate{other.Cert}
+			},
+		},
+		{
+			name: "expired",
+			setup: func(idp *IdentityProvider, sp *ServiceProvider) {
+				sp.Now = func() time.Time { return time.Now().Add(time.Hour) }
+			},
+		},
+		{
+			name: "not yet valid",
+			setup: func(idp *IdentityProvider, sp *ServiceProvider) {
+				sp.Now = func() time.Time { return time.Now().Add(-time.Hour) }
+			},
+		},
+		{
+			name: "tampered attribute",
+			edit: func(res *Element) {
+				a := res.Child(samlNS, "Assertion")
+				a.Child(samlNS, "Subject").Child(samlNS, "NameID").SetText("admin")
+			},
+		},
+		{
+			name: "signature removed",
+			edit: func(res *Element) {
+				a := res.Child(samlNS, "Assertion")
+				*a = *a.without(a.Child(dsigNS, "Signature"))
+			},
+		},
+		{
+			name: "wrapped assertion",
+			edit: func(res *Element) {
+				// A forged assertion next to the signed one.
+				a := res.Child(samlNS, "Assertion")
+				forged := *a
+				forged.SetAttr("ID", "_forged")
+				res.AddChild(&forged)
+			},
+		},
+		{
+			name: "failed status",
+			edit: func(res *Element) {
+				res.Child(samlpNS, "Status").Child(samlpNS, "StatusCode").
+					SetAttr("Value", "urn:oasis:names:tc:SAML:2.0:status:Requester")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp, sp := newTestPair(t)
+
+			reqID, res := roundTrip(t, idp, sp)
+
+			if tt.setup != nil {
+				tt.setup(idp, sp)
+			}
+
+			if tt.reqID != nil {
+				reqID = tt.reqID(reqID)
+			}
+
+			if tt.edit != nil {
+				res = edit(t, res, tt.edit)
+			}
+
+			_, err := sp.ParseResponse(res, reqID)
+			if err == nil {
+				t.Error("response should be rejected")
+			}
+		})
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	idp, sp := newTestPair(t)
+
+	ar, err := sp.AuthnRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := url.Parse(ar.URL)
+	msg := u.Query().Get("SAMLRequest")
+
+	// Unknown service provider
+	idp.Peers[0].EntityID = "https://other.example.com"
+
+	_, err = idp.ParseRequest(msg, "")
+	if err != ErrUnknownServiceProvider {
+		t.Errorf("expected unknown service provider, got: %v", err)
+	}
+
+	// Unregistered ACS
+	idp.Peers[0].EntityID = testSPEntityID
+	idp.Peers[0].ACSURL = "https://evil.example.com/acs"
+
+	_, err = idp.ParseRequest(msg, "")
+	if err == nil {
+		t.Error("request for unregistered ACS should be rejected")
+	}
+
+	// Stale
+	idp.Peers[0].ACSURL = testACSURL
+	idp.Now = func() time.Time { return time.Now().Add(time.Hour) }
+
+	_, err = idp.ParseRequest(msg, "")
+	if err == nil {
+		t.Error("stale request should be rejected")
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	idp, sp := newTestPair(t)
+
+	for _, md := range [][]byte{idp.Metadata(), sp.Metadata()} {
+		ed, err := Parse(md)
+		if err != nil {
+			t.Fatalf("invalid metadata: %s", err.Error())
+		}
+
+		if !ed.Is(mdNS, "EntityDescriptor") {
+			t.Errorf("unexpected metadata root '%s'", ed.Local)
+		}
+	}
+
+	ed, _ := Parse(idp.Metadata())
+	x509Cert := ed.Child(mdNS, "IDPSSODescriptor").Child(mdNS, "KeyDescriptor").
+		Child(dsigNS, "KeyInfo").Child(dsigNS, "X509Data").Child(dsigNS, "X509Certificate")
+
+	der, err := decodeBase64(x509Cert.Text())
+	if err != nil || !bytes.Equal(der, idp.KeyPair.Cert.Raw) {
+		t.Error("identity provider metadata should publish its certificate")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "saml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, "idp.key")
+	certFile := filepath.Join(dir, "idp.crt")
+
+	cfg := &config.Config{}
+	cfg.SetNamespace("grc")
+	cfg.SetValues(map[string]string{
+		"site.url":                         "granica.example.com",
+		"app.saml.idp.enabled":             "true",
+		"app.saml.idp.key.file":            keyFile,
+		"app.saml.idp.cert.file":           certFile,
+		"app.saml.idp.peers":               "wiki",
+		"app.saml.idp.peer.wiki.entity.id": testSPEntityID,
+		"app.saml.idp.peer.wiki.acs.url":   testACSURL,
+		"app.saml.providers":               "acme",
+		"app.saml.acme.label":              "ACME",
+		"app.saml.acme.idp.entity.id":      testIdPEntityID,
+		"app.saml.acme.idp.sso.url":        testSSOURL,
+		"app.saml.acme.idp.cert.file":      certFile,
+		"app.saml.acme.name.id.format":     "email",
+	})
+
+	idp, err := LoadIdentityProvider(cfg)
+	if err != nil {
+		t.Fatalf("cannot load identity provider: %s", err.Error())
+	}
+
+	if idp.EntityID != "https://granica.example.com/saml/idp/metadata" || len(idp.Peers) != 1 {
+		t.Errorf("unexpected identity provider: %+v", idp.IdPConfig)
+	}
+
+	// Generated key is reused on next start.
+	again, err := LoadIdentityProvider(cfg)
+	if err != nil || !bytes.Equal(again.KeyPair.Cert.Raw, idp.KeyPair.Cert.Raw) {
+		t.Errorf("key pair should be kept, err: %v", err)
+	}
+
+	sps, err := LoadServiceProviders(cfg)
+	if err != nil {
+		t.Fatalf("cannot load service providers: %s", err.Error())
+	}
+
+	sp, ok := sps.Get("ACME")
+	if !ok {
+		t.Fatal("provider 'acme' not found")
+	}
+
+	if sp.Label != "ACME" || sp.NameIDFormat != NameIDEmail ||
+		sp.ACSURL != "https://granica.example.com/users/saml/acme/acs" ||
+		sp.EntityID != "https://granica.example.com/users/saml/acme/metadata" {
+		t.Errorf("unexpected service provider: %+v", sp.SPConfig)
+	}
+
+	if len(sp.IdPCerts) != 1 || !bytes.Equal(sp.IdPCerts[0].Raw, idp.KeyPair.Cert.Raw) {
+		t.Error("identity provider certificate not loaded")
+	}
+
+	cfg.SetValues(map[string]string{})
+
+	idp, err = LoadIdentityProvider(cfg)
+	if err != nil || idp != nil {
+		t.Errorf("identity provider should be disabled, err: %v", err)
+	}
+}
@@ -0,0 +1,492 @@
+package saml
+
+import (
+	"crypto/x509"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultClockSkew = 2 * time.Minute
+	// Prefix of the provider name identities are linked with.
+	sourcePrefix = "saml:"
+)
+
+var (
+	// ErrInvalidResponse is returned for responses that can not be trusted.
+	ErrInvalidResponse = errors.New("invalid SAML response")
+	// ErrReplayed is returned when an assertion is presented twice.
+	ErrReplayed = errors.New("assertion already used")
+)
+
+type (
+	// SPConfig of Granica as service provider of an identity provider.
+	SPConfig struct {
+		// Name used in URLs and as identity provider name.
+		Name  string
+		Label string
+		// EntityID of the service provider, defaults to its metadata URL.
+		EntityID string
+		// ACSURL is the assertion consumer service URL.
+		ACSURL string
+		// Identity provider
+		IdPEntityID string
+		IdPSSOURL   string
+		IdPCerts    []*x509.Certificate
+		// NameIDFormat requested, persistent by default.
+		NameIDFormat string
+		// LinkByEmail links first sign-ins to the user owning the asserted email.
+		LinkByEmail bool
+		// Provision users on first sign-in.
+		Provision bool
+		// Attribute names
+		EmailAttr      string
+		UsernameAttr   string
+		GivenNameAttr  string
+		FamilyNameAttr string
+		// ClockSkew tolerated when checking validity windows.
+		ClockSkew time.Duration
+	}
+
+	// ServiceProvider consumes assertions issued by an identity provider.
+	ServiceProvider struct {
+		SPConfig
+		Now func() time.Time
+
+		mu sync.Mutex
+		// Assertion IDs already consumed, until they expire.
+		seen map[string]time.Time
+	}
+
+	// ServiceProviders by name.
+	ServiceProviders struct {
+		sps []*ServiceProvider
+	}
+
+	// AuthnRequest sent to an identity provider.
+	AuthnRequest struct {
+		ID string
+		// URL the user has to be redirected to.
+		URL string
+	}
+
+	// Identity asserted by an identity provider.
+	Identity struct {
+		Provider     string
+		Subject      string
+		NameIDFormat string
+		SessionIndex string
+		Email        string
+		Username     string
+		GivenName    string
+		FamilyName   string
+		Attributes   map[string][]string
+	}
+)
+
+// NewServiceProvider from cfg.
+func NewServiceProvider(cfg SPConfig) *ServiceProvider {
+	if cfg.Label == "" {
+		cfg.Label = cfg.Name
+	}
+
+	cfg.NameIDFormat = NameIDFormat(cfg.NameIDFormat)
+
+	if cfg.ClockSkew == 0 {
+		cfg.ClockSkew = defaultClockSkew
+	}
+
+	def := func(v *string, d string) {
+		if *v == "" {
+			*v = d
+		}
+	}
+
+	def(&cfg.EmailAttr, AttrEmail)
+	def(&cfg.UsernameAttr, AttrUsername)
+	def(&cfg.GivenNameAttr, AttrGivenName)
+	def(&cfg.FamilyNameAttr, AttrFamilyName)
+
+	return &ServiceProvider{
+		SPConfig: cfg,
+		Now:      time.Now,
+		seen:     map[string]time.Time{},
+	}
+}
+
+// Source returns the provider name identities asserted by it are linked with.
+func (sp *ServiceProvider) Source() string {
+	return sourcePrefix + sp.Name
+}
+
+// IsSource returns true if source identifies a SAML identity provider.
+func IsSource(source string) bool {
+	return strings.HasPrefix(source, sourcePrefix)
+}
+
+// SourceName returns the identity provider name of a source.
+func SourceName(source string) string {
+	return strings.TrimPrefix(source, sourcePrefix)
+}
+
+// AuthnRequest returns a request for the HTTP-Redirect binding.
+// Its ID has to be kept, bound to the user agent, to check the response.
+func (sp *ServiceProvider) AuthnRequest() (AuthnRequest, error) {
+	id, err := newID()
+	if err != nil {
+		return AuthnRequest{}, err
+	}
+
+	req := NewElement(samlpNS, "samlp", "AuthnRequest")
+	req.Namespaces["saml"] = samlNS
+	req.SetAttr("ID", id).
+		SetAttr("Version", "2.0").
+		SetAttr("IssueInstant", formatTime(sp.Now())).
+		SetAttr("Destination", sp.IdPSSOURL).
+		SetAttr("AssertionConsumerServiceURL", sp.ACSURL).
+		SetAttr("ProtocolBinding", bindingPOST)
+
+	req.AddChild(newSAMLElement("Issuer").SetText(sp.EntityID))
+	req.newChild("NameIDPolicy").
+		SetAttr("Format", sp.NameIDFormat).
+		SetAttr("AllowCreate", "true")
+
+	msg, err := encodeRedirect(req.Bytes())
+	if err != nil {
+		return AuthnRequest{}, err
+	}
+
+	u, err := url.Parse(sp.IdPSSOURL)
+	if err != nil {
+		return AuthnRequest{}, err
+	}
+
+	q := u.Query()
+	q.Set("SAMLRequest", msg)
+	u.RawQuery = q.Encode()
+
+	return AuthnRequest{ID: id, URL: u.String()}, nil
+}
+
+// ParseResponse validates a response received through the HTTP-POST binding
+// to the request with ID requestID and returns the asserted identity.
+// Unsolicited responses are not accepted.
+func (sp *ServiceProvider) ParseResponse(encoded, requestID string) (Identity, error) {
+	if requestID == "" {
+		return Identity{}, invalidf("no pending request")
+	}
+
+	b, err := decodeBase64(encoded)
+	if err != nil {
+		return Identity{}, invalidf("%s", err.Error())
+	}
+
+	res, err := Parse(b)
+	if err != nil {
+		return Identity{}, invalidf("%s", err.Error())
+	}
+
+	if !res.Is(samlpNS, "Response") {
+		return Identity{}, invalidf("not a response")
+	}
+
+	if d := res.Attr("Destination"); d != "" && d != sp.ACSURL {
+		return Identity{}, invalidf("unexpected destination '%s'", d)
+	}
+
+	if res.Attr("InResponseTo") != requestID {
+		return Identity{}, invalidf("response to other request")
+	}
+
+	if iss := res.Child(samlNS, "Issuer"); iss != nil && iss.Text() != sp.IdPEntityID {
+		return Identity{}, invalidf("unexpected issuer '%s'", iss.Text())
+	}
+
+	err = checkStatus(res)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	if res.Child(samlNS, "EncryptedAssertion") != nil {
+		return Identity{}, invalidf("encrypted assertions are not supported")
+	}
+
+	as := res.ChildrenNamed(samlNS, "Assertion")
+	if len(as) != 1 {
+		return Identity{}, invalidf("exactly one assertion expected")
+	}
+	a := as[0]
+
+	// Either the response or the assertion has to be signed.
+	// Data is only read from a, which is covered by the signature.
+	rerr := Verify(res, sp.IdPCerts)
+	if rerr != nil && rerr != ErrNotSigned {
+		return Identity{}, rerr
+	}
+
+	aerr := Verify(a, sp.IdPCerts)
+	if aerr != nil && (aerr != ErrNotSigned || rerr == ErrNotSigned) {
+		return Identity{}, aerr
+	}
+
+	return sp.readAssertion(a, requestID)
+}
+
+// readAssertion checks a verified assertion and extracts the identity.
+func (sp *ServiceProvider) readAssertion(a *Element, requestID string) (Identity, error) {
+	now := sp.Now()
+
+	iss := a.Child(samlNS, "Issuer")
+	if iss == nil || iss.Text() != sp.IdPEntityID {
+		return Identity{}, invalidf("unexpected assertion issuer")
+	}
+
+	// Subject
+	sub := a.Child(samlNS, "Subject")
+	if sub == nil {
+		return Identity{}, invalidf("no subject")
+	}
+
+	nid := sub.Child(samlNS, "NameID")
+	if nid == nil || nid.Text() == "" {
+		return Identity{}, invalidf("no name ID")
+	}
+
+	err := sp.checkConfirmation(sub, requestID, now)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	// Conditions
+	expires, err := sp.checkConditions(a.Child(samlNS, "Conditions"), now)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	err = sp.markSeen(a.Attr("ID"), expires, now)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	// Identity
+	id := Identity{
+		Provider:     sp.Source(),
+		Subject:      nid.Text(),
+		NameIDFormat: nid.Attr("Format"),
+		Attributes:   attributes(a),
+	}
+
+	if as := a.Child(samlNS, "AuthnStatement"); as != nil {
+		id.SessionIndex = as.Attr("SessionIndex")
+	}
+
+	first := func(name string) string {
+		if vs := id.Attributes[name]; len(vs) > 0 {
+			return vs[0]
+		}
+		return ""
+	}
+
+	id.Email = first(sp.EmailAttr)
+	id.Username = first(sp.UsernameAttr)
+	id.GivenName = first(sp.GivenNameAttr)
+	id.FamilyName = first(sp.FamilyNameAttr)
+
+	if id.Email == "" && id.NameIDFormat == NameIDEmail {
+		id.Email = id.Subject
+	}
+
+	return id, nil
+}
+
+// checkConfirmation requires a bearer confirmation for this request,
+// addressed to this service provider and not expired.
+func (sp *ServiceProvider) checkConfirmation(sub *Element, requestID string, now time.Time) error {
+	for _, sc := range sub.ChildrenNamed(samlNS, "SubjectConfirmation") {
+		if sc.Attr("Method") != confirmationBearer {
+			continue
+		}
+
+		scd := sc.Child(samlNS, "SubjectConfirmationData")
+		if scd == nil {
+			continue
+		}
+
+		if scd.Attr("Recipient") != sp.ACSURL {
+			continue
+		}
+
+		if irt := scd.Attr("InResponseTo"); irt != "" && irt != requestID {
+			continue
+		}
+
+		noa, err := parseTime(scd.Attr("NotOnOrAfter"))
+		if err != nil || !now.Before(noa.Add(sp.ClockSkew)) {
+			continue
+		}
+
+		return nil
+	}
+
+	return invalidf("no valid bearer subject confirmation")
+}
+
+// checkConditions checks the validity window and audience
+// and returns when the assertion expires.
+func (sp *ServiceProvider) checkConditions(c *Element, now time.Time) (time.Time, error) {
+	if c == nil {
+		return time.Time{}, invalidf("no conditions")
+	}
+
+	if nb := c.Attr("NotBefore"); nb != "" {
+		t, err := parseTime(nb)
+		if err != nil || now.Add(sp.ClockSkew).Before(t) {
+			return time.Time{}, invalidf("assertion not yet valid")
+		}
+	}
+
+	noa, err := parseTime(c.Attr("NotOnOrAfter"))
+	if err != nil {
+		return time.Time{}, invalidf("assertion without expiration")
+	}
+
+	if !now.Before(noa.Add(sp.ClockSkew)) {
+		return time.Time{}, invalidf("assertion expired")
+	}
+
+	// Every audience restriction has to include us.
+	ars := c.ChildrenNamed(samlNS, "AudienceRestriction")
+	if len(ars) == 0 {
+		return time.Time{}, invalidf("no audience restriction")
+	}
+
+	for _, ar := range ars {
+		var ok bool
+		for _, aud := range ar.ChildrenNamed(samlNS, "Audience") {
+			if aud.Text() == sp.EntityID {
+				ok = true
+				break
+			}
+		}
+
+		if !ok {
+			return time.Time{}, invalidf("not an audience")
+		}
+	}
+
+	return noa.Add(sp.ClockSkew), nil
+}
+
+// markSeen rejects assertions already consumed.
+func (sp *ServiceProvider) markSeen(id string, expires, now time.Time) error {
+	if id == "" {
+		return invalidf("assertion without ID")
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	for k, exp := range sp.seen {
+		if !now.Before(exp) {
+			delete(sp.seen, k)
+		}
+	}
+
+	if _, ok := sp.seen[id]; ok {
+		return ErrReplayed
+	}
+
+	sp.seen[id] = expires
+	return nil
+}
+
+// Metadata describes the service provider to identity providers.
+func (sp *ServiceProvider) Metadata() []byte {
+	ed := NewElement(mdNS, "md", "EntityDescriptor")
+	ed.SetAttr("entityID", sp.EntityID)
+
+	sd := ed.newChild("SPSSODescriptor")
+	sd.SetAttr("AuthnRequestsSigned", "false").
+		SetAttr("WantAssertionsSigned", "true").
+		SetAttr("protocolSupportEnumeration", samlpNS)
+
+	sd.newChild("NameIDFormat").SetText(sp.NameIDFormat)
+	sd.newChild("AssertionConsumerService").
+		SetAttr("Binding", bindingPOST).
+		SetAttr("Location", sp.ACSURL).
+		SetAttr("index", "0").
+		SetAttr("isDefault", "true")
+
+	return metadata(ed)
+}
+
+func checkStatus(res *Element) error {
+	st := res.Child(samlpNS, "Status")
+	if st == nil {
+		return invalidf("no status")
+	}
+
+	sc := st.Child(samlpNS, "StatusCode")
+	if sc == nil {
+		return invalidf("no status code")
+	}
+
+	if sc.Attr("Value") != statusSuccess {
+		return invalidf("status '%s'", sc.Attr("Value"))
+	}
+
+	return nil
+}
+
+// attributes returns the assertion attribute values
+// by name and by friendly name.
+func attributes(a *Element) map[string][]string {
+	attrs := map[string][]string{}
+	for _, st := range a.ChildrenNamed(samlNS, "AttributeStatement") {
+		for _, at := range st.ChildrenNamed(samlNS, "Attribute") {
+			var vals []string
+			for _, v := range at.ChildrenNamed(samlNS, "AttributeValue") {
+				vals = append(vals, v.Text())
+			}
+
+			for _, name := range []string{at.Attr("Name"), at.Attr("FriendlyName")} {
+				if name != "" {
+					attrs[name] = append(attrs[name], vals...)
+				}
+			}
+		}
+	}
+	return attrs
+}
+
+// NewServiceProviders from sps.
+func NewServiceProviders(sps ...*ServiceProvider) *ServiceProviders {
+	return &ServiceProviders{sps: sps}
+}
+
+// Get returns the service provider for identity provider name.
+func (ss *ServiceProviders) Get(name string) (*ServiceProvider, bool) {
+	if ss == nil {
+		return nil, false
+	}
+
+	for _, sp := range ss.sps {
+		if sp.Name == strings.ToLower(name) {
+			return sp, true
+		}
+	}
+
+	return nil, false
+}
+
+// List returns all configured service providers.
+func (ss *ServiceProviders) List() []*ServiceProvider {
+	if ss == nil {
+		return nil
+	}
+
+	return ss.sps
+}
@@ -0,0 +1,296 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Minimal XML tree keeping namespace prefixes as written,
+// as needed to canonicalize and verify signed documents.
+
+const (
+	xmlNS = "http://www.w3.org/XML/1998/namespace"
+)
+
+const (
+	// Limit on parsed documents size.
+	maxDocumentSize = 1 << 20
+)
+
+type (
+	// Element of an XML tree.
+	Element struct {
+		// Space is the namespace URI.
+		Space  string
+		Prefix string
+		Local  string
+		Attrs  []Attr
+		// Namespaces declared on the element, by prefix.
+		// The default namespace uses an empty prefix.
+		Namespaces map[string]string
+		// Children are either *Element or Text.
+		Children []interface{}
+		Parent   *Element
+	}
+
+	// Attr of an element, namespace declarations are not included.
+	Attr struct {
+		Space  string
+		Prefix string
+		Local  string
+		Value  string
+	}
+
+	// Text content.
+	Text string
+)
+
+// NewElement returns an element in namespace space using prefix.
+// The namespace is declared on the element.
+func NewElement(space, prefix, local string) *Element {
+	return &Element{
+		Space:      space,
+		Prefix:     prefix,
+		Local:      local,
+		Namespaces: map[string]string{prefix: space},
+	}
+}
+
+// newChild returns a new element in the same namespace as e, appended to it.
+func (e *Element) newChild(local string) *Element {
+	c := &Element{Space: e.Space, Prefix: e.Prefix, Local: local}
+	e.AddChild(c)
+	return c
+}
+
+// AddChild appends c to the children of e.
+func (e *Element) AddChild(c *Element) *Element {
+	c.Parent = e
+	e.Children = append(e.Children, c)
+	return e
+}
+
+// insertChild inserts c at position i.
+func (e *Element) insertChild(i int, c *Element) {
+	c.Parent = e
+	e.Children = append(e.Children, nil)
+	copy(e.Children[i+1:], e.Children[i:])
+	e.Children[i] = c
+}
+
+// SetText replaces the contents of e with text.
+func (e *Element) SetText(text string) *Element {
+	e.Children = []interface{}{Text(text)}
+	return e
+}
+
+// SetAttr sets an attribute without namespace.
+func (e *Element) SetAttr(name, value string) *Element {
+	for i, a := range e.Attrs {
+		if a.Space == "" && a.Local == name {
+			e.Attrs[i].Value = value
+			return e
+		}
+	}
+
+	e.Attrs = append(e.Attrs, Attr{Local: name, Value: value})
+	return e
+}
+
+// Attr returns the value of an attribute without namespace.
+func (e *Element) Attr(name string) string {
+	for _, a := range e.Attrs {
+		if a.Space == "" && a.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// Is returns true if e is the element local in namespace space.
+func (e *Element) Is(space, local string) bool {
+	return e.Space == space && e.Local == local
+}
+
+// Child returns the first child element local in namespace space.
+func (e *Element) Child(space, local string) *Element {
+	for _, c := range e.Elements() {
+		if c.Is(space, local) {
+			return c
+		}
+	}
+	return nil
+}
+
+// ChildrenNamed returns the child elements local in namespace space.
+func (e *Element) ChildrenNamed(space, local string) []*Element {
+	var cs []*Element
+	for _, c := range e.Elements() {
+		if c.Is(space, local) {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// Elements returns the child elements of e.
+func (e *Element) Elements() []*Element {
+	var cs []*Element
+	for _, c := range e.Children {
+		if ce, ok := c.(*Element); ok {
+			cs = append(cs, ce)
+		}
+	}
+	return cs
+}
+
+// Text returns the concatenated text content of e, without descendants.
+func (e *Element) Text() string {
+	var b strings.Builder
+	for _, c := range e.Children {
+		if t, ok := c.(Text); ok {
+			b.WriteString(string(t))
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// Bytes returns the serialized element.
+// Output is canonical, unused namespace declarations are dropped.
+func (e *Element) Bytes() []byte {
+	return canonicalize(e, nil)
+}
+
+// lookupNS resolves prefix in the scope of e.
+func (e *Element) lookupNS(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNS, true
+	}
+
+	for el := e; el != nil; el = el.Parent {
+		if uri, ok := el.Namespaces[prefix]; ok {
+			return uri, true
+		}
+	}
+
+	return "", false
+}
+
+// without returns a shallow copy of e excluding child c.
+func (e *Element) without(c *Element) *Element {
+	cp := *e
+	cp.Children = nil
+	for _, ch := range e.Children {
+		if ch != c {
+			cp.Children = append(cp.Children, ch)
+		}
+	}
+	return &cp
+}
+
+// Parse reads an XML document.
+// Document type declarations are rejected, SAML does not use them
+// and they are the door to entity expansion attacks.
+func Parse(b []byte) (*Element, error) {
+	if len(b) > maxDocumentSize {
+		return nil, errors.New("XML document too large")
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(b))
+
+	var root, cur *Element
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if root != nil && cur == nil {
+				return nil, errors.New("more than one root element")
+			}
+
+			e, err := newParsedElement(t, cur)
+			if err != nil {
+				return nil, err
+			}
+
+			if cur == nil {
+				root = e
+			} else {
+				cur.Children = append(cur.Children, e)
+			}
+			cur = e
+
+		case xml.EndElement:
+			if cur == nil || t.Name.Space != cur.Prefix || t.Name.Local != cur.Local {
+				return nil, fmt.Errorf("unexpected end element '%s'", t.Name.Local)
+			}
+			cur = cur.Parent
+
+		case xml.CharData:
+			if cur != nil {
+				cur.Children = append(cur.Children, Text(string(t)))
+			}
+
+		case xml.Directive:
+			return nil, errors.New("document type declarations not allowed")
+		}
+	}
+
+	if root == nil || cur != nil {
+		return nil, errors.New("incomplete XML document")
+	}
+
+	return root, nil
+}
+
+func newParsedElement(t xml.StartElement, parent *Element) (*Element, error) {
+	e := &Element{
+		Prefix:     t.Name.Space,
+		Local:      t.Name.Local,
+		Namespaces: map[string]string{},
+		Parent:     parent,
+	}
+
+	for _, a := range t.Attr {
+		switch {
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			e.Namespaces[""] = a.Value
+		case a.Name.Space == "xmlns":
+			e.Namespaces[a.Name.Local] = a.Value
+		}
+	}
+
+	space, ok := e.lookupNS(e.Prefix)
+	if !ok && e.Prefix != "" {
+		return nil, fmt.Errorf("undeclared namespace prefix '%s'", e.Prefix)
+	}
+	e.Space = space
+
+	for _, a := range t.Attr {
+		if (a.Name.Space == "" && a.Name.Local == "xmlns") || a.Name.Space == "xmlns" {
+			continue
+		}
+
+		attr := Attr{Prefix: a.Name.Space, Local: a.Name.Local, Value: a.Value}
+		if attr.Prefix != "" {
+			attr.Space, ok = e.lookupNS(attr.Prefix)
+			if !ok {
+				return nil, fmt.Errorf("undeclared namespace prefix '%s'", attr.Prefix)
+			}
+		}
+
+		e.Attrs = append(e.Attrs, attr)
+	}
+
+	return e, nil
+}
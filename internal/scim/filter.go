@@ -0,0 +1,657 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type (
+	// Filter selects resources, see RFC 7644 section 3.4.2.2.
+	// It is evaluated against the JSON object form of a resource.
+	Filter interface {
+		Match(res map[string]interface{}) bool
+	}
+
+	// AttrPath of an attribute, its sub-attribute and, for filtered
+	// multi-valued attributes, the filter selecting elements.
+	AttrPath struct {
+		Attr   string
+		Sub    string
+		Filter Filter
+	}
+
+	logicalExpr struct {
+		and         bool
+		left, right Filter
+	}
+
+	notExpr struct {
+		f Filter
+	}
+
+	attrExpr struct {
+		path  AttrPath
+		op    string
+		value interface{}
+	}
+
+	// valuePath matches resources with an element of a
+	// multi-valued attribute matching the filter.
+	valuePath struct {
+		attr string
+		f    Filter
+	}
+)
+
+type (
+	tokenKind int
+
+	token struct {
+		kind tokenKind
+		text string
+		// Decoded literal for strings, numbers, booleans and null.
+		val interface{}
+	}
+
+	filterParser struct {
+		toks []token
+		pos  int
+	}
+)
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokLiteral
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+)
+
+var compareOps = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// caseExact attributes compare values with case.
+var caseExact = map[string]bool{
+	"id":         true,
+	"externalid": true,
+}
+
+// ParseFilter parses a filter expression.
+func ParseFilter(s string) (Filter, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{toks: toks}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind != tokEOF {
+		return nil, BadRequest(InvalidFilter, "unexpected '%s'", p.peek().text)
+	}
+
+	return f, nil
+}
+
+// ParseAttrPath parses an attribute path as used by patch operations:
+// 'attr', 'attr.sub', 'attr[filter]' or 'attr[filter].sub'.
+// Attributes can be prefixed with their schema URN.
+func ParseAttrPath(s string) (AttrPath, error) {
+	var ap AttrPath
+	s = strings.TrimSpace(s)
+
+	i := strings.IndexByte(s, '[')
+	if i < 0 {
+		return splitAttr(s)
+	}
+
+	j := closingBracket(s, i)
+	if j < 0 {
+		return ap, BadRequest(InvalidPath, "unbalanced brackets in '%s'", s)
+	}
+
+	ap, err := splitAttr(s[:i])
+	if err != nil {
+		return ap, err
+	}
+
+	if ap.Sub != "" {
+		return ap, BadRequest(InvalidPath, "filter on sub-attribute in '%s'", s)
+	}
+
+	f, err := ParseFilter(s[i+1 : j])
+	if err != nil {
+		return ap, BadRequest(InvalidPath, "invalid filter in '%s'", s)
+	}
+	ap.Filter = f
+
+	rest := s[j+1:]
+	if rest != "" {
+		if !strings.HasPrefix(rest, ".") || !isAttrName(rest[1:]) {
+			return ap, BadRequest(InvalidPath, "invalid sub-attribute in '%s'", s)
+		}
+		ap.Sub = rest[1:]
+	}
+
+	return ap, nil
+}
+
+// splitAttr splits 'urn:...:attr.sub' in attribute and sub-attribute.
+// Core schema prefixes are dropped, others are kept as part of the
+// attribute name, extension attributes are accepted but not stored.
+func splitAttr(s string) (AttrPath, error) {
+	var ap AttrPath
+
+	urn := ""
+	if strings.HasPrefix(strings.ToLower(s), "urn:") {
+		i := strings.LastIndexByte(s, ':')
+		urn, s = s[:i], s[i+1:]
+	}
+
+	parts := strings.SplitN(s, ".", 2)
+	ap.Attr = parts[0]
+	if len(parts) > 1 {
+		ap.Sub = parts[1]
+	}
+
+	if !isAttrName(ap.Attr) || (len(parts) > 1 && !isAttrName(ap.Sub)) {
+		return ap, BadRequest(InvalidPath, "invalid attribute '%s'", s)
+	}
+
+	if urn != "" && !strings.EqualFold(urn, UserSchema) && !strings.EqualFold(urn, GroupSchema) {
+		ap.Attr = urn + ":" + ap.Attr
+	}
+
+	return ap, nil
+}
+
+func isAttrName(s string) bool {
+	if s == "$ref" {
+		return true
+	}
+
+	if s == "" {
+		return false
+	}
+
+	for i, r := range s {
+		if r == '_' || r == '-' || unicode.IsDigit(r) {
+			if i == 0 {
+				return false
+			}
+			continue
+		}
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// closingBracket returns the index of the bracket closing the one at i
+// skipping over quoted strings.
+func closingBracket(s string, i int) int {
+	inStr := false
+	for j := i + 1; j < len(s); j++ {
+		switch {
+		case inStr && s[j] == '\\':
+			j++
+		case s[j] == '"':
+			inStr = !inStr
+		case !inStr && s[j] == ']':
+			return j
+		}
+	}
+	return -1
+}
+
+func lex(s string) ([]token, error) {
+	var toks []token
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '(':
+			toks = append(toks, token{kind: tokLParen, text: "("})
+			i++
+
+		case c == ')':
+			toks = append(toks, token{kind: tokRParen, text: ")"})
+			i++
+
+		case c == '[':
+			toks = append(toks, token{kind: tokLBracket, text: "["})
+			i++
+
+		case c == ']':
+			toks = append(toks, token{kind: tokRBracket, text: "]"})
+			i++
+
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+
+			if j >= len(s) {
+				return nil, BadRequest(InvalidFilter, "unterminated string")
+			}
+
+			var v string
+			err := json.Unmarshal([]byte(s[i:j+1]), &v)
+			if err != nil {
+				return nil, BadRequest(InvalidFilter, "invalid string %s", s[i:j+1])
+			}
+
+			toks = append(toks, token{kind: tokLiteral, text: s[i : j+1], val: v})
+			i = j + 1
+
+		default:
+			j := i
+			for ; j < len(s); j++ {
+				if strings.IndexByte(" \t\n\r()[]\"", s[j]) >= 0 {
+					break
+				}
+			}
+
+			toks = append(toks, wordToken(s[i:j]))
+			i = j
+		}
+	}
+
+	return append(toks, token{kind: tokEOF, text: "end of filter"}), nil
+}
+
+// wordToken classifies bare words, literals other than strings are words too.
+func wordToken(w string) token {
+	switch strings.ToLower(w) {
+	case "true":
+		return token{kind: tokLiteral, text: w, val: true}
+	case "false":
+		return token{kind: tokLiteral, text: w, val: false}
+	case "null":
+		return token{kind: tokLiteral, text: w, val: nil}
+	}
+
+	if n, err := strconv.ParseFloat(w, 64); err == nil && (w[0] == '-' || unicode.IsDigit(rune(w[0]))) {
+		return token{kind: tokLiteral, text: w, val: n}
+	}
+
+	return token{kind: tokWord, text: w}
+}
+
+func (p *filterParser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *filterParser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) isKeyword(kw string) bool {
+	t := p.peek()
+	return t.kind == tokWord && strings.EqualFold(t.text, kw)
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (Filter, error) {
+	if p.isKeyword("not") {
+		p.next()
+		if p.peek().kind != tokLParen {
+			return nil, BadRequest(InvalidFilter, "expected '(' after not")
+		}
+		f, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{f: f}, nil
+	}
+
+	if p.peek().kind == tokLParen {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next().kind != tokRParen {
+			return nil, BadRequest(InvalidFilter, "expected ')'")
+		}
+		return f, nil
+	}
+
+	return p.parseAttrExpr()
+}
+
+func (p *filterParser) parseAttrExpr() (Filter, error) {
+	t := p.next()
+	if t.kind != tokWord {
+		return nil, BadRequest(InvalidFilter, "expected attribute, got '%s'", t.text)
+	}
+
+	path, err := splitAttr(t.text)
+	if err != nil {
+		return nil, BadRequest(InvalidFilter, "invalid attribute '%s'", t.text)
+	}
+
+	if p.peek().kind == tokLBracket {
+		if path.Sub != "" {
+			return nil, BadRequest(InvalidFilter, "filter on sub-attribute '%s'", t.text)
+		}
+
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next().kind != tokRBracket {
+			return nil, BadRequest(InvalidFilter, "expected ']'")
+		}
+
+		return valuePath{attr: path.Attr, f: f}, nil
+	}
+
+	op := p.next()
+	if op.kind != tokWord {
+		return nil, BadRequest(InvalidFilter, "expected operator after '%s'", t.text)
+	}
+
+	opName := strings.ToLower(op.text)
+	if opName == "pr" {
+		return attrExpr{path: path, op: opName}, nil
+	}
+
+	if !compareOps[opName] {
+		return nil, BadRequest(InvalidFilter, "unknown operator '%s'", op.text)
+	}
+
+	v := p.next()
+	if v.kind != tokLiteral {
+		return nil, BadRequest(InvalidFilter, "expected value after '%s %s'", t.text, op.text)
+	}
+
+	return attrExpr{path: path, op: opName, value: v.val}, nil
+}
+
+func (e logicalExpr) Match(res map[string]interface{}) bool {
+	if e.and {
+		return e.left.Match(res) && e.right.Match(res)
+	}
+	return e.left.Match(res) || e.right.Match(res)
+}
+
+func (e notExpr) Match(res map[string]interface{}) bool {
+	return !e.f.Match(res)
+}
+
+func (e valuePath) Match(res map[string]interface{}) bool {
+	v, ok := lookup(res, e.attr)
+	if !ok {
+		return false
+	}
+
+	for _, el := range asList(v) {
+		m, ok := el.(map[string]interface{})
+		if ok && e.f.Match(m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e attrExpr) Match(res map[string]interface{}) bool {
+	vals := values(res, e.path)
+
+	if e.op == "pr" {
+		for _, v := range vals {
+			if present(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if e.value == nil {
+		hasValue := false
+		for _, v := range vals {
+			hasValue = hasValue || present(v)
+		}
+		return (e.op == "eq") != hasValue
+	}
+
+	exact := caseExact[strings.ToLower(e.path.Attr)] && e.path.Sub == ""
+
+	if e.op == "ne" {
+		for _, v := range vals {
+			if compare(v, "eq", e.value, exact) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range vals {
+		if compare(v, e.op, e.value, exact) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// values returns the values at path, several for multi-valued attributes.
+// Complex multi-valued attributes compare on their 'value' sub-attribute.
+func values(res map[string]interface{}, path AttrPath) []interface{} {
+	v, ok := lookup(res, path.Attr)
+	if !ok {
+		return nil
+	}
+
+	if _, isList := v.([]interface{}); !isList && path.Sub == "" {
+		return []interface{}{v}
+	}
+
+	var vals []interface{}
+	for _, el := range asList(v) {
+		m, isMap := el.(map[string]interface{})
+		switch {
+		case path.Sub != "" && isMap:
+			if sv, ok := lookup(m, path.Sub); ok {
+				vals = append(vals, asList(sv)...)
+			}
+		case path.Sub == "" && isMap:
+			if sv, ok := lookup(m, "value"); ok {
+				vals = append(vals, sv)
+			}
+		case path.Sub == "":
+			vals = append(vals, el)
+		}
+	}
+
+	return vals
+}
+
+// lookup returns the value of attribute name, names are case insensitive.
+func lookup(m map[string]interface{}, name string) (interface{}, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// key returns the existing key in m for attribute name, or name itself.
+func key(m map[string]interface{}, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+
+	return name
+}
+
+func asList(v interface{}) []interface{} {
+	if l, ok := v.([]interface{}); ok {
+		return l
+	}
+	return []interface{}{v}
+}
+
+func present(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case string:
+		return t != ""
+	case []interface{}:
+		return len(t) > 0
+	case map[string]interface{}:
+		return len(t) > 0
+	}
+	return true
+}
+
+func compare(v interface{}, op string, want interface{}, exact bool) bool {
+	switch w := want.(type) {
+	case string:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		return compareStrings(s, op, w, exact)
+
+	case bool:
+		b, ok := v.(bool)
+		if !ok {
+			if s, isStr := v.(string); isStr {
+				b, ok = strings.EqualFold(s, "true"), strings.EqualFold(s, "true") || strings.EqualFold(s, "false")
+			}
+		}
+		return ok && op == "eq" && b == w
+
+	case float64:
+		n, ok := number(v)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return n == w
+		case "gt":
+			return n > w
+		case "ge":
+			return n >= w
+		case "lt":
+			return n < w
+		case "le":
+			return n <= w
+		}
+	}
+
+	return false
+}
+
+func compareStrings(s, op, w string, exact bool) bool {
+	if !exact {
+		s, w = strings.ToLower(s), strings.ToLower(w)
+	}
+
+	switch op {
+	case "eq":
+		return s == w
+	case "co":
+		return strings.Contains(s, w)
+	case "sw":
+		return strings.HasPrefix(s, w)
+	case "ew":
+		return strings.HasSuffix(s, w)
+	case "gt":
+		return s > w
+	case "ge":
+		return s >= w
+	case "lt":
+		return s < w
+	case "le":
+		return s <= w
+	}
+
+	return false
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
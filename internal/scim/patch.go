@@ -0,0 +1,370 @@
+package scim
+
+import (
+	"reflect"
+	"strings"
+)
+
+type (
+	// PatchRequest modifies a resource, see RFC 7644 section 3.5.2.
+	PatchRequest struct {
+		Schemas    []string  `json:"schemas"`
+		Operations []PatchOp `json:"Operations"`
+	}
+
+	// PatchOp is a single operation of a patch request.
+	PatchOp struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path,omitempty"`
+		Value interface{} `json:"value,omitempty"`
+	}
+)
+
+// readOnly attributes can not be modified by patch operations.
+var readOnly = map[string]bool{
+	"id":   true,
+	"meta": true,
+}
+
+// ParsePatch decodes and checks a patch request body.
+func ParsePatch(body []byte) (PatchRequest, error) {
+	var pr PatchRequest
+
+	err := Decode(body, &pr)
+	if err != nil {
+		return pr, err
+	}
+
+	if !hasSchema(pr.Schemas, PatchOpSchema) {
+		return pr, BadRequest(InvalidSyntax, "patch request must use the '%s' schema", PatchOpSchema)
+	}
+
+	if len(pr.Operations) == 0 {
+		return pr, BadRequest(InvalidSyntax, "no patch operations")
+	}
+
+	for i, op := range pr.Operations {
+		// Some providers capitalize operation names.
+		op.Op = strings.ToLower(op.Op)
+		switch op.Op {
+		case "add", "replace", "remove":
+		default:
+			return pr, BadRequest(InvalidSyntax, "unknown patch operation '%s'", op.Op)
+		}
+		pr.Operations[i] = op
+	}
+
+	return pr, nil
+}
+
+// Apply the operations in order to res, a resource in JSON object form.
+// Operations are all or nothing, callers discard res on error.
+func (pr PatchRequest) Apply(res map[string]interface{}) error {
+	for _, op := range pr.Operations {
+		err := op.apply(res)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (op PatchOp) apply(res map[string]interface{}) error {
+	if op.Op == "remove" {
+		if op.Path == "" {
+			return BadRequest(NoTarget, "remove operation needs a path")
+		}
+
+		ap, err := ParseAttrPath(op.Path)
+		if err != nil {
+			return err
+		}
+
+		return remove(res, ap, op.Value)
+	}
+
+	replace := op.Op == "replace"
+
+	if op.Path != "" {
+		ap, err := ParseAttrPath(op.Path)
+		if err != nil {
+			return err
+		}
+
+		return set(res, ap, op.Value, replace)
+	}
+
+	// Without path the value holds the attributes to modify.
+	m, ok := op.Value.(map[string]interface{})
+	if !ok {
+		return BadRequest(InvalidValue, "%s operation without path needs an object value", op.Op)
+	}
+
+	for k, v := range m {
+		if strings.EqualFold(k, "schemas") {
+			continue
+		}
+
+		ap, err := splitAttr(k)
+		if err != nil {
+			return err
+		}
+
+		err = set(res, ap, v, replace)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// set the value at path.
+// Complex values are merged into existing ones, multi-valued attributes
+// are appended to by add and overwritten by replace.
+func set(res map[string]interface{}, ap AttrPath, v interface{}, replace bool) error {
+	if readOnly[strings.ToLower(ap.Attr)] {
+		return BadRequest(Mutability, "attribute '%s' is read only", ap.Attr)
+	}
+
+	k := key(res, ap.Attr)
+	cur, exists := res[k]
+
+	if ap.Filter != nil {
+		return setFiltered(res, k, ap, v)
+	}
+
+	if ap.Sub != "" {
+		switch c := cur.(type) {
+		case map[string]interface{}:
+			c[key(c, ap.Sub)] = v
+		case nil:
+			res[k] = map[string]interface{}{ap.Sub: v}
+		default:
+			return BadRequest(InvalidPath, "attribute '%s' has no sub-attribute '%s'", ap.Attr, ap.Sub)
+		}
+		return nil
+	}
+
+	if !exists || cur == nil {
+		res[k] = v
+		return nil
+	}
+
+	switch c := cur.(type) {
+	case []interface{}:
+		if !replace {
+			res[k] = appendUnique(c, asList(v))
+			return nil
+		}
+
+	case map[string]interface{}:
+		if nv, ok := v.(map[string]interface{}); ok {
+			for sk, sv := range nv {
+				c[key(c, sk)] = sv
+			}
+			return nil
+		}
+	}
+
+	res[k] = v
+	return nil
+}
+
+// setFiltered sets the value on the elements of a multi-valued attribute
+// selected by the path filter. When none matches and the filter only
+// compares for equality the element is created, as providers expect
+// 'emails[type eq "work"].value' to add a work email.
+func setFiltered(res map[string]interface{}, k string, ap AttrPath, v interface{}) error {
+	var list []interface{}
+	if cur, ok := res[k]; ok && cur != nil {
+		list = asList(cur)
+	}
+
+	matched := false
+	for _, el := range list {
+		m, ok := el.(map[string]interface{})
+		if !ok || !ap.Filter.Match(m) {
+			continue
+		}
+
+		matched = true
+		err := setElement(m, ap, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !matched {
+		m, ok := equalities(ap.Filter)
+		if !ok {
+			return BadRequest(NoTarget, "no values of '%s' match the filter", ap.Attr)
+		}
+
+		err := setElement(m, ap, v)
+		if err != nil {
+			return err
+		}
+
+		list = append(list, m)
+	}
+
+	res[k] = list
+	return nil
+}
+
+func setElement(el map[string]interface{}, ap AttrPath, v interface{}) error {
+	if ap.Sub != "" {
+		el[key(el, ap.Sub)] = v
+		return nil
+	}
+
+	nv, ok := v.(map[string]interface{})
+	if !ok {
+		return BadRequest(InvalidValue, "values of '%s' are complex, an object is needed", ap.Attr)
+	}
+
+	for sk, sv := range nv {
+		el[key(el, sk)] = sv
+	}
+
+	return nil
+}
+
+// remove the value at path.
+// A value on a path without filter selects the elements to remove,
+// by their 'value' sub-attribute, as some providers remove group members.
+func remove(res map[string]interface{}, ap AttrPath, v interface{}) error {
+	if readOnly[strings.ToLower(ap.Attr)] {
+		return BadRequest(Mutability, "attribute '%s' is read only", ap.Attr)
+	}
+
+	k := key(res, ap.Attr)
+	cur, exists := res[k]
+	if !exists {
+		return nil
+	}
+
+	list, isList := cur.([]interface{})
+
+	switch {
+	case ap.Filter != nil:
+		var kept []interface{}
+		for _, el := range list {
+			m, ok := el.(map[string]interface{})
+			if !ok || !ap.Filter.Match(m) {
+				kept = append(kept, el)
+				continue
+			}
+
+			if ap.Sub != "" {
+				delete(m, key(m, ap.Sub))
+				kept = append(kept, m)
+			}
+		}
+		list = kept
+
+	case ap.Sub != "":
+		for _, el := range asList(cur) {
+			if m, ok := el.(map[string]interface{}); ok {
+				delete(m, key(m, ap.Sub))
+			}
+		}
+		return nil
+
+	case isList && v != nil:
+		var kept []interface{}
+		for _, el := range list {
+			if !containsValue(asList(v), el) {
+				kept = append(kept, el)
+			}
+		}
+		list = kept
+
+	default:
+		delete(res, k)
+		return nil
+	}
+
+	if len(list) == 0 {
+		delete(res, k)
+		return nil
+	}
+
+	res[k] = list
+	return nil
+}
+
+// equalities returns the attributes a filter made only of 'eq'
+// comparisons joined by 'and' requires.
+func equalities(f Filter) (map[string]interface{}, bool) {
+	switch e := f.(type) {
+	case attrExpr:
+		if e.op != "eq" || e.path.Sub != "" || e.value == nil {
+			return nil, false
+		}
+		return map[string]interface{}{e.path.Attr: e.value}, true
+
+	case logicalExpr:
+		if !e.and {
+			return nil, false
+		}
+
+		l, ok := equalities(e.left)
+		if !ok {
+			return nil, false
+		}
+
+		r, ok := equalities(e.right)
+		if !ok {
+			return nil, false
+		}
+
+		for k, v := range r {
+			l[k] = v
+		}
+		return l, true
+	}
+
+	return nil, false
+}
+
+func appendUnique(list, vals []interface{}) []interface{} {
+	for _, v := range vals {
+		if !containsValue(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// containsValue reports if list holds v, complex values
+// are compared by their 'value' sub-attribute if they have one.
+func containsValue(list []interface{}, v interface{}) bool {
+	vv := elementValue(v)
+	for _, el := range list {
+		if reflect.DeepEqual(elementValue(el), vv) {
+			return true
+		}
+	}
+	return false
+}
+
+func elementValue(el interface{}) interface{} {
+	if m, ok := el.(map[string]interface{}); ok {
+		if v, ok := lookup(m, "value"); ok {
+			return v
+		}
+	}
+	return el
+}
+
+func hasSchema(schemas []string, schema string) bool {
+	for _, s := range schemas {
+		if strings.EqualFold(s, schema) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package scim
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type (
+	// Bool accepts JSON booleans and the "True" / "False" strings
+	// some identity providers send instead.
+	Bool bool
+
+	// User resource.
+	User struct {
+		Schemas     []string     `json:"schemas"`
+		ID          string       `json:"id,omitempty"`
+		ExternalID  string       `json:"externalId,omitempty"`
+		UserName    string       `json:"userName"`
+		Name        *Name        `json:"name,omitempty"`
+		DisplayName string       `json:"displayName,omitempty"`
+		Password    string       `json:"password,omitempty"`
+		Active      *Bool        `json:"active,omitempty"`
+		Emails      []MultiValue `json:"emails,omitempty"`
+		Groups      []Member     `json:"groups,omitempty"`
+		Meta        *Meta        `json:"meta,omitempty"`
+	}
+
+	// Name of a user.
+	Name struct {
+		Formatted  string `json:"formatted,omitempty"`
+		GivenName  string `json:"givenName,omitempty"`
+		FamilyName string `json:"familyName,omitempty"`
+	}
+
+	// MultiValue attribute element.
+	MultiValue struct {
+		Value   string `json:"value"`
+		Type    string `json:"type,omitempty"`
+		Display string `json:"display,omitempty"`
+		Primary Bool   `json:"primary,omitempty"`
+	}
+
+	// Group resource.
+	Group struct {
+		Schemas     []string `json:"schemas"`
+		ID          string   `json:"id,omitempty"`
+		ExternalID  string   `json:"externalId,omitempty"`
+		DisplayName string   `json:"displayName"`
+		Members     []Member `json:"members,omitempty"`
+		Meta        *Meta    `json:"meta,omitempty"`
+	}
+
+	// Member of a group, also used for the groups of a user.
+	Member struct {
+		Value   string `json:"value"`
+		Ref     string `json:"$ref,omitempty"`
+		Display string `json:"display,omitempty"`
+		Type    string `json:"type,omitempty"`
+	}
+)
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	switch strings.ToLower(s) {
+	case "true":
+		*b = true
+	case "false", "null", "":
+		*b = false
+	default:
+		return BadRequest(InvalidValue, "'%s' is not a boolean", s)
+	}
+	return nil
+}
+
+// IsActive returns the active attribute, users are active unless told otherwise.
+func (u *User) IsActive() bool {
+	return u.Active == nil || bool(*u.Active)
+}
+
+// PrimaryEmail returns the primary email, the first one if none is marked,
+// or the user name if it looks like an email address.
+func (u *User) PrimaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+
+	if strings.Contains(u.UserName, "@") {
+		return u.UserName
+	}
+
+	return ""
+}
+
+// Version returns the weak entity tag of the user.
+func (u User) Version() string {
+	u.Meta = nil
+	u.Password = ""
+	return version(u)
+}
+
+// Version returns the weak entity tag of the group.
+func (g Group) Version() string {
+	g.Meta = nil
+	return version(g)
+}
+
+func version(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(sum[:16]))
+}
+
+// MatchVersion reports if an If-Match or If-None-Match header value
+// matches version. Comparison is weak, '*' matches any.
+func MatchVersion(header, version string) bool {
+	trim := func(s string) string {
+		return strings.TrimPrefix(strings.TrimSpace(s), "W/")
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || (tag != "" && trim(tag) == trim(version)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Decode reads a resource body into v rejecting malformed input.
+func Decode(body []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	err := dec.Decode(v)
+	if err != nil {
+		var se *Error
+		if errors.As(err, &se) {
+			return se
+		}
+		return BadRequest(InvalidSyntax, "malformed request body: %s", err)
+	}
+
+	return nil
+}
+
+// ToMap returns the JSON object representation of a resource,
+// the form filters and patch operations work on.
+func ToMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	err = Decode(b, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// FromMap reverses ToMap.
+func FromMap(m map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return Decode(b, v)
+}
@@ -0,0 +1,205 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Schema URNs
+const (
+	UserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	spConfigSchema     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// ContentType of SCIM requests and responses.
+const ContentType = "application/scim+json"
+
+// Error types, see RFC 7644 section 3.12.
+const (
+	InvalidFilter = "invalidFilter"
+	InvalidPath   = "invalidPath"
+	InvalidSyntax = "invalidSyntax"
+	InvalidValue  = "invalidValue"
+	NoTarget      = "noTarget"
+	Mutability    = "mutability"
+	Uniqueness    = "uniqueness"
+	TooMany       = "tooMany"
+)
+
+const (
+	// DefaultCount of resources per page when not requested.
+	DefaultCount = 100
+	// MaxCount of resources per page.
+	MaxCount = 1000
+)
+
+type (
+	// Error response.
+	Error struct {
+		Schemas  []string `json:"schemas"`
+		Status   string   `json:"status"`
+		ScimType string   `json:"scimType,omitempty"`
+		Detail   string   `json:"detail,omitempty"`
+		// status code, also kept as string as the spec requires.
+		code int
+	}
+
+	// Meta attributes of a resource.
+	Meta struct {
+		ResourceType string `json:"resourceType"`
+		Created      string `json:"created,omitempty"`
+		LastModified string `json:"lastModified,omitempty"`
+		Location     string `json:"location,omitempty"`
+		Version      string `json:"version,omitempty"`
+	}
+
+	// ListResponse of a query.
+	ListResponse struct {
+		Schemas      []string      `json:"schemas"`
+		TotalResults int           `json:"totalResults"`
+		StartIndex   int           `json:"startIndex"`
+		ItemsPerPage int           `json:"itemsPerPage"`
+		Resources    []interface{} `json:"Resources"`
+	}
+)
+
+// NewError returns an error response with HTTP status code and SCIM type.
+func NewError(code int, scimType, format string, a ...interface{}) *Error {
+	return &Error{
+		Schemas:  []string{ErrorSchema},
+		Status:   fmt.Sprintf("%d", code),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, a...),
+		code:     code,
+	}
+}
+
+// BadRequest returns a 400 error of scimType.
+func BadRequest(scimType, format string, a ...interface{}) *Error {
+	return NewError(http.StatusBadRequest, scimType, format, a...)
+}
+
+// NotFound returns a 404 error for resource id.
+func NotFound(id string) *Error {
+	return NewError(http.StatusNotFound, "", "resource '%s' not found", id)
+}
+
+func (e *Error) Error() string {
+	if e.ScimType == "" {
+		return fmt.Sprintf("scim %s: %s", e.Status, e.Detail)
+	}
+	return fmt.Sprintf("scim %s %s: %s", e.Status, e.ScimType, e.Detail)
+}
+
+// StatusCode of the response.
+func (e *Error) StatusCode() int {
+	return e.code
+}
+
+// Page returns the bounds of the requested page within n resources.
+// startIndex is 1-based, values under 1 are taken as 1.
+// A negative count means none was requested.
+func Page(n, startIndex, count int) (from, to int) {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	if count < 0 {
+		count = DefaultCount
+	}
+
+	if count > MaxCount {
+		count = MaxCount
+	}
+
+	from = startIndex - 1
+	if from > n {
+		from = n
+	}
+
+	to = from + count
+	if to > n {
+		to = n
+	}
+
+	return from, to
+}
+
+// NewListResponse for the page of resources starting at startIndex
+// out of total results.
+func NewListResponse(resources []interface{}, total, startIndex int) ListResponse {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	if resources == nil {
+		resources = []interface{}{}
+	}
+
+	return ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// ServiceProviderConfig describes supported features.
+func ServiceProviderConfig(baseURL string) map[string]interface{} {
+	supported := func(ok bool) map[string]interface{} {
+		return map[string]interface{}{"supported": ok}
+	}
+
+	return map[string]interface{}{
+		"schemas":        []string{spConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": MaxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(true),
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer token",
+				"description": "Per tenant bearer token",
+				"primary":     true,
+			},
+		},
+		"meta": Meta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     strings.TrimSuffix(baseURL, "/") + "/ServiceProviderConfig",
+		},
+	}
+}
+
+// ResourceTypes served.
+func ResourceTypes(baseURL string) []interface{} {
+	base := strings.TrimSuffix(baseURL, "/")
+
+	rt := func(name, endpoint, schema string) map[string]interface{} {
+		return map[string]interface{}{
+			"schemas":  []string{resourceTypeSchema},
+			"id":       name,
+			"name":     name,
+			"endpoint": endpoint,
+			"schema":   schema,
+			"meta": Meta{
+				ResourceType: "ResourceType",
+				Location:     base + "/ResourceTypes/" + name,
+			},
+		}
+	}
+
+	return []interface{}{
+		rt("User", "/Users", UserSchema),
+		rt("Group", "/Groups", GroupSchema),
+	}
+}
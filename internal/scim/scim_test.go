@@ -0,0 +1,435 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+const testUser = `{
+	"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+	"id": "2819c223-7f76-453a-919d-413861904646",
+	"externalId": "bjensen",
+	"userName": "bjensen@example.com",
+	"name": {"givenName": "Barbara", "familyName": "Jensen"},
+	"active": true,
+	"emails": [
+		{"value": "bjensen@example.com", "type": "work", "primary": true},
+		{"value": "babs@jensen.org", "type": "home"}
+	],
+	"meta": {"resourceType": "User", "lastModified": "2011-05-13T04:42:34Z"},
+	"logins": 42
+}`
+
+func testResource(t *testing.T) map[string]interface{} {
+	var m map[string]interface{}
+	err := Decode([]byte(testUser), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		match  bool
+	}{
+		// RFC 7644 section 3.4.2.2 examples
+		{`userName Eq "bjensen@example.com"`, true},
+		{`USERNAME eq "BJENSEN@EXAMPLE.COM"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "bjen"`, true},
+		{`name.familyName co "ens"`, true},
+		{`userName ew "example.com"`, true},
+		{`title pr`, false},
+		{`name pr`, true},
+		{`meta.lastModified gt "2011-05-13T04:42:34Z"`, false},
+		{`meta.lastModified ge "2011-05-13T04:42:34Z"`, true},
+		{`meta.lastModified lt "2011-05-14T00:00:00Z"`, true},
+		{`meta.lastModified le "2011-05-12T00:00:00Z"`, false},
+		{`title pr and userType eq "Employee"`, false},
+		{`title pr or name.givenName eq "barbara"`, true},
+		{`emails co "jensen.org"`, true},
+		{`emails[type eq "work" and value co "@example.com"]`, true},
+		{`emails[type eq "home" and value co "@example.com"]`, false},
+		{`emails.type eq "home"`, true},
+		{`emails.primary eq true`, true},
+		{`userName ne "bjensen@example.com"`, false},
+		{`userName ne "other"`, true},
+		{`not (userName eq "other")`, true},
+		{`not(name.givenName eq "Barbara")`, false},
+		{`userName eq "other" or (active eq true and logins ge 40)`, true},
+		{`userName eq "other" or active eq true and logins gt 42`, false},
+		{`active eq "True"`, false},
+		{`externalId eq "bjensen"`, true},
+		// externalId is case exact
+		{`externalId eq "BJENSEN"`, false},
+		{`title eq null`, true},
+		{`userName eq null`, false},
+		{`userName eq "with \"quotes\""`, false},
+	}
+
+	res := testResource(t)
+
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.filter)
+		if err != nil {
+			t.Errorf("%s: cannot parse: %s", tt.filter, err.Error())
+			continue
+		}
+
+		if got := f.Match(res); got != tt.match {
+			t.Errorf("%s: got %t, want %t", tt.filter, got, tt.match)
+		}
+	}
+}
+
+func TestFilterInvalid(t *testing.T) {
+	tests := []string{
+		``,
+		`userName`,
+		`userName xx "a"`,
+		`userName eq`,
+		`userName eq bjensen`,
+		`userName eq "unterminated`,
+		`(userName eq "a"`,
+		`emails[type eq "work"`,
+		`userName eq "a" and`,
+		`not userName eq "a"`,
+		`userName eq "a" extra`,
+	}
+
+	for _, s := range tests {
+		_, err := ParseFilter(s)
+		se, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%s: should fail with a SCIM error, got %v", s, err)
+			continue
+		}
+
+		if se.StatusCode() != http.StatusBadRequest || se.ScimType != InvalidFilter {
+			t.Errorf("%s: unexpected error %+v", s, se)
+		}
+	}
+}
+
+func TestPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		ops   string
+		check func(t *testing.T, res map[string]interface{})
+	}{
+		{
+			name: "replace attribute",
+			ops:  `{"op": "replace", "path": "userName", "value": "babs"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, res["userName"], "babs")
+			},
+		},
+		{
+			name: "replace capitalized",
+			ops:  `{"op": "Replace", "path": "active", "value": "False"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				var u User
+				err := FromMap(res, &u)
+				if err != nil || u.IsActive() {
+					t.Errorf("user should be inactive, err: %v", err)
+				}
+			},
+		},
+		{
+			name: "replace sub-attribute",
+			ops:  `{"op": "replace", "path": "name.familyName", "value": "Smith"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				name := res["name"].(map[string]interface{})
+				want(t, name["familyName"], "Smith")
+				want(t, name["givenName"], "Barbara")
+			},
+		},
+		{
+			name: "replace without path",
+			ops:  `{"op": "replace", "value": {"displayName": "Babs", "name": {"givenName": "Babs"}}}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, res["displayName"], "Babs")
+				name := res["name"].(map[string]interface{})
+				want(t, name["givenName"], "Babs")
+				want(t, name["familyName"], "Jensen")
+			},
+		},
+		{
+			name: "replace URN prefixed attribute",
+			ops:  `{"op": "replace", "value": {"urn:ietf:params:scim:schemas:core:2.0:User:userName": "babs"}}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, res["userName"], "babs")
+			},
+		},
+		{
+			name: "replace filtered",
+			ops:  `{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "babs@example.com"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				emails := res["emails"].([]interface{})
+				want(t, len(emails), 2)
+				want(t, emails[0].(map[string]interface{})["value"], "babs@example.com")
+			},
+		},
+		{
+			name: "add filtered creates",
+			ops:  `{"op": "add", "path": "emails[type eq \"other\"].value", "value": "b@other.org"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				emails := res["emails"].([]interface{})
+				want(t, len(emails), 3)
+				want(t, emails[2], map[string]interface{}{"type": "other", "value": "b@other.org"})
+			},
+		},
+		{
+			name: "add appends",
+			ops:  `{"op": "add", "path": "emails", "value": [{"value": "b@new.org"}, {"value": "babs@jensen.org"}]}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, len(res["emails"].([]interface{})), 3)
+			},
+		},
+		{
+			name: "replace multi-valued",
+			ops:  `{"op": "replace", "path": "emails", "value": [{"value": "b@new.org"}]}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, len(res["emails"].([]interface{})), 1)
+			},
+		},
+		{
+			name: "remove attribute",
+			ops:  `{"op": "remove", "path": "name"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				if _, ok := res["name"]; ok {
+					t.Error("name should be removed")
+				}
+			},
+		},
+		{
+			name: "remove filtered",
+			ops:  `{"op": "remove", "path": "emails[type eq \"home\"]"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				emails := res["emails"].([]interface{})
+				want(t, len(emails), 1)
+				want(t, emails[0].(map[string]interface{})["type"], "work")
+			},
+		},
+		{
+			name: "remove by value",
+			ops:  `{"op": "remove", "path": "emails", "value": [{"value": "babs@jensen.org"}]}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				want(t, len(res["emails"].([]interface{})), 1)
+			},
+		},
+		{
+			name: "remove last element",
+			ops: `{"op": "remove", "path": "emails[type eq \"home\"]"},
+				{"op": "remove", "path": "emails[type eq \"work\"]"}`,
+			check: func(t *testing.T, res map[string]interface{}) {
+				if _, ok := res["emails"]; ok {
+					t.Error("emails should be removed")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr, err := ParsePatch(patchBody(tt.ops))
+			if err != nil {
+				t.Fatalf("cannot parse patch: %s", err.Error())
+			}
+
+			res := testResource(t)
+			err = pr.Apply(res)
+			if err != nil {
+				t.Fatalf("cannot apply patch: %s", err.Error())
+			}
+
+			tt.check(t, res)
+		})
+	}
+}
+
+func TestPatchInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		scimType string
+	}{
+		{"no schema", `{"Operations": [{"op": "remove", "path": "name"}]}`, InvalidSyntax},
+		{"no operations", string(patchBody("")), InvalidSyntax},
+		{"unknown operation", string(patchBody(`{"op": "move", "path": "name"}`)), InvalidSyntax},
+		{"remove without path", string(patchBody(`{"op": "remove"}`)), NoTarget},
+		{"invalid path", string(patchBody(`{"op": "add", "path": "emails[type eq", "value": "x"}`)), InvalidPath},
+		{"read only", string(patchBody(`{"op": "replace", "path": "id", "value": "x"}`)), Mutability},
+		{"no target", string(patchBody(`{"op": "replace", "path": "emails[type pr].value", "value": "x"}`)), NoTarget},
+		{"no object", string(patchBody(`{"op": "add", "value": "x"}`)), InvalidValue},
+	}
+
+	for _, tt := range tests {
+		pr, err := ParsePatch([]byte(tt.body))
+		if err == nil {
+			err = pr.Apply(map[string]interface{}{"id": "1"})
+		}
+
+		se, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%s: should fail with a SCIM error, got %v", tt.name, err)
+			continue
+		}
+
+		if tt.scimType != "" && se.ScimType != tt.scimType {
+			t.Errorf("%s: got %s error, want %s", tt.name, se.ScimType, tt.scimType)
+		}
+	}
+}
+
+func TestUserRoundTrip(t *testing.T) {
+	var u User
+	err := Decode([]byte(testUser), &u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.PrimaryEmail() != "bjensen@example.com" || !u.IsActive() {
+		t.Errorf("unexpected user: %+v", u)
+	}
+
+	m, err := ToMap(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var again User
+	err = FromMap(m, &again)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(u, again) {
+		t.Errorf("got %+v, want %+v", again, u)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	u := User{UserName: "bjensen", Meta: &Meta{ResourceType: "User"}}
+	v := u.Version()
+
+	u.Meta = &Meta{ResourceType: "User", LastModified: "2011-05-13T04:42:34Z"}
+	u.Password = "secret"
+	if u.Version() != v {
+		t.Error("meta and password should not change the version")
+	}
+
+	u.UserName = "babs"
+	if u.Version() == v {
+		t.Error("changed user should change the version")
+	}
+
+	tests := []struct {
+		header string
+		match  bool
+	}{
+		{v, true},
+		{v[2:], true},
+		{`W/"other", ` + v, true},
+		{"*", true},
+		{`W/"other"`, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchVersion(tt.header, v); got != tt.match {
+			t.Errorf("%s: got %t, want %t", tt.header, got, tt.match)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		n, startIndex, count int
+		from, to             int
+	}{
+		{10, 1, -1, 0, 10},
+		{10, 0, 5, 0, 5},
+		{10, 3, 5, 2, 7},
+		{10, 8, 5, 7, 10},
+		{10, 11, 5, 10, 10},
+		{10, 20, 5, 10, 10},
+		{10, 1, 0, 0, 0},
+		{2000, 1, 5000, 0, MaxCount},
+		{2000, 1, -1, 0, DefaultCount},
+	}
+
+	for _, tt := range tests {
+		from, to := Page(tt.n, tt.startIndex, tt.count)
+		if from != tt.from || to != tt.to {
+			t.Errorf("Page(%d, %d, %d) = %d, %d, want %d, %d",
+				tt.n, tt.startIndex, tt.count, from, to, tt.from, tt.to)
+		}
+	}
+
+	lr := NewListResponse(nil, 10, 0)
+	b, _ := json.Marshal(lr)
+	if string(b) != `{"schemas":["`+ListResponseSchema+`"],"totalResults":10,"startIndex":1,"itemsPerPage":0,"Resources":[]}` {
+		t.Errorf("unexpected list response: %s", b)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.SetNamespace("grc")
+	cfg.SetValues(map[string]string{
+		"app.scim.tenants":               "acme, Initech",
+		"app.scim.acme.token.digests":    Digest("old") + "," + Digest("new"),
+		"app.scim.initech.token.digests": Digest("initech"),
+	})
+
+	tokens, err := LoadTokens(cfg)
+	if err != nil {
+		t.Fatalf("cannot load tokens: %s", err.Error())
+	}
+
+	tests := []struct {
+		token  string
+		tenant string
+		ok     bool
+	}{
+		{"old", "acme", true},
+		{"new", "acme", true},
+		{"initech", "initech", true},
+		{"other", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		tenant, ok := tokens.Tenant(tt.token)
+		if tenant != tt.tenant || ok != tt.ok {
+			t.Errorf("%s: got %s %t, want %s %t", tt.token, tenant, ok, tt.tenant, tt.ok)
+		}
+	}
+
+	cfg.SetValues(map[string]string{
+		"app.scim.tenants":            "acme",
+		"app.scim.acme.token.digests": "plain-token",
+	})
+
+	_, err = LoadTokens(cfg)
+	if err == nil {
+		t.Error("plain tokens should be rejected")
+	}
+}
+
+func patchBody(ops string) []byte {
+	return []byte(`{"schemas": ["` + PatchOpSchema + `"], "Operations": [` + ops + `]}`)
+}
+
+func want(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
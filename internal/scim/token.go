@@ -0,0 +1,105 @@
+package scim
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/config"
+)
+
+type (
+	// Tokens authenticates provisioning clients, each bearer token
+	// belongs to a tenant. Only token digests are kept.
+	Tokens struct {
+		digests map[string][][]byte
+	}
+)
+
+// LoadTokens reads the tenants provisioning is enabled for,
+// listed in 'app.scim.tenants'. For a tenant named 'acme'
+// 'app.scim.acme.token.digests' holds the hex SHA-256 digests
+// of its tokens, more than one while rotating.
+func LoadTokens(cfg *config.Config) (*Tokens, error) {
+	t := &Tokens{digests: map[string][][]byte{}}
+
+	for _, tenant := range splitList(cfg.ValOrDef("app.scim.tenants", "")) {
+		tenant = strings.ToLower(tenant)
+		k := fmt.Sprintf("app.scim.%s.token.digests", tenant)
+
+		for _, d := range splitList(cfg.ValOrDef(k, "")) {
+			err := t.Add(tenant, d)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(t.digests[tenant]) == 0 {
+			return nil, fmt.Errorf("SCIM tenant '%s' has no token digests", tenant)
+		}
+	}
+
+	return t, nil
+}
+
+// Add a token digest for tenant.
+func (t *Tokens) Add(tenant, digest string) error {
+	d, err := hex.DecodeString(digest)
+	if err != nil || len(d) != sha256.Size {
+		return fmt.Errorf("SCIM tenant '%s': token digest is not a hex SHA-256 sum", tenant)
+	}
+
+	t.digests[tenant] = append(t.digests[tenant], d)
+	return nil
+}
+
+// Tenant returns the tenant token belongs to.
+// All digests are compared so timing does not tell which matched.
+func (t *Tokens) Tenant(token string) (tenant string, ok bool) {
+	if t == nil || token == "" {
+		return "", false
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	for tn, ds := range t.digests {
+		for _, d := range ds {
+			if subtle.ConstantTimeCompare(sum[:], d) == 1 {
+				tenant, ok = tn, true
+			}
+		}
+	}
+
+	return tenant, ok
+}
+
+// Tenants returns the configured tenants, sorted.
+func (t *Tokens) Tenants() []string {
+	var ts []string
+	if t == nil {
+		return ts
+	}
+
+	for tn := range t.digests {
+		ts = append(ts, tn)
+	}
+	sort.Strings(ts)
+
+	return ts
+}
+
+// Digest returns the value to configure for token.
+func Digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func splitList(s string) []string {
+	f := func(r rune) bool {
+		return r == ',' || r == ' '
+	}
+
+	return strings.FieldsFunc(s, f)
+}
@@ -6,9 +6,33 @@ import (
 
 	"github.com/go-chi/chi"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
 )
 
 // Account
+func (a *Auth) makeAccountWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/accounts", func(aar chi.Router) {
+		aar.Get("/", a.webep.IndexAccounts)
+		aar.Get("/new", a.webep.NewAccount)
+		aar.Post("/", a.webep.CreateAccount)
+		aar.Route("/{account}", func(aarid chi.Router) {
+			aarid.Use(accountWebCtx)
+			aarid.Get("/", a.webep.ShowAccount)
+			aarid.Get("/edit", a.webep.EditAccount)
+			aarid.Patch("/", a.webep.UpdateAccount)
+			aarid.Put("/", a.webep.UpdateAccount)
+			aarid.Post("/init-delete", a.webep.InitDeleteAccount)
+			aarid.Delete("/", a.webep.DeleteAccount)
+			aarid.Post("/transfer", a.webep.InitiateTransfer)
+			aarid.Delete("/transfer", a.webep.CancelTransfer)
+			aarid.Route("/{token}", func(aartkn chi.Router) {
+				aartkn.Use(confCtx)
+				aartkn.Get("/confirm-transfer", a.webep.ConfirmTransfer)
+			})
+		})
+	})
+}
+
 func (a *Auth) makeAccountJSONRESTRouter(parent chi.Router) chi.Router {
 	return parent.Route("/accounts", func(aar chi.Router) {
 		aar.Post("/", a.jsonep.CreateAccount)
@@ -18,14 +42,63 @@ func (a *Auth) makeAccountJSONRESTRouter(parent chi.Router) chi.Router {
 			aarid.Get("/", a.jsonep.GetAccount)
 			aarid.Put("/", a.jsonep.UpdateAccount)
 			aarid.Delete("/", a.jsonep.DeleteAccount)
+			aarid.Post("/restore", a.jsonep.RestoreAccount)
+			aarid.Post("/transfer", a.jsonep.InitiateTransfer)
+			aarid.Delete("/transfer", a.jsonep.CancelTransfer)
+			aarid.Route("/{token}", func(aartkn chi.Router) {
+				aartkn.Use(tokenJSONCtx)
+				aartkn.Post("/confirm-transfer", a.jsonep.ConfirmTransfer)
+			})
+			aarid.Get("/api-keys", a.jsonep.IndexAccountAPIKeys)
+			aarid.Post("/api-keys", a.jsonep.CreateAccountAPIKey)
+			aarid.Route("/api-keys/{api-key}", func(aarak chi.Router) {
+				aarak.Use(apiKeyJSONCtx)
+				aarak.Delete("/", a.jsonep.RevokeAccountAPIKey)
+			})
+			aarid.Get("/service-accounts", a.jsonep.IndexServiceAccounts)
+			aarid.Post("/service-accounts", a.jsonep.CreateServiceAccount)
+			aarid.Route("/service-accounts/{service-account}", func(aarsa chi.Router) {
+				aarsa.Use(serviceAccountCtx)
+				aarsa.Delete("/", a.jsonep.DeleteServiceAccount)
+				aarsa.Get("/credentials", a.jsonep.IndexClientCredentials)
+				aarsa.Post("/credentials", a.jsonep.CreateClientCredential)
+				aarsa.Route("/credentials/{credential}", func(aarsac chi.Router) {
+					aarsac.Use(clientCredentialCtx)
+					aarsac.Delete("/", a.jsonep.RevokeClientCredential)
+				})
+			})
 		})
 	})
 }
 
 func accountCtx(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		slug := chi.URLParam(r, "account-slug")
+		slug := chi.URLParam(r, "account")
 		ctx := context.WithValue(r.Context(), jsonrest.AccountCtxKey, slug)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func accountWebCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := chi.URLParam(r, "account")
+		ctx := context.WithValue(r.Context(), web.AccountCtxKey, slug)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func serviceAccountCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := chi.URLParam(r, "service-account")
+		ctx := context.WithValue(r.Context(), jsonrest.ServiceAccountCtxKey, slug)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func clientCredentialCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "credential")
+		ctx := context.WithValue(r.Context(), jsonrest.ClientCredentialCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
+)
+
+func (a *Auth) makeAdminWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/admin", func(adr chi.Router) {
+		adr.Use(a.webep.AdminOnly)
+		adr.Get("/", a.webep.ShowAdminDashboard)
+		adr.Get("/users", a.webep.IndexAdminUsers)
+		adr.Post("/users/bulk", a.webep.BulkUpdateUsers)
+		adr.Route("/users/{slug}", func(adrus chi.Router) {
+			adrus.Use(userCtx)
+			adrus.Get("/roles", a.webep.IndexUserRoles)
+			adrus.Post("/roles", a.webep.GrantRole)
+			adrus.Route("/roles/{role}", func(adrrl chi.Router) {
+				adrrl.Use(roleCtx)
+				adrrl.Delete("/", a.webep.RevokeRole)
+			})
+		})
+		adr.Get("/accounts", a.webep.IndexAdminAccounts)
+		adr.Route("/accounts/{account}", func(adrac chi.Router) {
+			adrac.Use(adminAccountCtx)
+			adrac.Post("/activate", a.webep.ActivateAccount)
+			adrac.Post("/deactivate", a.webep.DeactivateAccount)
+			adrac.Post("/approve-transfer", a.webep.ApproveTransfer)
+		})
+	})
+}
+
+func roleCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "role")
+		ctx := context.WithValue(r.Context(), web.RoleCtxKey, name)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func adminAccountCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := chi.URLParam(r, "account")
+		ctx := context.WithValue(r.Context(), web.AdminAccountCtxKey, slug)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
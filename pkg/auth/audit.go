@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"github.com/go-chi/chi"
+)
+
+func (a *Auth) makeAuditWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/audit", func(aur chi.Router) {
+		aur.Use(a.webep.AdminOnly)
+		aur.Get("/", a.webep.IndexAuditEvents)
+	})
+}
+
+func (a *Auth) makeAuditJSONRESTRouter(parent chi.Router) chi.Router {
+	return parent.Route("/audit-events", func(aur chi.Router) {
+		aur.Get("/", a.jsonep.IndexAuditEvents)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
@@ -61,6 +62,82 @@ func (a *Auth) Init() bool {
 		return false
 	}
 	a.service.SetMailer(mlh)
+
+	gr, err := a.geoResolver()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetGeoResolver(gr)
+
+	pp, err := a.passwordPolicies()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetPasswordPolicies(pp)
+
+	bc, err := a.breachedCorpus()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetBreachedCorpus(bc)
+
+	idps, err := a.identityProviders()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetIdentityProviders(idps)
+
+	dirs, err := a.directories()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetDirectories(dirs)
+
+	sps, err := a.samlServiceProviders()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetSAMLServiceProviders(sps)
+
+	idp, err := a.samlIdentityProvider()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetSAMLIdentityProvider(idp)
+
+	scts, err := a.scimTokens()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetSCIMTokens(scts)
+
+	oi, err := a.oauthIssuer()
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetOAuthIssuer(oi)
+
+	mts, err := mailer.LoadTemplates(a.I18NBundle())
+	if err != nil {
+		a.Log().Error(err)
+		return false
+	}
+	a.service.SetMailTemplates(mts)
+
+	err = a.service.BootstrapAdmins()
+	if err != nil {
+		a.Log().Error(err)
+	}
+
 	return true
 }
 
@@ -79,6 +156,24 @@ func (a *Auth) Start() error {
 		wg.Done()
 	}()
 
+	wg.Add(1)
+	go func() {
+		a.StartPurger()
+		wg.Done()
+	}()
+
+	wg.Add(1)
+	go func() {
+		a.StartSubscriptionWorker()
+		wg.Done()
+	}()
+
+	wg.Add(1)
+	go func() {
+		a.StartDirectorySync()
+		wg.Done()
+	}()
+
 	wg.Wait()
 	return nil
 }
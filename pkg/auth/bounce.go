@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"github.com/go-chi/chi"
+)
+
+func (a *Auth) makeWebhookJSONRESTRouter(parent chi.Router) chi.Router {
+	return parent.Route("/webhooks", func(whr chi.Router) {
+		whr.Post("/ses", a.jsonep.SESNotification)
+		whr.Post("/mail", a.jsonep.MailNotification)
+	})
+}
@@ -25,6 +25,7 @@ func (ep *Endpoint) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.CreateAccount(req, &res)
 	if err != nil {
 		ep.Log().Error(err)
@@ -41,6 +42,7 @@ func (ep *Endpoint) GetAccounts(w http.ResponseWriter, r *http.Request) {
 	var res tp.GetAccountsRes
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err := ep.service.GetAccounts(req, &res)
 	if err != nil {
 		ep.Log().Error(err)
@@ -66,6 +68,7 @@ func (ep *Endpoint) GetAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Slug = slug
 	err := ep.service.GetAccount(req, &res)
 	if err != nil {
@@ -100,6 +103,7 @@ func (ep *Endpoint) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Identifier.Slug = slug
 	err = ep.service.UpdateAccount(req, &res)
 	if err != nil {
@@ -126,6 +130,7 @@ func (ep *Endpoint) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Identifier.Slug = slug
 	err := ep.service.DeleteAccount(req, &res)
 	if err != nil {
@@ -138,3 +143,30 @@ func (ep *Endpoint) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 	// Output
 	ep.writeResponse(w, res)
 }
+
+func (ep *Endpoint) RestoreAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.RestoreAccountReq
+	var res tp.RestoreAccountRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err := ep.service.RestoreAccount(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
@@ -0,0 +1,219 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	APIKeyCtxKey contextKey = "api-key"
+)
+
+const (
+	// APIPath is the base path of the JSON REST API.
+	APIPath = "/api/v1"
+	// apiKeyScheme of the 'Authorization: ApiKey <key>' header.
+	apiKeyScheme = "ApiKey "
+)
+
+var (
+	// apiKeyResources maps API resources to the scopes granting access to them.
+	apiKeyResources = map[string]string{
+		"users":         "users",
+		"accounts":      "accounts",
+		"audit-events":  "audit",
+		"outbox-emails": "outbox",
+	}
+)
+
+// APIKeyCtx middleware authenticates requests carrying an
+// 'Authorization: ApiKey <key>' header, the user the key acts
+// as is then loaded into request context as the current session.
+// The key must grant the scope the request needs.
+func (ep *Endpoint) APIKeyCtx(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		key := apiKey(r)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		req := tp.ValidateAPIKeyReq{Key: key, Scope: apiKeyScope(r), Origin: tp.MakeOrigin(r)}
+		var res tp.ValidateAPIKeyRes
+
+		err := ep.service.ValidateAPIKey(req, &res)
+		if err != nil {
+			ep.Log().Error(err)
+			status := http.StatusUnauthorized
+			if err == service.ErrForbidden {
+				status = http.StatusForbidden
+			}
+			ep.writeStatusResponse(w, status, res)
+			return
+		}
+
+		ctx := tp.WithCurrentSession(r.Context(), res.CurrentSession)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+func (ep *Endpoint) IndexUserAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ep.indexAPIKeys(w, r, UserCtxKey, false)
+}
+
+func (ep *Endpoint) CreateUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	ep.createAPIKey(w, r, UserCtxKey, false)
+}
+
+func (ep *Endpoint) RevokeUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	ep.revokeAPIKey(w, r, UserCtxKey, false)
+}
+
+func (ep *Endpoint) IndexAccountAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ep.indexAPIKeys(w, r, AccountCtxKey, true)
+}
+
+func (ep *Endpoint) CreateAccountAPIKey(w http.ResponseWriter, r *http.Request) {
+	ep.createAPIKey(w, r, AccountCtxKey, true)
+}
+
+func (ep *Endpoint) RevokeAccountAPIKey(w http.ResponseWriter, r *http.Request) {
+	ep.revokeAPIKey(w, r, AccountCtxKey, true)
+}
+
+func (ep *Endpoint) indexAPIKeys(w http.ResponseWriter, r *http.Request, ownerKey contextKey, account bool) {
+	var req tp.IndexAPIKeysReq
+	var res tp.IndexAPIKeysRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(ownerKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.Account = account
+	err := ep.service.IndexAPIKeys(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) createAPIKey(w http.ResponseWriter, r *http.Request, ownerKey contextKey, account bool) {
+	var req tp.CreateAPIKeyReq
+	var res tp.CreateAPIKeyRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(ownerKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.Account = account
+	err = ep.service.CreateAPIKey(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) revokeAPIKey(w http.ResponseWriter, r *http.Request, ownerKey contextKey, account bool) {
+	var req tp.RevokeAPIKeyReq
+	var res tp.RevokeAPIKeyRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(ownerKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	id, ok := ctx.Value(APIKeyCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid API key")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.Account = account
+	req.APIKeyID = id
+	err := ep.service.RevokeAPIKey(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+// apiKey returns the key from 'Authorization: ApiKey <key>' header.
+func apiKey(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, apiKeyScheme) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(h, apiKeyScheme))
+}
+
+// apiKeyScope returns the scope a request needs: read access for
+// safe methods, write access otherwise, to the requested resource.
+// Requests outside API resources need a scope no key is granted.
+func apiKeyScope(r *http.Request) string {
+	p := strings.TrimPrefix(r.URL.Path, APIPath+"/")
+	res := strings.SplitN(p, "/", 2)[0]
+
+	scope, ok := apiKeyResources[res]
+	if !ok {
+		return "none"
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return scope + ":read"
+	default:
+		return scope + ":write"
+	}
+}
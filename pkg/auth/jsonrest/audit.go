@@ -0,0 +1,36 @@
+package jsonrest
+
+import (
+	"net/http"
+	"strconv"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+func (ep *Endpoint) IndexAuditEvents(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexAuditEventsReq
+	var res tp.IndexAuditEventsRes
+
+	// Query
+	q := r.URL.Query()
+	req.Actor = q.Get("actor")
+	req.TargetType = q.Get("targetType")
+	req.Target = q.Get("target")
+	req.Action = q.Get("action")
+	req.From = q.Get("from")
+	req.To = q.Get("to")
+	req.Limit, _ = strconv.Atoi(q.Get("limit"))
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexAuditEvents(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	res.Filter = req.AuditFilter
+	ep.writeResponse(w, res)
+}
@@ -0,0 +1,79 @@
+package jsonrest
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// SignatureHeader carries generic mail notifications signature.
+	SignatureHeader = "X-Granica-Signature"
+	// maxNotificationSize limits notification body size.
+	maxNotificationSize = 256 << 10
+)
+
+// SESNotification webhook receives SES bounces and complaints through SNS.
+func (ep *Endpoint) SESNotification(w http.ResponseWriter, r *http.Request) {
+	var req tp.MailNotificationReq
+	var res tp.MailNotificationRes
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxNotificationSize))
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeStatusResponse(w, http.StatusBadRequest, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Body = body
+	err = ep.service.HandleSESNotification(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeStatusResponse(w, notificationErrStatus(err), res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+// MailNotification webhook receives bounces and complaints in generic format.
+func (ep *Endpoint) MailNotification(w http.ResponseWriter, r *http.Request) {
+	var req tp.MailNotificationReq
+	var res tp.MailNotificationRes
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxNotificationSize))
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeStatusResponse(w, http.StatusBadRequest, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Body = body
+	req.Signature = r.Header.Get(SignatureHeader)
+	err = ep.service.HandleMailNotification(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeStatusResponse(w, notificationErrStatus(err), res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+// notificationErrStatus lets senders tell apart rejected notifications,
+// that should not be retried, from failures.
+func notificationErrStatus(err error) int {
+	if errors.Is(err, service.ErrInvalidNotification) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
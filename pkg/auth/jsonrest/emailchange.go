@@ -0,0 +1,78 @@
+package jsonrest
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+func (ep *Endpoint) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req tp.ConfirmEmailChangeReq
+	var res tp.ConfirmEmailChangeRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	token, ok := ctx.Value(TokenCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid token")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug, Token: token}
+	err := ep.service.ConfirmEmailChange(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) CancelEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req tp.CancelEmailChangeReq
+	var res tp.CancelEmailChangeRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	token, ok := ctx.Value(TokenCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid token")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug, Token: token}
+	err := ep.service.CancelEmailChange(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
@@ -0,0 +1,114 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	ProviderCtxKey contextKey = "provider"
+)
+
+// FederatedAuth returns the provider authorization URL along with
+// the state, nonce and verifier the client has to send back to FederatedSignIn.
+func (ep *Endpoint) FederatedAuth(w http.ResponseWriter, r *http.Request) {
+	var req tp.FederatedAuthReq
+	var res tp.FederatedAuthRes
+
+	ctx := r.Context()
+	provider, ok := ctx.Value(ProviderCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid provider")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Provider = provider
+	req.Link = r.URL.Query().Get("link") == "true"
+	err := ep.service.FederatedAuthURL(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) FederatedSignIn(w http.ResponseWriter, r *http.Request) {
+	var req tp.FederatedSignInReq
+	var res tp.FederatedSignInRes
+
+	ctx := r.Context()
+	provider, ok := ctx.Value(ProviderCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid provider")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Provider = provider
+	err = ep.service.FederatedSignIn(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	var req tp.UnlinkIdentityReq
+	var res tp.UnlinkIdentityRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	provider, ok := ctx.Value(ProviderCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid provider")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.Provider = provider
+	err := ep.service.UnlinkIdentity(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
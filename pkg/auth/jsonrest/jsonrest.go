@@ -57,6 +57,19 @@ func (ep *Endpoint) writeResponse(w http.ResponseWriter, res interface{}) {
 	w.Write(o)
 }
 
+// writeStatusResponse writes res using a non default HTTP status code.
+func (ep *Endpoint) writeStatusResponse(w http.ResponseWriter, status int, res interface{}) {
+	// Marshalling
+	o, err := ep.toJSON(res)
+	if err != nil {
+		ep.Log().Error(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(o)
+}
+
 func (ep *Endpoint) toJSON(res interface{}) ([]byte, error) {
 	return json.Marshal(res)
 }
@@ -0,0 +1,77 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+func (ep *Endpoint) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var req tp.RequestMagicLinkReq
+	var res tp.RequestMagicLinkRes
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RequestMagicLink(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) MagicLinkSignIn(w http.ResponseWriter, r *http.Request) {
+	var req tp.MagicLinkSignInReq
+	var res tp.MagicLinkSignInRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	token, ok := ctx.Value(TokenCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid token")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug, Token: token}
+	err = ep.service.SignInWithMagicLink(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
@@ -0,0 +1,130 @@
+package jsonrest
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/oauth"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// OAuthPath is the base path of the token endpoint and of its keys.
+	OAuthPath = "/oauth"
+	// maxTokenRequestSize limits token request body size.
+	maxTokenRequestSize = 1 << 16
+)
+
+// OAuthToken endpoint, see RFC 6749 section 3.2.
+// Parameters are form encoded, client secrets are read from an
+// 'Authorization: Basic' header (client_secret_basic) or from
+// the form (client_secret_post).
+func (ep *Endpoint) OAuthToken(w http.ResponseWriter, r *http.Request) {
+	var res tp.IssueTokenRes
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxTokenRequestSize)
+
+	err := r.ParseForm()
+	if err != nil {
+		ep.writeOAuthError(w, oauth.NewError(oauth.InvalidRequest, "malformed request"))
+		return
+	}
+
+	f := r.PostForm
+	req := tp.IssueTokenReq{
+		GrantType:           f.Get("grant_type"),
+		ClientID:            f.Get("client_id"),
+		ClientSecret:        f.Get("client_secret"),
+		ClientAssertionType: f.Get("client_assertion_type"),
+		ClientAssertion:     f.Get("client_assertion"),
+		Scope:               f.Get("scope"),
+		Audience:            f.Get("audience"),
+	}
+
+	if req.Audience == "" {
+		req.Audience = f.Get("resource")
+	}
+
+	if id, secret, ok := r.BasicAuth(); ok {
+		if req.ClientSecret != "" || req.ClientAssertion != "" {
+			ep.writeOAuthError(w, oauth.NewError(oauth.InvalidRequest, "more than one client authentication method"))
+			return
+		}
+
+		// Credentials are form encoded before being base64 encoded,
+		// see RFC 6749 section 2.3.1.
+		req.ClientID, err = url.QueryUnescape(id)
+		if err == nil {
+			req.ClientSecret, err = url.QueryUnescape(secret)
+		}
+		if err != nil {
+			ep.writeOAuthError(w, oauth.NewError(oauth.InvalidClient, "malformed client credentials"))
+			return
+		}
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.IssueToken(req, &res)
+	if err == service.ErrOAuthDisabled {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		ep.writeOAuthError(w, err)
+		return
+	}
+
+	// Output
+	ep.writeOAuthResponse(w, http.StatusOK, res)
+}
+
+// OAuthJWKS endpoint publishes the keys access tokens are signed with.
+func (ep *Endpoint) OAuthJWKS(w http.ResponseWriter, r *http.Request) {
+	b, err := ep.service.OAuthJWKS()
+	if err == service.ErrOAuthDisabled {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		ep.Log().Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.Write(b)
+}
+
+func (ep *Endpoint) writeOAuthError(w http.ResponseWriter, err error) {
+	var oe *oauth.Error
+	if !errors.As(err, &oe) {
+		ep.Log().Error(err)
+		oe = oauth.NewError(oauth.ServerError, "cannot process request")
+	}
+
+	if oe.StatusCode() == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+
+	ep.writeOAuthResponse(w, oe.StatusCode(), oe)
+}
+
+// writeOAuthResponse writes token endpoint responses,
+// they must not be cached, see RFC 6749 section 5.1.
+func (ep *Endpoint) writeOAuthResponse(w http.ResponseWriter, status int, res interface{}) {
+	// Marshalling
+	o, err := ep.toJSON(res)
+	if err != nil {
+		ep.Log().Error(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	w.Write(o)
+}
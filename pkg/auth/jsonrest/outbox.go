@@ -0,0 +1,91 @@
+package jsonrest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	OutboxEmailCtxKey contextKey = "outbox-email"
+)
+
+func (ep *Endpoint) IndexOutboxEmails(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexOutboxEmailsReq
+	var res tp.IndexOutboxEmailsRes
+
+	// Query
+	q := r.URL.Query()
+	req.Status = q.Get("status")
+	req.Recipient = q.Get("recipient")
+	req.Limit, _ = strconv.Atoi(q.Get("limit"))
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexOutboxEmails(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	res.Filter = req.OutboxFilter
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) GetOutboxEmail(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetOutboxEmailReq
+	var res tp.GetOutboxEmailRes
+
+	ctx := r.Context()
+	id, ok := ctx.Value(OutboxEmailCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid email")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.ID = id
+	err := ep.service.GetOutboxEmail(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) RequeueOutboxEmail(w http.ResponseWriter, r *http.Request) {
+	var req tp.RequeueOutboxEmailReq
+	var res tp.RequeueOutboxEmailRes
+
+	ctx := r.Context()
+	id, ok := ctx.Value(OutboxEmailCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid email")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.ID = id
+	err := ep.service.RequeueOutboxEmail(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
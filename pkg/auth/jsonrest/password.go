@@ -0,0 +1,44 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+func (ep *Endpoint) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req tp.ChangePasswordReq
+	var res tp.ChangePasswordRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug}
+	err = ep.service.ChangePassword(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
@@ -0,0 +1,232 @@
+package jsonrest
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/scim"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	SCIMTenantCtxKey   contextKey = "scim-tenant"
+	SCIMResourceCtxKey contextKey = "scim-resource"
+)
+
+const (
+	// SCIMPath is the base path of the SCIM API.
+	SCIMPath = "/scim/v2"
+	// maxSCIMRequestSize limits SCIM request body size.
+	maxSCIMRequestSize = 1 << 20
+)
+
+type (
+	scimAction func(req tp.SCIMReq, res *tp.SCIMRes) error
+)
+
+// SCIMAuth middleware authenticates provisioning clients by their
+// 'Authorization: Bearer <token>' header and loads the tenant
+// the token belongs to into request context.
+func (ep *Endpoint) SCIMAuth(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		tenant, ok := ep.service.SCIMTenant(bearerToken(r))
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			ep.writeSCIMError(w, scim.NewError(http.StatusUnauthorized, "", "invalid bearer token"))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), SCIMTenantCtxKey, tenant)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// SCIMServiceProviderConfig endpoint.
+func (ep *Endpoint) SCIMServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMServiceProviderConfig)
+}
+
+// SCIMResourceTypes endpoint.
+func (ep *Endpoint) SCIMResourceTypes(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMResourceTypes)
+}
+
+// SCIMListUsers endpoint.
+func (ep *Endpoint) SCIMListUsers(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMListUsers)
+}
+
+// SCIMGetUser endpoint.
+func (ep *Endpoint) SCIMGetUser(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMGetUser)
+}
+
+// SCIMCreateUser endpoint.
+func (ep *Endpoint) SCIMCreateUser(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMCreateUser)
+}
+
+// SCIMReplaceUser endpoint.
+func (ep *Endpoint) SCIMReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMReplaceUser)
+}
+
+// SCIMPatchUser endpoint.
+func (ep *Endpoint) SCIMPatchUser(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMPatchUser)
+}
+
+// SCIMDeleteUser endpoint.
+func (ep *Endpoint) SCIMDeleteUser(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMDeleteUser)
+}
+
+// SCIMListGroups endpoint.
+func (ep *Endpoint) SCIMListGroups(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMListGroups)
+}
+
+// SCIMGetGroup endpoint.
+func (ep *Endpoint) SCIMGetGroup(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMGetGroup)
+}
+
+// SCIMCreateGroup endpoint.
+func (ep *Endpoint) SCIMCreateGroup(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMCreateGroup)
+}
+
+// SCIMReplaceGroup endpoint.
+func (ep *Endpoint) SCIMReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMReplaceGroup)
+}
+
+// SCIMPatchGroup endpoint.
+func (ep *Endpoint) SCIMPatchGroup(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMPatchGroup)
+}
+
+// SCIMDeleteGroup endpoint.
+func (ep *Endpoint) SCIMDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ep.serveSCIM(w, r, ep.service.SCIMDeleteGroup)
+}
+
+// serveSCIM decodes the request, calls the service action and writes its
+// response. Resources come with their version as ETag, requests for
+// an unchanged one (If-None-Match) are answered 304.
+func (ep *Endpoint) serveSCIM(w http.ResponseWriter, r *http.Request, action scimAction) {
+	var res tp.SCIMRes
+
+	req, err := ep.scimRequest(w, r)
+	if err != nil {
+		ep.writeSCIMError(w, err)
+		return
+	}
+
+	// Service
+	err = action(req, &res)
+	if err != nil {
+		ep.writeSCIMError(w, err)
+		return
+	}
+
+	// Output
+	if res.Version != "" {
+		w.Header().Set("ETag", res.Version)
+
+		inm := r.Header.Get("If-None-Match")
+		if r.Method == http.MethodGet && inm != "" && scim.MatchVersion(inm, res.Version) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if res.Status == http.StatusCreated && res.Location != "" {
+		w.Header().Set("Location", res.Location)
+	}
+
+	if res.Status == http.StatusNoContent {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ep.writeSCIMResponse(w, res.Status, res.Resource)
+}
+
+func (ep *Endpoint) scimRequest(w http.ResponseWriter, r *http.Request) (tp.SCIMReq, error) {
+	var err error
+
+	q := r.URL.Query()
+	req := tp.SCIMReq{
+		Filter:  q.Get("filter"),
+		IfMatch: r.Header.Get("If-Match"),
+		Count:   -1,
+	}
+
+	req.Tenant, _ = r.Context().Value(SCIMTenantCtxKey).(string)
+	req.ID, _ = r.Context().Value(SCIMResourceCtxKey).(string)
+
+	req.StartIndex, err = scimIntParam(q.Get("startIndex"), 1)
+	if err != nil {
+		return req, err
+	}
+
+	req.Count, err = scimIntParam(q.Get("count"), -1)
+	if err != nil {
+		return req, err
+	}
+
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		req.Body, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSCIMRequestSize))
+		if err != nil {
+			return req, scim.NewError(http.StatusRequestEntityTooLarge, scim.TooMany, "request body too large")
+		}
+	}
+
+	req.Origin = tp.MakeOrigin(r)
+	return req, nil
+}
+
+// scimIntParam parses a non negative query parameter, def if missing.
+func scimIntParam(val string, def int) (int, error) {
+	if val == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || n < 0 {
+		return 0, scim.BadRequest(scim.InvalidValue, "'%s' is not a valid number", val)
+	}
+
+	return n, nil
+}
+
+// writeSCIMError writes SCIM errors as they are and others
+// as internal server errors, their details are only logged.
+func (ep *Endpoint) writeSCIMError(w http.ResponseWriter, err error) {
+	var se *scim.Error
+	if !errors.As(err, &se) {
+		ep.Log().Error(err)
+		se = scim.NewError(http.StatusInternalServerError, "", "cannot process request")
+	}
+
+	ep.writeSCIMResponse(w, se.StatusCode(), se)
+}
+
+func (ep *Endpoint) writeSCIMResponse(w http.ResponseWriter, status int, res interface{}) {
+	// Marshalling
+	o, err := ep.toJSON(res)
+	if err != nil {
+		ep.Log().Error(err)
+	}
+
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	w.Write(o)
+}
@@ -0,0 +1,215 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	ServiceAccountCtxKey   contextKey = "service-account"
+	ClientCredentialCtxKey contextKey = "client-credential"
+)
+
+func (ep *Endpoint) IndexServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexServiceAccountsReq
+	var res tp.IndexServiceAccountsRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err := ep.service.IndexServiceAccounts(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.CreateServiceAccountReq
+	var res tp.CreateServiceAccountRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err = ep.service.CreateServiceAccount(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.DeleteServiceAccountReq
+	var res tp.DeleteServiceAccountRes
+
+	slug, sa, ok := serviceAccountSlugs(r)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.ServiceAccountSlug = sa
+	err := ep.service.DeleteServiceAccount(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) IndexClientCredentials(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexClientCredentialsReq
+	var res tp.IndexClientCredentialsRes
+
+	slug, sa, ok := serviceAccountSlugs(r)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.ServiceAccountSlug = sa
+	err := ep.service.IndexClientCredentials(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) CreateClientCredential(w http.ResponseWriter, r *http.Request) {
+	var req tp.CreateClientCredentialReq
+	var res tp.CreateClientCredentialRes
+
+	slug, sa, ok := serviceAccountSlugs(r)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.ServiceAccountSlug = sa
+	err = ep.service.CreateClientCredential(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) RevokeClientCredential(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeClientCredentialReq
+	var res tp.RevokeClientCredentialRes
+
+	slug, sa, ok := serviceAccountSlugs(r)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	id, ok := r.Context().Value(ClientCredentialCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid client credential")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.ServiceAccountSlug = sa
+	req.CredentialID = id
+	err := ep.service.RevokeClientCredential(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+// serviceAccountSlugs returns the slugs of the account
+// and of the service account in request context.
+func serviceAccountSlugs(r *http.Request) (account, serviceAccount string, ok bool) {
+	ctx := r.Context()
+
+	account, ok = ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		return "", "", false
+	}
+
+	serviceAccount, ok = ctx.Value(ServiceAccountCtxKey).(string)
+	return account, serviceAccount, ok
+}
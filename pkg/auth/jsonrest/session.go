@@ -0,0 +1,200 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	SessionCtxKey contextKey = "session"
+	TokenCtxKey   contextKey = "token"
+)
+
+// SessionCtx middleware loads the session identified by
+// the 'Authorization: Bearer <token>' header, if any, into request context.
+func (ep *Endpoint) SessionCtx(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		// SCIM clients use their own bearer tokens, see SCIMAuth.
+		if token == "" || strings.HasPrefix(r.URL.Path, SCIMPath+"/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var res tp.ValidateSessionRes
+		err := ep.service.ValidateSession(tp.ValidateSessionReq{Token: token}, &res)
+		if err != nil {
+			ep.Log().Error(err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		ctx := tp.WithCurrentSession(r.Context(), res.CurrentSession)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+func (ep *Endpoint) SignInUser(w http.ResponseWriter, r *http.Request) {
+	var req tp.SignInUserReq
+	var res tp.SignInUserRes
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SignInUser(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) VerifySignIn(w http.ResponseWriter, r *http.Request) {
+	var req tp.VerifySignInReq
+	var res tp.VerifySignInRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	token, ok := ctx.Value(TokenCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid token")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug, Token: token}
+	err := ep.service.VerifySignIn(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) GetUserSecurity(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetUserSecurityReq
+	var res tp.GetUserSecurityRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err := ep.service.GetUserSecurity(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeSessionReq
+	var res tp.RevokeSessionRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	sid, ok := ctx.Value(SessionCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid session")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	req.SessionID = sid
+	err := ep.service.RevokeSession(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeAllSessionsReq
+	var res tp.RevokeAllSessionsRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err := ep.service.RevokeAllSessions(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+// bearerToken returns the token from 'Authorization: Bearer <token>' header.
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(h, "Bearer "))
+}
@@ -0,0 +1,106 @@
+package jsonrest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+func (ep *Endpoint) InitiateTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.InitiateTransferReq
+	var res tp.InitiateTransferRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Decode
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug}
+	err = ep.service.InitiateTransfer(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) ConfirmTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.ConfirmTransferReq
+	var res tp.ConfirmTransferRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	token, ok := ctx.Value(TokenCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid token")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug, Token: token}
+	err := ep.service.ConfirmTransfer(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) CancelTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.CancelTransferReq
+	var res tp.CancelTransferRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier = tp.Identifier{Slug: slug}
+	err := ep.service.CancelTransfer(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 
 	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
 )
@@ -25,6 +26,7 @@ func (ep *Endpoint) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.CreateUser(req, &res)
 	if err != nil {
 		ep.Log().Error(err)
@@ -41,6 +43,7 @@ func (ep *Endpoint) IndexUsers(w http.ResponseWriter, r *http.Request) {
 	var res tp.IndexUsersRes
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err := ep.service.IndexUsers(req, &res)
 	if err != nil {
 		ep.Log().Error(err)
@@ -52,6 +55,27 @@ func (ep *Endpoint) IndexUsers(w http.ResponseWriter, r *http.Request) {
 	ep.writeResponse(w, res)
 }
 
+func (ep *Endpoint) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.SearchUsersReq
+	var res tp.SearchUsersRes
+
+	// Query
+	req.Query = r.URL.Query().Get("q")
+	req.Limit, _ = strconv.Atoi(r.URL.Query().Get("limit"))
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.SearchUsers(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
 func (ep *Endpoint) GetUser(w http.ResponseWriter, r *http.Request) {
 	var req tp.GetUserReq
 	var res tp.GetUserRes
@@ -66,6 +90,7 @@ func (ep *Endpoint) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Identifier.Slug = slug
 	err := ep.service.GetUser(req, &res)
 	if err != nil {
@@ -100,6 +125,7 @@ func (ep *Endpoint) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Identifier.Slug = slug
 	err = ep.service.UpdateUser(req, &res)
 	if err != nil {
@@ -126,6 +152,7 @@ func (ep *Endpoint) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	req.Identifier.Slug = slug
 	err := ep.service.DeleteUser(req, &res)
 	if err != nil {
@@ -138,3 +165,47 @@ func (ep *Endpoint) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	// Output
 	ep.writeResponse(w, res)
 }
+
+func (ep *Endpoint) IndexDeletedUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexUsersReq
+	var res tp.IndexUsersRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexDeletedUsers(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
+
+func (ep *Endpoint) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	var req tp.RestoreUserReq
+	var res tp.RestoreUserRes
+
+	ctx := r.Context()
+	slug, ok := ctx.Value(UserCtxKey).(string)
+	if !ok {
+		e := errors.New("invalid slug")
+		ep.Log().Error(e)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	req.Identifier.Slug = slug
+	err := ep.service.RestoreUser(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		ep.writeResponse(w, res)
+		return
+	}
+
+	// Output
+	ep.writeResponse(w, res)
+}
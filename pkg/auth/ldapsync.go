@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"time"
+)
+
+// StartDirectorySync periodically refreshes users linked to LDAP directories
+// and deactivates the ones no longer there.
+func (a *Auth) StartDirectorySync() error {
+	// Set envar GRN_APP_LDAP_SYNC_INTERVAL_MINUTES to change
+	// how often the directory sync job runs.
+	m := a.Cfg().ValAsInt("app.ldap.sync.interval.minutes", 60)
+	if m <= 0 || !a.service.HasDirectories() {
+		a.Log().Info("Directory sync job disabled")
+		return nil
+	}
+
+	a.Log().Info("Directory sync job initializing", "interval-minutes", m)
+
+	t := time.NewTicker(time.Duration(m) * time.Minute)
+	defer t.Stop()
+
+	for {
+		a.syncDirectories()
+
+		select {
+		case <-t.C:
+		case <-a.Ctx().Done():
+			return nil
+		}
+	}
+}
+
+func (a *Auth) syncDirectories() {
+	synced, deactivated, err := a.service.SyncDirectories()
+	if err != nil {
+		a.Log().Error(err, "job", "directory-sync")
+		return
+	}
+
+	a.Log().Info("Directory sync job done", "synced", synced, "deactivated", deactivated)
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
+)
+
+func (a *Auth) makeMailPreviewWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/mail-previews", func(mpr chi.Router) {
+		mpr.Get("/", a.webep.IndexMailPreviews)
+		mpr.Route("/{name}", func(mprn chi.Router) {
+			mprn.Use(mailCtx)
+			mprn.Get("/", a.webep.PreviewMail)
+		})
+	})
+}
+
+func mailCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		ctx := context.WithValue(r.Context(), web.MailCtxKey, name)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
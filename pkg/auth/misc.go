@@ -3,8 +3,15 @@ package auth
 import (
 	"errors"
 
+	"gitlab.com/mikrowezel/backend/granica/internal/geo"
+	"gitlab.com/mikrowezel/backend/granica/internal/ldap"
 	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/oauth"
+	"gitlab.com/mikrowezel/backend/granica/internal/oidc"
+	"gitlab.com/mikrowezel/backend/granica/internal/password"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/granica/internal/saml"
+	"gitlab.com/mikrowezel/backend/granica/internal/scim"
 )
 
 // TODO: Move functions to a more appropriate place.
@@ -40,18 +47,154 @@ func (a *Auth) accountRepo() (*repo.AccountRepo, error) {
 	return rh.AccountRepoNewTx()
 }
 
-
 // Mailer
-func (a *Auth) mailerHandler() (*mailer.SESMailer, error) {
+func (a *Auth) mailerHandler() (*mailer.MailHandler, error) {
 	h, ok := a.Handler("mailer-handler")
 	if !ok {
 		return nil, errors.New("mailer handler not available")
 	}
 
-	mailer, ok := h.(*mailer.SESMailer)
+	mailer, ok := h.(*mailer.MailHandler)
 	if !ok {
 		return nil, errors.New("invalid mailer handler")
 	}
 
 	return mailer, nil
 }
+
+// Geo
+// geoResolver returns an offline MaxMind DB resolver if envar
+// GRN_GEO_DB_PATH is set, otherwise sign-ins are not located.
+func (a *Auth) geoResolver() (geo.Resolver, error) {
+	path := a.Cfg().ValOrDef("geo.db.path", "")
+	if path == "" {
+		a.Log().Info("No geolocation database configured")
+		return geo.NopResolver{}, nil
+	}
+
+	r, err := geo.OpenMMDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("Geolocation database loaded", "path", path)
+	return r, nil
+}
+
+// Password
+// passwordPolicies returns the password policies configured through
+// GRN_APP_PASSWORD_* envars and the optional GRN_APP_PASSWORD_POLICY_FILE.
+func (a *Auth) passwordPolicies() (*password.Policies, error) {
+	pp, err := password.LoadPolicies(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("Password policies loaded", "tenants", len(pp.Tenants))
+	return pp, nil
+}
+
+// breachedCorpus returns the local breached password corpus if envar
+// GRN_APP_PASSWORD_BREACHED_PATH is set, otherwise no password is considered breached.
+func (a *Auth) breachedCorpus() (password.Corpus, error) {
+	path := a.Cfg().ValOrDef("app.password.breached.path", "")
+	if path == "" {
+		a.Log().Info("No breached password corpus configured")
+		return password.NopCorpus{}, nil
+	}
+
+	c, err := password.OpenCorpus(path)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("Breached password corpus loaded", "path", path)
+	return c, nil
+}
+
+// Identity providers
+// identityProviders returns the external identity providers
+// listed in envar GRN_APP_OIDC_PROVIDERS.
+func (a *Auth) identityProviders() (*oidc.Providers, error) {
+	pp, err := oidc.LoadProviders(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("Identity providers loaded", "count", len(pp.List()))
+	return pp, nil
+}
+
+// Directories
+// directories returns the LDAP directories
+// listed in envar GRN_APP_LDAP_DIRECTORIES.
+func (a *Auth) directories() (*ldap.Directories, error) {
+	dd, err := ldap.LoadDirectories(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("Directories loaded", "count", len(dd.List()))
+	return dd, nil
+}
+
+// SAML
+// samlServiceProviders returns the SAML identity providers
+// listed in envar GRN_APP_SAML_PROVIDERS.
+func (a *Auth) samlServiceProviders() (*saml.ServiceProviders, error) {
+	sps, err := saml.LoadServiceProviders(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("SAML identity providers loaded", "count", len(sps.List()))
+	return sps, nil
+}
+
+// samlIdentityProvider returns the SAML identity provider if
+// envar GRN_APP_SAML_IDP_ENABLED is set, nil otherwise.
+func (a *Auth) samlIdentityProvider() (*saml.IdentityProvider, error) {
+	idp, err := saml.LoadIdentityProvider(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	if idp == nil {
+		a.Log().Info("SAML identity provider not enabled")
+		return nil, nil
+	}
+
+	a.Log().Info("SAML identity provider loaded", "entityID", idp.EntityID, "peers", len(idp.Peers))
+	return idp, nil
+}
+
+// SCIM
+// scimTokens returns the bearer tokens of the provisioning clients
+// of the tenants listed in envar GRN_APP_SCIM_TENANTS.
+func (a *Auth) scimTokens() (*scim.Tokens, error) {
+	t, err := scim.LoadTokens(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log().Info("SCIM tenants loaded", "count", len(t.Tenants()))
+	return t, nil
+}
+
+// OAuth
+// oauthIssuer returns the token endpoint access token issuer if
+// envar GRN_APP_OAUTH_ENABLED is set, nil otherwise.
+func (a *Auth) oauthIssuer() (*oauth.Issuer, error) {
+	i, err := oauth.LoadIssuer(a.Cfg())
+	if err != nil {
+		return nil, err
+	}
+
+	if i == nil {
+		a.Log().Info("OAuth token endpoint not enabled")
+		return nil, nil
+	}
+
+	a.Log().Info("OAuth token endpoint enabled", "issuer", i.URL, "keyID", i.KeyID)
+	return i, nil
+}
@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
+)
+
+// OAuth
+func (a *Auth) makeOAuthJSONRESTRouter(parent chi.Router) chi.Router {
+	return parent.Route(jsonrest.OAuthPath, func(oar chi.Router) {
+		oar.Post("/token", a.jsonep.OAuthToken)
+		oar.Get("/jwks", a.jsonep.OAuthJWKS)
+	})
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
+)
+
+func (a *Auth) makeOutboxWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/outbox", func(obr chi.Router) {
+		obr.Use(a.webep.AdminOnly)
+		obr.Get("/", a.webep.IndexOutboxEmails)
+		obr.Route("/{email}", func(obrid chi.Router) {
+			obrid.Use(outboxEmailCtx)
+			obrid.Post("/requeue", a.webep.RequeueOutboxEmail)
+		})
+	})
+}
+
+func (a *Auth) makeOutboxJSONRESTRouter(parent chi.Router) chi.Router {
+	return parent.Route("/outbox-emails", func(obr chi.Router) {
+		obr.Get("/", a.jsonep.IndexOutboxEmails)
+		obr.Route("/{email}", func(obrid chi.Router) {
+			obrid.Use(outboxEmailJSONCtx)
+			obrid.Get("/", a.jsonep.GetOutboxEmail)
+			obrid.Post("/requeue", a.jsonep.RequeueOutboxEmail)
+		})
+	})
+}
+
+func outboxEmailCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "email")
+		ctx := context.WithValue(r.Context(), web.OutboxEmailCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func outboxEmailJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "email")
+		ctx := context.WithValue(r.Context(), jsonrest.OutboxEmailCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"time"
+)
+
+// StartPurger periodically removes soft deleted users and accounts
+// once their retention period is over.
+func (a *Auth) StartPurger() error {
+	// Set envar GRN_APP_PURGE_INTERVAL_HOURS to change
+	// how often the purge job runs.
+	h := a.Cfg().ValAsInt("app.purge.interval.hours", 24)
+	if h <= 0 {
+		a.Log().Info("Purge job disabled")
+		return nil
+	}
+
+	a.Log().Info("Purge job initializing", "interval-hours", h)
+
+	t := time.NewTicker(time.Duration(h) * time.Hour)
+	defer t.Stop()
+
+	for {
+		a.purge()
+
+		select {
+		case <-t.C:
+		case <-a.Ctx().Done():
+			return nil
+		}
+	}
+}
+
+func (a *Auth) purge() {
+	users, accounts, err := a.service.PurgeDeleted()
+	if err != nil {
+		a.Log().Error(err, "job", "purge")
+		return
+	}
+
+	a.Log().Info("Purge job done", "users", users, "accounts", accounts)
+}
@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"github.com/go-chi/chi"
+)
+
+func (a *Auth) makeSAMLWebRouter(parent chi.Router) chi.Router {
+	return parent.Route("/saml/idp", func(sir chi.Router) {
+		sir.Get("/metadata", a.webep.SAMLMetadata)
+		sir.Get("/sso", a.webep.SAMLSSO)
+	})
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
+)
+
+func (a *Auth) makeSCIMJSONRESTRouter(parent chi.Router) chi.Router {
+	return parent.Route(jsonrest.SCIMPath, func(scr chi.Router) {
+		scr.Use(a.jsonep.SCIMAuth)
+		scr.Get("/ServiceProviderConfig", a.jsonep.SCIMServiceProviderConfig)
+		scr.Get("/ResourceTypes", a.jsonep.SCIMResourceTypes)
+		scr.Route("/Users", func(sur chi.Router) {
+			sur.Get("/", a.jsonep.SCIMListUsers)
+			sur.Post("/", a.jsonep.SCIMCreateUser)
+			sur.Route("/{id}", func(surid chi.Router) {
+				surid.Use(scimResourceCtx)
+				surid.Get("/", a.jsonep.SCIMGetUser)
+				surid.Put("/", a.jsonep.SCIMReplaceUser)
+				surid.Patch("/", a.jsonep.SCIMPatchUser)
+				surid.Delete("/", a.jsonep.SCIMDeleteUser)
+			})
+		})
+		scr.Route("/Groups", func(sgr chi.Router) {
+			sgr.Get("/", a.jsonep.SCIMListGroups)
+			sgr.Post("/", a.jsonep.SCIMCreateGroup)
+			sgr.Route("/{id}", func(sgrid chi.Router) {
+				sgrid.Use(scimResourceCtx)
+				sgrid.Get("/", a.jsonep.SCIMGetGroup)
+				sgrid.Put("/", a.jsonep.SCIMReplaceGroup)
+				sgrid.Patch("/", a.jsonep.SCIMPatchGroup)
+				sgrid.Delete("/", a.jsonep.SCIMDeleteGroup)
+			})
+		})
+	})
+}
+
+func scimResourceCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		ctx := context.WithValue(r.Context(), jsonrest.SCIMResourceCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/go-chi/chi"
@@ -13,6 +14,7 @@ import (
 	"github.com/gorilla/csrf"
 	"github.com/markbates/pkger"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
 	"gitlab.com/mikrowezel/backend/web"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -22,6 +24,8 @@ type textResponse string
 
 var (
 	langMatcher = language.NewMatcher(message.DefaultCatalog.Languages())
+	// samlACSPath matches the assertion consumer services of SAML providers.
+	samlACSPath = regexp.MustCompile(`^/users/saml/[^/]+/acs/?$`)
 )
 
 func (t textResponse) write(w http.ResponseWriter, r *http.Request) {
@@ -37,7 +41,24 @@ func (a *Auth) AddWebServer() http.Handler {
 	a.makeUserWebRouter(hr)
 
 	// Account
-	//a.makeAccountWebRouter(hr)
+	a.makeAccountWebRouter(hr)
+
+	// Audit
+	a.makeAuditWebRouter(hr)
+
+	// Outbox
+	a.makeOutboxWebRouter(hr)
+
+	// Admin
+	a.makeAdminWebRouter(hr)
+
+	// SAML identity provider
+	a.makeSAMLWebRouter(hr)
+
+	// Mail previews
+	if a.Cfg().ValAsBool("app.mail.preview", false) {
+		a.makeMailPreviewWebRouter(hr)
+	}
 
 	a.WebServer = hr
 
@@ -58,6 +79,21 @@ func (a *Auth) AddJSONRESTServer() http.Handler {
 	// Account
 	a.makeAccountJSONRESTRouter(ar)
 
+	// Audit
+	a.makeAuditJSONRESTRouter(ar)
+
+	// Outbox
+	a.makeOutboxJSONRESTRouter(ar)
+
+	// Webhooks
+	a.makeWebhookJSONRESTRouter(ar)
+
+	// SCIM
+	a.makeSCIMJSONRESTRouter(hr)
+
+	// OAuth
+	a.makeOAuthJSONRESTRouter(hr)
+
 	a.JSONRESTServer = hr
 
 	return hr
@@ -72,6 +108,7 @@ func (a *Auth) makeHomeWebRouter() chi.Router {
 	hr.Use(a.MethodOverride)
 	hr.Use(a.CSRFProtection)
 	hr.Use(a.I18N)
+	hr.Use(a.webep.SessionCtx)
 	a.addHomeWebRoutes(hr)
 	return hr
 }
@@ -82,6 +119,7 @@ func (a *Auth) makeHomeJSONRESTRouter() chi.Router {
 	hr.Use(middleware.RealIP)
 	hr.Use(middleware.Recoverer)
 	hr.Use(middleware.Timeout(60 * time.Second))
+	hr.Use(a.jsonep.SessionCtx)
 	a.addHomeJSONRESTRoutes(hr)
 	return hr
 }
@@ -108,7 +146,8 @@ func (a *Auth) addHomeJSONRESTRoutes(rt chi.Router) {
 }
 
 func (a *Auth) makeAPIJSONRESTRouter(parent chi.Router) chi.Router {
-	return parent.Route("/api/v1", func(ar chi.Router) {
+	return parent.Route(jsonrest.APIPath, func(ar chi.Router) {
+		ar.Use(a.jsonep.APIKeyCtx)
 		tr := textResponse("API v1.0")
 		ar.Get("/", tr.write)
 	})
@@ -136,8 +175,20 @@ func (a *Auth) MethodOverride(next http.Handler) http.Handler {
 }
 
 // CSRFProtection add cross-site request forgery protecction to the handler.
+// SAML identity providers post responses cross-site, those are bound
+// to the browser through the SAML request ID instead.
 func (a *Auth) CSRFProtection(h http.Handler) http.Handler {
-	return csrf.Protect([]byte("32-byte-long-auth-key"), csrf.Secure(false))(h)
+	ph := csrf.Protect([]byte("32-byte-long-auth-key"), csrf.Secure(false))(h)
+
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && samlACSPath.MatchString(r.URL.Path) {
+			r = csrf.UnsafeSkipCheck(r)
+		}
+
+		ph.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
 }
 
 // I18N
@@ -1,43 +1,97 @@
 package service
 
 import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	uuid "github.com/satori/go.uuid"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
 	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
 )
 
 const (
-	createAccountErr = "cannot create account"
-	getAllAccountErr = "cannot get account list"
-	getAccountErr    = "cannot get account"
-	updateAccountErr = "cannot update account"
-	deleteAccountErr = "cannot delete account"
+	// Info
+	accountCreatedInfo  = "account_created_info"
+	accountUpdatedInfo  = "account_updated_info"
+	accountDeletedInfo  = "account_deleted_info"
+	accountRestoredInfo = "account_restored_info"
+	// Error
+	createAccountErr  = "cannot_create_account_err"
+	getAllAccountErr  = "cannot_get_accounts_list_err"
+	getAccountErr     = "cannot_get_account_err"
+	updateAccountErr  = "cannot_update_account_err"
+	deleteAccountErr  = "cannot_delete_account_err"
+	restoreAccountErr = "cannot_restore_account_err"
 )
 
 func (s *Service) CreateAccount(req tp.CreateAccountReq, res *tp.CreateAccountRes) error {
 	// Model
 	u := req.ToModel()
+	u.CreatedByID = db.ToNullString(req.ActorID)
+	// Accounts created without an explicit owner belong to their creator.
+	if u.OwnerID.String == "" {
+		u.OwnerID = db.ToNullString(req.ActorID)
+	}
 
 	// Repo
 	repo, err := s.accountRepo()
 	if err != nil {
-		res.FromModel(nil, createAccountErr, err)
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	// Only admins can create accounts owned by someone else.
+	if u.OwnerID.String != req.ActorID {
+		err = s.requireAdmin(repo.Tx, req.Origin)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, nil, adminErr(err, createAccountErr), err)
+			return err
+		}
+	}
+
+	// Validation
+	owner, err := s.accountOwner(repo.Tx, u)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, createAccountErr, err)
+		return err
+	}
+
+	v := NewAccountValidator(u).WithOwner(owner).WithPeriod(req.StartsAt, req.EndsAt)
+
+	err = v.ValidateForCreate()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, v.Errors, validationErr, err)
 		return err
 	}
 
 	err = repo.Create(&u)
 	if err != nil {
-		res.FromModel(nil, createAccountErr, err)
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, createAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountCreatedEvt, accountTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, createAccountErr, err)
 		return err
 	}
 
 	err = repo.Commit()
 	if err != nil {
-		res.FromModel(nil, createAccountErr, err)
+		res.FromModel(nil, nil, createAccountErr, err)
 		return err
 	}
 
 	// Output
-	res.FromModel(&u, "", nil)
+	res.FromModel(&u, nil, accountCreatedInfo, nil)
 	return nil
 }
 
@@ -45,12 +99,21 @@ func (s *Service) GetAccounts(req tp.GetAccountsReq, res *tp.GetAccountsRes) err
 	// Repo
 	repo, err := s.accountRepo()
 	if err != nil {
-		res.FromModel(nil, getAllAccountErr, err)
+		res.FromModel(nil, cannotProcErr, err)
 		return err
 	}
 
 	us, err := repo.GetAll()
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAllAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountListedEvt, accountTarget, "", meta{"count": len(us)}))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getAllAccountErr, err)
 		return err
 	}
@@ -62,7 +125,7 @@ func (s *Service) GetAccounts(req tp.GetAccountsReq, res *tp.GetAccountsRes) err
 	}
 
 	// Output
-	res.FromModel(us, "", nil)
+	res.FromModel(us, okResultInfo, nil)
 	return nil
 }
 
@@ -73,12 +136,21 @@ func (s *Service) GetAccount(req tp.GetAccountReq, res *tp.GetAccountRes) error
 	// Repo
 	repo, err := s.accountRepo()
 	if err != nil {
-		res.FromModel(nil, getAccountErr, err)
+		res.FromModel(nil, cannotProcErr, err)
 		return err
 	}
 
 	u, err = repo.GetBySlug(u.Slug.String)
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountViewedEvt, accountTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getAccountErr, err)
 		return err
 	}
@@ -90,7 +162,7 @@ func (s *Service) GetAccount(req tp.GetAccountReq, res *tp.GetAccountRes) error
 	}
 
 	// Output
-	res.FromModel(&u, "", nil)
+	res.FromModel(&u, okResultInfo, nil)
 	return nil
 }
 
@@ -98,64 +170,179 @@ func (s *Service) UpdateAccount(req tp.UpdateAccountReq, res *tp.UpdateAccountRe
 	// Repo
 	repo, err := s.accountRepo()
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		res.FromModel(nil, nil, cannotProcErr, err)
 		return err
 	}
 
 	// Get account
 	current, err := repo.GetBySlug(req.Identifier.Slug)
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, updateAccountErr, err)
+		return err
+	}
+
+	err = s.requireAccountOwner(repo.Tx, current, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, adminErr(err, updateAccountErr), err)
 		return err
 	}
 
 	// Create a model
 	u := req.ToModel()
 	u.ID = current.ID
+	u.UpdatedByID = db.ToNullString(req.ActorID)
+	// External ID is managed by provisioning clients.
+	u.ExternalID = current.ExternalID
+	// Ownership only changes through transfers.
+	u.OwnerID = current.OwnerID
+
+	// Validation
+	owner, err := s.accountOwner(repo.Tx, u)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, updateAccountErr, err)
+		return err
+	}
+
+	v := NewAccountValidator(u).WithOwner(owner).WithPeriod(req.StartsAt, req.EndsAt)
+
+	err = v.ValidateForUpdate()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, v.Errors, validationErr, err)
+		return err
+	}
 
 	// Update
 	err = repo.Update(&u)
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, updateAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountUpdatedEvt, accountTarget, current.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, updateAccountErr, err)
 		return err
 	}
 
 	err = repo.Commit()
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		res.FromModel(nil, nil, updateAccountErr, err)
 		return err
 	}
 
 	// Output
-	res.FromModel(&u, "", nil)
+	res.FromModel(&u, nil, accountUpdatedInfo, nil)
 	return nil
 }
 
 func (s *Service) DeleteAccount(req tp.DeleteAccountReq, res *tp.DeleteAccountRes) error {
+	if isImpersonating(req.Origin) {
+		res.FromModel(nil, impersonatingErr, ErrImpersonating)
+		return ErrImpersonating
+	}
+
 	// Repo
 	repo, err := s.accountRepo()
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		res.FromModel(nil, cannotProcErr, err)
 		return err
 	}
 
-	err = repo.DeleteBySlug(req.Slug)
+	// Soft delete, the account is purged after the retention period.
+	err = repo.DeleteBySlug(req.Slug, req.ActorID)
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		repo.Tx.Rollback()
+		res.FromModel(nil, deleteAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountDeletedEvt, accountTarget, req.Slug, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, deleteAccountErr, err)
 		return err
 	}
 
 	err = repo.Commit()
 	if err != nil {
-		res.FromModel(nil, updateAccountErr, err)
+		res.FromModel(nil, deleteAccountErr, err)
 		return err
 	}
 
 	// Output
-	res.FromModel(nil, "", nil)
+	res.FromModel(nil, accountDeletedInfo, nil)
 	return nil
 }
 
+// RestoreAccount undoes the deletion of an account not yet purged, only admins can do it.
+func (s *Service) RestoreAccount(req tp.RestoreAccountReq, res *tp.RestoreAccountRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(adminErr(err, restoreAccountErr), err)
+		return err
+	}
+
+	err = repo.RestoreBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(restoreAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountRestoredEvt, accountTarget, req.Slug, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(restoreAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(restoreAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(accountRestoredInfo, nil)
+	return nil
+}
+
+// accountOwner returns the user referenced as owner of a,
+// nil if there is none.
+func (s *Service) accountOwner(tx *sqlx.Tx, a model.Account) (*model.User, error) {
+	id := a.OwnerID.String
+	if _, err := uuid.FromString(id); err != nil {
+		return nil, nil
+	}
+
+	u, err := s.repo.UserRepo(tx).Get(id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
 // Misc
 func (s *Service) accountRepo() (*repo.AccountRepo, error) {
 	return s.repo.AccountRepoNewTx()
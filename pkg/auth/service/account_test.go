@@ -1,25 +1,19 @@
-package service
+package service_test
 
 import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"testing"
 
 	//"github.com/davecgh/go-spew/spew"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/jmoiron/sqlx"
 	"gitlab.com/mikrowezel/backend/config"
 	"gitlab.com/mikrowezel/backend/db"
-	"gitlab.com/mikrowezel/backend/granica/internal/migration"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
-	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
 	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
-	"gitlab.com/mikrowezel/backend/log"
-	mig "gitlab.com/mikrowezel/backend/migration"
 )
 
 var (
@@ -37,7 +31,7 @@ var (
 		"name":        "nameUpd",
 		"ownerID":     "ba3b11b3-947b-4536-8958-8c77185c06a7",
 		"parentID":    "24f696d1-453b-4d32-bdfe-8b0261c3cb16",
-		"accountType": "userUpd",
+		"accountType": "organization",
 		"email":       "usernameUpd@mail.com",
 	}
 
@@ -59,35 +53,8 @@ var (
 		"accountType": "user2",
 		"email":       "username2@mail.com",
 	}
-
-	userSample1 = map[string]string{
-		"username":          "username1",
-		"password":          "password1",
-		"email":             "username1@mail.com",
-		"emailConfirmation": "username1@mail.com",
-		"givenName":         "name1",
-		"middleNames":       "middles1",
-		"familyName":        "family1",
-	}
-
-	userSample2 = map[string]string{
-		"username":          "username2",
-		"password":          "password2",
-		"email":             "username2@mail.com",
-		"emailConfirmation": "username2@mail.com",
-		"givenName":         "name2",
-		"middleNames":       "middles2",
-		"familyName":        "family2",
-	}
 )
 
-func TestMain(m *testing.M) {
-	mgr := setup()
-	code := m.Run()
-	teardown(mgr)
-	os.Exit(code)
-}
-
 // TestCreateAccount tests account creation.
 func TestCreateAccount(t *testing.T) {
 	// Prerequisites
@@ -98,7 +65,7 @@ func TestCreateAccount(t *testing.T) {
 
 	// Setup
 	req := tp.CreateAccountReq{
-		tp.Account{
+		Account: tp.Account{
 			TenantID:    accountDataValid["tenantId"],
 			Name:        accountDataValid["name"],
 			OwnerID:     users[0].ID.String(),
@@ -187,8 +154,8 @@ func TestGetAllAccounts(t *testing.T) {
 		t.Error("no response")
 	}
 
-	if res.Error != "" {
-		t.Errorf("Response error: %s", res.Error)
+	if res.MsgID != okResultInfo {
+		t.Errorf("Response message: %s", res.MsgID)
 	}
 
 	qty := len(vAccounts)
@@ -211,7 +178,7 @@ func TestGetAccount(t *testing.T) {
 
 	// Setup
 	req := tp.GetAccountReq{
-		tp.Identifier{
+		Identifier: tp.Identifier{
 			Slug: accounts[0].Slug.String,
 		},
 	}
@@ -244,8 +211,8 @@ func TestGetAccount(t *testing.T) {
 	}
 
 	// Verify
-	if res.Error != "" {
-		t.Errorf("Response error: %s", res.Error)
+	if res.MsgID != okResultInfo {
+		t.Errorf("Response message: %s", res.MsgID)
 	}
 
 	accountRes := res.Account
@@ -266,10 +233,10 @@ func TestUpdateAccount(t *testing.T) {
 	// Setup
 	account := accounts[0]
 	req := tp.UpdateAccountReq{
-		tp.Identifier{
+		Identifier: tp.Identifier{
 			Slug: account.Slug.String,
 		},
-		tp.Account{
+		Account: tp.Account{
 			TenantID:    accountUpdateDataValid["tenantId"],
 			Name:        accountUpdateDataValid["name"],
 			AccountType: accountUpdateDataValid["accountType"],
@@ -326,7 +293,7 @@ func TestDeleteAccount(t *testing.T) {
 	// Setup
 	account := accounts[0]
 	req := tp.DeleteAccountReq{
-		tp.Identifier{
+		Identifier: tp.Identifier{
 			Slug: account.Slug.String,
 		},
 	}
@@ -355,114 +322,193 @@ func TestDeleteAccount(t *testing.T) {
 	// Verify
 	vAccount, err := getAccountBySlug(account.Slug.String, cfg)
 	if err != nil {
+		t.Errorf("cannot get account from database: %s", err.Error())
 		return
 	}
 
-	if vAccount == nil {
-		return
-	}
-
-	if vAccount.Slug.String == account.Slug.String {
-		t.Error("account was not deleted from database")
+	if !vAccount.IsDeleted.Bool {
+		t.Error("account was not marked as deleted")
 	}
 }
 
-func getAccountBySlug(slug string, cfg *config.Config) (*model.Account, error) {
-	conn, err := getConn()
+// TestCreateAccountInvalid tests that invalid input is reported per field
+// so that account forms can be rendered again.
+func TestCreateAccountInvalid(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("accountinvalid")
 	if err != nil {
-		return nil, err
+		t.Fatalf("error creating user: %s", err.Error())
 	}
 
-	schema := cfg.ValOrDef("pg.schema", "public")
+	// Setup
+	req := tp.CreateAccountReq{
+		Account: tp.Account{
+			Name:        "",
+			AccountType: "not-a-type",
+			Email:       "not-an-email",
+			StartsAt:    "2020-02-01",
+			EndsAt:      "2020-01-01",
+		},
+		Origin: tp.Origin{
+			ActorID: user.ID.String(),
+		},
+	}
 
-	st := `SELECT * FROM %s.accounts WHERE slug='%s';`
-	st = fmt.Sprintf(st, schema, slug)
+	var res tp.CreateAccountRes
 
-	u := &model.Account{}
-	err = conn.Get(u, st)
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	accountRepo, err := testRepo(ctx, cfg, log, "repo-handler")
 	if err != nil {
-		msg := fmt.Sprintf("cannot get account: %s", err.Error())
-		return nil, errors.New(msg)
+		t.Fatal(err.Error())
 	}
 
-	return u, nil
-}
+	// Service
+	s := testService(ctx, cfg, log, accountRepo)
 
-func isSameAccount(account tp.Account, toCompare model.Account) bool {
-	return account.TenantID == toCompare.TenantID.String &&
-		account.Slug == toCompare.Slug.String &&
-		account.Name == toCompare.Name.String &&
-		account.OwnerID == toCompare.OwnerID.String &&
-		account.ParentID == toCompare.ParentID.String &&
-		account.AccountType == toCompare.AccountType.String &&
-		account.Email == toCompare.Email.String
+	// Test
+	err = s.CreateAccount(req, &res)
+	if err == nil {
+		t.Error("invalid account should not be created")
+	}
+
+	// Verify
+	if res.MsgID != validationErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	for _, field := range []string{"Name", "AccountType", "Email", "EndsAt"} {
+		if len(res.Errors[field]) == 0 {
+			t.Errorf("expecting a validation error for %s", field)
+		}
+	}
+
+	if len(res.Errors["OwnerID"]) != 0 {
+		t.Errorf("creator should own the account: %v", res.Errors["OwnerID"])
+	}
+
+	if res.Name != "" || res.AccountType != "not-a-type" {
+		t.Error("received values should be kept to fill the form")
+	}
 }
 
-func createSampleUsers() (users []*model.User, err error) {
+// TestUpdateAccountInvalid tests that invalid updates are reported per field
+// and leave the account unchanged.
+func TestUpdateAccountInvalid(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("accountupdinvalid")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
 	ctx := context.Background()
 	cfg := testConfig()
 	log := testLogger()
 
-	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	// Repo
+	accountRepo, err := testRepo(ctx, cfg, log, "repo-handler")
 	if err != nil {
-		return users, err
+		t.Fatal(err.Error())
 	}
-	r.Connect()
 
-	user1 := &model.User{
-		Username:          db.ToNullString(userSample1["username"]),
-		Password:          userSample1["password"],
-		Email:             db.ToNullString(userSample1["email"]),
-		EmailConfirmation: db.ToNullString(userSample1["emailConfirmation"]),
-		GivenName:         db.ToNullString(userSample1["givenName"]),
-		MiddleNames:       db.ToNullString(userSample1["middleNames"]),
-		FamilyName:        db.ToNullString(userSample1["familyName"]),
+	// Service
+	s := testService(ctx, cfg, log, accountRepo)
+
+	origin := tp.Origin{
+		ActorID: user.ID.String(),
 	}
 
-	err = createUser(r, user1)
-	if err != nil {
-		return users, err
+	creq := tp.CreateAccountReq{
+		Account: tp.Account{
+			Name:        "validname",
+			AccountType: accountDataValid["accountType"],
+			Email:       accountDataValid["email"],
+		},
+		Origin: origin,
 	}
 
-	users = append(users, user1)
+	var cres tp.CreateAccountRes
 
-	user2 := &model.User{
-		Username:          db.ToNullString(userSample2["username"]),
-		Password:          userSample2["password"],
-		Email:             db.ToNullString(userSample2["email"]),
-		EmailConfirmation: db.ToNullString(userSample2["emailConfirmation"]),
-		GivenName:         db.ToNullString(userSample2["givenName"]),
-		MiddleNames:       db.ToNullString(userSample2["middleNames"]),
-		FamilyName:        db.ToNullString(userSample2["familyName"]),
+	err = s.CreateAccount(creq, &cres)
+	if err != nil {
+		t.Fatalf("create account error: %s", err.Error())
 	}
 
-	err = createUser(r, user2)
-	if err != nil {
-		return users, err
+	// Setup
+	req := tp.UpdateAccountReq{
+		Identifier: tp.Identifier{
+			Slug: cres.Slug,
+		},
+		Account: tp.Account{
+			Name:        "",
+			AccountType: accountDataValid["accountType"],
+			Email:       "not-an-email",
+			StartsAt:    "not-a-date",
+		},
+		Origin: origin,
 	}
 
-	users = append(users, user2)
+	var res tp.UpdateAccountRes
 
-	return users, nil
-}
+	// Test
+	err = s.UpdateAccount(req, &res)
+	if err == nil {
+		t.Error("invalid account should not be updated")
+	}
 
-func createUser(r *repo.Repo, user *model.User) error {
-	userRepo, err := r.UserRepoNewTx()
+	// Verify
+	if res.MsgID != validationErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	for _, field := range []string{"Name", "Email", "StartsAt"} {
+		if len(res.Errors[field]) == 0 {
+			t.Errorf("expecting a validation error for %s", field)
+		}
+	}
+
+	accountVerify, err := getAccountBySlug(cres.Slug, cfg)
 	if err != nil {
-		return err
+		t.Fatalf("cannot get account from database: %s", err.Error())
 	}
 
-	err = userRepo.Create(user)
+	if accountVerify.Name.String != "validname" || accountVerify.Email.String != accountDataValid["email"] {
+		t.Error("account should not be changed")
+	}
+}
+
+func getAccountBySlug(slug string, cfg *config.Config) (*model.Account, error) {
+	conn, err := getConn()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = userRepo.Commit()
+	schema := cfg.ValOrDef("pg.schema", "public")
+
+	st := `SELECT * FROM %s.accounts WHERE slug='%s';`
+	st = fmt.Sprintf(st, schema, slug)
+
+	u := &model.Account{}
+	err = conn.Get(u, st)
 	if err != nil {
-		return err
+		msg := fmt.Sprintf("cannot get account: %s", err.Error())
+		return nil, errors.New(msg)
 	}
 
-	return nil
+	return u, nil
+}
+
+func isSameAccount(account tp.Account, toCompare model.Account) bool {
+	return account.TenantID == toCompare.TenantID.String &&
+		account.Slug == toCompare.Slug.String &&
+		account.Name == toCompare.Name.String &&
+		account.OwnerID == toCompare.OwnerID.String &&
+		account.ParentID == toCompare.ParentID.String &&
+		account.AccountType == toCompare.AccountType.String &&
+		account.Email == toCompare.Email.String
 }
 
 func createSampleAccounts() (accounts []*model.Account, err error) {
@@ -534,82 +580,3 @@ func createAccount(r *repo.Repo, account *model.Account) error {
 
 	return nil
 }
-
-func setup() *mig.Migrator {
-	m := migration.GetMigrator(testConfig())
-	//m.Reset()
-	m.RollbackAll()
-	m.Migrate()
-	return m
-}
-
-func teardown(m *mig.Migrator) {
-	m.RollbackAll()
-}
-
-func testConfig() *config.Config {
-	cfg := &config.Config{}
-	values := map[string]string{
-		"pg.host":               "localhost",
-		"pg.port":               "5432",
-		"pg.schema":             "public",
-		"pg.database":           "granica_test",
-		"pg.user":               "granica",
-		"pg.password":           "granica",
-		"pg.backoff.maxentries": "3",
-	}
-
-	cfg.SetNamespace("grc")
-	cfg.SetValues(values)
-	return cfg
-}
-
-func testLogger() *log.Logger {
-	return log.NewDevLogger(0, "granica", "n/a")
-}
-
-func testRepo(ctx context.Context, cfg *config.Config, log *log.Logger, name string) (*repo.Repo, error) {
-	rh, err := repo.NewHandler(ctx, cfg, log, name)
-	if err != nil {
-		return nil, err
-	}
-	rh.Connect()
-	if err != nil {
-		return nil, err
-	}
-	return rh, nil
-}
-
-func testService(ctx context.Context, cfg *config.Config, log *log.Logger, r *repo.Repo) *service.Service {
-	s := service.MakeService(ctx, cfg, log)
-	s.SetRepo(r)
-	return s
-}
-
-// getConn returns a connection used to
-// verify repo insert and update operations.
-func getConn() (*sqlx.DB, error) {
-	cfg := testConfig()
-	conn, err := sqlx.Open("postgres", dbURL(cfg))
-	if err != nil {
-		return nil, err
-	}
-
-	err = conn.Ping()
-	if err != nil {
-		return nil, err
-	}
-
-	return conn, nil
-}
-
-// dbURL returns a Postgres connection string.
-func dbURL(cfg *config.Config) string {
-	host := cfg.ValOrDef("pg.host", "localhost")
-	port := cfg.ValOrDef("pg.port", "5432")
-	schema := cfg.ValOrDef("pg.schema", "public")
-	db := cfg.ValOrDef("pg.database", "granica_test")
-	user := cfg.ValOrDef("pg.user", "granica")
-	pass := cfg.ValOrDef("pg.password", "granica")
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable search_path=%s", host, port, user, pass, db, schema)
-}
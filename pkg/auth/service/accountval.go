@@ -0,0 +1,181 @@
+package service
+
+import (
+	"errors"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	notEmailErrMsg      = "not_email_err_msg"
+	ownerNotFoundErrMsg = "owner_not_found_err_msg"
+	ownerInactiveErrMsg = "owner_inactive_err_msg"
+	invalidDateErrMsg   = "invalid_date_err_msg"
+	invalidPeriodErrMsg = "invalid_period_err_msg"
+)
+
+const (
+	maxAccountName = 64
+)
+
+type (
+	AccountValidator struct {
+		Model model.Account
+		service.Validator
+		// Owner is the user referenced by the model, nil if not found.
+		owner *model.User
+		// Subscription dates as received.
+		startsAt string
+		endsAt   string
+	}
+)
+
+func NewAccountValidator(a model.Account) AccountValidator {
+	return AccountValidator{
+		Model:     a,
+		Validator: service.NewValidator(),
+	}
+}
+
+// WithOwner returns a copy of the validator checking owner,
+// the user the model OwnerID refers to, nil if there is none.
+func (av AccountValidator) WithOwner(owner *model.User) AccountValidator {
+	av.owner = owner
+	return av
+}
+
+// WithPeriod returns a copy of the validator aware of the subscription dates
+// as they were received, model only holds the ones that could be parsed.
+func (av AccountValidator) WithPeriod(startsAt, endsAt string) AccountValidator {
+	av.startsAt = startsAt
+	av.endsAt = endsAt
+	return av
+}
+
+func (av AccountValidator) ValidateForCreate() error {
+	// Name
+	ok0 := av.ValidateRequiredName()
+	ok1 := av.ValidateMaxLengthName(maxAccountName)
+	// Email
+	ok2 := av.ValidateEmailEmail()
+	// AccountType
+	ok3 := av.ValidateAccountType()
+	// Owner
+	ok4 := av.ValidateOwner()
+	// Subscription
+	ok5 := av.ValidatePeriod()
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 {
+		return nil
+	}
+
+	return errors.New("account has errors")
+}
+
+func (av AccountValidator) ValidateForUpdate() error {
+	// Name
+	ok0 := av.ValidateRequiredName()
+	ok1 := av.ValidateMaxLengthName(maxAccountName)
+	// Email
+	ok2 := av.ValidateEmailEmail()
+	// AccountType
+	ok3 := av.ValidateAccountType()
+	// Owner
+	ok4 := av.ValidateOwner()
+	// Subscription
+	ok5 := av.ValidatePeriod()
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 {
+		return nil
+	}
+
+	return errors.New("account has errors")
+}
+
+func (av AccountValidator) ValidateRequiredName() (ok bool) {
+	ok = av.ValidateRequired(av.Model.Name.String)
+	if ok {
+		return true
+	}
+
+	av.Errors.Add("Name", requiredErrMsg)
+	return false
+}
+
+func (av AccountValidator) ValidateMaxLengthName(max int) (ok bool) {
+	ok = av.ValidateMaxLength(av.Model.Name.String, max+1)
+	if ok {
+		return true
+	}
+
+	av.Errors.Add("Name", maxLengthErrMsg)
+	return false
+}
+
+// ValidateEmailEmail checks the account contact email, it is optional.
+func (av AccountValidator) ValidateEmailEmail() (ok bool) {
+	email := av.Model.Email.String
+	if email == "" || av.ValidateEmail(email) {
+		return true
+	}
+
+	av.Errors.Add("Email", notEmailErrMsg)
+	return false
+}
+
+// ValidateAccountType requires one of model.AccountTypes.
+func (av AccountValidator) ValidateAccountType() (ok bool) {
+	t := av.Model.AccountType.String
+	if !av.ValidateRequired(t) {
+		av.Errors.Add("AccountType", requiredErrMsg)
+		return false
+	}
+
+	if !model.IsAccountType(t) {
+		av.Errors.Add("AccountType", notAllowedErrMsg)
+		return false
+	}
+
+	return true
+}
+
+// ValidateOwner requires an existing and active owner.
+func (av AccountValidator) ValidateOwner() (ok bool) {
+	if av.owner == nil {
+		av.Errors.Add("OwnerID", ownerNotFoundErrMsg)
+		return false
+	}
+
+	if av.owner.IsActive.Valid && !av.owner.IsActive.Bool {
+		av.Errors.Add("OwnerID", ownerInactiveErrMsg)
+		return false
+	}
+
+	return true
+}
+
+// ValidatePeriod checks subscription dates, both are optional
+// but the subscription must start before it ends.
+func (av AccountValidator) ValidatePeriod() (ok bool) {
+	a := av.Model
+	ok = true
+
+	if av.startsAt != "" && !a.StartsAt.Valid {
+		av.Errors.Add("StartsAt", invalidDateErrMsg)
+		ok = false
+	}
+
+	if av.endsAt != "" && !a.EndsAt.Valid {
+		av.Errors.Add("EndsAt", invalidDateErrMsg)
+		ok = false
+	}
+
+	if a.StartsAt.Valid && a.EndsAt.Valid && !a.StartsAt.Time.Before(a.EndsAt.Time) {
+		av.Errors.Add("EndsAt", invalidPeriodErrMsg)
+		ok = false
+	}
+
+	return ok
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// Info
+	usersUpdatedInfo       = "users_updated_info"
+	accountActivatedInfo   = "account_activated_info"
+	accountDeactivatedInfo = "account_deactivated_info"
+	// Error
+	getAdminStatsErr     = "cannot_get_admin_stats_err"
+	getAdminUsersErr     = "cannot_get_admin_users_err"
+	getAdminAccountsErr  = "cannot_get_admin_accounts_err"
+	bulkUpdateUsersErr   = "cannot_bulk_update_users_err"
+	invalidBulkActionErr = "invalid_bulk_action_err"
+	setAccountActiveErr  = "cannot_set_account_active_err"
+	forbiddenErr         = "forbidden_err"
+)
+
+const (
+	defaultAdminStatsDays = 30
+	defaultAdminBulkLimit = 100
+)
+
+// Bulk user actions
+const (
+	bulkConfirm            = "confirm"
+	bulkActivate           = "activate"
+	bulkDeactivate         = "deactivate"
+	bulkDelete             = "delete"
+	bulkResendConfirmation = "resend-confirmation"
+)
+
+var (
+	bulkUserActions = []string{bulkConfirm, bulkActivate, bulkDeactivate, bulkDelete, bulkResendConfirmation}
+
+	// statsActions are the audited actions counted by day in the dashboard.
+	statsActions = []string{userSignedUpEvt, userConfirmedEvt, userSignInFailedEvt}
+)
+
+var (
+	// ErrForbidden is returned when the actor cannot operate on the target.
+	ErrForbidden = errors.New("forbidden")
+	// ErrInvalidBulkAction is returned for unknown bulk actions
+	// or when no users, or too many of them, are selected.
+	ErrInvalidBulkAction = errors.New("invalid bulk action")
+)
+
+// CheckAdmin returns ErrForbidden unless the origin actor is an admin.
+// Denied requests are audited.
+func (s *Service) CheckAdmin(req tp.CheckAdminReq, res *tp.CheckAdminRes) error {
+	err := s.checkAdmin(req.Origin)
+	if err != nil {
+		res.FromModel(adminErr(err, cannotProcErr), err)
+		return err
+	}
+
+	res.FromModel(okResultInfo, nil)
+	return nil
+}
+
+// GetAdminStats returns user and account totals
+// along with sign-ups, confirmations and failed sign-ins per day.
+func (s *Service) GetAdminStats(req tp.GetAdminStatsReq, res *tp.GetAdminStatsRes) error {
+	// Set envar GRN_APP_ADMIN_STATS_DAYS to change
+	// how many days back the dashboard goes.
+	days := int(s.Cfg().ValAsInt("app.admin.stats.days", defaultAdminStatsDays))
+	if days <= 0 {
+		days = defaultAdminStatsDays
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := today.AddDate(0, 0, 1-days)
+
+	// Repo
+	repo, err := s.repo.StatsRepoNewTx()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, adminErr(err, getAdminStatsErr), err)
+		return err
+	}
+
+	t, err := repo.Totals()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, getAdminStatsErr, err)
+		return err
+	}
+
+	counts, err := repo.CountByDay(statsActions, from)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, getAdminStatsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, getAdminStatsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&t, dailyStats(counts, from, days), okResultInfo, nil)
+	return nil
+}
+
+// IndexAdminUsers lists users along with their roles.
+// Service accounts are managed from their account and not listed.
+func (s *Service) IndexAdminUsers(req tp.IndexAdminUsersReq, res *tp.IndexAdminUsersRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, adminErr(err, getAdminUsersErr), err)
+		return err
+	}
+
+	all, err := repo.GetAll()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminUsersErr, err)
+		return err
+	}
+
+	var us []model.User
+	var ids []string
+	for _, u := range all {
+		if u.IsServiceAccount() {
+			continue
+		}
+		us = append(us, u)
+		ids = append(ids, u.ID.String())
+	}
+
+	rs, err := s.repo.RoleRepo(repo.Tx).GetByUsers(ids)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminUsersErr, err)
+		return err
+	}
+
+	roles := map[string][]string{}
+	for _, r := range rs {
+		roles[r.UserID] = append(roles[r.UserID], r.Name)
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userListedEvt, userTarget, "", meta{"count": len(us)}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminUsersErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, nil, getAdminUsersErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(us, roles, bulkUserActions, okResultInfo, nil)
+	return nil
+}
+
+// BulkUpdateUsers applies an action to the users identified by slugs.
+// Each user is updated in its own transaction so that a failure
+// does not undo the others, users already in the requested state are skipped.
+// Admins cannot deactivate or delete themselves.
+func (s *Service) BulkUpdateUsers(req tp.BulkUpdateUsersReq, res *tp.BulkUpdateUsersRes) error {
+	o := req.Origin
+
+	// Set envar GRN_APP_ADMIN_BULK_LIMIT to change
+	// how many users can be updated at once.
+	max := int(s.Cfg().ValAsInt("app.admin.bulk.limit", defaultAdminBulkLimit))
+
+	if !isBulkUserAction(req.Action) || len(req.Slugs) == 0 || len(req.Slugs) > max {
+		res.FromModel(req.Action, 0, 0, nil, invalidBulkActionErr, ErrInvalidBulkAction)
+		return ErrInvalidBulkAction
+	}
+
+	err := s.checkAdmin(o)
+	if err != nil {
+		res.FromModel(req.Action, 0, 0, nil, adminErr(err, bulkUpdateUsersErr), err)
+		return err
+	}
+
+	var updated, skipped int
+	var failed []string
+
+	for _, slug := range req.Slugs {
+		changed, err := s.bulkUpdateUser(req.Action, slug, o)
+		if err != nil {
+			s.Log().Error(err, "action", req.Action, "user", slug)
+			failed = append(failed, slug)
+			continue
+		}
+
+		if changed {
+			updated++
+		} else {
+			skipped++
+		}
+	}
+
+	if len(failed) > 0 {
+		err = fmt.Errorf("%d of %d users not updated", len(failed), len(req.Slugs))
+		res.FromModel(req.Action, updated, skipped, failed, bulkUpdateUsersErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(req.Action, updated, skipped, nil, usersUpdatedInfo, nil)
+	return nil
+}
+
+// bulkUpdateUser applies a bulk action to a user.
+// It returns false if the user was already in the requested state.
+func (s *Service) bulkUpdateUser(action, slug string, o tp.Origin) (changed bool, err error) {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		return false, err
+	}
+
+	u, err := repo.GetBySlug(slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		return false, err
+	}
+
+	changed, err = s.applyBulkUserAction(repo.Tx, action, &u, o)
+	if err != nil || !changed {
+		repo.Tx.Rollback()
+		return false, err
+	}
+
+	return true, repo.Commit()
+}
+
+func (s *Service) applyBulkUserAction(tx *sqlx.Tx, action string, u *model.User, o tp.Origin) (changed bool, err error) {
+	if u.IsServiceAccount() {
+		return false, ErrForbidden
+	}
+
+	ur := s.repo.UserRepo(tx)
+	id := u.ID.String()
+	md := meta{"bulk": true}
+
+	var evt string
+
+	switch action {
+	case bulkConfirm:
+		if u.IsConfirmed.Bool {
+			return false, nil
+		}
+
+		err = ur.SetConfirmed(id)
+		evt = userConfirmedEvt
+
+	case bulkActivate:
+		if isActiveUser(*u) {
+			return false, nil
+		}
+
+		err = ur.SetActive(id, true)
+		evt = userActivatedEvt
+
+	case bulkDeactivate:
+		if isSelf(o, u) {
+			return false, ErrForbidden
+		}
+
+		if !isActiveUser(*u) {
+			return false, nil
+		}
+
+		err = ur.SetActive(id, false)
+		if err != nil {
+			return false, err
+		}
+
+		md["sessions"], err = s.repo.SessionRepo(tx).RevokeAll(id)
+		evt = userDeactivatedEvt
+
+	case bulkDelete:
+		if isSelf(o, u) {
+			return false, ErrForbidden
+		}
+
+		err = s.checkOwnsNoAccounts(tx, id)
+		if err != nil {
+			return false, err
+		}
+
+		// Soft delete, the user is purged after the retention period.
+		err = ur.Delete(id, o.ActorID)
+		if err != nil {
+			return false, err
+		}
+
+		md["sessions"], err = s.repo.SessionRepo(tx).RevokeAll(id)
+		evt = userDeletedEvt
+
+	case bulkResendConfirmation:
+		if u.IsConfirmed.Bool || !u.ConfirmationToken.Valid {
+			return false, nil
+		}
+
+		err = s.queueConfirmationEmail(tx, u)
+		evt = userConfirmationResentEvt
+
+	default:
+		return false, ErrInvalidBulkAction
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	// Audit
+	err = s.recordEvent(tx, newEvent(o, evt, userTarget, u.Slug.String, md))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IndexAdminAccounts lists accounts along with their owner.
+func (s *Service) IndexAdminAccounts(req tp.IndexAdminAccountsReq, res *tp.IndexAdminAccountsRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(nil, nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, adminErr(err, getAdminAccountsErr), err)
+		return err
+	}
+
+	as, err := repo.GetAll()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminAccountsErr, err)
+		return err
+	}
+
+	us, err := s.repo.UserRepo(repo.Tx).GetAll()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminAccountsErr, err)
+		return err
+	}
+
+	owners := map[string]string{}
+	for _, u := range us {
+		owners[u.ID.String()] = u.Username.String
+	}
+
+	ts, err := s.repo.TransferRepo(repo.Tx).GetAwaitingApproval()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminAccountsErr, err)
+		return err
+	}
+
+	transfers := map[string]string{}
+	for _, t := range ts {
+		transfers[t.AccountID] = t.ToID
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, accountListedEvt, accountTarget, "", meta{"count": len(as)}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, getAdminAccountsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, nil, getAdminAccountsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(as, owners, transfers, okResultInfo, nil)
+	return nil
+}
+
+// SetAccountActive activates or deactivates an account.
+func (s *Service) SetAccountActive(req tp.SetAccountActiveReq, res *tp.SetAccountActiveRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(adminErr(err, setAccountActiveErr), err)
+		return err
+	}
+
+	a, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(setAccountActiveErr, err)
+		return err
+	}
+
+	err = repo.SetActive(a.ID.String(), req.Active)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(setAccountActiveErr, err)
+		return err
+	}
+
+	// Audit
+	evt, msgID := accountDeactivatedEvt, accountDeactivatedInfo
+	if req.Active {
+		evt, msgID = accountActivatedEvt, accountActivatedInfo
+	}
+
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, evt, accountTarget, a.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(setAccountActiveErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(setAccountActiveErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(msgID, nil)
+	return nil
+}
+
+// checkAdmin is requireAdmin in its own transaction,
+// denials are audited.
+func (s *Service) checkAdmin(o tp.Origin) error {
+	repo, err := s.repo.RoleRepoNewTx()
+	if err != nil {
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		if err == ErrForbidden {
+			s.recordFailure(newEvent(o, adminAccessDeniedEvt, adminTarget, "", nil))
+		}
+		return err
+	}
+
+	return repo.Commit()
+}
+
+// requireAdmin returns ErrForbidden unless the origin actor is an admin
+// signed in with their own session.
+// Admin actions cannot be taken with API keys nor while impersonating.
+func (s *Service) requireAdmin(tx *sqlx.Tx, o tp.Origin) error {
+	if o.ActorID == "" || o.APIKeyID != "" || isImpersonating(o) {
+		return ErrForbidden
+	}
+
+	admin, err := s.repo.RoleRepo(tx).Has(o.ActorID, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if !admin {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// adminErr returns the message for an admin action error.
+func adminErr(err error, msgID string) string {
+	if err == ErrForbidden {
+		return forbiddenErr
+	}
+	return msgID
+}
+
+// dailyStats spreads counts over the days starting at from.
+// Days without events are kept with zero counts.
+func dailyStats(counts []model.DailyCount, from time.Time, days int) []model.DailyStat {
+	ds := make([]model.DailyStat, days)
+	for i := range ds {
+		ds[i].Day = from.AddDate(0, 0, i)
+	}
+
+	for _, c := range counts {
+		i := int(c.Day.Sub(from).Hours() / 24)
+		if i < 0 || i >= days {
+			continue
+		}
+
+		switch c.Action {
+		case userSignedUpEvt:
+			ds[i].SignUps += c.Count
+		case userConfirmedEvt:
+			ds[i].Confirmations += c.Count
+		case userSignInFailedEvt:
+			ds[i].FailedSignIns += c.Count
+		}
+	}
+
+	return ds
+}
+
+func isBulkUserAction(action string) bool {
+	for _, a := range bulkUserActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
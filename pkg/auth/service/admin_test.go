@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// TestBulkDeactivateUsers tests that admins can deactivate users
+// and that their open sessions are revoked.
+func TestBulkDeactivateUsers(t *testing.T) {
+	// Prerequisites
+	admin, err := createNamedUser("bulkadmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	user, err := createNamedUser("bulktarget")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err = grantAdmin(r, admin)
+	if err != nil {
+		t.Fatalf("error granting admin role: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	token, _, err := openTestSession(s, user)
+	if err != nil {
+		t.Fatalf("error opening session: %s", err.Error())
+	}
+
+	req := tp.BulkUpdateUsersReq{
+		Action: "deactivate",
+		Slugs:  []string{user.Slug.String},
+		Origin: tp.Origin{ActorID: admin.ID.String()},
+	}
+
+	var res tp.BulkUpdateUsersRes
+
+	// Test
+	err = s.BulkUpdateUsers(req, &res)
+	if err != nil {
+		t.Errorf("bulk update users error: %s", err.Error())
+	}
+
+	// Verify
+	if res.MsgID != "users_updated_info" {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if res.Updated != 1 || res.Skipped != 0 {
+		t.Errorf("expecting one updated user got %d updated and %d skipped", res.Updated, res.Skipped)
+	}
+
+	uVerify, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if !uVerify.IsActive.Valid || uVerify.IsActive.Bool {
+		t.Error("user should be inactive")
+	}
+
+	var vres tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token}, &vres)
+	if err == nil {
+		t.Error("sessions of deactivated users should be revoked")
+	}
+
+	// Deactivating again skips the user.
+	var again tp.BulkUpdateUsersRes
+	err = s.BulkUpdateUsers(req, &again)
+	if err != nil {
+		t.Errorf("second bulk update users error: %s", err.Error())
+	}
+
+	if again.Updated != 0 || again.Skipped != 1 {
+		t.Errorf("expecting one skipped user got %d updated and %d skipped", again.Updated, again.Skipped)
+	}
+
+	// Admins cannot deactivate themselves.
+	self := tp.BulkUpdateUsersReq{
+		Action: "deactivate",
+		Slugs:  []string{admin.Slug.String},
+		Origin: tp.Origin{ActorID: admin.ID.String()},
+	}
+
+	var sres tp.BulkUpdateUsersRes
+	err = s.BulkUpdateUsers(self, &sres)
+	if err == nil {
+		t.Error("admins should not deactivate themselves")
+	}
+
+	if len(sres.Failed) != 1 || sres.Failed[0] != admin.Slug.String {
+		t.Errorf("expecting admin listed as failed got %v", sres.Failed)
+	}
+}
+
+// TestBulkUpdateUsersForbidden tests that non-admins cannot run bulk actions.
+func TestBulkUpdateUsersForbidden(t *testing.T) {
+	// Prerequisites
+	actor, err := createNamedUser("bulknonadmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	user, err := createNamedUser("bulkvictim")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	req := tp.BulkUpdateUsersReq{
+		Action: "deactivate",
+		Slugs:  []string{user.Slug.String},
+		Origin: tp.Origin{ActorID: actor.ID.String()},
+	}
+
+	var res tp.BulkUpdateUsersRes
+
+	// Test
+	err = s.BulkUpdateUsers(req, &res)
+
+	// Verify
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got %v", err)
+	}
+
+	if res.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	uVerify, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if uVerify.IsActive.Valid && !uVerify.IsActive.Bool {
+		t.Error("user should still be active")
+	}
+}
+
+// TestBulkUpdateUsersInvalidAction tests that unknown actions are rejected.
+func TestBulkUpdateUsersInvalidAction(t *testing.T) {
+	// Prerequisites
+	admin, err := createNamedUser("bulkinvalid")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err = grantAdmin(r, admin)
+	if err != nil {
+		t.Fatalf("error granting admin role: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	req := tp.BulkUpdateUsersReq{
+		Action: "promote",
+		Slugs:  []string{admin.Slug.String},
+		Origin: tp.Origin{ActorID: admin.ID.String()},
+	}
+
+	var res tp.BulkUpdateUsersRes
+
+	// Test
+	err = s.BulkUpdateUsers(req, &res)
+
+	// Verify
+	if err != service.ErrInvalidBulkAction {
+		t.Errorf("expecting invalid bulk action error got %v", err)
+	}
+
+	if res.MsgID != "invalid_bulk_action_err" {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+}
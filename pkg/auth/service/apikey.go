@@ -0,0 +1,333 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	apiKeyCreatedInfo     = "api_key_created_info"
+	apiKeyRevokedInfo     = "api_key_revoked_info"
+	getAPIKeysErr         = "cannot_get_api_keys_err"
+	createAPIKeyErr       = "cannot_create_api_key_err"
+	revokeAPIKeyErr       = "cannot_revoke_api_key_err"
+	invalidAPIKeyErr      = "invalid_api_key_err"
+	defaultAPIKeyTTLDays  = 90
+	defaultAPIKeyMaxDays  = 365
+	apiKeyRejectedIP      = "ip_not_allowed"
+	apiKeyRejectedScope   = "scope_not_granted"
+	apiKeyRejectedAccount = "account_not_active"
+)
+
+var (
+	// ErrInvalidAPIKey is returned for unknown, revoked and expired API keys.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+)
+
+type (
+	// apiKeyOwner is either a user or an account.
+	apiKeyOwner struct {
+		user    *model.User
+		account *model.Account
+	}
+)
+
+// IndexAPIKeys lists the API keys of a user or of an account.
+func (s *Service) IndexAPIKeys(req tp.IndexAPIKeysReq, res *tp.IndexAPIKeysRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	owner, err := s.apiKeyOwner(repo.Tx, req.Slug, req.Account, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, apiKeyOwnerErr(err, getAPIKeysErr), err)
+		return err
+	}
+
+	kr := s.repo.APIKeyRepo(repo.Tx)
+
+	var keys []model.APIKey
+	if owner.account != nil {
+		keys, err = kr.GetByAccount(owner.account.ID.String())
+	} else {
+		keys, err = kr.GetByUser(owner.user.ID.String())
+	}
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(owner.user, nil, getAPIKeysErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(owner.user, nil, getAPIKeysErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(owner.user, keys, okResultInfo, nil)
+	return nil
+}
+
+// CreateAPIKey issues a new API key for a user or an account.
+// The key is only returned here, just its digest is stored.
+func (s *Service) CreateAPIKey(req tp.CreateAPIKeyReq, res *tp.CreateAPIKeyRes) error {
+	// Model
+	k := model.APIKey{
+		Name:        strings.TrimSpace(req.Name),
+		Scopes:      strings.Join(req.Scopes, " "),
+		AllowedIPs:  db.ToNullString(strings.Join(model.SplitIPList(req.AllowedIPs), ",")),
+		CreatedByID: db.ToNullString(req.ActorID),
+	}
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = int(s.Cfg().ValAsInt("app.apikey.ttl.days", defaultAPIKeyTTLDays))
+	}
+
+	// Validation
+	v := NewAPIKeyValidator(k)
+
+	err := v.ValidateForCreate(days, int(s.Cfg().ValAsInt("app.apikey.max.ttl.days", defaultAPIKeyMaxDays)))
+	if err != nil {
+		res.FromModel(nil, "", v.Errors, validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, "", nil, cannotProcErr, err)
+		return err
+	}
+
+	owner, err := s.apiKeyOwner(repo.Tx, req.Slug, req.Account, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, apiKeyOwnerErr(err, createAPIKeyErr), err)
+		return err
+	}
+
+	md := meta{"name": k.Name, "scopes": k.Scopes}
+	if owner.account != nil {
+		k.AccountID = db.ToNullString(owner.account.ID.String())
+		md["account"] = owner.account.Slug.String
+	} else {
+		k.UserID = db.ToNullString(owner.user.ID.String())
+		md["user"] = owner.user.Slug.String
+	}
+
+	key, err := k.GenKey()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createAPIKeyErr, err)
+		return err
+	}
+
+	k.SetCreateValues(time.Duration(days) * 24 * time.Hour)
+	md["prefix"] = k.Prefix
+
+	err = s.repo.APIKeyRepo(repo.Tx).Create(&k)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createAPIKeyErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, apiKeyCreatedEvt, apiKeyTarget, k.ID.String(), md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createAPIKeyErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", nil, createAPIKeyErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&k, key, nil, apiKeyCreatedInfo, nil)
+	return nil
+}
+
+// RevokeAPIKey revokes an API key of a user or of an account.
+func (s *Service) RevokeAPIKey(req tp.RevokeAPIKeyReq, res *tp.RevokeAPIKeyRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	owner, err := s.apiKeyOwner(repo.Tx, req.Slug, req.Account, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(apiKeyOwnerErr(err, revokeAPIKeyErr), err)
+		return err
+	}
+
+	kr := s.repo.APIKeyRepo(repo.Tx)
+
+	var md meta
+	if owner.account != nil {
+		err = kr.RevokeByAccount(owner.account.ID.String(), req.APIKeyID)
+		md = meta{"account": owner.account.Slug.String}
+	} else {
+		err = kr.RevokeByUser(owner.user.ID.String(), req.APIKeyID)
+		md = meta{"user": owner.user.Slug.String}
+	}
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeAPIKeyErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, apiKeyRevokedEvt, apiKeyTarget, req.APIKeyID, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeAPIKeyErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(revokeAPIKeyErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(apiKeyRevokedInfo, nil)
+	return nil
+}
+
+// ValidateAPIKey resolves an API key into the user requests act as.
+// Account keys act as the current account owner.
+// Keys used from a non allowed IP or for a non granted scope
+// are rejected with ErrForbidden, the rejection is audited.
+// Successful uses are not audited but tracked on the key.
+func (s *Service) ValidateAPIKey(req tp.ValidateAPIKeyReq, res *tp.ValidateAPIKeyRes) error {
+	// Repo
+	repo, err := s.apiKeyRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	k, err := repo.GetActiveByKey(model.TokenDigest(req.Key))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidAPIKeyErr, ErrInvalidAPIKey)
+		return ErrInvalidAPIKey
+	}
+
+	reject := func(reason string) error {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, apiKeyRejectedEvt, apiKeyTarget, k.ID.String(), meta{"reason": reason, "scope": req.Scope}))
+		res.FromModel(nil, nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	if !k.AllowsIP(req.IP) {
+		return reject(apiKeyRejectedIP)
+	}
+
+	if !k.HasScope(req.Scope) {
+		return reject(apiKeyRejectedScope)
+	}
+
+	userID := k.UserID.String
+	if k.AccountID.Valid {
+		a, err := s.repo.AccountRepo(repo.Tx).Get(k.AccountID.String)
+		if err != nil || (a.IsActive.Valid && !a.IsActive.Bool) {
+			return reject(apiKeyRejectedAccount)
+		}
+		userID = a.OwnerID.String
+	}
+
+	u, err := s.repo.UserRepo(repo.Tx).Get(userID)
+	if err != nil || !isActiveUser(u) {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidAPIKeyErr, ErrInvalidAPIKey)
+		return ErrInvalidAPIKey
+	}
+
+	err = repo.Touch(k.ID.String(), db.ToNullString(req.IP))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidAPIKeyErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, invalidAPIKeyErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&k, &u, okResultInfo, nil)
+	return nil
+}
+
+// apiKeyOwner resolves the user or the account identified by slug.
+// Users manage their own keys, account keys are managed by the account owner.
+// Keys cannot be managed using an API key.
+func (s *Service) apiKeyOwner(tx *sqlx.Tx, slug string, account bool, o tp.Origin) (owner apiKeyOwner, err error) {
+	if o.APIKeyID != "" {
+		return owner, ErrForbidden
+	}
+
+	if account {
+		a, err := s.repo.AccountRepo(tx).GetBySlug(slug)
+		if err != nil {
+			return owner, err
+		}
+
+		if o.ActorID == "" || o.ActorID != a.OwnerID.String {
+			return owner, ErrForbidden
+		}
+
+		owner.account = &a
+		return owner, nil
+	}
+
+	u, err := s.repo.UserRepo(tx).GetBySlug(slug)
+	if err != nil {
+		return owner, err
+	}
+
+	if !isSelf(o, &u) {
+		return owner, ErrForbidden
+	}
+
+	owner.user = &u
+	return owner, nil
+}
+
+// apiKeyOwnerErr returns the message for an owner resolution error.
+func apiKeyOwnerErr(err error, msgID string) string {
+	if err == ErrForbidden {
+		return forbiddenErr
+	}
+	return msgID
+}
+
+// Misc
+func (s *Service) apiKeyRepo() (*repo.APIKeyRepo, error) {
+	return s.repo.APIKeyRepoNewTx()
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"errors"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	requiredErrMsg          = "required_err_msg"
+	maxLengthErrMsg         = "max_length_err_msg"
+	notAllowedErrMsg        = "not_allowed_err_msg"
+	invalidIPErrMsg         = "invalid_ip_err_msg"
+	invalidExpirationErrMsg = "invalid_expiration_err_msg"
+)
+
+const (
+	maxAPIKeyName = 64
+)
+
+type (
+	APIKeyValidator struct {
+		Model model.APIKey
+		service.Validator
+	}
+)
+
+func NewAPIKeyValidator(k model.APIKey) APIKeyValidator {
+	return APIKeyValidator{
+		Model:     k,
+		Validator: service.NewValidator(),
+	}
+}
+
+// ValidateForCreate checks key name, scopes and allowed IPs.
+// Key lifetime is given in days, up to maxDays.
+func (kv APIKeyValidator) ValidateForCreate(days, maxDays int) error {
+	// Name
+	ok0 := kv.ValidateRequiredName()
+	ok1 := kv.ValidateMaxLengthName(maxAPIKeyName)
+	// Scopes
+	ok2 := kv.ValidateScopes()
+	// AllowedIPs
+	ok3 := kv.ValidateAllowedIPs()
+	// Expiration
+	ok4 := kv.ValidateExpiration(days, maxDays)
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 {
+		return nil
+	}
+
+	return errors.New("API key has errors")
+}
+
+func (kv APIKeyValidator) ValidateRequiredName() (ok bool) {
+	ok = kv.ValidateRequired(kv.Model.Name)
+	if ok {
+		return true
+	}
+
+	kv.Errors.Add("Name", requiredErrMsg)
+	return false
+}
+
+func (kv APIKeyValidator) ValidateMaxLengthName(max int) (ok bool) {
+	ok = kv.ValidateMaxLength(kv.Model.Name, max+1)
+	if ok {
+		return true
+	}
+
+	kv.Errors.Add("Name", maxLengthErrMsg)
+	return false
+}
+
+// ValidateScopes requires at least one scope, all of them known.
+func (kv APIKeyValidator) ValidateScopes() (ok bool) {
+	scopes := kv.Model.ScopeList()
+	if len(scopes) == 0 {
+		kv.Errors.Add("Scopes", requiredErrMsg)
+		return false
+	}
+
+	for _, s := range scopes {
+		if !model.IsAPIKeyScope(s) {
+			kv.Errors.Add("Scopes", notAllowedErrMsg)
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateAllowedIPs accepts addresses and CIDR ranges.
+func (kv APIKeyValidator) ValidateAllowedIPs() (ok bool) {
+	for _, e := range model.SplitIPList(kv.Model.AllowedIPs.String) {
+		if !model.IsIPOrCIDR(e) {
+			kv.Errors.Add("AllowedIPs", invalidIPErrMsg)
+			return false
+		}
+	}
+
+	return true
+}
+
+func (kv APIKeyValidator) ValidateExpiration(days, maxDays int) (ok bool) {
+	if days > 0 && days <= maxDays {
+		return true
+	}
+
+	kv.Errors.Add("ExpiresInDays", invalidExpirationErrMsg)
+	return false
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// Targets
+	userTarget    = "user"
+	accountTarget = "account"
+	auditTarget   = "audit"
+	sessionTarget = "session"
+	adminTarget   = "admin"
+	apiKeyTarget  = "api_key"
+	emailTarget   = "email"
+	// Service account targets
+	serviceAccountTarget   = "service_account"
+	clientCredentialTarget = "client_credential"
+	// User actions
+	userCreatedEvt       = "user.created"
+	userListedEvt        = "user.listed"
+	userSearchedEvt      = "user.searched"
+	userViewedEvt        = "user.viewed"
+	userUpdatedEvt       = "user.updated"
+	userDeletedEvt       = "user.deleted"
+	userDeletedListedEvt = "user.deleted_listed"
+	userRestoredEvt      = "user.restored"
+	userSignedUpEvt      = "user.signed_up"
+	userConfirmedEvt     = "user.confirmed"
+	userConfirmFailedEvt = "user.confirmation_failed"
+	userSignedInEvt      = "user.signed_in"
+	userSignInFailedEvt  = "user.sign_in_failed"
+	userPurgedEvt        = "user.purged"
+	// User admin actions
+	userConfirmationResentEvt = "user.confirmation_resent"
+	userRoleGrantedEvt        = "user.role_granted"
+	userRoleRevokedEvt        = "user.role_revoked"
+	// User email change actions
+	userEmailChangeRequestedEvt = "user.email_change_requested"
+	userEmailChangedEvt         = "user.email_changed"
+	userEmailChangeCancelledEvt = "user.email_change_cancelled"
+	userEmailChangeFailedEvt    = "user.email_change_failed"
+	// User password actions
+	userPasswordChangedEvt      = "user.password_changed"
+	userPasswordChangeFailedEvt = "user.password_change_failed"
+	// User magic link actions
+	userMagicLinkRequestedEvt = "user.magic_link_requested"
+	userMagicLinkFailedEvt    = "user.magic_link_failed"
+	// User identity provider actions
+	userIdentityLinkedEvt        = "user.identity_linked"
+	userIdentityUnlinkedEvt      = "user.identity_unlinked"
+	userFederatedSignInFailedEvt = "user.federated_sign_in_failed"
+	userIdentityAssertedEvt      = "user.identity_asserted"
+	// User directory actions
+	userRolesChangedEvt    = "user.roles_changed"
+	userActivatedEvt       = "user.activated"
+	userDeactivatedEvt     = "user.deactivated"
+	userDirectorySyncedEvt = "user.directory_synced"
+	// User impersonation actions
+	userImpersonationStartedEvt = "user.impersonation_started"
+	userImpersonationStoppedEvt = "user.impersonation_stopped"
+	userImpersonationDeniedEvt  = "user.impersonation_denied"
+	// Account actions
+	accountCreatedEvt  = "account.created"
+	accountListedEvt   = "account.listed"
+	accountViewedEvt   = "account.viewed"
+	accountUpdatedEvt  = "account.updated"
+	accountDeletedEvt  = "account.deleted"
+	accountRestoredEvt = "account.restored"
+	accountPurgedEvt   = "account.purged"
+	// Account admin actions
+	accountActivatedEvt   = "account.activated"
+	accountDeactivatedEvt = "account.deactivated"
+	// Account subscription actions
+	accountSubscriptionChangedEvt = "account.subscription_changed"
+	accountExpiryWarnedEvt        = "account.expiry_warned"
+	// Account ownership actions
+	accountTransferInitiatedEvt = "account.transfer_initiated"
+	accountTransferConfirmedEvt = "account.transfer_confirmed"
+	accountTransferCancelledEvt = "account.transfer_cancelled"
+	accountTransferFailedEvt    = "account.transfer_failed"
+	accountOwnerTransferredEvt  = "account.owner_transferred"
+	// Session actions
+	sessionRevokedEvt      = "session.revoked"
+	sessionRevokedAllEvt   = "session.revoked_all"
+	sessionChallengedEvt   = "session.challenged"
+	sessionVerifiedEvt     = "session.verified"
+	sessionVerifyFailedEvt = "session.verification_failed"
+	// API key actions
+	apiKeyCreatedEvt  = "api_key.created"
+	apiKeyRevokedEvt  = "api_key.revoked"
+	apiKeyRejectedEvt = "api_key.rejected"
+	// Service account actions
+	serviceAccountCreatedEvt    = "service_account.created"
+	serviceAccountDeletedEvt    = "service_account.deleted"
+	clientCredentialCreatedEvt  = "client_credential.created"
+	clientCredentialRevokedEvt  = "client_credential.revoked"
+	clientCredentialRejectedEvt = "client_credential.rejected"
+	// Outbox email actions
+	emailListedEvt     = "email.listed"
+	emailViewedEvt     = "email.viewed"
+	emailRequeuedEvt   = "email.requeued"
+	emailSuppressedEvt = "email.suppressed"
+	// Audit actions
+	auditListedEvt = "audit.listed"
+	// Admin actions
+	adminAccessDeniedEvt = "admin.access_denied"
+)
+
+const (
+	getAuditEventsErr = "cannot_get_audit_events_err"
+)
+
+type (
+	// meta holds free form event metadata.
+	meta map[string]interface{}
+)
+
+// newEvent builds an audit event for an action issued from origin.
+func newEvent(o tp.Origin, action, targetType, targetID string, md meta) *model.AuditEvent {
+	e := &model.AuditEvent{
+		ActorID:    db.ToNullString(o.ActorID),
+		TargetType: db.ToNullString(targetType),
+		TargetID:   db.ToNullString(targetID),
+		Action:     action,
+		IP:         db.ToNullString(o.IP),
+		UserAgent:  db.ToNullString(o.UserAgent),
+		RequestID:  db.ToNullString(o.RequestID),
+	}
+
+	// Actions taken with an API key are traced to it.
+	if o.APIKeyID != "" {
+		if md == nil {
+			md = meta{}
+		}
+		md["api_key"] = o.APIKeyID
+	}
+
+	// So are actions taken by an admin impersonating the actor.
+	if o.ImpersonatorID != "" {
+		if md == nil {
+			md = meta{}
+		}
+		md["impersonator"] = o.ImpersonatorID
+	}
+
+	if len(md) > 0 {
+		e.Metadata, _ = json.Marshal(md)
+	}
+
+	return e
+}
+
+// recordEvent stores an audit event using tx so that it is committed
+// along with the change it describes.
+func (s *Service) recordEvent(tx *sqlx.Tx, e *model.AuditEvent) error {
+	return s.repo.AuditEventRepo(tx).Create(e)
+}
+
+// recordFailure stores an audit event in its own transaction.
+// Used for failed actions where the main transaction is not committed.
+func (s *Service) recordFailure(e *model.AuditEvent) {
+	repo, err := s.repo.AuditEventRepoNewTx()
+	if err != nil {
+		s.Log().Error(err, "action", e.Action)
+		return
+	}
+
+	err = repo.Create(e)
+	if err != nil {
+		repo.Tx.Rollback()
+		s.Log().Error(err, "action", e.Action)
+		return
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		s.Log().Error(err, "action", e.Action)
+	}
+}
+
+// IndexAuditEvents lists audit events, only admins can see them.
+func (s *Service) IndexAuditEvents(req tp.IndexAuditEventsReq, res *tp.IndexAuditEventsRes) error {
+	// Model
+	f := req.ToModel()
+
+	// Set envar GRN_APP_AUDIT_LIMIT to change
+	// the maximum number of returned events.
+	max := int(s.Cfg().ValAsInt("app.audit.limit", 200))
+	if f.Limit <= 0 || f.Limit > max {
+		f.Limit = max
+	}
+
+	// Repo
+	repo, err := s.repo.AuditEventRepoNewTx()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, adminErr(err, getAuditEventsErr), err)
+		return err
+	}
+
+	es, err := repo.GetAll(f)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAuditEventsErr, err)
+		return err
+	}
+
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, auditListedEvt, auditTarget, "", nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAuditEventsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, getAuditEventsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(es, okResultInfo, nil)
+	return nil
+}
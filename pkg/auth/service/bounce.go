@@ -0,0 +1,185 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/bounce"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	mailNotificationErr       = "cannot_process_mail_notification_err"
+	invalidNotificationSigErr = "invalid_mail_notification_signature_err"
+)
+
+var (
+	// ErrInvalidNotification is returned for malformed or unauthenticated
+	// mail notifications, senders should not retry them.
+	ErrInvalidNotification = errors.New("invalid mail notification")
+)
+
+// HandleSESNotification processes SES bounce and complaint notifications
+// delivered by Amazon SNS.
+func (s *Service) HandleSESNotification(req tp.MailNotificationReq, res *tp.MailNotificationRes) error {
+	m, err := bounce.ParseSNSMessage(req.Body)
+	if err != nil {
+		err = fmt.Errorf("%w: %s", ErrInvalidNotification, err.Error())
+		res.FromModel(0, mailNotificationErr, err)
+		return err
+	}
+
+	err = s.sns.Verify(m)
+	if err != nil {
+		err = fmt.Errorf("%w: %s", ErrInvalidNotification, err.Error())
+		res.FromModel(0, invalidNotificationSigErr, err)
+		return err
+	}
+
+	// Set envar GRN_MAILER_SNS_TOPIC_ARNS to a comma separated list
+	// of topics to accept notifications from, none are accepted otherwise.
+	if !s.isAllowedTopic(m.TopicArn) {
+		err = fmt.Errorf("%w: topic not allowed '%s'", ErrInvalidNotification, m.TopicArn)
+		res.FromModel(0, invalidNotificationSigErr, err)
+		return err
+	}
+
+	switch m.Type {
+	case bounce.SNSSubscriptionConfirmation:
+		// Set envar GRN_MAILER_SNS_AUTOCONFIRM to true
+		// to subscribe to topics without manual intervention.
+		if !s.Cfg().ValAsBool("mailer.sns.autoconfirm", false) {
+			s.Log().Info("SNS subscription confirmation received", "topic", m.TopicArn, "subscribe-url", m.SubscribeURL)
+			res.FromModel(0, okResultInfo, nil)
+			return nil
+		}
+
+		err = s.sns.Confirm(m)
+		if err != nil {
+			res.FromModel(0, mailNotificationErr, err)
+			return err
+		}
+
+		s.Log().Info("SNS subscription confirmed", "topic", m.TopicArn)
+		res.FromModel(0, okResultInfo, nil)
+		return nil
+
+	case bounce.SNSNotification:
+		e, err := bounce.ParseSES(m.Message)
+		if errors.Is(err, bounce.ErrNotSuppressible) {
+			res.FromModel(0, okResultInfo, nil)
+			return nil
+		}
+
+		if err != nil {
+			err = fmt.Errorf("%w: %s", ErrInvalidNotification, err.Error())
+			res.FromModel(0, mailNotificationErr, err)
+			return err
+		}
+
+		return s.suppress(req.Origin, e, res)
+
+	default:
+		res.FromModel(0, okResultInfo, nil)
+		return nil
+	}
+}
+
+// HandleMailNotification processes bounce and complaint notifications
+// in the generic format, signed with the configured webhook secret.
+func (s *Service) HandleMailNotification(req tp.MailNotificationReq, res *tp.MailNotificationRes) error {
+	// Set envar GRN_MAILER_WEBHOOK_SECRET to the secret shared
+	// with the notification sender.
+	secret := s.Cfg().ValOrDef("mailer.webhook.secret", "")
+
+	err := bounce.VerifyHMAC(secret, req.Body, req.Signature)
+	if err != nil {
+		err = fmt.Errorf("%w: %s", ErrInvalidNotification, err.Error())
+		res.FromModel(0, invalidNotificationSigErr, err)
+		return err
+	}
+
+	e, err := bounce.ParseGeneric(req.Body)
+	if err != nil {
+		err = fmt.Errorf("%w: %s", ErrInvalidNotification, err.Error())
+		res.FromModel(0, mailNotificationErr, err)
+		return err
+	}
+
+	return s.suppress(req.Origin, e, res)
+}
+
+// suppress stores the suppressions of an event and flags
+// the users using suppressed addresses.
+func (s *Service) suppress(o tp.Origin, e bounce.Event, res *tp.MailNotificationRes) error {
+	sps := e.Suppressions()
+	if len(sps) == 0 {
+		res.FromModel(0, okResultInfo, nil)
+		return nil
+	}
+
+	// Repo
+	userRepo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, cannotProcErr, err)
+		return err
+	}
+
+	suppressionRepo := s.repo.SuppressionRepo(userRepo.Tx)
+
+	for i := range sps {
+		sp := &sps[i]
+
+		err = suppressionRepo.Add(sp)
+		if err != nil {
+			userRepo.Tx.Rollback()
+			res.FromModel(0, mailNotificationErr, err)
+			return err
+		}
+
+		users, err := userRepo.MarkEmailUndeliverable(sp.Email)
+		if err != nil {
+			userRepo.Tx.Rollback()
+			res.FromModel(0, mailNotificationErr, err)
+			return err
+		}
+
+		// Audit
+		// Notifications are sent by the provider, there is no actor.
+		md := meta{"reason": sp.Reason, "kind": sp.Kind.String, "source": sp.Source.String, "users": users}
+		err = s.recordEvent(userRepo.Tx, newEvent(o, emailSuppressedEvt, emailTarget, sp.Email, md))
+		if err != nil {
+			userRepo.Tx.Rollback()
+			res.FromModel(0, mailNotificationErr, err)
+			return err
+		}
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		res.FromModel(0, mailNotificationErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(len(sps), okResultInfo, nil)
+	return nil
+}
+
+// isAllowedTopic tells if arn is one of the configured topics.
+// An empty list allows no topic.
+func (s *Service) isAllowedTopic(arn string) bool {
+	if arn == "" {
+		return false
+	}
+
+	topics := s.Cfg().ValOrDef("mailer.sns.topic.arns", "")
+	for _, t := range strings.Split(topics, ",") {
+		if strings.TrimSpace(t) == arn {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"errors"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/oauth"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	invalidPublicKeyErrMsg = "invalid_public_key_err_msg"
+)
+
+const (
+	// maxCredentialScopes is the stored length of space separated scopes.
+	maxCredentialScopes = 1024
+)
+
+type (
+	ClientCredentialValidator struct {
+		Model model.ClientCredential
+		service.Validator
+	}
+)
+
+func NewClientCredentialValidator(c model.ClientCredential) ClientCredentialValidator {
+	return ClientCredentialValidator{
+		Model:     c,
+		Validator: service.NewValidator(),
+	}
+}
+
+// ValidateForCreate checks credential kind, public key and scopes.
+// Credential lifetime is given in days, up to maxDays.
+func (cv ClientCredentialValidator) ValidateForCreate(days, maxDays int) error {
+	// Kind
+	ok0 := cv.ValidateKind()
+	// PublicKey
+	ok1 := cv.ValidatePublicKey()
+	// Scopes
+	ok2 := cv.ValidateScopes()
+	// Expiration
+	ok3 := cv.ValidateExpiration(days, maxDays)
+
+	if ok0 && ok1 && ok2 && ok3 {
+		return nil
+	}
+
+	return errors.New("client credential has errors")
+}
+
+func (cv ClientCredentialValidator) ValidateKind() (ok bool) {
+	switch cv.Model.Kind {
+	case model.CredentialKindSecret, model.CredentialKindPublicKey:
+		return true
+	}
+
+	cv.Errors.Add("Kind", notAllowedErrMsg)
+	return false
+}
+
+// ValidatePublicKey requires a usable key for private_key_jwt credentials
+// and none for the other kinds.
+func (cv ClientCredentialValidator) ValidatePublicKey() (ok bool) {
+	pem := cv.Model.PublicKey.String
+
+	if cv.Model.Kind != model.CredentialKindPublicKey {
+		if pem == "" {
+			return true
+		}
+
+		cv.Errors.Add("PublicKey", notAllowedErrMsg)
+		return false
+	}
+
+	if pem == "" {
+		cv.Errors.Add("PublicKey", requiredErrMsg)
+		return false
+	}
+
+	_, err := oauth.ParsePublicKey([]byte(pem))
+	if err != nil {
+		cv.Errors.Add("PublicKey", invalidPublicKeyErrMsg)
+		return false
+	}
+
+	return true
+}
+
+// ValidateScopes requires at least one scope, all of them
+// valid scope tokens. Their meaning is up to the services
+// receiving the access tokens.
+func (cv ClientCredentialValidator) ValidateScopes() (ok bool) {
+	scopes := cv.Model.ScopeList()
+	if len(scopes) == 0 {
+		cv.Errors.Add("Scopes", requiredErrMsg)
+		return false
+	}
+
+	for _, s := range scopes {
+		if !oauth.IsScopeToken(s) {
+			cv.Errors.Add("Scopes", notAllowedErrMsg)
+			return false
+		}
+	}
+
+	if len(cv.Model.Scopes) > maxCredentialScopes {
+		cv.Errors.Add("Scopes", maxLengthErrMsg)
+		return false
+	}
+
+	return true
+}
+
+func (cv ClientCredentialValidator) ValidateExpiration(days, maxDays int) (ok bool) {
+	if days > 0 && days <= maxDays {
+		return true
+	}
+
+	cv.Errors.Add("ExpiresInDays", invalidExpirationErrMsg)
+	return false
+}
@@ -3,89 +3,49 @@ package service
 import (
 	"fmt"
 
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 )
 
-func (s *Service) MakeConfirmationEmail(u *model.User) model.Email {
-	cfg := s.Cfg()
-
-	name := cfg.ValOrDef("mailer.agent.name", "mailer")
-	from := cfg.ValOrDef("mailer.agent.mail", "dontreply@localhost")
-	to := u.Email.String
-	subject := fmt.Sprintf("%s, please confirm your account!", u.Username.String)
-
-	site := cfg.ValOrDef("site.url", "localhost")
-	path := cfg.ValOrDef("user.confirmation.path", "users/%s/verify/%s")
-	confPath := fmt.Sprintf(path, u.Slug.String, u.ConfirmationToken.String)
-	link := fmt.Sprintf("https://%s/%s", site, confPath)
-
-	body := "<p>Hi %s, follow this link to confirm your account: <br/><br/>"
-	body = body + "<a href=\"%s\">%s</a><br/<br/>"
-	body = body + "Thanks!"
-	body = fmt.Sprintf(body, u.Username.String, link, link)
-
-	m := model.MakeEmail(name, from, to, "", "", subject, body)
-
-	s.Log().Info("User account confirmation", "mail-body", fmt.Sprintf("%+v", m))
-
-	return m
-}
-func (s *Service) makeConfirmationEmail(u *model.User) model.Email {
-	cfg := s.Cfg()
-
-	name := cfg.ValOrDef("mailer.agent.name", "mailer")
-	from := cfg.ValOrDef("mailer.agent.mail", "dontreply@localhost")
-	to := u.Email.String
-	subject := fmt.Sprintf("%s, please confirm your account!", u.Username.String)
-
-	site := cfg.ValOrDef("site.url", "localhost")
-	path := cfg.ValOrDef("user.confirmation.path", "users/%s/verify/%s")
+func (s *Service) makeConfirmationEmail(u *model.User) (model.Email, error) {
+	path := s.Cfg().ValOrDef("user.confirmation.path", "users/%s/verify/%s")
 	confPath := fmt.Sprintf(path, u.Slug.String, u.ConfirmationToken.String)
-	link := fmt.Sprintf("https://%s/%s", site, confPath)
-
-	body := "<p>Hi %s, follow this link to confirm your account: <br/><br/>"
-	body = body + "<a href=\"%s\">%s</a><br/<br/>"
-	body = body + "Thanks!"
-	body = fmt.Sprintf(body, u.Username.String, link, link)
-
-	m := model.MakeEmail(name, from, to, "", "", subject, body)
 
-	// s.Log().Info("User account confirmation", "mail-body", fmt.Sprintf("%+v", m))
+	data := mailer.MailData{
+		"Link": s.siteLink(confPath),
+	}
 
-	return m
+	return s.makeEmail(u, confirmationMail, data)
 }
 
-// NOTE: This is just to get an out of the box solution to send emails.
-// Resend management implementation is still incomplete.
-// Anyway, an option to configure an external dispatching mechanism will be implemented.
-// and also something like "Your account has not been confirmed, resend email to xxxx@yyyyyy.com?"
-// will also be developed.
-func (s *Service) sendConfirmationEmail(u *model.User) {
+// queueConfirmationEmail stores the confirmation email in the outbox
+// using tx so that it is only sent if the user is created.
+func (s *Service) queueConfirmationEmail(tx *sqlx.Tx, u *model.User) error {
 	cfg := s.Cfg()
 
 	debug := cfg.ValAsBool("user.confirmation.debug", false)
 	send := cfg.ValAsBool("user.confirmation.send", false)
 
-	var m model.Email
+	if !debug && !send {
+		s.Log().Info("User signup email confirmation send is disabled")
+		return nil
+	}
+
+	// Build mail
+	m, err := s.makeConfirmationEmail(u)
+	if err != nil {
+		return err
+	}
 
 	if debug {
-		m = s.makeConfirmationEmail(u)
-		s.Log().Debug("Confirmation email", "subject", m.Subject, "body", m.Body)
+		s.Log().Debug("Confirmation email", "subject", m.Subject, "body", m.Text)
 	}
 
 	if !send {
 		s.Log().Info("User signup email confirmation send is disabled")
-		return
+		return nil
 	}
 
-	// Build mail
-	m = s.makeConfirmationEmail(u)
-
-	// Send it
-	go func() {
-		_, err := s.mailer.Send(m)
-		if err != nil {
-			s.Log().Error(err)
-		}
-	}()
+	return s.queueEmail(tx, m, confirmationMail, u.ID.String())
 }
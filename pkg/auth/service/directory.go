@@ -0,0 +1,340 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/ldap"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/oidc"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+var (
+	// ErrInactiveUser is returned when a deactivated user tries to sign in.
+	ErrInactiveUser = errors.New("user is not active")
+	// ErrDirectoryConflict is returned when a directory entry matches the username
+	// of a local user that can not be told to be the same person.
+	ErrDirectoryConflict = errors.New("local user does not match directory entry")
+)
+
+// authenticate checks sign-in credentials.
+// If the user tenant has a directory it is consulted first. In 'before' mode
+// users not found there fall back to their local password, unless they came
+// from that directory; in 'instead' mode the directory is the only check.
+// It returns the directory that authenticated the user, nil for local passwords.
+func (s *Service) authenticate(tx *sqlx.Tx, login, password string, o tp.Origin) (model.User, *ldap.Directory, error) {
+	ur := s.repo.UserRepo(tx)
+
+	local, err := ur.GetByLogin(login)
+	if err != nil && err != sql.ErrNoRows {
+		return local, nil, err
+	}
+	found := err == nil
+
+	d, ok := s.dirs.For(local.TenantID.String)
+	if !ok {
+		u, err := ur.SignIn(login, password)
+		return u, nil, err
+	}
+
+	du, err := d.Authenticate(s.Ctx(), login, password)
+	switch {
+	case err == nil:
+		u, err := s.syncDirectoryUser(tx, d, du, o)
+		return u, d, err
+
+	case err == ldap.ErrUserNotFound && d.Mode == ldap.ModeBefore:
+		if found {
+			linked, err := s.hasIdentity(tx, &local, d.Source())
+			if err != nil {
+				return local, nil, err
+			}
+
+			// Users removed from the directory can not use a local password.
+			if linked {
+				return local, d, ldap.ErrUserNotFound
+			}
+		}
+
+		u, err := ur.SignIn(login, password)
+		return u, nil, err
+
+	default:
+		return local, d, err
+	}
+}
+
+// syncDirectoryUser returns the user for a directory entry that has just
+// authenticated, linking or provisioning it on its first sign-in,
+// and updates its attributes and roles from the directory.
+func (s *Service) syncDirectoryUser(tx *sqlx.Tx, d *ldap.Directory, du ldap.User, o tp.Origin) (model.User, error) {
+	ir := s.repo.IdentityRepo(tx)
+
+	var u model.User
+
+	i, err := ir.GetBySubject(d.Source(), du.ID)
+	switch {
+	case err == nil:
+		u, err = s.repo.UserRepo(tx).Get(i.UserID)
+		if err != nil {
+			return u, err
+		}
+
+		err = ir.Touch(i.ID.String(), du.Email)
+
+	case err == sql.ErrNoRows:
+		u, err = s.linkDirectoryUser(tx, d, du, o)
+	}
+
+	if err != nil {
+		return u, err
+	}
+
+	if u.IsActive.Valid && !u.IsActive.Bool {
+		return u, ErrInactiveUser
+	}
+
+	err = s.updateDirectoryUser(tx, d, &u, du, o)
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// linkDirectoryUser links a directory entry to the local user with the same
+// username or provisions a new one if the directory allows it.
+// In 'before' mode local users are only linked if emails also match,
+// otherwise a directory entry could take over an unrelated account.
+func (s *Service) linkDirectoryUser(tx *sqlx.Tx, d *ldap.Directory, du ldap.User, o tp.Origin) (model.User, error) {
+	id := oidc.Identity{
+		Provider:      d.Source(),
+		Subject:       du.ID,
+		Email:         du.Email,
+		EmailVerified: true,
+		Username:      du.Username,
+		GivenName:     du.GivenName,
+		FamilyName:    du.FamilyName,
+	}
+
+	u, err := s.repo.UserRepo(tx).GetByLogin(du.Username)
+	switch {
+	case err == nil:
+		if d.Mode != ldap.ModeInstead && !strings.EqualFold(u.Email.String, du.Email) {
+			return u, ErrDirectoryConflict
+		}
+
+		return u, s.linkIdentity(tx, &u, id, false, o)
+
+	case err == sql.ErrNoRows:
+		if !d.Provision {
+			return u, ErrNoAccount
+		}
+
+		return s.provisionUser(tx, id, o)
+
+	default:
+		return u, err
+	}
+}
+
+// updateDirectoryUser copies mapped attributes and roles from the directory entry.
+// Empty directory attributes do not clear local values.
+func (s *Service) updateDirectoryUser(tx *sqlx.Tx, d *ldap.Directory, u *model.User, du ldap.User, o tp.Origin) error {
+	if du.GivenName != "" {
+		u.GivenName = db.ToNullString(du.GivenName)
+	}
+
+	if du.FamilyName != "" {
+		u.FamilyName = db.ToNullString(du.FamilyName)
+	}
+
+	if du.Email != "" {
+		u.Email = db.ToNullString(du.Email)
+	}
+
+	md := meta{"directory": d.Name}
+
+	err := s.repo.UserRepo(tx).Update(u)
+	switch {
+	case err == nil:
+		err = s.recordEvent(tx, newEvent(o, userDirectorySyncedEvt, userTarget, u.Slug.String, md))
+		if err != nil {
+			return err
+		}
+
+	case !isNoChanges(err):
+		return err
+	}
+
+	changed, err := s.repo.RoleRepo(tx).ReplaceFromSource(u.ID.String(), d.Source(), du.Roles)
+	if err != nil || !changed {
+		return err
+	}
+
+	md = meta{"directory": d.Name, "roles": du.Roles}
+
+	return s.recordEvent(tx, newEvent(o, userRolesChangedEvt, userTarget, u.Slug.String, md))
+}
+
+// hasIdentity returns true if u signs in through provider.
+func (s *Service) hasIdentity(tx *sqlx.Tx, u *model.User, provider string) (bool, error) {
+	is, err := s.repo.IdentityRepo(tx).GetByUser(u.ID.String())
+	if err != nil {
+		return false, err
+	}
+
+	for _, i := range is {
+		if i.Provider == provider {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// federatedIdentities filters out directory identities.
+func federatedIdentities(is []model.Identity) []model.Identity {
+	fis := []model.Identity{}
+	for _, i := range is {
+		if !ldap.IsSource(i.Provider) {
+			fis = append(fis, i)
+		}
+	}
+	return fis
+}
+
+// HasDirectories returns true if any directory is configured.
+func (s *Service) HasDirectories() bool {
+	return len(s.dirs.List()) > 0
+}
+
+// SyncDirectories refreshes the users linked to directory entries.
+// Attributes and roles are updated from the directory, users whose entries
+// are gone are deactivated, signed out and lose their directory roles.
+// Users whose entries are back are activated again.
+// It returns the number of users synced and deactivated.
+func (s *Service) SyncDirectories() (synced, deactivated int, err error) {
+	for _, d := range s.dirs.List() {
+		n, m, err := s.syncDirectory(d)
+		synced += n
+		deactivated += m
+
+		if err != nil {
+			return synced, deactivated, fmt.Errorf("directory '%s': %w", d.Name, err)
+		}
+	}
+
+	return synced, deactivated, nil
+}
+
+func (s *Service) syncDirectory(d *ldap.Directory) (synced, deactivated int, err error) {
+	dus, err := d.Users(s.Ctx())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	entries := make(map[string]ldap.User, len(dus))
+	for _, du := range dus {
+		entries[du.ID] = du
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	is, err := s.repo.IdentityRepo(repo.Tx).GetByProvider(d.Source())
+	if err != nil {
+		repo.Tx.Rollback()
+		return 0, 0, err
+	}
+
+	// System initiated, there is no actor.
+	o := tp.Origin{}
+	md := meta{"directory": d.Name}
+
+	for _, i := range is {
+		u, err := repo.Get(i.UserID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			repo.Tx.Rollback()
+			return 0, 0, err
+		}
+
+		id := u.ID.String()
+		inactive := u.IsActive.Valid && !u.IsActive.Bool
+
+		du, ok := entries[i.Subject]
+		switch {
+		case ok:
+			if inactive {
+				err = repo.SetActive(id, true)
+				if err != nil {
+					repo.Tx.Rollback()
+					return 0, 0, err
+				}
+
+				err = s.recordEvent(repo.Tx, newEvent(o, userActivatedEvt, userTarget, u.Slug.String, md))
+				if err != nil {
+					repo.Tx.Rollback()
+					return 0, 0, err
+				}
+			}
+
+			err = s.updateDirectoryUser(repo.Tx, d, &u, du, o)
+			if err != nil {
+				repo.Tx.Rollback()
+				return 0, 0, err
+			}
+
+			synced++
+
+		case !inactive:
+			err = s.deactivateDirectoryUser(repo.Tx, d, &u, o)
+			if err != nil {
+				repo.Tx.Rollback()
+				return 0, 0, err
+			}
+
+			deactivated++
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return synced, deactivated, nil
+}
+
+// deactivateDirectoryUser disables a user no longer in the directory.
+func (s *Service) deactivateDirectoryUser(tx *sqlx.Tx, d *ldap.Directory, u *model.User, o tp.Origin) error {
+	id := u.ID.String()
+
+	err := s.repo.UserRepo(tx).SetActive(id, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.RoleRepo(tx).ReplaceFromSource(id, d.Source(), nil)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.repo.SessionRepo(tx).RevokeAll(id)
+	if err != nil {
+		return err
+	}
+
+	return s.recordEvent(tx, newEvent(o, userDeactivatedEvt, userTarget, u.Slug.String, meta{"directory": d.Name, "sessions": count}))
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	emailChangeRequestedInfo = "email_change_requested_info"
+	emailChangedInfo         = "email_changed_info"
+	emailChangeCancelledInfo = "email_change_cancelled_info"
+	confirmEmailChangeErr    = "cannot_confirm_email_change_err"
+	cancelEmailChangeErr     = "cannot_cancel_email_change_err"
+	// Hours the new address has to confirm the change.
+	defaultEmailChangeTTLHours = 24
+	// Hours the old address can cancel the change.
+	defaultEmailChangeCancelWindowHours = 72
+)
+
+// requestEmailChange starts the change of user email to newEmail.
+// Current address is kept until the new one is confirmed,
+// any previous pending request is discarded.
+func (s *Service) requestEmailChange(tx *sqlx.Tx, u *model.User, newEmail string) error {
+	ttl, window := s.emailChangeHours()
+
+	ecr := s.repo.EmailChangeRepo(tx)
+
+	_, err := ecr.CancelPending(u.ID.String())
+	if err != nil {
+		return err
+	}
+
+	ec := model.EmailChange{
+		UserID:   u.ID.String(),
+		OldEmail: u.Email.String,
+		NewEmail: newEmail,
+	}
+
+	ec.SetCreateValues(time.Duration(ttl)*time.Hour, time.Duration(window)*time.Hour)
+
+	confirm, cancel, err := ec.GenTokens()
+	if err != nil {
+		return err
+	}
+
+	err = ecr.Create(&ec)
+	if err != nil {
+		return err
+	}
+
+	return s.queueEmailChangeEmails(tx, u, &ec, confirm, cancel)
+}
+
+// ConfirmEmailChange sets the new email address of a user
+// using the token sent to it.
+func (s *Service) ConfirmEmailChange(req tp.ConfirmEmailChangeReq, res *tp.ConfirmEmailChangeRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel("", cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", confirmEmailChangeErr, err)
+		return err
+	}
+
+	ec, err := s.repo.EmailChangeRepo(repo.Tx).Confirm(u.ID.String(), model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, userEmailChangeFailedEvt, userTarget, u.Slug.String, meta{"step": "confirm"}))
+		res.FromModel("", confirmEmailChangeErr, err)
+		return err
+	}
+
+	err = repo.UpdateEmail(u.ID.String(), ec.NewEmail)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", confirmEmailChangeErr, err)
+		return err
+	}
+
+	// Audit
+	o := req.Origin
+	o.ActorID = u.ID.String()
+
+	err = s.recordEvent(repo.Tx, newEvent(o, userEmailChangedEvt, userTarget, u.Slug.String, meta{"from": ec.OldEmail, "to": ec.NewEmail}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", confirmEmailChangeErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel("", confirmEmailChangeErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(ec.NewEmail, emailChangedInfo, nil)
+	return nil
+}
+
+// CancelEmailChange discards an email change using the token sent to the old address.
+// If the change was already confirmed the old address is restored
+// and all user sessions are closed.
+func (s *Service) CancelEmailChange(req tp.CancelEmailChangeReq, res *tp.CancelEmailChangeRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel("", false, cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", false, cancelEmailChangeErr, err)
+		return err
+	}
+
+	ec, err := s.repo.EmailChangeRepo(repo.Tx).Cancel(u.ID.String(), model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, userEmailChangeFailedEvt, userTarget, u.Slug.String, meta{"step": "cancel"}))
+		res.FromModel("", false, cancelEmailChangeErr, err)
+		return err
+	}
+
+	email := u.Email.String
+	reverted := ec.IsConfirmed()
+
+	if reverted {
+		email = ec.OldEmail
+
+		err = repo.UpdateEmail(u.ID.String(), email)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel("", false, cancelEmailChangeErr, err)
+			return err
+		}
+
+		// Whoever confirmed the change may be signed in.
+		_, err = s.repo.SessionRepo(repo.Tx).RevokeAll(u.ID.String())
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel("", false, cancelEmailChangeErr, err)
+			return err
+		}
+	}
+
+	// Audit
+	o := req.Origin
+	o.ActorID = u.ID.String()
+
+	err = s.recordEvent(repo.Tx, newEvent(o, userEmailChangeCancelledEvt, userTarget, u.Slug.String, meta{"email": ec.NewEmail, "reverted": reverted}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", false, cancelEmailChangeErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel("", false, cancelEmailChangeErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(email, reverted, emailChangeCancelledInfo, nil)
+	return nil
+}
+
+func (s *Service) makeEmailChangeEmails(u *model.User, ec *model.EmailChange, confirm, cancel string) (confirmation, notice model.Email, err error) {
+	cfg := s.Cfg()
+	ttl, window := s.emailChangeHours()
+
+	path := cfg.ValOrDef("user.email.change.confirm.path", "users/%s/%s/confirm-email")
+	confPath := fmt.Sprintf(path, u.Slug.String, confirm)
+
+	path = cfg.ValOrDef("user.email.change.cancel.path", "users/%s/%s/cancel-email-change")
+	cancelPath := fmt.Sprintf(path, u.Slug.String, cancel)
+
+	// Confirmation is sent to the new address.
+	to := *u
+	to.Email = db.ToNullString(ec.NewEmail)
+
+	confirmation, err = s.makeEmail(&to, emailChangeConfirmMail, mailer.MailData{
+		"NewEmail": ec.NewEmail,
+		"Hours":    ttl,
+		"Link":     s.siteLink(confPath),
+	})
+	if err != nil {
+		return confirmation, notice, err
+	}
+
+	notice, err = s.makeEmail(u, emailChangeNoticeMail, mailer.MailData{
+		"NewEmail": ec.NewEmail,
+		"Hours":    window,
+		"Link":     s.siteLink(cancelPath),
+	})
+
+	return confirmation, notice, err
+}
+
+// queueEmailChangeEmails stores in the outbox the confirmation email
+// for the new address and the notice, with a cancel link, for the old one.
+func (s *Service) queueEmailChangeEmails(tx *sqlx.Tx, u *model.User, ec *model.EmailChange, confirm, cancel string) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("user.email.change.debug", false)
+	send := cfg.ValAsBool("user.email.change.send", false)
+
+	if !debug && !send {
+		s.Log().Info("User email change send is disabled")
+		return nil
+	}
+
+	cm, nm, err := s.makeEmailChangeEmails(u, ec, confirm, cancel)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Email change confirmation email", "subject", cm.Subject, "body", cm.Text)
+		s.Log().Debug("Email change notice email", "subject", nm.Subject, "body", nm.Text)
+	}
+
+	if !send {
+		s.Log().Info("User email change send is disabled")
+		return nil
+	}
+
+	err = s.queueEmail(tx, cm, emailChangeConfirmMail, u.ID.String())
+	if err != nil {
+		return err
+	}
+
+	return s.queueEmail(tx, nm, emailChangeNoticeMail, u.ID.String())
+}
+
+// emailChangeHours returns how long, in hours, the new address has
+// to confirm a change and the old one to cancel it.
+func (s *Service) emailChangeHours() (ttl, window int) {
+	cfg := s.Cfg()
+	ttl = int(cfg.ValAsInt("app.email.change.ttl.hours", defaultEmailChangeTTLHours))
+	window = int(cfg.ValAsInt("app.email.change.cancel.window.hours", defaultEmailChangeCancelWindowHours))
+	return ttl, window
+}
@@ -0,0 +1,295 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	confirmEmailChangeErr = "cannot_confirm_email_change_err"
+	cancelEmailChangeErr  = "cannot_cancel_email_change_err"
+)
+
+// TestConfirmEmailChange tests that the new address is only set
+// with the token sent to it.
+func TestConfirmEmailChange(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("ecconfirm")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	newEmail := "ecconfirm.new@mail.com"
+
+	confirm, _, err := createEmailChange(r, user, newEmail)
+	if err != nil {
+		t.Fatalf("error creating email change: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Invalid token
+	req := tp.ConfirmEmailChangeReq{
+		Identifier: tp.Identifier{
+			Slug:  user.Slug.String,
+			Token: confirm + "x",
+		},
+	}
+
+	var ires tp.ConfirmEmailChangeRes
+
+	err = s.ConfirmEmailChange(req, &ires)
+	if err == nil {
+		t.Error("email change should not be confirmed with an invalid token")
+	}
+
+	if ires.MsgID != confirmEmailChangeErr {
+		t.Errorf("Response message: %s", ires.MsgID)
+	}
+
+	// Test
+	req.Token = confirm
+	var res tp.ConfirmEmailChangeRes
+
+	err = s.ConfirmEmailChange(req, &res)
+	if err != nil {
+		t.Errorf("confirm email change error: %s", err.Error())
+	}
+
+	// Verify
+	if res.Email != newEmail {
+		t.Errorf("expecting email %s got %s", newEmail, res.Email)
+	}
+
+	vUser, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if vUser.Email.String != newEmail {
+		t.Errorf("expecting stored email %s got %s", newEmail, vUser.Email.String)
+	}
+
+	// Tokens are single use
+	var ures tp.ConfirmEmailChangeRes
+
+	err = s.ConfirmEmailChange(req, &ures)
+	if err == nil {
+		t.Error("used token should not confirm the change again")
+	}
+}
+
+// TestCancelEmailChange tests that the old address can revert
+// a confirmed change and that it signs out the user.
+func TestCancelEmailChange(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("eccancel")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	confirm, cancel, err := createEmailChange(r, user, "eccancel.new@mail.com")
+	if err != nil {
+		t.Fatalf("error creating email change: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	creq := tp.ConfirmEmailChangeReq{
+		Identifier: tp.Identifier{
+			Slug:  user.Slug.String,
+			Token: confirm,
+		},
+	}
+
+	var cres tp.ConfirmEmailChangeRes
+
+	err = s.ConfirmEmailChange(creq, &cres)
+	if err != nil {
+		t.Fatalf("confirm email change error: %s", err.Error())
+	}
+
+	token, _, err := openTestSession(s, user)
+	if err != nil {
+		t.Fatalf("cannot open session: %s", err.Error())
+	}
+
+	// Invalid token
+	req := tp.CancelEmailChangeReq{
+		Identifier: tp.Identifier{
+			Slug:  user.Slug.String,
+			Token: confirm,
+		},
+	}
+
+	var ires tp.CancelEmailChangeRes
+
+	err = s.CancelEmailChange(req, &ires)
+	if err == nil {
+		t.Error("email change should not be cancelled with an invalid token")
+	}
+
+	if ires.MsgID != cancelEmailChangeErr {
+		t.Errorf("Response message: %s", ires.MsgID)
+	}
+
+	// Test
+	req.Token = cancel
+	var res tp.CancelEmailChangeRes
+
+	err = s.CancelEmailChange(req, &res)
+	if err != nil {
+		t.Errorf("cancel email change error: %s", err.Error())
+	}
+
+	// Verify
+	if !res.Reverted {
+		t.Error("confirmed change should be reverted")
+	}
+
+	vUser, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if vUser.Email.String != user.Email.String {
+		t.Errorf("expecting old email %s got %s", user.Email.String, vUser.Email.String)
+	}
+
+	var vres tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token}, &vres)
+	if err == nil {
+		t.Error("sessions should be closed once the change is reverted")
+	}
+}
+
+// TestUpdateUserEmailOfOtherUser tests that users cannot
+// change the address of someone else.
+func TestUpdateUserEmailOfOtherUser(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("ecowner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	other, err := createNamedUser("ecother")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	// Setup
+	email := "ecother.takeover@mail.com"
+	req := tp.UpdateUserReq{
+		Identifier: tp.Identifier{
+			Slug: user.Slug.String,
+		},
+		User: tp.User{
+			Username:          user.Username.String,
+			Email:             email,
+			EmailConfirmation: email,
+			GivenName:         "given",
+			FamilyName:        "family",
+		},
+		Origin: tp.Origin{
+			ActorID: other.ID.String(),
+		},
+	}
+
+	var res tp.UpdateUserRes
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	err = s.UpdateUser(req, &res)
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got: %v", err)
+	}
+
+	// Verify
+	if res.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if res.PendingEmail != "" {
+		t.Error("no email change should be requested")
+	}
+
+	vUser, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if vUser.GivenName.String == "given" {
+		t.Error("user should not be updated")
+	}
+}
+
+// createEmailChange stores a pending email change for the user
+// and returns its confirmation and cancellation tokens.
+func createEmailChange(r *repo.Repo, user *model.User, newEmail string) (confirm, cancel string, err error) {
+	ecr, err := r.EmailChangeRepoNewTx()
+	if err != nil {
+		return "", "", err
+	}
+
+	ec := model.EmailChange{
+		UserID:   user.ID.String(),
+		OldEmail: user.Email.String,
+		NewEmail: newEmail,
+	}
+
+	ec.SetCreateValues(time.Hour, 72*time.Hour)
+
+	confirm, cancel, err = ec.GenTokens()
+	if err != nil {
+		ecr.Tx.Rollback()
+		return "", "", err
+	}
+
+	err = ecr.Create(&ec)
+	if err != nil {
+		ecr.Tx.Rollback()
+		return "", "", err
+	}
+
+	return confirm, cancel, ecr.Commit()
+}
@@ -0,0 +1,562 @@
+package service
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/ldap"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/oidc"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	identityLinkedInfo     = "identity_linked_info"
+	identityUnlinkedInfo   = "identity_unlinked_info"
+	unknownProviderErr     = "unknown_identity_provider_err"
+	federatedSignInErr     = "cannot_sign_in_with_provider_err"
+	federatedEmailTakenErr = "provider_email_taken_err"
+	federatedNoAccountErr  = "provider_no_account_err"
+	identityTakenErr       = "identity_linked_to_other_user_err"
+	unlinkIdentityErr      = "cannot_unlink_identity_err"
+	lastIdentityErr        = "cannot_unlink_last_identity_err"
+)
+
+const (
+	// Provisioned usernames length limits, same as sign up ones.
+	minProvisionedUsername = 4
+	maxProvisionedUsername = 16
+	// Attempts to find a free username before giving up.
+	provisionUsernameTries = 10
+)
+
+var (
+	// ErrUnknownProvider is returned for providers not configured.
+	ErrUnknownProvider = errors.New("unknown identity provider")
+	// ErrStateMismatch is returned when the state a provider redirects back with
+	// is not the one sent along with the authorization request.
+	ErrStateMismatch = errors.New("state mismatch")
+	// ErrIdentityTaken is returned when linking an identity that belongs to other user.
+	ErrIdentityTaken = errors.New("identity linked to other user")
+	// ErrEmailTaken is returned when a provider identity email belongs to an existing
+	// user that has not linked it, it has to sign in and link the provider first.
+	ErrEmailTaken = errors.New("email belongs to an existing user")
+	// ErrNoAccount is returned when there is no user for an identity
+	// and the provider does not provision them.
+	ErrNoAccount = errors.New("no user for identity")
+	// ErrLastIdentity is returned when unlinking the only way a user has to sign in.
+	ErrLastIdentity = errors.New("cannot unlink the last sign-in method")
+)
+
+var (
+	usernameInvalidChars = regexp.MustCompile(`[^a-z0-9_.-]+`)
+)
+
+// IdentityProviders returns the external providers users can sign in with.
+func (s *Service) IdentityProviders() tp.IdentityProviders {
+	ips := tp.IdentityProviders{}
+	for _, c := range s.idps.List() {
+		ips = append(ips, tp.IdentityProvider{Name: c.Name, Label: c.Label})
+	}
+	for _, sp := range s.samlSPs.List() {
+		ips = append(ips, tp.IdentityProvider{Name: sp.Source(), Label: sp.Label})
+	}
+	return ips
+}
+
+// FederatedAuthURL returns the provider URL the user has to be sent to.
+// State, nonce and PKCE verifier are generated here and have to be kept by the
+// caller, bound to the user agent, until the provider redirects back.
+func (s *Service) FederatedAuthURL(req tp.FederatedAuthReq, res *tp.FederatedAuthRes) error {
+	c, ok := s.idps.Get(req.Provider)
+	if !ok {
+		res.FromModel("", "", "", "", unknownProviderErr, ErrUnknownProvider)
+		return ErrUnknownProvider
+	}
+
+	if req.Link && req.Origin.ActorID == "" {
+		res.FromModel("", "", "", "", forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	ar, err := oidc.NewAuthRequest()
+	if err != nil {
+		res.FromModel("", "", "", "", federatedSignInErr, err)
+		return err
+	}
+
+	url, err := c.AuthCodeURL(s.Ctx(), s.federatedRedirectURL(c.Name), ar)
+	if err != nil {
+		res.FromModel("", "", "", "", federatedSignInErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(url, ar.State, ar.Nonce, ar.Verifier, okResultInfo, nil)
+	return nil
+}
+
+// FederatedSignIn completes the authorization code flow
+// and signs in with the provider identity.
+func (s *Service) FederatedSignIn(req tp.FederatedSignInReq, res *tp.FederatedSignInRes) error {
+	c, ok := s.idps.Get(req.Provider)
+	if !ok {
+		res.FromModel(nil, unknownProviderErr, ErrUnknownProvider)
+		return ErrUnknownProvider
+	}
+
+	md := meta{"provider": c.Name}
+
+	if req.State == "" || subtle.ConstantTimeCompare([]byte(req.State), []byte(req.ExpectedState)) != 1 {
+		s.recordFailure(newEvent(req.Origin, userFederatedSignInFailedEvt, userTarget, "", md))
+		res.FromModel(nil, federatedSignInErr, ErrStateMismatch)
+		return ErrStateMismatch
+	}
+
+	if req.Link && req.Origin.ActorID == "" {
+		res.FromModel(nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	tok, err := c.Exchange(s.Ctx(), s.federatedRedirectURL(c.Name), req.Code, req.Verifier)
+	if err != nil {
+		s.recordFailure(newEvent(req.Origin, userFederatedSignInFailedEvt, userTarget, "", md))
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	id, err := c.Identity(s.Ctx(), tok, req.Nonce)
+	if err != nil {
+		s.recordFailure(newEvent(req.Origin, userFederatedSignInFailedEvt, userTarget, "", md))
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	opts := identitySignIn{
+		method:      "oidc",
+		link:        req.Link,
+		linkByEmail: s.federatedLinkByEmail(c.Name),
+		provision:   s.federatedProvision(c.Name),
+	}
+
+	return s.signInIdentity(id, opts, req.Origin, res)
+}
+
+// identitySignIn options of an external identity sign-in.
+type identitySignIn struct {
+	// method recorded in the audit event, 'oidc' or 'saml'.
+	method      string
+	link        bool
+	linkByEmail bool
+	provision   bool
+}
+
+// signInIdentity completes a sign-in with an identity already verified by
+// its provider. It is looked up among the linked ones, if not found it is
+// linked to the signed in user when linking, to the user owning its email
+// if the provider is trusted to do so, or a new user is provisioned.
+// A session is opened except when linking.
+func (s *Service) signInIdentity(id oidc.Identity, opts identitySignIn, o tp.Origin, res *tp.FederatedSignInRes) error {
+	md := meta{"provider": id.Provider}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	ir := s.repo.IdentityRepo(repo.Tx)
+
+	var u model.User
+
+	i, err := ir.GetBySubject(id.Provider, id.Subject)
+	switch {
+	case err == nil:
+		if opts.link && i.UserID != o.ActorID {
+			repo.Tx.Rollback()
+			res.FromModel(nil, identityTakenErr, ErrIdentityTaken)
+			return ErrIdentityTaken
+		}
+
+		if opts.link {
+			repo.Tx.Rollback()
+			res.FromModel(nil, identityLinkedInfo, nil)
+			return nil
+		}
+
+		err = ir.Touch(i.ID.String(), id.Email)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, federatedSignInErr, err)
+			return err
+		}
+
+		u, err = repo.Get(i.UserID)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, federatedSignInErr, err)
+			return err
+		}
+
+	case err == sql.ErrNoRows:
+		if opts.link {
+			u, err = repo.Get(o.ActorID)
+			if err != nil {
+				repo.Tx.Rollback()
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+
+			err = s.linkIdentity(repo.Tx, &u, id, false, o)
+			if err != nil {
+				repo.Tx.Rollback()
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+
+			err = repo.Commit()
+			if err != nil {
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+
+			res.FromModel(&u, identityLinkedInfo, nil)
+			res.Linked = true
+			return nil
+		}
+
+		var found bool
+		if id.Email != "" {
+			u, err = repo.GetByEmail(id.Email)
+			if err != nil && err != sql.ErrNoRows {
+				repo.Tx.Rollback()
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+			found = err == nil
+		}
+
+		switch {
+		case found && id.EmailVerified && opts.linkByEmail:
+			err = s.linkIdentity(repo.Tx, &u, id, false, o)
+			if err != nil {
+				repo.Tx.Rollback()
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+			res.Linked = true
+
+		case found:
+			repo.Tx.Rollback()
+			s.recordFailure(newEvent(o, userFederatedSignInFailedEvt, userTarget, u.Slug.String, md))
+			res.FromModel(nil, federatedEmailTakenErr, ErrEmailTaken)
+			return ErrEmailTaken
+
+		case opts.provision:
+			u, err = s.provisionUser(repo.Tx, id, o)
+			if err != nil {
+				repo.Tx.Rollback()
+				res.FromModel(nil, federatedSignInErr, err)
+				return err
+			}
+			res.Provisioned = true
+
+		default:
+			repo.Tx.Rollback()
+			res.FromModel(nil, federatedNoAccountErr, ErrNoAccount)
+			return ErrNoAccount
+		}
+
+	default:
+		repo.Tx.Rollback()
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	// Session
+	session, token, verificationToken, ra, err := s.openSession(repo.Tx, &u, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	o.ActorID = u.ID.String()
+	o.SessionID = session.ID.String()
+
+	// Audit
+	evt := userSignedInEvt
+	if session.IsPending() {
+		evt = sessionChallengedEvt
+	}
+
+	md = meta{"session": o.SessionID, "method": opts.method, "provider": id.Provider, "risk": ra.Score, "reasons": ra.Reasons}
+
+	err = s.recordEvent(repo.Tx, newEvent(o, evt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	// Mail alert
+	if session.IsPending() {
+		err = s.queueSignInAlertEmail(repo.Tx, &u, session, verificationToken)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, federatedSignInErr, err)
+			return err
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	// Output
+	linked, provisioned := res.Linked, res.Provisioned
+	res.FromModel(&u, okResultInfo, nil)
+	res.SessionToken = token
+	res.VerificationRequired = session.IsPending()
+	res.Linked = linked
+	res.Provisioned = provisioned
+	return nil
+}
+
+// UnlinkIdentity removes a provider from the user sign-in methods.
+// Users without a password can not unlink their last provider.
+func (s *Service) UnlinkIdentity(req tp.UnlinkIdentityReq, res *tp.UnlinkIdentityRes) error {
+	// Directory links follow directory membership.
+	if ldap.IsSource(req.Provider) {
+		res.FromModel(forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(unlinkIdentityErr, err)
+		return err
+	}
+
+	if !isSelf(req.Origin, &u) {
+		repo.Tx.Rollback()
+		res.FromModel(forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	ir := s.repo.IdentityRepo(repo.Tx)
+
+	is, err := ir.GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(unlinkIdentityErr, err)
+		return err
+	}
+
+	if len(is) <= 1 && u.PasswordDigest.String == "" {
+		repo.Tx.Rollback()
+		res.FromModel(lastIdentityErr, ErrLastIdentity)
+		return ErrLastIdentity
+	}
+
+	err = ir.Delete(u.ID.String(), req.Provider)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(unlinkIdentityErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userIdentityUnlinkedEvt, userTarget, u.Slug.String, meta{"provider": req.Provider}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(unlinkIdentityErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(unlinkIdentityErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(identityUnlinkedInfo, nil)
+	return nil
+}
+
+// linkIdentity stores the provider identity as a sign-in method of u.
+func (s *Service) linkIdentity(tx *sqlx.Tx, u *model.User, id oidc.Identity, provisioned bool, o tp.Origin) error {
+	i := model.Identity{
+		UserID:      u.ID.String(),
+		Provider:    id.Provider,
+		Subject:     id.Subject,
+		Email:       db.ToNullString(id.Email),
+		Provisioned: provisioned,
+	}
+	i.SetCreateValues()
+
+	err := s.repo.IdentityRepo(tx).Create(&i)
+	if err != nil {
+		return err
+	}
+
+	if provisioned {
+		return nil
+	}
+
+	if o.ActorID == "" {
+		o.ActorID = u.ID.String()
+	}
+
+	return s.recordEvent(tx, newEvent(o, userIdentityLinkedEvt, userTarget, u.Slug.String, meta{"provider": id.Provider}))
+}
+
+// provisionUser creates a user for a provider identity on its first sign-in.
+// It follows sign up rules except that there is no password, users can set
+// one later through a password reset or keep signing in with the provider.
+// Email is considered confirmed if the provider says it has verified it,
+// otherwise the usual confirmation email is sent.
+func (s *Service) provisionUser(tx *sqlx.Tx, id oidc.Identity, o tp.Origin) (model.User, error) {
+	ur := s.repo.UserRepo(tx)
+
+	username, err := s.provisionUsername(tx, id.Username, id.Email)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	u := model.User{
+		Username:          db.ToNullString(username),
+		Email:             db.ToNullString(id.Email),
+		EmailConfirmation: db.ToNullString(id.Email),
+		GivenName:         db.ToNullString(id.GivenName),
+		FamilyName:        db.ToNullString(id.FamilyName),
+	}
+
+	// Validation
+	v := s.newUserValidator(u, nil)
+
+	err = v.ValidateForProvision()
+	if err != nil {
+		return u, err
+	}
+
+	if id.EmailVerified {
+		u.GenAutoConfirmationToken()
+	} else {
+		u.GenConfirmationToken()
+	}
+
+	// Audit columns
+	// Provisioned users are their own creators.
+	u.GenID()
+	u.CreatedByID = db.ToNullString(u.ID.String())
+	o.ActorID = u.ID.String()
+
+	err = ur.Create(&u)
+	if err != nil {
+		return u, err
+	}
+
+	err = s.linkIdentity(tx, &u, id, true, o)
+	if err != nil {
+		return u, err
+	}
+
+	// Mail confirmation
+	if !id.EmailVerified {
+		err = s.queueConfirmationEmail(tx, &u)
+		if err != nil {
+			return u, err
+		}
+	}
+
+	// Audit
+	err = s.recordEvent(tx, newEvent(o, userSignedUpEvt, userTarget, u.Slug.String, meta{"provider": id.Provider}))
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// provisionUsername derives a free username from the external
+// username or, if missing, from the email local part.
+func (s *Service) provisionUsername(tx *sqlx.Tx, username, email string) (string, error) {
+	base := username
+	if base == "" {
+		base = strings.SplitN(email, "@", 2)[0]
+	}
+
+	base = usernameInvalidChars.ReplaceAllString(strings.ToLower(base), "")
+	if len(base) > maxProvisionedUsername {
+		base = base[:maxProvisionedUsername]
+	}
+	for len(base) < minProvisionedUsername {
+		base += "0"
+	}
+
+	ur := s.repo.UserRepo(tx)
+
+	username = base
+	for n := 1; n <= provisionUsernameTries; n++ {
+		_, err := ur.GetByUsername(username)
+		if err == sql.ErrNoRows {
+			return username, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		sfx := fmt.Sprintf("%d", n)
+		if len(base)+len(sfx) > maxProvisionedUsername {
+			username = base[:maxProvisionedUsername-len(sfx)] + sfx
+		} else {
+			username = base + sfx
+		}
+	}
+
+	return "", fmt.Errorf("no free username for '%s'", base)
+}
+
+// federatedRedirectURL returns the callback URL registered with the provider.
+// Envar GRN_APP_OIDC_<NAME>_REDIRECT_URL overrides the default one.
+func (s *Service) federatedRedirectURL(name string) string {
+	url := s.Cfg().ValOrDef(fmt.Sprintf("app.oidc.%s.redirect.url", name), "")
+	if url != "" {
+		return url
+	}
+	return s.siteLink(fmt.Sprintf("users/oidc/%s/callback", name))
+}
+
+// federatedLinkByEmail returns true if identities with a verified email
+// can be linked to the existing user owning it on their first sign-in.
+// Only enable it for providers trusted to verify emails,
+// set envar GRN_APP_OIDC_<NAME>_LINK_BY_EMAIL=true.
+func (s *Service) federatedLinkByEmail(name string) bool {
+	return s.Cfg().ValAsBool(fmt.Sprintf("app.oidc.%s.link.by.email", name), false)
+}
+
+// federatedProvision returns true if users signing in with the provider
+// for the first time are created on the fly.
+// Set envar GRN_APP_OIDC_<NAME>_PROVISION=false to disable it.
+func (s *Service) federatedProvision(name string) bool {
+	return s.Cfg().ValAsBool(fmt.Sprintf("app.oidc.%s.provision", name), true)
+}
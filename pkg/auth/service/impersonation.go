@@ -0,0 +1,223 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	impersonationStartedInfo       = "impersonation_started_info"
+	impersonationStoppedInfo       = "impersonation_stopped_info"
+	impersonateErr                 = "cannot_impersonate_err"
+	stopImpersonationErr           = "cannot_stop_impersonation_err"
+	impersonatingErr               = "not_allowed_while_impersonating_err"
+	defaultImpersonationTTLMinutes = 60
+)
+
+var (
+	// ErrImpersonating is returned for sensitive actions
+	// attempted by an admin impersonating a user.
+	ErrImpersonating = errors.New("not allowed while impersonating")
+)
+
+// ImpersonateUser opens a session as a user for the requesting admin.
+// The session remembers the admin: every action taken with it is audited
+// on their behalf and sensitive ones are refused.
+// Admins and service accounts cannot be impersonated,
+// impersonations cannot be nested.
+func (s *Service) ImpersonateUser(req tp.ImpersonateUserReq, res *tp.ImpersonateUserRes) error {
+	o := req.Origin
+
+	if isImpersonating(o) {
+		res.FromModel(nil, "", impersonatingErr, ErrImpersonating)
+		return ErrImpersonating
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, "", cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", getUserErr, err)
+		return err
+	}
+
+	err = s.canImpersonate(repo.Tx, o, &u)
+	if err != nil {
+		repo.Tx.Rollback()
+		if err == ErrForbidden {
+			s.recordFailure(newEvent(o, userImpersonationDeniedEvt, userTarget, u.Slug.String, nil))
+			res.FromModel(nil, "", forbiddenErr, err)
+			return err
+		}
+		res.FromModel(nil, "", impersonateErr, err)
+		return err
+	}
+
+	// Set envar GRN_APP_IMPERSONATION_TTL_MINUTES to change
+	// how long an admin can act as the user.
+	ttl := time.Duration(s.Cfg().ValAsInt("app.impersonation.ttl.minutes", defaultImpersonationTTLMinutes)) * time.Minute
+
+	// Not a sign-in of the user: no risk assessment
+	// and the user sign-in location is kept.
+	session := &model.Session{
+		UserID:         db.ToNullString(u.ID.String()),
+		ImpersonatorID: db.ToNullString(o.ActorID),
+		IP:             db.ToNullString(o.IP),
+		UserAgent:      db.ToNullString(o.UserAgent),
+	}
+
+	token, err := session.GenToken()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", impersonateErr, err)
+		return err
+	}
+
+	session.SetCreateValues(ttl)
+
+	err = s.repo.SessionRepo(repo.Tx).Create(session)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", impersonateErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"session": session.ID.String()}
+	err = s.recordEvent(repo.Tx, newEvent(o, userImpersonationStartedEvt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", impersonateErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", impersonateErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, token, impersonationStartedInfo, nil)
+	return nil
+}
+
+// StopImpersonation ends the impersonation session of the request.
+// The admin is back to their own session, kept by the client.
+func (s *Service) StopImpersonation(req tp.StopImpersonationReq, res *tp.StopImpersonationRes) error {
+	o := req.Origin
+
+	if !isImpersonating(o) {
+		res.FromModel(nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.Get(o.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, stopImpersonationErr, err)
+		return err
+	}
+
+	err = s.repo.SessionRepo(repo.Tx).Revoke(o.ActorID, o.SessionID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, stopImpersonationErr, err)
+		return err
+	}
+
+	// Audit
+	// Recorded as an action of the admin.
+	ao := o
+	ao.ActorID = o.ImpersonatorID
+	ao.ImpersonatorID = ""
+
+	md := meta{"session": o.SessionID}
+	err = s.recordEvent(repo.Tx, newEvent(ao, userImpersonationStoppedEvt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, stopImpersonationErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, stopImpersonationErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, impersonationStoppedInfo, nil)
+	return nil
+}
+
+// canImpersonate returns ErrForbidden unless the origin actor
+// is an admin allowed to act as u.
+func (s *Service) canImpersonate(tx *sqlx.Tx, o tp.Origin, u *model.User) error {
+	if isSelf(o, u) || u.IsServiceAccount() || !isActiveUser(*u) {
+		return ErrForbidden
+	}
+
+	err := s.requireAdmin(tx, o)
+	if err != nil {
+		return err
+	}
+
+	// Admins would otherwise lend each other their sessions.
+	target, err := s.repo.RoleRepo(tx).Has(u.ID.String(), model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if target {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// impersonator returns the admin of an impersonation session.
+// Sessions of admins that lost the role are no longer valid.
+func (s *Service) impersonator(tx *sqlx.Tx, session *model.Session) (*model.User, error) {
+	id := session.ImpersonatorID.String
+
+	admin, err := s.repo.RoleRepo(tx).Has(id, model.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if !admin {
+		return nil, ErrForbidden
+	}
+
+	u, err := s.repo.UserRepo(tx).Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// isImpersonating returns true if the origin actor
+// is being impersonated by an admin.
+func isImpersonating(o tp.Origin) bool {
+	return o.ImpersonatorID != ""
+}
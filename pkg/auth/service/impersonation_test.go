@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// TestImpersonateUserByNonAdmin tests that only admins can act as other users.
+func TestImpersonateUserByNonAdmin(t *testing.T) {
+	// Prerequisites
+	actor, err := createNamedUser("impersonatoruser")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	target, err := createNamedUser("impersonatedbyuser")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Setup
+	req := tp.ImpersonateUserReq{
+		Identifier: tp.Identifier{
+			Slug: target.Slug.String,
+		},
+		Origin: tp.Origin{
+			ActorID: actor.ID.String(),
+		},
+	}
+
+	var res tp.ImpersonateUserRes
+
+	// Test
+	err = s.ImpersonateUser(req, &res)
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got: %v", err)
+	}
+
+	// Verify
+	if res.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if res.SessionToken != "" {
+		t.Error("no session should be opened for a non admin")
+	}
+}
+
+// TestImpersonateAdmin tests that admins cannot act as other admins.
+func TestImpersonateAdmin(t *testing.T) {
+	// Prerequisites
+	actor, err := createNamedUser("impersonatoradmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	target, err := createNamedUser("impersonatedadmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err = grantAdmin(r, actor)
+	if err != nil {
+		t.Fatalf("error granting admin role: %s", err.Error())
+	}
+
+	err = grantAdmin(r, target)
+	if err != nil {
+		t.Fatalf("error granting admin role: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Setup
+	req := tp.ImpersonateUserReq{
+		Identifier: tp.Identifier{
+			Slug: target.Slug.String,
+		},
+		Origin: tp.Origin{
+			ActorID: actor.ID.String(),
+		},
+	}
+
+	var res tp.ImpersonateUserRes
+
+	// Test
+	err = s.ImpersonateUser(req, &res)
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got: %v", err)
+	}
+
+	// Verify
+	if res.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if res.SessionToken != "" {
+		t.Error("no session should be opened as another admin")
+	}
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	magicLinkSentInfo          = "magic_link_sent_info"
+	magicLinkConfirmInfo       = "magic_link_confirm_info"
+	requestMagicLinkErr        = "cannot_request_magic_link_err"
+	magicLinkSignInErr         = "cannot_sign_in_with_magic_link_err"
+	magicLinkDisabledErr       = "magic_link_disabled_err"
+	defaultMagicLinkTTLMinutes = 15
+)
+
+var (
+	// ErrMagicLinkDisabled is returned when magic links are not enabled.
+	ErrMagicLinkDisabled = errors.New("magic link sign-in is disabled")
+)
+
+// RequestMagicLink emails a sign-in link to the user owning req.Email.
+// The response does not tell whether the address belongs to a user,
+// a binding is always returned and the request logged only when a link is sent.
+func (s *Service) RequestMagicLink(req tp.RequestMagicLinkReq, res *tp.RequestMagicLinkRes) error {
+	if !s.MagicLinkEnabled() {
+		res.FromModel("", magicLinkDisabledErr, ErrMagicLinkDisabled)
+		return ErrMagicLinkDisabled
+	}
+
+	ml := model.MagicLink{
+		IP:        db.ToNullString(req.Origin.IP),
+		UserAgent: db.ToNullString(req.Origin.UserAgent),
+	}
+
+	token, binding, err := ml.GenTokens()
+	if err != nil {
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel("", cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetByEmail(strings.TrimSpace(req.Email))
+	if err != nil || !u.IsConfirmed.Bool || !s.magicLinkEnabledFor(&u) {
+		repo.Tx.Rollback()
+		s.Log().Info("No magic link sent", "email", req.Email)
+		res.FromModel(binding, magicLinkSentInfo, nil)
+		return nil
+	}
+
+	mlr := s.repo.MagicLinkRepo(repo.Tx)
+
+	_, err = mlr.ExpirePending(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	ml.UserID = u.ID.String()
+	ml.SetCreateValues(s.magicLinkTTL())
+
+	err = mlr.Create(&ml)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	// Audit
+	o := req.Origin
+	o.ActorID = u.ID.String()
+
+	err = s.recordEvent(repo.Tx, newEvent(o, userMagicLinkRequestedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	// Mail
+	err = s.queueMagicLinkEmail(repo.Tx, &u, token)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel("", requestMagicLinkErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(binding, magicLinkSentInfo, nil)
+	return nil
+}
+
+// SignInWithMagicLink opens a session using an emailed magic link.
+// If the link is opened from a client other than the one that requested it
+// the user is asked to confirm first, the link is kept usable until then.
+func (s *Service) SignInWithMagicLink(req tp.MagicLinkSignInReq, res *tp.MagicLinkSignInRes) error {
+	if !s.MagicLinkEnabled() {
+		res.FromModel(nil, nil, magicLinkDisabledErr, ErrMagicLinkDisabled)
+		return ErrMagicLinkDisabled
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	if !s.magicLinkEnabledFor(&u) {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, magicLinkDisabledErr, ErrMagicLinkDisabled)
+		return ErrMagicLinkDisabled
+	}
+
+	mlr := s.repo.MagicLinkRepo(repo.Tx)
+
+	ml, err := mlr.GetUsable(u.ID.String(), model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, userMagicLinkFailedEvt, userTarget, u.Slug.String, nil))
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	bound := ml.IsBoundTo(req.Binding)
+	if !bound && !req.Confirmed {
+		repo.Tx.Rollback()
+		res.FromModel(&u, &ml, magicLinkConfirmInfo, nil)
+		res.ConfirmationRequired = true
+		return nil
+	}
+
+	err = mlr.Use(ml.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	// Session
+	session, token, verificationToken, ra, err := s.openSession(repo.Tx, &u, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	o := req.Origin
+	o.ActorID = u.ID.String()
+	o.SessionID = session.ID.String()
+
+	// Audit
+	evt := userSignedInEvt
+	if session.IsPending() {
+		evt = sessionChallengedEvt
+	}
+
+	md := meta{"session": o.SessionID, "method": "magic_link", "bound": bound, "risk": ra.Score, "reasons": ra.Reasons}
+
+	err = s.recordEvent(repo.Tx, newEvent(o, evt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	// Mail alert
+	if session.IsPending() {
+		err = s.queueSignInAlertEmail(repo.Tx, &u, session, verificationToken)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, nil, magicLinkSignInErr, err)
+			return err
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, magicLinkSignInErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, nil, okResultInfo, nil)
+	res.SessionToken = token
+	res.VerificationRequired = session.IsPending()
+	return nil
+}
+
+// MagicLinkEnabled returns true if magic link sign-in is enabled
+// for at least some tenants.
+// Set envar GRN_APP_MAGIC_LINK_ENABLED=true to enable it.
+func (s *Service) MagicLinkEnabled() bool {
+	return s.Cfg().ValAsBool("app.magic.link.enabled", false)
+}
+
+// magicLinkEnabledFor returns true if user tenant can sign in with magic links.
+// Envar GRN_APP_MAGIC_LINK_TENANTS, a comma separated list of tenant IDs,
+// restricts them to the listed tenants, if empty all of them are allowed.
+func (s *Service) magicLinkEnabledFor(u *model.User) bool {
+	if !s.MagicLinkEnabled() {
+		return false
+	}
+
+	tenants := s.Cfg().ValOrDef("app.magic.link.tenants", "")
+	if strings.TrimSpace(tenants) == "" {
+		return true
+	}
+
+	for _, t := range strings.Split(tenants, ",") {
+		if strings.TrimSpace(t) == u.TenantID.String {
+			return true
+		}
+	}
+
+	return false
+}
+
+// magicLinkTTL returns for how long magic links can be used.
+func (s *Service) magicLinkTTL() time.Duration {
+	mins := s.Cfg().ValAsInt("app.magic.link.ttl.minutes", defaultMagicLinkTTLMinutes)
+	return time.Duration(mins) * time.Minute
+}
+
+func (s *Service) makeMagicLinkEmail(u *model.User, token string) (model.Email, error) {
+	path := s.Cfg().ValOrDef("user.magic.link.path", "users/%s/%s/magic-signin")
+	linkPath := fmt.Sprintf(path, u.Slug.String, token)
+
+	data := mailer.MailData{
+		"Minutes": int(s.magicLinkTTL().Minutes()),
+		"Link":    s.siteLink(linkPath),
+	}
+
+	return s.makeEmail(u, magicLinkMail, data)
+}
+
+// queueMagicLinkEmail stores in the outbox the email
+// with the sign-in link.
+func (s *Service) queueMagicLinkEmail(tx *sqlx.Tx, u *model.User, token string) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("user.magic.link.debug", false)
+	send := cfg.ValAsBool("user.magic.link.send", false)
+
+	if !debug && !send {
+		s.Log().Info("User magic link send is disabled")
+		return nil
+	}
+
+	m, err := s.makeMagicLinkEmail(u, token)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Magic link email", "subject", m.Subject, "body", m.Text)
+	}
+
+	if !send {
+		s.Log().Info("User magic link send is disabled")
+		return nil
+	}
+
+	return s.queueEmail(tx, m, magicLinkMail, u.ID.String())
+}
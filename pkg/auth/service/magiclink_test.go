@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// magicLinkConfig enables magic links, risk checks are disabled
+// so that sign-ins do not wait for an email verification.
+var magicLinkConfig = map[string]string{
+	"app.magic.link.enabled": "true",
+	"app.risk.enabled":       "false",
+}
+
+// TestSignInWithMagicLinkFromOtherDevice tests that links opened
+// from a client other than the requesting one need a confirmation.
+func TestSignInWithMagicLinkFromOtherDevice(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("magiclinkdevice")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(magicLinkConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	token, _, err := createMagicLink(r, user, "requesting-browser")
+	if err != nil {
+		t.Fatalf("error creating magic link: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Setup
+	req := tp.MagicLinkSignInReq{
+		Identifier: tp.Identifier{
+			Slug:  user.Slug.String,
+			Token: token,
+		},
+		Binding: "other-browser-binding",
+	}
+
+	var res tp.MagicLinkSignInRes
+
+	// Test
+	err = s.SignInWithMagicLink(req, &res)
+	if err != nil {
+		t.Errorf("magic link sign in error: %s", err.Error())
+	}
+
+	// Verify
+	if !res.ConfirmationRequired {
+		t.Error("confirmation should be required from another device")
+	}
+
+	if res.SessionToken != "" {
+		t.Error("no session should be opened before confirmation")
+	}
+
+	if res.RequestUserAgent != "requesting-browser" {
+		t.Errorf("expecting requesting client to be shown got '%s'", res.RequestUserAgent)
+	}
+
+	// Confirmed, the link is still usable
+	req.Confirmed = true
+	var cres tp.MagicLinkSignInRes
+
+	err = s.SignInWithMagicLink(req, &cres)
+	if err != nil {
+		t.Errorf("confirmed magic link sign in error: %s", err.Error())
+	}
+
+	if cres.SessionToken == "" {
+		t.Error("a session should be opened once confirmed")
+	}
+
+	// Links are single use
+	var ures tp.MagicLinkSignInRes
+
+	err = s.SignInWithMagicLink(req, &ures)
+	if err == nil {
+		t.Error("used magic link should not sign in again")
+	}
+}
+
+// createMagicLink stores a magic link for the user
+// and returns its token and binding.
+func createMagicLink(r *repo.Repo, user *model.User, userAgent string) (token, binding string, err error) {
+	mlr, err := r.MagicLinkRepoNewTx()
+	if err != nil {
+		return "", "", err
+	}
+
+	ml := model.MagicLink{
+		UserID:    user.ID.String(),
+		UserAgent: db.ToNullString(userAgent),
+	}
+
+	token, binding, err = ml.GenTokens()
+	if err != nil {
+		mlr.Tx.Rollback()
+		return "", "", err
+	}
+
+	ml.SetCreateValues(15 * time.Minute)
+
+	err = mlr.Create(&ml)
+	if err != nil {
+		mlr.Tx.Rollback()
+		return "", "", err
+	}
+
+	return token, binding, mlr.Commit()
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// Emails, see 'assets/web/embed/mail'
+	confirmationMail = "confirmation"
+	signInAlertMail  = "signin_alert"
+	// Email change
+	emailChangeConfirmMail = "email_change_confirm"
+	emailChangeNoticeMail  = "email_change_notice"
+	// Password
+	passwordChangedMail = "password_changed"
+	// Magic link
+	magicLinkMail = "magic_link"
+	// Account subscription
+	accountExpiryWarningMail = "account_expiry_warning"
+	// Account ownership
+	accountTransferMail = "account_transfer"
+)
+
+const (
+	previewMailErr = "cannot_preview_mail_err"
+)
+
+var (
+	// mailSamples are used to preview emails.
+	mailSamples = map[string]mailer.MailData{
+		confirmationMail: {
+			"Link": "https://localhost/users/username-1a2b3c4d5e6f/a1b2c3d4/confirm",
+		},
+		signInAlertMail: {
+			"When":   "2020-01-15 09:30 UTC",
+			"Where":  "203.0.113.10 (PL)",
+			"Device": "Mozilla/5.0 (X11; Linux x86_64; rv:72.0) Gecko/20100101 Firefox/72.0",
+			"Link":   "https://localhost/users/username-1a2b3c4d5e6f/a1b2c3d4/verify-signin",
+		},
+		emailChangeConfirmMail: {
+			"NewEmail": "new-username@mail.com",
+			"Hours":    defaultEmailChangeTTLHours,
+			"Link":     "https://localhost/users/username-1a2b3c4d5e6f/a1b2c3d4/confirm-email",
+		},
+		emailChangeNoticeMail: {
+			"NewEmail": "new-username@mail.com",
+			"Hours":    defaultEmailChangeCancelWindowHours,
+			"Link":     "https://localhost/users/username-1a2b3c4d5e6f/a1b2c3d4/cancel-email-change",
+		},
+		passwordChangedMail: {
+			"When":   "2020-01-15 09:30 UTC",
+			"Where":  "203.0.113.10",
+			"Device": "Mozilla/5.0 (X11; Linux x86_64; rv:72.0) Gecko/20100101 Firefox/72.0",
+			"Link":   "https://localhost/users/username-1a2b3c4d5e6f/security",
+		},
+		magicLinkMail: {
+			"Minutes": defaultMagicLinkTTLMinutes,
+			"Link":    "https://localhost/users/username-1a2b3c4d5e6f/a1b2c3d4/magic-signin",
+		},
+		accountExpiryWarningMail: {
+			"Account":   "Acme",
+			"EndsAt":    "2020-01-31 00:00 UTC",
+			"GraceDays": defaultSubscriptionGraceDays,
+			"Link":      "https://localhost/accounts/acme-1a2b3c4d5e6f",
+		},
+		accountTransferMail: {
+			"Account": "Acme",
+			"Hours":   defaultTransferTTLHours,
+			"Link":    "https://localhost/accounts/acme-1a2b3c4d5e6f/a1b2c3d4/confirm-transfer",
+		},
+	}
+)
+
+// makeEmail renders the email 'name' for user in its locale.
+func (s *Service) makeEmail(u *model.User, name string, data mailer.MailData) (model.Email, error) {
+	if s.mailTemplates == nil {
+		return model.Email{}, errors.New("no mail templates available")
+	}
+
+	cfg := s.Cfg()
+	sender := cfg.ValOrDef("mailer.agent.name", "mailer")
+	from := cfg.ValOrDef("mailer.agent.mail", "dontreply@localhost")
+
+	if data == nil {
+		data = mailer.MailData{}
+	}
+	data["Username"] = u.Username.String
+
+	subject, html, text, err := s.mailTemplates.Render(name, u.Locale.String, data)
+	if err != nil {
+		return model.Email{}, err
+	}
+
+	m := model.MakeEmail(sender, from, u.Email.String, "", "", subject, html)
+	m.Text = text
+
+	return m, nil
+}
+
+// siteLink returns an absolute URL for a site path.
+func (s *Service) siteLink(path string) string {
+	site := s.Cfg().ValOrDef("site.url", "localhost")
+	return fmt.Sprintf("https://%s/%s", site, strings.TrimPrefix(path, "/"))
+}
+
+// PreviewMail renders an email with sample data.
+func (s *Service) PreviewMail(req tp.PreviewMailReq, res *tp.PreviewMailRes) error {
+	if s.mailTemplates == nil {
+		err := errors.New("no mail templates available")
+		res.FromModel(nil, nil, previewMailErr, err)
+		return err
+	}
+
+	names := s.mailTemplates.Names()
+
+	if req.Name == "" {
+		res.FromModel(names, nil, okResultInfo, nil)
+		return nil
+	}
+
+	u := &model.User{}
+	u.Username.String = "username"
+	u.Email.String = "username@mail.com"
+	u.Locale.String = req.Lang
+
+	data := mailer.MailData{}
+	for k, v := range mailSamples[req.Name] {
+		data[k] = v
+	}
+
+	m, err := s.makeEmail(u, req.Name, data)
+	if err != nil {
+		res.FromModel(names, nil, previewMailErr, err)
+		return err
+	}
+
+	res.FromModel(names, &m, okResultInfo, nil)
+	return nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	getOutboxEmailsErr   = "cannot_get_outbox_emails_err"
+	getOutboxEmailErr    = "cannot_get_outbox_email_err"
+	requeueOutboxMailErr = "cannot_requeue_outbox_email_err"
+)
+
+const (
+	outboxEmailRequeuedInfo = "outbox_email_requeued_info"
+)
+
+// queueEmail stores an email in the outbox using tx,
+// it is delivered by the outbox dispatcher once tx is committed.
+func (s *Service) queueEmail(tx *sqlx.Tx, m model.Email, kind, userID string) error {
+	oe := model.MakeOutboxEmail(m, kind, userID)
+	return s.repo.OutboxRepo(tx).Enqueue(&oe)
+}
+
+// IndexOutboxEmails lists outbox emails, only admins can see them.
+func (s *Service) IndexOutboxEmails(req tp.IndexOutboxEmailsReq, res *tp.IndexOutboxEmailsRes) error {
+	// Model
+	f := req.ToModel()
+
+	// Set envar GRN_APP_OUTBOX_LIMIT to change
+	// the maximum number of returned emails.
+	max := int(s.Cfg().ValAsInt("app.outbox.limit", 200))
+	if f.Limit <= 0 || f.Limit > max {
+		f.Limit = max
+	}
+
+	// Repo
+	repo, err := s.repo.OutboxRepoNewTx()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, adminErr(err, getOutboxEmailsErr), err)
+		return err
+	}
+
+	es, err := repo.GetAll(f)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getOutboxEmailsErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, emailListedEvt, emailTarget, "", meta{"status": f.Status}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getOutboxEmailsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, getOutboxEmailsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(es, okResultInfo, nil)
+	return nil
+}
+
+// GetOutboxEmail returns an outbox email without its bodies.
+func (s *Service) GetOutboxEmail(req tp.GetOutboxEmailReq, res *tp.GetOutboxEmailRes) error {
+	// Repo
+	repo, err := s.repo.OutboxRepoNewTx()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, adminErr(err, getOutboxEmailErr), err)
+		return err
+	}
+
+	oe, err := repo.Get(req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getOutboxEmailErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, emailViewedEvt, emailTarget, req.ID, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getOutboxEmailErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, getOutboxEmailErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&oe, okResultInfo, nil)
+	return nil
+}
+
+// RequeueOutboxEmail gives a dead-lettered email a new set of delivery attempts.
+func (s *Service) RequeueOutboxEmail(req tp.RequeueOutboxEmailReq, res *tp.RequeueOutboxEmailRes) error {
+	// Repo
+	repo, err := s.repo.OutboxRepoNewTx()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, adminErr(err, requeueOutboxMailErr), err)
+		return err
+	}
+
+	oe, err := repo.Requeue(req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, requeueOutboxMailErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, emailRequeuedEvt, emailTarget, req.ID, meta{"recipient": oe.Recipient}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, requeueOutboxMailErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, requeueOutboxMailErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&oe, outboxEmailRequeuedInfo, nil)
+	return nil
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/password"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/log"
+)
+
+const (
+	passwordChangedInfo = "password_changed_info"
+	changePasswordErr   = "cannot_change_password_err"
+)
+
+// ChangePassword replaces the password of a user once the current one is verified.
+// Every other session is closed and the user is notified by email.
+func (s *Service) ChangePassword(req tp.ChangePasswordReq, res *tp.ChangePasswordRes) error {
+	// Credentials stay with the user.
+	if isImpersonating(req.Origin) {
+		res.FromModel(nil, 0, nil, impersonatingErr, ErrImpersonating)
+		return ErrImpersonating
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, 0, nil, cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, 0, nil, getUserErr, err)
+		return err
+	}
+
+	if !isSelf(req.Origin, &u) {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	// Password history
+	// The current password counts as the most recent one.
+	oldDigest := u.PasswordDigest.String
+	policy := s.passwords.For(u.TenantID.String)
+
+	history, err := repo.GetPasswordHistory(u.ID.String(), policy.History)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+	history = append([]string{oldDigest}, history...)
+
+	// Validation
+	u.Password = req.Password
+	v := s.newUserValidator(u, history)
+
+	err = v.ValidateForPasswordChange(req.CurrentPassword, req.PasswordConfirmation)
+	if err != nil {
+		repo.Tx.Rollback()
+		if len(v.Errors["CurrentPassword"]) > 0 {
+			s.recordFailure(newEvent(req.Origin, userPasswordChangeFailedEvt, userTarget, u.Slug.String, nil))
+		}
+		res.FromModel(&u, 0, v.Errors, validationErr, err)
+		return err
+	}
+
+	digest, err := u.UpdatePasswordDigest()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	err = repo.UpdatePassword(u.ID.String(), digest, req.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	err = repo.AddPasswordHistory(u.ID.String(), oldDigest)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	// Sign out other devices
+	keep := []string{}
+	if req.SessionID != "" {
+		keep = append(keep, req.SessionID)
+	}
+
+	revoked, err := s.repo.SessionRepo(repo.Tx).RevokeAll(u.ID.String(), keep...)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userPasswordChangedEvt, userTarget, u.Slug.String, meta{"revoked": revoked}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	// Mail notice
+	err = s.queuePasswordChangedEmail(repo.Tx, &u, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(&u, 0, nil, changePasswordErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, revoked, nil, passwordChangedInfo, nil)
+	return nil
+}
+
+// newUserValidator returns a user validator that applies
+// the password policy of the user tenant.
+// history holds the digests of the passwords that cannot be reused.
+func (s *Service) newUserValidator(u model.User, history []string) UserValidator {
+	p := s.passwords.For(u.TenantID.String)
+	c := logCorpus{Corpus: s.breached, log: s.Log()}
+
+	return NewUserValidator(u).WithPasswordPolicy(p, c, history)
+}
+
+// logCorpus logs breached corpus lookup errors.
+// Passwords are not rejected when the corpus cannot be read.
+type logCorpus struct {
+	password.Corpus
+	log *log.Logger
+}
+
+func (c logCorpus) Range(prefix string) ([]string, error) {
+	if c.Corpus == nil {
+		return nil, nil
+	}
+
+	hashes, err := c.Corpus.Range(prefix)
+	if err != nil {
+		c.log.Error(err)
+		return nil, nil
+	}
+
+	return hashes, nil
+}
+
+func (s *Service) makePasswordChangedEmail(u *model.User, o tp.Origin) (model.Email, error) {
+	path := s.Cfg().ValOrDef("user.security.path", "users/%s/security")
+	secPath := fmt.Sprintf(path, u.Slug.String)
+
+	data := mailer.MailData{
+		"When":   time.Now().Format("2006-01-02 15:04 MST"),
+		"Where":  o.IP,
+		"Device": o.UserAgent,
+		"Link":   s.siteLink(secPath),
+	}
+
+	return s.makeEmail(u, passwordChangedMail, data)
+}
+
+// queuePasswordChangedEmail stores in the outbox an email that
+// lets the user know their password has been changed.
+func (s *Service) queuePasswordChangedEmail(tx *sqlx.Tx, u *model.User, o tp.Origin) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("user.password.notice.debug", false)
+	send := cfg.ValAsBool("user.password.notice.send", false)
+
+	if !debug && !send {
+		s.Log().Info("User password change notice send is disabled")
+		return nil
+	}
+
+	m, err := s.makePasswordChangedEmail(u, o)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Password changed email", "subject", m.Subject, "body", m.Text)
+	}
+
+	if !send {
+		s.Log().Info("User password change notice send is disabled")
+		return nil
+	}
+
+	return s.queueEmail(tx, m, passwordChangedMail, u.ID.String())
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// TestChangePasswordWrongCurrent tests that passwords are not
+// changed unless the current one is given.
+func TestChangePasswordWrongCurrent(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("passwordwrong")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	// Setup
+	newPassword := "N3w#Passw0rd-x"
+	req := tp.ChangePasswordReq{
+		Identifier: tp.Identifier{
+			Slug: user.Slug.String,
+		},
+		CurrentPassword:      "not-" + namedUserPassword,
+		Password:             newPassword,
+		PasswordConfirmation: newPassword,
+		Origin: tp.Origin{
+			ActorID: user.ID.String(),
+		},
+	}
+
+	var res tp.ChangePasswordRes
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	err = s.ChangePassword(req, &res)
+	if err == nil {
+		t.Error("password should not change with a wrong current password")
+	}
+
+	// Verify
+	if res.MsgID != validationErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if len(res.Errors["CurrentPassword"]) == 0 {
+		t.Error("current password should be reported as invalid")
+	}
+
+	vUser, err := getUserBySlug(user.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get user from database: %s", err.Error())
+	}
+
+	if vUser.PasswordDigest.String != user.PasswordDigest.String {
+		t.Error("password should not be changed")
+	}
+
+	_, _, err = openTestSession(s, user)
+	if err != nil {
+		t.Errorf("user should still sign in with the current password: %s", err.Error())
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"time"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// PurgeDeleted removes users and accounts soft deleted
+// longer than the configured retention period.
+func (s *Service) PurgeDeleted() (users, accounts int64, err error) {
+	// Set envar GRN_APP_PURGE_RETENTION_DAYS to change
+	// how long deleted users and accounts can be restored.
+	days := s.Cfg().ValAsInt("app.purge.retention.days", 30)
+	before := time.Now().AddDate(0, 0, -int(days))
+
+	// Repo
+	userRepo, err := s.userRepo()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	accountRepo := s.repo.AccountRepo(userRepo.Tx)
+
+	accounts, err = accountRepo.Purge(before)
+	if err != nil {
+		userRepo.Tx.Rollback()
+		return 0, 0, err
+	}
+
+	users, err = userRepo.Purge(before)
+	if err != nil {
+		userRepo.Tx.Rollback()
+		return 0, 0, err
+	}
+
+	// Audit
+	// Purge is run by the system, there is no actor nor origin.
+	md := meta{"before": before}
+
+	md["count"] = accounts
+	err = s.recordEvent(userRepo.Tx, newEvent(tp.Origin{}, accountPurgedEvt, accountTarget, "", md))
+	if err != nil {
+		userRepo.Tx.Rollback()
+		return 0, 0, err
+	}
+
+	md["count"] = users
+	err = s.recordEvent(userRepo.Tx, newEvent(tp.Origin{}, userPurgedEvt, userTarget, "", md))
+	if err != nil {
+		userRepo.Tx.Rollback()
+		return 0, 0, err
+	}
+
+	err = userRepo.Commit()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return users, accounts, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/geo"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+const (
+	// Risk reasons
+	newDeviceRisk        = "new_device"
+	newCountryRisk       = "new_country"
+	unusualLocationRisk  = "unusual_location"
+	impossibleTravelRisk = "impossible_travel"
+)
+
+const (
+	// Default settings
+	defaultRiskThreshold   = 3
+	defaultRiskHistory     = 20
+	defaultMaxTravelKmh    = 900
+	defaultUnusualDistance = 500
+)
+
+var (
+	riskWeights = map[string]int{
+		newDeviceRisk:        1,
+		newCountryRisk:       2,
+		unusualLocationRisk:  1,
+		impossibleTravelRisk: 3,
+	}
+)
+
+type (
+	// riskAssessment of a sign-in attempt.
+	riskAssessment struct {
+		Score   int
+		Reasons []string
+	}
+
+	// signInAttempt describes the sign-in being assessed.
+	signInAttempt struct {
+		UserAgent string
+		Location  geo.Location
+		Located   bool
+		At        time.Time
+	}
+
+	// riskSettings tune the assessment.
+	riskSettings struct {
+		MaxTravelKmh    float64
+		UnusualDistance float64
+	}
+)
+
+func (ra *riskAssessment) add(reason string) {
+	ra.Score += riskWeights[reason]
+	ra.Reasons = append(ra.Reasons, reason)
+}
+
+// String returns a comma separated list of reasons.
+func (ra riskAssessment) String() string {
+	return strings.Join(ra.Reasons, ",")
+}
+
+// assessSignIn compares a sign-in attempt against the user stored location
+// and previous sign-ins.
+// Nothing is considered suspicious on the very first sign-in.
+func assessSignIn(u *model.User, a signInAttempt, recent []model.Session, rs riskSettings) riskAssessment {
+	ra := riskAssessment{}
+
+	if len(recent) == 0 {
+		return ra
+	}
+
+	// New device
+	knownDevice := false
+	for _, s := range recent {
+		if s.UserAgent.String == a.UserAgent {
+			knownDevice = true
+			break
+		}
+	}
+
+	if !knownDevice {
+		ra.add(newDeviceRisk)
+	}
+
+	if !a.Located {
+		return ra
+	}
+
+	// New country
+	if a.Location.Country != "" {
+		knownCountry := false
+		countries := 0
+		for _, s := range recent {
+			if !s.Country.Valid {
+				continue
+			}
+			countries++
+			if s.Country.String == a.Location.Country {
+				knownCountry = true
+				break
+			}
+		}
+
+		if countries > 0 && !knownCountry {
+			ra.add(newCountryRisk)
+		}
+	}
+
+	// Impossible travel: the distance from the last located sign-in
+	// could not have been covered in the elapsed time.
+	for _, s := range recent {
+		if !s.Geolocation.Valid || !s.CreatedAt.Valid {
+			continue
+		}
+
+		from := geo.Location{Lat: s.Geolocation.Point.Lat, Lng: s.Geolocation.Point.Lng}
+		d := geo.Distance(from, a.Location)
+		h := a.At.Sub(s.CreatedAt.Time).Hours()
+
+		if d > rs.UnusualDistance && (h <= 0 || d/h > rs.MaxTravelKmh) {
+			ra.add(impossibleTravelRisk)
+		}
+		break
+	}
+
+	// Unusual location: far from user stored location.
+	if u.Geolocation.Valid {
+		home := geo.Location{Lat: u.Geolocation.Point.Lat, Lng: u.Geolocation.Point.Lng}
+		if geo.Distance(home, a.Location) > rs.UnusualDistance {
+			ra.add(unusualLocationRisk)
+		}
+	}
+
+	return ra
+}
+
+// assessSignInRisk gathers what is needed to assess a sign-in.
+func (s *Service) assessSignInRisk(u *model.User, a signInAttempt, recent []model.Session) riskAssessment {
+	cfg := s.Cfg()
+
+	rs := riskSettings{
+		MaxTravelKmh:    float64(cfg.ValAsInt("app.risk.travel.kmh", defaultMaxTravelKmh)),
+		UnusualDistance: float64(cfg.ValAsInt("app.risk.distance.km", defaultUnusualDistance)),
+	}
+
+	return assessSignIn(u, a, recent, rs)
+}
+
+// isHighRisk returns true if sign-in must be verified before
+// the session can be used.
+func (s *Service) isHighRisk(ra riskAssessment) bool {
+	if !s.Cfg().ValAsBool("app.risk.enabled", true) {
+		return false
+	}
+
+	threshold := int(s.Cfg().ValAsInt("app.risk.threshold", defaultRiskThreshold))
+	return ra.Score >= threshold
+}
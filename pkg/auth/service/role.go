@@ -0,0 +1,291 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// Info
+	roleGrantedInfo = "role_granted_info"
+	roleRevokedInfo = "role_revoked_info"
+	// Error
+	getUserRolesErr = "cannot_get_user_roles_err"
+	grantRoleErr    = "cannot_grant_role_err"
+	revokeRoleErr   = "cannot_revoke_role_err"
+)
+
+var (
+	// ErrNotLocalRole is returned when revoking a role granted by a directory,
+	// it would be granted again on the next sync.
+	ErrNotLocalRole = errors.New("role is managed by a directory")
+)
+
+// GetUserRoles returns the roles held by a user.
+func (s *Service) GetUserRoles(req tp.GetUserRolesReq, res *tp.GetUserRolesRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, adminErr(err, getUserRolesErr), err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, getUserRolesErr, err)
+		return err
+	}
+
+	rs, err := s.repo.RoleRepo(repo.Tx).GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, getUserRolesErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, getUserRolesErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, rs, okResultInfo, nil)
+	return nil
+}
+
+// GrantRole grants a local role to a user.
+// Granting a role already held is a no-op.
+func (s *Service) GrantRole(req tp.GrantRoleReq, res *tp.GrantRoleRes) error {
+	o := req.Origin
+
+	// Model
+	r := model.Role{Name: strings.ToLower(strings.TrimSpace(req.Name)), Source: model.RoleSourceLocal}
+
+	// Validation
+	v := NewRoleValidator(r)
+
+	err := v.ValidateForGrant()
+	if err != nil {
+		res.FromModel(&r, v.Errors, validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, adminErr(err, grantRoleErr), err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, grantRoleErr, err)
+		return err
+	}
+
+	// Service accounts are authorized through their scopes.
+	if u.IsServiceAccount() {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	r.UserID = u.ID.String()
+	r.SetCreateValues()
+
+	err = s.repo.RoleRepo(repo.Tx).Grant(&r)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, grantRoleErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(o, userRoleGrantedEvt, userTarget, u.Slug.String, meta{"role": r.Name}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, grantRoleErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, grantRoleErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&r, nil, roleGrantedInfo, nil)
+	return nil
+}
+
+// RevokeRole revokes a local role from a user.
+// Roles granted by a directory are managed there.
+// Admins cannot revoke their own admin role.
+func (s *Service) RevokeRole(req tp.RevokeRoleReq, res *tp.RevokeRoleRes) error {
+	o := req.Origin
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(adminErr(err, revokeRoleErr), err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeRoleErr, err)
+		return err
+	}
+
+	// Otherwise the last admin could lock everyone out.
+	if isSelf(o, &u) && req.Name == model.RoleAdmin {
+		repo.Tx.Rollback()
+		res.FromModel(forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	rr := s.repo.RoleRepo(repo.Tx)
+
+	rs, err := rr.GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeRoleErr, err)
+		return err
+	}
+
+	for _, r := range rs {
+		if r.Name == req.Name && r.Source != model.RoleSourceLocal {
+			repo.Tx.Rollback()
+			res.FromModel(revokeRoleErr, ErrNotLocalRole)
+			return ErrNotLocalRole
+		}
+	}
+
+	err = rr.Revoke(u.ID.String(), req.Name)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeRoleErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(o, userRoleRevokedEvt, userTarget, u.Slug.String, meta{"role": req.Name}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeRoleErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(revokeRoleErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(roleRevokedInfo, nil)
+	return nil
+}
+
+// BootstrapAdmins grants the admin role to the users listed in
+// envar GRN_APP_ADMIN_USERNAMES, a comma separated list of usernames,
+// so that a new install has someone to grant roles to the rest.
+// Listed users that do not exist yet are skipped, they get it on next start.
+func (s *Service) BootstrapAdmins() error {
+	usernames := s.Cfg().ValOrDef("app.admin.usernames", "")
+	if strings.TrimSpace(usernames) == "" {
+		return nil
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		return err
+	}
+
+	rr := s.repo.RoleRepo(repo.Tx)
+
+	for _, username := range strings.Split(usernames, ",") {
+		username = strings.TrimSpace(username)
+		if username == "" {
+			continue
+		}
+
+		u, err := repo.GetByUsername(username)
+		if err == sql.ErrNoRows {
+			s.Log().Info("Admin user not found", "username", username)
+			continue
+		}
+
+		if err != nil {
+			repo.Tx.Rollback()
+			return err
+		}
+
+		// Service accounts are authorized through their scopes.
+		if u.IsServiceAccount() {
+			s.Log().Info("Service accounts cannot be admins", "username", username)
+			continue
+		}
+
+		admin, err := rr.Has(u.ID.String(), model.RoleAdmin)
+		if err != nil {
+			repo.Tx.Rollback()
+			return err
+		}
+
+		if admin {
+			continue
+		}
+
+		r := model.Role{UserID: u.ID.String(), Name: model.RoleAdmin}
+		r.SetCreateValues()
+
+		err = rr.Grant(&r)
+		if err != nil {
+			repo.Tx.Rollback()
+			return err
+		}
+
+		// Audit
+		err = s.recordEvent(repo.Tx, newEvent(tp.Origin{}, userRoleGrantedEvt, userTarget, u.Slug.String, meta{"role": r.Name, "source": "config"}))
+		if err != nil {
+			repo.Tx.Rollback()
+			return err
+		}
+
+		s.Log().Info("Admin role granted", "username", username)
+	}
+
+	return repo.Commit()
+}
@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+)
+
+// TestBootstrapAdmins tests that configured users are granted the admin role.
+func TestBootstrapAdmins(t *testing.T) {
+	// Prerequisites
+	admin, err := createNamedUser("bootstrapadmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	other, err := createNamedUser("bootstrapother")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(map[string]string{
+		"app.admin.usernames": " bootstrapadmin, bootstrapmissing",
+	})
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	err = s.BootstrapAdmins()
+	if err != nil {
+		t.Errorf("bootstrap admins error: %s", err.Error())
+	}
+
+	// Applying it again keeps the role.
+	err = s.BootstrapAdmins()
+	if err != nil {
+		t.Errorf("second bootstrap admins error: %s", err.Error())
+	}
+
+	// Verify
+	ok, err := hasAdminRole(r, admin)
+	if err != nil {
+		t.Fatalf("cannot get roles: %s", err.Error())
+	}
+
+	if !ok {
+		t.Error("listed user should be an admin")
+	}
+
+	ok, err = hasAdminRole(r, other)
+	if err != nil {
+		t.Fatalf("cannot get roles: %s", err.Error())
+	}
+
+	if ok {
+		t.Error("unlisted user should not be an admin")
+	}
+}
+
+// hasAdminRole tells if the user holds the admin role.
+func hasAdminRole(r *repo.Repo, user *model.User) (bool, error) {
+	roleRepo, err := r.RoleRepoNewTx()
+	if err != nil {
+		return false, err
+	}
+	defer roleRepo.Tx.Rollback()
+
+	return roleRepo.Has(user.ID.String(), model.RoleAdmin)
+}
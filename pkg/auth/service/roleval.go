@@ -0,0 +1,80 @@
+package service
+
+import (
+	"errors"
+	"regexp"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	invalidRoleErrMsg = "invalid_role_err_msg"
+)
+
+const (
+	maxRoleName = 64
+)
+
+var (
+	// roleNameFmt matches lower case names such as 'admin' or 'billing.viewer'.
+	roleNameFmt = regexp.MustCompile(`^[a-z][a-z0-9_.:-]*$`)
+)
+
+type (
+	RoleValidator struct {
+		Model model.Role
+		service.Validator
+	}
+)
+
+func NewRoleValidator(r model.Role) RoleValidator {
+	return RoleValidator{
+		Model:     r,
+		Validator: service.NewValidator(),
+	}
+}
+
+// ValidateForGrant checks the name of a role to be granted.
+func (rv RoleValidator) ValidateForGrant() error {
+	// Name
+	ok0 := rv.ValidateRequiredName()
+	ok1 := rv.ValidateMaxLengthName(maxRoleName)
+	ok2 := ok0 && rv.ValidateNameFormat()
+
+	if ok0 && ok1 && ok2 {
+		return nil
+	}
+
+	return errors.New("role has errors")
+}
+
+func (rv RoleValidator) ValidateRequiredName() (ok bool) {
+	ok = rv.ValidateRequired(rv.Model.Name)
+	if ok {
+		return true
+	}
+
+	rv.Errors.Add("Name", requiredErrMsg)
+	return false
+}
+
+func (rv RoleValidator) ValidateMaxLengthName(max int) (ok bool) {
+	ok = rv.ValidateMaxLength(rv.Model.Name, max+1)
+	if ok {
+		return true
+	}
+
+	rv.Errors.Add("Name", maxLengthErrMsg)
+	return false
+}
+
+func (rv RoleValidator) ValidateNameFormat() (ok bool) {
+	if roleNameFmt.MatchString(rv.Model.Name) {
+		return true
+	}
+
+	rv.Errors.Add("Name", invalidRoleErrMsg)
+	return false
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"errors"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/oidc"
+	"gitlab.com/mikrowezel/backend/granica/internal/saml"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	samlAssertErr             = "cannot_assert_identity_err"
+	unknownServiceProviderErr = "unknown_service_provider_err"
+)
+
+var (
+	// ErrIdPDisabled is returned for identity provider requests
+	// when Granica is not configured as SAML identity provider.
+	ErrIdPDisabled = errors.New("SAML identity provider not enabled")
+)
+
+// SAMLAuthRequest returns the identity provider URL the user has to be sent to.
+// The request ID has to be kept by the caller, bound to the user agent,
+// until the identity provider posts the response back.
+func (s *Service) SAMLAuthRequest(req tp.SAMLAuthReq, res *tp.SAMLAuthRes) error {
+	sp, ok := s.samlSPs.Get(req.Provider)
+	if !ok {
+		res.FromModel("", "", unknownProviderErr, ErrUnknownProvider)
+		return ErrUnknownProvider
+	}
+
+	if req.Link && req.Origin.ActorID == "" {
+		res.FromModel("", "", forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	ar, err := sp.AuthnRequest()
+	if err != nil {
+		res.FromModel("", "", federatedSignInErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(ar.URL, ar.ID, okResultInfo, nil)
+	return nil
+}
+
+// SAMLSignIn consumes the response posted by the identity provider
+// and signs in with the asserted identity as FederatedSignIn does.
+// Only responses to a request issued by SAMLAuthRequest are accepted.
+func (s *Service) SAMLSignIn(req tp.SAMLSignInReq, res *tp.FederatedSignInRes) error {
+	sp, ok := s.samlSPs.Get(req.Provider)
+	if !ok {
+		res.FromModel(nil, unknownProviderErr, ErrUnknownProvider)
+		return ErrUnknownProvider
+	}
+
+	if req.Link && req.Origin.ActorID == "" {
+		res.FromModel(nil, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	sid, err := sp.ParseResponse(req.SAMLResponse, req.RequestID)
+	if err != nil {
+		s.recordFailure(newEvent(req.Origin, userFederatedSignInFailedEvt, userTarget, "", meta{"provider": sp.Source()}))
+		res.FromModel(nil, federatedSignInErr, err)
+		return err
+	}
+
+	// Providers trusted to link by email are trusted to verify it.
+	id := oidc.Identity{
+		Provider:      sid.Provider,
+		Subject:       sid.Subject,
+		Email:         sid.Email,
+		EmailVerified: sp.LinkByEmail,
+		Username:      sid.Username,
+		GivenName:     sid.GivenName,
+		FamilyName:    sid.FamilyName,
+	}
+
+	opts := identitySignIn{
+		method:      "saml",
+		link:        req.Link,
+		linkByEmail: sp.LinkByEmail,
+		provision:   sp.Provision,
+	}
+
+	return s.signInIdentity(id, opts, req.Origin, res)
+}
+
+// SAMLMetadata returns the service provider metadata of req.Provider
+// or, if empty, the identity provider one.
+func (s *Service) SAMLMetadata(req tp.SAMLMetadataReq, res *tp.SAMLMetadataRes) error {
+	if req.Provider == "" {
+		if s.samlIdP == nil {
+			res.FromModel(nil, unknownProviderErr, ErrIdPDisabled)
+			return ErrIdPDisabled
+		}
+
+		res.FromModel(s.samlIdP.Metadata(), okResultInfo, nil)
+		return nil
+	}
+
+	sp, ok := s.samlSPs.Get(req.Provider)
+	if !ok {
+		res.FromModel(nil, unknownProviderErr, ErrUnknownProvider)
+		return ErrUnknownProvider
+	}
+
+	// Output
+	res.FromModel(sp.Metadata(), okResultInfo, nil)
+	return nil
+}
+
+// SAMLAssert answers a service provider authentication request
+// with a signed assertion about the signed in user.
+// Email is only asserted once confirmed.
+func (s *Service) SAMLAssert(req tp.SAMLAssertReq, res *tp.SAMLAssertRes) error {
+	if s.samlIdP == nil {
+		res.FromModel("", "", "", unknownServiceProviderErr, ErrIdPDisabled)
+		return ErrIdPDisabled
+	}
+
+	if req.Origin.ActorID == "" {
+		res.FromModel("", "", "", forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	sr, err := s.samlIdP.ParseRequest(req.SAMLRequest, req.RelayState)
+	if err == saml.ErrUnknownServiceProvider {
+		res.FromModel("", "", "", unknownServiceProviderErr, err)
+		return err
+	}
+
+	if err != nil {
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel("", "", "", cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.Get(req.Origin.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	roles, err := s.repo.RoleRepo(repo.Tx).GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	sub := saml.Subject{
+		ID:         u.ID.String(),
+		Username:   u.Username.String,
+		GivenName:  u.GivenName.String,
+		FamilyName: u.FamilyName.String,
+		Roles:      roleNames(roles),
+	}
+
+	if u.IsConfirmed.Bool {
+		sub.Email = u.Email.String
+	}
+
+	sres, err := s.samlIdP.Respond(sr, sub)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"session": req.Origin.SessionID, "peer": sr.Peer.Name}
+
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userIdentityAssertedEvt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel("", "", "", samlAssertErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(sres.ACSURL, sres.SAMLResponse, sres.RelayState, okResultInfo, nil)
+	return nil
+}
+
+// roleNames returns the distinct names of roles, whatever their source.
+func roleNames(roles []model.Role) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, r := range roles {
+		if !seen[r.Name] {
+			seen[r.Name] = true
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
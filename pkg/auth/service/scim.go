@@ -0,0 +1,1275 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/scim"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	// scimSource marks audit events of provisioning clients.
+	scimSource = "scim"
+	// Group display names are stored as account names.
+	maxSCIMGroupName = 64
+	// Resource endpoints
+	scimUsersPath  = "Users"
+	scimGroupsPath = "Groups"
+)
+
+// SCIMTenant returns the tenant a provisioning client bearer token belongs to.
+func (s *Service) SCIMTenant(token string) (tenant string, ok bool) {
+	return s.scimTokens.Tenant(token)
+}
+
+// SCIMServiceProviderConfig describes the SCIM features supported.
+func (s *Service) SCIMServiceProviderConfig(req tp.SCIMReq, res *tp.SCIMRes) error {
+	res.FromModel(http.StatusOK, scim.ServiceProviderConfig(s.scimBaseURL()), "", "", okResultInfo, nil)
+	return nil
+}
+
+// SCIMResourceTypes lists the SCIM resource types served.
+func (s *Service) SCIMResourceTypes(req tp.SCIMReq, res *tp.SCIMRes) error {
+	rts := scim.ResourceTypes(s.scimBaseURL())
+	lr := scim.NewListResponse(rts, len(rts), 1)
+	res.FromModel(http.StatusOK, lr, "", "", okResultInfo, nil)
+	return nil
+}
+
+// SCIMListUsers returns the tenant users matching the request filter.
+// Reads are not audited, provisioning clients poll them continuously.
+func (s *Service) SCIMListUsers(req tp.SCIMReq, res *tp.SCIMRes) error {
+	f, err := scimFilter(req.Filter)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	us, err := repo.GetAllByTenant(req.Tenant)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	groups, err := s.scimUserGroups(repo.Tx, req.Tenant)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	var matched []interface{}
+	for _, u := range us {
+		su := s.toSCIMUser(u, groups[u.ID.String()])
+
+		ok, err := scimMatch(f, su)
+		if err != nil {
+			res.FromModel(0, nil, "", "", cannotProcErr, err)
+			return err
+		}
+
+		if ok {
+			matched = append(matched, su)
+		}
+	}
+
+	// Output
+	from, to := scim.Page(len(matched), req.StartIndex, req.Count)
+	lr := scim.NewListResponse(matched[from:to], len(matched), req.StartIndex)
+	res.FromModel(http.StatusOK, lr, "", "", okResultInfo, nil)
+	return nil
+}
+
+// SCIMGetUser returns a tenant user.
+func (s *Service) SCIMGetUser(req tp.SCIMReq, res *tp.SCIMRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	su, _, err := s.getSCIMUser(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusOK, su, su.Meta.Version, su.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// SCIMCreateUser creates a tenant user.
+// The provisioning client vouches for the user email, it is not confirmed
+// through mail. Without password users sign in through an identity provider
+// or a password reset.
+func (s *Service) SCIMCreateUser(req tp.SCIMReq, res *tp.SCIMRes) error {
+	var su scim.User
+
+	err := scim.Decode(req.Body, &su)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	// Model
+	u := model.User{}
+	u.TenantID = db.ToNullString(req.Tenant)
+	applySCIMUser(&u, su)
+
+	// Validation
+	v := s.newUserValidator(u, nil)
+
+	err = v.ValidateForSCIM()
+	if err != nil {
+		err = scimValidationErr(v)
+		res.FromModel(0, nil, "", "", validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	err = s.checkSCIMUserUnique(repo.Tx, &u)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createUserErr, err)
+		return err
+	}
+
+	u.GenAutoConfirmationToken()
+	u.Slug = scimSlug(u.Username.String)
+
+	err = repo.Create(&u)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createUserErr, err)
+		return err
+	}
+
+	if !su.IsActive() {
+		err = repo.SetActive(u.ID.String(), false)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(0, nil, "", "", createUserErr, err)
+			return err
+		}
+		u.IsActive = db.ToNullBool(false)
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, scimEvent(req, userCreatedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", createUserErr, err)
+		return err
+	}
+
+	// Output
+	out := s.toSCIMUser(u, nil)
+	res.FromModel(http.StatusCreated, out, out.Meta.Version, out.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// SCIMReplaceUser replaces a tenant user attributes with the request ones.
+func (s *Service) SCIMReplaceUser(req tp.SCIMReq, res *tp.SCIMRes) error {
+	var su scim.User
+
+	err := scim.Decode(req.Body, &su)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	return s.modifySCIMUser(req, res, func(scim.User) (scim.User, error) {
+		return su, nil
+	})
+}
+
+// SCIMPatchUser applies the request patch operations to a tenant user.
+func (s *Service) SCIMPatchUser(req tp.SCIMReq, res *tp.SCIMRes) error {
+	pr, err := scim.ParsePatch(req.Body)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	return s.modifySCIMUser(req, res, func(current scim.User) (scim.User, error) {
+		var su scim.User
+
+		m, err := scim.ToMap(current)
+		if err != nil {
+			return su, err
+		}
+
+		err = pr.Apply(m)
+		if err != nil {
+			return su, err
+		}
+
+		return su, scim.FromMap(m, &su)
+	})
+}
+
+// modifySCIMUser updates a tenant user to the resource returned by modify.
+// Deactivated users and those whose password is set are signed out.
+func (s *Service) modifySCIMUser(req tp.SCIMReq, res *tp.SCIMRes, modify func(scim.User) (scim.User, error)) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	current, u, err := s.getSCIMUser(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getUserErr, err)
+		return err
+	}
+
+	err = checkSCIMVersion(req.IfMatch, current.Meta.Version)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateUserErr, err)
+		return err
+	}
+
+	su, err := modify(current)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateUserErr, err)
+		return err
+	}
+
+	if su.ID != "" && su.ID != current.ID {
+		err = scim.BadRequest(scim.Mutability, "id can not be changed")
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateUserErr, err)
+		return err
+	}
+
+	err = s.updateSCIMUser(repo.Tx, req, &u, su)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", updateUserErr, err)
+		return err
+	}
+
+	// Output
+	out := s.toSCIMUser(u, current.Groups)
+	res.FromModel(http.StatusOK, out, out.Meta.Version, out.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// updateSCIMUser stores su attributes in u.
+func (s *Service) updateSCIMUser(tx *sqlx.Tx, req tp.SCIMReq, u *model.User, su scim.User) error {
+	ur := s.repo.UserRepo(tx)
+	current := *u
+	id := u.ID.String()
+
+	applySCIMUser(u, su)
+
+	// Password history
+	// The current password counts as the most recent one.
+	var history []string
+	if u.Password != "" {
+		policy := s.passwords.For(u.TenantID.String)
+
+		h, err := ur.GetPasswordHistory(id, policy.History)
+		if err != nil {
+			return err
+		}
+		history = append([]string{current.PasswordDigest.String}, h...)
+	}
+
+	// Validation
+	v := s.newUserValidator(*u, history)
+
+	err := v.ValidateForSCIM()
+	if err != nil {
+		return scimValidationErr(v)
+	}
+
+	err = s.checkSCIMUserUnique(tx, u)
+	if err != nil {
+		return err
+	}
+
+	// Password is set apart to keep its history.
+	password := u.Password
+	u.Password = ""
+	u.PasswordDigest = current.PasswordDigest
+
+	err = ur.Update(u)
+	if err != nil && !isNoChanges(err) {
+		return err
+	}
+
+	if err == nil {
+		err = s.recordEvent(tx, scimEvent(req, userUpdatedEvt, userTarget, u.Slug.String, nil))
+		if err != nil {
+			return err
+		}
+	}
+
+	if password != "" {
+		u.Password = password
+
+		digest, err := u.UpdatePasswordDigest()
+		if err != nil {
+			return err
+		}
+		u.Password = ""
+
+		err = ur.UpdatePassword(id, digest, req.ActorID)
+		if err != nil {
+			return err
+		}
+
+		if current.PasswordDigest.Valid {
+			err = ur.AddPasswordHistory(id, current.PasswordDigest.String)
+			if err != nil {
+				return err
+			}
+		}
+
+		revoked, err := s.repo.SessionRepo(tx).RevokeAll(id)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordEvent(tx, scimEvent(req, userPasswordChangedEvt, userTarget, u.Slug.String, meta{"revoked": revoked}))
+		if err != nil {
+			return err
+		}
+	}
+
+	active := su.IsActive()
+	if active == isActiveUser(current) {
+		return nil
+	}
+
+	err = ur.SetActive(id, active)
+	if err != nil {
+		return err
+	}
+	u.IsActive = db.ToNullBool(active)
+
+	if active {
+		return s.recordEvent(tx, scimEvent(req, userActivatedEvt, userTarget, u.Slug.String, nil))
+	}
+
+	count, err := s.repo.SessionRepo(tx).RevokeAll(id)
+	if err != nil {
+		return err
+	}
+
+	return s.recordEvent(tx, scimEvent(req, userDeactivatedEvt, userTarget, u.Slug.String, meta{"sessions": count}))
+}
+
+// SCIMDeleteUser deletes a tenant user and signs it out.
+// Like other deletions the user is purged after the retention period.
+func (s *Service) SCIMDeleteUser(req tp.SCIMReq, res *tp.SCIMRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	current, u, err := s.getSCIMUser(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getUserErr, err)
+		return err
+	}
+
+	err = checkSCIMVersion(req.IfMatch, current.Meta.Version)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	id := u.ID.String()
+
+	err = s.checkOwnsNoAccounts(repo.Tx, id)
+	if err == ErrOwnsAccounts {
+		err = scim.NewError(http.StatusConflict, "", "user owns accounts, transfer their ownership first")
+	}
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	err = repo.Delete(id, "")
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	count, err := s.repo.SessionRepo(repo.Tx).RevokeAll(id)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, scimEvent(req, userDeletedEvt, userTarget, u.Slug.String, meta{"sessions": count}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", deleteUserErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusNoContent, nil, "", "", okResultInfo, nil)
+	return nil
+}
+
+// SCIMListGroups returns the tenant groups matching the request filter.
+func (s *Service) SCIMListGroups(req tp.SCIMReq, res *tp.SCIMRes) error {
+	f, err := scimFilter(req.Filter)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	as, err := repo.GetAllByType(req.Tenant, model.AccountTypeGroup)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getAllAccountErr, err)
+		return err
+	}
+
+	usernames, err := s.scimUsernames(repo.Tx, req.Tenant)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getAllAccountErr, err)
+		return err
+	}
+
+	var matched []interface{}
+	for _, a := range as {
+		g, err := s.loadSCIMGroup(repo.Tx, a, usernames)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(0, nil, "", "", getAllAccountErr, err)
+			return err
+		}
+
+		ok, err := scimMatch(f, g)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(0, nil, "", "", getAllAccountErr, err)
+			return err
+		}
+
+		if ok {
+			matched = append(matched, g)
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", getAllAccountErr, err)
+		return err
+	}
+
+	// Output
+	from, to := scim.Page(len(matched), req.StartIndex, req.Count)
+	lr := scim.NewListResponse(matched[from:to], len(matched), req.StartIndex)
+	res.FromModel(http.StatusOK, lr, "", "", okResultInfo, nil)
+	return nil
+}
+
+// SCIMGetGroup returns a tenant group.
+func (s *Service) SCIMGetGroup(req tp.SCIMReq, res *tp.SCIMRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	g, _, err := s.getSCIMGroup(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", getAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusOK, g, g.Meta.Version, g.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// SCIMCreateGroup creates a tenant group, an account of type group
+// whose members are tenant users.
+func (s *Service) SCIMCreateGroup(req tp.SCIMReq, res *tp.SCIMRes) error {
+	var g scim.Group
+
+	err := scim.Decode(req.Body, &g)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	// Model
+	a := model.Account{
+		AccountType: db.ToNullString(model.AccountTypeGroup),
+	}
+	a.TenantID = db.ToNullString(req.Tenant)
+
+	err = applySCIMGroup(&a, g)
+	if err != nil {
+		res.FromModel(0, nil, "", "", validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	usernames, err := s.scimUsernames(repo.Tx, req.Tenant)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	members, err := scimMemberIDs(g, usernames)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", validationErr, err)
+		return err
+	}
+
+	err = s.checkSCIMGroupUnique(repo.Tx, &a)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	a.Slug = scimSlug(a.Name.String)
+
+	err = repo.Create(&a)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	err = s.repo.MemberRepo(repo.Tx).Replace(a.ID.String(), members)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, scimEvent(req, accountCreatedEvt, accountTarget, a.Slug.String, meta{"members": len(members)}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	out, err := s.loadSCIMGroup(repo.Tx, a, usernames)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", createAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusCreated, out, out.Meta.Version, out.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// SCIMReplaceGroup replaces a tenant group attributes and members.
+func (s *Service) SCIMReplaceGroup(req tp.SCIMReq, res *tp.SCIMRes) error {
+	var g scim.Group
+
+	err := scim.Decode(req.Body, &g)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	return s.modifySCIMGroup(req, res, func(scim.Group) (scim.Group, error) {
+		return g, nil
+	})
+}
+
+// SCIMPatchGroup applies the request patch operations to a tenant group,
+// mostly used to add and remove members.
+func (s *Service) SCIMPatchGroup(req tp.SCIMReq, res *tp.SCIMRes) error {
+	pr, err := scim.ParsePatch(req.Body)
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	return s.modifySCIMGroup(req, res, func(current scim.Group) (scim.Group, error) {
+		var g scim.Group
+
+		m, err := scim.ToMap(current)
+		if err != nil {
+			return g, err
+		}
+
+		err = pr.Apply(m)
+		if err != nil {
+			return g, err
+		}
+
+		return g, scim.FromMap(m, &g)
+	})
+}
+
+// modifySCIMGroup updates a tenant group to the resource returned by modify.
+func (s *Service) modifySCIMGroup(req tp.SCIMReq, res *tp.SCIMRes, modify func(scim.Group) (scim.Group, error)) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	current, a, err := s.getSCIMGroup(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getAccountErr, err)
+		return err
+	}
+
+	err = checkSCIMVersion(req.IfMatch, current.Meta.Version)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	g, err := modify(current)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	if g.ID != "" && g.ID != current.ID {
+		err = scim.BadRequest(scim.Mutability, "id can not be changed")
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	err = applySCIMGroup(&a, g)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", validationErr, err)
+		return err
+	}
+
+	usernames, err := s.scimUsernames(repo.Tx, req.Tenant)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	members, err := scimMemberIDs(g, usernames)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", validationErr, err)
+		return err
+	}
+
+	err = s.checkSCIMGroupUnique(repo.Tx, &a)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	err = repo.Update(&a)
+	if err != nil && !isNoChanges(err) {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	err = s.repo.MemberRepo(repo.Tx).Replace(a.ID.String(), members)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	out, err := s.loadSCIMGroup(repo.Tx, a, usernames)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	// Audit
+	if out.Meta.Version != current.Meta.Version {
+		err = s.recordEvent(repo.Tx, scimEvent(req, accountUpdatedEvt, accountTarget, a.Slug.String, meta{"members": len(members)}))
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(0, nil, "", "", updateAccountErr, err)
+			return err
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", updateAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusOK, out, out.Meta.Version, out.Meta.Location, okResultInfo, nil)
+	return nil
+}
+
+// SCIMDeleteGroup deletes a tenant group, its members are kept.
+func (s *Service) SCIMDeleteGroup(req tp.SCIMReq, res *tp.SCIMRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(0, nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	current, a, err := s.getSCIMGroup(repo.Tx, req.Tenant, req.ID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", getAccountErr, err)
+		return err
+	}
+
+	err = checkSCIMVersion(req.IfMatch, current.Meta.Version)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteAccountErr, err)
+		return err
+	}
+
+	err = repo.Delete(a.ID.String(), "")
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteAccountErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, scimEvent(req, accountDeletedEvt, accountTarget, a.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, nil, "", "", deleteAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, nil, "", "", deleteAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(http.StatusNoContent, nil, "", "", okResultInfo, nil)
+	return nil
+}
+
+// getSCIMUser returns a tenant user and its SCIM representation.
+func (s *Service) getSCIMUser(tx *sqlx.Tx, tenant, id string) (scim.User, model.User, error) {
+	var su scim.User
+
+	if _, err := uuid.FromString(id); err != nil {
+		return su, model.User{}, scim.NotFound(id)
+	}
+
+	u, err := s.repo.UserRepo(tx).GetByTenant(tenant, id)
+	if err == sql.ErrNoRows {
+		return su, u, scim.NotFound(id)
+	}
+	if err != nil {
+		return su, u, err
+	}
+
+	ms, err := s.repo.MemberRepo(tx).GetByUser(id)
+	if err != nil {
+		return su, u, err
+	}
+
+	var groups []scim.Member
+	for _, m := range ms {
+		a, err := s.repo.AccountRepo(tx).GetByType(tenant, model.AccountTypeGroup, m.AccountID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return su, u, err
+		}
+
+		groups = append(groups, s.scimGroupRef(a))
+	}
+
+	return s.toSCIMUser(u, groups), u, nil
+}
+
+// getSCIMGroup returns a tenant group and its SCIM representation.
+func (s *Service) getSCIMGroup(tx *sqlx.Tx, tenant, id string) (scim.Group, model.Account, error) {
+	var g scim.Group
+
+	if _, err := uuid.FromString(id); err != nil {
+		return g, model.Account{}, scim.NotFound(id)
+	}
+
+	a, err := s.repo.AccountRepo(tx).GetByType(tenant, model.AccountTypeGroup, id)
+	if err == sql.ErrNoRows {
+		return g, a, scim.NotFound(id)
+	}
+	if err != nil {
+		return g, a, err
+	}
+
+	usernames, err := s.scimUsernames(tx, tenant)
+	if err != nil {
+		return g, a, err
+	}
+
+	g, err = s.loadSCIMGroup(tx, a, usernames)
+	return g, a, err
+}
+
+// loadSCIMGroup returns the SCIM representation of a group account.
+// usernames maps tenant user IDs to their usernames.
+func (s *Service) loadSCIMGroup(tx *sqlx.Tx, a model.Account, usernames map[string]string) (scim.Group, error) {
+	ms, err := s.repo.MemberRepo(tx).GetByAccount(a.ID.String())
+	if err != nil {
+		return scim.Group{}, err
+	}
+
+	var members []scim.Member
+	for _, m := range ms {
+		name, ok := usernames[m.UserID]
+		if !ok {
+			// Deleted users
+			continue
+		}
+
+		members = append(members, scim.Member{
+			Value:   m.UserID,
+			Ref:     s.scimLocation(scimUsersPath, m.UserID),
+			Display: name,
+			Type:    "User",
+		})
+	}
+
+	g := scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		ID:          a.ID.String(),
+		ExternalID:  a.ExternalID.String,
+		DisplayName: a.Name.String,
+		Members:     members,
+		Meta:        scimMeta("Group", s.scimLocation(scimGroupsPath, a.ID.String()), a.CreatedAt, a.UpdatedAt),
+	}
+	g.Meta.Version = g.Version()
+
+	return g, nil
+}
+
+// scimUserGroups returns the group references of each tenant user.
+func (s *Service) scimUserGroups(tx *sqlx.Tx, tenant string) (map[string][]scim.Member, error) {
+	as, err := s.repo.AccountRepo(tx).GetAllByType(tenant, model.AccountTypeGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string][]scim.Member{}
+	for _, a := range as {
+		ms, err := s.repo.MemberRepo(tx).GetByAccount(a.ID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		ref := s.scimGroupRef(a)
+		for _, m := range ms {
+			groups[m.UserID] = append(groups[m.UserID], ref)
+		}
+	}
+
+	return groups, nil
+}
+
+// scimUsernames maps tenant user IDs to their usernames.
+func (s *Service) scimUsernames(tx *sqlx.Tx, tenant string) (map[string]string, error) {
+	us, err := s.repo.UserRepo(tx).GetAllByTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(us))
+	for _, u := range us {
+		names[u.ID.String()] = u.Username.String
+	}
+
+	return names, nil
+}
+
+// checkSCIMUserUnique returns a uniqueness error if username, email or
+// external ID belong to other user.
+func (s *Service) checkSCIMUserUnique(tx *sqlx.Tx, u *model.User) error {
+	ur := s.repo.UserRepo(tx)
+
+	taken := func(other model.User, err error) (bool, error) {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return other.ID != u.ID, nil
+	}
+
+	ok, err := taken(ur.GetByUsername(u.Username.String))
+	if err != nil || ok {
+		return scimTakenErr(err, "userName", u.Username.String)
+	}
+
+	ok, err = taken(ur.GetByEmail(u.Email.String))
+	if err != nil || ok {
+		return scimTakenErr(err, "email", u.Email.String)
+	}
+
+	if !u.ExternalID.Valid {
+		return nil
+	}
+
+	ok, err = taken(ur.GetByExternalID(u.TenantID.String, u.ExternalID.String))
+	if err != nil || ok {
+		return scimTakenErr(err, "externalId", u.ExternalID.String)
+	}
+
+	return nil
+}
+
+// checkSCIMGroupUnique returns a uniqueness error if the external ID
+// belongs to other group.
+func (s *Service) checkSCIMGroupUnique(tx *sqlx.Tx, a *model.Account) error {
+	if !a.ExternalID.Valid {
+		return nil
+	}
+
+	other, err := s.repo.AccountRepo(tx).GetByExternalID(a.TenantID.String, a.ExternalID.String)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if other.ID == a.ID {
+		return nil
+	}
+
+	return scimTakenErr(nil, "externalId", a.ExternalID.String)
+}
+
+func scimTakenErr(err error, attr, val string) error {
+	if err != nil {
+		return err
+	}
+	return scim.NewError(http.StatusConflict, scim.Uniqueness, "%s '%s' is taken", attr, val)
+}
+
+// toSCIMUser returns the SCIM representation of a user.
+func (s *Service) toSCIMUser(u model.User, groups []scim.Member) scim.User {
+	active := scim.Bool(isActiveUser(u))
+
+	su := scim.User{
+		Schemas:     []string{scim.UserSchema},
+		ID:          u.ID.String(),
+		ExternalID:  u.ExternalID.String,
+		UserName:    u.Username.String,
+		DisplayName: strings.TrimSpace(u.GivenName.String + " " + u.FamilyName.String),
+		Active:      &active,
+		Groups:      groups,
+		Meta:        scimMeta("User", s.scimLocation(scimUsersPath, u.ID.String()), u.CreatedAt, u.UpdatedAt),
+	}
+
+	if u.GivenName.String != "" || u.FamilyName.String != "" {
+		su.Name = &scim.Name{
+			GivenName:  u.GivenName.String,
+			FamilyName: u.FamilyName.String,
+		}
+	}
+
+	if u.Email.String != "" {
+		su.Emails = []scim.MultiValue{
+			{Value: u.Email.String, Type: "work", Primary: true},
+		}
+	}
+
+	su.Meta.Version = su.Version()
+	return su
+}
+
+// applySCIMUser sets the user attributes Granica keeps.
+// Display name is derived from given and family names.
+func applySCIMUser(u *model.User, su scim.User) {
+	email := su.PrimaryEmail()
+
+	u.Username = db.ToNullString(su.UserName)
+	u.Email = db.ToNullString(email)
+	u.EmailConfirmation = db.ToNullString(email)
+	u.ExternalID = db.ToNullString(su.ExternalID)
+	u.Password = su.Password
+
+	u.GivenName = db.ToNullString("")
+	u.FamilyName = db.ToNullString("")
+	if su.Name != nil {
+		u.GivenName = db.ToNullString(su.Name.GivenName)
+		u.FamilyName = db.ToNullString(su.Name.FamilyName)
+	}
+}
+
+// applySCIMGroup sets the group account attributes.
+func applySCIMGroup(a *model.Account, g scim.Group) error {
+	name := strings.TrimSpace(g.DisplayName)
+	if name == "" || len(name) > maxSCIMGroupName {
+		return scim.BadRequest(scim.InvalidValue, "displayName must have between 1 and %d characters", maxSCIMGroupName)
+	}
+
+	a.Name = db.ToNullString(name)
+	a.ExternalID = db.ToNullString(g.ExternalID)
+	return nil
+}
+
+// scimMemberIDs returns the user IDs of group members,
+// only tenant users can be members.
+func scimMemberIDs(g scim.Group, usernames map[string]string) ([]string, error) {
+	seen := map[string]bool{}
+	ids := []string{}
+
+	for _, m := range g.Members {
+		if m.Type != "" && !strings.EqualFold(m.Type, "User") {
+			return nil, scim.BadRequest(scim.InvalidValue, "only users can be group members")
+		}
+
+		if _, ok := usernames[m.Value]; !ok {
+			return nil, scim.BadRequest(scim.InvalidValue, "member '%s' is not a user", m.Value)
+		}
+
+		if !seen[m.Value] {
+			seen[m.Value] = true
+			ids = append(ids, m.Value)
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *Service) scimGroupRef(a model.Account) scim.Member {
+	return scim.Member{
+		Value:   a.ID.String(),
+		Ref:     s.scimLocation(scimGroupsPath, a.ID.String()),
+		Display: a.Name.String,
+		Type:    "direct",
+	}
+}
+
+func (s *Service) scimBaseURL() string {
+	return s.siteLink("scim/v2")
+}
+
+func (s *Service) scimLocation(resources, id string) string {
+	return fmt.Sprintf("%s/%s/%s", s.scimBaseURL(), resources, id)
+}
+
+func scimMeta(resourceType, location string, created, updated pq.NullTime) *scim.Meta {
+	m := &scim.Meta{
+		ResourceType: resourceType,
+		Location:     location,
+	}
+
+	if created.Valid {
+		m.Created = created.Time.UTC().Format(time.RFC3339)
+		m.LastModified = m.Created
+	}
+
+	if updated.Valid {
+		m.LastModified = updated.Time.UTC().Format(time.RFC3339)
+	}
+
+	return m
+}
+
+// scimFilter parses filter, nil if empty.
+func scimFilter(filter string) (scim.Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	return scim.ParseFilter(filter)
+}
+
+// scimMatch reports if resource matches f, all do if f is nil.
+func scimMatch(f scim.Filter, resource interface{}) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+
+	m, err := scim.ToMap(resource)
+	if err != nil {
+		return false, err
+	}
+
+	return f.Match(m), nil
+}
+
+// checkSCIMVersion enforces If-Match preconditions.
+func checkSCIMVersion(ifMatch, version string) error {
+	if ifMatch == "" || scim.MatchVersion(ifMatch, version) {
+		return nil
+	}
+
+	return scim.NewError(http.StatusPreconditionFailed, "", "resource version is %s", version)
+}
+
+// scimValidationErr describes validation errors to the provisioning client.
+func scimValidationErr(v UserValidator) error {
+	fields := make([]string, 0, len(v.Errors))
+	for f := range v.Errors {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	var msgs []string
+	for _, f := range fields {
+		if f == "EmailConfirmation" {
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: %s", f, strings.Join(v.Errors[f], ", ")))
+	}
+
+	return scim.BadRequest(scim.InvalidValue, "%s", strings.Join(msgs, "; "))
+}
+
+// scimSlug returns a slug for name that fits the column,
+// the ones derived from long user or group names would not.
+func scimSlug(name string) sql.NullString {
+	pfx := strings.SplitN(strings.ToLower(name), "@", 2)[0]
+	pfx = usernameInvalidChars.ReplaceAllString(pfx, "")
+	pfx = strings.NewReplacer("-", "", "_", "").Replace(pfx)
+	if len(pfx) > maxProvisionedUsername {
+		pfx = pfx[:maxProvisionedUsername]
+	}
+	if pfx == "" {
+		pfx = scimSource
+	}
+
+	parts := strings.Split(uuid.NewV4().String(), "-")
+	return db.ToNullString(pfx + "-" + parts[len(parts)-1])
+}
+
+// scimEvent builds an audit event for a change issued by
+// a tenant provisioning client.
+func scimEvent(req tp.SCIMReq, action, targetType, targetID string, md meta) *model.AuditEvent {
+	if md == nil {
+		md = meta{}
+	}
+	md["source"] = scimSource
+
+	e := newEvent(req.Origin, action, targetType, targetID, md)
+	e.TenantID = db.ToNullString(req.Tenant)
+	return e
+}
+
+func isActiveUser(u model.User) bool {
+	return !u.IsActive.Valid || u.IsActive.Bool
+}
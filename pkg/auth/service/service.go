@@ -6,23 +6,45 @@ import (
 	"gitlab.com/mikrowezel/backend/log"
 
 	"gitlab.com/mikrowezel/backend/config"
+	"gitlab.com/mikrowezel/backend/granica/internal/bounce"
+	"gitlab.com/mikrowezel/backend/granica/internal/geo"
+	"gitlab.com/mikrowezel/backend/granica/internal/ldap"
 	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/oauth"
+	"gitlab.com/mikrowezel/backend/granica/internal/oidc"
+	"gitlab.com/mikrowezel/backend/granica/internal/password"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/granica/internal/saml"
+	"gitlab.com/mikrowezel/backend/granica/internal/scim"
 )
 
 type Service struct {
-	ctx  context.Context
-	cfg  *config.Config
-	log  *log.Logger
-	repo *repo.Repo
-	mailer *mailer.SESMailer
+	ctx           context.Context
+	cfg           *config.Config
+	log           *log.Logger
+	repo          *repo.Repo
+	mailer        mailer.Mailer
+	mailTemplates *mailer.Templates
+	geo           geo.Resolver
+	sns           *bounce.SNSVerifier
+	passwords     *password.Policies
+	breached      password.Corpus
+	idps          *oidc.Providers
+	dirs          *ldap.Directories
+	samlSPs       *saml.ServiceProviders
+	samlIdP       *saml.IdentityProvider
+	scimTokens    *scim.Tokens
+	oauth         *oauth.Issuer
 }
 
 func MakeService(ctx context.Context, cfg *config.Config, log *log.Logger) *Service {
 	return &Service{
-		ctx: ctx,
-		cfg: cfg,
-		log: log,
+		ctx:      ctx,
+		cfg:      cfg,
+		log:      log,
+		geo:      geo.NopResolver{},
+		sns:      bounce.NewSNSVerifier(),
+		breached: password.NopCorpus{},
 	}
 }
 
@@ -44,6 +66,58 @@ func (s *Service) SetRepo(repo *repo.Repo) {
 }
 
 // Mailer
-func (s *Service) SetMailer(mailer *mailer.SESMailer) {
+func (s *Service) SetMailer(mailer mailer.Mailer) {
 	s.mailer = mailer
 }
+
+// SetMailTemplates used to render emails.
+func (s *Service) SetMailTemplates(t *mailer.Templates) {
+	s.mailTemplates = t
+}
+
+// GeoResolver
+func (s *Service) SetGeoResolver(r geo.Resolver) {
+	s.geo = r
+}
+
+// SetPasswordPolicies applied to new passwords.
+func (s *Service) SetPasswordPolicies(pp *password.Policies) {
+	s.passwords = pp
+}
+
+// SetBreachedCorpus used to reject known leaked passwords.
+func (s *Service) SetBreachedCorpus(c password.Corpus) {
+	s.breached = c
+}
+
+// SetIdentityProviders users can sign in with.
+func (s *Service) SetIdentityProviders(pp *oidc.Providers) {
+	s.idps = pp
+}
+
+// SetDirectories users of a tenant authenticate against.
+func (s *Service) SetDirectories(dd *ldap.Directories) {
+	s.dirs = dd
+}
+
+// SetSAMLServiceProviders for the SAML identity providers users can sign in with.
+func (s *Service) SetSAMLServiceProviders(sps *saml.ServiceProviders) {
+	s.samlSPs = sps
+}
+
+// SetSAMLIdentityProvider to assert users identity to SAML service providers,
+// nil if disabled.
+func (s *Service) SetSAMLIdentityProvider(idp *saml.IdentityProvider) {
+	s.samlIdP = idp
+}
+
+// SetSCIMTokens to authenticate tenant provisioning clients.
+func (s *Service) SetSCIMTokens(t *scim.Tokens) {
+	s.scimTokens = t
+}
+
+// SetOAuthIssuer to issue access tokens to service accounts,
+// nil if disabled.
+func (s *Service) SetOAuthIssuer(i *oauth.Issuer) {
+	s.oauth = i
+}
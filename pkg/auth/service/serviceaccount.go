@@ -0,0 +1,598 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	uuid "github.com/satori/go.uuid"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/oauth"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	serviceAccountCreatedInfo      = "service_account_created_info"
+	serviceAccountDeletedInfo      = "service_account_deleted_info"
+	clientCredentialCreatedInfo    = "client_credential_created_info"
+	clientCredentialRevokedInfo    = "client_credential_revoked_info"
+	getServiceAccountsErr          = "cannot_get_service_accounts_err"
+	createServiceAccountErr        = "cannot_create_service_account_err"
+	deleteServiceAccountErr        = "cannot_delete_service_account_err"
+	getClientCredentialsErr        = "cannot_get_client_credentials_err"
+	createClientCredentialErr      = "cannot_create_client_credential_err"
+	revokeClientCredentialErr      = "cannot_revoke_client_credential_err"
+	issueTokenErr                  = "cannot_issue_token_err"
+	defaultClientCredentialTTLDays = 365
+	defaultClientCredentialMaxDays = 730
+	clientRejectedAuth             = "authentication_failed"
+	clientRejectedScope            = "scope_not_granted"
+	clientRejectedAccount          = "account_not_active"
+)
+
+var (
+	// ErrServiceAccount is returned when a service account tries to sign in interactively.
+	ErrServiceAccount = errors.New("service accounts cannot sign in")
+	// ErrOAuthDisabled is returned by the token endpoint if not enabled.
+	ErrOAuthDisabled = errors.New("token endpoint not enabled")
+)
+
+// IndexServiceAccounts lists the service accounts of an account.
+func (s *Service) IndexServiceAccounts(req tp.IndexServiceAccountsReq, res *tp.IndexServiceAccountsRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	a, err := s.ownedAccount(repo.Tx, req.Slug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, apiKeyOwnerErr(err, getServiceAccountsErr), err)
+		return err
+	}
+
+	us, err := repo.GetServiceAccounts(a.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getServiceAccountsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, getServiceAccountsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(us, okResultInfo, nil)
+	return nil
+}
+
+// CreateServiceAccount creates a non-human user owned by an account.
+// It has no password nor email, it can only authenticate at the
+// token endpoint once a client credential is added.
+func (s *Service) CreateServiceAccount(req tp.CreateServiceAccountReq, res *tp.CreateServiceAccountRes) error {
+	// Model
+	u := model.User{
+		Username: db.ToNullString(strings.TrimSpace(req.Username)),
+		UserType: model.UserTypeService,
+		IsActive: db.ToNullBool(true),
+	}
+
+	// Validation
+	v := NewUserValidator(u)
+
+	err := v.ValidateForServiceAccount()
+	if err != nil {
+		res.FromModel(nil, v.Errors, validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	a, err := s.ownedAccount(repo.Tx, req.Slug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, apiKeyOwnerErr(err, createServiceAccountErr), err)
+		return err
+	}
+
+	u.AccountID = db.ToNullString(a.ID.String())
+	u.TenantID = a.TenantID
+	u.CreatedByID = db.ToNullString(req.ActorID)
+
+	err = repo.Create(&u)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, createServiceAccountErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"account": a.Slug.String, "username": u.Username.String}
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, serviceAccountCreatedEvt, serviceAccountTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, createServiceAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, createServiceAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, nil, serviceAccountCreatedInfo, nil)
+	return nil
+}
+
+// DeleteServiceAccount deletes a service account of an account,
+// its credentials are revoked.
+func (s *Service) DeleteServiceAccount(req tp.DeleteServiceAccountReq, res *tp.DeleteServiceAccountRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	a, u, err := s.ownedServiceAccount(repo.Tx, req.Slug, req.ServiceAccountSlug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(apiKeyOwnerErr(err, deleteServiceAccountErr), err)
+		return err
+	}
+
+	err = s.repo.ClientCredentialRepo(repo.Tx).RevokeAllByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(deleteServiceAccountErr, err)
+		return err
+	}
+
+	err = repo.Delete(u.ID.String(), req.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(deleteServiceAccountErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"account": a.Slug.String, "username": u.Username.String}
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, serviceAccountDeletedEvt, serviceAccountTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(deleteServiceAccountErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(deleteServiceAccountErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(serviceAccountDeletedInfo, nil)
+	return nil
+}
+
+// IndexClientCredentials lists the credentials of a service account.
+func (s *Service) IndexClientCredentials(req tp.IndexClientCredentialsReq, res *tp.IndexClientCredentialsRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	_, u, err := s.ownedServiceAccount(repo.Tx, req.Slug, req.ServiceAccountSlug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, apiKeyOwnerErr(err, getClientCredentialsErr), err)
+		return err
+	}
+
+	cs, err := s.repo.ClientCredentialRepo(repo.Tx).GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, nil, getClientCredentialsErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(&u, nil, getClientCredentialsErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, cs, okResultInfo, nil)
+	return nil
+}
+
+// CreateClientCredential adds a client secret or a public key to a
+// service account. A generated secret is only returned here, just its
+// digest is stored.
+func (s *Service) CreateClientCredential(req tp.CreateClientCredentialReq, res *tp.CreateClientCredentialRes) error {
+	// Model
+	c := model.ClientCredential{
+		Kind:        req.Kind,
+		PublicKey:   db.ToNullString(strings.TrimSpace(req.PublicKey)),
+		Scopes:      strings.Join(req.Scopes, " "),
+		CreatedByID: db.ToNullString(req.ActorID),
+	}
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = int(s.Cfg().ValAsInt("app.oauth.credential.ttl.days", defaultClientCredentialTTLDays))
+	}
+
+	// Validation
+	v := NewClientCredentialValidator(c)
+
+	err := v.ValidateForCreate(days, int(s.Cfg().ValAsInt("app.oauth.credential.max.ttl.days", defaultClientCredentialMaxDays)))
+	if err != nil {
+		res.FromModel(nil, "", v.Errors, validationErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, "", nil, cannotProcErr, err)
+		return err
+	}
+
+	a, u, err := s.ownedServiceAccount(repo.Tx, req.Slug, req.ServiceAccountSlug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, apiKeyOwnerErr(err, createClientCredentialErr), err)
+		return err
+	}
+
+	c.UserID = u.ID.String()
+
+	var secret string
+	if c.Kind == model.CredentialKindSecret {
+		secret, err = c.GenSecret()
+	} else {
+		err = setKeyID(&c)
+	}
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createClientCredentialErr, err)
+		return err
+	}
+
+	c.SetCreateValues(time.Duration(days) * 24 * time.Hour)
+
+	err = s.repo.ClientCredentialRepo(repo.Tx).Create(&c)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createClientCredentialErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"account": a.Slug.String, "service_account": u.Slug.String, "kind": c.Kind, "scopes": c.Scopes}
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, clientCredentialCreatedEvt, clientCredentialTarget, c.ID.String(), md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", nil, createClientCredentialErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", nil, createClientCredentialErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&c, secret, nil, clientCredentialCreatedInfo, nil)
+	return nil
+}
+
+// RevokeClientCredential revokes a credential of a service account.
+// Access tokens already issued with it stay valid until they expire.
+func (s *Service) RevokeClientCredential(req tp.RevokeClientCredentialReq, res *tp.RevokeClientCredentialRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	a, u, err := s.ownedServiceAccount(repo.Tx, req.Slug, req.ServiceAccountSlug, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(apiKeyOwnerErr(err, revokeClientCredentialErr), err)
+		return err
+	}
+
+	err = s.repo.ClientCredentialRepo(repo.Tx).RevokeByUser(u.ID.String(), req.CredentialID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeClientCredentialErr, err)
+		return err
+	}
+
+	// Audit
+	md := meta{"account": a.Slug.String, "service_account": u.Slug.String}
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, clientCredentialRevokedEvt, clientCredentialTarget, req.CredentialID, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeClientCredentialErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(revokeClientCredentialErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(clientCredentialRevokedInfo, nil)
+	return nil
+}
+
+// IssueToken implements the client credentials grant of the token endpoint.
+// Service accounts authenticate with a client secret or a signed assertion
+// and get a short lived access token for the requested audience and scopes.
+// Client errors are returned as *oauth.Error, rejected authentications of
+// known service accounts are audited. Successful requests are not audited
+// but tracked on the credential.
+func (s *Service) IssueToken(req tp.IssueTokenReq, res *tp.IssueTokenRes) error {
+	if s.oauth == nil {
+		res.FromModel("", 0, nil, cannotProcErr, ErrOAuthDisabled)
+		return ErrOAuthDisabled
+	}
+
+	if req.GrantType == "" {
+		err := oauth.NewError(oauth.InvalidRequest, "missing grant type")
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	if req.GrantType != oauth.GrantClientCredentials {
+		err := oauth.NewError(oauth.UnsupportedGrantType, "grant type '%s' is not supported", req.GrantType)
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	clientID, keyID, err := tokenClient(req)
+	if err != nil {
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	aud, err := s.oauth.Audience(req.Audience)
+	if err != nil {
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	// Repo
+	repo, err := s.clientCredentialRepo()
+	if err != nil {
+		res.FromModel("", 0, nil, cannotProcErr, err)
+		return err
+	}
+
+	u, err := s.repo.UserRepo(repo.Tx).Get(clientID)
+	if err != nil || !u.IsServiceAccount() || !isActiveUser(u) {
+		repo.Tx.Rollback()
+		err = oauth.NewError(oauth.InvalidClient, "unknown client")
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	method := model.CredentialKindSecret
+	if req.ClientAssertion != "" {
+		method = model.CredentialKindPublicKey
+	}
+
+	reject := func(reason string, err error) error {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, clientCredentialRejectedEvt, serviceAccountTarget, u.Slug.String, meta{"reason": reason, "method": method, "scope": req.Scope}))
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	a, err := s.repo.AccountRepo(repo.Tx).Get(u.AccountID.String)
+	if err != nil || (a.IsActive.Valid && !a.IsActive.Bool) {
+		return reject(clientRejectedAccount, oauth.NewError(oauth.InvalidClient, "client not active"))
+	}
+
+	cs, err := repo.GetActiveByUser(clientID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	c, ok := s.clientCredential(req, cs, clientID, keyID)
+	if !ok {
+		return reject(clientRejectedAuth, oauth.NewError(oauth.InvalidClient, "client authentication failed"))
+	}
+
+	scopes, ok := c.GrantScopes(strings.Fields(req.Scope))
+	if !ok {
+		return reject(clientRejectedScope, oauth.NewError(oauth.InvalidScope, "requested scope not granted"))
+	}
+
+	at := oauth.AccessToken{
+		Subject:  clientID,
+		ClientID: clientID,
+		Audience: aud,
+		Scopes:   scopes,
+		Extra:    oauth.Claims{"account": a.Slug.String},
+	}
+
+	token, ttl, err := s.oauth.Issue(at, time.Now())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	err = repo.Touch(c.ID.String(), db.ToNullString(req.IP))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel("", 0, nil, issueTokenErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(token, ttl, scopes, okResultInfo, nil)
+	return nil
+}
+
+// OAuthJWKS returns the keys access tokens are signed with.
+func (s *Service) OAuthJWKS() ([]byte, error) {
+	if s.oauth == nil {
+		return nil, ErrOAuthDisabled
+	}
+
+	return s.oauth.JWKS()
+}
+
+// clientCredential returns the active credential a token request
+// authenticates with. Assertions are checked against the public
+// keys of the client, only against the one they name if they do.
+func (s *Service) clientCredential(req tp.IssueTokenReq, cs []model.ClientCredential, clientID, keyID string) (model.ClientCredential, bool) {
+	now := time.Now()
+
+	for _, c := range cs {
+		if req.ClientAssertion == "" {
+			if c.SecretMatches(req.ClientSecret) {
+				return c, true
+			}
+			continue
+		}
+
+		if c.Kind != model.CredentialKindPublicKey || (keyID != "" && keyID != c.KeyID.String) {
+			continue
+		}
+
+		pub, err := oauth.ParsePublicKey([]byte(c.PublicKey.String))
+		if err != nil {
+			continue
+		}
+
+		err = s.oauth.VerifyAssertion(req.ClientAssertion, pub, clientID, now)
+		if err == nil {
+			return c, true
+		}
+	}
+
+	return model.ClientCredential{}, false
+}
+
+// tokenClient returns the client a token request claims to come from
+// and, for assertions, the ID of the key it is signed with, if any.
+// Exactly one authentication method must be used.
+func tokenClient(req tp.IssueTokenReq) (clientID, keyID string, err error) {
+	switch {
+	case req.ClientAssertion != "":
+		if req.ClientAssertionType != oauth.ClientAssertionType {
+			return "", "", oauth.NewError(oauth.InvalidRequest, "unsupported client assertion type")
+		}
+
+		if req.ClientSecret != "" {
+			return "", "", oauth.NewError(oauth.InvalidRequest, "more than one client authentication method")
+		}
+
+		clientID, keyID, err = oauth.AssertionClientID(req.ClientAssertion)
+		if err != nil {
+			return "", "", oauth.NewError(oauth.InvalidClient, "malformed client assertion")
+		}
+
+		if req.ClientID != "" && req.ClientID != clientID {
+			return "", "", oauth.NewError(oauth.InvalidClient, "client assertion subject mismatch")
+		}
+
+	case req.ClientSecret != "":
+		clientID = req.ClientID
+
+	default:
+		return "", "", oauth.NewError(oauth.InvalidClient, "client authentication required")
+	}
+
+	// Client IDs are service account user IDs.
+	_, err = uuid.FromString(clientID)
+	if err != nil {
+		return "", "", oauth.NewError(oauth.InvalidClient, "unknown client")
+	}
+
+	return clientID, keyID, nil
+}
+
+// ownedAccount resolves the account identified by slug.
+// Service accounts are managed by the account owner,
+// not using an API key.
+func (s *Service) ownedAccount(tx *sqlx.Tx, slug string, o tp.Origin) (model.Account, error) {
+	owner, err := s.apiKeyOwner(tx, slug, true, o)
+	if err != nil {
+		return model.Account{}, err
+	}
+
+	return *owner.account, nil
+}
+
+// ownedServiceAccount resolves a service account of an owned account.
+func (s *Service) ownedServiceAccount(tx *sqlx.Tx, accountSlug, slug string, o tp.Origin) (a model.Account, u model.User, err error) {
+	a, err = s.ownedAccount(tx, accountSlug, o)
+	if err != nil {
+		return a, u, err
+	}
+
+	u, err = s.repo.UserRepo(tx).GetServiceAccountBySlug(a.ID.String(), slug)
+	if err != nil {
+		return a, u, err
+	}
+
+	return a, u, nil
+}
+
+// setKeyID stores the thumbprint of the credential public key.
+func setKeyID(c *model.ClientCredential) error {
+	pub, err := oauth.ParsePublicKey([]byte(c.PublicKey.String))
+	if err != nil {
+		return err
+	}
+
+	kid, err := oauth.KeyID(pub)
+	if err != nil {
+		return err
+	}
+
+	c.KeyID = db.ToNullString(kid)
+	return nil
+}
+
+// Misc
+func (s *Service) clientCredentialRepo() (*repo.ClientCredentialRepo, error) {
+	return s.repo.ClientCredentialRepoNewTx()
+}
@@ -0,0 +1,385 @@
+package service
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	sessionRevokedInfo     = "session_revoked_info"
+	sessionsRevokedInfo    = "sessions_revoked_info"
+	signInVerifiedInfo     = "sign_in_verified_info"
+	verifySignInErr        = "cannot_verify_sign_in_err"
+	invalidSessionErr      = "invalid_session_err"
+	getUserSecurityErr     = "cannot_get_user_security_err"
+	revokeSessionErr       = "cannot_revoke_session_err"
+	defaultSessionTTLHours = 24 * 14
+	defaultRecentLogins    = 20
+)
+
+// openSession creates a new session for a successfully signed in user
+// and stores the sign-in origin on the user.
+// It returns the session and the token to be handed to the client.
+// Risky sign-ins open a pending session, verificationToken is then
+// set and the session cannot be used until verified.
+func (s *Service) openSession(tx *sqlx.Tx, u *model.User, o tp.Origin) (session *model.Session, token, verificationToken string, ra riskAssessment, err error) {
+	// Service accounts only authenticate at the token endpoint.
+	if u.IsServiceAccount() {
+		return nil, "", "", ra, ErrServiceAccount
+	}
+
+	// Deactivated users keep their data but cannot sign in.
+	if u.IsActive.Valid && !u.IsActive.Bool {
+		return nil, "", "", ra, ErrInactiveUser
+	}
+
+	// Accounts out of their subscription window cannot be signed into.
+	err = s.checkSubscription(tx, u)
+	if err != nil {
+		return nil, "", "", ra, err
+	}
+
+	ttl := time.Duration(s.Cfg().ValAsInt("app.session.ttl.hours", defaultSessionTTLHours)) * time.Hour
+	sr := s.repo.SessionRepo(tx)
+
+	session = &model.Session{
+		UserID:    db.ToNullString(u.ID.String()),
+		IP:        db.ToNullString(o.IP),
+		UserAgent: db.ToNullString(o.UserAgent),
+	}
+
+	token, err = session.GenToken()
+	if err != nil {
+		return nil, "", "", ra, err
+	}
+
+	session.SetCreateValues(ttl)
+
+	// Risk
+	loc, err := s.geo.Resolve(o.IP)
+	located := err == nil
+
+	recent, err := sr.GetRecentByUser(u.ID.String(), defaultRiskHistory)
+	if err != nil {
+		return nil, "", "", ra, err
+	}
+
+	a := signInAttempt{UserAgent: o.UserAgent, Location: loc, Located: located, At: session.CreatedAt.Time}
+	ra = s.assessSignInRisk(u, a, recent)
+	session.RiskScore = ra.Score
+	session.RiskReasons = db.ToNullString(ra.String())
+
+	if s.isHighRisk(ra) {
+		verificationToken, err = session.GenVerificationToken()
+		if err != nil {
+			return nil, "", "", ra, err
+		}
+	}
+
+	// Only a coarse location is kept.
+	if located {
+		loc = loc.Coarse()
+		session.Geolocation = db.NullPoint{Point: db.Point{Lat: loc.Lat, Lng: loc.Lng}, Valid: true}
+		session.Country = db.ToNullString(loc.Country)
+	}
+
+	err = sr.Create(session)
+	if err != nil {
+		return nil, "", "", ra, err
+	}
+
+	// Pending sign-ins update user location once verified.
+	if session.IsPending() {
+		return session, token, verificationToken, ra, nil
+	}
+
+	err = s.repo.UserRepo(tx).UpdateSignInLocation(u.ID.String(), session.IP, session.Geolocation)
+	if err != nil {
+		return nil, "", "", ra, err
+	}
+
+	return session, token, "", ra, nil
+}
+
+// VerifySignIn activates a session opened by a risky sign-in.
+func (s *Service) VerifySignIn(req tp.VerifySignInReq, res *tp.VerifySignInRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(verifySignInErr, err)
+		return err
+	}
+
+	session, err := s.repo.SessionRepo(repo.Tx).Verify(u.ID.String(), model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, sessionVerifyFailedEvt, userTarget, u.Slug.String, nil))
+		res.FromModel(verifySignInErr, err)
+		return err
+	}
+
+	err = repo.UpdateSignInLocation(u.ID.String(), session.IP, session.Geolocation)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(verifySignInErr, err)
+		return err
+	}
+
+	// Audit
+	o := req.Origin
+	o.ActorID = u.ID.String()
+
+	err = s.recordEvent(repo.Tx, newEvent(o, sessionVerifiedEvt, sessionTarget, session.ID.String(), meta{"user": u.Slug.String}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(verifySignInErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(verifySignInErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(signInVerifiedInfo, nil)
+	return nil
+}
+
+// ValidateSession resolves a session token into the current session.
+// Not audited: it runs on every authenticated request.
+func (s *Service) ValidateSession(req tp.ValidateSessionReq, res *tp.ValidateSessionRes) error {
+	// Repo
+	repo, err := s.sessionRepo()
+	if err != nil {
+		res.FromModel(nil, nil, cannotProcErr, err)
+		return err
+	}
+
+	session, err := repo.GetActiveByToken(model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidSessionErr, err)
+		return err
+	}
+
+	u, err := s.repo.UserRepo(repo.Tx).Get(session.UserID.String)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidSessionErr, err)
+		return err
+	}
+
+	var admin *model.User
+	if session.IsImpersonation() {
+		admin, err = s.impersonator(repo.Tx, &session)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, nil, invalidSessionErr, err)
+			return err
+		}
+	}
+
+	err = repo.Touch(session.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, invalidSessionErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, nil, invalidSessionErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&session, &u, okResultInfo, nil)
+	res.SetImpersonator(admin)
+	return nil
+}
+
+// GetUserSecurity returns active sessions and recent sign-ins of a user.
+func (s *Service) GetUserSecurity(req tp.GetUserSecurityReq, res *tp.GetUserSecurityRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, nil, nil, "", cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, "", getUserSecurityErr, err)
+		return err
+	}
+
+	if !isSelf(req.Origin, &u) {
+		repo.Tx.Rollback()
+		res.FromModel(nil, nil, nil, "", forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	sr := s.repo.SessionRepo(repo.Tx)
+
+	active, err := sr.GetActiveByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, nil, nil, "", getUserSecurityErr, err)
+		return err
+	}
+
+	limit := int(s.Cfg().ValAsInt("app.session.recent.limit", defaultRecentLogins))
+	recent, err := sr.GetRecentByUser(u.ID.String(), limit)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, nil, nil, "", getUserSecurityErr, err)
+		return err
+	}
+
+	identities, err := s.repo.IdentityRepo(repo.Tx).GetByUser(u.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, nil, nil, "", getUserSecurityErr, err)
+		return err
+	}
+
+	// Directory identities are managed by the directory, not the user.
+	identities = federatedIdentities(identities)
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(&u, nil, nil, "", getUserSecurityErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&u, active, recent, req.SessionID, okResultInfo, nil)
+	res.SetIdentities(identities, s.IdentityProviders())
+	return nil
+}
+
+// RevokeSession signs a user out from one device.
+func (s *Service) RevokeSession(req tp.RevokeSessionReq, res *tp.RevokeSessionRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeSessionErr, err)
+		return err
+	}
+
+	if !isSelf(req.Origin, &u) {
+		repo.Tx.Rollback()
+		res.FromModel(forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	err = s.repo.SessionRepo(repo.Tx).Revoke(u.ID.String(), req.SessionID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeSessionErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, sessionRevokedEvt, sessionTarget, req.SessionID, meta{"user": u.Slug.String}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(revokeSessionErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(revokeSessionErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(sessionRevokedInfo, nil)
+	return nil
+}
+
+// RevokeAllSessions signs a user out from every device but the current one.
+func (s *Service) RevokeAllSessions(req tp.RevokeAllSessionsReq, res *tp.RevokeAllSessionsRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(0, cannotProcErr, err)
+		return err
+	}
+
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, revokeSessionErr, err)
+		return err
+	}
+
+	if !isSelf(req.Origin, &u) {
+		repo.Tx.Rollback()
+		res.FromModel(0, forbiddenErr, ErrForbidden)
+		return ErrForbidden
+	}
+
+	keep := []string{}
+	if req.SessionID != "" {
+		keep = append(keep, req.SessionID)
+	}
+
+	count, err := s.repo.SessionRepo(repo.Tx).RevokeAll(u.ID.String(), keep...)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, revokeSessionErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, sessionRevokedAllEvt, userTarget, u.Slug.String, meta{"count": count}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(0, revokeSessionErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(0, revokeSessionErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(count, sessionsRevokedInfo, nil)
+	return nil
+}
+
+// isSelf returns true if the origin actor is the user.
+func isSelf(o tp.Origin, u *model.User) bool {
+	return o.ActorID != "" && o.ActorID == u.ID.String()
+}
+
+// Misc
+func (s *Service) sessionRepo() (*repo.SessionRepo, error) {
+	return s.repo.SessionRepoNewTx()
+}
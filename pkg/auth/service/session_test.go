@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// sessionConfig disables risk checks,
+// sign-ins would otherwise wait for an email verification.
+var sessionConfig = map[string]string{
+	"app.risk.enabled": "false",
+}
+
+// TestValidateSession tests that session tokens resolve into their session.
+func TestValidateSession(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("sessionvalidate")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	req := tp.SignInUserReq{
+		SignIn: tp.SignIn{
+			Username: user.Username.String,
+			Password: namedUserPassword,
+		},
+	}
+
+	var sres tp.SignInUserRes
+
+	err = s.SignInUser(req, &sres)
+	if err != nil {
+		t.Fatalf("sign in error: %s", err.Error())
+	}
+
+	token := sres.SessionToken
+
+	// Test
+	var res tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token}, &res)
+	if err != nil {
+		t.Errorf("validate session error: %s", err.Error())
+	}
+
+	// Verify
+	if res.MsgID != okResultInfo {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	if res.UserID != user.ID.String() {
+		t.Errorf("expecting session of user %s got %s", user.ID.String(), res.UserID)
+	}
+
+	var invalid tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token + "x"}, &invalid)
+	if err == nil {
+		t.Error("unknown token should not be valid")
+	}
+}
+
+// TestRevokeSession tests that users can sign out one of their devices.
+func TestRevokeSession(t *testing.T) {
+	// Prerequisites
+	user, err := createNamedUser("sessionrevoke")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	token, sessionID, err := openTestSession(s, user)
+	if err != nil {
+		t.Fatalf("cannot open session: %s", err.Error())
+	}
+
+	// Setup
+	req := tp.RevokeSessionReq{
+		Identifier: tp.Identifier{
+			Slug: user.Slug.String,
+		},
+		SessionID: sessionID,
+		Origin: tp.Origin{
+			ActorID: user.ID.String(),
+		},
+	}
+
+	var res tp.RevokeSessionRes
+
+	// Test
+	err = s.RevokeSession(req, &res)
+	if err != nil {
+		t.Errorf("revoke session error: %s", err.Error())
+	}
+
+	// Verify
+	var vres tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token}, &vres)
+	if err == nil {
+		t.Error("revoked session should not be valid")
+	}
+}
+
+// TestRevokeSessionOfOtherUser tests that users cannot sign out others.
+func TestRevokeSessionOfOtherUser(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("sessionowner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	other, err := createNamedUser("sessionother")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	token, sessionID, err := openTestSession(s, owner)
+	if err != nil {
+		t.Fatalf("cannot open session: %s", err.Error())
+	}
+
+	// Setup
+	req := tp.RevokeSessionReq{
+		Identifier: tp.Identifier{
+			Slug: owner.Slug.String,
+		},
+		SessionID: sessionID,
+		Origin: tp.Origin{
+			ActorID: other.ID.String(),
+		},
+	}
+
+	var res tp.RevokeSessionRes
+
+	// Test
+	err = s.RevokeSession(req, &res)
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got: %v", err)
+	}
+
+	// Verify
+	if res.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	var vres tp.ValidateSessionRes
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: token}, &vres)
+	if err != nil {
+		t.Errorf("session should still be valid: %s", err.Error())
+	}
+}
+
+// openTestSession signs a named user in and returns the session token and ID.
+func openTestSession(s *service.Service, user *model.User) (token, sessionID string, err error) {
+	req := tp.SignInUserReq{
+		SignIn: tp.SignIn{
+			Username: user.Username.String,
+			Password: namedUserPassword,
+		},
+	}
+
+	var res tp.SignInUserRes
+
+	err = s.SignInUser(req, &res)
+	if err != nil {
+		return "", "", err
+	}
+
+	var vres tp.ValidateSessionRes
+
+	err = s.ValidateSession(tp.ValidateSessionReq{Token: res.SessionToken}, &vres)
+	if err != nil {
+		return "", "", err
+	}
+
+	return res.SessionToken, vres.ID, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (s *Service) makeSignInAlertEmail(u *model.User, session *model.Session, token string) (model.Email, error) {
+	path := s.Cfg().ValOrDef("user.signin.verification.path", "users/%s/%s/verify-signin")
+	verPath := fmt.Sprintf(path, u.Slug.String, token)
+
+	where := session.IP.String
+	if session.Country.Valid && session.Country.String != "" {
+		where = fmt.Sprintf("%s (%s)", where, session.Country.String)
+	}
+
+	data := mailer.MailData{
+		"When":   session.CreatedAt.Time.Format("2006-01-02 15:04 MST"),
+		"Where":  where,
+		"Device": session.UserAgent.String,
+		"Link":   s.siteLink(verPath),
+	}
+
+	return s.makeEmail(u, signInAlertMail, data)
+}
+
+// queueSignInAlertEmail stores in the outbox an email that warns
+// the user about a risky sign-in and lets them verify it.
+func (s *Service) queueSignInAlertEmail(tx *sqlx.Tx, u *model.User, session *model.Session, token string) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("user.signin.alert.debug", false)
+	send := cfg.ValAsBool("user.signin.alert.send", false)
+
+	if !debug && !send {
+		s.Log().Info("User sign-in alert email send is disabled")
+		return nil
+	}
+
+	m, err := s.makeSignInAlertEmail(u, session, token)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Sign-in alert email", "subject", m.Subject, "body", m.Text)
+	}
+
+	if !send {
+		s.Log().Info("User sign-in alert email send is disabled")
+		return nil
+	}
+
+	return s.queueEmail(tx, m, signInAlertMail, u.ID.String())
+}
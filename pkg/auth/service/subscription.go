@@ -0,0 +1,298 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	defaultSubscriptionGraceDays = 7
+	defaultSubscriptionWarnDays  = 7
+)
+
+var (
+	// ErrSubscriptionExpired is returned when all the accounts of a user
+	// have ended and their grace period is over.
+	ErrSubscriptionExpired = errors.New("account subscription expired")
+	// ErrSubscriptionNotStarted is returned when the accounts of a user
+	// have not started yet.
+	ErrSubscriptionNotStarted = errors.New("account subscription not started")
+)
+
+// ProcessSubscriptions enforces account subscription windows:
+// accounts are activated when they start, deactivated once ended
+// and their grace period is over, owners are warned before expiry.
+// Each account is processed in its own transaction.
+func (s *Service) ProcessSubscriptions() (activated, warned, deactivated int, err error) {
+	repo, err := s.accountRepo()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	accounts, err := repo.GetWithSubscription()
+	if err != nil {
+		repo.Tx.Rollback()
+		return 0, 0, 0, err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	now := time.Now()
+	var failed int
+
+	for _, a := range accounts {
+		r, err := s.processSubscription(a, now)
+		if err != nil {
+			s.Log().Error(err, "job", "subscription", "account", a.Slug.String)
+			failed++
+			continue
+		}
+
+		if r.activated {
+			activated++
+		}
+
+		if r.deactivated {
+			deactivated++
+		}
+
+		if r.warned {
+			warned++
+		}
+	}
+
+	if failed > 0 {
+		err = fmt.Errorf("%d of %d accounts not processed", failed, len(accounts))
+		return activated, warned, deactivated, err
+	}
+
+	return activated, warned, deactivated, nil
+}
+
+type subscriptionResult struct {
+	activated   bool
+	deactivated bool
+	warned      bool
+}
+
+func (s *Service) processSubscription(a model.Account, now time.Time) (r subscriptionResult, err error) {
+	repo, err := s.accountRepo()
+	if err != nil {
+		return r, err
+	}
+
+	id := a.ID.String()
+	prev := a.SubscriptionStatus.String
+	state := a.SubscriptionState(now, s.subscriptionGrace())
+	changed := false
+
+	// Subscription is run by the system, there is no actor nor origin.
+	if state != prev {
+		// Only transitions toggle the account so that admins can still
+		// deactivate an account within its subscription window.
+		was, is := usableSubscription(prev), usableSubscription(state)
+
+		if was != is {
+			err = repo.SetActive(id, is)
+			if err != nil {
+				repo.Tx.Rollback()
+				return r, err
+			}
+
+			r.activated, r.deactivated = is, !is
+		}
+
+		err = repo.SetSubscriptionStatus(id, state)
+		if err != nil {
+			repo.Tx.Rollback()
+			return r, err
+		}
+
+		md := meta{"from": prev, "to": state, "active": is}
+		err = s.recordEvent(repo.Tx, newEvent(tp.Origin{}, accountSubscriptionChangedEvt, accountTarget, a.Slug.String, md))
+		if err != nil {
+			repo.Tx.Rollback()
+			return r, err
+		}
+
+		changed = true
+	}
+
+	if s.dueExpiryWarning(a, state, now) {
+		err = s.warnExpiry(repo.Tx, a)
+		if err != nil {
+			repo.Tx.Rollback()
+			return r, err
+		}
+
+		r.warned = true
+		changed = true
+	}
+
+	if !changed {
+		repo.Tx.Rollback()
+		return r, nil
+	}
+
+	return r, repo.Commit()
+}
+
+// dueExpiryWarning returns true if the owner of an active account
+// has not been warned yet and the account ends within the warning period.
+func (s *Service) dueExpiryWarning(a model.Account, state string, now time.Time) bool {
+	if state != model.SubscriptionActive || !a.EndsAt.Valid || a.ExpiryWarnedAt.Valid {
+		return false
+	}
+
+	// Set envar GRN_APP_SUBSCRIPTION_WARN_DAYS to change
+	// how long before expiry owners are warned, 0 disables warnings.
+	days := s.Cfg().ValAsInt("app.subscription.warn.days", defaultSubscriptionWarnDays)
+	if days <= 0 {
+		return false
+	}
+
+	return !now.Before(a.EndsAt.Time.AddDate(0, 0, -int(days)))
+}
+
+// warnExpiry lets the account owner know the subscription is ending.
+func (s *Service) warnExpiry(tx *sqlx.Tx, a model.Account) error {
+	owner, err := s.accountOwner(tx, a)
+	if err != nil {
+		return err
+	}
+
+	notified := owner != nil && isActiveUser(*owner)
+	if notified {
+		err = s.queueAccountExpiryEmail(tx, owner, a)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Accounts without a reachable owner are marked too,
+	// there is nobody to warn later either.
+	err = s.repo.AccountRepo(tx).SetExpiryWarned(a.ID.String())
+	if err != nil {
+		return err
+	}
+
+	md := meta{"endsAt": a.EndsAt.Time, "notified": notified}
+	return s.recordEvent(tx, newEvent(tp.Origin{}, accountExpiryWarnedEvt, accountTarget, a.Slug.String, md))
+}
+
+func (s *Service) makeAccountExpiryEmail(u *model.User, a model.Account) (model.Email, error) {
+	path := s.Cfg().ValOrDef("account.show.path", "accounts/%s")
+	accPath := fmt.Sprintf(path, a.Slug.String)
+
+	data := mailer.MailData{
+		"Account":   a.Name.String,
+		"EndsAt":    a.EndsAt.Time.Format("2006-01-02 15:04 MST"),
+		"GraceDays": int(s.subscriptionGrace().Hours() / 24),
+		"Link":      s.siteLink(accPath),
+	}
+
+	return s.makeEmail(u, accountExpiryWarningMail, data)
+}
+
+// queueAccountExpiryEmail stores in the outbox an email that
+// lets the owner know the account subscription is about to end.
+func (s *Service) queueAccountExpiryEmail(tx *sqlx.Tx, u *model.User, a model.Account) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("account.expiry.notice.debug", false)
+	send := cfg.ValAsBool("account.expiry.notice.send", false)
+
+	if !debug && !send {
+		s.Log().Info("Account expiry notice send is disabled")
+		return nil
+	}
+
+	m, err := s.makeAccountExpiryEmail(u, a)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Account expiry email", "subject", m.Subject, "body", m.Text)
+	}
+
+	if !send {
+		s.Log().Info("Account expiry notice send is disabled")
+		return nil
+	}
+
+	return s.queueEmail(tx, m, accountExpiryWarningMail, u.ID.String())
+}
+
+// checkSubscription denies access to users whose accounts are all
+// out of their subscription window.
+// Users without accounts and admins are not affected.
+func (s *Service) checkSubscription(tx *sqlx.Tx, u *model.User) error {
+	accounts, err := s.repo.AccountRepo(tx).GetByMember(u.ID.String())
+	if err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	grace := s.subscriptionGrace()
+	scheduled := false
+
+	for _, a := range accounts {
+		switch a.SubscriptionState(now, grace) {
+		case model.SubscriptionActive, model.SubscriptionGrace:
+			return nil
+		case model.SubscriptionScheduled:
+			scheduled = true
+		}
+	}
+
+	admin, err := s.repo.RoleRepo(tx).Has(u.ID.String(), model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if admin {
+		return nil
+	}
+
+	if scheduled {
+		return ErrSubscriptionNotStarted
+	}
+
+	return ErrSubscriptionExpired
+}
+
+// subscriptionGrace returns how long accounts keep working once ended.
+func (s *Service) subscriptionGrace() time.Duration {
+	// Set envar GRN_APP_SUBSCRIPTION_GRACE_DAYS to change
+	// how long accounts can be used after they end.
+	days := s.Cfg().ValAsInt("app.subscription.grace.days", defaultSubscriptionGraceDays)
+	if days < 0 {
+		days = 0
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// usableSubscription returns true for states in which accounts are active.
+// Accounts never processed before are considered active.
+func usableSubscription(state string) bool {
+	switch state {
+	case model.SubscriptionScheduled, model.SubscriptionExpired:
+		return false
+	}
+	return true
+}
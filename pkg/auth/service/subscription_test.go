@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+)
+
+// TestProcessSubscriptions tests that accounts are toggled
+// according to their subscription window.
+func TestProcessSubscriptions(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("subsowner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(map[string]string{
+		"app.subscription.grace.days": "7",
+		"app.subscription.warn.days":  "0",
+	})
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	now := time.Now()
+
+	expired, err := createSubscriptionAccount(r, "subsexpired", owner, nil, timeRef(now.AddDate(0, 0, -30)))
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	grace, err := createSubscriptionAccount(r, "subsgrace", owner, nil, timeRef(now.AddDate(0, 0, -1)))
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	scheduled, err := createSubscriptionAccount(r, "subsscheduled", owner, timeRef(now.AddDate(0, 0, 10)), nil)
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	_, _, deactivated, err := s.ProcessSubscriptions()
+	if err != nil {
+		t.Errorf("process subscriptions error: %s", err.Error())
+	}
+
+	// Verify
+	if deactivated < 2 {
+		t.Errorf("expecting at least two deactivated accounts got %d", deactivated)
+	}
+
+	cases := []struct {
+		account *model.Account
+		status  string
+		active  bool
+	}{
+		{expired, model.SubscriptionExpired, false},
+		{grace, model.SubscriptionGrace, true},
+		{scheduled, model.SubscriptionScheduled, false},
+	}
+
+	for _, c := range cases {
+		a, err := getAccountBySlug(c.account.Slug.String, cfg)
+		if err != nil {
+			t.Fatalf("cannot get account from database: %s", err.Error())
+		}
+
+		if a.SubscriptionStatus.String != c.status {
+			t.Errorf("account %s: expecting status %s got %s", a.Name.String, c.status, a.SubscriptionStatus.String)
+		}
+
+		if a.IsActive.Bool != c.active {
+			t.Errorf("account %s: expecting active %t got %t", a.Name.String, c.active, a.IsActive.Bool)
+		}
+	}
+
+	// A second run finds nothing to change.
+	activated, _, deactivated, err := s.ProcessSubscriptions()
+	if err != nil {
+		t.Errorf("second process subscriptions error: %s", err.Error())
+	}
+
+	if activated != 0 || deactivated != 0 {
+		t.Errorf("expecting no changes got %d activated and %d deactivated", activated, deactivated)
+	}
+}
+
+// TestSignInExpiredSubscription tests that members of expired accounts cannot sign in.
+func TestSignInExpiredSubscription(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("subsexpiry")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(sessionConfig)
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = createSubscriptionAccount(r, "subsended", owner, nil, timeRef(time.Now().AddDate(0, 0, -30)))
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	_, _, err = openTestSession(s, owner)
+
+	// Verify
+	if err != service.ErrSubscriptionExpired {
+		t.Errorf("expecting subscription expired error got %v", err)
+	}
+}
+
+// createSubscriptionAccount creates an active account with the given subscription window.
+func createSubscriptionAccount(r *repo.Repo, name string, owner *model.User, startsAt, endsAt *time.Time) (*model.Account, error) {
+	account := &model.Account{
+		Name:        db.ToNullString(name),
+		OwnerID:     db.ToNullString(owner.ID.String()),
+		AccountType: db.ToNullString("organization"),
+		Email:       db.ToNullString(name + "@mail.com"),
+		IsActive:    db.ToNullBool(true),
+	}
+
+	if startsAt != nil {
+		account.StartsAt = pq.NullTime{Time: *startsAt, Valid: true}
+	}
+
+	if endsAt != nil {
+		account.EndsAt = pq.NullTime{Time: *endsAt, Valid: true}
+	}
+
+	err := createAccount(r, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func timeRef(t time.Time) *time.Time {
+	return &t
+}
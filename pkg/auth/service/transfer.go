@@ -0,0 +1,453 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/mailer"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+const (
+	transferInitiatedInfo        = "transfer_initiated_info"
+	transferAwaitingApprovalInfo = "transfer_awaiting_approval_info"
+	transferCompletedInfo        = "transfer_completed_info"
+	transferCancelledInfo        = "transfer_cancelled_info"
+	initiateTransferErr          = "cannot_initiate_transfer_err"
+	confirmTransferErr           = "cannot_confirm_transfer_err"
+	approveTransferErr           = "cannot_approve_transfer_err"
+	cancelTransferErr            = "cannot_cancel_transfer_err"
+	ownsAccountsErr              = "cannot_delete_account_owner_err"
+	// Hours the new owner has to confirm a transfer.
+	defaultTransferTTLHours = 72
+)
+
+var (
+	// ErrOwnsAccounts is returned when deleting a user that still owns accounts,
+	// their ownership has to be transferred first.
+	ErrOwnsAccounts = errors.New("user owns accounts")
+)
+
+// InitiateTransfer starts handing an account to one of its members.
+// The new owner has to confirm it using the token sent by email,
+// any previous open transfer of the account is discarded.
+// Only the account owner and admins can initiate transfers.
+func (s *Service) InitiateTransfer(req tp.InitiateTransferReq, res *tp.InitiateTransferRes) error {
+	o := req.Origin
+
+	if isImpersonating(o) {
+		res.FromModel(nil, "", "", nil, impersonatingErr, ErrImpersonating)
+		return ErrImpersonating
+	}
+
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(nil, "", "", nil, cannotProcErr, err)
+		return err
+	}
+
+	a, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	err = s.requireAccountOwner(repo.Tx, a, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, adminErr(err, initiateTransferErr), err)
+		return err
+	}
+
+	// Validation
+	to, member, err := s.transferRecipient(repo.Tx, a, req.To)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	v := NewTransferValidator(a, to, member)
+
+	err = v.ValidateForInitiate()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, a.Slug.String, req.To, v.Errors, validationErr, err)
+		return err
+	}
+
+	tr := s.repo.TransferRepo(repo.Tx)
+
+	_, err = tr.CancelOpen(a.ID.String())
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	t := model.Transfer{
+		AccountID:     a.ID.String(),
+		FromID:        a.OwnerID.String,
+		ToID:          to.ID.String(),
+		InitiatedByID: db.ToNullString(o.ActorID),
+	}
+
+	t.SetCreateValues(time.Duration(s.transferTTLHours()) * time.Hour)
+
+	token, err := t.GenToken()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	err = tr.Create(&t)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	err = s.queueTransferEmail(repo.Tx, to, a, token)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(o, accountTransferInitiatedEvt, accountTarget, a.Slug.String, meta{"from": t.FromID, "to": t.ToID}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", "", nil, initiateTransferErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&t, a.Slug.String, to.Username.String, nil, transferInitiatedInfo, nil)
+	return nil
+}
+
+// ConfirmTransfer accepts the ownership of an account
+// using the token sent to the new owner.
+// The owner is swapped right away unless transfers require admin approval.
+func (s *Service) ConfirmTransfer(req tp.ConfirmTransferReq, res *tp.ConfirmTransferRes) error {
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	a, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", confirmTransferErr, err)
+		return err
+	}
+
+	t, err := s.repo.TransferRepo(repo.Tx).Confirm(a.ID.String(), model.TokenDigest(req.Token))
+	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, accountTransferFailedEvt, accountTarget, a.Slug.String, meta{"step": "confirm"}))
+		res.FromModel(nil, "", "", confirmTransferErr, err)
+		return err
+	}
+
+	to, err := s.repo.UserRepo(repo.Tx).Get(t.ToID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", confirmTransferErr, err)
+		return err
+	}
+
+	// Audit
+	// Token was sent to the new owner, they are the actor.
+	o := req.Origin
+	o.ActorID = t.ToID
+
+	err = s.recordEvent(repo.Tx, newEvent(o, accountTransferConfirmedEvt, accountTarget, a.Slug.String, meta{"from": t.FromID, "to": t.ToID}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", confirmTransferErr, err)
+		return err
+	}
+
+	msgID := transferAwaitingApprovalInfo
+
+	if t.IsReady(s.transferApproval()) {
+		err = s.completeTransfer(repo.Tx, a, &t, o)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, "", "", confirmTransferErr, err)
+			return err
+		}
+
+		msgID = transferCompletedInfo
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", "", confirmTransferErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&t, a.Slug.String, to.Username.String, msgID, nil)
+	return nil
+}
+
+// ApproveTransfer lets an admin complete a confirmed transfer.
+func (s *Service) ApproveTransfer(req tp.ApproveTransferReq, res *tp.ApproveTransferRes) error {
+	o := req.Origin
+
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(nil, "", "", cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", adminErr(err, approveTransferErr), err)
+		return err
+	}
+
+	a, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", approveTransferErr, err)
+		return err
+	}
+
+	t, err := s.repo.TransferRepo(repo.Tx).Approve(a.ID.String(), o.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", approveTransferErr, err)
+		return err
+	}
+
+	to, err := s.repo.UserRepo(repo.Tx).Get(t.ToID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", approveTransferErr, err)
+		return err
+	}
+
+	err = s.completeTransfer(repo.Tx, a, &t, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, "", "", approveTransferErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, "", "", approveTransferErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(&t, a.Slug.String, to.Username.String, transferCompletedInfo, nil)
+	return nil
+}
+
+// CancelTransfer discards the open transfer of an account.
+// Only the account owner and admins can cancel transfers.
+func (s *Service) CancelTransfer(req tp.CancelTransferReq, res *tp.CancelTransferRes) error {
+	o := req.Origin
+
+	// Repo
+	repo, err := s.accountRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	a, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(cancelTransferErr, err)
+		return err
+	}
+
+	err = s.requireAccountOwner(repo.Tx, a, o)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(adminErr(err, cancelTransferErr), err)
+		return err
+	}
+
+	n, err := s.repo.TransferRepo(repo.Tx).CancelOpen(a.ID.String())
+	if err == nil && n == 0 {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(cancelTransferErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(o, accountTransferCancelledEvt, accountTarget, a.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(cancelTransferErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(cancelTransferErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(transferCancelledInfo, nil)
+	return nil
+}
+
+// completeTransfer swaps the account owner.
+// It fails if the owner changed since the transfer was initiated
+// or the new owner can no longer sign in.
+func (s *Service) completeTransfer(tx *sqlx.Tx, a model.Account, t *model.Transfer, o tp.Origin) error {
+	to, err := s.repo.UserRepo(tx).Get(t.ToID)
+	if err != nil {
+		return err
+	}
+
+	if !isActiveUser(to) {
+		return ErrInactiveUser
+	}
+
+	err = s.repo.AccountRepo(tx).SwapOwner(t.AccountID, t.FromID, t.ToID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.TransferRepo(tx).Complete(t.ID.String())
+	if err != nil {
+		return err
+	}
+
+	md := meta{"from": t.FromID, "to": t.ToID}
+	if t.IsApproved() {
+		md["approvedBy"] = t.ApprovedByID.String
+	}
+
+	return s.recordEvent(tx, newEvent(o, accountOwnerTransferredEvt, accountTarget, a.Slug.String, md))
+}
+
+// requireAccountOwner returns ErrForbidden unless the origin actor
+// owns the account or is an admin.
+func (s *Service) requireAccountOwner(tx *sqlx.Tx, a model.Account, o tp.Origin) error {
+	if o.ActorID != "" && o.APIKeyID == "" && o.ActorID == a.OwnerID.String {
+		return nil
+	}
+
+	return s.requireAdmin(tx, o)
+}
+
+// transferRecipient returns the user with the given username, nil if there is none,
+// and whether it is member of the account.
+func (s *Service) transferRecipient(tx *sqlx.Tx, a model.Account, username string) (*model.User, bool, error) {
+	u, err := s.repo.UserRepo(tx).GetByUsername(username)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	member, err := s.repo.MemberRepo(tx).IsMember(a.ID.String(), u.ID.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &u, member, nil
+}
+
+// checkOwnsNoAccounts returns ErrOwnsAccounts if the user owns accounts.
+// Deleting them would otherwise remove their accounts too.
+func (s *Service) checkOwnsNoAccounts(tx *sqlx.Tx, userID string) error {
+	as, err := s.repo.AccountRepo(tx).GetOwnedBy(userID)
+	if err != nil {
+		return err
+	}
+
+	if len(as) > 0 {
+		return ErrOwnsAccounts
+	}
+
+	return nil
+}
+
+func (s *Service) makeTransferEmail(u *model.User, a model.Account, token string) (model.Email, error) {
+	path := s.Cfg().ValOrDef("account.transfer.confirm.path", "accounts/%s/%s/confirm-transfer")
+	confPath := fmt.Sprintf(path, a.Slug.String, token)
+
+	data := mailer.MailData{
+		"Account": a.Name.String,
+		"Hours":   s.transferTTLHours(),
+		"Link":    s.siteLink(confPath),
+	}
+
+	return s.makeEmail(u, accountTransferMail, data)
+}
+
+// queueTransferEmail stores in the outbox an email that
+// lets the new owner confirm the transfer of an account.
+func (s *Service) queueTransferEmail(tx *sqlx.Tx, u *model.User, a model.Account, token string) error {
+	cfg := s.Cfg()
+
+	debug := cfg.ValAsBool("account.transfer.debug", false)
+	send := cfg.ValAsBool("account.transfer.send", false)
+
+	if !debug && !send {
+		s.Log().Info("Account transfer send is disabled")
+		return nil
+	}
+
+	m, err := s.makeTransferEmail(u, a, token)
+	if err != nil {
+		return err
+	}
+
+	if debug {
+		s.Log().Debug("Account transfer email", "subject", m.Subject, "body", m.Text)
+	}
+
+	if !send {
+		s.Log().Info("Account transfer send is disabled")
+		return nil
+	}
+
+	return s.queueEmail(tx, m, accountTransferMail, u.ID.String())
+}
+
+// transferTTLHours returns how long, in hours, the new owner has to confirm a transfer.
+func (s *Service) transferTTLHours() int {
+	return int(s.Cfg().ValAsInt("app.account.transfer.ttl.hours", defaultTransferTTLHours))
+}
+
+// transferApproval returns true if transfers have to be approved by an admin.
+func (s *Service) transferApproval() bool {
+	// Set envar GRN_APP_ACCOUNT_TRANSFER_APPROVAL to require
+	// admin approval once the new owner confirms a transfer.
+	return s.Cfg().ValAsBool("app.account.transfer.approval", false)
+}
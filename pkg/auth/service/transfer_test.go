@@ -0,0 +1,388 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/db"
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/repo"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+)
+
+// TestInitiateTransfer tests that only account owners can hand their accounts to members.
+func TestInitiateTransfer(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("initowner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	member, err := createNamedUser("initmember")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	outsider, err := createNamedUser("initoutsider")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	account, err := createTransferAccount(r, "inittransfer", owner, member)
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	// Members cannot give away the account.
+	var fres tp.InitiateTransferRes
+	freq := tp.InitiateTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String},
+		To:         member.Username.String,
+		Origin:     tp.Origin{ActorID: member.ID.String()},
+	}
+
+	err = s.InitiateTransfer(freq, &fres)
+	if err != service.ErrForbidden {
+		t.Errorf("expecting forbidden error got %v", err)
+	}
+
+	if fres.MsgID != forbiddenErr {
+		t.Errorf("Response message: %s", fres.MsgID)
+	}
+
+	// Recipients have to be members of the account.
+	var vres tp.InitiateTransferRes
+	vreq := tp.InitiateTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String},
+		To:         outsider.Username.String,
+		Origin:     tp.Origin{ActorID: owner.ID.String()},
+	}
+
+	err = s.InitiateTransfer(vreq, &vres)
+	if err == nil {
+		t.Error("transfers to non members should not be initiated")
+	}
+
+	if vres.MsgID != validationErr {
+		t.Errorf("Response message: %s", vres.MsgID)
+	}
+
+	var res tp.InitiateTransferRes
+	req := tp.InitiateTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String},
+		To:         member.Username.String,
+		Origin:     tp.Origin{ActorID: owner.ID.String()},
+	}
+
+	err = s.InitiateTransfer(req, &res)
+	if err != nil {
+		t.Errorf("initiate transfer error: %s", err.Error())
+	}
+
+	// Verify
+	if res.MsgID != "transfer_initiated_info" {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	tr, err := getOpenTransfer(r, account)
+	if err != nil {
+		t.Fatalf("cannot get open transfer: %s", err.Error())
+	}
+
+	if tr.ToID != member.ID.String() || tr.FromID != owner.ID.String() {
+		t.Error("open transfer does not match the initiated one")
+	}
+
+	aVerify, err := getAccountBySlug(account.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get account from database: %s", err.Error())
+	}
+
+	if aVerify.OwnerID.String != owner.ID.String() {
+		t.Error("owner should not change before the transfer is confirmed")
+	}
+}
+
+// TestConfirmTransfer tests that the new owner takes over the account
+// using the token sent to them.
+func TestConfirmTransfer(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("confowner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	member, err := createNamedUser("confmember")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	account, err := createTransferAccount(r, "conftransfer", owner, member)
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	token, err := createTransfer(r, account, member)
+	if err != nil {
+		t.Fatalf("error creating transfer: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	// Test
+	var ires tp.ConfirmTransferRes
+	ireq := tp.ConfirmTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String, Token: token + "x"},
+	}
+
+	err = s.ConfirmTransfer(ireq, &ires)
+	if err == nil {
+		t.Error("unknown token should not confirm the transfer")
+	}
+
+	var res tp.ConfirmTransferRes
+	req := tp.ConfirmTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String, Token: token},
+	}
+
+	err = s.ConfirmTransfer(req, &res)
+	if err != nil {
+		t.Errorf("confirm transfer error: %s", err.Error())
+	}
+
+	// Verify
+	if res.MsgID != "transfer_completed_info" {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	aVerify, err := getAccountBySlug(account.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get account from database: %s", err.Error())
+	}
+
+	if aVerify.OwnerID.String != member.ID.String() {
+		t.Error("member should own the account")
+	}
+
+	// Tokens cannot be used twice.
+	var again tp.ConfirmTransferRes
+	err = s.ConfirmTransfer(req, &again)
+	if err == nil {
+		t.Error("used token should not confirm the transfer")
+	}
+}
+
+// TestApproveTransfer tests that confirmed transfers wait for an admin
+// when approval is required.
+func TestApproveTransfer(t *testing.T) {
+	// Prerequisites
+	owner, err := createNamedUser("approwner")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	member, err := createNamedUser("apprmember")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	admin, err := createNamedUser("appradmin")
+	if err != nil {
+		t.Fatalf("error creating user: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	cfg := testConfigWith(map[string]string{
+		"app.account.transfer.approval": "true",
+	})
+	log := testLogger()
+
+	// Repo
+	r, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err = grantAdmin(r, admin)
+	if err != nil {
+		t.Fatalf("error granting admin role: %s", err.Error())
+	}
+
+	account, err := createTransferAccount(r, "apprtransfer", owner, member)
+	if err != nil {
+		t.Fatalf("error creating account: %s", err.Error())
+	}
+
+	token, err := createTransfer(r, account, member)
+	if err != nil {
+		t.Fatalf("error creating transfer: %s", err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, r)
+
+	var cres tp.ConfirmTransferRes
+	creq := tp.ConfirmTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String, Token: token},
+	}
+
+	err = s.ConfirmTransfer(creq, &cres)
+	if err != nil {
+		t.Fatalf("confirm transfer error: %s", err.Error())
+	}
+
+	if cres.MsgID != "transfer_awaiting_approval_info" {
+		t.Errorf("Response message: %s", cres.MsgID)
+	}
+
+	// Test
+	// Neither party can approve the transfer.
+	for _, u := range []*model.User{owner, member} {
+		var fres tp.ApproveTransferRes
+		freq := tp.ApproveTransferReq{
+			Identifier: tp.Identifier{Slug: account.Slug.String},
+			Origin:     tp.Origin{ActorID: u.ID.String()},
+		}
+
+		err = s.ApproveTransfer(freq, &fres)
+		if err != service.ErrForbidden {
+			t.Errorf("expecting forbidden error got %v", err)
+		}
+
+		if fres.MsgID != forbiddenErr {
+			t.Errorf("Response message: %s", fres.MsgID)
+		}
+	}
+
+	aVerify, err := getAccountBySlug(account.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get account from database: %s", err.Error())
+	}
+
+	if aVerify.OwnerID.String != owner.ID.String() {
+		t.Error("owner should not change before the transfer is approved")
+	}
+
+	var res tp.ApproveTransferRes
+	req := tp.ApproveTransferReq{
+		Identifier: tp.Identifier{Slug: account.Slug.String},
+		Origin:     tp.Origin{ActorID: admin.ID.String()},
+	}
+
+	err = s.ApproveTransfer(req, &res)
+	if err != nil {
+		t.Errorf("approve transfer error: %s", err.Error())
+	}
+
+	// Verify
+	if res.MsgID != "transfer_completed_info" {
+		t.Errorf("Response message: %s", res.MsgID)
+	}
+
+	aVerify, err = getAccountBySlug(account.Slug.String, cfg)
+	if err != nil {
+		t.Fatalf("cannot get account from database: %s", err.Error())
+	}
+
+	if aVerify.OwnerID.String != member.ID.String() {
+		t.Error("member should own the account")
+	}
+}
+
+// createTransferAccount creates an account owned by owner with member as its only member.
+func createTransferAccount(r *repo.Repo, name string, owner, member *model.User) (*model.Account, error) {
+	account := &model.Account{
+		Name:        db.ToNullString(name),
+		OwnerID:     db.ToNullString(owner.ID.String()),
+		AccountType: db.ToNullString("organization"),
+		Email:       db.ToNullString(name + "@mail.com"),
+	}
+
+	err := createAccount(r, account)
+	if err != nil {
+		return nil, err
+	}
+
+	memberRepo, err := r.MemberRepoNewTx()
+	if err != nil {
+		return nil, err
+	}
+
+	err = memberRepo.Add(account.ID.String(), member.ID.String())
+	if err != nil {
+		memberRepo.Tx.Rollback()
+		return nil, err
+	}
+
+	return account, memberRepo.Commit()
+}
+
+// createTransfer stores an open transfer of the account to the user
+// and returns its confirmation token.
+func createTransfer(r *repo.Repo, account *model.Account, to *model.User) (string, error) {
+	transferRepo, err := r.TransferRepoNewTx()
+	if err != nil {
+		return "", err
+	}
+
+	tr := model.Transfer{
+		AccountID:     account.ID.String(),
+		FromID:        account.OwnerID.String,
+		ToID:          to.ID.String(),
+		InitiatedByID: account.OwnerID,
+	}
+
+	tr.SetCreateValues(72 * time.Hour)
+
+	token, err := tr.GenToken()
+	if err != nil {
+		transferRepo.Tx.Rollback()
+		return "", err
+	}
+
+	err = transferRepo.Create(&tr)
+	if err != nil {
+		transferRepo.Tx.Rollback()
+		return "", err
+	}
+
+	return token, transferRepo.Commit()
+}
+
+// getOpenTransfer returns the open transfer of the account.
+func getOpenTransfer(r *repo.Repo, account *model.Account) (model.Transfer, error) {
+	transferRepo, err := r.TransferRepoNewTx()
+	if err != nil {
+		return model.Transfer{}, err
+	}
+	defer transferRepo.Tx.Rollback()
+
+	return transferRepo.GetOpen(account.ID.String())
+}
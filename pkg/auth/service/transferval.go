@@ -0,0 +1,65 @@
+package service
+
+import (
+	"errors"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	recipientNotFoundErrMsg  = "recipient_not_found_err_msg"
+	recipientNotMemberErrMsg = "recipient_not_member_err_msg"
+	recipientIsOwnerErrMsg   = "recipient_is_owner_err_msg"
+	recipientInactiveErrMsg  = "recipient_inactive_err_msg"
+)
+
+type (
+	TransferValidator struct {
+		Account model.Account
+		service.Validator
+		// To is the new owner, nil if not found.
+		to *model.User
+		// Member is true if the new owner belongs to the account.
+		member bool
+	}
+)
+
+func NewTransferValidator(a model.Account, to *model.User, member bool) TransferValidator {
+	return TransferValidator{
+		Account:   a,
+		Validator: service.NewValidator(),
+		to:        to,
+		member:    member,
+	}
+}
+
+func (tv TransferValidator) ValidateForInitiate() error {
+	if tv.ValidateRecipient() {
+		return nil
+	}
+
+	return errors.New("transfer has errors")
+}
+
+// ValidateRecipient requires an active member of the account,
+// other than its current owner, that can sign in.
+func (tv TransferValidator) ValidateRecipient() (ok bool) {
+	u := tv.to
+
+	switch {
+	case u == nil || u.IsServiceAccount():
+		tv.Errors.Add("To", recipientNotFoundErrMsg)
+	case u.ID.String() == tv.Account.OwnerID.String:
+		tv.Errors.Add("To", recipientIsOwnerErrMsg)
+	case !tv.member:
+		tv.Errors.Add("To", recipientNotMemberErrMsg)
+	case !isActiveUser(*u):
+		tv.Errors.Add("To", recipientInactiveErrMsg)
+	default:
+		return true
+	}
+
+	return false
+}
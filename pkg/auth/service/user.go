@@ -2,7 +2,9 @@ package service
 
 import (
 	"errors"
+	"strings"
 
+	"gitlab.com/mikrowezel/backend/db"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
 	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
 )
@@ -14,13 +16,16 @@ const (
 	userUpdatedInfo   = "user_updated_info"
 	userDeletedInfo   = "user_deleted_info"
 	userConfirmedInfo = "user_confirmed_info"
+	userRestoredInfo  = "user_restored_info"
 	// Error
 	cannotProcErr       = "cannot_process_err"
 	createUserErr       = "cannot_create_user_err"
 	getAllUserErr       = "cannot_get_users_list_err"
+	searchUserErr       = "cannot_search_users_err"
 	getUserErr          = "cannot_get_user_err"
 	updateUserErr       = "cannot_update_user_err"
 	deleteUserErr       = "cannot_delete_user_err"
+	restoreUserErr      = "cannot_restore_user_err"
 	validationErr       = "validation_error_err"
 	signupErr           = "cannot_sign_up_user_err"
 	confirmationErr     = "cannot_confirm_user_err"
@@ -33,7 +38,7 @@ func (s *Service) CreateUser(req tp.CreateUserReq, res *tp.CreateUserRes) error
 	u := req.ToModel()
 
 	// Validation
-	v := NewUserValidator(u)
+	v := s.newUserValidator(u, nil)
 
 	err := v.ValidateForCreate()
 	if err != nil {
@@ -44,6 +49,9 @@ func (s *Service) CreateUser(req tp.CreateUserReq, res *tp.CreateUserRes) error
 	// Confirmation
 	u.GenAutoConfirmationToken()
 
+	// Audit columns
+	u.CreatedByID = db.ToNullString(req.ActorID)
+
 	// Repo
 	repo, err := s.userRepo()
 	if err != nil {
@@ -53,6 +61,15 @@ func (s *Service) CreateUser(req tp.CreateUserReq, res *tp.CreateUserRes) error
 
 	err = repo.Create(&u)
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, createUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userCreatedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, createUserErr, err)
 		return err
 	}
@@ -78,6 +95,15 @@ func (s *Service) IndexUsers(req tp.IndexUsersReq, res *tp.IndexUsersRes) error
 
 	us, err := repo.GetAll()
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAllUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userListedEvt, userTarget, "", meta{"count": len(us)}))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getAllUserErr, err)
 		return err
 	}
@@ -93,6 +119,63 @@ func (s *Service) IndexUsers(req tp.IndexUsersReq, res *tp.IndexUsersRes) error
 	return nil
 }
 
+// SearchUsers finds users by partial name, email or username,
+// only admins can search.
+func (s *Service) SearchUsers(req tp.SearchUsersReq, res *tp.SearchUsersRes) error {
+	q := strings.TrimSpace(req.Query)
+	if q == "" {
+		res.FromModel(nil, q, okResultInfo, nil)
+		return nil
+	}
+
+	// Set envar GRN_APP_SEARCH_LIMIT to change
+	// the maximum number of returned matches.
+	max := int(s.Cfg().ValAsInt("app.search.limit", 50))
+	limit := req.Limit
+	if limit <= 0 || limit > max {
+		limit = max
+	}
+
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, q, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, q, adminErr(err, searchUserErr), err)
+		return err
+	}
+
+	ms, err := repo.Search(q, limit)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, q, searchUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userSearchedEvt, userTarget, "", meta{"query": q, "count": len(ms)}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, q, searchUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, q, searchUserErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(ms, q, okResultInfo, nil)
+	return nil
+}
+
 func (s *Service) GetUser(req tp.GetUserReq, res *tp.GetUserRes) error {
 	// Model
 	u := req.ToModel()
@@ -106,10 +189,21 @@ func (s *Service) GetUser(req tp.GetUserReq, res *tp.GetUserRes) error {
 
 	u, err = repo.GetBySlug(u.Slug.String)
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userViewedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getUserErr, err)
 		return err
 	}
 
+	canImpersonate := s.canImpersonate(repo.Tx, req.Origin, &u) == nil
+
 	err = repo.Commit()
 	if err != nil {
 		res.FromModel(nil, getUserErr, err)
@@ -118,6 +212,7 @@ func (s *Service) GetUser(req tp.GetUserReq, res *tp.GetUserRes) error {
 
 	// Output
 	res.FromModel(&u, okResultInfo, nil)
+	res.CanImpersonate = canImpersonate
 	return nil
 }
 
@@ -134,6 +229,15 @@ func (s *Service) GetUserByUsername(req tp.GetUserReq, res *tp.GetUserRes) error
 
 	u, err = repo.GetByUsername(u.Username.String)
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userViewedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getUserErr, err)
 		return err
 	}
@@ -160,6 +264,7 @@ func (s *Service) UpdateUser(req tp.UpdateUserReq, res *tp.UpdateUserRes) error
 	// Get user
 	current, err := repo.GetBySlug(req.Identifier.Slug)
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, getUserErr, err)
 		return err
 	}
@@ -168,6 +273,7 @@ func (s *Service) UpdateUser(req tp.UpdateUserReq, res *tp.UpdateUserRes) error
 	// Neither ID nor Username should change.
 	u := req.ToModel()
 	u.ID = current.ID
+	u.UpdatedByID = db.ToNullString(req.ActorID)
 	// Set envar GRN_APP_USERNAME_UPDATABLE=true
 	// to let username be updatable.
 	if !(s.Cfg().ValAsBool("app.username.updatable", false)) {
@@ -179,17 +285,74 @@ func (s *Service) UpdateUser(req tp.UpdateUserReq, res *tp.UpdateUserRes) error
 
 	err = v.ValidateForUpdate()
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(nil, validationErr, err)
 		return err
 	}
 
+	// Email is not overwritten,
+	// the new address has to be confirmed first.
+	newEmail := ""
+	if u.Email.String != current.Email.String {
+		newEmail = u.Email.String
+	}
+	u.Email = current.Email
+
+	// Mails meant for the user are sent to the new address once confirmed,
+	// only the user or an admin can change it.
+	if newEmail != "" && !isSelf(req.Origin, &current) {
+		err = s.requireAdmin(repo.Tx, req.Origin)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(nil, adminErr(err, updateUserErr), err)
+			return err
+		}
+	}
+
+	// Password is changed through ChangePassword.
+	u.Password = ""
+	u.PasswordDigest = current.PasswordDigest
+
+	// External ID is managed by provisioning clients.
+	u.ExternalID = current.ExternalID
+
 	// Update
 	err = repo.Update(&u)
+	// Nothing else to update is fine if only email changes.
+	if err != nil && !(isNoChanges(err) && newEmail != "") {
+		repo.Tx.Rollback()
+		res.FromModel(&u, updateUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userUpdatedEvt, userTarget, current.Slug.String, nil))
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, updateUserErr, err)
 		return err
 	}
 
+	msgID := okResultInfo
+
+	if newEmail != "" {
+		err = s.requestEmailChange(repo.Tx, &current, newEmail)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(&u, updateUserErr, err)
+			return err
+		}
+
+		err = s.recordEvent(repo.Tx, newEvent(req.Origin, userEmailChangeRequestedEvt, userTarget, current.Slug.String, meta{"to": newEmail}))
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(&u, updateUserErr, err)
+			return err
+		}
+
+		msgID = emailChangeRequestedInfo
+	}
+
 	err = repo.Commit()
 	if err != nil {
 		res.FromModel(&u, updateUserErr, err)
@@ -197,11 +360,17 @@ func (s *Service) UpdateUser(req tp.UpdateUserReq, res *tp.UpdateUserRes) error
 	}
 
 	// Output
-	res.FromModel(&u, okResultInfo, nil)
+	res.FromModel(&u, msgID, nil)
+	res.PendingEmail = newEmail
 	return nil
 }
 
 func (s *Service) DeleteUser(req tp.DeleteUserReq, res *tp.DeleteUserRes) error {
+	if isImpersonating(req.Origin) {
+		res.FromModel(impersonatingErr, ErrImpersonating)
+		return ErrImpersonating
+	}
+
 	// Repo
 	repo, err := s.userRepo()
 	if err != nil {
@@ -209,8 +378,33 @@ func (s *Service) DeleteUser(req tp.DeleteUserReq, res *tp.DeleteUserRes) error
 		return err
 	}
 
-	err = repo.DeleteBySlug(req.Slug)
+	u, err := repo.GetBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(deleteUserErr, err)
+		return err
+	}
+
+	// Accounts would be deleted along with their owner.
+	err = s.checkOwnsNoAccounts(repo.Tx, u.ID.String())
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(ownsAccountsErr, err)
+		return err
+	}
+
+	// Soft delete, the user is purged after the retention period.
+	err = repo.DeleteBySlug(req.Slug, req.ActorID)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(deleteUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userDeletedEvt, userTarget, req.Slug, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(deleteUserErr, err)
 		return err
 	}
@@ -226,21 +420,113 @@ func (s *Service) DeleteUser(req tp.DeleteUserReq, res *tp.DeleteUserRes) error
 	return nil
 }
 
+// IndexDeletedUsers lists users waiting to be purged, only admins can see them.
+func (s *Service) IndexDeletedUsers(req tp.IndexUsersReq, res *tp.IndexUsersRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(nil, cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, adminErr(err, getAllUserErr), err)
+		return err
+	}
+
+	us, err := repo.GetAllDeleted()
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAllUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userDeletedListedEvt, userTarget, "", meta{"count": len(us)}))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(nil, getAllUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(nil, getAllUserErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(us, okResultInfo, nil)
+	return nil
+}
+
+// RestoreUser undoes the deletion of a user not yet purged, only admins can do it.
+func (s *Service) RestoreUser(req tp.RestoreUserReq, res *tp.RestoreUserRes) error {
+	// Repo
+	repo, err := s.userRepo()
+	if err != nil {
+		res.FromModel(cannotProcErr, err)
+		return err
+	}
+
+	err = s.requireAdmin(repo.Tx, req.Origin)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(adminErr(err, restoreUserErr), err)
+		return err
+	}
+
+	err = repo.RestoreBySlug(req.Slug)
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(restoreUserErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userRestoredEvt, userTarget, req.Slug, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(restoreUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(restoreUserErr, err)
+		return err
+	}
+
+	// Output
+	res.FromModel(userRestoredInfo, nil)
+	return nil
+}
+
 func (s *Service) SignUpUser(req tp.SignUpUserReq, res *tp.SignUpUserRes) error {
 	// Model
 	u := req.ToModel()
 
 	// Validation
-	v := NewUserValidator(u)
+	v := s.newUserValidator(u, nil)
 
 	err := v.ValidateForSignUp()
 	if err != nil {
 		res.FromModel(&u, validationErr, err)
+		return err
 	}
 
 	// Generate confirmation token
 	u.GenConfirmationToken()
 
+	// Audit columns
+	// Signed up users are their own creators.
+	u.GenID()
+	u.CreatedByID = db.ToNullString(u.ID.String())
+	o := req.Origin
+	o.ActorID = u.ID.String()
+
 	// Repo
 	repo, err := s.userRepo()
 	if err != nil {
@@ -250,18 +536,32 @@ func (s *Service) SignUpUser(req tp.SignUpUserReq, res *tp.SignUpUserRes) error
 
 	err = repo.Create(&u)
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, cannotProcErr, err)
 		return err
 	}
 
-	err = repo.Commit()
+	// Mail confirmation
+	err = s.queueConfirmationEmail(repo.Tx, &u)
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, createUserErr, err)
 		return err
 	}
 
-	// Mail confirmation
-	s.sendConfirmationEmail(&u)
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(o, userSignedUpEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, createUserErr, err)
+		return err
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		res.FromModel(&u, createUserErr, err)
+		return err
+	}
 
 	// Output
 	res.FromModel(&u, okResultInfo, nil)
@@ -281,8 +581,11 @@ func (s *Service) ConfirmUser(req tp.GetUserReq, res *tp.GetUserRes) error {
 
 	s.Log().Debug("Values", "slug", u.Slug.String, "token", u.ConfirmationToken.String)
 
+	slug := u.Slug.String
 	u, err = repo.GetBySlugAndToken(u.Slug.String, u.ConfirmationToken.String)
 	if err != nil {
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, userConfirmFailedEvt, userTarget, slug, nil))
 		res.FromModel(&u, confirmationErr, err)
 		return err
 	}
@@ -294,6 +597,15 @@ func (s *Service) ConfirmUser(req tp.GetUserReq, res *tp.GetUserRes) error {
 
 	u, err = repo.ConfirmUser(u.Slug.String, u.ConfirmationToken.String)
 	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, confirmationErr, err)
+		return err
+	}
+
+	// Audit
+	err = s.recordEvent(repo.Tx, newEvent(req.Origin, userConfirmedEvt, userTarget, u.Slug.String, nil))
+	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, confirmationErr, err)
 		return err
 	}
@@ -320,12 +632,61 @@ func (s *Service) SignInUser(req tp.SignInUserReq, res *tp.SignInUserRes) error
 		return err
 	}
 
-	u, err = repo.SignIn(u.Username.String, u.Password)
+	username := u.Username.String
+	u, dir, err := s.authenticate(repo.Tx, u.Username.String, u.Password, req.Origin)
+	if err != nil {
+		md := meta{"username": username}
+		if dir != nil {
+			md["directory"] = dir.Name
+		}
+
+		repo.Tx.Rollback()
+		s.recordFailure(newEvent(req.Origin, userSignInFailedEvt, userTarget, u.Slug.String, md))
+		res.FromModel(&u, signinErr, err)
+		return err
+	}
+
+	// Session
+	session, token, verificationToken, ra, err := s.openSession(repo.Tx, &u, req.Origin)
 	if err != nil {
+		repo.Tx.Rollback()
 		res.FromModel(&u, signinErr, err)
 		return err
 	}
 
+	o := req.Origin
+	o.ActorID = u.ID.String()
+	o.SessionID = session.ID.String()
+
+	// Audit
+	evt := userSignedInEvt
+	if session.IsPending() {
+		evt = sessionChallengedEvt
+	}
+
+	md := meta{"session": o.SessionID, "risk": ra.Score, "reasons": ra.Reasons}
+	if dir != nil {
+		md["method"] = "ldap"
+		md["directory"] = dir.Name
+	}
+
+	err = s.recordEvent(repo.Tx, newEvent(o, evt, userTarget, u.Slug.String, md))
+	if err != nil {
+		repo.Tx.Rollback()
+		res.FromModel(&u, signinErr, err)
+		return err
+	}
+
+	// Mail alert
+	if session.IsPending() {
+		err = s.queueSignInAlertEmail(repo.Tx, &u, session, verificationToken)
+		if err != nil {
+			repo.Tx.Rollback()
+			res.FromModel(&u, signinErr, err)
+			return err
+		}
+	}
+
 	err = repo.Commit()
 	if err != nil {
 		res.FromModel(&u, signinErr, err)
@@ -334,6 +695,8 @@ func (s *Service) SignInUser(req tp.SignInUserReq, res *tp.SignInUserRes) error
 
 	// Output
 	res.FromModel(&u, okResultInfo, nil)
+	res.SessionToken = token
+	res.VerificationRequired = session.IsPending()
 	return nil
 }
 
@@ -341,3 +704,8 @@ func (s *Service) SignInUser(req tp.SignInUserReq, res *tp.SignInUserRes) error
 func (s *Service) userRepo() (*repo.UserRepo, error) {
 	return s.repo.UserRepoNewTx()
 }
+
+// isNoChanges returns true if update found nothing to change.
+func isNoChanges(err error) bool {
+	return err == repo.ErrNoChanges
+}
@@ -1,10 +1,13 @@
-package service
+package service_test
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	//"github.com/davecgh/go-spew/spew"
@@ -14,6 +17,7 @@ import (
 	"gitlab.com/mikrowezel/backend/db"
 	"gitlab.com/mikrowezel/backend/granica/internal/migration"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/password"
 	"gitlab.com/mikrowezel/backend/granica/internal/repo"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
 	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
@@ -21,6 +25,16 @@ import (
 	mwmig "gitlab.com/mikrowezel/backend/migration"
 )
 
+// Service result message IDs.
+const (
+	okResultInfo  = "ok_result"
+	validationErr = "validation_error_err"
+	forbiddenErr  = "forbidden_err"
+)
+
+// namedUserPassword is the password of users made by createNamedUser.
+const namedUserPassword = "vC7#kq2!Lm9z"
+
 var (
 	userDataValid = map[string]string{
 		"username":          "username",
@@ -80,7 +94,7 @@ func TestMock(t *testing.T) {
 func TestCreateUser(t *testing.T) {
 	// Setup
 	req := tp.CreateUserReq{
-		tp.User{
+		User: tp.User{
 			Username:          userDataValid["username"],
 			Password:          userDataValid["password"],
 			Email:             userDataValid["email"],
@@ -166,8 +180,8 @@ func TestAllIndexUsers(t *testing.T) {
 		t.Error("no response")
 	}
 
-	if res.Error != "" {
-		t.Errorf("Response error: %s", res.Error)
+	if res.MsgID != okResultInfo {
+		t.Errorf("Response message: %s", res.MsgID)
 	}
 
 	qty := len(vUsers)
@@ -190,8 +204,8 @@ func TestGetUser(t *testing.T) {
 
 	// Setup
 	req := tp.GetUserReq{
-		tp.Identifier{
-			Username: users[0].Username.String,
+		Identifier: tp.Identifier{
+			Slug: users[0].Slug.String,
 		},
 	}
 
@@ -223,8 +237,8 @@ func TestGetUser(t *testing.T) {
 	}
 
 	// Verify
-	if res.Error != "" {
-		t.Errorf("Response error: %s", res.Error)
+	if res.MsgID != okResultInfo {
+		t.Errorf("Response message: %s", res.MsgID)
 	}
 
 	user := res.User
@@ -244,10 +258,10 @@ func TestUpdateUser(t *testing.T) {
 	// Setup
 	user := users[0]
 	req := tp.UpdateUserReq{
-		tp.Identifier{
-			Username: user.Username.String,
+		Identifier: tp.Identifier{
+			Slug: user.Slug.String,
 		},
-		tp.User{
+		User: tp.User{
 			Username:          userUpdateDataValid["username"],
 			Password:          userUpdateDataValid["password"],
 			Email:             userUpdateDataValid["email"],
@@ -256,6 +270,9 @@ func TestUpdateUser(t *testing.T) {
 			MiddleNames:       userUpdateDataValid["middleNames"],
 			FamilyName:        userUpdateDataValid["familyName"],
 		},
+		Origin: tp.Origin{
+			ActorID: user.ID.String(),
+		},
 	}
 
 	var res tp.UpdateUserRes
@@ -289,9 +306,18 @@ func TestUpdateUser(t *testing.T) {
 	}
 
 	// TODO: Add accurate check of all updated fields.
-	if userVerify.Email.String != userUpdateDataValid["email"] {
+	if userVerify.GivenName.String != userUpdateDataValid["givenName"] {
 		t.Error("obtained values do not match expected ones")
 	}
+
+	// Email only changes after the new address confirms it.
+	if userVerify.Email.String != userSample1["email"] {
+		t.Error("email should not change before confirmation")
+	}
+
+	if res.PendingEmail != userUpdateDataValid["email"] {
+		t.Error("email change should be pending")
+	}
 }
 
 // TestDeleteUser tests delete users from repo.
@@ -306,7 +332,7 @@ func TestDeleteUser(t *testing.T) {
 	user := users[0]
 	req := tp.DeleteUserReq{
 		Identifier: tp.Identifier{
-			Username: user.Username.String,
+			Slug: user.Slug.String,
 		},
 	}
 
@@ -334,15 +360,76 @@ func TestDeleteUser(t *testing.T) {
 	// Verify
 	vUser, err := getUserBySlug(user.Slug.String, cfg)
 	if err != nil {
+		t.Errorf("cannot get user from database: %s", err.Error())
 		return
 	}
 
-	if vUser == nil {
-		return
+	if !vUser.IsDeleted.Bool {
+		t.Error("user was not marked as deleted")
 	}
+}
 
-	if vUser.Username.String == user.Username.String {
-		t.Error("user was not deleted from database")
+// TestSignUpUserRejectsPassword tests that weak
+// and breached passwords are not accepted on sign-up.
+func TestSignUpUserRejectsPassword(t *testing.T) {
+	breached := "correct horse battery staple"
+	h := sha1.Sum([]byte(breached))
+	line := strings.ToUpper(hex.EncodeToString(h[:])) + ":3\n"
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{"weak", "weakpassword", "password"},
+		{"breached", "breachedpassword", breached},
+	}
+
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	// Repo
+	userRepo, err := testRepo(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// Service
+	s := testService(ctx, cfg, log, userRepo)
+	s.SetPasswordPolicies(&password.Policies{
+		Default: password.Policy{MinLength: 8, MaxLength: 32, MinScore: 2, CheckBreached: true},
+	})
+	s.SetBreachedCorpus(password.NewCorpus(strings.NewReader(line), int64(len(line))))
+
+	for _, tt := range tests {
+		// Setup
+		req := tp.SignUpUserReq{
+			User: tp.User{
+				Username:          tt.username,
+				Password:          tt.password,
+				Email:             tt.username + "@mail.com",
+				EmailConfirmation: tt.username + "@mail.com",
+			},
+		}
+
+		var res tp.SignUpUserRes
+
+		// Test
+		err = s.SignUpUser(req, &res)
+		if err == nil {
+			t.Errorf("%s password should be rejected", tt.name)
+		}
+
+		if res.MsgID != validationErr {
+			t.Errorf("%s password response message: %s", tt.name, res.MsgID)
+		}
+
+		// Verify
+		_, err = getUserByUsername(tt.username, cfg)
+		if err == nil {
+			t.Errorf("user with %s password should not be created", tt.name)
+		}
 	}
 }
 
@@ -464,6 +551,54 @@ func createUser(r *repo.Repo, user *model.User) error {
 	return nil
 }
 
+// createNamedUser creates a user whose credentials are
+// its username and namedUserPassword.
+func createNamedUser(username string) (*model.User, error) {
+	ctx := context.Background()
+	cfg := testConfig()
+	log := testLogger()
+
+	r, err := repo.NewHandler(ctx, cfg, log, "repo-handler")
+	if err != nil {
+		return nil, err
+	}
+	r.Connect()
+
+	user := &model.User{
+		Username:          db.ToNullString(username),
+		Password:          namedUserPassword,
+		Email:             db.ToNullString(username + "@mail.com"),
+		EmailConfirmation: db.ToNullString(username + "@mail.com"),
+		IsConfirmed:       db.ToNullBool(true),
+	}
+
+	err = createUser(r, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// grantAdmin makes the user an admin.
+func grantAdmin(r *repo.Repo, user *model.User) error {
+	roleRepo, err := r.RoleRepoNewTx()
+	if err != nil {
+		return err
+	}
+
+	role := &model.Role{UserID: user.ID.String(), Name: model.RoleAdmin}
+	role.SetCreateValues()
+
+	err = roleRepo.Grant(role)
+	if err != nil {
+		roleRepo.Tx.Rollback()
+		return err
+	}
+
+	return roleRepo.Commit()
+}
+
 func setup() *mwmig.Migrator {
 	m := migration.GetMigrator(testConfig())
 	// m.Reset()
@@ -493,6 +628,22 @@ func testConfig() *config.Config {
 	return cfg
 }
 
+// testConfigWith returns the test config along with the given values.
+func testConfigWith(values map[string]string) *config.Config {
+	cfg := testConfig()
+
+	merged := map[string]string{}
+	for k, v := range cfg.Get() {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+
+	cfg.SetValues(merged)
+	return cfg
+}
+
 func testLogger() *log.Logger {
 	return log.NewDevLogger(0, "granica", "n/a")
 }
@@ -4,23 +4,46 @@ import (
 	"errors"
 
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/granica/internal/password"
 	"gitlab.com/mikrowezel/backend/service"
 )
 
+const (
+	wrongPasswordErrMsg = "wrong_password_err_msg"
+)
+
 type (
 	UserValidator struct {
 		Model model.User
 		service.Validator
+		// Password policy
+		policy  password.Policy
+		corpus  password.Corpus
+		history []string
 	}
 )
 
+// NewUserValidator returns a validator that applies the default password policy,
+// see WithPasswordPolicy.
 func NewUserValidator(u model.User) UserValidator {
 	return UserValidator{
 		Model:     u,
 		Validator: service.NewValidator(),
+		policy:    password.DefaultPolicy,
+		corpus:    password.NopCorpus{},
 	}
 }
 
+// WithPasswordPolicy returns a copy of the validator applying policy p.
+// Breached passwords are looked up in c, history contains the digests
+// of the current and previous user passwords.
+func (uv UserValidator) WithPasswordPolicy(p password.Policy, c password.Corpus, history []string) UserValidator {
+	uv.policy = p
+	uv.corpus = c
+	uv.history = history
+	return uv
+}
+
 func (uv UserValidator) ValidateForCreate() error {
 	// Username
 	ok0 := uv.ValidateRequiredUsername()
@@ -31,22 +54,21 @@ func (uv UserValidator) ValidateForCreate() error {
 	ok4 := uv.ValidateEmailConfirmation()
 	// Password
 	ok5 := uv.ValidateRequiredPassword()
-	ok6 := uv.ValidateMinLengthPassword(8)
-	ok7 := uv.ValidateMaxLengthPassword(32)
+	ok6 := uv.ValidatePolicyPassword()
 	// GivenName
-	ok8 := uv.ValidateRequiredGivenName()
+	ok7 := uv.ValidateRequiredGivenName()
 	// FamilyName
-	ok9 := uv.ValidateRequiredFamilyName()
+	ok8 := uv.ValidateRequiredFamilyName()
 
-	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 && ok7 && ok8 && ok9 {
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 && ok7 && ok8 {
 		return nil
 	}
 
 	return errors.New("user has errors")
 }
 
-// NOTE: Update validations shoud be different
-// than the ones executed on creation.
+// ValidateForUpdate differs from creation validations in that
+// password is not checked: it can only be changed through ValidateForPasswordChange.
 func (uv UserValidator) ValidateForUpdate() error {
 	// Username
 	ok0 := uv.ValidateRequiredUsername()
@@ -55,22 +77,35 @@ func (uv UserValidator) ValidateForUpdate() error {
 	// Email
 	ok3 := uv.ValidateEmailEmail()
 	ok4 := uv.ValidateEmailConfirmation()
-	// Password
-	ok5 := uv.ValidateRequiredPassword()
-	ok6 := uv.ValidateMinLengthPassword(8)
-	ok7 := uv.ValidateMaxLengthPassword(32)
 	// GivenName
-	ok8 := uv.ValidateRequiredGivenName()
+	ok5 := uv.ValidateRequiredGivenName()
 	// FamilyName
-	ok9 := uv.ValidateRequiredFamilyName()
+	ok6 := uv.ValidateRequiredFamilyName()
 
-	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 && ok7 && ok8 && ok9 {
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 {
 		return nil
 	}
 
 	return errors.New("user has errors")
 }
 
+// ValidateForPasswordChange checks current password against
+// the model digest and the new one set in model Password.
+func (uv UserValidator) ValidateForPasswordChange(current, confirmation string) error {
+	// Current
+	ok0 := uv.ValidateCurrentPassword(current)
+	// Password
+	ok1 := uv.ValidateRequiredPassword()
+	ok2 := uv.ValidatePolicyPassword()
+	ok3 := uv.ValidatePasswordConfirmation(confirmation)
+
+	if ok0 && ok1 && ok2 && ok3 {
+		return nil
+	}
+
+	return errors.New("password has errors")
+}
+
 func (uv UserValidator) ValidateForSignUp() error {
 	// Username
 	ok0 := uv.ValidateRequiredUsername()
@@ -81,10 +116,63 @@ func (uv UserValidator) ValidateForSignUp() error {
 	ok4 := uv.ValidateEmailConfirmation()
 	// Password
 	ok5 := uv.ValidateRequiredPassword()
-	ok6 := uv.ValidateMinLengthPassword(8)
-	ok7 := uv.ValidateMaxLengthPassword(32)
+	ok6 := uv.ValidatePolicyPassword()
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 {
+		return nil
+	}
+
+	return errors.New("user has errors")
+}
+
+// ValidateForProvision checks users created on their first sign-in
+// through an identity provider, they have no password.
+func (uv UserValidator) ValidateForProvision() error {
+	// Username
+	ok0 := uv.ValidateRequiredUsername()
+	ok1 := uv.ValidateMinLengthUsername(4)
+	ok2 := uv.ValidateMaxLengthUsername(16)
+	// Email
+	ok3 := uv.ValidateEmailEmail()
+	ok4 := uv.ValidateEmailConfirmation()
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 {
+		return nil
+	}
+
+	return errors.New("user has errors")
+}
+
+// ValidateForSCIM checks users managed by a provisioning client.
+// Identity providers often use email addresses as user names,
+// they are only limited by the stored length. Password is optional.
+func (uv UserValidator) ValidateForSCIM() error {
+	// Username
+	ok0 := uv.ValidateRequiredUsername()
+	ok1 := uv.ValidateMinLengthUsername(4)
+	ok2 := uv.ValidateMaxLengthUsername(32)
+	// Email
+	ok3 := uv.ValidateEmailEmail()
+	ok4 := uv.ValidateEmailConfirmation()
+	// Password
+	ok5 := uv.ValidatePolicyPassword()
+
+	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 {
+		return nil
+	}
+
+	return errors.New("user has errors")
+}
+
+// ValidateForServiceAccount checks non-human users,
+// they have neither email nor password.
+func (uv UserValidator) ValidateForServiceAccount() error {
+	// Username
+	ok0 := uv.ValidateRequiredUsername()
+	ok1 := uv.ValidateMinLengthUsername(4)
+	ok2 := uv.ValidateMaxLengthUsername(32)
 
-	if ok0 && ok1 && ok2 && ok3 && ok4 && ok5 && ok6 && ok7 {
+	if ok0 && ok1 && ok2 {
 		return nil
 	}
 
@@ -228,6 +316,68 @@ func (uv UserValidator) ValidateMaxLengthPassword(max int, errMsg ...string) (ok
 	return false
 }
 
+// ValidatePolicyPassword appends the password policy violations, if any.
+// Empty passwords are left to ValidateRequiredPassword.
+func (uv UserValidator) ValidatePolicyPassword() (ok bool) {
+	u := uv.Model
+
+	if u.Password == "" {
+		return true
+	}
+
+	in := password.Input{
+		Password: u.Password,
+		Username: u.Username.String,
+		Email:    u.Email.String,
+		History:  uv.history,
+	}
+
+	// Corpus lookup errors do not prevent validation,
+	// the password is then not considered breached.
+	vs, _ := uv.policy.Check(in, uv.corpus)
+	if len(vs) == 0 {
+		return true
+	}
+
+	uv.Errors["Password"] = append(uv.Errors["Password"], vs...)
+	return false
+}
+
+func (uv UserValidator) ValidatePasswordConfirmation(confirmation string, errMsg ...string) (ok bool) {
+	u := uv.Model
+
+	ok = uv.ValidateConfirmation(u.Password, confirmation)
+	if ok {
+		return true
+	}
+
+	msg := service.NoMatchErrMsg
+	if len(errMsg) > 0 {
+		msg = errMsg[0]
+	}
+
+	uv.Errors["Password"] = append(uv.Errors["Password"], msg)
+	uv.Errors["PasswordConfirmation"] = append(uv.Errors["PasswordConfirmation"], msg)
+	return false
+}
+
+func (uv UserValidator) ValidateCurrentPassword(current string, errMsg ...string) (ok bool) {
+	u := uv.Model
+
+	ok = u.PasswordMatches(current)
+	if ok {
+		return true
+	}
+
+	msg := wrongPasswordErrMsg
+	if len(errMsg) > 0 {
+		msg = errMsg[0]
+	}
+
+	uv.Errors["CurrentPassword"] = append(uv.Errors["CurrentPassword"], msg)
+	return false
+}
+
 func (uv UserValidator) ValidateRequiredGivenName(errMsg ...string) (ok bool) {
 	u := uv.Model
 
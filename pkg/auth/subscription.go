@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"time"
+)
+
+// StartSubscriptionWorker periodically enforces account subscription windows,
+// activating, warning about and deactivating accounts as their dates pass.
+func (a *Auth) StartSubscriptionWorker() error {
+	// Set envar GRN_APP_SUBSCRIPTION_INTERVAL_MINUTES to change
+	// how often the subscription job runs.
+	m := a.Cfg().ValAsInt("app.subscription.interval.minutes", 60)
+	if m <= 0 {
+		a.Log().Info("Subscription job disabled")
+		return nil
+	}
+
+	a.Log().Info("Subscription job initializing", "interval-minutes", m)
+
+	t := time.NewTicker(time.Duration(m) * time.Minute)
+	defer t.Stop()
+
+	for {
+		a.processSubscriptions()
+
+		select {
+		case <-t.C:
+		case <-a.Ctx().Done():
+			return nil
+		}
+	}
+}
+
+func (a *Auth) processSubscriptions() {
+	activated, warned, deactivated, err := a.service.ProcessSubscriptions()
+	if err != nil {
+		a.Log().Error(err, "job", "subscription")
+	}
+
+	a.Log().Info("Subscription job done", "activated", activated, "warned", warned, "deactivated", deactivated)
+}
@@ -1,46 +1,69 @@
 package transport
 
+import (
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
 type (
 	// Account request and response data.
 	Account struct {
-		TenantID    string `json:"tenantID"`
-		Slug        string `json:"slug"`
-		Name        string `json:"name"`
-		OwnerID     string `json:"ownerID"`
-		ParentID    string `json:"parentID"`
-		AccountType string `json:"accountType"`
-		Email       string `json:"email"`
-		StartsAt    string `json:"startsAt"`
-		EndsAt      string `json:"endsAt"`
+		TenantID    string `json:"tenantID" schema:"-"`
+		Slug        string `json:"slug" schema:"slug"`
+		Name        string `json:"name" schema:"name"`
+		OwnerID     string `json:"ownerID" schema:"owner-id"`
+		ParentID    string `json:"parentID" schema:"parent-id"`
+		AccountType string `json:"accountType" schema:"account-type"`
+		Email       string `json:"email" schema:"email"`
+		StartsAt    string `json:"startsAt" schema:"starts-at"`
+		EndsAt      string `json:"endsAt" schema:"ends-at"`
+		IsNew       bool   `json:"-" schema:"-"`
 	}
 
 	Accounts []Account
 )
 
+func (a Account) GetSlug() string {
+	return a.Slug
+}
+
 type (
 	// CreateAccountReq input data.
 	CreateAccountReq struct {
 		Account
+		Origin `json:"-" schema:"-"`
 	}
 
 	// CreateAccountRes output data.
 	CreateAccountRes struct {
 		Account
-		Msg   string `json:"msg,omitempty"`
-		Error string `json:"err,omitempty"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
 	}
 )
 
 type (
 	// GetAccountsReq input data.
 	GetAccountsReq struct {
+		Origin `json:"-" schema:"-"`
 	}
 
 	// GetAccountsRes output data.
 	GetAccountsRes struct {
 		Accounts
-		Msg   string `json:"msg,omitempty"`
-		Error string `json:"err,omitempty"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
 	}
 )
 
@@ -48,13 +71,22 @@ type (
 	// GetAccountReq input data.
 	GetAccountReq struct {
 		Identifier
+		Origin `json:"-" schema:"-"`
 	}
 
 	// GetAccountRes output data.
 	GetAccountRes struct {
 		Account
-		Msg   string `json:"msg,omitempty"`
-		Error string `json:"err,omitempty"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
 	}
 )
 
@@ -63,13 +95,22 @@ type (
 	UpdateAccountReq struct {
 		Identifier
 		Account
+		Origin `json:"-" schema:"-"`
 	}
 
 	// UpdateAccountRes output data.
 	UpdateAccountRes struct {
 		Account
-		Msg   string `json:"msg,omitempty"`
-		Error string `json:"err,omitempty"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
 	}
 )
 
@@ -77,11 +118,32 @@ type (
 	// DeleteAccountReq input data.
 	DeleteAccountReq struct {
 		Identifier
+		Origin `json:"-" schema:"-"`
 	}
 
 	// DeleteAccountRes output data.
 	DeleteAccountRes struct {
-		Msg   string `json:"msg,omitempty"`
-		Error string `json:"err,omitempty"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RestoreAccountReq input data.
+	RestoreAccountReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RestoreAccountRes output data.
+	RestoreAccountRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
 	}
 )
@@ -4,8 +4,14 @@ import (
 	"gitlab.com/mikrowezel/backend/db"
 	"gitlab.com/mikrowezel/backend/granica/internal/model"
 	m "gitlab.com/mikrowezel/backend/model"
+	"gitlab.com/mikrowezel/backend/service"
 )
 
+// AccountTypes lists the types an account can have.
+func AccountTypes() []string {
+	return model.AccountTypes
+}
+
 func (req *CreateAccountReq) ToModel() model.Account {
 	return model.Account{
 		Name:        db.ToNullString(req.Name),
@@ -13,10 +19,12 @@ func (req *CreateAccountReq) ToModel() model.Account {
 		OwnerID:     db.ToNullString(req.OwnerID),
 		ParentID:    db.ToNullString(req.ParentID),
 		Email:       db.ToNullString(req.Email),
+		StartsAt:    parseNullTime(req.StartsAt),
+		EndsAt:      parseNullTime(req.EndsAt),
 	}
 }
 
-func (res *CreateAccountRes) FromModel(m *model.Account, msg string, err error) {
+func (res *CreateAccountRes) FromModel(m *model.Account, errors service.ErrorSet, msgID string, err error) {
 	if m != nil {
 		res.Account = Account{
 			Slug:        m.Slug.String,
@@ -25,32 +33,34 @@ func (res *CreateAccountRes) FromModel(m *model.Account, msg string, err error)
 			OwnerID:     m.OwnerID.String,
 			ParentID:    m.ParentID.String,
 			Email:       m.Email.String,
+			StartsAt:    formatNullTime(m.StartsAt),
+			EndsAt:      formatNullTime(m.EndsAt),
 		}
 	}
-	res.Msg = msg
-	if err != nil {
-		res.Error = err.Error()
-	}
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
 }
 
 // getAccounts -------------------------------------------------------------------
-func (res *GetAccountsRes) FromModel(ms []model.Account, msg string, err error) {
+func (res *GetAccountsRes) FromModel(ms []model.Account, msgID string, err error) {
 	resAccounts := []Account{}
 	for _, m := range ms {
 		res := Account{
+			Slug:        m.Slug.String,
 			Name:        m.Name.String,
 			AccountType: m.AccountType.String,
 			OwnerID:     m.OwnerID.String,
 			ParentID:    m.ParentID.String,
 			Email:       m.Email.String,
+			StartsAt:    formatNullTime(m.StartsAt),
+			EndsAt:      formatNullTime(m.EndsAt),
 		}
 		resAccounts = append(resAccounts, res)
 	}
 	res.Accounts = resAccounts
-	res.Msg = msg
-	if err != nil {
-		res.Error = err.Error()
-	}
+	res.MsgID = msgID
+	res.err = err
 }
 
 // getAccount ---------------------------------------------------------------------
@@ -62,24 +72,25 @@ func (req *GetAccountReq) ToModel() model.Account {
 	}
 }
 
-func (res *GetAccountRes) FromModel(m *model.Account, msg string, err error) {
+func (res *GetAccountRes) FromModel(m *model.Account, msgID string, err error) {
 	if m != nil {
 		res.Account = Account{
+			Slug:        m.Slug.String,
 			Name:        m.Name.String,
 			AccountType: m.AccountType.String,
 			OwnerID:     m.OwnerID.String,
 			ParentID:    m.ParentID.String,
 			Email:       m.Email.String,
+			StartsAt:    formatNullTime(m.StartsAt),
+			EndsAt:      formatNullTime(m.EndsAt),
 		}
 	}
-	res.Msg = msg
-	if err != nil {
-		res.Error = err.Error()
-	}
+	res.MsgID = msgID
+	res.err = err
 }
 
 // updateAccount ------------------------------------------------------------------
-//func (a *Auth) makeUpdateAccountResJSON(m *model.Account, msg string, err error) ([]byte, error) {
+//func (a *Auth) makeUpdateAccountResJSON(m *model.Account, msgID string, err error) ([]byte, error) {
 //res := UpdateAccountRes{}
 //res.FromModel(m, msg, err)
 //return a.toJSON(res.Account)
@@ -96,10 +107,12 @@ func (req *UpdateAccountReq) ToModel() model.Account {
 		OwnerID:     db.ToNullString(req.OwnerID),
 		ParentID:    db.ToNullString(req.ParentID),
 		Email:       db.ToNullString(req.Email),
+		StartsAt:    parseNullTime(req.StartsAt),
+		EndsAt:      parseNullTime(req.EndsAt),
 	}
 }
 
-func (res *UpdateAccountRes) FromModel(m *model.Account, msg string, err error) {
+func (res *UpdateAccountRes) FromModel(m *model.Account, errors service.ErrorSet, msgID string, err error) {
 	if m != nil {
 		res.Account = Account{
 			Slug:        m.Slug.String,
@@ -108,18 +121,23 @@ func (res *UpdateAccountRes) FromModel(m *model.Account, msg string, err error)
 			OwnerID:     m.OwnerID.String,
 			ParentID:    m.ParentID.String,
 			Email:       m.Email.String,
+			StartsAt:    formatNullTime(m.StartsAt),
+			EndsAt:      formatNullTime(m.EndsAt),
 		}
 	}
-	res.Msg = msg
-	if err != nil {
-		res.Error = err.Error()
-	}
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
 }
 
 // deleteAccount ------------------------------------------------------------------
-func (res *DeleteAccountRes) FromModel(m *model.Account, msg string, err error) {
-	res.Msg = msg
-	if err != nil {
-		res.Error = err.Error()
-	}
+func (res *DeleteAccountRes) FromModel(m *model.Account, msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+// restoreAccount -----------------------------------------------------------------
+func (res *RestoreAccountRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
 }
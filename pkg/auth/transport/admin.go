@@ -0,0 +1,252 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// AdminStats response data.
+	AdminStats struct {
+		Users     int64      `json:"users"`
+		Confirmed int64      `json:"confirmed"`
+		Inactive  int64      `json:"inactive"`
+		Deleted   int64      `json:"deleted"`
+		Accounts  int64      `json:"accounts"`
+		Days      DailyStats `json:"days"`
+	}
+
+	// DailyStat response data.
+	DailyStat struct {
+		Day           string `json:"day"`
+		SignUps       int64  `json:"signUps"`
+		Confirmations int64  `json:"confirmations"`
+		FailedSignIns int64  `json:"failedSignIns"`
+	}
+
+	DailyStats []DailyStat
+
+	// AdminUser is a user as listed in the admin area.
+	AdminUser struct {
+		Slug        string   `json:"slug"`
+		Username    string   `json:"username"`
+		Email       string   `json:"email"`
+		Roles       []string `json:"roles"`
+		LastIP      string   `json:"lastIP,omitempty"`
+		CreatedAt   string   `json:"createdAt"`
+		IsConfirmed bool     `json:"isConfirmed"`
+		IsActive    bool     `json:"isActive"`
+	}
+
+	AdminUsers []AdminUser
+
+	// AdminAccount is an account as listed in the admin area.
+	AdminAccount struct {
+		Slug          string `json:"slug"`
+		Name          string `json:"name"`
+		AccountType   string `json:"accountType"`
+		Email         string `json:"email"`
+		OwnerUsername string `json:"ownerUsername,omitempty"`
+		CreatedAt     string `json:"createdAt"`
+		IsActive      bool   `json:"isActive"`
+		// TransferTo is the username of the new owner of a transfer awaiting approval.
+		TransferTo string `json:"transferTo,omitempty"`
+	}
+
+	AdminAccounts []AdminAccount
+
+	// Role response data.
+	Role struct {
+		Name      string `json:"name"`
+		Source    string `json:"source"`
+		CreatedAt string `json:"createdAt"`
+		// IsLocal roles are granted from Granica and can be revoked from it,
+		// the others are managed by their directory.
+		IsLocal bool `json:"isLocal"`
+	}
+
+	Roles []Role
+)
+
+func (u AdminUser) GetSlug() string {
+	return u.Slug
+}
+
+func (a AdminAccount) GetSlug() string {
+	return a.Slug
+}
+
+type (
+	// CheckAdminReq input data.
+	CheckAdminReq struct {
+		Origin `json:"-" schema:"-"`
+	}
+
+	// CheckAdminRes output data.
+	CheckAdminRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// GetAdminStatsReq input data.
+	GetAdminStatsReq struct {
+		Origin `json:"-" schema:"-"`
+	}
+
+	// GetAdminStatsRes output data.
+	GetAdminStatsRes struct {
+		AdminStats
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// IndexAdminUsersReq input data.
+	IndexAdminUsersReq struct {
+		Origin `json:"-" schema:"-"`
+	}
+
+	// IndexAdminUsersRes output data.
+	IndexAdminUsersRes struct {
+		AdminUsers AdminUsers
+		// BulkActions lists the actions that can be applied to selected users.
+		BulkActions []string
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// BulkUpdateUsersReq input data.
+	BulkUpdateUsersReq struct {
+		Action string   `json:"action" schema:"action"`
+		Slugs  []string `json:"slugs" schema:"slugs"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// BulkUpdateUsersRes output data.
+	// Users already in the requested state are skipped,
+	// the ones that could not be updated are listed in Failed.
+	BulkUpdateUsersRes struct {
+		Action  string   `json:"action"`
+		Updated int      `json:"updated"`
+		Skipped int      `json:"skipped"`
+		Failed  []string `json:"failed,omitempty"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// IndexAdminAccountsReq input data.
+	IndexAdminAccountsReq struct {
+		Origin `json:"-" schema:"-"`
+	}
+
+	// IndexAdminAccountsRes output data.
+	IndexAdminAccountsRes struct {
+		AdminAccounts AdminAccounts
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// SetAccountActiveReq input data.
+	SetAccountActiveReq struct {
+		Identifier
+		Active bool `json:"active" schema:"active"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// SetAccountActiveRes output data.
+	SetAccountActiveRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// GetUserRolesReq input data.
+	GetUserRolesReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// GetUserRolesRes output data.
+	GetUserRolesRes struct {
+		User
+		Roles Roles
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// GrantRoleReq input data.
+	GrantRoleReq struct {
+		Identifier
+		Name   string `json:"name" schema:"name"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// GrantRoleRes output data.
+	GrantRoleRes struct {
+		Role Role `json:"role"`
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RevokeRoleReq input data.
+	RevokeRoleReq struct {
+		Identifier
+		Name   string `json:"name" schema:"name"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RevokeRoleRes output data.
+	RevokeRoleRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
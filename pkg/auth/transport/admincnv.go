@@ -0,0 +1,127 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+func (res *CheckAdminRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *GetAdminStatsRes) FromModel(t *model.Totals, days []model.DailyStat, msgID string, err error) {
+	if t != nil {
+		res.AdminStats = AdminStats{
+			Users:     t.Users,
+			Confirmed: t.Confirmed,
+			Inactive:  t.Inactive,
+			Deleted:   t.Deleted,
+			Accounts:  t.Accounts,
+		}
+	}
+	res.Days = DailyStats{}
+	for _, d := range days {
+		res.Days = append(res.Days, DailyStat{
+			Day:           d.Day.Format("2006-01-02"),
+			SignUps:       d.SignUps,
+			Confirmations: d.Confirmations,
+			FailedSignIns: d.FailedSignIns,
+		})
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+// FromModel sets the listed users, roles are the names held by each user ID.
+func (res *IndexAdminUsersRes) FromModel(ms []model.User, roles map[string][]string, actions []string, msgID string, err error) {
+	res.AdminUsers = AdminUsers{}
+	for _, m := range ms {
+		res.AdminUsers = append(res.AdminUsers, AdminUser{
+			Slug:        m.Slug.String,
+			Username:    m.Username.String,
+			Email:       m.Email.String,
+			Roles:       roles[m.ID.String()],
+			LastIP:      m.LastIP.String,
+			CreatedAt:   formatNullTime(m.CreatedAt),
+			IsConfirmed: m.IsConfirmed.Bool,
+			IsActive:    !m.IsActive.Valid || m.IsActive.Bool,
+		})
+	}
+	res.BulkActions = actions
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *BulkUpdateUsersRes) FromModel(action string, updated, skipped int, failed []string, msgID string, err error) {
+	res.Action = action
+	res.Updated = updated
+	res.Skipped = skipped
+	res.Failed = failed
+	res.MsgID = msgID
+	res.err = err
+}
+
+// FromModel sets the listed accounts, owners are usernames by user ID
+// and transfers the new owner IDs of transfers awaiting approval by account ID.
+func (res *IndexAdminAccountsRes) FromModel(ms []model.Account, owners, transfers map[string]string, msgID string, err error) {
+	res.AdminAccounts = AdminAccounts{}
+	for _, m := range ms {
+		res.AdminAccounts = append(res.AdminAccounts, AdminAccount{
+			Slug:          m.Slug.String,
+			Name:          m.Name.String,
+			AccountType:   m.AccountType.String,
+			Email:         m.Email.String,
+			OwnerUsername: owners[m.OwnerID.String],
+			CreatedAt:     formatNullTime(m.CreatedAt),
+			IsActive:      !m.IsActive.Valid || m.IsActive.Bool,
+			TransferTo:    owners[transfers[m.ID.String()]],
+		})
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *SetAccountActiveRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *GetUserRolesRes) FromModel(u *model.User, rs []model.Role, msgID string, err error) {
+	if u != nil {
+		res.User = User{
+			Slug:     u.Slug.String,
+			Username: u.Username.String,
+			Email:    u.Email.String,
+		}
+	}
+	res.Roles = Roles{}
+	for _, r := range rs {
+		res.Roles = append(res.Roles, toRole(r))
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *GrantRoleRes) FromModel(r *model.Role, errors service.ErrorSet, msgID string, err error) {
+	if r != nil {
+		res.Role = toRole(*r)
+	}
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RevokeRoleRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func toRole(m model.Role) Role {
+	return Role{
+		Name:      m.Name,
+		Source:    m.Source,
+		CreatedAt: formatNullTime(m.CreatedAt),
+		IsLocal:   m.Source == model.RoleSourceLocal,
+	}
+}
@@ -0,0 +1,124 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// APIKey response data.
+	APIKey struct {
+		ID         string   `json:"id"`
+		Name       string   `json:"name"`
+		Prefix     string   `json:"prefix"`
+		Scopes     []string `json:"scopes"`
+		AllowedIPs string   `json:"allowedIPs,omitempty"`
+		CreatedAt  string   `json:"createdAt"`
+		ExpiresAt  string   `json:"expiresAt"`
+		LastUsedAt string   `json:"lastUsedAt,omitempty"`
+		LastUsedIP string   `json:"lastUsedIP,omitempty"`
+		IsActive   bool     `json:"isActive"`
+	}
+
+	APIKeys []APIKey
+)
+
+type (
+	// IndexAPIKeysReq input data.
+	// Account is set when Slug identifies an account instead of a user.
+	IndexAPIKeysReq struct {
+		Identifier
+		Account bool `json:"-" schema:"-"`
+		Origin  `json:"-" schema:"-"`
+	}
+
+	// IndexAPIKeysRes output data.
+	IndexAPIKeysRes struct {
+		// User owning the keys, only set for user keys.
+		User
+		APIKeys APIKeys
+		// Scopes lists the scopes a new key can be granted.
+		Scopes []string
+		// NewKey is only set right after a key is created
+		// so that it is shown once.
+		NewKey string `json:"-"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// CreateAPIKeyReq input data.
+	// ExpiresInDays uses the configured default if zero.
+	CreateAPIKeyReq struct {
+		Identifier
+		Account       bool     `json:"-" schema:"-"`
+		Name          string   `json:"name" schema:"name"`
+		Scopes        []string `json:"scopes" schema:"scopes"`
+		AllowedIPs    string   `json:"allowedIPs" schema:"allowed-ips"`
+		ExpiresInDays int      `json:"expiresInDays" schema:"expires-in-days"`
+		Origin        `json:"-" schema:"-"`
+	}
+
+	// CreateAPIKeyRes output data.
+	CreateAPIKeyRes struct {
+		APIKey APIKey `json:"apiKey"`
+		// Key is only returned once, on creation.
+		Key string `json:"key,omitempty"`
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RevokeAPIKeyReq input data.
+	RevokeAPIKeyReq struct {
+		Identifier
+		Account  bool   `json:"-" schema:"-"`
+		APIKeyID string `json:"apiKeyID" schema:"api-key-id"`
+		Origin   `json:"-" schema:"-"`
+	}
+
+	// RevokeAPIKeyRes output data.
+	RevokeAPIKeyRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// ValidateAPIKeyReq input data.
+	// Scope is the one the requested operation needs.
+	ValidateAPIKeyReq struct {
+		Key   string
+		Scope string
+		Origin
+	}
+
+	// ValidateAPIKeyRes output data.
+	ValidateAPIKeyRes struct {
+		CurrentSession
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
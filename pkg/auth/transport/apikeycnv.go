@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+func (res *IndexAPIKeysRes) FromModel(u *model.User, keys []model.APIKey, msgID string, err error) {
+	if u != nil {
+		res.User = User{
+			Slug:     u.Slug.String,
+			Username: u.Username.String,
+			Email:    u.Email.String,
+		}
+	}
+	res.APIKeys = toAPIKeys(keys)
+	res.Scopes = model.APIKeyScopes
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *CreateAPIKeyRes) FromModel(k *model.APIKey, key string, errors service.ErrorSet, msgID string, err error) {
+	if k != nil {
+		res.APIKey = toAPIKey(*k)
+	}
+	res.Key = key
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RevokeAPIKeyRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *ValidateAPIKeyRes) FromModel(k *model.APIKey, u *model.User, msgID string, err error) {
+	if k != nil && u != nil {
+		res.CurrentSession = CurrentSession{
+			UserID:   u.ID.String(),
+			UserSlug: u.Slug.String,
+			Username: u.Username.String,
+			APIKeyID: k.ID.String(),
+		}
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func toAPIKeys(ms []model.APIKey) APIKeys {
+	ks := APIKeys{}
+	for _, m := range ms {
+		ks = append(ks, toAPIKey(m))
+	}
+	return ks
+}
+
+func toAPIKey(m model.APIKey) APIKey {
+	return APIKey{
+		ID:         m.ID.String(),
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		Scopes:     m.ScopeList(),
+		AllowedIPs: m.AllowedIPs.String,
+		CreatedAt:  formatNullTime(m.CreatedAt),
+		ExpiresAt:  formatNullTime(m.ExpiresAt),
+		LastUsedAt: formatNullTime(m.LastUsedAt),
+		LastUsedIP: m.LastUsedIP.String,
+		IsActive:   m.IsActive(),
+	}
+}
@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"encoding/json"
+
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// AuditEvent response data.
+	AuditEvent struct {
+		ID         string          `json:"id"`
+		ActorID    string          `json:"actorID"`
+		TargetType string          `json:"targetType"`
+		TargetID   string          `json:"targetID"`
+		Action     string          `json:"action"`
+		IP         string          `json:"ip"`
+		UserAgent  string          `json:"userAgent"`
+		RequestID  string          `json:"requestID"`
+		Metadata   json.RawMessage `json:"metadata,omitempty"`
+		CreatedAt  string          `json:"createdAt"`
+	}
+
+	// AuditFilter request data.
+	// Dates use YYYY-MM-DD format, 'To' is inclusive.
+	AuditFilter struct {
+		Actor      string `json:"actor" schema:"actor"`
+		TargetType string `json:"targetType" schema:"target-type"`
+		Target     string `json:"target" schema:"target"`
+		Action     string `json:"action" schema:"action"`
+		From       string `json:"from" schema:"from"`
+		To         string `json:"to" schema:"to"`
+		Limit      int    `json:"limit" schema:"limit"`
+	}
+
+	AuditEvents []AuditEvent
+)
+
+type (
+	// IndexAuditEventsReq input data.
+	IndexAuditEventsReq struct {
+		AuditFilter
+		Origin `json:"-" schema:"-"`
+	}
+
+	// IndexAuditEventsRes output data.
+	IndexAuditEventsRes struct {
+		AuditEvents
+		// Filter is the applied filter, used to refill the filter form.
+		Filter AuditFilter
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,53 @@
+package transport
+
+import (
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+const (
+	dateLayout = "2006-01-02"
+)
+
+func (req *IndexAuditEventsReq) ToModel() model.AuditFilter {
+	f := model.AuditFilter{
+		ActorID:    req.Actor,
+		TargetType: req.TargetType,
+		TargetID:   req.Target,
+		Action:     req.Action,
+		Limit:      req.Limit,
+	}
+
+	if t, err := time.Parse(dateLayout, req.From); err == nil {
+		f.From = t
+	}
+
+	if t, err := time.Parse(dateLayout, req.To); err == nil {
+		f.To = t.AddDate(0, 0, 1)
+	}
+
+	return f
+}
+
+func (res *IndexAuditEventsRes) FromModel(ms []model.AuditEvent, msgID string, err error) {
+	resEvents := []AuditEvent{}
+	for _, m := range ms {
+		res := AuditEvent{
+			ID:         m.ID.String(),
+			ActorID:    m.ActorID.String,
+			TargetType: m.TargetType.String,
+			TargetID:   m.TargetID.String,
+			Action:     m.Action,
+			IP:         m.IP.String,
+			UserAgent:  m.UserAgent.String,
+			RequestID:  m.RequestID.String,
+			Metadata:   m.Metadata,
+			CreatedAt:  formatNullTime(m.CreatedAt),
+		}
+		resEvents = append(resEvents, res)
+	}
+	res.AuditEvents = resEvents
+	res.MsgID = msgID
+	res.err = err
+}
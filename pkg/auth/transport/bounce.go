@@ -0,0 +1,22 @@
+package transport
+
+type (
+	// MailNotificationReq input data.
+	// Body is kept raw, it is needed as is to verify its signature.
+	MailNotificationReq struct {
+		Body      []byte `json:"-"`
+		Signature string `json:"-"`
+		Origin    `json:"-" schema:"-"`
+	}
+
+	// MailNotificationRes output data.
+	MailNotificationRes struct {
+		// Suppressed is the number of addresses suppressed.
+		Suppressed int `json:"suppressed"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
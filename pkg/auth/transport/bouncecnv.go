@@ -0,0 +1,7 @@
+package transport
+
+func (res *MailNotificationRes) FromModel(suppressed int, msgID string, err error) {
+	res.Suppressed = suppressed
+	res.MsgID = msgID
+	res.err = err
+}
@@ -0,0 +1,42 @@
+package transport
+
+type (
+	// ConfirmEmailChangeReq input data.
+	// Token is the one sent to the new address.
+	ConfirmEmailChangeReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// ConfirmEmailChangeRes output data.
+	ConfirmEmailChangeRes struct {
+		// Email is the new, now current, user address.
+		Email string `json:"email"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// CancelEmailChangeReq input data.
+	// Token is the one sent to the old address.
+	CancelEmailChangeReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// CancelEmailChangeRes output data.
+	CancelEmailChangeRes struct {
+		// Email is the current user address.
+		Email string `json:"email"`
+		// Reverted is true if the change was already confirmed
+		// and the old address has been restored.
+		Reverted bool `json:"reverted"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,14 @@
+package transport
+
+func (res *ConfirmEmailChangeRes) FromModel(email, msgID string, err error) {
+	res.Email = email
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *CancelEmailChangeRes) FromModel(email string, reverted bool, msgID string, err error) {
+	res.Email = email
+	res.Reverted = reverted
+	res.MsgID = msgID
+	res.err = err
+}
@@ -0,0 +1,107 @@
+package transport
+
+type (
+	// IdentityProvider users can sign in with.
+	IdentityProvider struct {
+		Name  string `json:"name"`
+		Label string `json:"label"`
+	}
+
+	IdentityProviders []IdentityProvider
+
+	// Identity linked to a user.
+	Identity struct {
+		Provider   string `json:"provider"`
+		Label      string `json:"label"`
+		Email      string `json:"email,omitempty"`
+		CreatedAt  string `json:"createdAt"`
+		LastUsedAt string `json:"lastUsedAt,omitempty"`
+	}
+
+	Identities []Identity
+)
+
+// Has returns true if an identity from provider is in the list.
+func (is Identities) Has(provider string) bool {
+	for _, i := range is {
+		if i.Provider == provider {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	// FederatedAuthReq input data.
+	// Link is set by signed in users adding a provider to their account.
+	FederatedAuthReq struct {
+		Provider string `json:"-" schema:"-"`
+		Link     bool   `json:"link" schema:"link"`
+		Origin   `json:"-" schema:"-"`
+	}
+
+	// FederatedAuthRes output data.
+	// State, Nonce and Verifier have to be kept by the client
+	// and sent back along with the code returned by the provider.
+	FederatedAuthRes struct {
+		URL      string `json:"url"`
+		State    string `json:"state"`
+		Nonce    string `json:"nonce"`
+		Verifier string `json:"verifier"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// FederatedSignInReq input data.
+	// Code and State are the ones the provider redirected back with,
+	// the rest are the values returned by FederatedAuthRes.
+	FederatedSignInReq struct {
+		Provider      string `json:"-" schema:"-"`
+		Code          string `json:"code" schema:"code"`
+		State         string `json:"state" schema:"state"`
+		ExpectedState string `json:"expectedState" schema:"-"`
+		Nonce         string `json:"nonce" schema:"-"`
+		Verifier      string `json:"verifier" schema:"-"`
+		Link          bool   `json:"link" schema:"-"`
+		Origin        `json:"-" schema:"-"`
+	}
+
+	// FederatedSignInRes output data.
+	FederatedSignInRes struct {
+		User
+		// SessionToken identifies the session opened by this sign-in.
+		// Not set when linking a provider to an already signed in user.
+		SessionToken string `json:"sessionToken,omitempty"`
+		// VerificationRequired is set when the sign-in looks suspicious,
+		// session cannot be used until verified from the emailed link.
+		VerificationRequired bool `json:"verificationRequired,omitempty"`
+		// Linked is set when the provider identity has just been linked to the user.
+		Linked bool `json:"linked,omitempty"`
+		// Provisioned is set when the user has been created on this sign-in.
+		Provisioned bool `json:"provisioned,omitempty"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// UnlinkIdentityReq input data.
+	UnlinkIdentityReq struct {
+		Identifier
+		Provider string `json:"-" schema:"-"`
+		Origin   `json:"-" schema:"-"`
+	}
+
+	// UnlinkIdentityRes output data.
+	UnlinkIdentityRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,67 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (res *FederatedAuthRes) FromModel(url, state, nonce, verifier, msgID string, err error) {
+	res.URL = url
+	res.State = state
+	res.Nonce = nonce
+	res.Verifier = verifier
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *FederatedSignInRes) FromModel(m *model.User, msgID string, err error) {
+	if m != nil {
+		res.User = User{
+			Slug:     m.Slug.String,
+			Username: m.Username.String,
+			Email:    m.Email.String,
+		}
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *UnlinkIdentityRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+// SetIdentities adds to the security page the linked identities and
+// the providers still available to link.
+func (res *GetUserSecurityRes) SetIdentities(ms []model.Identity, providers IdentityProviders) {
+	labels := map[string]string{}
+	for _, p := range providers {
+		labels[p.Name] = p.Label
+	}
+
+	res.Identities = toIdentities(ms, labels)
+	res.Providers = IdentityProviders{}
+	for _, p := range providers {
+		if !res.Identities.Has(p.Name) {
+			res.Providers = append(res.Providers, p)
+		}
+	}
+}
+
+func toIdentities(ms []model.Identity, labels map[string]string) Identities {
+	is := Identities{}
+	for _, m := range ms {
+		label := labels[m.Provider]
+		if label == "" {
+			label = m.Provider
+		}
+
+		is = append(is, Identity{
+			Provider:   m.Provider,
+			Label:      label,
+			Email:      m.Email.String,
+			CreatedAt:  formatNullTime(m.CreatedAt),
+			LastUsedAt: formatNullTime(m.LastUsedAt),
+		})
+	}
+	return is
+}
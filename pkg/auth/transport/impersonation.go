@@ -0,0 +1,39 @@
+package transport
+
+type (
+	// ImpersonateUserReq input data.
+	ImpersonateUserReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// ImpersonateUserRes output data.
+	ImpersonateUserRes struct {
+		User
+		// SessionToken of the session opened for the admin as the user.
+		SessionToken string
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// StopImpersonationReq input data.
+	StopImpersonationReq struct {
+		Origin `json:"-" schema:"-"`
+	}
+
+	// StopImpersonationRes output data.
+	StopImpersonationRes struct {
+		// User the admin was acting as.
+		User
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,28 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (res *ImpersonateUserRes) FromModel(m *model.User, token, msgID string, err error) {
+	if m != nil {
+		res.User = User{
+			Slug:     m.Slug.String,
+			Username: m.Username.String,
+		}
+	}
+	res.SessionToken = token
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *StopImpersonationRes) FromModel(m *model.User, msgID string, err error) {
+	if m != nil {
+		res.User = User{
+			Slug:     m.Slug.String,
+			Username: m.Username.String,
+		}
+	}
+	res.MsgID = msgID
+	res.err = err
+}
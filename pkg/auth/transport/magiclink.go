@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// RequestMagicLinkReq input data.
+	RequestMagicLinkReq struct {
+		Email  string `json:"email" schema:"email"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RequestMagicLinkRes output data.
+	// Response is the same whether a link has been sent or not.
+	RequestMagicLinkRes struct {
+		// Binding has to be presented along with the emailed token,
+		// it ties the link to the client that requested it.
+		Binding string `json:"binding"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// MagicLinkSignInReq input data.
+	// Token is the one emailed to the user.
+	MagicLinkSignInReq struct {
+		Identifier
+		Binding string `json:"binding" schema:"-"`
+		// Confirmed is set when the user accepts to sign in
+		// from a client other than the one that requested the link.
+		Confirmed bool `json:"confirmed" schema:"confirmed"`
+		Origin    `json:"-" schema:"-"`
+	}
+
+	// MagicLinkSignInRes output data.
+	MagicLinkSignInRes struct {
+		User
+		// SessionToken identifies the session opened by this sign-in.
+		SessionToken string `json:"sessionToken,omitempty"`
+		// VerificationRequired is set when the sign-in looks suspicious,
+		// session cannot be used until verified from the emailed link.
+		VerificationRequired bool `json:"verificationRequired,omitempty"`
+		// ConfirmationRequired is set when the link is opened from a client
+		// other than the one that requested it, no session is opened then.
+		ConfirmationRequired bool `json:"confirmationRequired,omitempty"`
+		// Where and when the link was requested,
+		// shown when asking for confirmation.
+		RequestIP        string `json:"requestIP,omitempty"`
+		RequestUserAgent string `json:"requestUserAgent,omitempty"`
+		RequestedAt      string `json:"requestedAt,omitempty"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
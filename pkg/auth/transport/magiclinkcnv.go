@@ -0,0 +1,28 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (res *RequestMagicLinkRes) FromModel(binding, msgID string, err error) {
+	res.Binding = binding
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *MagicLinkSignInRes) FromModel(m *model.User, ml *model.MagicLink, msgID string, err error) {
+	if m != nil {
+		res.User = User{
+			Slug:     m.Slug.String,
+			Username: m.Username.String,
+			Email:    m.Email.String,
+		}
+	}
+	if ml != nil {
+		res.RequestIP = ml.IP.String
+		res.RequestUserAgent = ml.UserAgent.String
+		res.RequestedAt = formatNullTime(ml.CreatedAt)
+	}
+	res.MsgID = msgID
+	res.err = err
+}
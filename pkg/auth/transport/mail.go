@@ -0,0 +1,24 @@
+package transport
+
+type (
+	// PreviewMailReq input data.
+	PreviewMailReq struct {
+		// Name of the email, all available ones are listed if empty.
+		Name string `schema:"-"`
+		Lang string `schema:"lang"`
+	}
+
+	// PreviewMailRes output data.
+	PreviewMailRes struct {
+		Names   []string
+		Langs   []string
+		Subject string
+		HTML    string
+		Text    string
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,20 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+// MailLangs are the languages emails can be rendered in.
+var MailLangs = []string{"en", "es", "de", "pl"}
+
+func (res *PreviewMailRes) FromModel(names []string, m *model.Email, msgID string, err error) {
+	res.Names = names
+	res.Langs = MailLangs
+	if m != nil {
+		res.Subject = m.Subject
+		res.HTML = m.Body
+		res.Text = m.Text
+	}
+	res.MsgID = msgID
+	res.err = err
+}
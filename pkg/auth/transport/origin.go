@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+type (
+	// Origin describes who issued a request and from where.
+	// It is not part of the request payload, endpoints fill it
+	// before calling the service so that actions can be audited.
+	// ImpersonatorID is the admin acting as the actor, if any.
+	Origin struct {
+		ActorID        string
+		SessionID      string
+		APIKeyID       string
+		ImpersonatorID string
+		IP             string
+		UserAgent      string
+		RequestID      string
+	}
+)
+
+// MakeOrigin from an HTTP request.
+// It relies on middleware.RealIP and middleware.RequestID being in the chain,
+// the actor is taken from the current session, if any.
+func MakeOrigin(r *http.Request) Origin {
+	o := Origin{
+		IP:        remoteIP(r.RemoteAddr),
+		UserAgent: r.UserAgent(),
+		RequestID: middleware.GetReqID(r.Context()),
+	}
+
+	if cs, ok := CurrentSessionFrom(r.Context()); ok {
+		o.ActorID = cs.UserID
+		o.SessionID = cs.ID
+		o.APIKeyID = cs.APIKeyID
+		o.ImpersonatorID = cs.ImpersonatorID
+	}
+
+	return o
+}
+
+// remoteIP strips the port, if any, and discards non IP values.
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+
+	return ip.String()
+}
@@ -0,0 +1,94 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// OutboxEmail response data.
+	// Bodies are never included, they may carry confirmation and sign-in tokens.
+	OutboxEmail struct {
+		ID            string `json:"id"`
+		Kind          string `json:"kind"`
+		Recipient     string `json:"recipient"`
+		Subject       string `json:"subject"`
+		Status        string `json:"status"`
+		Attempts      int    `json:"attempts"`
+		NextAttemptAt string `json:"nextAttemptAt,omitempty"`
+		LastError     string `json:"lastError,omitempty"`
+		SentAt        string `json:"sentAt,omitempty"`
+		CreatedAt     string `json:"createdAt"`
+		IsDead        bool   `json:"isDead"`
+	}
+
+	// OutboxFilter request data.
+	OutboxFilter struct {
+		Status    string `json:"status" schema:"status"`
+		Recipient string `json:"recipient" schema:"recipient"`
+		Limit     int    `json:"limit" schema:"limit"`
+	}
+
+	OutboxEmails []OutboxEmail
+)
+
+type (
+	// IndexOutboxEmailsReq input data.
+	IndexOutboxEmailsReq struct {
+		OutboxFilter
+		Origin `json:"-" schema:"-"`
+	}
+
+	// IndexOutboxEmailsRes output data.
+	IndexOutboxEmailsRes struct {
+		OutboxEmails
+		// Filter is the applied filter, used to refill the filter form.
+		Filter OutboxFilter
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// GetOutboxEmailReq input data.
+	GetOutboxEmailReq struct {
+		ID     string `json:"id" schema:"-"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// GetOutboxEmailRes output data.
+	GetOutboxEmailRes struct {
+		OutboxEmail
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RequeueOutboxEmailReq input data.
+	RequeueOutboxEmailReq struct {
+		ID     string `json:"id" schema:"-"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RequeueOutboxEmailRes output data.
+	RequeueOutboxEmailRes struct {
+		OutboxEmail
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,60 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (req *IndexOutboxEmailsReq) ToModel() model.OutboxFilter {
+	return model.OutboxFilter{
+		Status:    req.Status,
+		Recipient: req.Recipient,
+		Limit:     req.Limit,
+	}
+}
+
+func (res *IndexOutboxEmailsRes) FromModel(ms []model.OutboxEmail, msgID string, err error) {
+	resEmails := []OutboxEmail{}
+	for _, m := range ms {
+		resEmails = append(resEmails, toOutboxEmail(m))
+	}
+	res.OutboxEmails = resEmails
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *GetOutboxEmailRes) FromModel(m *model.OutboxEmail, msgID string, err error) {
+	if m != nil {
+		res.OutboxEmail = toOutboxEmail(*m)
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RequeueOutboxEmailRes) FromModel(m *model.OutboxEmail, msgID string, err error) {
+	if m != nil {
+		res.OutboxEmail = toOutboxEmail(*m)
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func toOutboxEmail(m model.OutboxEmail) OutboxEmail {
+	oe := OutboxEmail{
+		ID:        m.ID.String(),
+		Kind:      m.Kind.String,
+		Recipient: m.Recipient,
+		Subject:   m.Subject,
+		Status:    m.Status,
+		Attempts:  m.Attempts,
+		LastError: m.LastError.String,
+		SentAt:    formatNullTime(m.SentAt),
+		CreatedAt: formatNullTime(m.CreatedAt),
+		IsDead:    m.IsDead(),
+	}
+
+	if m.Status == model.OutboxPending || m.Status == model.OutboxSending {
+		oe.NextAttemptAt = formatNullTime(m.NextAttemptAt)
+	}
+
+	return oe
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// ChangePasswordReq input data.
+	ChangePasswordReq struct {
+		Identifier
+		CurrentPassword      string `json:"currentPassword" schema:"current-password"`
+		Password             string `json:"password" schema:"password"`
+		PasswordConfirmation string `json:"passwordConfirmation" schema:"password-confirmation"`
+		Origin               `json:"-" schema:"-"`
+	}
+
+	// ChangePasswordRes output data.
+	ChangePasswordRes struct {
+		User
+		// Revoked is the number of other sessions closed after the change.
+		Revoked int64 `json:"revoked"`
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
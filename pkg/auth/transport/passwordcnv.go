@@ -0,0 +1,20 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+func (res *ChangePasswordRes) FromModel(m *model.User, revoked int64, errors service.ErrorSet, msgID string, err error) {
+	if m != nil {
+		res.User = User{
+			Slug:     m.Slug.String,
+			Username: m.Username.String,
+			Email:    m.Email.String,
+		}
+	}
+	res.Revoked = revoked
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
@@ -0,0 +1,73 @@
+package transport
+
+type (
+	// SAMLAuthReq input data.
+	// Link is set by signed in users adding the provider to their account.
+	SAMLAuthReq struct {
+		Provider string `json:"-" schema:"-"`
+		Link     bool   `json:"link" schema:"link"`
+		Origin   `json:"-" schema:"-"`
+	}
+
+	// SAMLAuthRes output data.
+	// RequestID has to be kept by the client and sent back
+	// along with the response the identity provider posts.
+	SAMLAuthRes struct {
+		URL       string `json:"url"`
+		RequestID string `json:"requestID"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// SAMLSignInReq input data.
+	// SAMLResponse is the one posted by the identity provider,
+	// RequestID and Link the values kept since SAMLAuthRes.
+	// Output is a FederatedSignInRes.
+	SAMLSignInReq struct {
+		Provider     string `json:"-" schema:"-"`
+		SAMLResponse string `json:"samlResponse" schema:"SAMLResponse"`
+		RequestID    string `json:"requestID" schema:"-"`
+		Link         bool   `json:"link" schema:"-"`
+		Origin       `json:"-" schema:"-"`
+	}
+
+	// SAMLMetadataReq input data.
+	// An empty provider requests the identity provider metadata.
+	SAMLMetadataReq struct {
+		Provider string `json:"-" schema:"-"`
+	}
+
+	// SAMLMetadataRes output data.
+	SAMLMetadataRes struct {
+		Metadata []byte
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// SAMLAssertReq input data.
+	// SAMLRequest and RelayState as received from the service provider.
+	SAMLAssertReq struct {
+		SAMLRequest string `json:"-" schema:"SAMLRequest"`
+		RelayState  string `json:"-" schema:"RelayState"`
+		Origin      `json:"-" schema:"-"`
+	}
+
+	// SAMLAssertRes output data.
+	// The response has to be posted by the user agent to ACSURL.
+	SAMLAssertRes struct {
+		ACSURL       string
+		SAMLResponse string
+		RelayState   string
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
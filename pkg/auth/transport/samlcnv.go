@@ -0,0 +1,22 @@
+package transport
+
+func (res *SAMLAuthRes) FromModel(url, requestID, msgID string, err error) {
+	res.URL = url
+	res.RequestID = requestID
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *SAMLMetadataRes) FromModel(metadata []byte, msgID string, err error) {
+	res.Metadata = metadata
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *SAMLAssertRes) FromModel(acsURL, samlResponse, relayState, msgID string, err error) {
+	res.ACSURL = acsURL
+	res.SAMLResponse = samlResponse
+	res.RelayState = relayState
+	res.MsgID = msgID
+	res.err = err
+}
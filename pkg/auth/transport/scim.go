@@ -0,0 +1,32 @@
+package transport
+
+type (
+	// SCIMReq input data, shared by all SCIM endpoints.
+	// Tenant is the one the client bearer token belongs to,
+	// Body is kept raw, the service decodes the SCIM resource.
+	SCIMReq struct {
+		Tenant     string `json:"-"`
+		ID         string `json:"-"`
+		Body       []byte `json:"-"`
+		IfMatch    string `json:"-"`
+		Filter     string `json:"-"`
+		StartIndex int    `json:"-"`
+		// Count is negative when not requested.
+		Count  int `json:"-"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// SCIMRes output data.
+	// Resource is written as is, Status is the HTTP one on success.
+	SCIMRes struct {
+		Status   int
+		Resource interface{}
+		Version  string
+		Location string
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,10 @@
+package transport
+
+func (res *SCIMRes) FromModel(status int, resource interface{}, version, location, msgID string, err error) {
+	res.Status = status
+	res.Resource = resource
+	res.Version = version
+	res.Location = location
+	res.MsgID = msgID
+	res.err = err
+}
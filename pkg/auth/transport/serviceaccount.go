@@ -0,0 +1,193 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+type (
+	// ServiceAccount response data.
+	// ClientID identifies it at the token endpoint.
+	ServiceAccount struct {
+		Slug      string `json:"slug"`
+		ClientID  string `json:"clientID"`
+		Username  string `json:"username"`
+		IsActive  bool   `json:"isActive"`
+		CreatedAt string `json:"createdAt"`
+	}
+
+	ServiceAccounts []ServiceAccount
+
+	// ClientCredential response data.
+	ClientCredential struct {
+		ID         string   `json:"id"`
+		Kind       string   `json:"kind"`
+		KeyID      string   `json:"keyID,omitempty"`
+		Scopes     []string `json:"scopes"`
+		CreatedAt  string   `json:"createdAt"`
+		ExpiresAt  string   `json:"expiresAt"`
+		LastUsedAt string   `json:"lastUsedAt,omitempty"`
+		LastUsedIP string   `json:"lastUsedIP,omitempty"`
+		IsActive   bool     `json:"isActive"`
+	}
+
+	ClientCredentials []ClientCredential
+)
+
+type (
+	// IndexServiceAccountsReq input data.
+	// Slug identifies the account owning them.
+	IndexServiceAccountsReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// IndexServiceAccountsRes output data.
+	IndexServiceAccountsRes struct {
+		ServiceAccounts ServiceAccounts `json:"serviceAccounts"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// CreateServiceAccountReq input data.
+	CreateServiceAccountReq struct {
+		Identifier
+		Username string `json:"username"`
+		Origin   `json:"-" schema:"-"`
+	}
+
+	// CreateServiceAccountRes output data.
+	CreateServiceAccountRes struct {
+		ServiceAccount ServiceAccount `json:"serviceAccount"`
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// DeleteServiceAccountReq input data.
+	DeleteServiceAccountReq struct {
+		Identifier
+		ServiceAccountSlug string `json:"-"`
+		Origin             `json:"-" schema:"-"`
+	}
+
+	// DeleteServiceAccountRes output data.
+	DeleteServiceAccountRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// IndexClientCredentialsReq input data.
+	IndexClientCredentialsReq struct {
+		Identifier
+		ServiceAccountSlug string `json:"-"`
+		Origin             `json:"-" schema:"-"`
+	}
+
+	// IndexClientCredentialsRes output data.
+	IndexClientCredentialsRes struct {
+		ServiceAccount    ServiceAccount    `json:"serviceAccount"`
+		ClientCredentials ClientCredentials `json:"clientCredentials"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// CreateClientCredentialReq input data.
+	// Kind is either 'client_secret' or 'private_key_jwt',
+	// PublicKey, PEM encoded, is required for the latter.
+	// ExpiresInDays uses the configured default if zero.
+	CreateClientCredentialReq struct {
+		Identifier
+		ServiceAccountSlug string   `json:"-"`
+		Kind               string   `json:"kind"`
+		PublicKey          string   `json:"publicKey"`
+		Scopes             []string `json:"scopes"`
+		ExpiresInDays      int      `json:"expiresInDays"`
+		Origin             `json:"-" schema:"-"`
+	}
+
+	// CreateClientCredentialRes output data.
+	CreateClientCredentialRes struct {
+		ClientCredential ClientCredential `json:"clientCredential"`
+		ClientID         string           `json:"clientID"`
+		// ClientSecret is only returned once, on creation.
+		ClientSecret string `json:"clientSecret,omitempty"`
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RevokeClientCredentialReq input data.
+	RevokeClientCredentialReq struct {
+		Identifier
+		ServiceAccountSlug string `json:"-"`
+		CredentialID       string `json:"-"`
+		Origin             `json:"-" schema:"-"`
+	}
+
+	// RevokeClientCredentialRes output data.
+	RevokeClientCredentialRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// IssueTokenReq input data, the token endpoint form parameters.
+	// Clients authenticate either with ClientSecret or with ClientAssertion.
+	// Audience is the one of the 'audience' or 'resource' parameter.
+	IssueTokenReq struct {
+		GrantType           string
+		ClientID            string
+		ClientSecret        string
+		ClientAssertionType string
+		ClientAssertion     string
+		Scope               string
+		Audience            string
+		Origin
+	}
+
+	// IssueTokenRes output data, written as is on success.
+	IssueTokenRes struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Scope       string `json:"scope,omitempty"`
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string `json:"-"`
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"strings"
+	"time"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+func (res *IndexServiceAccountsRes) FromModel(us []model.User, msgID string, err error) {
+	res.ServiceAccounts = ServiceAccounts{}
+	for _, u := range us {
+		res.ServiceAccounts = append(res.ServiceAccounts, toServiceAccount(u))
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *CreateServiceAccountRes) FromModel(u *model.User, errors service.ErrorSet, msgID string, err error) {
+	if u != nil {
+		res.ServiceAccount = toServiceAccount(*u)
+	}
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *DeleteServiceAccountRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *IndexClientCredentialsRes) FromModel(u *model.User, cs []model.ClientCredential, msgID string, err error) {
+	if u != nil {
+		res.ServiceAccount = toServiceAccount(*u)
+	}
+	res.ClientCredentials = ClientCredentials{}
+	for _, c := range cs {
+		res.ClientCredentials = append(res.ClientCredentials, toClientCredential(c))
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *CreateClientCredentialRes) FromModel(c *model.ClientCredential, secret string, errors service.ErrorSet, msgID string, err error) {
+	if c != nil {
+		res.ClientCredential = toClientCredential(*c)
+		res.ClientID = c.UserID
+	}
+	res.ClientSecret = secret
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RevokeClientCredentialRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *IssueTokenRes) FromModel(token string, ttl time.Duration, scopes []string, msgID string, err error) {
+	if token != "" {
+		res.AccessToken = token
+		res.TokenType = "Bearer"
+		res.ExpiresIn = int64(ttl / time.Second)
+		res.Scope = strings.Join(scopes, " ")
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func toServiceAccount(m model.User) ServiceAccount {
+	return ServiceAccount{
+		Slug:      m.Slug.String,
+		ClientID:  m.ID.String(),
+		Username:  m.Username.String,
+		IsActive:  !m.IsActive.Valid || m.IsActive.Bool,
+		CreatedAt: formatNullTime(m.CreatedAt),
+	}
+}
+
+func toClientCredential(m model.ClientCredential) ClientCredential {
+	return ClientCredential{
+		ID:         m.ID.String(),
+		Kind:       m.Kind,
+		KeyID:      m.KeyID.String,
+		Scopes:     m.ScopeList(),
+		CreatedAt:  formatNullTime(m.CreatedAt),
+		ExpiresAt:  formatNullTime(m.ExpiresAt),
+		LastUsedAt: formatNullTime(m.LastUsedAt),
+		LastUsedIP: m.LastUsedIP.String,
+		IsActive:   m.IsActive(),
+	}
+}
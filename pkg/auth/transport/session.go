@@ -0,0 +1,171 @@
+package transport
+
+import (
+	"context"
+
+	"gitlab.com/mikrowezel/backend/service"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+type (
+	// Session response data.
+	Session struct {
+		ID         string `json:"id"`
+		IP         string `json:"ip"`
+		UserAgent  string `json:"userAgent"`
+		Lat        string `json:"lat,omitempty"`
+		Lng        string `json:"lng,omitempty"`
+		Country    string `json:"country,omitempty"`
+		Risk       string `json:"risk,omitempty"`
+		CreatedAt  string `json:"createdAt"`
+		LastSeenAt string `json:"lastSeenAt"`
+		ExpiresAt  string `json:"expiresAt"`
+		RevokedAt  string `json:"revokedAt,omitempty"`
+		IsActive   bool   `json:"isActive"`
+		IsPending  bool   `json:"isPending"`
+		IsCurrent  bool   `json:"isCurrent"`
+	}
+
+	Sessions []Session
+
+	// CurrentSession identifies the signed in user of a request.
+	// Requests authenticated with an API key have no session ID
+	// but the ID of the key instead.
+	// Impersonator fields are set while an admin acts as the user.
+	CurrentSession struct {
+		ID                   string
+		UserID               string
+		UserSlug             string
+		Username             string
+		APIKeyID             string
+		ImpersonatorID       string
+		ImpersonatorSlug     string
+		ImpersonatorUsername string
+	}
+)
+
+// IsImpersonation returns true if an admin is acting as the user.
+func (cs CurrentSession) IsImpersonation() bool {
+	return cs.ImpersonatorID != ""
+}
+
+type (
+	ctxKey string
+)
+
+const (
+	currentSessionCtxKey ctxKey = "current-session"
+)
+
+// WithCurrentSession returns a copy of ctx carrying the current session.
+func WithCurrentSession(ctx context.Context, cs CurrentSession) context.Context {
+	return context.WithValue(ctx, currentSessionCtxKey, cs)
+}
+
+// CurrentSessionFrom returns the current session stored in ctx, if any.
+func CurrentSessionFrom(ctx context.Context) (cs CurrentSession, ok bool) {
+	cs, ok = ctx.Value(currentSessionCtxKey).(CurrentSession)
+	return cs, ok
+}
+
+type (
+	// ValidateSessionReq input data.
+	ValidateSessionReq struct {
+		Token string
+	}
+
+	// ValidateSessionRes output data.
+	ValidateSessionRes struct {
+		CurrentSession
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// GetUserSecurityReq input data.
+	GetUserSecurityReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// GetUserSecurityRes output data.
+	GetUserSecurityRes struct {
+		User
+		// ActiveSessions lists the devices where the user is currently signed in.
+		ActiveSessions Sessions
+		// RecentLogins lists the latest sign-ins, including ended sessions.
+		RecentLogins Sessions
+		// Identities lists the external providers linked to the user.
+		Identities Identities
+		// Providers lists the external providers the user can still link.
+		Providers IdentityProviders
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RevokeSessionReq input data.
+	RevokeSessionReq struct {
+		Identifier
+		SessionID string `json:"sessionID" schema:"session-id"`
+		Origin    `json:"-" schema:"-"`
+	}
+
+	// RevokeSessionRes output data.
+	RevokeSessionRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// RevokeAllSessionsReq input data.
+	RevokeAllSessionsReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RevokeAllSessionsRes output data.
+	RevokeAllSessionsRes struct {
+		// Count of revoked sessions.
+		Count int64
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// VerifySignInReq input data.
+	VerifySignInReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// VerifySignInRes output data.
+	VerifySignInRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"fmt"
+
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+)
+
+func (res *ValidateSessionRes) FromModel(s *model.Session, u *model.User, msgID string, err error) {
+	if s != nil && u != nil {
+		res.CurrentSession = CurrentSession{
+			ID:       s.ID.String(),
+			UserID:   u.ID.String(),
+			UserSlug: u.Slug.String,
+			Username: u.Username.String,
+		}
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+// SetImpersonator sets the admin acting as the session user.
+func (res *ValidateSessionRes) SetImpersonator(m *model.User) {
+	if m == nil {
+		return
+	}
+	res.ImpersonatorID = m.ID.String()
+	res.ImpersonatorSlug = m.Slug.String
+	res.ImpersonatorUsername = m.Username.String
+}
+
+func (res *GetUserSecurityRes) FromModel(u *model.User, active, recent []model.Session, currentID, msgID string, err error) {
+	if u != nil {
+		res.User = User{
+			Slug:     u.Slug.String,
+			Username: u.Username.String,
+			Email:    u.Email.String,
+			LastIP:   u.LastIP.String,
+		}
+	}
+	res.ActiveSessions = toSessions(active, currentID)
+	res.RecentLogins = toSessions(recent, currentID)
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RevokeSessionRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *RevokeAllSessionsRes) FromModel(count int64, msgID string, err error) {
+	res.Count = count
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *VerifySignInRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+func toSessions(ms []model.Session, currentID string) Sessions {
+	ss := Sessions{}
+	for _, m := range ms {
+		s := Session{
+			ID:         m.ID.String(),
+			IP:         m.IP.String,
+			UserAgent:  m.UserAgent.String,
+			CreatedAt:  formatNullTime(m.CreatedAt),
+			LastSeenAt: formatNullTime(m.LastSeenAt),
+			ExpiresAt:  formatNullTime(m.ExpiresAt),
+			RevokedAt:  formatNullTime(m.RevokedAt),
+			Country:    m.Country.String,
+			Risk:       m.RiskReasons.String,
+			IsActive:   m.IsActive(),
+			IsPending:  m.IsPending(),
+			IsCurrent:  m.ID.String() == currentID,
+		}
+		if m.Geolocation.Valid {
+			s.Lat = fmt.Sprintf("%.1f", m.Geolocation.Point.Lat)
+			s.Lng = fmt.Sprintf("%.1f", m.Geolocation.Point.Lng)
+		}
+		ss = append(ss, s)
+	}
+	return ss
+}
@@ -0,0 +1,88 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+type (
+	// Transfer response data.
+	Transfer struct {
+		Account     string `json:"account"`
+		To          string `json:"to"`
+		ExpiresAt   string `json:"expiresAt"`
+		ConfirmedAt string `json:"confirmedAt,omitempty"`
+		ApprovedAt  string `json:"approvedAt,omitempty"`
+		CompletedAt string `json:"completedAt,omitempty"`
+	}
+)
+
+type (
+	// InitiateTransferReq input data.
+	// Slug is the one of the account, To the username of the new owner.
+	InitiateTransferReq struct {
+		Identifier
+		To     string `json:"to" schema:"to"`
+		Origin `json:"-" schema:"-"`
+	}
+
+	// InitiateTransferRes output data.
+	InitiateTransferRes struct {
+		Transfer
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// ConfirmTransferReq input data.
+	// Token is the one sent to the new owner.
+	ConfirmTransferReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// ConfirmTransferRes output data.
+	ConfirmTransferRes struct {
+		Transfer
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// ApproveTransferReq input data.
+	ApproveTransferReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// ApproveTransferRes output data.
+	ApproveTransferRes struct {
+		Transfer
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+
+	// CancelTransferReq input data.
+	CancelTransferReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// CancelTransferRes output data.
+	CancelTransferRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"gitlab.com/mikrowezel/backend/granica/internal/model"
+	"gitlab.com/mikrowezel/backend/service"
+)
+
+func (res *InitiateTransferRes) FromModel(m *model.Transfer, account, to string, errors service.ErrorSet, msgID string, err error) {
+	if m != nil {
+		res.Transfer = toTransfer(m, account, to)
+	}
+	res.Errors = errors
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *ConfirmTransferRes) FromModel(m *model.Transfer, account, to string, msgID string, err error) {
+	if m != nil {
+		res.Transfer = toTransfer(m, account, to)
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *ApproveTransferRes) FromModel(m *model.Transfer, account, to string, msgID string, err error) {
+	if m != nil {
+		res.Transfer = toTransfer(m, account, to)
+	}
+	res.MsgID = msgID
+	res.err = err
+}
+
+func (res *CancelTransferRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
+// toTransfer returns the response data of a transfer,
+// account is the slug of the account, to the username of the new owner.
+func toTransfer(m *model.Transfer, account, to string) Transfer {
+	return Transfer{
+		Account:     account,
+		To:          to,
+		ExpiresAt:   formatNullTime(m.ExpiresAt),
+		ConfirmedAt: formatNullTime(m.ConfirmedAt),
+		ApprovedAt:  formatNullTime(m.ApprovedAt),
+		CompletedAt: formatNullTime(m.CompletedAt),
+	}
+}
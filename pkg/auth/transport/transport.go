@@ -1,8 +1,33 @@
 package transport
 
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
 type (
 	Identifier struct {
 		Slug  string
 		Token string
 	}
 )
+
+// formatNullTime returns an RFC3339 representation of t or an empty string if not set.
+func formatNullTime(t pq.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+// parseNullTime accepts RFC3339 timestamps and plain dates,
+// the result is not valid if s is empty or cannot be parsed.
+func parseNullTime(s string) pq.NullTime {
+	for _, layout := range []string{time.RFC3339, dateLayout} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return pq.NullTime{Time: t, Valid: true}
+		}
+	}
+	return pq.NullTime{}
+}
@@ -8,22 +8,30 @@ import (
 type (
 	// User request and response data.
 	User struct {
-		Slug              string `json:"slug" schema:"slug"`
-		Username          string `json:"username" schema:"username"`
-		Password          string `json:"password" schema:"password"`
-		Email             string `json:"email" schema:"email"`
-		EmailConfirmation string `json:"emailConfirmation" schema:"email-confirmation"`
-		GivenName         string `json:"givenName" schema:"given-name"`
-		MiddleNames       string `json:"middleNames" schema:"middle-names"`
-		FamilyName        string `json:"familyName" schema:"family-name"`
-		LastIP            string `json:"lastIP" schema:"last-ip"`
-		ConfirmationToken string `json:"confirmationToken" schema:"verify-token"`
-		IsConfirmed       bool   `json:"isConfirmed" schema:"is-confirmed"`
-		Lat               string `json:"lat" schema: "lat"`
-		Lng               string `json:"lng" schema: "lng"`
+		Slug              string  `json:"slug" schema:"slug"`
+		Username          string  `json:"username" schema:"username"`
+		Password          string  `json:"password" schema:"password"`
+		Email             string  `json:"email" schema:"email"`
+		EmailConfirmation string  `json:"emailConfirmation" schema:"email-confirmation"`
+		GivenName         string  `json:"givenName" schema:"given-name"`
+		MiddleNames       string  `json:"middleNames" schema:"middle-names"`
+		FamilyName        string  `json:"familyName" schema:"family-name"`
+		LastIP            string  `json:"lastIP" schema:"last-ip"`
+		ConfirmationToken string  `json:"confirmationToken" schema:"verify-token"`
+		IsConfirmed       bool    `json:"isConfirmed" schema:"is-confirmed"`
+		Lat               string  `json:"lat" schema: "lat"`
+		Lng               string  `json:"lng" schema: "lng"`
+		Rank              float64 `json:"rank,omitempty" schema:"-"`
+		DeletedAt         string  `json:"deletedAt,omitempty" schema:"-"`
 		IsNew             bool
 	}
 
+	// Search
+	Search struct {
+		Query string `json:"q" schema:"q"`
+		Limit int    `json:"limit" schema:"limit"`
+	}
+
 	// SignIn
 	SignIn struct {
 		Username string `json:"username" schema:"username"`
@@ -41,6 +49,7 @@ type (
 	// CreateUserReq input data.
 	CreateUserReq struct {
 		User
+		Origin `json:"-" schema:"-"`
 	}
 
 	// CreateUserRes output data.
@@ -62,11 +71,39 @@ type (
 type (
 	// IndexUsersReq input data.
 	IndexUsersReq struct {
+		Origin `json:"-" schema:"-"`
 	}
 
 	// IndexUsersRes output data.
 	IndexUsersRes struct {
 		Users
+		// Query is the current search query, empty when listing all users.
+		Query string
+		// Action can be used to reuse form templates letting change target and method from controller.
+		Action web.Action
+		// Errors stores localizable errors message IDs for model properties.
+		// Mainly used to show messages on fields with errors after validation.
+		Errors service.ErrorSet
+		// Msg stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
+type (
+	// SearchUsersReq input data.
+	SearchUsersReq struct {
+		Search
+		Origin `json:"-" schema:"-"`
+	}
+
+	// SearchUsersRes output data.
+	SearchUsersRes struct {
+		Users
+		// Query is the normalized search query, used to highlight matches.
+		Query string
 		// Action can be used to reuse form templates letting change target and method from controller.
 		Action web.Action
 		// Errors stores localizable errors message IDs for model properties.
@@ -84,11 +121,14 @@ type (
 	// GetUserReq input data.
 	GetUserReq struct {
 		Identifier
+		Origin `json:"-" schema:"-"`
 	}
 
 	// GetUserRes output data.
 	GetUserRes struct {
 		User
+		// CanImpersonate is true if the requesting admin can act as the user.
+		CanImpersonate bool
 		// Action can be used to reuse form templates letting change target and method from controller.
 		Action web.Action
 		// Errors stores localizable errors message IDs for model properties.
@@ -107,11 +147,15 @@ type (
 	UpdateUserReq struct {
 		Identifier
 		User
+		Origin `json:"-" schema:"-"`
 	}
 
 	// UpdateUserRes output data.
 	UpdateUserRes struct {
 		User
+		// PendingEmail is set when a new email address
+		// has been requested and waits for confirmation.
+		PendingEmail string `json:"pendingEmail,omitempty"`
 		// Action can be used to reuse form templates letting change target and method from controller.
 		Action web.Action
 		// Errors stores localizable errors message IDs for model properties.
@@ -129,6 +173,7 @@ type (
 	// DeleteUserReq input data.
 	DeleteUserReq struct {
 		Identifier
+		Origin `json:"-" schema:"-"`
 	}
 
 	// DeleteUserRes output data.
@@ -141,10 +186,28 @@ type (
 	}
 )
 
+type (
+	// RestoreUserReq input data.
+	RestoreUserReq struct {
+		Identifier
+		Origin `json:"-" schema:"-"`
+	}
+
+	// RestoreUserRes output data.
+	RestoreUserRes struct {
+		// MsgID stores localizable message ID for the whole model.
+		// Mainly used to show a message related to current state of the model.
+		MsgID string
+		// Mainly used for debugging porpouses and/or to show relevant info to admin users.
+		err error
+	}
+)
+
 type (
 	// SignUpUserReq input data.
 	SignUpUserReq struct {
 		User
+		Origin `json:"-" schema:"-"`
 	}
 
 	// SignUpUserRes output data.
@@ -167,11 +230,24 @@ type (
 	// SignInUserReq input data.
 	SignInUserReq struct {
 		SignIn
+		Origin `json:"-" schema:"-"`
 	}
 
 	// SignInUserRes output data.
 	SignInUserRes struct {
 		User
+		// SessionToken identifies the session opened by this sign-in.
+		SessionToken string `json:"sessionToken,omitempty"`
+		// VerificationRequired is set when the sign-in looks suspicious,
+		// session cannot be used until verified from the emailed link.
+		VerificationRequired bool `json:"verificationRequired,omitempty"`
+		// EmailUndeliverable is set when mails sent to user email bounce,
+		// user should be asked to update it.
+		EmailUndeliverable bool `json:"emailUndeliverable,omitempty"`
+		// MagicLinkEnabled lets the sign-in form offer to email a sign-in link.
+		MagicLinkEnabled bool `json:"-"`
+		// Providers lists the external identity providers the user can sign in with.
+		Providers IdentityProviders `json:"-"`
 		// Action can be used to reuse form templates letting change target and method from controller.
 		Action web.Action
 		// Errors stores localizable errors message IDs for model properties.
@@ -59,6 +59,7 @@ func (res *IndexUsersRes) FromModel(ms []model.User, msgID string, err error) {
 			IsConfirmed:       m.IsConfirmed.Bool,
 			Lat:               fmt.Sprintf("%f", m.Geolocation.Point.Lat),
 			Lng:               fmt.Sprintf("%f", m.Geolocation.Point.Lng),
+			DeletedAt:         formatNullTime(m.DeletedAt),
 		}
 		resUsers = append(resUsers, res)
 	}
@@ -67,6 +68,28 @@ func (res *IndexUsersRes) FromModel(ms []model.User, msgID string, err error) {
 	res.err = err
 }
 
+func (res *SearchUsersRes) FromModel(ms []model.UserMatch, query, msgID string, err error) {
+	resUsers := []User{}
+	for _, m := range ms {
+		res := User{
+			Slug:        m.Slug.String,
+			Username:    m.Username.String,
+			Password:    "",
+			Email:       m.Email.String,
+			GivenName:   m.GivenName.String,
+			MiddleNames: m.MiddleNames.String,
+			FamilyName:  m.FamilyName.String,
+			IsConfirmed: m.IsConfirmed.Bool,
+			Rank:        m.Rank,
+		}
+		resUsers = append(resUsers, res)
+	}
+	res.Users = resUsers
+	res.Query = query
+	res.MsgID = msgID
+	res.err = err
+}
+
 func (req *GetUserReq) ToModel() model.User {
 	return model.User{
 		Identification: m.Identification{
@@ -146,6 +169,11 @@ func (res *DeleteUserRes) FromModel(msgID string, err error) {
 	res.err = err
 }
 
+func (res *RestoreUserRes) FromModel(msgID string, err error) {
+	res.MsgID = msgID
+	res.err = err
+}
+
 func (req *SignUpUserReq) ToModel() model.User {
 	return model.User{
 		Username:          db.ToNullString(req.Username),
@@ -193,6 +221,7 @@ func (res *SignInUserRes) FromModel(m *model.User, msgID string, err error) {
 			Lng:         fmt.Sprintf("%f", m.Geolocation.Point.Lng),
 			IsNew:       m.IsNew(),
 		}
+		res.EmailUndeliverable = m.IsEmailUndeliverable()
 	}
 	res.MsgID = msgID
 	res.err = err
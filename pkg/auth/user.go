@@ -5,18 +5,34 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi"
+	"gitlab.com/mikrowezel/backend/granica/pkg/auth/jsonrest"
 	"gitlab.com/mikrowezel/backend/granica/pkg/auth/web"
 )
 
 func (a *Auth) makeUserWebRouter(parent chi.Router) chi.Router {
 	return parent.Route("/users", func(uar chi.Router) {
 		uar.Get("/", a.webep.IndexUsers)
+		uar.With(a.webep.AdminOnly).Get("/search", a.webep.SearchUsers)
+		uar.With(a.webep.AdminOnly).Get("/deleted", a.webep.IndexDeletedUsers)
 		uar.Get("/new", a.webep.NewUser)
 		uar.Post("/", a.webep.CreateUser)
 		uar.Get("/signup", a.webep.InitSignUpUser)
 		uar.Post("/signup", a.webep.SignUpUser)
 		uar.Get("/signin", a.webep.InitSignInUser)
 		uar.Post("/signin", a.webep.SignInUser)
+		uar.Post("/magic-link", a.webep.RequestMagicLink)
+		uar.Delete("/impersonation", a.webep.StopImpersonation)
+		uar.Route("/oidc/{provider}", func(uarpv chi.Router) {
+			uarpv.Use(providerCtx)
+			uarpv.Get("/", a.webep.FederatedAuth)
+			uarpv.Get("/callback", a.webep.FederatedCallback)
+		})
+		uar.Route("/saml/{provider}", func(uarpv chi.Router) {
+			uarpv.Use(providerCtx)
+			uarpv.Get("/", a.webep.SAMLAuth)
+			uarpv.Post("/acs", a.webep.SAMLACS)
+			uarpv.Get("/metadata", a.webep.SAMLMetadata)
+		})
 		uar.Route("/{slug}", func(uarid chi.Router) {
 			uarid.Use(userCtx)
 			uarid.Get("/", a.webep.ShowUser)
@@ -25,9 +41,34 @@ func (a *Auth) makeUserWebRouter(parent chi.Router) chi.Router {
 			uarid.Put("/", a.webep.UpdateUser)
 			uarid.Post("/init-delete", a.webep.InitDeleteUser)
 			uarid.Delete("/", a.webep.DeleteUser)
+			uarid.With(a.webep.AdminOnly).Post("/restore", a.webep.RestoreUser)
+			uarid.Post("/impersonate", a.webep.ImpersonateUser)
+			uarid.Get("/security", a.webep.ShowUserSecurity)
+			uarid.Get("/password", a.webep.InitChangePassword)
+			uarid.Put("/password", a.webep.ChangePassword)
+			uarid.Delete("/sessions", a.webep.RevokeAllSessions)
+			uarid.Route("/sessions/{session}", func(uarsn chi.Router) {
+				uarsn.Use(sessionCtx)
+				uarsn.Delete("/", a.webep.RevokeSession)
+			})
+			uarid.Get("/api-keys", a.webep.IndexAPIKeys)
+			uarid.Post("/api-keys", a.webep.CreateAPIKey)
+			uarid.Route("/api-keys/{api-key}", func(uarak chi.Router) {
+				uarak.Use(apiKeyCtx)
+				uarak.Delete("/", a.webep.RevokeAPIKey)
+			})
+			uarid.Route("/identities/{provider}", func(uarpv chi.Router) {
+				uarpv.Use(providerCtx)
+				uarpv.Delete("/", a.webep.UnlinkIdentity)
+			})
 			uarid.Route("/{token}", func(uartkn chi.Router) {
 				uartkn.Use(confCtx)
 				uartkn.Get("/confirm", a.webep.ConfirmUser)
+				uartkn.Get("/verify-signin", a.webep.VerifySignIn)
+				uartkn.Get("/confirm-email", a.webep.ConfirmEmailChange)
+				uartkn.Get("/cancel-email-change", a.webep.CancelEmailChange)
+				uartkn.Get("/magic-signin", a.webep.MagicLinkSignIn)
+				uartkn.Post("/magic-signin", a.webep.ConfirmMagicLinkSignIn)
 			})
 		})
 	})
@@ -37,12 +78,46 @@ func (a *Auth) makeUserJSONRESTRouter(parent chi.Router) chi.Router {
 	return parent.Route("/users", func(uar chi.Router) {
 		uar.Post("/", a.jsonep.CreateUser)
 		uar.Get("/", a.jsonep.IndexUsers)
+		uar.Get("/search", a.jsonep.SearchUsers)
+		uar.Get("/deleted", a.jsonep.IndexDeletedUsers)
+		uar.Post("/signin", a.jsonep.SignInUser)
+		uar.Post("/magic-link", a.jsonep.RequestMagicLink)
+		uar.Route("/oidc/{provider}", func(uarpv chi.Router) {
+			uarpv.Use(providerJSONCtx)
+			uarpv.Get("/", a.jsonep.FederatedAuth)
+			uarpv.Post("/callback", a.jsonep.FederatedSignIn)
+		})
 		uar.Route("/{slug}", func(uarid chi.Router) {
-			uarid.Use(userCtx)
+			uarid.Use(userJSONCtx)
 			uarid.Get("/", a.jsonep.GetUser)
 			uarid.Patch("/", a.jsonep.UpdateUser)
 			uarid.Put("/", a.jsonep.UpdateUser)
 			uarid.Delete("/", a.jsonep.DeleteUser)
+			uarid.Post("/restore", a.jsonep.RestoreUser)
+			uarid.Put("/password", a.jsonep.ChangePassword)
+			uarid.Get("/sessions", a.jsonep.GetUserSecurity)
+			uarid.Delete("/sessions", a.jsonep.RevokeAllSessions)
+			uarid.Route("/sessions/{session}", func(uarsn chi.Router) {
+				uarsn.Use(sessionJSONCtx)
+				uarsn.Delete("/", a.jsonep.RevokeSession)
+			})
+			uarid.Get("/api-keys", a.jsonep.IndexUserAPIKeys)
+			uarid.Post("/api-keys", a.jsonep.CreateUserAPIKey)
+			uarid.Route("/api-keys/{api-key}", func(uarak chi.Router) {
+				uarak.Use(apiKeyJSONCtx)
+				uarak.Delete("/", a.jsonep.RevokeUserAPIKey)
+			})
+			uarid.Route("/identities/{provider}", func(uarpv chi.Router) {
+				uarpv.Use(providerJSONCtx)
+				uarpv.Delete("/", a.jsonep.UnlinkIdentity)
+			})
+			uarid.Route("/{token}", func(uartkn chi.Router) {
+				uartkn.Use(tokenJSONCtx)
+				uartkn.Get("/verify-signin", a.jsonep.VerifySignIn)
+				uartkn.Post("/confirm-email", a.jsonep.ConfirmEmailChange)
+				uartkn.Post("/cancel-email-change", a.jsonep.CancelEmailChange)
+				uartkn.Post("/magic-signin", a.jsonep.MagicLinkSignIn)
+			})
 		})
 	})
 }
@@ -55,6 +130,70 @@ func userCtx(next http.Handler) http.Handler {
 	})
 }
 
+func userJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := chi.URLParam(r, "slug")
+		ctx := context.WithValue(r.Context(), jsonrest.UserCtxKey, slug)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func sessionCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "session")
+		ctx := context.WithValue(r.Context(), web.SessionCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func sessionJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "session")
+		ctx := context.WithValue(r.Context(), jsonrest.SessionCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func apiKeyCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "api-key")
+		ctx := context.WithValue(r.Context(), web.APIKeyCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func apiKeyJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "api-key")
+		ctx := context.WithValue(r.Context(), jsonrest.APIKeyCtxKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func providerCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "provider")
+		ctx := context.WithValue(r.Context(), web.ProviderCtxKey, name)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func providerJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "provider")
+		ctx := context.WithValue(r.Context(), jsonrest.ProviderCtxKey, name)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func tokenJSONCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := chi.URLParam(r, "token")
+		ctx := context.WithValue(r.Context(), jsonrest.TokenCtxKey, token)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func confCtx(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		slug := chi.URLParam(r, "token")
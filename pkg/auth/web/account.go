@@ -0,0 +1,382 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	svc "gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	accountRes = "account"
+)
+
+const (
+	AccountCtxKey web.ContextKey = "account"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	AccountCreatedInfoID = "account_created_info_msg"
+	AccountUpdatedInfoID = "account_updated_info_msg"
+	AccountDeletedInfoID = "account_deleted_info_msg"
+	// Error
+	CreateAccountErrID = "create_account_err_msg"
+	IndexAccountsErrID = "get_all_accounts_err_msg"
+	GetAccountErrID    = "get_account_err_msg"
+	UpdateAccountErrID = "update_account_err_msg"
+	DeleteAccountErrID = "delete_account_err_msg"
+	// Subscription
+	SubscriptionExpiredErrID    = "subscription_expired_err_msg"
+	SubscriptionNotStartedErrID = "subscription_not_started_err_msg"
+)
+
+// IndexAccounts web endpoint.
+func (ep *Endpoint) IndexAccounts(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetAccountsReq
+	var res tp.GetAccountsRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.GetAccounts(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAccountsErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(accountRes, web.IndexTmpl)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAccountsErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAccountsErrID, err)
+		return
+	}
+}
+
+// NewAccount web endpoint.
+func (ep *Endpoint) NewAccount(w http.ResponseWriter, r *http.Request) {
+	// Req & Res
+	res := &tp.CreateAccountRes{}
+	res.IsNew = true
+	res.Action = ep.accountCreateAction()
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(accountRes, web.NewTmpl)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CannotProcErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CannotProcErrID, err)
+		return
+	}
+}
+
+// CreateAccount web endpoint.
+func (ep *Endpoint) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.CreateAccountReq
+	var res tp.CreateAccountRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req.Account)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.CreateAccount(req, &res)
+
+	// Input validation errors
+	if !res.Errors.IsEmpty() {
+		res.IsNew = true
+		res.Action = ep.accountCreateAction()
+		ep.rerenderAccountForm(w, r, res, web.NewTmpl)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CreateAccountErrID, err)
+		return
+	}
+
+	m := ep.localize(r, AccountCreatedInfoID)
+	ep.RedirectWithFlash(w, r, AccountPath(), m, web.InfoMT)
+}
+
+// ShowAccount web endpoint.
+func (ep *Endpoint) ShowAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetAccountReq
+	var res tp.GetAccountRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	req = tp.GetAccountReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GetAccount(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(accountRes, web.ShowTmpl)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+}
+
+// EditAccount web endpoint.
+func (ep *Endpoint) EditAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetAccountReq
+	var res tp.GetAccountRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	req = tp.GetAccountReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GetAccount(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Set additional values
+	res.IsNew = false
+	res.Action = ep.accountUpdateAction(res)
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(accountRes, web.EditTmpl)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+}
+
+// UpdateAccount web endpoint.
+func (ep *Endpoint) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.UpdateAccountReq
+	var res tp.UpdateAccountRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	req = tp.UpdateAccountReq{Identifier: id}
+
+	// Input data to request struct
+	err = ep.FormToModel(r, &req.Account)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.UpdateAccount(req, &res)
+
+	// Input validation errors
+	if !res.Errors.IsEmpty() {
+		res.Slug = id.Slug
+		res.Action = ep.accountUpdateAction(res)
+		ep.rerenderAccountForm(w, r, res, web.EditTmpl)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), UpdateAccountErrID, err)
+		return
+	}
+
+	m := ep.localize(r, AccountUpdatedInfoID)
+	ep.RedirectWithFlash(w, r, AccountPath(), m, web.InfoMT)
+}
+
+// InitDeleteAccount web endpoint.
+func (ep *Endpoint) InitDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetAccountReq
+	var res tp.GetAccountRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	req = tp.GetAccountReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GetAccount(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Set additional values
+	res.Action = ep.accountDeleteAction(res)
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(accountRes, web.InitDelTmpl)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), GetAccountErrID, err)
+		return
+	}
+}
+
+// DeleteAccount web endpoint.
+func (ep *Endpoint) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	var req tp.DeleteAccountReq
+	var res tp.DeleteAccountRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), DeleteAccountErrID, err)
+		return
+	}
+
+	req = tp.DeleteAccountReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.DeleteAccount(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), impersonationErrID(err, DeleteAccountErrID), err)
+		return
+	}
+
+	m := ep.localize(r, AccountDeletedInfoID)
+	ep.RedirectWithFlash(w, r, AccountPath(), m, web.InfoMT)
+}
+
+// AccountTypes lists the types offered by account forms.
+func AccountTypes() []string {
+	return tp.AccountTypes()
+}
+
+// subscriptionErrID returns the message for err,
+// sign-in errors due to account subscriptions are told apart.
+func subscriptionErrID(err error, msgID string) string {
+	switch {
+	case errors.Is(err, svc.ErrSubscriptionExpired):
+		return SubscriptionExpiredErrID
+	case errors.Is(err, svc.ErrSubscriptionNotStarted):
+		return SubscriptionNotStartedErrID
+	}
+	return msgID
+}
+
+func (ep *Endpoint) rerenderAccountForm(w http.ResponseWriter, r *http.Request, res interface{}, template string) {
+	wr := ep.ErrRes(w, r, res, InputValuesErrID, nil)
+
+	ts, err := ep.TemplateFor(accountRes, template)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), InputValuesErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CannotProcErrID, err)
+		return
+	}
+}
+
+func (ep *Endpoint) getAccountIdentifier(r *http.Request) (identifier tp.Identifier, err error) {
+	ctx := r.Context()
+	slug, ok := ctx.Value(AccountCtxKey).(string)
+	if !ok {
+		err := errors.New("no account provided")
+		return tp.Identifier{}, err
+	}
+
+	return tp.Identifier{
+		Slug: slug,
+	}, nil
+}
+
+// accountCreateAction
+func (ep *Endpoint) accountCreateAction() web.Action {
+	return web.Action{Target: AccountPath(), Method: "POST"}
+}
+
+// accountUpdateAction
+func (ep *Endpoint) accountUpdateAction(model web.Identifiable) web.Action {
+	return web.Action{Target: AccountPathSlug(model), Method: "PUT"}
+}
+
+// accountDeleteAction
+func (ep *Endpoint) accountDeleteAction(model web.Identifiable) web.Action {
+	return web.Action{Target: AccountPathSlug(model), Method: "DELETE"}
+}
@@ -0,0 +1,38 @@
+package web
+
+import (
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+// AccountRoot - Account resource root path.
+var AccountRoot = "accounts"
+
+// AccountPath
+func AccountPath() string {
+	return web.ResPath(AccountRoot)
+}
+
+// AccountPathEdit
+func AccountPathEdit(res web.Identifiable) string {
+	return web.ResPathEdit(AccountRoot, res)
+}
+
+// AccountPathNew
+func AccountPathNew() string {
+	return web.ResPathNew(AccountRoot)
+}
+
+// AccountPathInitDelete
+func AccountPathInitDelete(res web.Identifiable) string {
+	return web.ResPathInitDelete(AccountRoot, res)
+}
+
+// AccountPathSlug
+func AccountPathSlug(res web.Identifiable) string {
+	return web.ResPathSlug(AccountRoot, res)
+}
+
+// AccountPathTransfer
+func AccountPathTransfer(res web.Identifiable) string {
+	return web.ResPathSlug(AccountRoot, res) + "/transfer"
+}
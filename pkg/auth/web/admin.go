@@ -0,0 +1,387 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	adminRes = "admin"
+)
+
+const (
+	AdminUsersTmpl    = "users.tmpl"
+	AdminAccountsTmpl = "accounts.tmpl"
+	AdminRolesTmpl    = "roles.tmpl"
+)
+
+const (
+	AdminAccountCtxKey web.ContextKey = "admin-account"
+	RoleCtxKey         web.ContextKey = "role"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	UsersUpdatedInfoID       = "users_updated_info_msg"
+	RoleGrantedInfoID        = "role_granted_info_msg"
+	RoleRevokedInfoID        = "role_revoked_info_msg"
+	AccountActivatedInfoID   = "account_activated_info_msg"
+	AccountDeactivatedInfoID = "account_deactivated_info_msg"
+	// Error
+	AdminForbiddenErrID   = "admin_forbidden_err_msg"
+	GetAdminStatsErrID    = "get_admin_stats_err_msg"
+	GetAdminUsersErrID    = "get_admin_users_err_msg"
+	BulkUpdateUsersErrID  = "bulk_update_users_err_msg"
+	GetAdminAccountsErrID = "get_admin_accounts_err_msg"
+	SetAccountActiveErrID = "set_account_active_err_msg"
+	GetUserRolesErrID     = "get_user_roles_err_msg"
+	GrantRoleErrID        = "grant_role_err_msg"
+	RevokeRoleErrID       = "revoke_role_err_msg"
+)
+
+// AdminOnly lets admins through.
+// Anonymous users are sent to sign in, the others back home.
+func (ep *Endpoint) AdminOnly(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		var req tp.CheckAdminReq
+		var res tp.CheckAdminRes
+
+		// Service
+		req.Origin = tp.MakeOrigin(r)
+		err := ep.service.CheckAdmin(req, &res)
+		if err != nil {
+			path := "/"
+			if _, ok := tp.CurrentSessionFrom(r.Context()); !ok {
+				path = UserPathSignIn()
+			}
+
+			ep.handleError(w, r, path, AdminForbiddenErrID, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// ShowAdminDashboard web endpoint.
+func (ep *Endpoint) ShowAdminDashboard(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetAdminStatsReq
+	var res tp.GetAdminStatsRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.GetAdminStats(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", GetAdminStatsErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(adminRes, web.IndexTmpl)
+	if err != nil {
+		ep.handleError(w, r, "/", GetAdminStatsErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, "/", GetAdminStatsErrID, err)
+		return
+	}
+}
+
+// IndexAdminUsers web endpoint.
+func (ep *Endpoint) IndexAdminUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexAdminUsersReq
+	var res tp.IndexAdminUsersRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexAdminUsers(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminUsersErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(adminRes, AdminUsersTmpl)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminUsersErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminUsersErrID, err)
+		return
+	}
+}
+
+// BulkUpdateUsers web endpoint.
+func (ep *Endpoint) BulkUpdateUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.BulkUpdateUsersReq
+	var res tp.BulkUpdateUsersRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.BulkUpdateUsers(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), BulkUpdateUsersErrID, err)
+		return
+	}
+
+	m := ep.localize(r, UsersUpdatedInfoID)
+	ep.RedirectWithFlash(w, r, AdminPathUsers(), m, web.InfoMT)
+}
+
+// IndexUserRoles web endpoint.
+func (ep *Endpoint) IndexUserRoles(w http.ResponseWriter, r *http.Request) {
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), GetUserRolesErrID, err)
+		return
+	}
+
+	res, err := ep.getUserRoles(r, id)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), GetUserRolesErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+	ep.renderUserRoles(w, r, wr)
+}
+
+// GrantRole web endpoint.
+func (ep *Endpoint) GrantRole(w http.ResponseWriter, r *http.Request) {
+	var req tp.GrantRoleReq
+	var res tp.GrantRoleRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), GrantRoleErrID, err)
+		return
+	}
+
+	u := tp.User{Slug: id.Slug}
+
+	// Input data to request struct
+	err = ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUserRoles(u), CannotProcErrID, err)
+		return
+	}
+
+	req.Identifier = id
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GrantRole(req, &res)
+
+	// Input validation errors
+	if !res.Errors.IsEmpty() {
+		rres, err := ep.getUserRoles(r, id)
+		if err != nil {
+			ep.handleError(w, r, AdminPathUserRoles(u), GrantRoleErrID, err)
+			return
+		}
+
+		rres.Errors = res.Errors
+		wr := ep.ErrRes(w, r, rres, InputValuesErrID, nil)
+		ep.renderUserRoles(w, r, wr)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, AdminPathUserRoles(u), GrantRoleErrID, err)
+		return
+	}
+
+	m := ep.localize(r, RoleGrantedInfoID)
+	ep.RedirectWithFlash(w, r, AdminPathUserRoles(u), m, web.InfoMT)
+}
+
+// RevokeRole web endpoint.
+func (ep *Endpoint) RevokeRole(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeRoleReq
+	var res tp.RevokeRoleRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), RevokeRoleErrID, err)
+		return
+	}
+
+	u := tp.User{Slug: id.Slug}
+
+	name, err := ep.getRoleName(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUserRoles(u), RevokeRoleErrID, err)
+		return
+	}
+
+	req = tp.RevokeRoleReq{Identifier: id, Name: name}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RevokeRole(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUserRoles(u), RevokeRoleErrID, err)
+		return
+	}
+
+	m := ep.localize(r, RoleRevokedInfoID)
+	ep.RedirectWithFlash(w, r, AdminPathUserRoles(u), m, web.InfoMT)
+}
+
+// IndexAdminAccounts web endpoint.
+func (ep *Endpoint) IndexAdminAccounts(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexAdminAccountsReq
+	var res tp.IndexAdminAccountsRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexAdminAccounts(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminAccountsErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(adminRes, AdminAccountsTmpl)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminAccountsErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AdminPath(), GetAdminAccountsErrID, err)
+		return
+	}
+}
+
+// ActivateAccount web endpoint.
+func (ep *Endpoint) ActivateAccount(w http.ResponseWriter, r *http.Request) {
+	ep.setAccountActive(w, r, true)
+}
+
+// DeactivateAccount web endpoint.
+func (ep *Endpoint) DeactivateAccount(w http.ResponseWriter, r *http.Request) {
+	ep.setAccountActive(w, r, false)
+}
+
+func (ep *Endpoint) setAccountActive(w http.ResponseWriter, r *http.Request, active bool) {
+	var req tp.SetAccountActiveReq
+	var res tp.SetAccountActiveRes
+
+	slug, err := ep.getAdminAccountSlug(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathAccounts(), SetAccountActiveErrID, err)
+		return
+	}
+
+	req = tp.SetAccountActiveReq{Identifier: tp.Identifier{Slug: slug}, Active: active}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SetAccountActive(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPathAccounts(), SetAccountActiveErrID, err)
+		return
+	}
+
+	msgID := AccountDeactivatedInfoID
+	if active {
+		msgID = AccountActivatedInfoID
+	}
+
+	m := ep.localize(r, msgID)
+	ep.RedirectWithFlash(w, r, AdminPathAccounts(), m, web.InfoMT)
+}
+
+func (ep *Endpoint) getUserRoles(r *http.Request, id tp.Identifier) (res tp.GetUserRolesRes, err error) {
+	req := tp.GetUserRolesReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GetUserRoles(req, &res)
+	if err != nil {
+		return res, err
+	}
+
+	res.Action = ep.grantRoleAction(res)
+	return res, nil
+}
+
+func (ep *Endpoint) renderUserRoles(w http.ResponseWriter, r *http.Request, wr PageRes) {
+	// Template
+	ts, err := ep.TemplateFor(adminRes, AdminRolesTmpl)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), GetUserRolesErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, AdminPathUsers(), GetUserRolesErrID, err)
+		return
+	}
+}
+
+func (ep *Endpoint) getRoleName(r *http.Request) (name string, err error) {
+	ctx := r.Context()
+	name, ok := ctx.Value(RoleCtxKey).(string)
+	if !ok {
+		err := errors.New("no role provided")
+		return "", err
+	}
+
+	return name, nil
+}
+
+func (ep *Endpoint) getAdminAccountSlug(r *http.Request) (slug string, err error) {
+	ctx := r.Context()
+	slug, ok := ctx.Value(AdminAccountCtxKey).(string)
+	if !ok {
+		err := errors.New("no account provided")
+		return "", err
+	}
+
+	return slug, nil
+}
+
+// grantRoleAction
+func (ep *Endpoint) grantRoleAction(model web.Identifiable) web.Action {
+	return web.Action{Target: AdminPathUserRoles(model), Method: "POST"}
+}
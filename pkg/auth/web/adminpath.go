@@ -0,0 +1,53 @@
+package web
+
+import (
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+// AdminRoot - Admin area root path.
+var AdminRoot = "admin"
+
+// AdminPath
+func AdminPath() string {
+	return web.ResPath(AdminRoot)
+}
+
+// AdminPathUsers
+func AdminPathUsers() string {
+	return web.ResPath(AdminRoot) + "/users"
+}
+
+// AdminPathUsersBulk
+func AdminPathUsersBulk() string {
+	return AdminPathUsers() + "/bulk"
+}
+
+// AdminPathUserRoles
+func AdminPathUserRoles(res web.Identifiable) string {
+	return web.ResPathSlug(AdminRoot+"/users", res) + "/roles"
+}
+
+// AdminPathUserRole
+func AdminPathUserRole(res web.Identifiable, name string) string {
+	return AdminPathUserRoles(res) + "/" + name
+}
+
+// AdminPathAccounts
+func AdminPathAccounts() string {
+	return web.ResPath(AdminRoot) + "/accounts"
+}
+
+// AdminPathAccountActivate
+func AdminPathAccountActivate(res web.Identifiable) string {
+	return web.ResPathSlug(AdminRoot+"/accounts", res) + "/activate"
+}
+
+// AdminPathAccountDeactivate
+func AdminPathAccountDeactivate(res web.Identifiable) string {
+	return web.ResPathSlug(AdminRoot+"/accounts", res) + "/deactivate"
+}
+
+// AdminPathAccountApproveTransfer
+func AdminPathAccountApproveTransfer(res web.Identifiable) string {
+	return web.ResPathSlug(AdminRoot+"/accounts", res) + "/approve-transfer"
+}
@@ -0,0 +1,188 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	APIKeysTmpl = "apikeys.tmpl"
+)
+
+const (
+	APIKeyCtxKey web.ContextKey = "api-key"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	APIKeyCreatedInfoID = "api_key_created_info_msg"
+	APIKeyRevokedInfoID = "api_key_revoked_info_msg"
+	// Error
+	GetAPIKeysErrID   = "get_api_keys_err_msg"
+	CreateAPIKeyErrID = "create_api_key_err_msg"
+	RevokeAPIKeyErrID = "revoke_api_key_err_msg"
+)
+
+// IndexAPIKeys web endpoint.
+func (ep *Endpoint) IndexAPIKeys(w http.ResponseWriter, r *http.Request) {
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetAPIKeysErrID, err)
+		return
+	}
+
+	res, err := ep.indexAPIKeys(r, id)
+	if err != nil {
+		ep.handleError(w, r, UserPathSecurity(tp.User{Slug: id.Slug}), GetAPIKeysErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+	ep.renderAPIKeys(w, r, wr)
+}
+
+// CreateAPIKey web endpoint.
+// The new key is rendered right away instead of redirecting
+// so that it is never stored, not even as a flash message.
+func (ep *Endpoint) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req tp.CreateAPIKeyReq
+	var res tp.CreateAPIKeyRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CreateAPIKeyErrID, err)
+		return
+	}
+
+	u := tp.User{Slug: id.Slug}
+
+	// Input data to request struct
+	err = ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, UserPathAPIKeys(u), CannotProcErrID, err)
+		return
+	}
+
+	req.Identifier = id
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.CreateAPIKey(req, &res)
+
+	// Input validation errors
+	if !res.Errors.IsEmpty() {
+		ires, err := ep.indexAPIKeys(r, id)
+		if err != nil {
+			ep.handleError(w, r, UserPathAPIKeys(u), CreateAPIKeyErrID, err)
+			return
+		}
+
+		ires.Errors = res.Errors
+		wr := ep.ErrRes(w, r, ires, InputValuesErrID, nil)
+		ep.renderAPIKeys(w, r, wr)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, UserPathAPIKeys(u), CreateAPIKeyErrID, err)
+		return
+	}
+
+	ires, err := ep.indexAPIKeys(r, id)
+	if err != nil {
+		ep.handleError(w, r, UserPathAPIKeys(u), GetAPIKeysErrID, err)
+		return
+	}
+
+	ires.NewKey = res.Key
+
+	// Wrap response
+	wr := ep.OKRes(w, r, ires, ep.localize(r, APIKeyCreatedInfoID))
+	ep.renderAPIKeys(w, r, wr)
+}
+
+// RevokeAPIKey web endpoint.
+func (ep *Endpoint) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeAPIKeyReq
+	var res tp.RevokeAPIKeyRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), RevokeAPIKeyErrID, err)
+		return
+	}
+
+	kid, err := ep.getAPIKeyID(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), RevokeAPIKeyErrID, err)
+		return
+	}
+
+	req = tp.RevokeAPIKeyReq{Identifier: id, APIKeyID: kid}
+	u := tp.User{Slug: id.Slug}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RevokeAPIKey(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathAPIKeys(u), RevokeAPIKeyErrID, err)
+		return
+	}
+
+	m := ep.localize(r, APIKeyRevokedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathAPIKeys(u), m, web.InfoMT)
+}
+
+func (ep *Endpoint) indexAPIKeys(r *http.Request, id tp.Identifier) (res tp.IndexAPIKeysRes, err error) {
+	req := tp.IndexAPIKeysReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.IndexAPIKeys(req, &res)
+	if err != nil {
+		return res, err
+	}
+
+	res.Action = ep.createAPIKeyAction(res)
+	return res, nil
+}
+
+func (ep *Endpoint) renderAPIKeys(w http.ResponseWriter, r *http.Request, wr PageRes) {
+	// Template
+	ts, err := ep.TemplateFor(userRes, APIKeysTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetAPIKeysErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetAPIKeysErrID, err)
+		return
+	}
+}
+
+func (ep *Endpoint) getAPIKeyID(r *http.Request) (id string, err error) {
+	ctx := r.Context()
+	id, ok := ctx.Value(APIKeyCtxKey).(string)
+	if !ok {
+		err := errors.New("no API key provided")
+		return "", err
+	}
+
+	return id, nil
+}
+
+// createAPIKeyAction
+func (ep *Endpoint) createAPIKeyAction(model web.Identifiable) web.Action {
+	return web.Action{Target: UserPathAPIKeys(model), Method: "POST"}
+}
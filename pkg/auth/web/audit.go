@@ -0,0 +1,58 @@
+package web
+
+import (
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	auditRes = "audit"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	IndexAuditEventsErrID = "get_audit_events_err_msg"
+)
+
+// IndexAuditEvents web endpoint.
+func (ep *Endpoint) IndexAuditEvents(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexAuditEventsReq
+	var res tp.IndexAuditEventsRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req.AuditFilter)
+	if err != nil {
+		ep.handleError(w, r, "/", CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.IndexAuditEvents(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAuditEventsErrID, err)
+		return
+	}
+
+	// Set additional values
+	res.Filter = req.AuditFilter
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(auditRes, web.IndexTmpl)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAuditEventsErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexAuditEventsErrID, err)
+		return
+	}
+}
@@ -0,0 +1,13 @@
+package web
+
+import (
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+// AuditRoot - Audit resource root path.
+var AuditRoot = "audit"
+
+// AuditPath
+func AuditPath() string {
+	return web.ResPath(AuditRoot)
+}
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	EmailChangeRequestedInfoID = "email_change_requested_info_msg"
+	EmailChangedInfoID         = "email_changed_info_msg"
+	EmailChangeCancelledInfoID = "email_change_cancelled_info_msg"
+	// Error
+	ConfirmEmailChangeErrID = "confirm_email_change_err_msg"
+	CancelEmailChangeErrID  = "cancel_email_change_err_msg"
+)
+
+// ConfirmEmailChange web endpoint.
+// Reached from the link sent to the new address.
+func (ep *Endpoint) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req tp.ConfirmEmailChangeReq
+	var res tp.ConfirmEmailChangeRes
+
+	// Identifier
+	slug, err := ep.getUserSlug(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ConfirmEmailChangeErrID, err)
+		return
+	}
+
+	// Token
+	token, err := ep.getToken(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ConfirmEmailChangeErrID, err)
+		return
+	}
+
+	req = tp.ConfirmEmailChangeReq{
+		Identifier: tp.Identifier{
+			Slug:  slug,
+			Token: token,
+		},
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.ConfirmEmailChange(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ConfirmEmailChangeErrID, err)
+		return
+	}
+
+	m := ep.localize(r, EmailChangedInfoID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
+
+// CancelEmailChange web endpoint.
+// Reached from the link sent to the old address.
+func (ep *Endpoint) CancelEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req tp.CancelEmailChangeReq
+	var res tp.CancelEmailChangeRes
+
+	// Identifier
+	slug, err := ep.getUserSlug(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CancelEmailChangeErrID, err)
+		return
+	}
+
+	// Token
+	token, err := ep.getToken(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CancelEmailChangeErrID, err)
+		return
+	}
+
+	req = tp.CancelEmailChangeReq{
+		Identifier: tp.Identifier{
+			Slug:  slug,
+			Token: token,
+		},
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.CancelEmailChange(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CancelEmailChangeErrID, err)
+		return
+	}
+
+	m := ep.localize(r, EmailChangeCancelledInfoID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
@@ -0,0 +1,222 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	svc "gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	ProviderCtxKey web.ContextKey = "provider"
+)
+
+const (
+	// Cookie store keys for the secrets of an ongoing provider sign-in.
+	FederatedProviderKey = "federated-provider"
+	FederatedStateKey    = "federated-state"
+	FederatedNonceKey    = "federated-nonce"
+	FederatedVerifierKey = "federated-verifier"
+	FederatedLinkKey     = "federated-link"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	IdentityLinkedInfoID   = "identity_linked_info_msg"
+	IdentityUnlinkedInfoID = "identity_unlinked_info_msg"
+	ProvisionedInfoID      = "provisioned_info_msg"
+	// Error
+	FederatedSignInErrID     = "federated_sign_in_err_msg"
+	FederatedEmailTakenErrID = "federated_email_taken_err_msg"
+	LinkIdentityErrID        = "link_identity_err_msg"
+	UnlinkIdentityErrID      = "unlink_identity_err_msg"
+)
+
+// FederatedAuth web endpoint.
+// Sends the user to the provider, state, nonce and PKCE verifier are kept
+// in the cookie store until it redirects back to FederatedCallback.
+// With 'link=true' a signed in user adds the provider to its account.
+func (ep *Endpoint) FederatedAuth(w http.ResponseWriter, r *http.Request) {
+	var req tp.FederatedAuthReq
+	var res tp.FederatedAuthRes
+
+	provider, err := ep.getProvider(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	req = tp.FederatedAuthReq{
+		Provider: provider,
+		Link:     r.URL.Query().Get("link") == "true",
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.FederatedAuthURL(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	s := ep.GetSession(r)
+	s.Values[FederatedProviderKey] = provider
+	s.Values[FederatedStateKey] = res.State
+	s.Values[FederatedNonceKey] = res.Nonce
+	s.Values[FederatedVerifierKey] = res.Verifier
+	s.Values[FederatedLinkKey] = req.Link
+	err = s.Save(r, w)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	http.Redirect(w, r, res.URL, http.StatusFound)
+}
+
+// FederatedCallback web endpoint.
+// Reached when the provider redirects back after the user authenticates.
+func (ep *Endpoint) FederatedCallback(w http.ResponseWriter, r *http.Request) {
+	var req tp.FederatedSignInReq
+	var res tp.FederatedSignInRes
+
+	provider, err := ep.getProvider(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	// Secrets are single use
+	s := ep.GetSession(r)
+	expected, _ := s.Values[FederatedProviderKey].(string)
+	state, _ := s.Values[FederatedStateKey].(string)
+	nonce, _ := s.Values[FederatedNonceKey].(string)
+	verifier, _ := s.Values[FederatedVerifierKey].(string)
+	link, _ := s.Values[FederatedLinkKey].(bool)
+	delete(s.Values, FederatedProviderKey)
+	delete(s.Values, FederatedStateKey)
+	delete(s.Values, FederatedNonceKey)
+	delete(s.Values, FederatedVerifierKey)
+	delete(s.Values, FederatedLinkKey)
+	s.Save(r, w)
+
+	if expected != provider {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, errors.New("unexpected provider"))
+		return
+	}
+
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, errors.New(e))
+		return
+	}
+
+	req = tp.FederatedSignInReq{
+		Provider:      provider,
+		Code:          q.Get("code"),
+		State:         q.Get("state"),
+		ExpectedState: state,
+		Nonce:         nonce,
+		Verifier:      verifier,
+		Link:          link,
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.FederatedSignIn(req, &res)
+
+	if link {
+		u := tp.User{Slug: currentUserSlug(r)}
+
+		if err != nil {
+			ep.handleError(w, r, UserPathSecurity(u), LinkIdentityErrID, err)
+			return
+		}
+
+		m := ep.localize(r, IdentityLinkedInfoID)
+		ep.RedirectWithFlash(w, r, UserPathSecurity(u), m, web.InfoMT)
+		return
+	}
+
+	if err == svc.ErrEmailTaken {
+		ep.handleError(w, r, UserPathSignIn(), FederatedEmailTakenErrID, err)
+		return
+	}
+
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), subscriptionErrID(err, FederatedSignInErrID), err)
+		return
+	}
+
+	err = ep.storeSessionToken(w, r, res.SessionToken)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+		return
+	}
+
+	if res.VerificationRequired {
+		m := ep.localize(r, SignInVerificationWarnID)
+		ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.WarnMT)
+		return
+	}
+
+	msgID := LoggedInInfoID
+	if res.Provisioned {
+		msgID = ProvisionedInfoID
+	}
+
+	m := ep.localize(r, msgID)
+	ep.RedirectWithFlash(w, r, ep.signedInPath(w, r), m, web.InfoMT)
+}
+
+// UnlinkIdentity web endpoint.
+func (ep *Endpoint) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	var req tp.UnlinkIdentityReq
+	var res tp.UnlinkIdentityRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), UnlinkIdentityErrID, err)
+		return
+	}
+
+	provider, err := ep.getProvider(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), UnlinkIdentityErrID, err)
+		return
+	}
+
+	req = tp.UnlinkIdentityReq{Identifier: id, Provider: provider}
+	u := tp.User{Slug: id.Slug}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.UnlinkIdentity(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSecurity(u), UnlinkIdentityErrID, err)
+		return
+	}
+
+	m := ep.localize(r, IdentityUnlinkedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSecurity(u), m, web.InfoMT)
+}
+
+func (ep *Endpoint) getProvider(r *http.Request) (name string, err error) {
+	ctx := r.Context()
+	name, ok := ctx.Value(ProviderCtxKey).(string)
+	if !ok {
+		err := errors.New("no provider provided")
+		return "", err
+	}
+
+	return name, nil
+}
+
+// currentUserSlug returns the slug of the signed in user, if any.
+func currentUserSlug(r *http.Request) string {
+	cs, _ := tp.CurrentSessionFrom(r.Context())
+	return cs.UserSlug
+}
@@ -0,0 +1,143 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	svc "gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	// ImpersonatorTokenKey is the cookie store key for the admin
+	// session token kept while impersonating a user.
+	ImpersonatorTokenKey = "impersonator-session-token"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	ImpersonationStartedInfoID = "impersonation_started_info_msg"
+	ImpersonationStoppedInfoID = "impersonation_stopped_info_msg"
+	// Error
+	ImpersonateErrID       = "impersonate_err_msg"
+	StopImpersonationErrID = "stop_impersonation_err_msg"
+	ImpersonatingErrID     = "not_allowed_while_impersonating_err_msg"
+)
+
+type (
+	// PageRes is the wrapped response page templates are executed with.
+	PageRes struct {
+		web.WrappedRes
+		// Impersonation is set while an admin acts as the signed in user,
+		// the layout shows it in a banner.
+		Impersonation *tp.CurrentSession
+	}
+)
+
+// OKRes wraps a response to be shown in a page.
+func (ep *Endpoint) OKRes(w http.ResponseWriter, r *http.Request, data interface{}, infoMsg string, warnMsgs ...string) PageRes {
+	wr := ep.Endpoint.OKRes(w, r, data, infoMsg, warnMsgs...)
+	return ep.pageRes(r, wr)
+}
+
+// ErrRes wraps an error response to be shown in a page.
+func (ep *Endpoint) ErrRes(w http.ResponseWriter, r *http.Request, data interface{}, errorMsg string, err error) PageRes {
+	wr := ep.Endpoint.ErrRes(w, r, data, errorMsg, err)
+	return ep.pageRes(r, wr)
+}
+
+func (ep *Endpoint) pageRes(r *http.Request, wr web.WrappedRes) PageRes {
+	pr := PageRes{WrappedRes: wr}
+
+	cs, ok := tp.CurrentSessionFrom(r.Context())
+	if ok && cs.IsImpersonation() {
+		pr.Impersonation = &cs
+	}
+
+	return pr
+}
+
+// ImpersonateUser web endpoint.
+// The admin session token is kept aside
+// to be restored when the impersonation stops.
+func (ep *Endpoint) ImpersonateUser(w http.ResponseWriter, r *http.Request) {
+	var req tp.ImpersonateUserReq
+	var res tp.ImpersonateUserRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ImpersonateErrID, err)
+		return
+	}
+
+	req = tp.ImpersonateUserReq{Identifier: id}
+	u := tp.User{Slug: id.Slug}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.ImpersonateUser(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSlug(u), impersonationErrID(err, ImpersonateErrID), err)
+		return
+	}
+
+	s := ep.GetSession(r)
+	s.Values[ImpersonatorTokenKey] = s.Values[SessionTokenKey]
+	s.Values[SessionTokenKey] = res.SessionToken
+	err = s.Save(r, w)
+	if err != nil {
+		ep.handleError(w, r, UserPathSlug(u), ImpersonateErrID, err)
+		return
+	}
+
+	m := ep.localize(r, ImpersonationStartedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSlug(res), m, web.InfoMT)
+}
+
+// StopImpersonation web endpoint.
+func (ep *Endpoint) StopImpersonation(w http.ResponseWriter, r *http.Request) {
+	var req tp.StopImpersonationReq
+	var res tp.StopImpersonationRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.StopImpersonation(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), StopImpersonationErrID, err)
+		return
+	}
+
+	err = ep.restoreImpersonator(w, r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), StopImpersonationErrID, err)
+		return
+	}
+
+	m := ep.localize(r, ImpersonationStoppedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSlug(res), m, web.InfoMT)
+}
+
+// restoreImpersonator puts back the admin session token.
+func (ep *Endpoint) restoreImpersonator(w http.ResponseWriter, r *http.Request) error {
+	s := ep.GetSession(r)
+
+	token, ok := s.Values[ImpersonatorTokenKey].(string)
+	if !ok || token == "" {
+		return errors.New("no impersonator session")
+	}
+
+	s.Values[SessionTokenKey] = token
+	delete(s.Values, ImpersonatorTokenKey)
+	return s.Save(r, w)
+}
+
+// impersonationErrID returns the message for err,
+// actions refused while impersonating get their own.
+func impersonationErrID(err error, msgID string) string {
+	if errors.Is(err, svc.ErrImpersonating) {
+		return ImpersonatingErrID
+	}
+	return msgID
+}
@@ -0,0 +1,157 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	MagicLinkTmpl = "magiclink.tmpl"
+)
+
+const (
+	// MagicLinkBindingKey is the cookie store key for the magic link binding.
+	MagicLinkBindingKey = "magic-link-binding"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	MagicLinkSentInfoID = "magic_link_sent_info_msg"
+	// Error
+	RequestMagicLinkErrID = "request_magic_link_err_msg"
+	MagicLinkSignInErrID  = "magic_link_sign_in_err_msg"
+)
+
+// RequestMagicLink web endpoint.
+// The binding returned is kept in the cookie store
+// so that the link can only be used from this browser without confirmation.
+func (ep *Endpoint) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var req tp.RequestMagicLinkReq
+	var res tp.RequestMagicLinkRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RequestMagicLink(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), RequestMagicLinkErrID, err)
+		return
+	}
+
+	s := ep.GetSession(r)
+	s.Values[MagicLinkBindingKey] = res.Binding
+	err = s.Save(r, w)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), RequestMagicLinkErrID, err)
+		return
+	}
+
+	m := ep.localize(r, MagicLinkSentInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.InfoMT)
+}
+
+// MagicLinkSignIn web endpoint.
+// Reached from the emailed link.
+func (ep *Endpoint) MagicLinkSignIn(w http.ResponseWriter, r *http.Request) {
+	ep.magicLinkSignIn(w, r, false)
+}
+
+// ConfirmMagicLinkSignIn web endpoint.
+// Used when the link is opened in a browser other than the one that requested it.
+func (ep *Endpoint) ConfirmMagicLinkSignIn(w http.ResponseWriter, r *http.Request) {
+	ep.magicLinkSignIn(w, r, true)
+}
+
+func (ep *Endpoint) magicLinkSignIn(w http.ResponseWriter, r *http.Request, confirmed bool) {
+	var req tp.MagicLinkSignInReq
+	var res tp.MagicLinkSignInRes
+
+	// Identifier
+	slug, err := ep.getUserSlug(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), MagicLinkSignInErrID, err)
+		return
+	}
+
+	// Token
+	token, err := ep.getToken(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), MagicLinkSignInErrID, err)
+		return
+	}
+
+	s := ep.GetSession(r)
+	binding, _ := s.Values[MagicLinkBindingKey].(string)
+
+	req = tp.MagicLinkSignInReq{
+		Identifier: tp.Identifier{
+			Slug:  slug,
+			Token: token,
+		},
+		Binding:   binding,
+		Confirmed: confirmed,
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SignInWithMagicLink(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), subscriptionErrID(err, MagicLinkSignInErrID), err)
+		return
+	}
+
+	if res.ConfirmationRequired {
+		res.Action = ep.magicLinkConfirmAction(slug, token)
+		ep.renderMagicLinkConfirm(w, r, res)
+		return
+	}
+
+	delete(s.Values, MagicLinkBindingKey)
+	err = ep.storeSessionToken(w, r, res.SessionToken)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+		return
+	}
+
+	if res.VerificationRequired {
+		m := ep.localize(r, SignInVerificationWarnID)
+		ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.WarnMT)
+		return
+	}
+
+	m := ep.localize(r, LoggedInInfoID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
+
+func (ep *Endpoint) renderMagicLinkConfirm(w http.ResponseWriter, r *http.Request, res tp.MagicLinkSignInRes) {
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, MagicLinkTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), MagicLinkSignInErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), MagicLinkSignInErrID, err)
+		return
+	}
+}
+
+// magicLinkConfirmAction
+func (ep *Endpoint) magicLinkConfirmAction(slug, token string) web.Action {
+	return web.Action{Target: fmt.Sprintf("%s/%s/magic-signin", UserPathSlug(tp.User{Slug: slug}), token), Method: "POST"}
+}
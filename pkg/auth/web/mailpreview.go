@@ -0,0 +1,92 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	mailPreviewRes = "mail"
+)
+
+const (
+	MailCtxKey web.ContextKey = "mail"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	PreviewMailErrID = "preview_mail_err_msg"
+)
+
+// IndexMailPreviews web endpoint.
+func (ep *Endpoint) IndexMailPreviews(w http.ResponseWriter, r *http.Request) {
+	var req tp.PreviewMailReq
+	var res tp.PreviewMailRes
+
+	// Service
+	err := ep.service.PreviewMail(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", PreviewMailErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(mailPreviewRes, web.IndexTmpl)
+	if err != nil {
+		ep.handleError(w, r, "/", PreviewMailErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, "/", PreviewMailErrID, err)
+		return
+	}
+}
+
+// PreviewMail web endpoint.
+// Email is written as is, not wrapped by site layout.
+// Use 'format=text' query param to get the plain text version.
+func (ep *Endpoint) PreviewMail(w http.ResponseWriter, r *http.Request) {
+	var req tp.PreviewMailReq
+	var res tp.PreviewMailRes
+
+	name, ok := r.Context().Value(MailCtxKey).(string)
+	if !ok {
+		err := errors.New("no mail provided")
+		ep.handleError(w, r, MailPreviewPath(), PreviewMailErrID, err)
+		return
+	}
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, MailPreviewPath(), CannotProcErrID, err)
+		return
+	}
+	req.Name = name
+
+	// Service
+	err = ep.service.PreviewMail(req, &res)
+	if err != nil {
+		ep.handleError(w, r, MailPreviewPath(), PreviewMailErrID, err)
+		return
+	}
+
+	// Write response
+	if r.URL.Query().Get("format") == "text" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("Subject: " + res.Subject + "\n\n" + res.Text))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(res.HTML))
+}
@@ -0,0 +1,25 @@
+package web
+
+import (
+	"fmt"
+
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+// MailPreviewRoot - Mail preview resource root path.
+var MailPreviewRoot = "mail-previews"
+
+// MailPreviewPath
+func MailPreviewPath() string {
+	return web.ResPath(MailPreviewRoot)
+}
+
+// MailPreviewPathName
+func MailPreviewPathName(name, lang string) string {
+	return fmt.Sprintf("/%s/%s?lang=%s", MailPreviewRoot, name, lang)
+}
+
+// MailPreviewPathText
+func MailPreviewPathText(name, lang string) string {
+	return MailPreviewPathName(name, lang) + "&format=text"
+}
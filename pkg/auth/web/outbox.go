@@ -0,0 +1,101 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	outboxRes = "outbox"
+)
+
+const (
+	OutboxEmailCtxKey web.ContextKey = "outbox-email"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	OutboxEmailRequeuedInfoID = "outbox_email_requeued_info_msg"
+	// Error
+	IndexOutboxEmailsErrID  = "get_outbox_emails_err_msg"
+	RequeueOutboxEmailErrID = "requeue_outbox_email_err_msg"
+)
+
+// IndexOutboxEmails web endpoint.
+func (ep *Endpoint) IndexOutboxEmails(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexOutboxEmailsReq
+	var res tp.IndexOutboxEmailsRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req.OutboxFilter)
+	if err != nil {
+		ep.handleError(w, r, "/", CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.IndexOutboxEmails(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexOutboxEmailsErrID, err)
+		return
+	}
+
+	// Set additional values
+	res.Filter = req.OutboxFilter
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(outboxRes, web.IndexTmpl)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexOutboxEmailsErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, "/", IndexOutboxEmailsErrID, err)
+		return
+	}
+}
+
+// RequeueOutboxEmail web endpoint.
+func (ep *Endpoint) RequeueOutboxEmail(w http.ResponseWriter, r *http.Request) {
+	var req tp.RequeueOutboxEmailReq
+	var res tp.RequeueOutboxEmailRes
+
+	id, err := ep.getOutboxEmailID(r)
+	if err != nil {
+		ep.handleError(w, r, OutboxPath(), RequeueOutboxEmailErrID, err)
+		return
+	}
+
+	// Service
+	req.ID = id
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RequeueOutboxEmail(req, &res)
+	if err != nil {
+		ep.handleError(w, r, OutboxPath(), RequeueOutboxEmailErrID, err)
+		return
+	}
+
+	m := ep.localize(r, OutboxEmailRequeuedInfoID)
+	ep.RedirectWithFlash(w, r, OutboxPath(), m, web.InfoMT)
+}
+
+func (ep *Endpoint) getOutboxEmailID(r *http.Request) (id string, err error) {
+	ctx := r.Context()
+	id, ok := ctx.Value(OutboxEmailCtxKey).(string)
+	if !ok {
+		err := errors.New("no email provided")
+		return "", err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,20 @@
+package web
+
+import (
+	"fmt"
+
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+// OutboxRoot - Outbox resource root path.
+var OutboxRoot = "outbox"
+
+// OutboxPath
+func OutboxPath() string {
+	return web.ResPath(OutboxRoot)
+}
+
+// OutboxPathRequeue
+func OutboxPathRequeue(id string) string {
+	return fmt.Sprintf("/%s/%s/requeue", OutboxRoot, id)
+}
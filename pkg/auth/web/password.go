@@ -0,0 +1,99 @@
+package web
+
+import (
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	PasswordTmpl = "password.tmpl"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	PasswordChangedInfoID = "password_changed_info_msg"
+	// Error
+	ChangePasswordErrID = "change_password_err_msg"
+)
+
+// InitChangePassword web endpoint.
+func (ep *Endpoint) InitChangePassword(w http.ResponseWriter, r *http.Request) {
+	var res tp.ChangePasswordRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ChangePasswordErrID, err)
+		return
+	}
+
+	res.User = tp.User{Slug: id.Slug}
+	res.Action = ep.changePasswordAction(res)
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, PasswordTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ChangePasswordErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ChangePasswordErrID, err)
+		return
+	}
+}
+
+// ChangePassword web endpoint.
+func (ep *Endpoint) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req tp.ChangePasswordReq
+	var res tp.ChangePasswordRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), ChangePasswordErrID, err)
+		return
+	}
+
+	// Input data to request struct
+	err = ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CannotProcErrID, err)
+		return
+	}
+
+	req.Identifier = id
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.ChangePassword(req, &res)
+
+	// Input validation errors
+	if !res.Errors.IsEmpty() {
+		res.User.Slug = id.Slug
+		res.Action = ep.changePasswordAction(res)
+		ep.rerenderUserForm(w, r, res, PasswordTmpl)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, UserPath(), impersonationErrID(err, ChangePasswordErrID), err)
+		return
+	}
+
+	m := ep.localize(r, PasswordChangedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSecurity(res), m, web.InfoMT)
+}
+
+// changePasswordAction
+func (ep *Endpoint) changePasswordAction(model web.Identifiable) web.Action {
+	return web.Action{Target: UserPathPassword(model), Method: "PUT"}
+}
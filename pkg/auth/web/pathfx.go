@@ -9,4 +9,50 @@ var pathFxs = template.FuncMap{
 	"userPathSlug":       UserPathSlug,
 	"userPathInitDelete": UserPathInitDelete,
 	"userPathNew":        UserPathNew,
+	"userPathSearch":     UserPathSearch,
+	"userPathDeleted":    UserPathDeleted,
+	"userPathRestore":    UserPathRestore,
+	"userPathSecurity":   UserPathSecurity,
+	"userPathSessions":   UserPathSessions,
+	"userPathSession":    UserPathSession,
+	"userPathPassword":   UserPathPassword,
+	"userPathAPIKeys":    UserPathAPIKeys,
+	"userPathAPIKey":     UserPathAPIKey,
+	"userPathMagicLink":  UserPathMagicLink,
+	"userPathProvider":   UserPathProvider,
+	"userPathIdentity":   UserPathIdentity,
+	// User impersonation
+	"userPathImpersonate":   UserPathImpersonate,
+	"userPathImpersonation": UserPathImpersonation,
+	// Account
+	"accountPath":           AccountPath,
+	"accountPathEdit":       AccountPathEdit,
+	"accountPathSlug":       AccountPathSlug,
+	"accountPathInitDelete": AccountPathInitDelete,
+	"accountPathNew":        AccountPathNew,
+	"accountPathTransfer":   AccountPathTransfer,
+	// Admin
+	"adminPath":                  AdminPath,
+	"adminPathUsers":             AdminPathUsers,
+	"adminPathUsersBulk":         AdminPathUsersBulk,
+	"adminPathUserRoles":         AdminPathUserRoles,
+	"adminPathUserRole":          AdminPathUserRole,
+	"adminPathAccounts":          AdminPathAccounts,
+	"adminPathAccountActivate":   AdminPathAccountActivate,
+	"adminPathAccountDeactivate": AdminPathAccountDeactivate,
+	// Admin account transfers
+	"adminPathAccountApproveTransfer": AdminPathAccountApproveTransfer,
+	// Audit
+	"auditPath": AuditPath,
+	// Outbox
+	"outboxPath":        OutboxPath,
+	"outboxPathRequeue": OutboxPathRequeue,
+	// Mail preview
+	"mailPreviewPath":     MailPreviewPath,
+	"mailPreviewPathName": MailPreviewPathName,
+	"mailPreviewPathText": MailPreviewPathText,
+	// Options
+	"accountTypes": AccountTypes,
+	// Text
+	"highlight": Highlight,
 }
@@ -4,5 +4,4 @@ import "github.com/markbates/pkger"
 
 import "github.com/markbates/pkger/pkging/mem"
 
-
 var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd5993e3baf12ff85dea75fafa7091aa4b1d711f4a5289225ba4ac8d0b26261c5c74b88894e4d64afec3df7d02e00e801479c6c7be9ef0c309bb5540221348247e998904ffe7cd3ffe7ebabcfdf89f37d7bf86a6f517fb14fd16f9875fa7c73ed987bf59a67dd81f9ddfdc5fe6d1b74dd870eaff7afbf1f69b778af6bf99ce2fdf3cfef6d75fa7606f5f2f6d3dbfbd89d1f9f4ebfa57f3eabdfde838dcb737f9e4dcc23d1cb76f47d33fbefdb8febaedbffd719685937c72fe40d7dfdcd35fa2938328a8fb5f17ff747cfbf1c6fe85e5dffef1ed4d31a3fddb8fb70872f88f7f7c7bfbdd0ff7ddd7e0c76fe6e5b2bf5e7e7becaddff691b5776057b88ef07f9dfdd5f44344ed980e9336f9f676f193fddb8f01337afff6169d9cfddb0f8e1d7c1f7c0cd8e177f4cbdfae3eeac031ece87fb1ecffe2f82dc7fd60f81f43e62fa377d89467d8ff8b617f30ccdbb737fff237074e6b3ac397180d39dddfdf7ebc0fbebf8fbebd89c7d3db0f9efb18b0efefdfde94d03f1ede7e0cd18aeedf7eb0ef1f23fedbdbce77de7eb00cc37c7b13caffabffed6f67d361de7e30dfded60ea4c97c7bdb54f81f87874bed9f27fb7079fbf1f1ededf3ea479093cdde7efbc10ebff3a3013f1c0dbfbd2917f8cb07cf7ebcf30cf3fd1fdfde64ace96038e0199ec99b1622ffe3dbdba47b53fd6f7fbb1d6f17b82aff37f38df9c6fc3fff806beced7ffd77f3fcd337cfb7b73312ec7fdefe7a707bc897112177d23fbebd39e6d57cfb71bc8521a4fe6b7fbc96f4cbae68e3fec1fdfa9bcf7e1cdb372d6ad17bcf72ff8be3b60cfb831bc13dfbc1bd73df87cc60d87bcf7effce316cb167b97ff59e6dd95df89e7d1f7d1f0c4745d34264ca9e6d69fadf3dfb1fbc67d3ddf4afdbb8bf39fbbf0497d38b0d9c37caf7303b648a3d3ce0dfdb372f33facb6030fae0d9d1883c707f37c34bfbee1d7d1f7e14bb97cd772fcf73cce00fecde94f3eebb17edb3c128df6785204dbb97de94ba7b2b4d87fc07cf31fc9fb57bb3a5c737f1ffbff76726f39fb3438b6d53ecd4b7fde3e43bba12dae1e86c45eb703f11dfc5893401da9075e6e7d08ec21b10d481e83f7e8ad13074d8116b736a2c0627573c0c43475363f14b0aada3caec23354edba907535fff8ee8fa183d7fec036d161b917a7604f586da1f9593c53b4ded3deb780e4dcd092d5fbc4c7c293135e7a673e10df1102a67c00ddc8d36f0179f27dfe6c2a3158e583b95630bdb8a33f501c799f812e25de7941868330668ebdf4d6d1859ece86a73b6bff43ffd55a4de1c7dfd0e74d105ba9438827ab1e62b443b9b27d616d69e23a807c8038846bf5bba724ce7a3320fc2ec9733573d4b0813535363678278cfc65f8796a0be1e9f532f8fef2031388fc9f847b2c37e8e3e26faeea0ec917a10e7ea431494bba58f2f405f87201d3bb0b86164ead29536ae8ae67e1c5adaec6c4dc69ec12977471b32884e04ce76c4e6742e16e71c331a240fbc1a189c9ad81c602de1391485e71d704e0826e3335a3ff7e4034e65746e76b166a3d03e4ad4f983f3e6083bd7e46637cb1fdf4c4d393990afc809eda31a016de03a9c1a835c87a26c4dd5669aa270f5ac68e05a511898f3956b68d211e8120b22f526ce9dd08ed6b98e1d81befe1de852ac736c026242277f19daf0260ac3335a1b418d6d1eaee993c1e8e4bad28316ae37631ee8d20164fa0d0415ada9cea9b11dd2f5069bb323d092e9f5a82099eb7316deecb9cae83cf0acb91ada2c367797a56d446a6ccf17d3bb0042cb1f6f4d6de702210c45413d5b9113024ef58136847fd3812e316093aeb1c14b211cc71142cf9a2bb8fc1ad0a533381e5cb4a7a2a167693bd7d08607713ef66c5ee12d5e3a643a1fc0f9b1f8f5eff65cba1b388f073511a1ae4cc607a04bef400b99722ef9e935722e701e1693f101f2bed286911dcd8e60337e7736a42d9af8520cf4196bea520808fd5463108de0fc41fd0ccc743f5ce13eb004f506f8f5095f17edb8734d5e8df7a93e5c0ddd6b6d6b09b31b88515b24b3a13d2f16ef5078c9650c6f22e267c61ac735d427d9d495741d321a40633d537be26bb0005a78039bf16cb1837f7f5c57da3a06ba92647d91bdd039d6730485e075c7afcf96c656e69a3da76b20baab484d1c79797084f0e868c3a3282f3fa01ec1f1723b6668ecc312d4df2d0dda0ab81ef9be08135b08434b187a06e7c570edb233c243f64c1d0516afde9cd9e80a7935342784f3b17f9c5c71221d80ae0476143e1c21bc5be959b2b5383614e7c0b3a3d033a267086d5a6adbd8d0e6150f703bb8b760ffbb15ed52f9e6eacde457ee9ebfdcb6d1ec0a7476240a9ee74cc6dff7f1708a7898b3237106edd12aef7f35b4e1303d97c6ac155d19717efdbe3840fd5647bf6fc627434f69aeb8116b1dd7f0b772dff89fae188d1853c8ced7fe347e8a93f16872647ebefdb9f87b7fec80bff34605fee6075c67fccd7dfc65c8320cffce7c707f0c7ff3ff44fc8d38ef89bfdf3f72a45c08d284bfe94da9f8bbda74c0f0ef2cf75ffcfd1f83bff31df1127fab08637fbdc0def1386d47c7dc3320848c385313a08b1936f6ee351a21c4ddb69b62bf3f1f5b671833b02315e1e7d4ae43fe2488670f06c4aa33380fe05e39a75219e7ea0308906e0d8b1f80f60ca9583c4472bb395e42d8d5cd6488d483ceab0fb20f947708b12a6b4fc681c5a133aa18d3e046371085b1a5b58c87ceda876b684a7a4e213a39ee4ee958c2e808b894068507d6e014782e458ef6bcec37e30c6faf5c335d879f62e484ce6ce459c2335def2381afa629665a411f8211e7296f08534fec54165e0ae17ceb7c3aff4dfdf376198d148342fcfea25f65dc6cbdd2f57dd52f6f571b2fc7bcafc6ccdae5f276c2b821803e6eb4df8c430be227e43f656de37f359685d8354c4461787726e387c52b89a94917b01933563c7ed8d1288098479caf4f6033463815e80af41918f89ba9cbae2384d71c83db91fa74b430867b11e3795360dc0e1856154677710e6d85c4a473ca7afbd9e802b4e1d1113c9cf6cee23e5c4b1875c5b150d6b305fd7ce1797738f5200acfb3cd677b251a313aa75e0d2dbce07c6da311941de25ff47751981dc05c0a6d5e2ef76b38ba1afa3a308971d777a0cbae8568a418579c3b674770dd729fcf18531ba59815d98ed9d5169ea1ce95b831f5bbd3df313c59699fe2cd7abff1f714efad439b5362531fa763a536e00e75c88966b1a9cd7c4bd8419e58ebb8722b7839c78bb70c3b4b8ea05e49ac28e3589133b4275bf6673deb784072e058d78e31ac5bdd1738563d761c57977dd8f7f7d5793471fff7fffeb371eaa50b4ebd603875f0cebec2a9ec68cb303ff8f71fdcf7bf70ef2c331831a3c11fc0a9dfdf07ec3f11a722ce7be0d4ef2cc77e7ccf116521080da7363725706a73d3ffe2d4ff0c9c7ae9865317d3401b1e4c4d0945213d771d0ddaac51d2805959a0837bd9ee233ddfb5f5d99955db212cebd9fe98b57915faedf78638b162f10a6347b318a79961d5731e034058f52bcce3cd10e7fdeee89f65dcd41fab76861133390b9c4ac37ad958ae297cb936171eac38c5b540f8e8162ba6d3f00c7ecd1adaf3bc8f66072bee16335675853574e96cc5e393b11927a6b6be8b8274b78f52ce4f2e333c7f599bc4d8db34ae0cf1d7273c6bd0b95560f4288be1c27e21d9d7d114844bc051bd58f3839be35b149b4a710f8aa7420c409ee11faecded5c7bae8640d8b9162731401b423fe1626cc61753571863333e804da90b36f751c7b0149af06cb785518e9fd0daa0f8f604d3a9144b1398449c2ba1381fb3405b87a230f21d888d10be7f409c97cd776b8c7769c5e3d08e118e3ba771a75990c576df0d3aaddc9fe9402b5d5f628f74c4bba6a6c0be178b9721ceb858bc04e99fadc88981ae784048f730c4ac1013036dc898daecd2829f919e5a103f6cc69e15ad5c5b982536efdced6895f92d8a6745c3bb331b212cdb61dd72cc9baf5d05f3aa10f7a11c81a58dae903f2bee867b115ecff6a9a5411fec195a91c35030626869b3c4d466687f43fc9bad536b1c770df1a8ae9c0db40f9f9e1d39d9da20d90b9c4bf43baaa11d7db9681fa0fcc757070c9cce99a30d3d51589fed480d0c2ebc5b3eda3390e718681fae735942bd3b181d62bb90a6c1c17dae9c418a3d6f508e4a2ec833b8e3f4c20d72db92c7666b3159f1c086d651f1cc688764b2e7120b34c9fb77c473d7dac37dbcb3670a46664c4dbd810d05eb0aa334b632f9f42d6d76dba7387b664da6d35ffa3ab4d23e4b4363c3df7506fa5ea115ed68b8f76e476a52d0e2d727e8db56f86280c6d463cdf3f519dac194d7543e3446b157ff65b1de73d80143e78dcabb165cf7bb16e8a214c77d1f3294cb8daf31f4071ae09f77d782eb7fd7a27aa72913a4cb4da9b2e9bff9a6d47f31f43f1d43e73be265ac5782ffdf84e7b0ad7decf5f52f8b776ea6769f5e78e7dc80a3ef0e7fc0da5f1ab1f45e5fb3a63f669fd673e808d7bbc30dcf26f7d510131edf4d6e3ebb4f9adaffa9b89a71b851bc8f46b73d3cfb8fe770dfc0f76b7cadcfeef39031b9116f45e12f717ef6cc025b8f7894a7abc49f8b383125fe5c1b3f4678eb66da1be0cc0fff046c5db95fd114fb2ee35b411e07aac6a2ff7c8c1e86a2c0de9fd6f3666cc6ecfe18262637fff2e2149f43ec579ba38f65e2a438295f6b02cf9a1ac46dbfcdeec2d013e7eafb5e577e19f6662eceebeb66688732e6fc822f67eedcede379f8146d6c6f5c33cc91e510883b22c333d88cafd687f661455fee3e2aee60bc5bbce335d28b329fec053d88f1adcb6646a363a678ec6c1dc7ac331b65313222c6bcb5e792e770dbd9cd1ff3f65cba3bdcecb047b1bf316fe812637d6896c11d729c0db16f681dd767437b26443c3752635303c3344e2edd207e1185d90d4cc60f435712b807f37c89c1cd6e087ff2e3d8e208befe6a446a60a175a8cb6ccfa577a0af798b97def7705d65ede329aa07431b9e8dd7f1697dafb11ee010e67a199f5e45b318cccf7751b8c68fef0a637239b69f0d9ab1bde4d9d1fadd8ac787a721b120ef83b0f6ec6209239ec2d7d2d454d6e1cf474bde00200ccf16371c8a508785e7f909ae81a9ed3a6076385fe7d0e657ae81ee5cc82ed8b0e91e2f6dc2ef9636630c8e88afa3bd037d2b473b87a2b0be8bf3f1dd993b67a04b877da4de4cedab1d9787e3787f0c7f3d0d09fa79bcb119f3c8c7e1d8fb13dcbfbc74ef167734529bb3aedfd5985f109605829a18bc74b6e7ebb3c50d32bb3f823e2007fd5a23f3bfb35c58c9478e85b961ced37d7f0c0313eebf5a1c9af5080ccccd6247f86d167d6ce6147cbeb2f8f3d0e0ce1e86f3af0681f11dcf3eaeef68dde8f72ffaf3c42967d13db9e29cf9f9fbeaf427c6b7cf372bf4ed17a83c6df3078a18587ecbb13fd8e10f9efb0bff3e623fb8d1c7f73f5478f4bd80e683ff830b8fdeb9f70f96e7f2a685c83468dedcf4bf450cffc9450cd96ef9d79431a483fd665f2eed5b1836f8038583ecfb96e37ff0fc0f76f8978fc1f78f779e1dbef7ddbfc3c1901dbdfffb8a907aec5fbc692e3265ffb634fdeffefdcfdfbf684bfdabf7f06f70db3efca3f397971bbad632dfd91f1fdc07336c0f9875ddd4af02666857ff138b9372de1bf635fb9debb0b52b9bf03f676bff376af6e76cdffa6e2a03688bf8e489c2f06e472c72cec16618d8bcec3a817b932783a7387fb8db5db813bf9e6783536f36b7737f9f8c8fa6be3e399a7833b8d175c10d43831b5d0c5dbe5bd128b6b4d9c5d4cfe12252123bfefcfbe271fab9884fee5a18058ec686d671051d0cf7af5b66f427ffe7fef43f7e4efc8fbfff744fee4ffff3b9f0c7d33481a330d9a5af8ba90d4351f04253734ee5c5a9876b1ca5bbc32ba17d946f13fff3efe254bc89b3710c7410a28091b6febba32b8ca541e747762dce704134824e5902e5178559e440c74718c5266c2364979f04e56468c32374524d6de09abbd17681f8fbf839893cc6d21e69b246789ead68773505f50c388f5942fe832f76197fdea12ce2e474870ed642774223ba9e9d09cb00dd6316ba72de47bb6bce1fec274f3fc3ac5f8cfa1d19342fe24c090d1e3a9ac304396bd33f7d4d46687cf774ffe99f7e8a9393bb8bd4abc583305b8faba14b47342fdac035b4e74514a4d8e29d04e85252ce937407f343364fac6747ced90a4eae3cbd402711eadbdfd19acda4d08a501107630a3bd71058cfd486ae28a81790ea220afcd95c9838e842e2738802785fe1ac1c0bf6196463adcf363fbe18fae15d14a48bc529bf968ff3a82acf8a1bc576a406ce241d13d3891b9894325adcc03504f7694cc6a125a828e96ac7631eee2f24ffbc581f17f5fd843c8d5d43971853532e404301d09b23a803a883b0cf8af3628b63c3c5642ca00b89d168b098a409e41d378b0c5d3a97b2b9cf34f0308e2c6e98ebcebb3895426b8b7ecf797d17a79f372570426bf3f928651ecfed68c4da42780331ba98cb4019fe5dba246f06ee4a5b1f325d0a8abd2e48f77dba06672bb2d13cada330061ab8ef2783b4af3f70779c7777e274dda04eda11b8587cd65e5b1ff344fc66b7433601da36732e66ba01c760f331de5172f7b8863a7635a2d100dfb7957dfdc0ff5619fb5d9c83b3cd85be25ecbe8b930f6887d0dad5f47cfa7513bf94bb7d9442835fb98ee085508f4d418d4d7ded599192eb5e62703368f38ea2308a4401dce11eb03968fb5fd83e82fee80026631768ac5ba593d20e6fa230bb5893b16f4723dee6d4d88ed13cdf27eef96147bb6cce50bf2b886657537b0e97fef86a71c3bbcd8f3d83db5d448185674a620bb3006c2f2e3c47e40d9af7a22fe27d7b72654dbdd26ddc3adccf57574b5039a03d5c1b05118770fffc9f62f38e76341b8a82e419dcf508f713d431641b3485317510a667c135c96d9338fd7a947bf82bd7bf6aff2b3c932d5e7c17e7ebd8d086700e63b837717b05f70cc68f6ff16bc6e218d7c88af3d27195931d8dae60f3c92a3153ec19ea7e9ba3397781a0c2dfbcf4b201d2afa93997ee96b6bb885fea01c03586b67632feab2da8b1b179a417bd678a07a2596c6e0a9df18c488a357e7d768467a86fd2cbbe397f0b4dba3bf3f5ddda9e5c2b1add085d49f9b9d6f8094eaea30d0f40971006a8e90eed5cd5d610e7c4868e02b3efe25cbd65858b37b0191fe09c016d955e429ee4ff8d7bf56ddcdf5f33b8d7d233ac3c5f90cd16e74e15bb90f39beefbada101cf8e0e85dd32fcc74f9b5fc716373cd6cf808cdef404e7f702f9a4e90c7ece99daf00474e9ec08b39bc1e517f695c0d09ec8e692f8a8d146a47a85d1b7b8f54114c6a1a57d56e914f6bc192b287720ec2e93e8ea83c9e327ba443e49f527a5c34686c69ead393cd32bf666f3702d6d74b378e561684a88dbe8ac2f9205622ca0317f70fdd099db2ec351b9a2bf51cf6af7216d71dc53c1a9738535a2cf743d8471e2e8e30afe5927a20062b4d73510c1bd8bec77133646fcd0cf08a85336affa8bcf73e2e89f35fb9edb6825d9854b3ab67da0dfe7e3bbcd8508272ffd710cb427dcab1c48b1080752dce35913e4af1ce11e36a25902908eedbe239b7f5458c32fec03e308a3ebd2ffbcca9341acc03302e97285c7f9fa6e4f4fee62fb792bff8eb0cdccd2a41008eb104cfefdd886b4d1122a0e86e78489927ba8701b62d943b676bfd005447f2cad379f4f7952d8d2b3a5d90566b1113e6613671e5e40693f737d72d7d0a7d3e57fabcf66421bc6ed32dc91610d7e3db48454e6363bd2e65f9418b0091f4b2c3a57fcc74f531b3e1c7d759944a3872338b1c5ab0fa8eb407b424c74991ce119b3f6ec48f56afb54039ea58597fdf654dac8e90b2cb3fd847b1a6bd3cd1fb4b4590cb8f056c195353c94ceeb786b0a233e9b93bb13a931889e10fb66fa3228b1c14b4c5ce895efe810370c2e93ecd2ac38c76518c13d1c59c2885ffa63ced4d3cbb7f8f982add9d98abcd08e42c6d08601e417f8e9b99a631b007534c5af33203854df27c7ec082b43bf501b7160433fbbe059857caac998b1a3d9cde6c0dd8e189adf5097fba8403f0edaf6ec2cf8427a54e000bdc22fb9d7b2b382c4f186269d2d2164f669e15aba5721de12c2c0e4d28226671e3eaa3185c673ffa8429b785b7c9e2dd467cbf8f96f62c85c26e195d9ebe3f0af1b2906ba123a13c9a9b68536ded242460c99ec5229cb034dfa65eaababa18f1fe80108b84edb939bd37dbdbee8827a61d3f2623f20ac9bfcb5eabcbf2f7df66af1a72b884681a3cb57531bde802e5665c9dbfafaf6f4bed0d8fbde67238b53583b66b3f11f3f357e3db485dd483c4aa1cda950c62eb4134793aea6be6aa18de1555d61f6da335cfae39bc50dc3ececcbe585bef6776cbf6e80ae30162fe57396d1cf7421bdb09c007de51ac743aa37738401ce16af26e25c622d6147e8405d362586677f835ed4e5e225c82755575ed1ace80f8d66762e8d5827b577efe274f6d84f0a8cecae329e54b83ff1780fbe775eeb50646aea05cc21efe91ad9c2ec00202e4f4eae3c19241013a0d847a2a2d8c72230e23a4e68c6c4990de1ed68f6c86224858dcaf667696b299837f3e108fb048ed2ddda8c5d1085a125ac13a0af314c578d617d260ba83f1a7b77a21d312f36f701fd340e68cf3bf4c1ec78ec41ff3c2de4543c27c57e77475fb90ee785168aa5a943113d66f5e15abccaa0b987fe8e302ee753f874e1d998c747db31fa33049c0ae723df278314dfa6eb50f5f3f6c4d9a9dc2d61142ffdf29cc07d392084892d3c3d78063bc2ac124b29ecf3d5d0ddabc385074770dfa17f2d4f88360f435b1fa03e57ced6241fc3e1bcb323ecae59ece2bd88abd7f8c5628554df1984f6717d36b819f4dd2ea6e6dcd01c12fe1d38db911a59bc9bfb78990f5d8db38c8e76a426769cce2b3ce71d210c0ced01fd893380bea8207936a7a63e70bb9d3e000d788ef6acf33857023b1a5d2c0115cf54f0e6a5b037681fa0f8431eaba8e100cfd1d777ea7eea18cbdbec76103355e2f4f5f3bdd8d3743c5cda3bce0b0deeea5bbcebeb9bda79e00121bc17671e11eb2b7034ecdb456f68980ccf4d048e2e2570ffa75837c525a6360cec288d1567eb7e009a1217f1eccc3e66d83fd797622d0a99a2210bfd1bdb979c6570ba3a9cea9b5cc82cb4f06645e88c7a98dae04ac30bd651bd1a911a67e76371fe435b007411ee81b3e5b3f9999bcd9957c6157495b1924b8718268a317af67c1c1aba94c531abb1281a6eff8c29799ebba3af537f769e9ebd880e19672acf294ef5ec483975c139a97e8333d09e073296ab32699c87bd8308c033fa5d9c30b13d779be305243e45c572981ee76b8bc5ccc677cb1f5f0d4df9eb2e6e95abb676f9ef58aceaa5ec540cdb108ffe67e1d82c1e9de6d136791c651c5bfc18d9258487840fd72863e02e7dbd4fb5390011e487656de17937b455a1fbd9e5ccca1e81e7399c2f741ec3fd9ffa8901d5af7b2f63f1d4189fbbd1860cd025cfe0d76727dafdcb7ded9618d5c1d4958725ccb2bc5819b38567e4cf388bd7966751616780a03286165ef2b3a77a061bd1f36e709702e3761ebf39a790381a0b7dfb61e67b17fd967e7aa97ea1850cd098624cf18b3ddbdcbf2bae311e0321df17e53e29e668d3788e5ee1df1d6147c9470eef450cec0f8d53da0a53080f40506f799cbdba76749f95f5aca3eaa50f54425ff91a82c93882365a9c29ea465db92b56d98a5f106bc0fde231ce7c9c2cfd8f3be042c69cabfe2252eed66694385ac818bc922c38d436c549c80e86a86872afa19cfa0dc5ed26281670b378e99a16a2ec6eb02fb4f986be72d1c5fe283c407b606cc609c4204e6a3f1f1617a20bd4d0a74232cc15d6d4d7590124b8dbfe98079ae8427b07ed536a0f872566adc7a85676043134b24305b64e63ae3936c8e643984500ea76ca4791f715e7ce19ccd727c44b6d7e46f745e45c16fc183882b4501ee519578f8bad4310cd586bbecae3e3795c9b8abdc58977873e89a98d6ee8a1a8c9215fff9323b079ac19e2996b9ed75b963e3a35a749c4d3e78a671d6578b6c4a66610b6efa7fff8f93338bd1bc87648e1e2f3f4f76570f640faf022d517a8c4d0e86726b2fdf5f94831399a6f8417cabc165a67882fe0d9119bda8c019b6a2ebe8c3558c22830f9196bf1eb70f1793e5893c74f20ac2e93c87d2e3ecf27d97ffc34a732fc3703ffad6ce0bf8dcb24f2e2c527f4331dd68e7697c9f1f332398e6390edad5abc2e8d4d63b8a996d7a3e4f35ec60e748b977e89c22cb678e46379157b7d87d8d938aa4771eec079cdb17e1af7e2a12f887c8f02571a93b16f68ca2fc04b77471b1ed2bd238536af5e0a3f6f33be58701f0aa388f01d847126df109d8f068a4fa498ac3adf796c278bbbbc8a0b7caf61adedc955f4f14014662cc401955c5e1e9749e3125ae63ba6f34ec402ea6bd330cfd1e8b2f83cb356de073d0a46c6d3b1bb1464ac81d0e7b5676a4fde443644616d7e0d7dfe2cce52c47d7ed2ee40a8ba92af63b2d715540c9de7cc1c611698d0c78fc71eda9fa8985c8d0c5dbd3895bb108846850789972bed3efdfcae97e8a779a28556e489122b8b17a70f8b8ccf363738a27c172f05405ba131e0baa078f2514245f1a6be42bf016d9838500fd1fd09e58c1e028e94a1886214833c06c1dae97e4eefd8147ca1d8c4b51293aeda32221e9fe2b867b8d095211a67f3705711cab52b86a66cd3b96305d46ff2e9ef38f568713b57dd1dfcc564bcb138e9ee081f17712289407b7240873ef197bb8d54f42094f8851e9cbac0bedb680431ef16ee5dd17fb83637bbd9319ba0c713fc872b1e525e206e4685f25fead5e2ce67d8560c951070a3509ca952e3ef33656869d2dd9a8817f4c005bfbe13b4087c4acb29b4dc33f94a0bb9c4d20ff12ae71b94cfb3fcca992648435138b35961d621bd1bf2558983a6671e3a53535b35d9576cc5228fa371b3619ef7cab19f38571e4053ce200a431be9c12c7fbcacb8db858a71b5e7013d348b625a1f48f72af71a12c423dcf3f32c4ea8af8e765c9e73b9ef9dfa131063a9a92f91f2830a7d17daec98e506ff5ed832ce0b81be42d82d9d3b885fb271e7ebbb08fd072ebcec37d0a694671294c58a6bf7c1fcfd668ceef255f94ccfb892cf3476e5b9290f852f345c4cc63cf4b19ab0021e93cecf444b78d4e27748fe39d4a7f0604c52dc9f8ea526957c641e4343ed118edca47b12e526f9756868ab9b38cb1ed783eb2a8c58db1f177c189331eb08e1c5d4533fcee28697fd0ae9c60d68ea01cd5d6aa3b33b7d45ec8537b535630a9f08f7d9821a3b08fb0c1347503d9062c5d45e6843c6443626e75bcae62d7d940fcdd5e401edffafecae145bcd4f38100b0a21636a6a220a2c6b70ae6b692378a627ce5cf2901d84f378743c3b4aed5a5aa83c4a32db84f4d09caf197b2ebf2fe251fd4e2d5fda5d8485897f8f594b78dce5ed1797fb061db1546ddf15e71a250f6c73a38b999dab65fc358fb57e06602b854bc128ef02a6fb77661d1588735c5318c5a67ebe5bc7b5674dc6b183cef734b6ec4461fe208d5fddefb43b75195f8cc57f56e2b3b3c79e382fd51b103e887d91eddfcc3f525d8455d0639aa98fb0addc59160564838bf84191579f2bf7ac3d5a378b87b6a31c63cfa7f3f9bbf689e9466a77529ed4812d40dcaf1e44414d7d8049a90b2a6cf355bd8b5d9cf5673b1eb356340cd30f01cce2fd069ea5500f65179e8306dcd71c7e0eee88f54e757c7d17a79fd9fd32e8139479838a1d3e39f3f5c34e4ef705e79c1dc1630d7f18581c737784d9d99a3b672b5a07362f13ff3675257134355924322717f1fdf00ae2c61c586271cf33c8d6938815a3f54af3e295f87586bdd23c331ebf84bf2df4f1c5d094d014d23b4c8b2c076be852686c4fef154c98168b4fd88725cc02a079774ba8ec99fc1edd44f16461161ac92731b6a58dde5f8e97e6bafed8183e7b45b9442a9f0fb73267709ca4690e321c1458dcf36e072757e4be1e86a60639f6ccee38bcf719afbd6dd7393ce363fcac8d71ec274735666d47a30b96cbcc6c8bc2da4705f5b785d1193d561be43a98c622311b04c7bb18fa3841b96d2813b4175c167369f703a9f7a1aabe2cedfe5d9baf8b6c587e2f14e189d1c311c26b6ac7d0197eb7e7ab2bf4c7521b98c58d8f10d3ce0ed0374b630da3873361ef4e8655507e7883ad5b257e89f24828afc91e80a6dced6896dfd72af36f641e09e5a6b37b1a9598071eb3451f04406704d06649162786e7d911a4fe01a2976332a07b283663ea6b88a3a0df31b47868074737895fc1f9f3409aab82f6ed82e25dc7756c716bd6c8624bc53da2ec3c0082ca657180345e330fef4efaf0357acc5b9ce7bec200d96cf4d85e46238f23d9f371595fb0193fd2fbfab30b7ef6400c6468cf18ae07c481b09f09318e200dd3b5216a7368f738b2bdf7f869f16306f9e5fa67e52eca836253313f36f55b6f60c39e80161ecdf98a5873aa2ed4e30caa5de0cbf41116c0ab31d056aec4b1772bfa38e677b7f2fbde999e56b12ec2f1a9ec3388a7c2fd7c1c1a3cba830775c147e7627a6f17fe866a25a04f51bdab998d731105e059688fd819ee47be2f93fba7368fea3eaef57b9f858f7c4ef1a20af98067f0cd8ab3c7c7512c474df1efc44177d6a538cb4308997f59cbc3ee2ebdeea17eb1a1153def8bc958b1b8a167701f17e4cb1d21fe9a25c89f14421fe8c5dd6ad8ee8e7cc2837277a04fa98bd00fe5e16fb57bacd8dedc6ab35f29d6486d2ca8d7a250e2f65737f5eb2a3e44b1779d6c0fa4be1ad020fe09e11aa37de970b31bdcdf96c0de539f10e28f2246c4981a1b2efcb1641d9547fa98f6d8cfb0ff74c7ca281f0db4d4a749d77d9c3891e3a6fe28dc37b3449c3ba1253c4abfab39d67cb5f8f301e82059704a62f312f4750efbcde86cf30a0b74f92e6f57d53b93704f71207efc443165ed03aea9671d8167c78f9f86ae1e4cf45b18d9e8d1c6c74fa0b13ed0d09d41df4cf319cdb990c6fc3c7b06c2fa42bb27066db91dcdcee86e3df441913d191ec439380341bddb555dcfe285799c397fa0c646b659e2403acf50dfcf288f8f68a3fba4019c573bbdd796e605e30e3e0cc7def7d13a748ef21dfa2c76be765c98d8bc1adaf1c753f9ace2c3625e8bbaaefd24f3e9a6c59d48784e566c148aef65766888eee81a5a8872a5a99d2bfc9cb4062e8df37dc27536b52ff7a7c09e2dbf1c4309560f7bee9eb37cdf2dadad9a9dad28c71c9f045f599fefe523dc2df41363d09b7edaa7137d79fb192bf3f1e067767e233d3f42dfb1bc8346bdeb12ac913f953f8038398ce15a9d8dcde7c9d2c25bc55f7cca81db7b8ee4ad3890e7e3411a4365523d3e2af102daa5bc8eaf7ef7db33a0ad9bab4c163b2d69e9199dca7d82e237dab956c670af65dfdafd9393a9ad0f401b64e7dcf36c4f4f6e9a7f741f8bc967f1dfcf9c172164c086cdea92508c17ddf1c27ce992e71cbf45c3bb336113db87e7cd33cf55a24768f39a149b5f439b1e2c6bfa9bdf91a1dfa320ee9850ea22c549e59e6f722a7df06d86a585f0511b67ee9c4c7d1d2ef4ecdc28f26c8f7cbfa5b174f6f16ef3e215c569b6e7fc5e6216df1e25665ef322cc7c9b1b5d1c7d1d2eb1bd99eb77350e99ff86c7c86b71f40953b4abd5cc091eb499a115d46443f7417e4e3f2f62f9dfb959562736f4cf96b8093a476fd93d477b89aded32ca731bffd16b5cbb7bb3d0508eb9f39d90ac0e2af58f893b1359beba5e2f551bafbc1f9bceb511d9571085038829a9356ffa9a3134e5846296d3939bb5cd65f141cc5e2c4ef1ace29ee12c303927cb8bb19e335f7b06e721ecb3f4c769db62ef4ac7859edd75ebd63f3178e96e098feaf8785e8d46a7f06b9db9e4412c64e812f425aa747c2bc5a8adfdc5e4f321d7f84fd73bcfed56f378b5d85e04a2da9cf392b7df30f00c6daf359c2891c2010f4472955754dfb8d88a0ff985bc22a71e4020454a52e15983fbf0709593cfc74bbeeb31c92aef4c07de03235ab160fb49e17dd781f77a0ca2cabb12bce65d49be06f2f4c05178e75ff32e33c676c72a814be1dd7dcd7b221de4c4669614de97dbd7bccbda572c4fa5a0ca3bf48f16dbaf0ef3062210a891b2adea29c4fe4cdc45df80b6e2c1d4aeca0d7dce6efa12a9be1c8931c9f7aa0bdfc172fa359049bed94efb646bb04ab023f8eea22b60bb4bc0560949beed0e7ccbac9c7c2675bb80f81e74d0b3a11148815cdfdf88ef2e7aa224abd8d84a5e956f8b87b6de093beb4a348bea7b6c14a7f700bbea8bea1b496d9fdca14d06dcaea3cec0bde21de83274d11b8333b4155bd3f752862eba1382a93b9413832a4327fd11569c11880fba0c9d74e8a16c8d58a6cbd04d8f92552c075f54193ae9122726ca7456db037b4d45f9e9eeba04eabaa48721fa9840dc5597406044b5751802f4612ebba32e415d76877419bae912d87eb2604b95a1932e199c3c5404912a43275d9a1a4374ee5265e8a24b22af24872708a83274d22525513d794b5f878e768991832f1c3784401b5ce50efd41308e406430d4fe1de6d04840a4442b96dabf831e2db559a84422757c65da41fec03bc8c9e149eddf65fea69e6f04f680dabf83fc72e2c1f51b52fb77905f8e8c81b2553d5aff6507f9e5c0e69464451dbf13fe094456992ab533c11154cf9a74c2cca1822c51cd1632409b5dba61663106811a1a351bb00e0dedd14d77a65077eab267bc77c0cc3203a2d5d3487614de3b60e6441e1881e41909c97b37bdf91a2ac981a5f0de0533c746e2780a275278ef8299c581a2ed389932efdd744666c07657c39e46f46441373f2b34226300ea678f6fcdd5b09bce4821d82a511def4b1747eb868196db710084babdcb78efa2339ccc8909e69767bc77d19955bcdc86611d7ba4bc77d499811cd5b173c67b379d094060049f14de3bf95981b215077240f2de4967a22f464e8c9abe9be88371cebd1be6517d10aca3da59ab8537208447ab1be609646eed81a8b6dfcf56b43e03eea393ee185bc50711f0e83274c03c89132d35a5ee2f963274c03c32a7044e08b42faa0c9d7c75ed8b53b8d5802e4307cc93ac03653bf31bd6a10be661e464f55cd66315850c9d7489931325007ecd879cab31ba0fdb0d3f7bd06fafe3b6316b1fc7978ebe58b8d4560310d5ecc0c3d1a5872574f4c5a6c037b8ba2e953274c1cf22bfdcae23654a95a18b2e0d41e078982e153274d1a5e5f49385fe205d862eba047c650a82fa3954c8d045975865ab04cb06193ae1e7e92a9113a7be0e427833e36e314310192c086a319c87a90d7f753bcb4008a64e28d7f5f06c4597aefebcaf60e741c67b979861a4042e6f043285f72e3143f5a0046b4fa1f0decdf7da2520f0020aef1dce32c55f4ee57839fda4f0dee52c3bf0726070604af2decde7fa8c95e9aec09d669a93b397298eaad7b8441eca6bb4d3c46a560abed2befaf45cc6cd93f309e58bfc3ef1f31a9d3407b8ed4807c538a974b218edd7439e76a565f0209a452566a6d1137bd0c363ee347a720f7abb186cbda861de327aab1ef4141f4cd5b09ddeae073d3c4e4fa36774a797d8cfe5f473a8b4aeafdd83deda53b63b6ed9ca9fdb831e1edba7d13bf4a037f3e4e0336ed0bf34f6befd7c74dbb369ae49d14a4c5bb703451ee03dfd5dec9b0f48650a1fef658cbc8f2e83d008245fa6af6d1aaf9ff691551ec85bf7d9222bdb47562c874091b5871e733b56e67649194ba4c89a7497d54876893c951f2db20ebacb4ae41d28b2f6d1619b91a3afd8a0cb5ae6037aeab1a1197479eb398a1eba5ccb55d4642ee3f5bdf4d907537900e8b6a0cc21f4d1e9007860baa3efdf7a5ea3abdc787ea341ee3ebabd3e28911837d8fc52ee5efafdc92a8148dfcbf55c48771dafe7441ae4eea3e78e0ffdc4863d5de62a7aea39881af4bc9e3fe9a1e7b53c4a4dee3297d04bcf3d107c0e962fe4eea9e7a13c55c31772f7d2732cf7d220771f3d5f714b0de2af1772f7d1f3c0e697db59f442ee5e7a8ee56b1ae4ee67cf95a9f86cc6746a68f9c817edac93b20031fb8aca6335b7d3dd5ed4733c757be1c440536fbd743c093db085be7fabcc4c8f75e196c22a596ee9f6a79a0fea2a339e17a2cbdc076bcb0363fbc535eceb5c66be87cc31085c56d1da655ef6b013782e892e731fdd166365bb4a1aceec2c5fd1c77fdca1cc7703f629f34e9de9e1f9271abd3e7aed3ec1161c1ad638a3d7c37f4cd61198ce02a5955e0fff91c859d1e8f5d2e9e7726b0fdbf9ebe33f4a81c27df106dd6729f35bddf50fcb73d1e8f5d2e74411766c834f95e552fae8b3ea83c06b8aaf9439b1cef4f0dc188d5e1f7d3e3c0cae1a63a6d1eba3cf4662041023b7d1eba3cf783e8d46afa73e4fa5a0018364f47ae9b3af448adf70b697b9b73efba39683a3d1eba3cf92a74c65ae61ff96b9b13e185b9322450074cc55cfd775c53378deae76ce95b9ab3e18fb2b319acf91329fd6e3ec54386368700db1a07a8eaf33a6c1727d0d72f7d16fd55302e7d0601f4ab97b606c45905959935ead771f8c8de7071be4eea3e74a202706d770ae9479bb5ebee42c52b4063dafe7123bfb54584eb11e272af26a7df47cc51991d2a4e765aeaf879e1b813d542af7271ae4eea3e7781eb241ee3e7aee3e146d7668b06ba5dc7df43c121925921be29fb59c6557b9f1dc6583dc7df47cc5c889cc37ad779a1feb834fb2fbed74bc58e6397bc4a4ebf94e1abd7e314123004de77546af4fbec6e09653c7975be5ed93afc173a4347abd6224accc198306fc9ed1eb814f38712027e2a0019f94f9d4ee7811cbabd2e8f58a8d3ce460c596fe545a9796e3f77aceb45ebfd7b32ea9a807ccee54790677c1deef6bdcc70fb9cc43d7e864efaa77a483ee1250e9546a983ad3c26a9968f4e21ef442201871e59e038d5ed2879e1ced0620a0cf5ba5eea92b3dbcfe89468fed41affed60b9d1ed79d9ecccb5bf7b1dcb6ae2fdf831e5e3345a337e8412f06539957a6adf4863de83d0d782e34e85f515fd571cf927556353b90e66d513cb356b7da563b7550b8154fd6c116f9bd3eba1c014d8c8d406c9175d54756ac368b94358d63769435d871cab6f44b28b2f6d0632554b64a2873bb1659ed1eb2e2f55ca4ac69fcb29bac4bb80e4959cb4791b58f0ef3ca54f2e4f26e7b4dd65aed571f3daed580d5e42d73b7fd74393422794095b9cce5f5d267235a254b817e36d6eac53acb8dd78dd1e5eea5d7da6ab0dccadc0bb9fbe8f6014c812f379c49b51ab3eefa8dd59ad1e5eea7e332235772b70d72f7d27323901959a3efe95a5d5a2f3d074d7a5ee66efbe9796470659ca1beb78b5c5e3f3dd78cc7727a7821773f3dafd7b4d1e5eea7e74ab0dcaefd1772f7d273250821fe7a21771f3dc7ebe0e872f7d2f3adcda27c59bbdc3dedf92a969b31625133d7fd6cadd7ced5f7629e2f13fbd80b0e04e0d06027f31c610f1d978786b6e38c806ecbab75765d65c6ebede832efbacb9cac064624f24bbaadc865ee83b593a5f0f55402fabeaed6e6759519afd1a3cbecf69059e495c0661afcda5ce63eba1dcbc18e9139fa3a57eaf9bae3bc7a5d1f8d5e1fffd1972383adc4f868f4fae8f540d6761c68b0dd951ac0cefe14560b48a3d7c37f843a28c68646f77f327abd745ade1ef8e5b695bf3efe235e3f48a3d7cb7f5c4ed550095ad7b79f3e27eb50d9d2f9abd41a76d667ace69046af973e036d978006ff3ba3d7cb4ecb8272000d76ba529fd8951e5ea748a3d7479f1939380cc196bebe19bd9efaecc646831f59a969ecaecff5da461abd5efa6c24bb78396dd5bf5efa6c6c5749e5be738d5ead0eb23356c0eb216b674899bbed81b18d4439181a08e9e75c91cbeb83b13d99931acf915aed64e7b313afa1a4cbdd07632b81c103ade97c2fe4eea3dfacb13578d0104fabd55b76961bafbba4cbdd07632b539991b707ca7b7035b9fbe879622432a7440db1b06a8d66775f12abd5acc95de66efbf892dbf0b014c081ee5b14b9bc3e7a7e50222968d2f35a5d670f9faa5edf4997bb8f9e2f3583379af4bc94bb8f9e0fe4a9ea8169433cb05a0bda59cff19a50badcbdf47cbb4b8c247c25771f3d6794a99c285cc37a97f5a37d723f6c537e25a3d7079fa4b5440df829a3d7332628724de775a5e6b47b7eaa5e7b4aa3d7275f1382a9c1180df9958c5ebf18c9568996422b7f3df00951af4aa3d7079f0c9544e494888eef327abd6223f2546494eda1fe3604bf662c8ea1f6adbf8f2addd137446bef1a28a155fb7e4b6dbfd7de143538f5e6086aed3d114b50a38637266b7d51bbda5b20cf101c5757231a318e30ba526d835e7baff70234c0d065486939c2885e134ca3335fdfedfafb74e85dd80efde3f4dd54b2ef42cb78dbbc9e0f3b42ef4bbbb9fcf577f6d0dfbaca1367df44632cbe56670dfffd5a27c879086d41f51ad63406fa3834f4d53bface006c575bd3e1bd737fa1d2963a3e3ba0cb9ef741ef273f80366316ba5b7f2b301de3baa7af0329c3841d62ef1c6674d3315ff381e695ca43f6ad76fa3a64e32cfd71f6ce73752ed3ef0a52fb65dff6a87de33addcbcd77a4d0f72d77efa290b6a9bd4d93bfaf4db70189a99fc3a53f2ede3e2ffb56bfd9f4f4ecb91296dff4ccbee1597ec3e43dfd8e7eda8ea0a12ba12dcc62a3f2762c95c6bc6c47d2a8d7f453e9403b4a7cdf129747bac0b96bec5fbf4b52f92e19ba4742fba60ba546bff87b599f3fc1be5f548b613b91a18180e4357bbbb6f29603c92f712fa46853b913d2c2375e6f8ff3be6ae5dde0a483a2ed1e4dbc57df7fa3f08edff1c079e75a79276ae771deed56de15e18b55b6c6a089f7eadb6f143dc1ef6be0bc0fdb79c7ebe08b36450d7ccbbc655875478c9de6ffc5767dc3ee5e947bacb877d1a6e7584d7b9d6fa575ce94c848dce772fad5c0f7eec53ea9dfa3c0f86ed715a23e1de33b69e53b50b6f243a6e869cab7dbca377e2702e3fb859ee0b5e6459b5a9d79bbaea07a5682f7329ffe525f6af71bca36b5bb0d6d3a83d58d9332bcd21b259a1d942da9efa50c2f7407bbab4091e185fee035e014195ee8903cdd71d5376b49195ee951fdde01458617ba84d773176d6ab5dc2f7429a2e95299b37ea94bb53b04e55ea8dd1f68d325ac369b94e1b52eed1e8a46ea5229c32b5daadf07a0c8f04297f03a6b8a0cedbae42b893d9435a3458617ba84e5f62932bcb44bf59ae9ead95bd44bb7cc81f1406fb734f78fdbfbe3797aa27fbb1e11f5cf447fa6bdbf3100537928378fcfb6f7c773ee44ff17d807af6526faf3edfda56839ddb1947325ef3f68ef8fe7cf89fe2ff407af4b2ebfd55ad424b7edc12db2441ec54f48dfae6dc7cc782ebcc4f9651ebc8577bcc618e7bd1d332b0238c89a4cc131d9dbb5ad3698c86be3bcbfd21bac5e18e7bd1d33cb91cc1b5b8762fbb2b76b5f60662c478df3fe4a67b0dadfa24d59f7db6ab7a5682900f2ecc9dfae7da13358beb96c53e69a5b78c7eb7871de5fe84ca0f832a71e9a787fa13378ee18e7fdb5ced46a7271de5fe98cc8199c48f111b3b76b5fe80c9607c6797fa133787d6dd1a6565bdbe65f1b91c154df4acedb9439c476cc83e774cb36b57c6ecb5987d7ca9232b4631e99074118d0fcc5528676cc83e7672932bcd021bcee9522432be6911923d93d8dd67568c73c78ae9522c30b5dc26b588b36b5fad556ff5733589a2e9579ba17f819cb9b96f6b796336dc59ef57a54528617f8d957920353b9274391a15d97f01c28458617ba84d796526468d725ce08569c9150e210850c2f7409cb67526478a14b789d6829435123daea47a1770f29319ceceddaf6b30ccf4d96f29779c9567fbe5ef389f3de7e9681c018289c9834f1fe226688e71971de5ff95e58fd26ce7bfb5966046e22072e6943f2b76bdbed309e33c4797fe57361b598656d6781a3eab595781d3441b3a116b352075d8f9be3b5da2fe2e7249dec1b72afe9d46a3129fc64b171ca7b01549fbf5e8bd94c8ff2265787987b333d4a7d3b8d1e568bd9488ff64e002dd684d56236d3eb367f789cbe995ea7f9236a319be9759a3fa216b3911eed2dae0eb1fd667a9de68fa8c5a4ec0b140ba6bd31d7906baad6629276a0c8031075deaff301957715ea39896eba8cd76236c94a7b5b8e262b568bd9242bed4d80d739842659bbe931518bd9242bed4d395a4c0babc56c9295f61ec0ebbc4393ac1d7518afc5a4ec89220edd5d8f6bb598a4bcb51c45375daee52a2832d7bed7d7459fb15acc36b93bea345e8bd9267747bdc6f21b6d7277d46dbc16b34deeaefa8dd562b6c9dd55c7eb399136b9bbea39568b49d9d3458cbc879e83563dafe54fbae9792d8f4291bbf62dc14e7a5eafc56c93bbb39ed76b31dbe4eeaae7f5dc4b9bdc5df51cabc56c93bba39ee3b5986d7277d4732c5fd32677777b5eabc5a4629cb4ae8ef65e11ed6cadd7625278ace676bad80b2cc743ce63f53b871d64c66b315b64a6bd59448b8bd56b315b64a6bdc7d5212fd42c7347ac8dd762b6c84c7bb78816cfadd762b6c84c7b8bab432ea959e68eba8dd76252f6749627eae6ffe0b598cdf4bad91c3cffd44caf9b5ee3b5988df4baf98f442d6633bd6eeb81e7ac9ae975d469ac16b3995eb7f9c36b311be975f51fb13c5733bdaefa5cafc5a4c667500eaba33e63b598cdf43aea33961b6ba6d7d14e63b5988df43aea335e8bd94cafdb7ae0f9b4667a9df5b9568bd94cafab3ed76b311be975d5672c07d74cafa33e63b598147a454ea623c6c66b31c933a496afeb70cee1793bca3957fb7665a7385abd16b34dee6e189ba8c56c93bb1bc6c6737d6d7277d46fbc16b34dee6e189ba8c56c93bb1bc6c6f3836d7277d473bc1693720e14f9a2aebe24568b4989115573895d703b9653a4c85dfbae66279faa5e8bd92677475f12afc56c93bb9b9ee379c836b93bea395e8bd92677473dc76b31dbe4eea8e758eeb24dee8e7a8ed762d2e44ef3921df335582d6633bd6ef804cf7736d3eb1c13acd56236d2eb98afc16b319be975c477588eb4995ed71849bd16b3995eb7f9c36b311be975c427785eb5995ec7d808568b59a9ed2cf03bf68dd17a1d3441b3a12ea952075ddea94abf318ad56a53fc703837cd74f26f8cbea4033ddb163ad56f8cbea685d53235d3a3bdc9458bf7ad23393268ef8462f4e46ef4b652b8148cb679ab7e63f4253dbcfea9991eed3d2e0abdedd8039adc819ed1899e927c0de4e981f6861b592bd5851e5633d54c8ff616172d96b44a9464d581dea11b3ded2b96a7d4f74789faaa977b96acb3a2d881f21ba3589d37b5760a4c9d43f9967bf9ae423dbfd7519723d59723317e252bed6db90eb5594db2d2de04a0c5fe5905e55a5fc9da4d8fc1769780ad427b1787a8e3ea202b5ecfd5242bed3d005a2d812f4772a596af49d66e3aac24abd8d84ab4b732a8b55fddf4b8560346ca8b7d63b4932e6b52407b3390cce575d567e920271eed4d186abd5817b9b1bab136b93beab57a584e0f8942790b8794bba36e0b2bce08c4369b8f7d63f4b57e63b5666d7277d5f140998ad4777449b93bea392726ca74d6b6a7b16f8c76d2f3a855cfb16f8c76d2f348a1bef944e6f2baeaf92c92a7eeb08bdcddf5bc56d3d62677573d0f8ce4c0189de4eea8e7536388f05717b9bbe9395e07d72677473d3ff08af6f56cdbdfd83746bbd873460ebe5e60baf21ba31dce56ac768ee4b1fe8dd10ee7616470f437a4f01c61371d5f6ab35089c4363fa7f68dd1d7eb82d7db35cb4c7b8f8b16b3580a92af24b4f7e17099bbe9b79c789ebc35daf675ed1ba3af65c66bf49a65a6bdc5458bbb2b89c12bd4375b71993be2ed406495a9d2766657bf31da01e7d5ebfa9ae975f31f8d408a14fa7b6b18bd8e7a3d857abd6a5be3ea37463bf853f55ac0667addfc4739f17c399ab5f93fd56f8c76a0f73554924307febaf98f78fd6033bd8efe63e0b2606bd0dec2c6e875d5679901db5d9b4f55fdc66887f845bde6b0995e477d8ed450e65afdefea37465febf3761c0081fa7e3a599fd8459fb13ac5667addf459998ac3a5b66b5bdfea3746bbe8f3408e5afdc8ea37463be873bdb6b1995ed77888ccca81db41ff3aea73f4c5c809f5db33d43ac80e5801af87a49c21f56f8cbec6337202845944fb660399cbeba6d7c656f15f9c23d837465f9e9d780d659bdcdd307662700a57fdf6759bdc1de37dda17a770adf134ec1ba3af71125677d92677478cbd4a94e9d790f66d1252ee8e7acec9891280b67305fbc668877858bd5693e25bd4bf31fa5acf774330f522d02237f68dd10e3e15f00dae55cfb16f8c76f1a96af59d6d7277d4f33052b859ab9e63df18ed80c33e59435bb5d935ec1ba32ff51caf096d93bba39eef58999387dde4eee84b4e57899c38adeb5df9c668a7dc4fb58eb4995ec77c0daa256ac54fd56f8c768909fa4afb795dfdc66887fc54bdf6b4995e377c02b415a708d46fe460f4bac648760908bc36fc5efdc66807ffa75eafda4caf1b3e596eed5809a8ef7663f4bac6463e6365ba2bfca9f41dcc750826eccde286c47b9176343b98ba9a2cfd32f759e99394efb316717c0f08216b2727579e0c9e72a0c64063f23d9ebe8329a887b6711681c8da917aa58c7701b86cf5f19afa458ef6bcb48c395c6e0f437bee127c2ef4d5d58a463740c8b9bedb901f0d30351ea6f5311c61f428deb4c5da211b754cdf1405daea6afbf43568a351b3add9fbb75dc7331ac67b4587da367b37f885be5c16daf06e45c4bb9e8cc57f66ef1fb3b1a1adcf8e2ebf8bd34f4c8ff33781e9f38ecbe750f5b361ddaaba4ab6cfde05aef17693b78d7a1a2f74e5da791d9bc62ee5ed3bbe4f1bbf755d5ff3d03e67f8bed11e57bb7c47fbd53a5764a9b5cfdfe37ed187909f78af16cd3d45474b5b43f299bf1dddd13611f75b5ad63b567429b4b6bdd6a7e843ee63dcdebfd09bfeebdc38b685bfddd0bcbfda656e5d1b727d17daf3d87d8dfbedeb26596d9f76febca6d3638d9bf73345de6eb4baefa7e6356e949bb6ceffb4719d8673bb619dcb33bcd3fa1c86cb6df8d8d3cfe1e67129730dc75deae34193cc143bd28c3734f10a8eea85d857ad7bba94a5e3de6a19ff411fbf61bd5fc94eae77f3bc3bc2a337d62268ccc5361ab47d4a9903296ec0296d6b4ac33bbed580ab7be226c6faa35886c0b3eb3f8e4d68f3f4ff056790f42eadf4a8b6fdabf16c72844777fcd1325f7fe45c27cee82371e7bc55975ace3ebfc536f43abbd17e13ecce73d44807eeb9063a74d95af4e90f9f73d4bd479e5bed73d58837ac3f7a16d1f69f0658ab4137fb9d155213ad3f78f6489757f45edaf3322e9158dcf30c88734461f6da335cfae3f4ef64bf83a12b27a0ad701e12671e5ec0f6e40261969802ed6dce355cdbb065cc0394a3529758e6f585116b444f32fec1af8796b07b1785f5ddd1a40be50d367adca4e887ce11623ce2cd33dd3983f9fa54b5ffc5dfb684ede04d6dcd98d3932b1fc7e43bf15b62ee8af6ca9cd6de6d6cbfa4b427f600171e1cc17d17a7339a1e308b2df1c61ad2f5b21fe59d7f9f21de752bbee5d3d2cfa0f42bed47cb1c6b0f724df26f21b58ce74c9886f3a1182baeecb5aa7c443f523e89369f3e451f8afdf9a2ef85d237b383edfc3a1386ee5397fd980639897ea49ceb0639093da6c849ed7ba1f4c5e5a4f2eb4c98c10b39070d7212fd4839bd063989fd479193daf742e98bcb49e5d7a1e3fbd2aee8143b41d6cde578babd9fd6455fa9b683386fb3f3873e1eafc636378aebdf7b5258fba8a0fc50fd1b4fd9ef3e7b001af01ced99eb69e0e852827254c23a04d18cb5e6c57991ffed6a0ba373ed5b6fd9d84b7ffcb0b8b0fe6db79c2fddf14c7d45f0e570b373f9ddae822f06e81ec9d37c1dee29fc58da882b7c9e9297abc557de412ee667787726ac87ee6972452cbe2277fdefe57a3eef0677c9f85f9f6d7e7c31f4c3bb2848178b537e15f58ddaf0626ac310e209f87b46ff60eacac31266c3a53f3e5bd1f36c45bb6b46339727ff9d324678b384f056b9b37001ba4bb69bb03cd0a45fa68ebe8797f9a5553aa81f3ede1544cf709fed092084892d3cbdfdf6e42e742734a2ebd999b0159e697433fe366c64096aa1938e30f32d8122cf7ced41bc81b5bbdad188a7cc176340ecb161638b2f6a34f3df0250e2525a1ff4f7b2f6343c0041bd51da231c53e699e07ca0f152deb1795d68a3d8d4d49b333db9a6308a4dfd7cb78e6bcf42310878b6abbec985e83b7efb097b30752934f8fcdb89c3bb1db1795bb7f82f5d9b2b10c218684af14dbe8acc686ed1b7e4d077e140681ff3efa41163deed28446fb28a8207ffff7bfacdb5075ae3cabafa164f8e99d346eb5ce1319b97ba3cf33a3fd81c0616f77839874ea4c68e10061df9bb59bc74cddb96fc8dd3f9d1d6673b5283f25b93a38ba3b1370a5f573b5239a04b496e3bf0712d5e3aa3fa497f0cdb31a6a6745be3b91442996c6ed78bc7855ee9575b732736f41c47a67ae2f09267c3b943ff5bb30be9df26b88e8cabb4dec539fadf720eeab6e56a451f199dcc2ea4f3c6dbd1ec91ae01ea5fd8124750af76cc268e308bf3f3159f4f437b9e019a1f85317489c1f52bfbfbd5d4d7a105fdab4c569b5f7b76f95e703e566845ab06dd9a5d4ccd81ba12a2ef29d6d7c0b384f068f96c4a2739e5b285956f2fe6630470fd8b9af9e671f2762fc7c2bf7d9ac97a35a25902b48afd6f1ecb377425cccf83ba0d49db2cb490011a9be06dd3f54dff06fd423b521983735f8d9738509f05e5f472bde605cd7c1ea14c511d93643c22be8c7751407fabb7d753fdc8f4369f43f4b7a59feb71ae4359bc82532fc0afe804314e665fb5e101eb535f63a25ff65ddbbc46e3887e873a19037d1d983996cce602d10c4e6ef1f7626f9e599b5f9fc1f190eb337ddef5b1677097c63d621ed5c411c268bf61d1773433fb449d934adbe6bd928f27a837409c4925cfe9b73d537b4dd92f7f7774689fc110ff0e306e036c546f5edd079de42bf717319e143a732704dae0857c852cf81eadf20ecff1f4dc9c2b0f4353c20a7dcad8b3ecdba6a9ac0dbca7df64ddb01ef67dd7a20df1ede44c97aa734e7c3339ffdba46e73697db3f54adb95583b9715ed096caf91baa5a5fa81f7352295713835b45a78cfbe5d5bb60d2874eadfc8a5f1d1348f297fdbaf063b86f6fdbb38fda29ec54bfff3294ebf5c79829db9559bf50769d67daed4fe981a3c5b42cf6a3823d15a4df3bb26043e7c17a79faebc197b8ebebe6398a6128b23f049ced7838ae9a62717e58d300c80bef91be731a59e3427ec11e2f96abca9fafb0b9acf17349f18cdc49c4be99b2a75bc92ff5e8d5fe5b6f26447e1cd8c9bda177733337f9c793663edf5d98a66978586be75fc58fa9f845dcb7c781addb807dda499ae88d34d7ad025ce9992ae8cd3657ad0659be9ae70ba6c0fba5c33dd1d4e97eb41976fa66be0749b74984677d04cd7c6e90e7ad01d36d37571bac31e749fa4fd49e359e998e9de2be2f9798c9be2e30121bc19da23b525d0ef987e117bbbb2371e24ed26bb4ea5ddb6ef9e24ed265c4fa5ddb2f7be629c3688c2d8e69bfc130afd09335c6e0fcd7a92fe9d987b4b98254dfe166c636a43cf4aed260bfd4579fb997e33a57e6ea53a1264771a8a73208f6156ee03749bab46fa953a8c8bc5a16fe3a3bc66e9a38eb26fe1a3f871c5668f3c27cbf7e36d515c14a75bbbc390fe86c7790cee191aba1499bafb6e68a0e2035470e044f489785711bf426d2f409bc5d0d7b2f8f5a97257196133431b2605bf1cc47ac0b3b4f082e26bdaec81eee3ebcad0e6d7a1b579b82b1467bb2a86a66cf7ba027d3501f59b7cfa3b4e3d5adcce5577077f31196f2c4eba3bc2c7459c4822d09e1cd0d76783fb72b791ca828d7811bf66b1a9cd2eb0ef361a31563cde227efc876b73b31bf4d7019c7fffe18a879417f12bbd2f2d7ea9578b3b9f615b315442c08d4271a64a8dbfcf94a1a549776b22427e9616bfbe13b42a75ee10f3d99c1a9b9a91af11fa0d44b3aba93d874b7f3c0750b7b8d05b4cc65343637d3b82ffffd35785f00a7419cac98b33e96e69b31be427fbfd82beed1f8511b616578b1bde9bd662aba9370bcee5177bb7a25960c50f77c58d6e3637ba1890e644924d4d0aed6886e25069bb0f1ff2b3e246ac1d8510bf2f816ec3b5416365b8b752e301c7f43c5397508cb8c68bee84289f90ed518c77c614c25b63fb80687f49731339b641bff140437b0a9ee98f6a3d149c772b1ac59636bb348da14c8931ae405b9f1d8da9ad9fc3a9683fc3b35fae622e444f092d2df42dee79681c8794a5bd7d42b40ff7e8fd33e96e09ab26de06046fd937a79ac65956d6a47cc330a56b0ab3b39dc6c7b1371689719fb4ef5555d7187b2fae75ad713ea0cf04d7f7050f490b0f796cf5150da68546ae17af68b02d3412a0b167231a5d00f6c624a9cbc683f2ad8b7c4da10ebce2836fe1a3aa4bafe80c5ae8e4efecbd90e5f0a0d429127603ab2f7b653fb29a998c5fc13b5b41bd5686e4436ce1e399cafb8a87a49987dcd6bca2a150df1ccc6868eac1d4d5eb4b1a2d736173ead5ccd6b65e5f47ac2d57b71769ad5ed53ebde4a3653e80ee317634eb4467d9322746f444b1ae17b20cab7a5ade4ff1cab52bd6debdca596ec4148afec4fd3cb3bc07722a7508bb330769c5345a364e8b2169cdaa77e64e8bed8ee47983ddf58bdcabe213e33db1fb77a7f20e4185d611e39b3647fe00e7fb2993e325781b0a4f0c3e97790ca3d286986f856cc3616d120a3f037cae29633de539368f53ca1c6d257c4d3872ddbe70d9380adf4f059b6f14372374a51ef33227ec634fa155b9ef71aadfe7f36af651aa8c67730a7a8786c2ff439e8302d35b82ea415f07c4b57b6f59bebf4a3fa7fd0c0d0dfad6f6d549cfe68c9734975ed74b91a58c935847f5d838ce669028e57733a01f02fdbd23f47d508e399b9f2c375f95eba65078b423f562e85e718790da8fcee7c5e246451e95ec57c4a62ea6ae300b2dbcd95c78a8b44f501c7b1e5ec0867d58bc72ce73a3a6364c4c6d1d9663a2b677475fa3df8bb98174b3bb8e0b7d7cb7b930cf17176d311af535acf55f0f6df2fe62c6fbfa6c7372b18e36bfcaef3b5e9d79f848ef688649f93e63da06086a606ab535a9caccecf531baa30934e58ccec69cd74d71a7ef6ae8d2d1d406d558e6b5887b0bac6747ce19ce1971ef3962aeb92db0b419f4a96e94bbc8d7c556a6d1e2715af91eaed07a56f7b9b5c96379559ebff07be6d7c5d620c7dbd4edaab529ee96556849189d2fca1c8937620e8afb7f95f178bc0dc99332c7da049f641b7cac6045b639626d12929f2536963225c792b7634cfe15658ebe627c4d1452079eb86c0a856f790beae3d5f2b965bb7abe83b9da7397a455b9b3b6d098025f5478ba12faebb3d70565ed165b0c63a076a40ea3764955f706576b53e08c72ce264c4d8f217f0a8d3f1c6ba07149bd596cbf28fc9173b2a89da588b7a74ceeb12b8e39d0fc51e74521e851d6fe5a5bfb748ec97d3461c831293ab2a8e9081a33a1d89ceb921873451bb38e41d276a48ec37647bc5d81436aed48590deafa2b383d7d4c1db78233ae7bd246a37bb3456e2c9fa7f2ee67cd86ef2764ff34a659d49c17bf6771d17aff4d9e9b22c7afd7f852f920ecf27e42d22bf8a9d7b2d2f822e96d0aff80c25f599f9aeb4951a7b225eb522cdd25ceb2ecae3a5e774ae5ad6ea7d921612fd2fbf969dbe4256fd8d9c80e28f450ccbaeeebd4fe1e17788ea4372cef72e3fccdda7923f822e8e47c3d5b7922f8d935f0f355abada6f244f868902f825ec15783eec6cd3e1fe4cf68e48fa61b797d04c53784fb00b725e53ed80c5ef186e9ede1bac46c3aba775ef1371bf88a71fd27e814fa2f91f39fe557483a878a5f8bcf95f86aae62ca5c116756c9179d5eb92f71ac06d7f1abc96624edf64c21f96ab4174adb7c25e47c35e9d6ba55efeb180beafc57a35ee56de9f3b426ec2a31e7994ebd583fd2a6e298b6dc87ad7a4eae9b889fabf9ba71edeb06f0758b713fa35c37d0b66e1cb16e38ce2ed6cd6b5db725b16e32a193f9ba2d5bd7cdc3d78d695ab702db37d9500cfbef27145ac53c7d3d5a6d144e6bc3308d366a4bb12d35be2482af663b95fb264d7c49385f84ef52f2d5bef78abf977c91b44abe9876be88fdc7113a5af045d1d11a5f84be93b44abe687ba7c217466bc3a258197d1fe671b4867d58bf8f7bdd4fe8b472bdcf7d3eaadee3b43665cc8e98afe21d059a6da8d647b1030a262fcf65924e05cb547d4f74c6d0edc284798d9b6b6d0a9a030acddc8e5e5f62673acd21e1fbd6f86cc75c341f793f21f1783e7fd5f6cd3c62be34b4b1317d5d6bbe771b8fb87faebb24cd7c3f201edab1349de681885d957cb6e3e9e2ef15fe1af76b114768e20da77520e20c255faf7175ad4d953f9c6685bf57d89a4ef3706db2c3d5584703e6b8e29838dd2f04a62af7cb0b8c4d8bafecc978618e3fae2f70f6158f25ec2724ad729fb4626d921625a653cedd4bbc4d89fd205bd3183768a259d9c731751f37db9a76dc5dfcbdc65f8b9d69c5ded75a5c369bbf66dd6bc7008bdab99dd99616bd6bc5e01386a1d8e7a6f8cff5d5bad26c73239e2b62714dfb81b29e78acae5ccf763c5efcbdba9e24b62fd7b315935ff1b8da9e121f2cd7b31d97177fafae2789f18bf56cc5e6136640ae2711932c6dc80b7c0ef724396f24bd72deda313a85de90120badf0d78ed3e19ea4f0d762e7dab13a85de901283adf0f76aaf7ee1fb6b40a357e1af15b353e80d29b1df0a7fedb81dfaee9433ac655fb463770abd210ddf16fcb5e1e45a5c3add17440ea67abeb660e5c5b686b92bef981071d202df57de2d698d571b9476159b58de01afbc4b4289f516f41c4abc9a8c7fe7f10091c83955ec14d95ea3b4afc780f118e4a5c507a0f0b322e3d9b94f44c4be454adb2aeff5989ce19338bf3acf787babc52f20795935627e4a1c3ba6c4c5dbe2de3ea57d2d6751c7f688f7a485779c3ef332ae5eea34b1b74b9d9ee1f34db4adc697eb7af568f61f30bace84c8a7e7fafda4e937e94b48b57b26b87e937e426bccfed22d665fea4963ec8688918b94b652d3dd2d38dfc4b9d016cfb73ac5f34bbe5fc5eb4bdd266c6c4cbf4b567def86a6d712ce6f739ce388eb33e90b643a42c4ea6d9fe68b947902ca3c5362fb151dc17c1364b7c9f322d71182be438bd1e773cde3734dfa14e57e5448be89585f856f9eb0dbcdf68f27e6bb09e33084cd267d8d327e3ec7f583a45bd169869ce7463f8621ec3519e3cf6c1ea11f94bc8244bd8b88ce47926e45a7c933a6f94c27f482cc01e4f90d620f92be49a91780381749db5bc9d71c71bd68f67b14621f9239824c2f06845e903e4ba1174b422f48ba15bd18107ad1ec0f0d08fb8cdf312a73a60fc23e936d1bf309f03c6cb51b587bab532ea3ca37614b73be6392ef161b8de51b20df64eea239d76175ca7554f86ece5113b683d2b6311f61f8b4dc46732ec4ea940ba9f0dd144b987e7104df64dbc67c053cc7dbf623dedeea942b29f9aede27c6ed48fdce1a1b53efb65578c7dafb0ded1bf32956c77c4a614f9a7dc1da1d24dba7e55624dabde8ab416b5bbb3b52e649a02d69f121317f7345f1adca3c00194f6ef5c5a8f7000d9ff4f56a71524a1fabe53e139daf669f8c964fb1297e566bbe44a3f8712f72366dbe19adbd43f1878a1802ee0fa53210edeb32d878fcb9dd47a3f7b9b4dc8fa2f255797b93120b216468c600c4ddceea1b9b0db11482f7c63b54042f2d3e1bf5fe68f9fe019d774a5ed0a7f86135fe297dda7c372a5f0ec567aac881d38f29f99cd67ccd0b1f8e36c6a55bcea868cf507cae3c068ec72e5b7d39e29eae2652ee6255f731defed17c778be4a5cda7a3e583625afbd67c8f26d2fcb4aa0e51fab4fa7634be9896bb5c449ec7a6f96c2d79a1573e1e71171af1df9c2bc0e93b930e79a7ea79d6aaff6bcafc13edabf38fc7ae2f6d77c7487e5a7c3ee25e37f2a1daf487627fc8f66db9ad56df8fa4cfb4dc2523f24936cda76bc93fbdf20189fbec506f484c5be60c8fe4f9fb32bf55d59b165f9068af51db57f506cf695ddaeeae91fcb4f88494bbf936cdcfabeed99a9f97f24fa15f3bbbf0315a7d434a7b87e69395771528769fcc8155cf5f3c9fffca47a48c71e99667abea7f873c5a4587da7c454a1ecca7d1af9dbd84bd7ad0c6286d27c5fe34fa8c945a0b9be607d6f408df93543fb3a647f818adbe23a5bd3369beb356cb9121fe5b7db02b76ffcca7f976b5f9afb7bfb4dd7bc3f275de7ec262f5afe96fb5dad6f2cdbbaba1bb5773c226062f956f29d2fa54eb61b5d960a1dbd7fd5caeb62fdf270fb07890ee5e9db8fc964fdabf7c7b1f8b85225a0b8d3d9432bb9537d3452ce695f2527e8b05e3259168bc0cac099597048beba5bc6ca5a6f68c4c6daf34b65fd264ddae9bdab3747ed4a6f61c752eb7a0a93d4fe59fd49f723e6b35d42cdc7795b704d27f57de0228e61deaac59ff9e4cfa9b507b23a5acd5d5e0fe246ab4297dc65cf9d62a1a9fc7f82979c7dbd5e5acb7adc939f2cd480d9c49fa2ea5a1e56ff27c5ead98bd1bd13934f8d51544217ab3346f53bce92514fd23535fbd67ef4c7966f9b66841c3e02a6f8d4cd8fb4223fb3a51e5fd540aedf4efd93c72d2df81a6300b0d9c0b59a974d1df918ea0f7c72afdd2b744c38b3525e6a3f6f624652eb277b79a78add559177d6d4ef12c617705c288cfdf175d681f579ca75abbe0f2337f730ce32131b8d9056cd883c53b37725ef26f2351c6a9d793e7e70b39474ded88b54ddf72a5cd972d8c1253cfdf8a2fdf1ec6656a6a87ad4be5bdb4b671d0f9f74a9ef49c27e62dfd4e4c7e6e751ccb2dce3952276af5ffe9372f9ae732fb2606c414349da6d1826d3bc92acee1394fcc695c7d5bab454f32ecf562ede6193ec0e735ffde5777bdccf9eaaa9f647b7cffe5df7011468f97eb3acfdff14be7ac8bcc0df3db692d110e7ea13b699bfcbdc4b167702153a9d5bda3b7a8f5f5b076e7a86c1717f722f2dff2fb3ee579f03034e5eccc0f57a5386b8adfd27b2ab4b6c98ede36a9bc5d9af186bead3fa1f0b125debcaaf4f9c4deaaaa8d43bc515593795b7fa3aa5976dab8bb07f67e596d8e17c1ae6d6cfec5d87cfbd85f0f79dad27f4bbc67559baffa9b49d4f92ade7dc2e6abf69656f37cd1c685f3557befa969be6863f32fc6e6dbc7fe22be994f9faf11eb64efd554bf2d6af12a937e57a0f6a667318ea3afb3f76ad0d98ecf6f4eb33abf684d41f4bc3b712d4756fe9ee0f931f4fe5d6409237ea1796720ac8b77c8911e44e062f1f6bb28647f0b28fd7470b6b9b0f29d9af26f4bffff25ee6d9b53e77d7de1af4402acd97dd99692c26eccc00282fdae099d4248dade1b5a483efd3d794e1ccb96935eebcc996bf6f9af929f2559b22d59961eccd71df9aaf7aaa9e1a66752d72ccfb10d3a8bbf89bee3de54d5f91ef1758e1adffd05bf1b4bbf7b343e5f9dd5a9f2ebeafcbf2be473ba707d73f8710b6cd13c8e64f24dc77e2eff2efa7e2cfffea4fa7ef4b223be17de953d7d1b729928e6e96fea0fff54be013f768e5df6e2cdff77561369e00eb7657f885a6dde3a7d397e620bde9f643fdc3ba4f4af0bdab29a4ee27af4227d4c7ecfeb8357d598b8526775ca7e53ebb75bdd9136fedeeac55add4372bf6be4ee5ccb7bbee78703b356197fedbccd6b79a7d6f8dd94fbcd46f09b563da16b792fc4fdaed9bbf5bebc83f1ace929ad5f1573f9741ff7d51d47036bd6c22aef121abf231c5d65ccbe2eb3012f575b40576b3c5f242fc6cb5e3447236ebc81683c2e27e75a8b59d6b16ebcbc8858f63c8f65ecad3926477f15e76a8c59cb61b8d6ce71e56fca9cc109f7eff5dc86d2561f462fed39e6ebaed4b0ab3ceb929fe75399bf50f21355f543eaeff66a7f97d55eb9be09ecb05edf04c0ace752733d8f1faaf7a3353e45f557201a39bc9108af9d27defc3b588325a5afb556d4e8f38478cd3cf6669e81f75cbd1dadf124aec302d1c8ad536f55bda8b6be556fbcc43ad7925fcb866bef970558f53c9e069fd5bbd1b6fe3d21f4afbd86be55790b02fd0331e19a2ce9fcb6d6865afeba9ac6b6feb5f18475598473dbc21b9777fe6dfa2285ee45bcdeb5f6964aef8458155d735edf6eadf53912d66711eb1c770f9fda846cbd3baae4c6ef7d0ff51a2d6d9b182ae81bf2732ac012d46911eadcb045576bbf2df3869fe57435e2e6195d6dac92ae95942e0eabfe4eb44d97623e056b701b8bcfa386e86aef0fe0da463e14747db4e86a6309eab608e9fa68ed0ba07e2d14f3b868cda300aba4eb20a56bd19ac7257cf6a8bd0f05d6b32b6f97e55db8c82ec57850cef5b55ec345b027a8d633ee5cf730129dff84755cc46b6dd4daaf247b81ca36b9fce56bbd968b60bd15e3d5f6d2b67d52788f5f0b75b73eaf666b5edb67f5dabcca6d813f13d76ba7b4e735bbfb85cf1fd53d72425799cf2b3c7fe46f4285f359bb63fe781888cecf55ddc362bcaadfbf90cf0aef28c213bd0bf5acab08b33cdf55632f71e7f35cc6558f2ff1b9bfe50b5b3311bef47cef62cff7f9eff7027f1b7a1f9acaba6d0f75dfa04d7ffbf7b2b3ff1977f6afc9bf7d0e6fd757ace41fcbe5efb5e96fe3cbde895e6bef4485f2e77f5fcb3f06cffdb96c22d1b91e782b7aadbd15c5f80c678ccf50d9652b5e02be174d7446e043c8cef347117ef3dd256f2757d118e09bd1446f04eb7aab8e6325f7f69e2239ef1f45bf97bc1bbdd6de8d0a7d22d292bfca9fa8642fd8abc56f4753badbb8a02f625d11be48cd4ee1b59c7faf79adbf1f15d2cdfb1ed64c882fa92799ae939235bef5fbfa1bd2b6ce9036fdb27572c8cb5de49b80becc19e5cbd4e886757dd5a25bf05bc8df388a7d17d0d739a37c9ddabe04fba72d7d11fd16f2478e62df06f485ce285fa846772b0659d2cd5a740b7e0bf92b47b1ef03fa4a6794af54d11dc3fa7d68eda332fd5eb4f444e41b81bed419e54bd5f64f584f785f6826f4856a6bf9955f5744bf6ff8332dda05be1bf0c634dd8726e05acefb4a91d057aaf91bedf55ce45b81ef4caff577a6a27d94c3afe70f0bfc99d6da22f2a5a0b7a6572afe7ddd1f1ab4ce00b2b8ddbab5beb4ef0a22f17bd3546f04be564d6fcc96de087e5fd79bb6ad0a7c43e0cd69a237221f4bf06ef35acf1916cabdeebb5933216ea3c6480ddb7d14620bde6e8eafae35f59973f871ad55e01d8d81174ebfbde1c3c10bd31ede7f6af7975feec78391e6da34bec9c6a1e6ddd91dcefeccd2be69e3d8b39273efb8ead36fdd0ed4dc7ebae62ded5fba7f9e1fdc0f72a5c99afeb1fcb3381a1777f879e17e97dfbf66fd2417c7078ebe6a9ea8438257ebee9cf62ddd71341c8d8b17fdf7f4ffe763707ce67ae9bbe6edc7f33fdfb9df43739cf7a4eb30b78ff6b5d6430cc6758c9fb7e3af605ddc9d7d7975c6d7fd6ea9d08d7bdf9ed463054d59163dd2b4e609c96f1fbaf6e6e16b6f6d12bacabb7d2cbf2c64210217615738ac17c788d31e4ecfdbc1cb4eae8b339385cc87ec73fff9ba5b050b5f6f7e51fc2a6c444a97b38f68561fff9ad056f5cbc6f24c872c9c86650f2805beebdcfdf9554c2ddd262131d9818536a4df1f5e38cdf3c53e35e75e470e5fbf44e72ca3537b4d2301b368c47c0f878f5adbb430f56cc05985764887901db0e1fc90f60798dc6bae757a72f8253ae39c4efdb56f3d0f161605d7550e1fb7066a60ead9c32662eb436843e795c21ee27c5dd0d1071d3928d7461c9d697fd64eeb2339b2c936c0e2e3d6470d4c3d7bf069b834d81adcff737b5876581f75e4a05c1f91746ebaae8f07db9a063446e2e3d6471d4c2d7ba0eb8703736c953d181dd6472d39fc129d66d7f591c44f237b723291f8a8f5510753cb1e62efb698dc8f89e25c96f688d75c1fb5e4a05a1fb174c61dd7c7787520eb8db950ad0bb1c6faa883a9e71b0de87a63101f8c25e4f6e0e9af8f5a7250ad8f583adf3bae8f76c426f6904c90f838df580753cf470e9731899723853d8cf4d7473d39fc129de3aeeba3ed3c45f664ee23f151eba30ea69e3d4c0fb67f1fa9fca9c55a7f7dd49283727d54d0b99b07ec7170eb7ce60f59481c6a40b47ae1f654f35731e7909085d460d57b2b5e6723e62c3bf9d668dc0e3110ac0c7e07abb35f1d3267396413d09fcc64e0eb9cf559c026fb1391eb57a4ef9fe171b5f5002d03751c0485d5f5ec61b280faf3a30dc6ec5219e8f9d3e1f66887b348ae5fb67e0c048fabad076819a8e38c18ac41f7b5d01ed9ebf79b5c064badb590c6efb7c5e449aa5f1dfc683caef65a8897c1ef6075f6a143b2a60651ec07442f2e9ce099642dd52f437f2dc4e36ac79bd13240c48431587ed7b57063d8e62666721968f9ce6c9de09140ae5f547f2dc4e3eac62cf13250c7153158c3ae6b218d37b13d816329990c3c9db5d027c9fe01c77a32fdd2f699357035d7421d19fc0e56677f794cfdb96fc3f739a90c163a31e03838daa1adf23b46fa7e171e57d357d6908132be81c3ea7aa7197b033b7c8aa8dc76b5fc64122f23ba9e1fe4fa75d25e0b357075e3937819a86388722ce72ea2cef883fdede7275307be137287f3831bee037d5f991d980fae5d3f5e38fd66e6a6a3bfac81ddc167d691c7efe1f5f19db7471a83b1b44a1ebafeb333f7e1b86aa97bdd7c680d6c7d3f5a471e185f1a89d7dd9f3eb2893d626a7968fad4f3931d1f4e6addebe2576b61ebea879e3cd4fe3516afbb8fedb3039b6cc0f858250f3d3f9b84d3135983be4ba97b5d7c6d2d6cdd7554531ebf87d7d9ef0ed8e47d6cc7542d0f2ddf7b7b5a4c4e317c0f58ea5e07ff5b0f5b3bc7474b1eca75148fd7d9175f9d48388be05c89521e7afeb8b534a93f43ece11d7c723d6c4dfdd09487721d45e3f5f0cfef0de2cfc0d85a250f3d1fdd9e6c4cdb07e38a95ee75f0d3b5b0b57d753d79fc1e5e0f9f3d5e46187968faed3e99cc225bed0b75f1ddb5b0b5fd772d79207c782c5e673f7e7fb4e3e50d8cc155f2d0f3e5cd594c265330fe58e95e077f5e0f5b533f34e5a1f4eb1578bb2070addbcf3eeae7d7b310f63bdf9ced39ad51a8efd78712df7bcc9c5b5a67b0a35f8fc7eee0d7ebc8e3f7f0faf8f5cca721785eaae4a1ebd787e408bfb72875af9b5faf81adefd7ebc803e3d723f1bafbf507e6df8f166a3bd7f4eba7a13d791fab75af8b5faf85adab1f7af250fbf558bc3e7e7d604fb6e0be52c943d7afdf5c8903fadea5ee75f3eb35b0f5fd7a2d79fc1e5e77bf9e9af6985833b53cf4fc7a9fc6a70155af755dfc7a2d6c5dbf5e4f1e6abf1e8dd7d9af5f9a0b671321ce4b7a7efd848ed337314addebe0d7eb616bea87a63c94eb281aafbb5fef7bc3c57a0abec9ace4a1e5d71f49ec8d6d47bd8777f0ebf5b075fd7a4d79fc1e5e77bf9ec4db83bd56cb43cfaf3f0d89f37403639895ee75f0ebf5b075fd7a3d79a8fd7a345e8ffb793299ddc0f85d250fdd3bfa81ed3f217ca14ef7f43ad8da77f55af240dcd74bf19c7dda9facf4b93bf884b695f8c860bd860f2fdc06eeb18c1be0f641ff21f165c1da122cebf7d7c9a7d7a5f73fc356ee81f49ae6b82be7aeb33fef93909a6c029ecb0b7a239db9a3310b49b804efc5cab9f33be49f69d2fb9f612be7ce3699cf4e601e6a397776f798b6b331a95ad7b4fcf885330d483853db9dfe7b3b6d7aff336ce59a191cd89a1dd576b7ec3a77e6c25ac68b35182f2fe81d68ed79fee164c727f01ea5983b7dff5d9fdeff0c5b397774c426f618be3729e6aeabef6e0f16d6fc48e0dc9b825e436bee268723f53dd97bc16ceeb4f73b7d7aff336ce5dc2d47349c0d65ef66b3b9a35de72e5e58c9d9fba4a257cb67b7e343724606e393e5dc69ef77faf4fe67d8cab9b34774fd648271cf72eebceeef94fd778338ca7562a83577211d91f516ccb92de74e7fbfd3a6f73fc356cedd3c5c4c36069cd35bcc5df737e624a6433251eada486bee7ccf24f15269770bfdfd4e9bdeff0c5b3977b321f1bd81daee4e9de7cef63703db549eadf4de9afb33834c0898ab59ce9dfe7ea74def7f86ad9ebb88ac97319803ba5b05d4b9f6a8e7b631d31b613086dac4c7dd3168606ade0164b7ede09a3660cef4dcad9e9b8e1cd4770b383a3bfbe4473ba40683d7f6263e3226ad81a919339e87c481f7cfbdb53db8ddde9e6bc9e197e8ec5ccf6d3149fc66782fe2f071beb806a65e8cf1fdc6d6ec04df51e7f6d0e10e414b0ecaf5114767e77a6ef12a6493a90fbf156ee223eb15e131357d2d162e6f3406e353b93d74a8e7a6250775bd221c9d9dfdef7861cd22ea80ef059bf8b87a453a987af569e2c3d10ea7607d9adc1ebaf8de5a72f8253a3bd773b3e3a731894f604c98c347fadd784c3d7bb06f8bb53756da43877a6e5a7250fbdc383a3bd7739bfbc47c1ac2391f4d7cdcfaa881a97936a7f1fe404c30e723b7872ef5dc74e4a05c1f917476f7b517936d40e05cec263eb29e9b06a69e3df8ef065b5330669ddb43273f5b470ebf4467f77a6ebe3d60eb0d581388c347fad8784c3d7b98c5c4da18706da0cc1e3ad573d39183dabf96d3e9cccf7b67d3c3bfde1e997f90d4636fe22373f8f098ba3976211d3138b7f8e83e6f836efeb58e1c10b97b283abbfbd7ccd9c40caea7d9c447fad71a987afe75b80d6c9382f74234bc191ddfa46bc9e197e8ecee5faf1f7c66c1f9201c3ecebfd6c0d4ccf1ba527325c9dbc8eda18b7fad2307e5fa88a3b3bb7f4d63ea93007ee3dec4c79d1f353035efa16d73163347d27726b5872efeb58e1c94e747249dddefb76dff3466f07d7c131f777ed4c1d4cbc59bccc60b67039ecb727be874b7ad23875fa2b38f7f3db243b88e1a878ff5afd198dafef564eec36f17737be8e65fe3e580f1af317476f7af8f2424473837b3898ff4aff198bafeb5cf7c0abf85cceda1937fad2107847f8da2b3bb7f4de34db49880fe7b131fe95f6b606ad64bb70ddb7f07fda9dc1e3af9d73a72f8253abbfbd7e1d3c08ee15ad11c3e3277048fa9690f0732b1e11aa6b93d74f2af75e4a0ce1b51d0197c332bf870fbbc1977e621b1e0be69afcef8f4eaec7f34df8c273ee640e2637eb9e1ea8b99ffd3d5cfc663ebbe75d494c7efe175cf31b7cdd58185e07d50250fad37e376ccac6908efcda5ee757833ae87ad5d9b454b1eeafe4968bcce6fc69f622acb9fa8e4a1e57fd33539b2908177f295eee9bf19d7c4d6d40f4d7928df0aa0f13abf1927261d53135ef72b79e8bc19b787cc0f7c499f8852f73af8e37ad8ba7d9534e5f17b789dfd7293f8fb40920f53c94327de1c539398db00a17bfa6fc635b175e3357af250c755d0785ddf8cc7db03f1f72738bfbd94875e5f33e7c92426dc6ba8d23dfd37e39ad89afaa1290fe579148dd7f9cd38b16cc376e688f392ce9b717b40e3cd4d52f7a5d2bd2e7dce74b075d7514d79fc1e5e67ff7d60c7cbdb02ee3759ca43efcdf8322693a7311cc32875afc39b713d6ced38a7963cd4f95f68bcce6fc6896fc7d404f3312a79e8f9f3a61d139f1dd5baa7ff665c135b533f34e5a15c471578bb07c3fb7838f7abf13e0d8903fb9ddef336f2ac9b768d77ea5043e27b5ff7bbf9d5b5bad578d7c2d6ae05a7278fdfc3ebde777ce12c472c04efa12a7968f9f59b319b1c42f84d44a97b1dfc7a3d6cddb88f9e3cd4791068bcce7efdd2a42191bc8b28e5a1570b6ec28ed4847def4af73ad482d3c3d6ae61a4250f440d23245e67bf9efade9848f2012a7968d5823b92f83490d4b12875af432d383d6cfd1a465af2f83dbcce7efd98f9fb83c4eface4a1e5d7072131a7b0ef5de95e07bf5e0f5bb796839e3cd4358cd0789dfdfaf72b71a670ad8f4a1e5a7efd62726f5007aea352e99ebe5faf89adfbbe484f1eea774058bcee7e7d381b90d086fb2796f2d0f2eb4dea2f4d1a23744fdfafd7c3d6f5eb35e5f17b789dfd7a83ac89bf40d8b99e5fbf316cd31e23d6ba0e7ebd1eb6a67e68ca43b98ee2f13afbf5cb811ddb43843cf46ac14d96b11def1167c60eb5e0f4b075e33e7af250d75751e17db9e1b9473e3c0b99bf0de13ed44d7c646d703c66a71ee7603feaebab33febf6ef9f03a7240d40447d1d9b3cff91acce369e2636bf2e331757b679f8809bf0ff1ace0fbb5732d77bc1c7e89cecef9f02c4c73e6e0beb94d7c6c6f3634a66eef34ea33388fb2b0870ee7142d39207ab261e8ec9c0f6f527331d91f6dc5baa0950faf83a95bb3dd7f1f521f7c5f9cdb43877c782d39207a69a3e8ecd3838d0e285c03b7898fcc3fd3c0d4cc0f5b9ac47a3215f6d0b1f71a5e0ebf4467e77c7836d9c4cc3f80efd9397c645c138fa91977346c938ee03ecdb93d74c887d792833a9e89a3b3733c7a36b2e3d908ae7ddcc4c7ad8f1a989a71ccc5c48e1613f09e33b7870ef9f05a7250ae8f483afbd4609f9944724fd1c0c7c54d743035dfcf7911f11958772cb7878eb5d7f172f8253a3be7c393f83e22930d58a787c347d65cc7636ad644bfdafed280eb0065f6d0251f5e4b0eea5aeb4d3a43f64387db7837e16dd41878e1f4db1b3e1cbc70fbbd9f7c85ae490c2fee607fcfab883ae3d8b3a61173c6e53d050beffcfd8e783ccd2f3beef7398dd8f574ff3c3fb81fe44a77f3c0fd28753da3dfb8f27a7de17eff27a3cbd69affdf1a53cb9e7f4dae8af559771c679efcefffabcf9b7e9cedfe5aee2db08e1e93fffd1ad77413b7765fed6aaf01e78886c9ff3e77d2871ef4ffe76328f380129f46a94bfbcfd7dd2ae835c7690c4a49fff0d50a066cad3bc7694c4a6d87e6e16b6f6dbacd7177fafff331d431892aef06b6e37d4477a72c06d86b9ee99085d3b03c93c37c7c78e1749cc533f5e79b98ecc0425b39e76c383fbce57961dde6bd373fff6c2ca51ef8fb903acc47eb82df5717b627e6cfabb8b3929f59175d089845a35a3c5aa50b51775de8cdcf3f1b4b1def5885764887685d88fbeac22662eb4388d8c3727eec4eba60879b51ad3fae4a17e2eebad09b9f7f36965217d6f3606151f53920d785340eda4b17c8914db6019e9f65175df069b834d81aad0b83eebad09b9f7f369632bfcc9c9f88b3c1eb42eff3c2d3953a5b1fcfcfa68b2e1c6c6b1ad018ad0b46775de8cdcf3f1b4bfd66f5e1c01c1baf0b7dcf0bb1775b4ceec7447d06cef9a11d74c11edaebf7eb62adf617725d303beb427f7efed958eab8d4d3c89e9c4cb42ef43d2fc4ab03596fcc055ab7bd2eba30a0eb8d41aa9a732a5d1876d785defcfcb3b1d46fe29e0cb2ae6aeba97461d1f7bc10cf4f76ec0df0fcbc77d185884dec2199a07561d45d177af3f3cfc652d76f59c6245ee275a1ef79219e1e6cff3ec2fbc8a72eba70a3893f858f2f8cbbeb426f7efed9584a5d709e227b3257c71776f3803d366332dd728e58489caac681e678c81ca4ee6374cad153dfd544cc59b6e2747a394a3de4a6ce59d2e3a3778c89056cb23f21e224c2f190399fddc7e892f33751c7b2bc707bea175fea2337448ea8161f5c6ca453cee82ca2feacdb78e81cd2ce63e8e6941eed701621ed33e6d7353d7deb2eb75fe663d07f3fb047f6fafdd6713ce47ed07d0c4dfbf41793a7918d5c47f9989c9ebef5909b7a3fd0e3a3fffd9341fc8d89dd7ff8f19039aeddc7d0cd795da76321edb34f2ca98fdc1039b15a7cd0befb4140d624b0cd8ee321ef1a7a8ca1679feb4dccd6554eb9c23ecd1efb412fb9fd321fc3befb018d37b13d41c42fc5e3e16a06f61843b306a461c7f73122d69bd967dc7d3fe82537e57ea0c747ffd85170b4431be3f30ac743be6def3e86664e27f5e73e262e91d9679fb8511fb9a9dfc0ebf171eab91fd84332991fecaa47aade78c87b851e6368e6882e23ba9e1f90f639eebe1ff493dbaff1e1dc45d4197fb0bfbf1333a20ee6ae1b1c131d37ea338e76ec289c62f2037ebc70facdcc4deff8512f19626248bafcfc461cc999fb88383938263a96d4671c6dff7e7ba4b1fa4ec31dce0f6eb80f7ac7947ac9101357d2e5a77f6c8986cb7861a9f3bcc131b1b5e2fa8da3fb4ef564c78793860df78a33f595e17fc04fff7893cf0e6cb251c7d1e131717b49cf7174dfc252676920e200a50df78a3df595a1722fe9c04fef18d4f6b4989c62442e063826b23763bf71b4dfdbbe8fed589d3753d9709f78545f19aaeb19eaf3d33b2e75621376b411f9a9e098b8bda4ef387a366c2d4deacf74ce837d6254bd65f81ff0f30bb1aa7b83f833750c1e1e1319afea378e66ccea4ad63442c4fe2b1bee15b7ea294375ec4a9b9f5f885ff96432eb35263286d56f1cdd3856bc8c6c5f1d57a86cb8572caba70c11f12c6d7efac7b4a86f0f6c471dab07c744c6b57a8ea3d907651693c9547d4750d970aff8565f19fe1a3fbb2070addbcf3efa9d18170b11f119784c748cabcf38fa312e8689098d99733bbb43af778cab970c51312e4d7e7e23c6159223e28d2938263ac6d5671ced1817f329e2fdef9bb33dbbd6ddb0778cab970c31312e5d7e7e21c6e5d0eb6272ea3e26be1f429f71b47b18d893f7b1860df78b71f594e17fc0cf6fc4b8027bb2d5d9bfbac6b87a8da31be362eb7b83a9cf83a50df78c71f5932122c6a5cd4fff18974fe3d3806aac831d635cfdc6d1ed956ada6362a9f3212b1bee15e3ea2943758c4b9f9ffe312ee207811daacfd4e098c81857cf7134ebd6d171fa4e1a6fc3bd625c7d65f81ff0d33fc6e57bc3c57aaaae45028f898b71f51c47b336de90c4a79bce79b0578cabaf0c957b893e3ffd635ca721719e6e88583f38262ec6d5731cedfa7bdb838d78675cd9709f18575f19aa635cfafcfc46ded632b211351cc031d1b95bbdc6d1cddf1ad8fe934e6ca1670e573f19fe1a3fce3e62cef6bb5523a983bf6a5b2cacf56c92bdcf0c98336ac7827079fed7f4ad1ceaedec36708fbdea4cf51a4bd757ed273f750c431bbf776ccb3699cf4e887bfe427e51b7fb3316927089c85728747dd6799de83996ee5d5d3ff9a9efce74f1f9588cf67973e14c0312ce10352c0bf9758969d963ea6c4caaa3eb718f7bb33e63699e35fbcaeff7f1977dcf13e6c25ac68bb5fa9eae905f2bee83eb613f62137b8cb86f2c74bd7b2cabe758ba3dbefac94fddf35e1bbf773d89e58886b321ae3e462a3fa3cbbe41268723f53d549d8c4cd737ddcf97fdc6d2ed09d54f7eea1e51baf87cdc457bdfb0e343e2e7a8e3eca5fc3ad5a18a1756e2afa9ef0b4a5def7c47d6732ccd7da3affc7e1fdfebbb6f44cc7f3788a3b12ef1fb2e6adf98878bc9c6a0f8b5bc473daa7e6369ea5f4ff929f70d6dfcfe31abd990f8de00515bbf90dfa8cbbe61fb9e49e2a586aebf77de377a8ea5a97f3de5a7dc37b4f1f9188bfebee1cf0c322188370885fc3ac5aa22dbdf0c6c13ef032c3adf87f51c4b77dfe829bf5fc3dfad02ea5c7fa116fa26cdf241acb103e64ccf1d6ba10724cd2452af7d7b6b7b707bd5a8eacdcf3f1b4b5d8b20cdbf42bca1cd75a1777c6a16317f1be0f9e9540bfd6887d460887d30d7851eb5d07bf3f3cfc652dfa9cd43e26cf1bad0bbb6e9fb8dadd909d39727e3a7535c6a643b1b9321f291725de85e0bbd3f3fff6c2c654c6a320d48883803e5bad0bb167abc0ad964ea23e2a2393f5d6aa1db03162e6f3456e713e4bad0231ed59b9f7f3696328e62b193edd8883a89b92ef48e45d923eacf0f889a1b393f5d6aa1dbf1c29a45d451d7dec875a17b2df4fefcfcb3b1103187a31d4e11b54d735de85d0bddbe2dd6de18afdbdd6250f6fa345cacd1eb428f5ae8bdf9f96763a975e1694ce2137e5de87d5e98fbc47c1a22f204737e3ad5428f68bc3f10539d2b98eb429fd8535f7efed9584a5d08ed215def8f585df885b8d388381b1371df93f3d3ad16fa62b20d08e23d5bae0b3d6aa1f7e6e79f8da58e2fbc1b6c4d117534735de87d5e98c5c4da18365ab73bc69be25540d6e875a1472df4defcfcb3b1d4ba600fd87aa3ae7debcccf7ba75d8fa9433efe91f9074cffc0a3fbbc0dbac69a684847985e8b34bc19fd6a5af5e6e79f8da58c3539f37061617a30e6bad03bd6340fd89a84883bd39c9f6eb126e66c6286e89d92eb428f58536f7efed958ca5853b80d6c13d33b25d785de67c7d3959a2b4c5e61ce4fb71c28db22274cbe5fae0b3d624dbdf9f9676329634deb079f5914d56339d185feb1261a539f040ccd4fa75893699bb39839685de893fbd4979f7f369632d6e493a36d6e11775fb92ef4cf7b8a16eb20409c85737e3ac59a06b67f1a33443e57ae0b3d624dbdf9f96763a9f3b766e385b341d4b1c975e137624d93b98f783f9af3d335d6f41e51446df15c17fac59afaf1f3cfc642c49a467688a8995ee842ff58d39184e488ea359ff2d331d6e4339f226a78e5bad027d6d4979f7f36963ad63433a989a94b96e9c22ff4ddf3c97a3642f8c8393fdd624d34de448b893abe90eb429fbe7b7df9f9676321e28e86edbfa3e30bfd634df30399d898fe20393fdd624d74bd8c317d42725de8116beacdcf3f1b4bad0b4f033b46f47373826f66051fee6fd49872e62141c534c0317175417a8ea31f075a61e2405f6eb8fa62e6fff4ab31d55786ca37571df8e9ff0e2f66d634449c87c031716f337b8ea3f75ed2b7cdd58121ea31bc3ae3d3abb3ffe9f916afa70c95ef333bf0d3bbc6d4c136e7a83c45704c6caff77ee3e8bdd15c93232a8fb0b2e13e35a67acbf03fe0a7778d2962d23135b5f6af4e35a6fa8ea3b7e7efc38543307d504b1bee5363aab70c95f9f41df8e97de6a4263151799fe098c8f79afdc6d17c4349fc7d80c9d1ad6cb84facaaaf0cd56f36f5f9e95b63ca36e89a0e19a2573b3826ce1fe93b8e9e0d3b4f263131bdd44b1bee5163aabf0cff037e7ad79822966dd8ce5ce74cdda9c654df7134f35c7db29e626a8e5636dce3dd7f6f192af7127d7efac7b29631993c8d11b139704cdc5ed2731c3d1b1ed8f1f2b640d4f6a96cb84f4cabaf0c957b49077e7ad7988a696c9b2454c71fc13191f71f3dc7d17b4b67da31f1993a2fb1b2e13e35a67acbf0d7f8d93d18dec7c3f9777a054e43e220e233f098c83aeafdc6d1ac5f7d60fe161313baee77f3ab6bf5ed15d85386ea3aeafafcf48e716dc66c720811b900e098b81857cf71346bdf2e9ce58885eabc0def791b79d6ad679e555f19aa6bdfeaf3d33bc67522e1dcc7c467c031b1b56ffb8da359fb961da989792b5bda709f18576f19fe07fcf48e7151df1b134cae173c26b6f66daf71746bdf2ed6a8fcb8d286fbc4b87acb1051fb569b9fde31ae2024e614139f01c744f677ea378e669d3ae6ef0f98985065c37d625c7d65a8ae7dabcf4fef18d7c89e6c0f6ca2bea301c7c4be13ef378e5e4dc1c9bd411d444dcbca86fbc4b87acbf03fe0a77f8c2b9c0d486823de198163e2625c3dc7d1b361762413e623722a2b1bee13e3ea2b43658c4b9f9ffe31ae8d619bf658671dec16e3ea398e669c9aac89bfd05a7bfbc4b8faca50b99774e0a7778c6b4026764ccc1e6322f3bd7b8ea359e77219dbf15ee71cdf27c6d55b86bfc8cf971b9e7fe1cd200b99bf0d89ba66c2f5d519ff5fc73783210ba9c17c4f2d372bf87eeddb23b01f3fff6c2c75dc8c85c4a143b42efc466fc0c93e40e4abe6fc747a33183267396488b7c4b92ef47833d89b9f7f36965a17b6276222de1217bad0f70d80c98ed46798370d393f1d7b01a6f9ec88b379a60bdddf0cf6e7e79f8da5d685f48d01a26f53a60bbddf0c9ad45c4cf6471bbdce7579334842e2bf0fa9afae4994eb42afde7f3df9f967632975c1a72362ce62b42ef4af317022feea80e83194f3d3e5cd2009d8840e28a2cf50ae0bdddf0cf6e7e79f8da5ce255f9ac47a32d1bad0fbbcb0346c938e10f5b6727e3af6f85b937061a1d785ee6f06fbf3f3cfc652df6f6c62e61f10b5ca725de87d5e988dec783642e485e4fc747933488e8b891d2d26eadc905c17faf4f4ebcbcf3f1b4b7d67f21edbfe3ba2d758a60bbfd1cbcff6a989b9d7cdf8e9f466704ce299493077bb992ef47833d89b9f7f3696bade8417119f21ea5fe7bad0fb6ecbbbdafed240d46acdf9e9d6bbcf9ecc0664adae739beb428f3783bdf9f9676329634df17d44269bb2cead67dd7db91f295eb00fb7dffb67fbe28677dfec6f266fc1dfffccacf18f5bbd21baba66f0bd4f73cab62673c6032f320efbddea27e7adfdf7f8f39deeb603b7d8073fc880ee565f342ac78c5f77ab2fd71cfd993de77fabf6ccaf376b7b2ab187e46b6f053faefff9cec26054ab3f72a01fe4c77dde0e6abc54b8d6f4e89977e7fd6e55fa545eb83d2763edc34d26b7e7871fcf0c06afceddf7e2f81031e776d85b8159fa781f64f0ea90ff7b13e13f56f73bde70f545cdcb78d1c68cf756e0bf0e4b1dfe723f48b07f1c5c73fe06eef0fecf2cd9fb12fc30f8787d5e96ff9b867783bd7577591cefaf29b6750bd847fef7065e633e324c6b6aecadff69e2d6feadc27e48bf6de017bf6b8e317e59dfe7725bfd7893cff7646f9b3d3e1cdde16ae09a03115da3ea9bf917330f03042fe317271dbf3156a14f8df1c4748e9adf17e32af94ce476b11fb3b9de5b77d78cd642775219967f6fcc55f67d4157f99be6fc6538aeb50d6bf37f660e1bd4ff9e8c2be33fd3cb8c27399f195e4143e3b7d6fc67ffbcfa71d71c6e98cae592fe5b6eab29bdedf103cf0cfeb0bfc6b76b8e839c9788edc8d75bb8e1d78fe2df2fa51d85dbf875f7152c8e0f26dbcd131b3bb89316f6a88d7bf8f1c2e08f6b8e07d4b9f2f8851cb2ef13dec2bbc16b894b3ea9b3fad947e5dc8c5e76f9bfc59feff6e3fdcd7b7e7fb7870fa3d924a1717fa47fdfaf2f8ff7d57ffed357f27f6793e9f5edf13e4a7f9fd067ce0fff3bb93fcfaaffbeed09fd2a75c73c1c9875377c718c625d3bbac3f74bf1efc9fccf26ab04d34c305fd6093e80fbf794fcdf77fb23a133fde662ef1e46b3e7f9070d9f3eedc7eb7bf5dfe84a8ee5da13bf5ad3933bf42eaee5957af0f66814fffe27c55d3f24983792603e0ee2841eb92cea74cf61ba279bb63c7687332c8fd93595afbf4d7924308fb7fffd7b4de7cf7e4e64f274cd68d94a6859b66959cf47ee23a81b513afe6493633f45b05c66c6ffae799d33bedc705c9d7138fc57671cb3dd321d27c125cf321dbc3705f83ffbddeafcea94b62890e77d825f9f4f931cafeff67a13bf3cde47b6bf496543fe0af4a5765ee0694fff569e650e3ffb701bed265fa54ebd5a7766754e11e85b2a5792ccf15535bfa93e7c2065d3a6e5e23a4b253d99ec99caae0a9aaaf97a1cdc609d50d06521e4f40fec32a361ee2d2abd1ab993af4f77c840dd4d6490d9ddcc50da5db176e5bcd899dc0c89dc0632b9d91f87f322ff77c93a92e2936ccc8b2d93852f954566c3be5c1e9d6d59a417c3edc0b5826fb656ce4166dfc34a5f894fcfb3c9936147d777db9f19d97852fed2bd5c2ecf87ec372d3fb3a025f753fe1fecb12d5a22e3c2264a7a06e91c7da8ecb7a029d19dec1be91e2da7ebcc1072caf7b2dca688d2a61aebd687e43cf057e04fd7f6e3222ef0ff7a5f6ecb6d10bd59d7c2bf87d7c98c2e235f5b6e125944525938c1b71b6e23b93c1e729fa3a91b1df5b5dcc3d573a0bd970bf84bcfe8727936cff1e1ab733bbf38c4f03ee681fbb1ccbf615fae75fd33b38a7f5f4db25847e5437be1ddffb1bf86bfdfcd23e68c07395ff1fe79fef36a6e24dfae0e34bc059c3f94ffdbedf0e6dc196ddfa4f87b238e30a0cefcccfe1a67ba0b7ef6bbc4f7cbfe5ef95ddbd1fed148fdb1e21bb64b7dacf3abb34f7cf9cc576bfede4ff829f7ede755f0f6bcbc50e7f6c5d2180409d2d84ac54ffaf7dc77147f53f871cd71febffd8e0c5e1d3606682bffce7d37f0c2e9b7377c3878e1f67bffc8c5d89e571175c6b1674dd379e1be3dbad6d47f8d5a71b9ab5df9bc191dbbfde7eb6e158873699abcb3e1fcf096bd0513dc7bee43ea309fa3e3c30ba7e334b782a7ff9104cca2512d072997cb3ea2bb539a2f21789f14b1f5212ce3f24dba0622baa8393f11677315d3b511d175b0ad6940e37b215dc417d0157bb7c5e47e4cc4f21a8ae822d69341d655cde9265def02baec884dec21a96a0334e85aac45744d0fb67f1fd198d7e1ed29cd7d6af39ee7856d381d99072ceb4b07e4c12c871c1f117396c01cb280faf3a3ddb2ab84a6a590261abfdf169327114d865817372659577557ea3409e7cfdc18b6b989abd8619d264f44934fd6f6d5e67524a36924a269e1cc221acf79fb486912cf9d37b0c3a7887234b9c3f9c10df7013c7fecc07c5e77ef92b5e223aff72e9ac3808636af8b3fc9fac3cc0d348f4736b1476c02d1279e4b124e4f64bd81e813cfa7b31c2dd6b609d1279ed3d58984b3885f2b2afac4f36a4f36a6ed3f41f401736b0f6c7f7683e813cfeffe68c7cb1baf736fcef6eca6310d707ec3d6fcee82c0b56ee9390b98df909a55ed889cbe31736ee7d41713cf6fa247a305481f34bf9b2b71f8f5a3a40f985fe22fd6ab23449f787e97e6c249f603883ee1fc1e49ec8d6d8742f489e777ed1974fdc4eb5f491f64bf6432bb09f6aab427af2dfac6a4d734c714fa462807db643e3b9135f08d686ee3e0c0d6ec088d4326a26fe8884decb10d8c438432588e68381b2ea071847ba93d4a650d8d23e487eb9bce7db310f2c3f5a2e6bf11f2338bc87a19f36b5ead87afb2a76d71b62b7ba20a7494eb179beb68d5e7b44d17d77fb34997f05cc4f7516cd2253a17b57a1436e802e6b2d9f3ad4997f05cc4f7ee6ad2253e17717db11a7401f3d8ec3394d355eb8fa3ec17539eb38b7e238279e47ab1e47b49d54344d9dba24997781eb91e054dba84f3c8d7ff6fd005ce63bd9e7a932e601e9b75b19b7489e791ab39dda04b3c8f5c0ddf9caeaa9e89700f0d684807b57e49f93865cd15e11e4a6372a20e0b387eaa5a9fc23df429a6f57ed92dfa447ba83d647ee0b7cee0157dc23d94f874c86afd9279fac4f3ba3d107f7fe2d7e78a3ed11e6a0f68bcb951587ec23d944cec81bd3ef1fa50d52412ce2ff1ed989a94f715cab73ce2331275a8d19adfeabd91f88cb40e4e0b8bf17654d5b910ceefd2a42169cd6f459ff08c7424f16950ebcbc3d3279cdf854387b43dbfd5bb4be1fcbe5f89336d9d0d2afa84f36b527f69d2985f4f4bfac4f3bbdec4340e40fac4f3bb1cd871b2de73f4556f8e946f708a71ca371ca23373f37d4bc14ff92e43f95ea04997701de6f3be9b7409e30c7c4e75832ee0ccdbcc516dd2255c87f95cc3265dc27598cfe36bd0059c75b9bca8f23ea4d88b9bb1aae63d4589b37f9e1fdc0f72a5bb3446598c9fdd9f04d73f8dd856ccddab88635cfcb7c3572b18b4ee649af10fe8db3c3693be1903bf2789df14da0a8c990c838f8b0118b614c30e37a39a9f08602c65183e0d97065bab3036320c3e9606605009863db4d7efd7c55a352f9e0c6340d71b8354673100e35d86c1c7df008c930ce34693b987f5238b9ba5399e621b29df3882b656c6eab87b69386657dd27d6e35e52fd0a99338ba83f93f291bd7302f9f01793a7912de7c390f2c1c5f9c47cc8748b04644d02bb7aef26e62396f1611b767c1f57b9e2423e46323ef8d8a0980fa95e0dc9647eb0abfa4c3c1f552c4fa55be134047969c61165fad58827f2fc543138b98ed170192f2c701fa8e27f523da326759606682fcdd823cc131783847992eadb894dd8d186d7e58a27b9ce5dc99a46a0ed34e39512bd6bc62d619ee4ba477d7b603ba00d557146a5ee3158f79a314e99ee35629d3c4f557c50a17b0ebd2e2627254f2add63eb7b439063258a8b4a74af191f857992eb1ef183e45ca0e649aa7bb321894fb7aab623c8935cf7b8982acc936add5b46b6f48cb20ddc6356bf00de471e9273df00a2a51e7f95d864330edbb2c97dc49cedb75cefec31753689ffa5e26720e387f887931d9f40bbaec76c417ef8d82dc88ff4ec162faca71bf1413b2af819caf8b1433a22ebcaf785f859c86c918ff782fc48f52db2fdcdc036c1f9c9e394521f21c86e71c0bdba8a0dc3187c8c18c090eadac876362683d7b81c43e623d803162e6f3406d7942a9e2cd591465c19c090eb99bd3e0d176b151d521f21a2f1fe404cf04c5bc5a025185c2c1ac050e858b3b77fdbffadfa9d833ac6f5840730e43ac6c5af010cf97ac6f5a00630a43ac6f72e0630a43ac6c7bc010c958e357aa502180a1d6bf6d80430e43ac6c5c9010cb98e713dfd780cae971ab4e7f1bdccf835b5115307d7773eb6ceafef5cdf2438d6d0ecc304f2243db3b57aea803c49cf6c7c3c1ee649aa737c3f109827d999add5db01e4497a66e363f8304f52dde3ebd2f33c7135ce417f81ab31ce9f431bf17ef8ecc7c5fd5bbe77b39e317cb66ed647067992fb0b7cad5b9027a9eef17705304f52dde3eb74c23c49758fafb908f224d73dee7e01e649aa7b7cbdb8164fb5ba60b218b12189cfd6eb4949e2678d3b090043151f69d46a0230a4315ebec60f80218df1f2f7180086c2376dd6140130647b6bab16058021dd5bf9bb0f0043ee93426fdf8579bdfc3b07457e6f957b5edec11fa879e672c16bbed3e45e506722cfc3300f5f7b8bcf236fdeb541dfd67281e1efb99c600023926238abd00e4535f71a18b114633d0f161605e550cb1f0631f83c6200c39062ac1f0eccb15518a60c83c44f237b7212d4146ae51cc3185cee3180319261d8e13226b1a88f5803632cc5709e227b3207f5a3cc53866ca49dafccdb5a769f52abab04d4093c096a46d6e3f772fd0ab7473b9c096a0172b9cd123eb81c67211ff55a618258a341d2fb10291f52dd62eb4dccd6a23a765c3e34cc079f172de4a35ecf46909b78b4433b12d461aaf321d52b122f23ba9e0b6ab60a72a8a5bad5c8a5e679a9ee5454fae5ccfd45bb7e0c1fab57e8d8fc64c707419d1841deb584272eff1ae449ae6bdbd362728a0575d3789ee4fa662d4deacfc0f5b091ab2dd1392e671be449a1773e99cc22416d709e27b9ee99b3984ca6a00d35f2bbe5ba174a74afba5351e95e48443dfdf858bd42f7a6a13d791fab7952e95e23371ce449a17b3e8d4f03aae649ae7b133a4ecf054a9ea4bac7e793833cc975ef3424ced30db4a78a27d5ba37b0fd27d9f9a2cc3d97ec23cd1cf4961d1471f2997c1f08a929eaf1c0c5fda57ab770a6010967e0d9b79eaf0ecb96cb5b07f9d948f8b1070b6b7e2471bb2f2bc78f54e7ecf870b0d714b4a37a8e3bcc0f97eb0ef2f32ee32722311d9209e8a314fcc8cf6ffecc201302ee49b5bc78c999a3991f0f60487d04eacf43528b770018725d9b24bab604e7a6964b2f39573773ea010ca98f60c787a31d4ec133718ea1d0b3a731894f2a3aa43e029f870f60c87d04ffdd606b2ae8e7d1c050e8983d606b519ded56cebec4876ce6ee0318721d0bb7816dc23e538e21d7b1f583cf84bdb25a79feb08e71f9fe008654c7c864365e381b705e720c958e8d6c61cdebd6db00898e35df0800180a3fd4366cff5da51f721d0b9f06762cead32f784f00ef79fcbb027e4dadee54a467363b66d63414d42ae763f5525da36b7294adab8d3708f03ec1bf450079929ed9e07ef73c4ff2d807d8bb5cf06e41b29773ef17409ee46736b0ef32cf935cf7c01eba82b70eb2f841f3cd03cf93b05fa746ff4f3e562ff717c05e8e82f711d2b375e39d04c8935cf7c03e743c4f52dd837b8a09de54c0bac7bfad007992eb1ed80f89e749ee2f80bd6d5aef30a43162714f9406863cc60bf6d26860a8e223400f86d6db0d49bc1aaadddfc090eead70cdf70686c237856a85b7de7b48cec4508de90686746f856b133730143e69b3a66daee7a3c2365878f7bd7f34f29a92f7d717df33bc707be17e1fbb7fa1dfbf0fc96e1eb8adb789d39889bfb909f0cf4c4ccfedc57f8a44f86fd6b5a8a1f3e37e2c2f45dd4afbef48f87bfba3ac9718bae678f052d4c59c3c7d0b7f3f847fbf988864341880341dc534911d340689983368beff59b3b2c62227a3419d96d7ddeae05a81cfe10ede9c5bb0383e7cedade9f9b5caf5fa7687cb8be29b6f7738bfd46a861adef343e085e4e0999b461d9efcfd5098fc7e717ca8ffaef8f6ec0ef7986febbf2bbef5e92ea77ff715346af9e4ef9b587817a57559cde935e7e94f758e1bff78a171701f0d9fee88f4fb465dc68fed37b3b6515a4bee6f51df36d5d91373c88f174e8b7ac5861bae022fbc7dd5ea3ea535a95e76ab4f2fbc3398f9dee099595bdf1dce0f458de66c0c63f0fa3cffd93bfbcfb2ee6df83f97bd333eb1ddbc5ea7323fe7ac026ade45b4acdddca83b55dd9fd6bee77205c438cf35bedbb9033c5fcdbc014dfeca5c8270fcf3b2ab8fdbbce305e40ed4d56be034ce031af3579e0f5ab437cf06c05ca45897bd7588dce1f6e3b57daefb7623a33e6e731d87e4d8a81b3afdde5b41a2eb07cf2c6a76ddd7ead2176b91f1e30e579fafcef863717c38b81fab2feadcbe125b6093ec9bb4cfa57317bf3d1ac51a7071cdbbc16bc27bfcf99ede29ee884f9375a1a8013bdc1e3d2b1831e77aa1ce78f09ad6d59ac6ccc96b74d56a7d17eb96ebdcfdecadc3971b7a8dbada796dfb1f6ff82eff6dbe2e7ae1d47f35836f1619835767ffb9af6ac69fd96e3548ebdb0f1f0ed4cce87f59df7fdb936d7d1d8ea843fe2f91c9cb6e95d60e537f3f33ea6b738d86629dccf7805bb07f4e6bc3c79e35f533b9d76a553fcf0fd4bc247c5df666702ad616d7da0ed27af03b72a50ec9f89e48c71dbe3a550f00f87b88effd17b3b67151df0cfcdea9f618cfdc9e994306791dbcd63cef7724e59b39b7800e97d59a74bc7f9f3d96f36c78663a4ee05a5b7f3fa9f43af94dfebbf865b78dd379c8c7acecfdf3bdf85df21f38665e8f37fff74b66fb19afb59ea12d5ad2df4d4a5af21eb02d5a52bbafd192f6ea6cd192d971c97ff6df0338665efb2ffbf747e3e016e70aa98ca767574d6f8255a323edf5d9a2833ab7b35abed9783cad552dfdda1a24a0a3ac279fd3e10e3f453c956b8394deb2be7d4dbecfd098990ee73503b373aa93aebdf99acb022f591bcde9b95623f048d3f1ea7b61f6b797ddeac72beade87db686f053e4dd7c2a226e1eac79b70df38c617b356e7f23c21faceca7fc38fe7e4f5e7a5df1635ea5bb4e671dfbbe20d7955433ed5b3e023d95f8a3c297758de7f667f3b1a454d10350d456dc6a28e7fb1ee8541fc9ad6932cbe4dfff7976b0583b7f5e77bf9f792f640f9cdab159c98b5fd2ec748f3aab6e9ba9bd6d9afafcbcf59bdc57c7d139d6fb337eaf9df9335ee65b78fe8ee5ef27dfa77f1f7bb87a8a43bcbf7caf794cd9fd9f343ad7e56869bffede23dcf8397865eb6c6bd7ae1e6d2d04911869cf614633f9c1f2a1d9d47cc99fe5fc277765ecef785e41c16b2f42e35fb7dba5e47ae33cdd68ae7fd8f172e2ff4631e50e79caebfe9f73cee2ef9ddaa3837a5e74916de7ef69131f4c2e935b1352fdc1e5e2363e80ee7a5ef9be326fa55f84ce9df5f9c0cb7f65db6c615fbe4c7f69b9ad3b2cf498d8f3f33eb90d858e0faf5335c7a160dddac466de05ab72fef997c7959ff8c6c6eff667b3acb7c858cee42f7a2b23f45b22f0f5ef35e1fd9fc7817bbd485e0b4b7deb3bdd5dfd6fdbde1cbba3847efbfd8f3ea33f3b3f9dfd882df707e693418b46869f8a1de85b4e87912d043f9b16e6d7adef9df441c2db7b23f4839d68cf3bdbd8bdde63de671c891c759f1f26bcd416b1c7fc98f6372e3c4766b9c0337ceb23dd7eb07fe37a3f66fe6dc6f3666fb373c4fb43d976bd6fc4da3d66fc15773dfdb47c6f5ad350fd3eb5b5d47b7d79f36ef9beb7ccdfd26e27f63c72fbe1da7ff2fdad4689f7b2f714b5f4d721c99c4a726f1bda03ebfbbc7ff69f3ea6feabf8944bf21ebfbfa6f62e16fe20d3f96d11eeb9e1fabf51b32698dd5fe4d136720facda28993d023d005fa9dc9890e1b73105c7fdab600cd01f15ee2961d1bf663fd372bd16fa0793244b42e26f6773e7adcd297b66d8f607d798ad0723084d811f9cbf326c08cc5f41231bd03fbaf5016e6eef17f6e2a9b21623a8dc5e328a7c0ae638ec498d47cc964609286cc02217fbcbedb53f13cd89190861b306f63b11c8c705fdb9fab79bb6fc8c133499a2323faddfea37cd7f1a7ea7ff599de2db2dd7bbd67d49f97f553b1fefec9eefbb6a3646faaf6dbcf8bed8b30aa3befb7e3202ef6a5b7637af718bca57a511f67291a67501f870869ddd4c669f419abe1d4d7e7af7bd7d99e5e9da7f7ffb58c2ff768d4d692e5d57b7effca7dab6fcf34bcc507895e9cbb6ff7f994fb87b23e6ed9dff9353ff595ac4390c64fd2f3c1c36851624d4f2c8ffdd8e56f8d8317eebf123f21ede350fe7be3dcf4f9eaac4ecc19e5bedb83ef5ac135eb1347065c6dffaf8a36ee7c6c8d7f8ab36936c63c393b263e62e36c357b7c4864fc3e7b1cc7eef6fac70def062f3b856c6abdf3327f91df2fb36f5ead6dbb071a4236b6582ee6eb8e7cd1f056ce07356f5f1eefc3ca7c94e3fd7ba273a50e54b1c4d83b1a3f6e781b376393f77c5fbdaaf7df9ae73ded3152ccd927738a3e6db5797e7e38306b55c4858adf5ee86efef1ea8cfee4bd408a7fafcef771dd4f6bf2ee855b7fffd8ec1bf2bf6b992c727f2b1be398f80189af5f3b53cbe494de7978c3d9c535c7e7372e36ccf74df486ab43e2dbb6eca7119ba8c9aaf9ef57eaac4e55ec2fc3709d69c4cce0bbf16f75bfe4997d79667074ad0d6f3f0d1d14d94be173f13641770f57e64c23ea8cfd66efb9fb465ca9f1bb753b2657c46ad4bfcbfe837fc7d1177a97bc476569af348d71ee2377b8fd668fc661ffbc3a50f37049df631d1fc2d7dd218f5db7b15cebce2f7d7e35d6d93589586e61b65fb966b1afa5ebd0819a970f2fbc33dcc4e7ddad06d4219faeb3fd4ed6d0e2f7995f9cec21f7ef2f1fe4b29b7c1d5924ee2b91e83175c8ffb1e1fc67ef8c4f2f4e7187fbc0bd5713d398bf4383f8ade52edc5f456b264de3d0a93ea931f2de14c239e47b544871e89085d3305b8341ac0887b53d317f1e1239568cc3aafa5c48b006382c7264936da0c03270584f57ea6c7d059689c2aaf5cc90600d7158ab03596fcc859cae110e6b7eb2636fa0c01ae3b0aafe1b42bbe6fa70c03658e6d918804d97efdcc035827f7b3cf1047bd4fca3f6de0d6387652f0f98bf25923f7b64afdf6f32feaa1af372fec83a7d0b27e30fa7a3b5be20307f1e8a3f1a6f627b625f65fc5575ea65fcd963eacffdb48624cc1f523fab1e23c23d4cd06b44a5a369dd34318f8df772383ddd1e69fc2ee6b3f96e0ea3ab65bf1239af487df5d9814d36903d36ded121780dd8e47d6cc754c52b526fabde27725eb1ba7b6f90b44ea09c57b4fec6cb287daf27e715a9c3551f1511afa27e2a2a1d6621a8c38d7777381d667e9a6b2cb4d5c6fb3b8c0e973d59e4bca27538b027db40c52b5687a9698f893553f18ad4e1aabf8b9c57a40efbde70b19e862a5eb13a4ce2edc15e2be715bd0e17bd62803307d73346ae73b6959c8f97807dd5deefa16c9f85245c42eb5cfd1d1f82cfaaef8c8c4f829b0373612de3c51a5a476aeffa107c92c9e1487d6fa4e01379b6ad7ad848f98c517c46cc7f3788a3e2f31dc5a7ed7b26899763059f48bdadfae108fd39be2f8efcac95568085ce22b5778008ac59c4fcecbd89040ba9b3556f1d0916ce178b57219b4c7d22c7c2f962898ef9f303e45bd4de0962b0ca3e3d122ca42f36f789f934a4804f507b3788d1af117136a62da70babab65cf1f207ed1ecfd23f7f58fcc3f803188da7b4204d63c606b1242fe66ed5d2182c7aa7f90040ba9ab34a63e01e35bb5778608ac65b4580701348fb5f786385dcd7b1149b0b0ba7a24213942fb6eedfd214eefd7b39142bf90ba5af535126189fa1b49cf79ce3c24e9fb57e19adf789788385ff8b6b93ab010f0af9bef133131a5b247929c57dc999698744c4d304ed278af883963107f1f3070ef6dbc5b44cc6bd56f49ce2bee4c4b2cdbb09db9725e9167da41e2472dd6a2bb8b06af481dae7a37096327821e4e72bf6c1a1207d4e1c6fb468cafb2709623160267e4e63b4794af52f48192f38ad361ea7b6392be1397f38ad4e131f3f70750879bef1f31e7a1b2a7949c57a40e87b301096d28b6d0780f89e0d5206be22fd4bc2275b8ea4f25e495ef53a5bcb3d882f703b57792a8b82b9bec0330ee5abd9744c5c58a5e57122ce45d03351793fdd196f388bc6bd89e88bf3a40f703b5779418fb2cfb6649b07067067336b2e3d9083a33d4de5562ce6b43dba72674c6aabdafc4c410ca1e5c3c56f5be29b101592f2e896db5ea51b6ecb57ceb55bb0f6cbecbea702fd8a43dbb8b47d35cf4f36aad05e53bb2dce715f7f592c7ce8ada967239d46b5ca26521b94b6cc8a3d62b5f4326556f30945cdaf563a57b575927132597761de38ef78f22b99056bf0ee9de51f51943c9a55d8b567e562b6a6ea2e4d2ae85dcf1ce52249745abaeb6542e55cf32b95c80de654afbccea7762b0b5e65376cf29c0d6b3a1aaff1902bb5df35b8e5dd602c5606bca1bbe1b1560ebc9bbeaa586c1d6937755571481ddee03d3f53e5580ad673b555f3639b6a43f9bca7ef21aa5587c6d1b82ee60017c4d3b2a7bbc21f1756da9ac778ac5d793bfe4de16c0d7937fd52f0e8baf695765ed5424beb66d8177bd00bea67d95bde7e4f8921e744afb0a11f6c5d563d5b12fe87e18c0d7b5afa28f1d125fdfbef29aae587c4dfb82ef94017c4dfb2a7be261f1f5e45fd58745e2ebda177c0f0de06bef5f797f3de59918e8b3278f1794b56655e7d65acd59bc2f02df5df367eeec7e5d6b6e6bbdfa70b269f7ec93dfbf94756b71b269f7e8e878df2d948da62f55f5fdc3c9a6ddff4feeef94357071b269f7fce878472e948da64d553d04e5b2017b094ae312653d5d39edf5baba787b85efd5b91853409dabae3d55fd085172d18b4b54b5795172d18b4b48eee24572d1b5a5b2b7214a2e7a7189aace2f4a2e9a7109f8fe5e24176d3b2afa24cae502f64b54dd992ec477a67c9cd310f618eb78e7df5cbfe6e7bda3eb53d57a2ea2e4a2694765fd61945c34ed08ce1310c945df8ef2fe8d28b9e8da5151cb182517ddf81e985b20928bae1d95bd20e57291f484549ce18bbac8587cbd33bc241f01c0d73bc3577d2591f87a3e54adc632165f6f7e25390c00be9e5d553d2ab1f87aeb5955af1989afe94349f21e007c3df957fd2ee5f892be974a1fdf40d81757031a6fbf70ae0480af675f55ef4c24be668ca2aa238dc5d79b5f497e0580af675f551f4e2cbe9e7d5535a991f89af625c9c900f0f5e45ff5f454e043bd3d11efee86aa7dbd5ee71a7f0e84f3389ae7e3872f373c7788ade7fd415172d13b0756b5b25172d1bce785733f4472d18ef915bd465172d13b075675b75172d13c07c2f92222b9e8c6facabea570ed82f6fbf149ab7e41e777e465eddcfc2c9be0b46a49c0f45f6d79dd85635aabd40a067c3d5e95fd0be6b2accbcbbd396fd6cfedf8f6bc29873cc7a3d5b741712f65d128ed512c97479ecb60b77a16c8634c453f55945cda35f73bbe5717c9a5ddfb417196b7a60115de238be442b5e452f56645c9a55dbfbfe31b77915cdafd23146f8c26f69008cff022b99cb4e452f57995cb05eaf7daf15dbc00bbddb34e8ebd3d117329dafb05d89a3654f68cc560b7fba4747c4b2fc06ef7bd93effb1b93acb1f2d6b39faaff2c06bbdd67a5e3fb7b0176bb779e0c7be1cc221a63f55bcf76aa5eb6726c594fdb8e6ff6017c6d1b0a68688bee99007c5d3b2afae262f1356d097ee70fe06bda93b31c2dd6b672cfa8f0356daaecb18bc5d7b52bb0360080af6b5bf6c0d6c2d7b4afb25faf1c5fd6b7b7633d01005fdbbe426a0adf0001f8baf655f4fec5e26bdb17548300c0d7b52fe22fd62bd1fd1680af695f651f612cbea67dc1750b007c4dfb5a7b46fa561d8dafbd7fe53d89956762a03771d75a07fcb935cd3368f72193fa22b6c97c267cebc49fb9b33c033ddbaafa1be364d3ee73dcb53e825036edbe66f2bbba110d67438c3f92c946cfaeaa5ec948d9b47a2677ada920944dbb4f9afcde7b487c6f207a8b23968da63f55f65d96cb06ecbfdcb10e4383f622c7432f2e71b4436a8863e5cd185396cba0694f650f67945cf4e21292da0d22b9e8c525ec7861cd22ea88629222b9e8da52d10f1a2517cdb8045cef412417ddb8c462b20d88307f5824175d3b2a7a4bcbe502f698ee5823828f73a6391e9a76c49c4dcc7c65bc26cf65d0b4a3b24f354a2e9a7604d79510c945d38e06b67f1a33f59e97cb45db8ef29ed728b9e8da11588b4224175d3ba2f1265a4c4477d022b968da51d93f5b2e17591fed8ef52b007c3d1f8ac6e4441da69cd70a5fcf9eaa5edc587c3d1f4a52f302c0d7f3a1884f87ccd96ad0af19372ffb7a63f1f57c28499d0c005fcf8722137b60af4f4a1fa7c2d7b4afb247b822be28e915deb1b60680af19a35807a785c594e7d50a5f334651f61bc7e2ebd997a41e0780af675f0b870e29c2be2a7c3dfbaa7a9763f135ed0baee101e06bdad77a13d338d0908f668ca2ec83aec087faa177acfbd13c1fe7391e7ae7c0ec8db33007bab1afe7b90cdab1f5bca73a4a2e9af7bc70ad10915c34ef790336a1032a7c1725928b6eccafe8cf8e928bde3950525f442417cd73e098c433930873f94472d18df515bdde85b57c8eee703570cd01248346df8baa6fa310cb67ce7820c999696299dbefbdb515f97909d699396c00c9aed9cfe616b00fa8ae6afab7b2dfa4069e8ad794be7a9fcfd61cec1afd55727eb2be9fe2bae569af1094ecf2df8a79decdd3de347c3f4e057d51daeb27ed9b92ea831c1bcf77819bf50d1562a2b12418f3c0b3b607b06f46f1f7acafcc953953a88ed7b71b1911db3d047407ce45f1f7b4b74a850dcd6b36decbee5d8df75cf06140356f8bdf5edec0796df3fab213e58fd5e566e4b46030539b51e1e57d561178599f1ac07e6bbd0bdb728b5f775f41a3ff8d604d92e608eec8d75bb8f933b3489a8f076298e30175826f70fd30833f6c9dac6977df7b6bfae50aeb63dd45ccda46d9da7c3b78cf24a8e1153dac923de74c770f718e7776ade9d513cba7f82666bb87831756fd4cebfd8f6a187f66cf24f0ac69448bde5f1caf65cfa4e7f9c1fd2057ba9b07ee47dd0eea3c60fbd014df64b98300cf8a3e3439465e6b4a8a01f6a129e8c873f79a75fa0438b23e341c96afc292f5a1e1b0621596ac0f4d138babd92dc092f5a1e1b094f292f5a1e1b054f292f6a1e1b054f292f6a169622d54f292f6a1e1b054f292f6a129f43ded1bc2d532e46d50de87a6b0e932d7ee115823d47d680a1ecada4c0a3b94f4a169f03750f307f7a169f2b751f227e943d3e04fa9a3d23e340dfe862afe647d689afcbd2bf893f6a169f0a7d64f591f9a8287b22f084a47a13e34051f8d7c36a59e827d680a5e1af58f14ba2ae943d3e255adaf923e346d5e953a2beb43d3e255adb7b23e342d5e11ba0bf7a169f38ad05fb80f4d8b57b50ecbfad014365af60541e930d487a6e0a59133a6d461b00f4dc14ba3c6904287257d685abc627418ec43d3e655adc3923e342d5ed53a2ceb43d3e255adc3923e346d5ed53a2ce943d3e215b30ec37d68aa334796b3f2a4d439491f9a62ec7a5e96c2f6e13e34c5d8f53a3e723e657d68783e97aa3990f5a1e1f934547ccafad0f07caacfb6b23e343c9f9e8a4f591f1a9ecf918a4f591f1a9e4fb5decafad014369ae72b297d0b591f1a0e4bb976c8fad070584a9d95f5a16962297d31691f1a0e4b297b591f1a0e4badaf923e341c96525eb23e344d2cb52f26eb43c361a97555d287a68a5fa439414a5d95f5a1e1b094ba2aeb43c36129652feb43d3c452ebaaac0f0d87a594bdac0f0d8785d155b00f0d87a5d655491f9a2616226e20e943c36129e525eb435360957d41d4675a491f9a62cd6fe4f0c8cf17b23e34052f8d9a2f8a9892a40f4d8b57e59956d687a6cdabea4c2bed43d3e255adbbb23e342d5e95675a591f9a36afca33adac0f4d8b57b50ecbfad0146b77d91704e197c17d68caf8493d4f46e1ab48fad014bc34eaaaa87c15b80f4d8b57a50ecbfad0b47955eab0ac0f4d8b57b50ecbfad0b47855ebb0a40f4d9b57a50ecbfad0b47855ebb0ac0f4dc96b963fa23c33c8fad07058ca3383ac0f0d8785888bc17d689a58eabb06591f1a0e4b7dc692f4a1e1b0d4b104491f1a0e4b292f591f9a2696facc20eb43c361a96308983e34c5777c2d91569d95966d69f5a169de070a6aa1a0ef0505b417b544d43423fad0d47cdeb29648bb2e8c287686e943d3be4344c902d787a6e6fb97b544303241f5a1e1e402d45016ec5da83e34ed7b479c5c507d6838b950a45c707d6838b900f5934567354c1f9af65d25ce76507d6838b99cb07241f5a169de61896b7d01f6a9ec43d3badfc46223fad034b1d13684ea43d3c416d7f9126163fad0b4ee4491d8983e344d6cacfde0fad034b1c535be443e27a60f4deb1e15a7dfa83e344d6cb4eda0fad0b4efd074ec47dd874678f78ad673751f9a363ede8e307d68daf8785bc2f4a111ded722751ed387a68d8fb629541f9a363edeae307d688477bc58db42f4a169e3e3ed0bd387a67dbfa7655fca3e34c27b61b47da9fbd0b4f135ec0bd187a68daf635fea3e34c2bb64a47d61fad0b4f1f1f685e943d3c647db17aa0f8df0fe19695f983e346d7c9dfd4bdd87a6750f8c3ecfa3fad088eeac71be08aa0f0d7f1f8bb52d5c1f9ad6dd31fa4c8fea4323bae7c6f923a83e34bc6cd0be14aa0f4d4b36e23abb227f07d3874674378e920dae0f0d2f1bb44da1fad09479dab55a22a8b804aa0f4dfb3e1d67afa83e34658ca9564b042317541f1a4e2ee8b804ae0f4dfb0e1ea78fa83e349c5cf0b684e943c3c9051d97c0f5a169dfdbe3e482ea43c3c945c38e107d68caef6ab544507684ea43d3beebc7d911aa0f4df15dbd96086a5fc2f4a1e1e482b723541f9a767e004e5f507d6838b9e8d891ba0f0d27170d3bc2f4a169e71420f73b4c1f1a4e2e783bc2f4a169df27a37d28541f1a611e02d68745f4a169e3a37d28541f9a363ed687c2f5a111e62ee0f0517d68daf868bb42f5a169e3637d285c1f1a61be03121fd387a68d8fb62f541f9af65d373e4681e94323cc9140da2fa60f4d1b1f6d5fa83e346d7c748c02d58746985781dc6f317d68daf868fb42f5a169e3a3ed0bd58746988b815c1f307d68daf868fb42f5a129f16bb54450e740541f9a76fe06ee1c88ea4353e6add46a892063ebea3e349c5cd0e7405c1f9a76ce07f6fe1bd18786938b46cc0fd18786930bfa1c88eb43d3ce13c19d03517d6838b9e0637d983e34a59fd97c3f2ea8b3a2ff8ebc5667a53acb5a53ff356ed74211d0afec4353d26e1ebef6d6064133a60f4dfbcdb9a02e8cd6db73811cf23b7b71fd2fe1bd14a20f0d97cb108bebe588624c983e34ed77ea38b9a0fad0707211d7fd129ee5117d6838b99858b9e0fad0b4dfb6a3e482eb43c3c9455cef4be40362fad070721963e582eb43d37a0f8fb64f751f9a0636508b5c848de943d3c0c6db10aa0f4deb0d3d121bd387a6810dd42017edfb983e340d6cb4fde0fad0b4dedda3b0717d681ad840edf13636ae0f4d031b6d3bb83e34c2b7fa68fb51f7a169e1ebd810a20f4d0b5fc38e307d6884effb91fa8ee943d3c2c7db13aa0f4d0b1f6f53a83e34c29a0048bbc2f4a169e16bd816a60f4d0b1f6f5fa83e34c23a0268fb52f7a169e1ebd817a20f4d0b5fc3be307d685af83af685e843d3c2d7b02f4c1f9a163edebe507d685af878fb42f5a169e1e3ed0bd587a685afb37f21fad0f077e9e23acaa21807a60f4d2b3f455ceb55e08ba0fad0f0790668dbc2f5a1e16523aea12c8aeb61fad0b47230b0fe2bae0f0d2f1bb45de1fad0f0b211d74f16fa3b883e34bc6c809aafa27b6f4c1f1a5e36787f0ad58786cbf140c725507d68b83b7b745c02d58786cb65c0db13aa0f0d97e3819e53541f1a4e2ed8b804ae0f0d27170d5bc2f4a1e1e4828e4ba0fad07072c1c725307d6838b968d811a60f0d97e381b623541f1aeece1e6f47983e345c2e03de8e507d68b81c0fb41da1fad0707241db11aa0f0d27171d3b42f4a1e1e482b7234c1f1a4e2e783bc2f4a1e1e482b723541f9a567d03bc0f85ea43d3c247fb50b83e342d7cb43de1fad0b4f0d13e14ae0f4d0b1fed43e1fad0b4f0f17173541f9a163eda87c2f5a169e1a37d285c1f9a163edebe507d68ca3bc57a4d0db48fafee43d3c2c7c728507d685af8f81805aa0f4d0b1f6d5fb83e342d7cb47de1fad0b4f0d1f685eb43d3c2c7db17aa0f4d0b1f6f5fa83e342d7c7c8c02d58786cbf1409f03517d68b83b7bf43910d58786cb65d089ad23fad070391ee87b5e541f1a4e2ee87b5e541f1a4e2e1a313f4c1f1a4e2ed87320ae0f0d2717f43910d58786938b46ac0fec4393f573b0b6a71767fce3869b36ce6e7e60566078f1e7bb88f7ac0fc32a608f46ec0a7a2078e1f4f4badbc68be3fdf5c57f8ac86e1eb8025fbdc25161cc0c2fdc8a7ac3d478b97d08e632a2ceea6bbfb3ffcc26f7df6a3a8c70efdcce729cd378b10eae6f72b90c60d9ae7ebcc7a29f0d2fe7c6980377785ff4a969c8432a87ddece28677dfa2de147bebee5af594918e5df4a5d11fdf81c72f7afce8d2e05adb70ffd898cbb6cd7c643d4698b3bcb88fc6b76b8e457d2b52994278425af39e4678f92f2f5ea3bf9362de1f4737dbdf46cc19e0e78bfb0692837714db2716173d77487a28408f0e36768e70eb8e717ed9918b869e7cdbebda5ad4587b8bfe4fea3986e4b317ac83e95c09f903e8c8f42cef03c5d18ed2192d7da9c918bf7ee0d673516e672963257f783dc1d072d5b167a54c847a85d20fe3cc04670a5847b4d715b52c92fdc5f2207908e7a59b8ea0d613a13c14eb4917fbc5e8884c2e72ccdfa463b7bcb08fedd9c5af25e3c5fa34f69edfd17a92fc7eb17b10c5881a7a22a543858bb67bd479ecf8e230c3b504768cc6c6da328a9e33480fa02f08d9c8bf01e76975ee765685cfbede517efed23f53ce239046f99e205f3fc2abfaacaa7dfe5bf53b53c0b8910c57bc86a2ce4047e89cf82b67bef02a3e0fffc67949c37e9afbb24c77bb9e7f64f2d5962d0a57b4b6f63eeba4eb81d09706f5172757c9d9a5eb59245d1784b4f63f5b84c07ede7b7f5ef55bfb65eb831cb7f3dee91dc1b8884cce8d73854cced03941a2cb3076ad4fa76bdebe84b1a5e16aec5a697fce1fd70a44772155be91358d5faded49c07bbc7f0ece6cfdf95efc468e93f0a2c2c97ea3c031687813edad759cf437d21e61c0be57cac64af75b897c07a21ac8c3576735789d88cf08a53eae05bedd6effc59e579f8be37d249fd7c140362e7916da49c9df42741e3583d3de7aff339b1c84b650d028e5576c9fd5188f4279a56b6a35beb4de7ff4b216d46f2fd7ce527ed27e69543c6fa51d2369390b69c9ed14893110eb41ba565572fd90cbd53b0e44f352ac75681c2ac611c8652ed591c40fb405fb7dbe6fa2e9d93f0af59cd397959c96ddec4284b1f56cad46e338629cea0c53eade40b1269f5fd6a2fec6bcdea8f85a8aed383d4b94b48c14b4442feb778c3da9708e429cea9c50cef942b136b98f839160cef3f3009a1e84de4c557a8391cb4d25179c3d296811c5de5afa02600cb79167de45adbee6f9bf4b7a99fbfbdd3c7687c97ebb0de96e7baec5668bbffd9959ab808553c37d16dde111c3fb203fded1b8ba66f0bd6fec1ff9dffccf77cfbafb723f568127eaad50d0bfdb1f5eebfdf12bfa87d40944b91025fd7b6b3b12d2febc0adee4745fdc210b4434bbce9d29ec5151ca7bfcb37f340ee9fb04b37ee6a96184778317679ad6c0648eb0f7cbd935ebfdcb575fdef0e14c77a73f332bfb9b80ef2f37bc7db9e1e642c3db0f35cfa5cc9815c49e753bbcad3fdf5f9df1f9d519072f8e1247fabdf0ced9da8e84343f1a43e6ccffef75979c79efca384e1d9785b740a4c715bdeccc76ef429a5e76fb808697affda3519381781c0e4f703e5f1d9a67cc1a1fcfd9df04bc0fa8333fb3bf46e40e3d218d7b6b7a74adcdc50bef44ef788bef7dd6f035da63bf382470adaba866c417b356815bedbfa7d71db9bad674bc383ea4312968ce5e76f39f7de99f266be5f6f86a0683641d7f7b347ebc30486b2bccac43f2ffffe39ae30175aed0ef4fafbb794087ab2fd71ca567692f340e6e1147acfd97ce39fffbe7f9cf5e988b91fe3691efc50bb726dbcde3ea0cffcb343fcf837d98d8f3a6f87df59f95eacd855941c41c327875eebe17c787265dc2dcfc947edf35ab35bda6b74777f87e7187f3afb4a7c9f121c119bc3aa426b7a60dbd38ab2f2fdcfa7b2bf871b33525729de9b9d0f9eabf876c8eb9df53f3eebc778c6f50271cf2e33e1a11dbb1c0fb20953db4e8b88b5e9dedf77ef2f99ec86c6f05fe7f2867df356f86eb8c30b21e7ae1b476decff0f6c3f9c14b684dffaffcdb975d495f65d3b5bf277b10f73b513e4b3aae1bfe4f3676b51fe5b6b78fe8ee3eb51388a6d7dd2a707776e29f1ebc8f25a83fd4b97db154ae644077f38140170eae157cb847e36b6f6d2f5efc59f013efad6924aa85528ccd9cf109d681e9f9d5d927f313b8794e4f732eb3bfbf38c1803946bc38163ab9fd16d690c9c7a4e6b6797680c7f513bb61bb1966ecc66f9b7395fde6c5991f3c739bee1388b18f7497acc9c1375bb7d7b87c4e2e394fc9fe5d628bf6de745e2223de27b661914fc4f8e56f31f3ed0d5711dbadfc5751dccbdc9ed9d148cf8595dde4f24b79a47f66567a9e14ec9be9df5b3a5af09f62fb6a7dcb6948f4488551ea9a888e96eeb4f8200174f6cc6968cf018ff14c065eb81d5053e40b7c19de70f5c53e4e4abbf5d2da42e9dc24bf13e8708545cdbb84af74bd55daeec736de5b41f8f6b7294f68fcc4c6053af4ffed77c9facbc6e99ef0b1caf7d57c7d2ebe69ea71fd9b4cce4770afbea6bdb9a4362ce6bf9863f9f8f360ffbc0f983352f25fca4b60c73559fac95922dfa7e3e45bf6d738b2dd6ac89cad683fadd132cd62d6d51e2096ed3349641294bf17f977851c1e8d426e2d7b41caaae4e76597ed1b2d7d17cc7b5d57391faaf86d73cf9060013a54d1259843118fdef0e140cde4ecbf1deccd6de08afcca4a6e872ccedf5e678498bb2c6e0f9e97d64fa07e65becde7bb2dd0abf26f7fef6fb3c93d7c46e7fcf8ce6340eb777e4e7975c65f7b2b385477122d7b4de92963ad82b366f2b7d9e4e99deeb603173ecb37efc95bfb5b4a4f2d3fb1753694c4e973bd1a267e4d2de6ab3f468601dcfbe77c7c243e9d7a8c27f518f059367e7d9e67f9feadf323f9f4c2e0fb554e63f13d7f8e2dfebda4af29c7e2ce02d66d77383fbd3ae3839bc9c148ced6591dc926af799c4f31ce4c6f9c58368ee87c578c636b8d4304be6b358ee8ad74310ebce70ac711d86e358e68ad2dc6d9e88d239d1f913d15e350bd71a4f3237a13518ce3698db390ce8fa8f66031cebbde38d2f9119d018b714e7a7abd16ad4719567affdbde238afbc19b0dafdbc96fbedc707a7e71f6c9b9e2ba38dedf44fb45cd5645f769d558d2f88b602cf9ba207ab7558d059f1fc5634975ef49740e2ae2f4e1eb6e1eeff5e47849f314647af83848efed25e39ee98e0c6471166605dfd4b966fbc5ee3e9d1f7b22dc87334c3fcf9f10ec2be2bb709c6c65e3017bd00fdd2d8bbbb7fa1e94fcfb9fd97376bf223efbdc1df6795e483d96e39ad3e4fc9dfebbe43b2e17affc0e8a87faaeb53d7821fb7a9b7c1d58c36f79a89dfbef8f335f111f4ece93e62da0c9dc5bf39fbd25ae91909c933d73fa5d7b2f1526e3bc38ece03ac1398d6f3bd36b5a8b7a47c6de7015b87fafefcb34ce7d21d421ebb71d1930c7b0d2ef1eef8f1b73fbe19a9bf7ede6747c797cf8eb9ac9f8ff739e3dce67ccb9996cb7faa2e6d3fb3adc1aecefec3c7b9a46afcef49c7cbb0eef066ef4b0a6ce389e1dafef196d46cc76f32f76bcbecf4e192db3a7ecbdd5ec697b71cdafafe4b7b38004ccbc0b66d3ed1cfcf72919bbcefcc77d9c25f42cdce1eaa78525ae55919cd9733a6059adcced8f17eebf682223737aa11ff3f4ff3f0b565faeb38d674fe3601f2532310eee71769e4db37f7f797cc8b0e1b12fae39aec545b2b963e1f4f2eadcc68be30361cef8ec46d7f7b573f74d1df2f3f2f83071cd71ec9ab7831725b2bb7dd1701bd15d1aff4c7fe746a91c26ee701bbd3adb28a36f769e59c68f1bde65fea5b8d64b42d3277582288d3dfde564b2db07691e40b69f006fc293dfad3e5f6b7e558e3b644e6aabc9394ad80f3bffdd39cb35908c1d4bc676f2bb01c9f76422fbde08981518ae847722e59d04ae131c5df376e2e7756f6eb335e9786f42f59c93dfd1f0eecc64f44bf9df8ef6cff3830a63219541d6db5c42ff584c7f59633cc7391c5e77f32c2ed9aa01dec2bca13033dd6ad52bc7ea58554b3c1bdfb5b2dfabf16c1c5e7167a2e677809361ae8fed9ef058bd2c6bb397eb9d637ca5fad1ae9ddec6f45198a9be23781ee278aee93082464097db3466f5d6d578a07d67ef9f5bebe4baf53e5963bd6c600e5eade0bbfd96ba25c748510f3e97e32dfbbd1a2fc6e115f7ae6a7ee135b6c1ef8539abafbd3368bdc16edbdf06da33f277e3c5b9677b794dce0256bb6e7f1b93a230733d54f30caecb0d9e83b7e75544d134be2369bc1da87946e09dc4eb58239ff250e9adf8b737e16f2579f6af8ff57cc0c637508ef7673da6f26a95ba7a7df13dc9374bd13737f8f71be1ef25b9fd9ff59845f31b200f3e7cafe7d77d567331077f6fd7f2416b6344b231ec5aacabf64d0cf3f1648ae6843cc373680bf990d0e40be7c394ccb9504f16304d03314d4f57898e8c84bab886e78300732e919549c474c1bc73f1f86a1c30afeef365f720e6459c3ffdc9bf69a8f3628bef0a3e5f76c4cfea788ae56c3fb34fc17767e64c4fafcef8837f6b96f916461d4320f35b409dd5971b7a97bdd5f4f105df7fdbc21e731586678e8dda7b993c5fab26afbfa398c4728ce26c57c93bcb0b6bae010a3ac2ed99ee0ef51a1d225a4c153fae75f753cf2169cb4414abbe7d79c3e5e5d519c7afce2aa8f391fc7bfe56e1e25977f1ebaec8b50abe3d333889fc45d70ae2fda3f1b3dfad34f0ee8cfd33f9629690bff43bee2dc5f97547d27bc437e716bcec56634ffa9e22c3605610d34885b1faf24c51acbdc0d8faaf4ec3978470026a06972247a98935a8dfaf5ce86efe91f82fe25cfec1a58a7f367e0bbf674fbe398abf81f662f7efa07616340e5eb8ff72fd742f1e4abe1908bf817f6f087fff173e23b87f0726f00db4175feaf176d79946cc0cbed3372fe0ef9faec2318eb2319e22e13743908f1b11cec90a9e43ff5ec48784a699703ec8073ce7623d398034918990a69b0dec79898e2c84bafe24998f8d78ced7b0ac48ed4c51a70be69dcf3faac681ee93ddbfc6f54dc88b3837fec5016cf771207927fa7971ff0e84baf5b286ed371d2b82c682cec6e958429d79594fc5b23e1a97faf9b826eb8bcc8e13fa84faff3880cfc8e958425bbed8c0bca672580b75f402db732a879bd0161e07c05939fb06e069209b5be1daff3890ea03117f039c1bd36fc4b6fd3818c97401a00db4ef745e27c01c4167e74c878436feb27e92c9ce04e400da79429ff70cd0273e0f5fdefe0edafb41f6bea75ec7aa18bf7ca305bc0bbcbc3d0af1b23ba55a7e4b415bf10e08c4fb5bf7b55bf4f1357a84744a7cd9845e117e492f5f174944b7145fb4bed5e86fd6b229ff5ebc6b929f6376efa2b34ff636908f2d80b443e72a635c8f3f54dfae7ebc097f5692d01e83f823217ef616abf56d9dbffcce53853faec7425af42b6907f684946e016e49f7544133883b16ed3525bd7ff93a50229a9f24e7e4846e017e49f713684b15ed52fc713d2e24a05fac8bc5bbc6b5fc9cfbf628381b57767a7b41d00edbd1e92238b7a5ef3f1b6769886ee8dcb57b17e296f6f9219ccfa8f48341dc9370df2f657d44c85a72de4fd644c1de58d10de157eb8a244e97e889c09f28d744a25acfa1785e46b76c3d1c2ae40df933a9bc61ddae6260905d4267f9c4269f647a3d50c819383f64fb8e680e739d56ea8774cf11f86ad53aa2b243995e087cba522f984a2fa0b862a217a23365a917446187a05f95e8453c03f562a1d40bc8f74cf746918d147a3152e80570ee4df54274ee2df4a276f605f71828ae9cc859885ddadffa41b546c3d862dfbaa25b3c8775ba411f3ca15bba4ed7cef600dd30f6df8121f09b4abad56b07e423a4740bb12bba572aba65eb87c8ffa8e856cb5b668f42ec8a6eb1add7e886b1a15847b18ed472f5c5eb0878d770797b04b173bbac7d2bb44b09b638de52cabb564f51b4f601b5138c51f37ea47d6e12e1d6ceaa40ac27ddc3c175ef658df2cb2eb2fbd217c71809c728f6193eae245e0755638cebf9f1223e9467ee940e38a6f3f628f4ff0af95f103eda05be23febca47b503b1656e8cd05e5a7a9e266c99e2f18a3b0d7544e2a5f4d39c6e922596f2e4a7fed7100dc7167f4cbd69b7afc0fa05d827dba9076dcb3a21be3b7a57141498c34a15f3046453fc277538e7112c52ceb7c28cf8c091d727b169ca12b7b56fb708af8e9dbdf8168adcbd6fdc781d28f7b91c4f0de1e85d8951d2bce9052ecbf627fab943dc29f7b59cf14b217dcfbd4e957fb74f29872ea23c9d652a55f7781fcaf9c7ef93aaaf0edeae38be42fd37de5194d16034fd64eb9deab7cbc0b946391ef5f70fcb61e6707f546be77c9cef3f56f017b95eb8be01eabd217a5bf7781e3e089be087dc9525f543e5f7d7c81be88ee1b4a7d51fa7db27b89445f443e65a52f2adfef02e50a65fab294e98bdaff93de8fa4b151f93aa3f201e5f8e366ee92807e955fd2f88d907ef93aaff205e5f863e15d508d7ec45a03e65c65f40bf16bf42b7c4239fef8654de1184de36e4a42bf54fe42fc1afd0adf508e3f06fca1927e859f05dca365762bba7bab9f6fa4be56edef9c0f377891c57a05fe99bb9b89726eeaf726a2718ec271ea3163d158ce553856e9cb0be95b8a72058af5537a9fe61d85f775112607d77566c271b17785aece5d21f7ed5eec070aee01057329d86b1a7760327e05df36f905e3b167a16f5cbf9f03695eca7c5259fe7232bfeddcace6fcc2395a09bf82719bfcc263bb8fc2b16bf30b7fdbacafcacf2f78e71789ef28e7aabced0b3d0ac7c3dc5f9eb1f797ed75087b3fd9d66191ff8abc573c8aef2d1bf786129bbf4a7d6739dd4b994f2cc9773722f15de81c912b9ff02bf21770f7a4aec63d697b7e05fb5731bfe01dc84c78c759cd2d9453deac73ab75afea5c91f7aa8275097b6f2ad877e47b2aec7fa7eb926a6f958cedeadcc7b6d725e19d68aec3e05d6ab33e80885fc09f4ee755e8abd7d626f0dbb3c2cf07e94df894d82a78cf91f029faaee213bcbb3d0277b7987bdf33fade57708e40ddebb6f9147e87b8973d0231861a9fd23384cc1f949e9724f160f0fe27e153f45dc527e0d7677c2af654f0dbb322de00d2bb7f94c612c07bdce49c24b74ff06ef908dc2d63eea5cfe87b69c1f90877ef2c383b08f2b0eb7b29f8ad037c5b5f776bf108c13949a6bb129a97c23cd1729f01df59a5e741917f1aa9df6825f32afc167507ee6adc810bce85704c4372c7ec1dc5f7d798fb690a7c5bbf1385e218e999501693958cbb07eeaedbf7e2023d16c63b2b3d06bf75806f1b7a2c5b9ba4311509cdcb4bfa3e00f4e5e0fb6caf55f3b4adcbe0db0367068e8bb9a77775eee95bf38bbb876fd9ad38ae1429de235ee8513c1ee26eff8cbddbe7e3d77b711ca38881c9df6becc43194e63dafe47b750c4675677fd6ca0b68d1bf14c6446af7e572de65eb952217a0d913463f97c0d5c92510f18dce1368c93c12e78237721464df1fc5b9def57b28492e77c6bb2c36a31a7f20cb0597bdfff1c47196da3d11fcad324623cb2b48f62864ce82c0bedb6f0f6b316e957d4b6335aaef1df1f70dfb96be9d4aec5b92a7aea45f1ab3b9c0b1939c77b97d4bf30fe851187be1f23ca43a2ebfbb91d2be94e5b04bde9035fb16096d1bfe5619c391e53db8e89c0a913d0bf319ca5c0f999c95b11cc5f714f8bea1e3b25c09e70ac4834a1d978eaf88e9c8f2282220be82ca93a0404c0899c3a18aedc8be1d00f19262be25f9173320771f955fa18ef1c8c64ee71999fb21daaf4579f6e59e25d56f79ac479697919ccf84f9fdb89c1157236744a0d7b2988f2cdf2302e22fa87c0e0ac48c90b926aad88fecdb01104f29f45a92273203de1ea0f240d43120d9d8895e63735444fbb3309e53e835f40620df9fe5b1a00b18cfc9cf63ca755bf2bdab78df20a75d1e134ad64639df8a3d5ab2cf267c8bce064dbea5fbb4343624a77d298eb5547c4bd76ef1b775be81187bc6b72a4624fdde55bcaf90d3be04e23d25dff07b7675ace802c67bf273a86a3d937def2ade67c8695f8af3602a5f0b7a53a08ca148be3d02df367d2d201ee202319f9aaf251cd775a6a317e79def377da1bbf70ba2ae54f6bb4723a6c379a30f47867b68d44edc87c218d0e1edd118beec0e0ddff8adde17d607e3dbf9b7e4027f0bde5f65df3ac609fe16bc43c8bfadf759e7be8dc15873c1ef19fe168c8366dfaee7237eaeaabe9f4b303e9efe2e1ac46fff3f736fdb9daacff40d7f2540ddebe8cbb656aa67894bab227927d0a522b4bdfeb555f8f4f702519e26c924b077ef75adff3aaf6357e62933c96492ccafd43b2c1ba712562d6bbdbdfcce3b9267b6dc845dbfcde55e71e476d875f28bdc0647ee1efb9cfd2237e4df05efa793f508f5a1d2d3f8fba8de294b73047e6fc5cb6f9a3195c62326a6b2df813155e3cd8ea954ee5e436e3e1e76c6d78febb8f6175a37df5cdc9f26909d8dc9ffa336d1721c9f5db977f48bfdbf63f177fa79c11dbafbf6cdd1a75beefd6fdeed37d12af01ff568b39efd199b375a808eb7df06d55eb0f74737d67f9ce833747ab3238d422dc3074c7f23e0e547200e5299d65719ff06e0136dec73056bb1ae774603f299dbefaa7df620db65bdd5163c5d587dd56ebf4b1c63f4455ff583dbf3bf4bd8023f2f768dd633091cfb1cbed8f31fdfe85f754ffdb9ae7b8526cf8ed7359cc1f7e4f6c8e70d8ff2bade43365d3ffc784678c580bcae935c5fa9636255ec5ba507e08e153a57fbe1b131e38ab1cbf61518be0576015f5f16c6c14dc61c5ffd6a1734ef0c9720db27833e56199f0b0f94ed73cceef98fc788a1aa4d2ff6caf6cd485b8c9fd3fc8c330679ee89f1bbbcce201e7b364645f1fb1c5b5ec6efaf981812fe9fe3fba3c622ff6db647c7f8c59576fa7bb44dc4e381f4851b1dbe2fde7ca5360e198edf7a3ea8ac87197e5ba8bdb1ee845ffffeaac5a5b3801badeb5d693ebf65a9be7b77726cf2e93f1f2ef78e41ec951bcf1e9ba727e099e632e0b7108eceadbf7a89f7a9d903be223be057b7deef2539c6ba800ea3df2b446b299209b467d1d7fff6db2349960d9c808a2f3cf67b585a593f78ae5c504fdc5b3ff6b25f367a7e8bfcb3e81d5e922758f2e93cf6634837881611c9f4d887fcf0d627bde2cbcddefa189f8668a539335f2e30ae565ade5bb786fb73a7fb97febf0cbf2ec6ca5fcff31ebf596e05c890f360ce2734f422fae5f6bcfabd90cc7f6874ceb0fe98f7494adf6fccf040cdd577498fd43723d7bceb4df70f1f1b7b7ea070ce7cfbddcb9a7e7a46b877cdd23965c1e3cff8f9f677011d1278d15d799f53d1c73348ec1a794e5c9bb36ebf8bf5068e6559df37de3d9bf2ef1a7d33ca721ed818c5157db65cfbbe64f89239a63e94ef55e5b98e05acd7e2637be525b40f7fbc321bf951986cecc917d8e7b92a97b159934f273a874c5ba1c77f7bbc8e31d35ecfb98f08e2e2ed35dbf3fe947b9bd7edc5f1a7fcdb73de6ffc4e770c10c7af6cd7f237599ee1db04ea835ea15d8ddf32361a1017e99cc0896bafdc6bf2f96147cdf94516683e7abf2fdf3b3a39f63cebcd2e78b7752abf81f2ccd181e63271701b4ee5bb2eb56f987754bcf2fba18a2e8c7b1eeff7e57b16657d78d80da7f2db928a6cec3725a7f2197fe51b46ddc8abbe5728cbc63ed74ec787656bc6b9b057bdf35fe6c3aa879d2af7d12b76e6c855aac597e5629deb79d53bdd65b958f7a34f95bbc615b9983db14e95fbbed56f5875cc53e5ae6ef51b8ecd1c866f32fb309d2af5f8aa3f83b5742fcdc1009fa9be73add012f5a23dbdc1f343ed6d6af56fdc37b2ef0f95be47375f8899fd680b3dca777539f71bbde72d384f95df5d3279e07ad29e2a3d694bba17ef33513ab0e93fc2f4597d694b768c11b838a9fc095f7e8f451fd79bf6fda10fcee131e32d285b07f67cff5ce977d4f4f772cf20d8e7596f4353fb43f15ad89f415bd8a336f3ff25c7ff1b7d8860dfe1dcff4bfd1f5ad7387d6a21ffe7ae816f659c85a6ffd4fb10b174e0f93f48bff4e654ec3b3cfae53e474df963b1ef33ced732bf07d6fac2ef59b4853d6bbde76acfdaa6ef8cabbdd6609fe7e40f0fd51e4790ddf708bbb3df529edeaa7df39b31db13cacf7abf78aaf5ae05fc9e887c9e4dfb15ce93c0feb5b0dcccdce08d91831572cf4572b369577bd836e516fb0b778d0269c37d6c21b9b9eb2b6f6eaff44881e566e655b55eb680dc5424379bf62b9cb782fd6c61b999f9ed1b23272ee4de89e466d3aef6b46dc665b5e7103c9fb3ee7d65f30a989bb3fada82eb11ebad6096070cc139f7baa622e67376be5feb6d0bada7e2399d7537ec920f70d752c4dcc27ca797e502d07e352ebd5d63d047bc213cd57adc027ec38825ccbbbbcc6fc07d13abcf2de837cc3de6f3a172cfaae937d77d16277f84ee43a572c37bb752fe98bf6563f90bfcd6ede433f663d7bc1dfac6dbc3df08f669955e468cfc1ffaee8bf15d15bfa4a9d799bf1f61e7a2957e46b07eecde28999ee07eb4b2cfe17cffc5f81ed3d3e8e433f679d03eb2c63706eb6ac8fd5fe51d1da31ec0e1fdc5e05dd299f9adc6d817167d9cd87bca98f12d6ebf68567a1bb1f6e49cef4f8cef61bc95a6de400d2c16f437ca7406bf2bcd156c7de13ca7a42f47577e8ec4945793d9df367505bf2defe9d8fb5e73ccd8f722f7cde6496adfdcd41b5c676f7ab3f7eb9537738cbd386ffe02f956306db83a73734a9edc95f772cdb59fb9ee8e19df15d8279c790b5c57cbfb5bd6b795b772d09acc947726b1ef6eeacaf816b55faebd93837d9af3bdcb384728c69727fb0c3e4f889b3833cd387e02d71761efa3cb7a2c5a9b98fb7457a206d08cdf275e1ececc816bfd8fa4f6e70eebdb328e0c2bb737ab6fe3807598c9d767eddd1b7501c09f03be3f73d75ff85b711fa44bcec1dd4372fd183a7f8a9bb838408e05ee3f84bd90323f86bfc5d4285c891a05905ff1724a660da0d60f49aa7ee0b0be2df931eb6e7fe6c709379f64f2adf544e2d62d80bc4ab02f629f1bd6dec1c9d643be64ea21401ec9ab5d337b276779065c27c1d429f62cbee5fd3093779a67c0bc8b5e509cf59751c310f5473ad5fa23c1f501a65f8e597c31d842a7dadb3728b7e2cdcfe8ba4ad3afe19a0902fbe7547bf7265baff992a9d734fc9a5133e1f60d4af5657c27c2e049c79751ff29f780657c7b627dcbef1df4fef0e5d824dc98775fd45cc52feb79ecd883c433d3fdd4409b061fa5fb3ce1b7f7bc4aed52f9a6b8e7447e5cf32e9eee1f342f1a7d7bbd879d17adbe7d50def3ce31561fae713ed0f5f8e83f4f76ee3b3939e99af93efb93bde1eb7d1c6bbfbbe9e718775f6e6ffc67fc5c9317f27b7314507bf7e39af3d0dbeb35d93e8eeeda3a6eecc1c95fcffe05bf3f2fb69efc535bbe77cfc389d2fffd958e538fda93ff36eb19de868fd6c962d4ea2abe684fd2fffd5fea83ae7df7476cabfbc01ac2f5bcda98ecd3ffbd4924c75e45eebf4abfaed76d9e0c5ce3fce3051fdb71727f82eedf367cc6d87dfae652713c4944230731b7f81f9bf53c94194f1ad108319ebd8d196a74216b6f05b9ff2a7dc4781a34a2c03ddbfa78d2de6477bd1fae3aa6c4a03b1a59087ff463679ddfff9719dbc08f1c9b0688f17df7a2d1e52d87ec7cdd468f7fc20733e64e8f46a3c881eecbc2e31eb718f7909a4e4c030faf472039eef63cb222a7871ff7b1cab8abebf14ff860c67d75a0c12422f8714fda8cbb152dfb34c0ac3fb91e89e4b82f26e1d47430eb733eee96d2b82bebf14ff860c67d19d3c52ec2acdbf9b86b2dc63d70a2994e17783dc8506edc1d637220f65262dc672ae3aeaec73fe1831977b2a7c3552831ee7a8b71df59e6287412093d24d77767f1b0a3b62531ee4b957157d7e39ff0c18cfbd3c9b15781c4b81beae36ef5acc5f6345d20f2d2ab1e92eb3b499efad6f060e0c7dd5118f7167afc133e98bd99779e0eef07049fcff75a8cbbe62c963a091035a0ab1e92eb3b319f74b270c07b42f0b87b2ae3aeaec73fe18319f7f98e2c96c6141feffd16e31ed3a1d52343bc1e53c9f5dd8a6609496612e3be551977753dfe091fccb84f0e56e26912e33e6831ee6727dd3749ec47a792ebbb653fc5d67022b17f3fa88cbbba1eff840f66dc473b2bb88f31fb772f5a1df23ac79fd21bdd4ff7fd41cffa7ba0ea43ab08ea6bc0e565eb3f6ffbbf465fa56ea653a81f46a3763609e9e3adce75f4e2bf6fabbf207f9b7a4d44ed598f0e11f5872a2fdcf98a227db9338efb8046ab033166989a504ced996aad46cd56c2f31305f9f39c44fe6c2de3b5b7a231d49b8dcb0b5f7357a02f1f7ba1134cf61622f7bdc49e759da764fd4ac156a83abca4fcb336737a301d3ef5a15e2e5c5ef8395d85be74ec39c9f63c857a00c1b1a729cee94ab6fa0bf2b7a9cd4464e1e804bf7ee8b273ba0a7de9d80b9646ca071b7b8a7519355b61e67459f9831673fa6299d00581fa9f7279e16bef0af4a5636fa95b46ca071b7b8eda9cae642b54dd5d527eafc59c6ee956729f606a7e155ee8395d89be742d9ca4eb32a6267a89bd9eda9cae66abbf207f9b3accc00926016a7f59e5859cd3d5e8cbc6ded41ec74e82daf367b1a7588351b315624e97967fa13ea79364163b8bc94e9617beceae405f36f6124fb3a2a7d841cf8907a5395dcd56a81a3b5a7eb737d9b9911fb6aebf4423d4d96d839f4c0d468d87421d86ee7067dc77b1630fdee96b8b5a8ca2ddfe921e2d6b32abbd93606ac80d7e127519351e0a7be8d0892c4c7dffc78b46dfd458b6a8cf28da0d57a391d7a3559d6672b092dd41851fbe56a3c8433e3ef77468f5a9547caad66c54ed86aadb28e8d1a676e3188e3dd33135e6063ff47aa0cc437eaf1d8d0e6481d86b17f1a958c751b7db5fd2a34d3d27a4c3edc04a3077081afcb0eb81320fe9f8b467fde9c2c2dcefb8c5a7626d47dd6e98f540458f36351e736638c118731faac10fbd1ea8f2908ecff98144e3582e5f53acf728db0db31ea8e8d1aaee73220b27c6d4a71bfcf0b51f551ed2f51f6bb834ac0051132fe253b506a46cb7bfa447bb5a50328b15f9e1eb418a3ce46b4296660563084f83199fca752155bba16a430a7ab4a90f19e3840c47983a76831fba46a4ca43ba4ee4efad6476c6d4ce8bf854ac1529db0d532fc2ebf166afbeb2def6adeb451455f768f093aa1729f150a91745a83acb3a0c5df3fce3c76dea456a76fb4b7ab4ac17d1c041bd156cf093a817a9f150a817458e31c1bcdb1c50fb9c6159a8d78b14ed86ab17c9ebd1aa5e348aace176a0c20f5f2f52e4211f9f3b1adcf7a752f3a86abd48d56ea87a91821eedea457471afcbad3ff2f522451e0af5a2e589d898bb19b7f854ae17a9daed2fe9d1aa5ee418d6809863157ee87a912a0ff97a1109a68bf95e263e55eb45ca7643d58b14f468532f1a3a83eccdac023ff47aa0ca433a3e67c6d45ec672f1a9582f52b61b663d50d1a34dbd68dc23c9e18ce9f9d0e0875e0f9479c8c6e79e24dec0b2a5f235c57a91baddfe921eadea452459ed2ccc1bc4263f74bd48958774bd68e1e9cee2095377bfc5a76abd48d96e987a918a1eedee136956f084a98337f849dc2952e3a170af880cc767b9fdbbf2dd2245bbe1ee1761f5a0bd4948edfeadbf8c524e103c443472307dc7debd6815bafba2be82ad1358268d683043bd83a3f6ea5bb556a4c847e11ebaa2cd5077c4a569b7a9130524720c3ac49c5b5f6d36963d3b3268400f9873f79b3f2be5838a7c14fa75a8daecefd0b6d473c124dcd105dd1399b946b23e34b5472189c632fe9ca89d19a9f1915f67546d8699fbe5695f7bce28adffc1ee602507ccf9d4d5669a640e684ccd59325d60ced7aefeac521752e623bff6abda0c31f72bd06e5113b2b4a939d913d4ddc8abcd9672737f32eb3bd1b887ec4f70f167a5dc4f918f420f0f559bfd1dda4e8bb9dfea677b0d89f552b6166425bb74af84a9a35ffdd95099fb55f9c8fb99aacd3073bf02ed16674356e4f4c96285791f71b5594f72ee8f69b0d5892d33272bf5ef51e523ef67aa3643ccfd0ab45bf5f02189d323c38384cdb69273ffb847024f93f1e7ac26231d938a7c14faf8a8daecefd03eb498fbc73159cc12cc9df59bcd647bf904639d0c09e6cefdd59f074a73bf221f053f53b41966eec7d3f6cdd5ce7d6cd58739bcdc46c1f41d98878e7d92efc3bcc86ebb60e6328ddaa32fc57a8fba1eff840fea7d7876eb08f396301ff736b59ebd15393ac5ac93573d24f33d279844c446ad61f9b82bf56156d7e39ff0c18cfb38a6c12a9418f7167d98adbe652f0d8a595bae7ac8d67886a39044334cfe9d8fbb4a1fe6167afc133e98797e7ba60b7ac0e42af9b8b7e8c36c69349a9d9d0473c7e4a2876c1f6662d283655b987e26f9b82bd576d4f5f8277c50fd39233a1c0598da743eee6dea3ac9d41cc78e8de97590eb219bdb25bbbd158d303d0ff27157e9c3dc428f7fc207b9a70f263b4c9fc67cdcdbf4614eacc5a137c5bc41bcea215dcf791a90e42011ef4a7d98d5f5f8277c50e37e9e2ebc8144bcb7e9c31c3b89bf2306e68e5aae87e4fa6e4556cf59f8987b6af9b8abd57194f5f8277c50fd7803623cf51cc41da17cdc5bd570a6c35548306f97723da4fb30075b9d2e1ccc79443eee6a7d9895f5f8277c50fbf73eb19706e62d613eee6dfa30c756320fc902efbff2b51b4ba38b25a6c7653eee4a7d98d5f5f8277c50e39e1073a9637a763ad159a7edf0b3422772fa28fc2f7bf2e5db4bf9ba8d3d89a6260a1f6def3eaf547b00a9ebf14ff8a07094f634d8a170d3f2716f55b7a1f632a128dc805c0fd9ba4db40a2d0385b3908fbb5add46598f7fc20733ee93902e48843967cec7bd4ddd666099e480bbcf96eb215bb7593c04d444dd35cbc75da96ea3aec73fe18399e70f27c79847987b39f9b8b7a9db1896314ea88dd743ba6e1390bd65ac306726f9b8abddc951d6e39ff0c18cbb9338010931e732f9b8b7ba8f6305870145dd61caf590bd8b3d1c0fa6f61293cfe7e3ae54b751d7e39ff0c18cfb2c9e2ec21093cfe7e3deb26eb38d1d0373e733d743be6ed3b722547fe37cdc55eb368a7afc133ec8bacd701260de62e5e3deae6e13d0c041f590ccf590aedb8c0dc740f539cac75db16ea3aac73fe183aadbec4944f6983717f9b8b7aadb38c9329e0e11fbd15c0f79fc2c4bb7822d66ff9e8fbb5add46598f7fc20759af5b8cfb12fbf756751b67314b50b802573d64eb36d1936625287c817cdcd5ea36ca7afc133ea871df91a16560eaf31b7b70d8d8fe4fbb9e3cab3d0de6a87a41831ffa9d8d320f95ba8a86abab84dfd40cdf5de59e3cea76fb4b7ab47a6b6519f31d8d30fedce0877d6ba7cc43f6de9d93908363534c4ef9e946f34f6afc4ff9bd95b2dd10f76e95f46871efda59903df26e5c831f1e27599187747c3e250ef2fe5a119f6a3d79d4ed86c24b56d0a3454f9ec48fa63641e10436f8a1ef5f2bf390c633a64118a0b0098bf854ebc9d3c26e7f498f36f51e83047e88ba5fd8e4877e7fa7ca43fe5d9cd3a3b8bb9fb7f854acfba8db0df5064f418f163d7988fd6410038575dce087aefba9f2908ecfd58e04fe01733e52c4a75a4f1e75bb61f6072a7ab4e8c993cc03b218ede5f25cc99e3cea3ce471ce93e519d543b1884fb59e3c2decf697f4685317d2ac64769e2e30f5ad063fec7aa0cc433a3e8796662d0e98dae32d3e15eb43ea76c3ac072a7ab4e8c762195642028ab96bd7e087ae13a9f2908e4f1258896360eec315f1a9d69347dd6e98f500af87f7bc8a3df3dc16f36b478315aaeed1e087efe1acca431ea7d77674549d65fda07bef0f5f2d30bf94edf697f468532f0aa7f6ac4f23c4b976931fba67a72a0f798cd5f0303529e6cec1c95f4f4eaea97cff47dd6e98fe4c2a7ab4e9e13ca47bc740d53d1afcf03d3b157928f4ec742282aab314f1a9d8c359d96eb89e9df27ab4e9e13cee4d17b8fb470d7e123d3b157928f4ec3c68a87b5a457c2af67056b7db5fd2a34dbd6840037f877b8fdae087eed9a9ca43be5f97d3737075965b7c2ad68bd4ed86c27851d0a345bd683abcd71d7b8639e36df0c3bfe755e421bd3fd89e883d42f5ec2be253ad5ea46e37d4bb5e053ddad48be89e0c6980b99fd6e087ae1729f390aee73ac1cc7012a9f854ac17a9dbed2fe9d1a65ea4930509a672f39a6cbd48998774bd68b14c9c24949a4715eb45ea76c3d48b54f468d3c379384bacc457e287bf37acc843be8f9f6625564f2eaf56ece1ac6c37d4fd61bc1e66f8bd895bbd0b8b68e4e834f030fefce9465ff2efc2221a11dbe9616cb5b107ffa9637d29eaf14ff8a0b07d221aac228278ef9f8f7b4b8caf598fa2de35e67ac8627944ab033150ef40f371577a17a6aec73fe1831af7900efd10738f341ff716efc248e464f7ba317d0b723de431e0f704f75e201f779577612df4f8277c70d8ef4e4051ef05f2716ff12e8c4424d8f69c00d3a7e4a287ecbb301a387d628c13fcb82b6278a9eaf14ff860c6dd31a6437f6fe1e7f99658ef8ee6a03047723da4df7dcf0c62a27047f271577917d6428f7fc207f5eefb4082f98ee0e7f916efc2c8812c48343525fc571aab6b99d06087e9e3948fbbcabbb0167afc133ea87abf6e194e1fd3c7291ff716efc2c87e3ab4e2e91083159beb217b3f38d82656b0c5dc69c8c75d0d9b4b598f7fc207d5a7b16f25e33ee65d583eee2d31b9c606c19d5b667ac8be0b9b2ebc9804b8b3cbcbb82bbd0b53d7e39ff041bdf7ef59816360de7de7e3dee65d58cf1a8e35b2c0f49bccf590c6e0ba8fc97089e9cf998fbbd2bb30753dfe091f14f6d6c90a663ad49f93467781bf2650cfa0d8b10789678e626a0fb469447f9cde2a51abe73d685e34faf67a0f3b2f5a7dfb80ffa9c881f393bfc05bb2fee73f4f76ee3b3939eb49e8be03719dd385fa505565ff8c5c83e85e225b17fc1bf617fb5d0bbdf7e9ffde24257d95ce93ee4fd01ac7d4d59ea4fffb3f791fbb0fac740e6ece4317f975f0ddedce31be72592cf97b812df9c9d7b0dbda12334f49f258fb1f9bf53c6c37375deac032b6f48ddda76f2e2bb6c4620682f7ecd871d0db98a14617b73850a909b7e1a78031dfd29698bb6a923c686fb27bcbef5e96c64cad5665d01d8d80bd18dba6ef5e341a5cce0e2a76c562d0f768348aa03d1a3bf6fcd8591f2ef57dc535ab33be2af5ad8e6cfc77798dabe3a9f67efe40830978ae22b47150b331ba26368fac083cf312c54f5c8e1f796cfb6ef82abcb5efc8c6b83a9a32afa43a9e4aebd862124e4d472a2fc86d9cd46d8cbd676d45cb3e857ad708e3c72ac78f742db423be0af7bd3bb231ea4eb6322fad83b52e70a2994ea1f747421bcf14d73ab2a7c3552897675ee2273be3505eeb3ae2abd003a22b1bff5d5ecb0ed6baa79363af02251bd7f30974bf88871db52d95f8d1dbac755df155e85bd1918d51bd25d47905edd73a923cf5ade1013adb13d9d8505bebac9eb5d89ea6d0f99b307e9c166b5d677ce5ef92756563ccfd42755ebdf66b9da5398ba54ea0deca421b7b6a6b5d32df91c5d280dee20be32769b1d675c557e13d735736febbbcb6edd7ba6472b0124f53b1f1b49e4fa07be4cd1292807d2344f1d36fb3d675c557de8fbbb231aaaf9e3aaf45fbb5ceb29f626b3881ee33886c3c505cebcece627cb294ea2287366b5d577ce5fdb82b1b23d63a155e5eb43a346a886a6ff02f7779a5ce0227217d6cd44f656ade3ad87f8a3da631b5672deb97dde9f92b7c91757062837d51057ed445ed32bb1b7c90aa755df46cd4f3d07e64cf7a7408dc6f17f951d0ca8f3ad3f357f8a2fc687520c64caa367bf123ab133f7282c91eba632ed053b56619517b1c3b01705f5ee4476df2ee0ef5fc15be383fda5bd1187a5721f0a359177e144c874f7de80da440cf7aad14ed474eb23d4f87c07b3b811fb5ab5576a7e7aff045f991d5b7165b08cf43e0475dd4291d9d044bb087b640cf46ed0eeb476491f194f7a376e7bb9de9f92b7c317e94f151c98f9c0efc68a95bc63281ded008f454ac4f92902c486841ef81447ed42a3fea4ecf5fe18b3a874bf980efc6047ee475e047966e25f789dcbda84ccf7a5d14dd6b9da4795d22754e74f1a356f951777afe0a5f544ff665620de5ce852e7ed4454d32dc5b91154bdde7b9e8d9a8d3617bee38c12490ace5647e346d931f75a8e7aff045f5e619c74ea2523f3a74e0479e66454f31d40340a0a76a2db24786939d15c9e7d9d336f951877afe0a5fd499db2c761613a85704d38fdcde64e7467ed8592d321a457267c877b1630fde69f33ea7443d92ee24ef0efd78d1e89b1acbf635c90ef5fd35dec8daa463cbdd5d2a7caba3faa43d09e4ce6c6efab6a851aef64e2275ee58f856db3a6587fafe1a6f5c9d2974224beaccb1f0ad4e6a967b3ab4fad03b3a84beca754b279a255353ea1d4de15bed6a979deafb6bbc51be353958c90e7a4b8af0ad2eea988ee1d8335daa3e52e8ab5ccb24d1e8401652b5839b6fb5ab6776abefaff146d5a3e88e0e9752f5a8c2b7baa86dae0ed3e12181fa3c20f455ad6f8674b81d5889d4fdb5c2b75ae55bddeafb6bbc31be65cffad38525757faef0ad2eea9df30389c6b1627ea95af33cd021dd5b8ab97cbbba67b7fafe1a6f8c6f9933c30940bc65846f7552033d9185134b9d3d15fa2ad741ade1d2b002a9ba43e15bed6aa19deafb6bbc5135d17b9d0463a9b3bec2b73aa98b06643856d557bd369acc6255df6a591fed54df5fe38daa935a9aa5ec5b5dd44afdbd95ccce52675485becaf55227b034cb56cbe5dbd54cbbd5f7d778636aa7c63821c391d499e09bbdfa72cdbb5e77b5532a57cf5b87a16b9e7ffcb855ed3492ac9d0ea87dfe727b5e07b5d3eef4fd35dec8da298de46aa7856f75543b8d08883f88d0b745ed9406202e21c6b7dad64e3bd4f7d778e36aa791634ca4fa6714bed549ed744783fbbe5c2faa9bbeeab553db394d87406f2d8c6fb5ab9d76aaefaff146f9d628b2865ba81f1bc2b7baa99dd2c5bd2e7727e8a66f8bdae9f244e4f2ad9b6fb5ad9d76a9efaff1c6d54e436bb852ccb73aa99d064e72d020ac4c84becab553c7b006c494baab5ef856bbda69a7fafe1a6f54ed9404d3c51cc25845f85617b5d39931b597b1622c29d74e49108656a4386fb5cab7bad5f7d778637c6be80cb23e2e4abed545ed74dc23c9e1ac985faad64ef724f10696ad96cbb7ab9d76abefaff1c6d44e03af375d8c1473f92e6aa7871eb19fce52675c85becab55392ac76965c3f8c9b6fb5ab9d76abefaff1c6d44e179eee2c40ec04846f7573cf940cc767c59a4b8bbba6b3d892eb9154f856cbfba65deafb6bbc71f74e352b7892ed8f1452bbdfe82da9d4272f788868e4685df0c763d074c4537e9f76cade894bf6aea0f6ea1be8592ad72baf333ba3b06b5ae8d945cdd432684041ac5469fee87e799df194ee5b4722c7a043a9bdc3bb17ad4277dfb65eda9d9dffb29ef5da9d127686632f0d07c2e296e78f3f9bee88a73c76f62824d1586dbe68d79baa3b3ba3cea5d5f5acf79b54ea9d17ec0e5672903a1767f147637574c5533ab771fa74680de4ee1c5ce78b461f53b9fe799dd919d3a3aa8d9e1dd4479359df89c63dd91e59207f740fbdce784af7b29b9a933d917be7719d2fdad5463bb4f35fd6b35ea75359ff92a999ee79a5ceed58fcb1eb5f673ca57b9025bb9db570a4cecf6ef345bd1fbc9c3f7767674cffb3367a76d027dd8a9c3e59aca4de3db2f8e3fb3376c453be4f62341d2e75a9f7a4b7f9a2d1db54b23764577646f5686ca16717b5d0718f049e2675d6cee28f5eff3ae3293b5fc424717a44ee3ec575be685907edcece7f59cf7a4d4e65fd8bad60a95986d2be44b506da194fe9f92218eb6448a4dede5de78b7a9f55597feeccce98f54f414fdf5ceddc666f4a25dce5cb2d4ea9bbe71ab5475fea1846cbec06a55c4f8379e8d8a796b5cf8ef82a60357765e3bfcbab8bbae738a6c12a54b2b1228691134c2262cbe51579fcb4c230ea8aafc2b9724736466103abf3ea20379f0e47218966527b83dcc6aaf5cebe652f0d2ab7c6e7f1d306c3a833bef275d6ae6c8ca975aaf3ea00c3c8d268343b3b8954fd3eb7b1228651328fe87014c8f537bcc44fbb3a67477c15701dbab2f1dfe5d5458dd3ea3bc16427d7e32bb7b1e2b9b195ecf6563492eaf595c74f2b0ca3aef8cafb715736c6d40c5af0ea00c3c84a9e06243948f583c86dac5cdbb41687de54aecf471e3fad308cbae2abe0c71dd91855d754e6d5058651ec24fe8e185277c5731bab62184d02623cf5e4ee69e7f1d3aea6d90d5f853a415736febbbc3aa967f689bd34e4fa7d5f6cac8c61146c75ba70a4ce4bf3f8698761d4115f855a664736c6ac752d7875816114581a5d2ca5b001721babd731937948162af1d30ac3a82bbe0a7edc918d51354c795e4e74d6813e964a354c2772fa92d8f67bf779d5ec5b8aae61aef634d8455277fbedc9976fb7ed19da115f851a665736febbbcbaa8614e42ba2091dc9d8adcc6aa35cc68155a8623d5c3328f9f7635cc8ef8cafb715736c6d4305bf0eaa286b97808a82977ef39b7b1f29d4dcb24072a777f318f9f5635ccaef8cad730bbb231ea2d8c32af2e6a9886658c136aabd858b586e9244e4042b99eab97f8697957b31bbef2fbbace6cfc777975724f339e2ec250ee9e686e63c51a26198e07537b29b5bfcae3a7550db32bbe0a77343bb231665fd782573735ccbe15c961e3e4366e51c3dcc68e1cce511e3f6d6b989df055a9617663635c0d5395573735cc80068e5c7fb1dcc6ca35cc3d89c85eae976a1e3fed6a98ddf055a9617664e3bfcbab131cf6802cc67d29eccbdcc6ea38ec966e055b95ba484b1cf66ef82ad5e23bb131ea8eaf3aaf2e6a98d1936625727882b98d956b98ce6296486243e6f1d3ae86d9115f053feec8c698b54e81d7c61e1c36b6ffd34d0fcfd59e0673c9da5ef84dcdf0dd55efe1193a91a349d6da3edd68fe498dff7550cbec4edf5fe38dea2935898849a57ab314bed5c97bf4849aa348ae1fee4d5fd51e9e8165cc7754aea754e15bad7a4a75abefaff146f54c2707c7a652397ae15b5df4f07c4a1cd97bd585beaa3d3c77963191bde75cf856ab9e52ddeafb6bbc31beb5207b1a51a93bce856f75d0c333f1a3a94d22b9fe0e377d157b785a3d1a840195cb776ebed5aee6d9adbebfc61bd533dd1938866abed545edd331882179b7bdd057b187a76590c00fa9ad366fb5eae1d9b1bebfc61be35b81d3a3926f330adfeaa08767b2da91c03fc89d39def455ad85eacec2e9d1a1d4fbfcc2b7dae45b1debfb6bbc31be653f19c498499d9917bed5410fcf641e90c548ae477ca1af620f4f4b7392e559b21f7fe15bad6aa3ddeafb6bbc31be655aba65cbf5e32f7cab8b1ae92c21c3a7815c1dfaa6af6a0f4fcd4a66e7a95c9fc59b6fb5ebe1d9adbebfc61be35b434bb31607a93e8b856f75d1c3930456e21852f7b40b7d556ba68993580689a4ee5f16bed52adfea56df5fe38da99d1a5642022ad533dd7b5ec59e79ee0a3b7e4783955c3d6ffda07bef0f5f6db0e31ddbd1256ba7277f3d39b9666becf84ef5fd35de481c1162cbd54e0bdfeaa276ba1cd0e12e92ba8754e8ab5a3b0da7f6ac4f23a97b41856fb5aa9d76abefaff1c6f8d6223c4c4d2ad57ba3f0ad2e6aa733c38988644f8a9bbeaab5d30389268164edb4f0ad56b5d36ef5fd35de28ac07ba770cb9da69e15b5de01f8d7bd3c55cae0772a1af2afed19e24074df20ef0cdb7dae11f75abefaff1c6613d0c88e41de4c2b7baa89d86113146927d416efaaad64e0734f07792b5d3c2b75ae5f2ddeafb6bbc51fd889d9e23593b2d7cab8bdae9f644ec915c4fef425fd5da69df1aae7692bdbd0bdf6a553bed56df5fe38deaff72af3bf64ceacca7f0ad2e6aa7744f8634907bf77ad357b5766a38c1cc7012c579ab55edb45b7d7f8d37a6be158d35125952f7fb0bdfeaa276bad42dc31a28ced3aab5539d2c4830555c13dbd54ebbd5f7d778637c6bb14c9c24545c13bba89dce342bb17a8afaaad64e3532b4126228fa56ab7cab5b7d7f8d37aa2e3f4bacc497f32d33fcde34ef40aabc9d8f68e4e834f0a4c678630ffe537f3b4f231aac2222d567e9e1d38dbeda63c677c157feed7c6736febbbc3ac28a1ffaa1d49dfcab8d15dfced3687520865cef893c7e5abd9def8aaf12467c2736c6bc9d6fc1ab83b7f334cade4549dd6dcd6dac8e0d1f8d0dc9777d79fcb4793bdf195f053feec8c6a8b31c655e1dbc9d271109b63d27b0146cacf876de708ce9d0df5b0a6b4e5b2cf84ef82aac755dd9f8eff2ea0403fe4082f94e0e8335b7b12a6ea43d33882987c39ac74fabb7f35df15578a7d3918d316b5d0b5e1dbc9da7c36542839d548fd5dcc6ea98ef0b124d4d95f869f376be33bef27edc958d51e77fcabc3a783b4ff6d3a1154f875277ea721b2bbe9d37c67d2b19f7e57083f3f86987f1de0d5ff9b5ae331bff5d5edd60bb5b816348decbc86cacfa767ebaf0621248decdb8c44fabb7f35df155c174efc6c698b7f32d7875f0769e24f731192ea57000721b2b63b95bc3b1461652f80a79fcb47a3bdf155ff97e475dd91853c364f33ab946f8ed67f74b5706b5079a17ebdfae31086f749e1f7e36f640cbf09bd63474dfe7c974ff90fd06496fe7afe73f027ad96f007a896f8eb48d6dddc6ce33ef92cd7afee91afd3fe367a239ebf927dc133eec537b067f67d2cf377305cd213be79dfcb8cf2b8dbedee2f2e4f6c8a76f863f6ef0b175ec49e21a67dd37815ac0fb24745379d7b4623fcf08b58d7df73ddd3fc4d43eef7c3334a0f7c75e6ffee918c701fced7d11cb6ba26d6cf2dfdb02e491f866186c7a904f87df9eb1d25e16f737bbf8e6dd69babfbfdc3b4fed1485ef9be7d99f71be968d1f1ff66e6faeb98656feb72f6a53edf65dd5869fee3b09fdc7fab8cf7fbce1c7d659af3437b9fedbe4931a3bad32fe697c46779a6fde1debffee9aabc8afd0a8da6f630f12ba9e1ddf5eb5539def25ff48e362f2e33fcf7fdcc5f5df18341ecb34ae721676bacac2a5f18ab041491ea15e0d9bc2f613d9291d73a60f54c732ded8fe87cfb345f5f7575f395a8f0f4cffa9fae439a4efb3f2ef79bea5b9bdfb63a6577cfb7dfa6f7fc6e648f7cdff413199c9fb62677f2fe683ab9fb3be8bf271a98f2162bc32fbd7c7caccf4e4c9197a46f887bed6e7dffcdf171f5b37bafb06e78d68956cd69fe1cbfa50d2eff26fd3fd8341d79374bed8b980af16df6ea16f3fdcdee4f32dbafbf6cdd117e41f744d3edfa225f46d3aee1fd09cbe314707b7e715eb7174d77f59930fc79efff8c9c7d67abc3f7bcfdbadd57be88f87294d7fefbc6e4f2f8ff7c57fc1d367fa7fc7c3d1e9edf13ece7efff8105363b2fbbfe1fdd7b8f8efdb1a3a9f1c398e6e699d0064d152dae4fda13f7ed432b9f8f24c5279b26f5e16a96c8a32997cfb58cfa96d9ef40b1fc2e6f37a48ffef9664bfa7a96c472bd5e579f2ee444f1fd6e3695bfcd73f915760dd78cf79c77adfadc4f5f6e819bb1d35ef7a69bc8e87e353360ec12ae343d64c3ee7ff7b3d95f5385df45871ec35e3dacb7adf7d9563f4ed51bffe2d9d77ae32e919bf47edccb1412cb0c1a71b0dca797bcd0e0ff9fa57f50565dfecad34d70cbf4bf90860f7fbd49649c9370db23f6dadc5327979bc8fad6099f112e8559d77ea3634073f2ed82b66f7e347abb824f39f8d796754f2eddf89ef0fb747432f3cfdb9daeac5d60f577979be528915b10f67b29167d1fc706ffc1f30775f6c35298fc3174d72d9993e96dae51233d6e2126b992df97296e7b19823a78e94b3ef0e3172fef339a139ee8b0956d66c3e258b878b4d171336ef6089f4b9f0db8d56b1d0efcc4b6e9ec5723a46c3792ac389394fefa1fcbe118bc5fcb1c0e8df621e817867f98a882f732f59ec83afbfcdf7e4dc793ef5af21e1d9eee667995fbef3e62038668b1acc2d168ef42a6fccb36f36a6863037c8d7ec921ff0e621862fdcea17e59ce25a37f8ff4f6e01c8f9669e3072c61739c7ba709eb8daf312d7e73c07d03973a0861b772dc6ca9acd7bc3653ea73d71e6df3138ff023e77c9430291df75928f3462f1c5bed37df3fce9464b8cfe97b9a5b44691c0f94a7dcc8a4f5b2b18eb17ddc13915e09de52242beacfd128dc22ff7510ffcf524a6f6402be613fae99aa73f6393e8defb2474dfe7c34b4d16acd5c4ae710c5feceb6f4bb5a6fc6fd3fdc38dc7ec527304f2aff9ce89ce617d7fea9ba3bd6b2e8fae391aa47338a746a639f6e48bbeead166bd0b69919f5ffffdcb59873ffe7ac6ab7b69749dee91b33d6a4986553fddc7ba66f8ee061f795d01aa2764bf0be8a5aec7fcde3156596d90c97f7dd9ab97ea25e1dbf3ece8d8e74f9ad5fbf2da0353fe4fddebcd3fe9fb8129c3e67d95f86618bd017e91ff56f3a2d1b7d77bd879d1eadb7f6cd6e7fde7c9ce7d2727679d8d3b34a617b9a3f3ce31bea0baf3c9826a21f977beb1fbf4cd25ebfe20f33bda9becde2e6fb8e1fb1806ddd10838d7ad7e1f33be0fa9e9c4e0ddc5eaf709eb7b2b5af629d45fbafabdc6f83e70a2994ea13e24d5ef75c6f73bcb1c854e22fcde80bfb77ad6627b9a2e84f6ef31bed79cc5522701d0ffa9fa7d9ff17d4c87568f40fda3aadf0f18df9f9dc5f86471c6df8b5687ec4e2be0f3b7fbb9ecb889a93dcbee30b2ef63ce7a9cd80fe923d377226a8f632700e7cf92ec3396ecc174f8d487deba9465270b86ecc1d2200bb0175e597686df90902c48684177072bb27b0cd92ddd4aee13a8ce5c967d3a84659fa6764b26d03d87b2ec2c9fe991e16467416fd173d9ddde64e7467ec8f59b681471e4ff49e75b6a2c79be133a9105f5f4cae7fdbbd8b107ef943df7444e344ba6267bae2ef460f9906338f64ce7f8ff4d0fa61fd9b3fe746141f7b4ea7ab07ce94087746f71e6d0420fa63f9dc8c28939b170d383ed5396660563e82d575d0fa65f3981a559363b26deecd5979beddd797e45797e35a0f6f92bab0db2fd2a720cb09f571e1b61e89ae734cf67fb95ed9ca643a8ae56d783ed577471af43796a5d0fb65f9160ba9843fd7dea7a30fd8a0461ba3e23f460f9d5b84792c319ea3b53d783e9570b4f7716e03dc6ba1e9cf96a165bfc1c21a4763f7b3302cff50f698ec5c931fd98daabefec3d061c5f060d28f86637b7c3bb17ad4277cff2296be0d84bc3817a34d474200c3b926077b09203273eaf3a2c611d9259df89c63da867634d0756ae944ccda73309d87171d3218175b022a74f16e03bf79a0e5b860ee31e093c6d2a1e07962fc556b0d42c833d0e391e2b2bdf0e493643b1d7cefc7b56bebdb7224707fb2954bf67f951dfb29706e5cc4df9f78c7cdbd268343b3b097b4ec8bf67e4dba90f8c63c766e79b25ec76391cfaeaf7ac7c9b8dc95dfd9e996f4f87ab900442fbb3fd87852d5cec0faf18bb7238abd5ef99fe43ed654239fb8d12c6a21c5e64f57b96ffb0b1f3aadfb3fc47b382c3802ed8f62fe166c9618055bf67fb0f0b0fa9fa3dd37f9c64194f87c2f167fa0f13d725ffbe826f02ae456c1c8d7c0eace03948e217e4f370a57fbd1c1640430f468ec4e97d5ed7839523717a4ed7f560f913bb7f73430f468ec4e9575bd783952371fa84d6f560f915bbe7e6753f5aeefd08e7decc1e83b91e955e7792bdddf25cafd2db4bae4f5a430f56eecdee0b55d783e5579c7e3c753d587ec5ee6dd3d083e557ec5e1e753d987ec5eea150d783e557ec7e04573d8a77f1726fa3abdfb3d6bb88dab31ee5acb7a57791726f3cabdfb3ea93ecf76ed5ef59f5c9900e1dcde1d4174b6f5de4deed54bf67ac779c370cd5ef59ebdd80246383403d31abdf33f76eecbbd8b7b3e5dc17e7b1630f12cf1c65672bcdb3f5ab5f3f546bf9d05dcddbbd864acdbe79874554bb6fc8e87f6cd6f3902ddb7d90ee0e7877088a7dca6890f71aa89fa597eb083d1a8d22e82e745dc7529d9fa327a7de5fd7d5f663677dc8df9473f45d4cc2a9e9406b1743e7994067b2a7c35508dec18575d6053ab3cf08183a5fde16b27526c953df1a1ea09a0643678faf7332df91c5d280f6e60c9dfb7c9d39e70a0c9d2f6f4cd83a5bf6536c0dc19a735de7cb9981805e1a27c406ebef303dc19870ce22607a22bf8e567b2b1a43e79a20bdcb1b6e1e3dab6f2db650fd06a627b21ffbfc02a627b2df6299d005d82700a627b09f932c136b6861e685db59078f1ee7cc03a627b01f4966b1b39840b9519d5e719681f0e9ac762c4353ecd7ac7312364da16f4f0e56b283f274264da17f0734cd7731fe583953e1d2649fadb0698aec69ce0c27184b8d91d8d7ef75029f9d30698afd9d791ec3a629f279639c90e10813e3c5390bc2e76984f2f9cad98dc0e75967386c9a429f1f45d6700bbd9763d244f87c680d5752f614fb3cf3dc874d5364cfa133c8f234199a229f0fbcde7431921a23a1cfb3cf8ad834c5f3bc66054fc8fc343b3b380bf2c9c8326904f7db6ee656b773256ececb3e5f02f2bfcb5997607ca6f62824d1183af362e9ad09724a636ace922954bb65eabd14e4bdec332996de225fb792ddce5a389838bfeadd13e6d2c1562760bf4f96de5b81deec732c96dec2fc3c18eb6448306bba46edd19778efb9cc4ee3a19e8c75194b675ebcb8619f7d356b01a1639fc43e3e4c7d7c8619eb5c67c1de33994774380a3071583a27e3f90efbbc8ca1b3d8bf9f06243960f2ac5c67d1de731210e3a907e116317416ee3d99676c0c9dc5be6d697401f6fdabeb9c63e08b7c7bb5a7c10eac7503b5a3eb791cd7b799e7728d3962f2e5dbe21c7dba7808288c37c0d059e4db4ee2042484fa4f307416f936fb2c8fa133c2b7fb16dcab8ea1b3d0b7f724227b4c2da974eec7f56de6f91f4367a16f474f9a95a0f6f1c5b99e30876462dfb3690ac6867366c8a629ca21d958d74c9aa29c9c8371cca629ca21d9e78c6c9aa21a221bd3944d53604f0e962593a62827e79c4db2698a7c9e8d5d57a759c36ee3efef1898656c9ac29a18f33c934d53b40f65631431698a7c9e834dc3a629b027e70c944d53604f0e16059ba6c8e7d918044c9a429f679f9bb2698ae61076cff106cd521f6a4e0ec3eead5d5fdbca3d9079fb5ae6596b236f2bf71be6d61959bd67193a8bce86d83d56193a8bce86d8e7b30c9dc5b516660f4286ce821c86d36b8fa1b32887619fe9327416d758c47dafc07758cdf7b5f8f758c51bd35bbee5d8e4bfe67be2d2ba3304fbcfd465ec6dcc50a30bb66c97d8e3bde76cbcdd6abe6bc4bce16ae8989fc9967a9fc2f9df3cb222073a0babeb9a9f4f96fa5983fa2e63bad881f7f2193a6b029dd9efbe183a977bcbc2b9e4c38edae07913436787af73e29da7c3fb01bf376de38d184767ce5b3186cee51e83f0de619690848f8b5fd5f920d079b4b382fb18eebd56d5f9f6ae8ceb37ecf76530bdb1885e4887fe818f2751a627f26b1a3ac164cfefb55f7b8bc6a7c77c9306d35b0ae8393ac9ce79b1f4043e6d2c75cb5826f0fb69889e27a0c77ec706d3db0afc2fdc5b9125e17f227ff6342b7a8af9b832c09b37914f33debeb16922fcda9e04501d9c4d53e8db7b3ab4fafc7ea3c03b39be3f32dfcbb1698a7c7c75980e0f09669e2d688afc7c7e20d138961b23a1af33dfd8b1690afd3d20c3b1244d91cfa7f9f1ec8c89f1ca7b3ca1cfc3eff2d834113e1f11f0de349ba6d0e77734b8ef43e72a6c9a629f67bde563d314fa7ce024070daab9b3698a7c7e664ced344f93a129f279f6fb3f364d91cf1f7ac47e3a63e2bda0299ee7c9707c46e6a7b7b782dc1c9afd6610c8adb273c45894f392c8310478a7b5734481bf27e18e2ee89e8f81dc7c5fc8d39bf3ce90a5b72eca7ba7e6644f125cde7bd15b949b5bfdec9e00c2d7cb6f12f9b934f36d224befbe40ef98244e8f406ff5987a8bfc7c1c93c52cc1ace9a5778c3c9f64bf67accb783d9315ec3d9d601211019671f57c52e4e3db335dd00366ac4b6f1fb9fec87c03c9d059b4f7b492ddde8a465cdc89aace42ff3e4f17de00936795de4b727d91f96e92a1b370ef196c75ba7030f344aeb3d0b713622e75cc5eacf4c692ebdbccb79640ed283b9315f976b40a2dc3c1ecb7f3f349916f1f4e8e21c2b06fbccbe48d33fb7d264367916f93e17830b5979871ce7546f8f6701260f294d25b4ebe6fb3de74327416d7552cdd0ab65c9cc1aace22df9eecc8d042ede32bef3ff93924f31d289ba62827b7126a8e22ccd814344539f953e230ee11b1690a7272cedb51364d414e9e3806315602ccff3a4d91afaf7624f00f987d7de59d299726fbbd299ba628279f2564f834c0e4cf054d91cf93c04a1c03b3c7a9bc4d15eeefe037aa6c9a229f5f0ee870176172aa82a6c8e76786131194cf57deb30af662ac77ad6c9a229f0f23628c503e5fd014f9fcf644ec117897954d53e4f3185cfb3a4d91cf63f0cceb34453e8fc1b16ebc9be59e0d21b085ab67b2a2b32104866ef57c525c674cd75f544db9892baa8a31593d9315ede511588a559d85b5163dcd035175ea26be9c2ad658f54c5690c36030b5aa3a0b6b2c272b98e99cde3bfdd277916b0cb4970b8ec49facbf74b28aa9adb1df111bdacd2e34bafbf61ff51c73e2fef4126c7b643d095d36efbdb326a53ef7f4c77d4f695e30362cfeb75f9429f7d3b7b518eb5eb43ab2dfd097310a6a7c5ffb315feeacd7304beff34bc0b799d5e3f2ee8b6c4e9e39dfef85b2eb1cd913aecd16b48c3951b3f91c96390a35c73e7f3acdb1d2deec7338dd3f7cfae6e86b03deb51efcf88ffaf5ef0d99fde7f02bc762895d1bc444d1bde787d08bc8ce3396f53eb4973b0d06fdf122edcff87975f2cc55ec1823f09ccd35ef7a743d092afe9af7c4cdef3c446e6f729cee1fbedc9e7fe509e844769e79b14919ab0594c9acfc16b48f17e93bf751c7c8c5eaedaebbd13cf4a2ac7f745d9e03b5c98f178dae7856e5df02f264ff7ef4cd5decf656ef9b52ffe3cb3e6e1e3ac65decdcb0bf6abf07722437fadfd1b70707ba9e7c6dec4143476aae02b737d95d31c1587da68b3b37ab6f6aae62461f7e58cee7f2371cda75fd9bf78394ed50f018fcbcac1574606286dcd6a9f2d836d63c357fb8d1cec6ee653dfff0a23b9d1a5b2cfdfa773c1edf6eacabe8c08a8b9dfb3effcce6ae3529f5f22e61bfd9d7b957ff717bf38f8d3d789fee1faadf5db1ce1e2ff88b6e8ff419df65773d3c83ec5c10cf8ee8cefbc3e79bb9fa7ab147dfbe19ee5c33dc79c6ea708b89c702fff63a47baf6dd8f6fee3eddc8abe05da57a64f8a2f65df2f6a85fe7e4a36bdc699bd4af120e66d9f364e718c794e6d137c3f7cd73053bf38baee719a6a0d77bd839c685d6cbe2fedb1aaee075a941afe87bee9a2b2dc3305c939363938b1e8fdae925b88f890daf555e340a3629df58ff4ee73ec73ef1e9c17d5662c726ffa563f3b2f63f69a9073883c6b7351ceb8c75bb46ab7c1fef1cfa99fdf4c43347c1c5172ed82f485a89ffc8b7bdf5d83fc3b448e89aabc07face10d95fcc85f934c366a9f43a737abcc2397dfe67eb45e25195f63f5456da255e2eaf1fadb0ce755f78ccc7617dec3f29c7dbf2dffc7e3cdd0239bfb507a5cd60f8c1e194d8c1ed9fc3244e851e2cdd063e79672b4120e7183a7639fbfcab2e518d4c2dfb17468fe2ef32bf6ef6a5859b98e355cc0325e66c3be15bc44ae0e65acc6eb98ad2dc0472bf35cf1df3387777dee37c377777fd99fd430bf0a0cbf329e42344af933d6c1347ff5bf5fd6f31fef914f27c37f64cb73dcd8f3835b9a8bfc6815fb661838f6a9c06630f54f6acebfa05c25ffcdf18aeb59e45334f4d275cb18a5eb4b266f8e1ba8b90694d7df74cacfb3ee4e025ad773aa1f0fc86fae72ddeaf317be7c3daf9814578cd2a6dd8ccd9a7c3ad1b99437d24fcf08f71bfbfce93f1ffe5cff37b8e646e1b71f85c926c3ee28c6ed4233fcdaace783e9fee1231d136af78178bef49dbaccc5cbe3759f52c872f97bbe8e84d99c7959af10b4fe77f47b935dc99f2ef79f5f2feb48be2fea79d108f2a7cb6f73be2feb87b8a45f838ef70cf6200068e8b51cbda1dfc98b9647561e7dc1da585df8beead96f453aa6b2bdacfdd859433598494cedd17f2ff6759f74a393dd89a7d1f9c78ff58b8d6ab64f79b9bdc9e1c5bed0e08ecd95cfdafff1a279a9ae93e5ec517617e622e39fb159fe2d74de779579f45592b72ecbde8b56bb4d9cad13608ee0db83c059cf434896bacf167b86ca6f42d73c7f7acfe4d34b78fecab0fdbb57c691ed6decb9b66160f4fab1762ef9cc277d9e7f5c7220660da7f7b22861db18e1c137b7977a15abf6116b09ccc3e3f098013c468cda8c7724af80bebc5a52ac19804c9cfa91779c42367d67d23f8363b0ef73747e8a01995875a1f4f7900e1a67dc4ec0ef79e3ac01bf37d8bfb720f9fb9c3186e4395bcf4c9b6ae0182c266cbf5838801f3d716ce4403a9f09739ceb58d4d76f98f94def25c3b06bf2f09ea19ebaded15a9d7eac7d536fb2b807d78697c5c87b492c406f2b7909ac24fb7f3194cb7b472b3cfd5831c02b70be49e01824707a1386bfaf1fff07d92e26af6c5e04184fb65ec47b49a079c1d33932e9904cd6234726602e21439e4c4b4026e7c4904963c8d467cab43afd1060fcad85704ce2f5e3ffa03922b1e27e927b02472f2876963cbd205e06d9f78d8b9c1e83d78ac16b2bf6d73436c0f568fb9d7f99706c7386e20a61d784f96db064e838f75e12787e178fc58af5ad66bd8a6c4b59dfea2c7f233acba6cbbe683ca623d6b78ef172b1a94158df32c7921ddf16931fc277b27912b4cd4068579beae53a41696d39b1ecea1924bbb30aae79c307c307ea7d6f7badb4467e647749e87acba89b7d1cad45d153f86d9ff51708df329f66fc3e60fc1eecf7ff71b412c6ef413cb98f2319c2bf270cf909437ec290bf5aeb78f873e9fbbeead7d7dff4bb99a987d40c77e3c7dd7163f78f25ff32a6cf0ffdffcbe7fd97483fac879f89b7d77fdce83c28ecfff0e319e1b576bd73dec98ffbbcd26ef533602d1f3f3e7c503bababfec930bc87375a3b6ace2b35d0f1e3c3d1594fde3776ff4f8ea1dddc33251fdb8d191ea8b9fa9edecec4cf9fdef063eb45abc07face2a8fedf02b77fb8e0a54e0e743d2ed9a56a73d79e5fcf0545b6497c73a45dcf15cbf363a58e64eec28ded7f34ea4b22db14ff56b1cbad5eb0b89ded7f6db27d5fb92605eed59b6798171f2830577be3a36b0cbede9a6f9c4f6e8f7c5ecfc01c7b92b8c659f7cd65736d583ff4af63b63157170ccfaaaf9c1c7b7eb8d6a1afbf75ed514c8df07bbabfe01fdffebd47432fa25f17dcecdd2735e7a11bd4747f9e844e6f56c3203ee0ea16172cd99866f5f662dfc6b3931bdd692f6b12bfd877dfeef3a1710ee49977c9e676b64734673dff74124eee5cb155f5df3d7374a0f97989758d2b53df7991ff998e45f16f95bdf5ad2e558f9faa0fe2ea439798189d3c73b5f3a2d1775e6b2ad52ccb675695dfb1ebf9e2df5dfe33d9bfabca37797fb12f98b7454d223bf779f7a23bdd8d6647673dd71c9b7cb8f6ea3b8d471a85fdfa9de882d6f9c7314ab534012dd7bc0b60bb4dde5fd624f0a2bb72bd65efd8e43fda9bfcf8f6e0f0628f34df1c051b530fddf759ea3397df576b19473a3afd7122ef58eb8150dc1bcacea5fcd8edadbee9a38eea9950e87be993c0d497d313bfd0f3e24f621a4d6cdb428ebc97410583834d07ea91d0a015a068813df01bb4121c2d08ffb64eab8ae9c6a405f64468d0c2d90bec79dfa085b2178c91dba085b217dc03a14e6b8ab217dce3be410b652f1847b7f0f70c4fb58a71c2884146cf835b4cdffa1c30e708414ffbcb1ab5a771d1c71e158700d66e4d3f0da91fd4e3a0a6df12a71fd4c3bea61fce47613cde9a7e3d9c7e604f839a7e5b947e60cffa9a7e48ff04317b0b5fbf61b3e27db4d9c3e0a663a56f01ce4f9b3dea0b3d2b7de931be0ae1fa02ba22fd15ec5900e88af459a8273da02bd26f41ec5f4057acef423d0a005db1fe0bf4a00774c5fa30840f5cc4e80d0756c2871b3d096eba56fa10e07cb8d973bed0b5d2671ee5c3008630a02bda87811e0480ae581f067acc03ba627d18c219067445fa30d87300d015e9c3504f794057fc3cdcc4222ee71c9777dc4fc8f50fe83150c856ee2b80897da887fccd6ee5bef1083d41bce2a69e33949e604f81a69e3a4a4fb8677c434f646e0b621a37f5f4507a823d049a7af6717a823de21b7a22fd16c43d2e62347fdb8fdb5b803d031ab4707307d813be410be7b32036729d166e2f06f70868d0c2d91eec01dfa085f457083fb9410b672fb027409d16762f06f57c6fd0c2fa2a80b15cae5f646ff591be0af50068d042fa2ad4e3bd410b39bf4238cc755a485f05dffc3768e16c0ff6746fd042fb6a13abb9410bebabc01bff3a2dacaf423ddc1bb490be0ae13917b46eb8bdc89c167cd37f9bf32beff811eb11d8b3bd588f2a7dda51352500f319d01597d3c26ff8015d71392dd8a31dd015e9bb202e34a02b2ea785dfec03bae2725ab0273ba02bd28741ece862eebe610463f765d01bfda27e527e978fc991a11eec85ae95beeba8bd0a802f0de88adc97816ff2015d713e0cf65c077445fa3088410de88af461f00d3ea02bd287a11eeb80ae481f0671aa4bba5edec623cf1aa037f70d5ab89c01eca9dea085ae8b35b1acebb490670de01bfb062d648e05f5506fd0c2d61200bceb062d9cbdc037f5755ac89c01ec99dea085ac214098d8175ab7feeb690cd47ba5d7df6732628b8b8f9dc56bf106b4380f6cf677973d17acc87eeda18e9419c0cdbecc05b79ef9f99ef7d64fbdd1df9e5d3b8330b4013b947bab636dc13b4bacd8a3dc671d6f13085b9b6d1718bf8f691710679b6917182f44e5fc11b28b236517107f9b6d1718df8f9dab4158dc4cbbc058222a6796905d0e52760131ba01bb947ab74bc56703af9b491bc4bb5439e704684bc61084e3cda40de28f70680398de4cda2016a6cad928405b2e7e40ac6f266d109f84b3e70470bf99b4419c4c95f35480b65cec8078e000ed5a9f78a9f869608373e94bc710eb0c96415f368e00cc702e7dc95882f0c3b9f425e3897d6ecba02f195310ae3897be6c5c0118e35cfab2b1c53ceb65d0978c2f087b1ca05feb492f155f0d1c722e7de9f8629d0f33e8cbc617804fcea52f1d5f4dac722e7dd9f8629e2933e84bc6178461cea52f195f109e3997be647cb1cfa119f4a5d7af26ce399c1397fadfa3f37910f31cce5b8b5ef8f8bd08fbecba9e7397fae24bcc6d00163ac73630de22fbfc05c245e7d806c6a45338ef866d231757205e3acf3620e61f67bf0360a7736c0363d7299c91c3b691dc4f4198ea806dcabdf6f13e0fe2ab3765aff4dd47c72be75cbd5a632af5e0c7c71384bbceb68b5c5d02c66067da45ae2ec13b8b87ec221b4b00363bdb2e92750910a79d6917d9ba04fbfc1eb28b6c1c01f8ed805dca7dfdf1710462b98375cea2c73f3e8ed867fe15bb94fbfd4be40400c63bdb2e927104e2bd33ed2219479c7b02905da4e3a88903cfb68b6c1c4198f04cbbc8c611fb6e016417c93882b0e201bbd4300424727800379e4b5f6e0fc5b98fc0a02f174f209e3c97bedc1e0ac496e7d297db4371ee3030e84bd6cd21cc792e7db93d14883fcfa52fb787e2dc7b60d0978c2f08971eaa2f56f10aa4f6f80d8c7a2e7dc91a05fbae0483be648d02c2aee7d2978b2f10c79e4b5f2ebe38f72b18f4e5e20bc4b7e7d2978c2f08eb9e4b5f32bed8773218f4256b1410063e44bf848d80cf03413c7c203f2ee324e0eb1fec7b1c9575bd8c9920535b6fe2e4b3ed2279ce0b62e633ed2279cecbb9fb01d945b6e60760e9b3ed229707c2b8fa4cbb48e6819cfb22905d646b7d00de7ead7701f07ebc893fa1fc8efcd62bfb92cb9aa36093347a49b0d7d93a0e3f247bd6c3b1d97b9b1fffcdb1bce171d4de9c37b13354de9e57ec909fd98378439c9c1bc0ea07ec91df6548401c11768d09c2ed67da05c6065579af0ed905c422e2e4f2009e3fdb2e86945d606c7fb65d40ec509537ee905d409c22ce1e10c0fc67db6520671710ffbf6997e23dbc647cc2efe201da30962e9b764887fe015afb01da923144432798ec2d91af146fe8656833dfd203b4619c5df6baaf93ec0e098ab65cfc184bdd3296757c13166d18fb48e1fd3d401bc6e065fa77b8b7220bebdf92b1e36956f41443f5fcea9c5279ab2f173f8c37fb0cfaf231644f02e89c89415f368ef67468f5a17a2483be642cb1dff933e84bc6d3ea301d1e12f19a71a32f1953f30389c6b1c4f8cac615b3370083be6c6c05643896a12f195fe91e667616cf39953e0292f105f71360d0978faf88806f8018f465e36b4783fb3e7416caa02f1d5fac1e040cfab2f11538c94183ceb718f425e36b664ced341f46d3978c2f76df02067dc9f83af488fd7416ce3f057de9f58b0cc767cc5ee1d6e340669fc3ee7550cf5b2ff753401c4bf65e84448e01bf75aae7dcd93d03b9d84ac21d5dd03df4ce0bb60d88e5aad21f01b40d8c77c9de8f4ccdc99e2498fd48661bc9bd94d5cfee3509e7fd522f05a9fd0eb3a702681b1817937d5e4712a747c09e01a06d24636a1c93c52c11e63ca5fe0b123ecfe9c350913d3fb397ab4b38c1242270adbc5aa7b9dc65908ca7ed992ee841bc56153d1b64fc9dddbb01b28b5c5dc24a767b2b1a413549c82eb2b1749e2ebc8178cf56f47990f17576bf07c82e92758960abd385239cc372bbc8c65142cca52edc83977a43c8c411bb4744adce999dd94bc651b40a2dc311d66bf2bb0c927174383906fcfe18b28b641c71fa4a4076918b23321c0fa6f652e82fb95de4e36838a96352b3ed221b47cc5e14905d64eb7b966e055be8ae146417c9389aecc8d012d784aa7d2b64727866ff0a067dc93d949550731489c7f5465f720ff594388c3b990cfa727b284ecf0b067db93d54e218c458d5f1fc79f425e36ab523817f10d7882afd3124e8b3fb6430e84beea16609193e0dc47b9c1b7dc9f82281953886780f5be9a921b9c7877b6b30e84bc6d7724087bb489cafdee84bc6d7cc7022228eaf6a1f0ea93d38ab1f0783be647c85113146e2f82ae84bc6d7f644ec11f80e82415f32bed83d3c18f425e36ba95b863590b08f647ccd342bb17a08fab77e1f32e7bcecbe1f95753d3fb3973ce7cdde388377a02beb7a7e9741bab69ee624e2da56d12344eafc9bd92b04b28b5c1e48ed99414cf05d146417d99a9f9ee6dee2f39ea2af88845d38fd4520bbc8e581d385179300bccb07d945b6d677b28299ceea2dd9c074add9a0827b614e7efce7f98fcba265acbe7d937d67a64a8b84eefb3cf418bda35c7315f9acde42eb0a66cb17b529abaf6af6b7173b97fb154f4fa4eb453e5d737bcc7e4a157c955c9ff4f78cbe32930c2b0465bbe7cb6f59bdc6336c1a000f97279f175d30d4415cdc266db4de57ba0d8ce282269a1687464cd70fa1b366e2665cff9ee1ca78e66a07f5cac9c635fadfd18b56276a8fd86391ff3dc3562978b3c6f5c2ef51ef23e85d691ddfd83de9b3dfbeac0f785d1f75e8fe58d96ec75c160ccd2c6684f42e98c3087a394e0d4caf8a5159b79b11fea18b32fe0d342771ef08269bf56738dd3f04d97d3cc65ce41877dfbe39fa7459f7f5d6e4f32d5aa673da8ffb3edf6d6ab8ceb92ffc785186d99fcecd5fcefa2129e127df30acb235c71c9d3c23a7679e77de336c9feb379eb13a39ebc9ce37cbf6cef18fec128dfd4342d70f3b2f1a69707ff12b66d23c76ec41e299a398da83721c9475c0e2d05cbfb9dc1d8c193af37168ae3472dc271e0d260ecd95467e17abdab71ba2c3c1a1a9d11a8b68f170686ab42c212d0e0e4d8dd64c448b874353a3b514d1e2e1d0d46839025a5c1c9a1a2d4f448b874353a3b515d1e2e1d0d4681d44b4783834575a17dc976aafb9460cf27168ae317dbb6bc79823c43834b7f9e6da9b49103f1c1c9a8a7eb53e86907e1c1c9a8a7eba503f0e0e4d553f918f727168aafa2522fd78383415fdfa22fd78383455fd84fec9c3a1b9ea50e0bd607c94894373d5a3729f4de4a74c1c9aab2e95fe47025fe5e0d0347415fb2b0f87a6a1abd8673938344d5d857ecbc3a169ea2af65d0e0e4d435784ffb271689aba8a7d98834373d5a5c07b41f9300b87e61aa7953b63221f66e2d05c75a9f41812f9301b87a6a12bc687d938340d5d113eccc6a169ea2af6610e0e4d5357a10ff370681aba8a7d988343d3d415330f3371684a39477667e52c5effd8383457dee57b5982d8e7e0d05c7997faf8f0f5e4e1d0d4f5d4447af27068ea7a1251dc737168ea7a0a735b1e0e4d5dcf9e484f1e0e4d5dcfa928e6b93834753d857ecbc3a1b9f2ceef9f08f7623c1c9a1a2de15e8c874353a325f4591e0e4d8d96682fc6c5a1a9d112edc5b83834355a627fe5e0d0d46809f7623c1c9a1a2df15e8c834353a385f055360e4d51bfc8ee78887d95834353a325f6550e0e4d8d96787ee5e0d0d468097d95874353a325f4551e0e4d8d16c657993834355a085f65e3d0d468897d95834353a325f6550e0ecd955681f722cc69793834d739bf728787bb1ef17068aeba547abe886a4a6c1c9a86aec29c968b43d3d05598d3f270689aba0a7d978743d3d45594d37271681aba0a735a1e0e4d5357a10ff37068aeba14782fe27d190787e69abf57eec9f073640e0ecdad8652eeab22daabb071681aba8af7653c1c9a86ae421fe6e1d0347515fa300f87a6a9abd0877938340d5dc53eccc1a169ea2af4611e0ecd4dd7cb7d00f159030787a6464b9833f070686ab4307531260e4d8d96f0ac81874353a3253c6be0e1d0d468216a096c1c9a1a2d51cec0c5a1a9d112e60c3c1c9a1a2d710d01834373fdaede4ba4d967a51e5b523834d5f3c0a4d90b057f2e08c89ef71211cb8cc1a129f6bc452f91465f18a87686c1a1699e21027d61e4ce129bf628f71241d904854353b50ba387326017140e4df3dc116717140e4dcd2e06d62e381c9a9a5de0fec950ae86c1a1699e55a2ec82c3a1a9d96580b50b0e87a67286c5e8f505c7a71887a671be89a48dc1a1a9d0c6c7100a87a6429bd1e70ba28dc1a1699c89e268a370682ab4d1f183c3a1a9d066f4f882f69c181c9ac6392a8a360e87a6421b1d3b381c9ac6199a54fc887168c0b357ac9f2370681af425e2088343d3a08f8f25140e0d785e8ba38fc2a169d0c7c7140a87a6415f22ae303834e0192f32b63038340dfaf8f842e1d034cef7a4e24b8c43039e0b63e30b8143d3a02f115f181c9a067d99f842e0d08067c9c8f8c2e0d034e8e3e30b8543d3a08f8f2f140e0d78fe8c8b2f140e4d83beccfa85c0a1a99f1bc27d94a17a010687063ab3c6ed45503834f5f358746ce17068eab6817b2843e72f181c1ae89c1bb71f41e1d0d46d838e2b1c0e4ddd3670ff6470bf83c0a181cec671b641e1d0d46d83df4fa170686ef7b44bbd44303e8fc2a1699ea7e3e215854373abd3947a89a0e209854353b50bba2e81c3a1699ec1e3fc11854353b38b442c6170686a7641d725503834cd737b649c6270686a769188230c0ecdf5bb722f114c1ca170689a67fdc838c2e0d0dcbe2bf512c1e504181c9aaa5df07184c2a169de0fc0f90b0a87a66617993842e0d0d4ec828f230c0e4df34e01328e30383435bbe0e3088543d3384fc6efa1503834e03d04e41e168343d3a08f8e271c0e4d833e7a0f85c3a101ef2ee0e8a370681af4f17573140e4d833e7a0f85c3a101ef3be0e8a370681af4f1f185c2a1699c754bd428303834e01d09645d178343d3a08faf51a070681af4d1f185c3a101ef5520f781181c9a067d747ce170681af4f1f185c2a101ef62e0e8a370681af4f1350a140ecd8d7ea99708260f44e1d034ef6f20eb1f181c9adbbd95522f11646d1d814353b50bfe9c178543d3bcf3813dff46e0d0d4ec2251f3c3e0d0d4ec82cd03713834cd7b22b83c10854353b38b44ad0f834373db6756de8f037d5614de91177d564ab9ecce31be805e28c03a2bc4a1b9caee7f6cd6f31023330287a6f9e61ce80b23f7f6bc6987fccc9ed1ff0bcab9313834b5bb2f70bf1ca8c684c1a169be53c7d905854353b30ba3ef1794cb6370686a773ce0ded5c01e108543d37cdb8eb20b0e87a6661746bf2f680f88c1a1a9da650af7e302ec82c2a169bc87c7c7a71087a64a1b3b9e381c9a2a6d740ca170681a6fe891b431383455da687ba37068aab4d1f646e1d034dedde368a370682ab419bdc701ff46e1d05469a36307854303bed547c78f1887a6495f2686c438344dfaf838c2e0d080effb91fe8ec1a169d247db1f8543d3a48fb63f0a8706ec09808c2b0c0e4d83be446c6170689af4d1f185c2a101fb08e0e34b8843d3a42f135f621c9a267d7c7c6170689aeff125e20b8143d3a48f8f2f0c0e4d933edafe281c9a267db4fd5138340dfaf8f842e1d034e94bac5f081c9afa593aa38f3274ff08834353bf6700f77a85f622181c9ac6fd14ecd8e27068eab661f45086eafe181c9aba6de07eafd07e048343d3b89f82de4ba17068eab661f44f86f63b181c9aba6de09eafd0791d0687a6713f051d53281c9ada993dba2e81c2a1a99dd9a3eb12381c9adadd17743ca170686a76c1d62570383435bba0eb12381c9ada1d0f7c2c6170686a7641d72550383435bbe0eb12281c9aaa5d24e2088343533bb3c7c7110687a676668f8f23140e4dedee0b3a8e50383435bba0e308854353b30b3a8e703834b53b1e327124c6a1a9d945228e10383435bb48d4f730383455bbe0e3088543d3e86f80df43a170689af4d1393c0a87a6491f9dc3a370681af4d17b281c0e4d933e7a7c5138344dfae8b842e1d034e9a3e733140e4d833e7e0f85c2a169d247db1f8543733b532cf7d4c0eff18538344dfaf8ba2e0687a6491f1d5f281c9a067d7c8d028543d3a48f1e5f140e4d933e3abe5038344dfae8f842e1d034e8e3e30b8543d3a48fb63f0a87a676668f3fe7c5e0d0d4ceecf1e7bc281c9adadd1789da3a0287a66617f4392f0a87a66617741e88c3a1a9ddf1c0d7fc30383435bb60f3401c0e4dcd2ee83c10874353b58b44ad8f894373c1739887f451ff768d4108d0891d7bfee9afad3f6348f7f70b0e03b56747af8277729575b2a366a87bc9c7d67aec9fad6015535be3d111d188c97a1242d830255dbe2830965e343a6cd6ab64babf3fbd88e538d2f7d5970bf47629d1194c178781f70cd4da4a747cb66d35b7777fc5b369d8b9ccd337ef4e579c9a8a3d80b5af6487f8c51efcb811804db19eff788f374c192eef2b2e8d02ff3d93ff0d17485206fb1cd2f759652c8198b9608c98abc38b7d3abad1dd37845b91d994450f96f5826984d75f7b5993a3c4b87f5b8bb1ee45ab634306f67855bf61d921c32a81e21349173f7638796c863c32b4b163849b778eeea39eb8127ef2123c95e6a2caf85ef19fc463cc1c2f681eccc60ad28f2547e667390e544d7694cfc8f94bc9c6e8f903379fef8118bad958a81fde4f506b8b543c8b6c02fa15ca3f8eaee9c9f888f4bc82b045fc629fdf59f680c745c94750f309680fc17ca212bf181fe1d9854fb35339b4179beaae7942fb499acf4cd70f7dbc9f1c06d345788230d3aa7ec2954340171df7a87cccd9eb916f9fa13846d346c6324a1ef791290fc35fc4b6e17fc3f61757315765e6becf637efea590537a7ba68cdc35813f7f4cbe10b9aa74fed72ea760d3f5f61cbaf01c8aca819cf70ef2329e8de17cb8837c69aebc2e737c5739ffe1d857deb618ba1130b7b6cf7552ff05f7d26cff45d99597bba8e622e9bc00cada3eb798b0d6f3d6eb73bbb99f3b3f70e92aaf9def63665d8467e74a5ec1b533234fe0f832877651b331eebe36606d89686ff639c3e7748df327741652c830df79c62ea4504da8371fb8e6f2cfd8cc7fc3a793ea22a273f98d888e3df982d6d60a9df437dcbefbac1ad1cd36d97acbb32fd403d937c2836f6ee11ce15687d5a0777bbd8d3dd736c38fadf5ce1f57c2e53b07e3e4a6df620be8ec7fd2e7f9c7747fdf0763e126378fef88cf773d83ed95addd37fedc7effde5e83fab75fe7cec27e5cff81c7ad88639c2cee23284b1ea7381afe23e807d95c55d87522b0ebf86801e3729debd0746c980e609798ef23fad7cb025aef2feb265eaf19ece7557fd104b2c42f0bb0b69ecfd5683a7b904e313fdf7c8f3cf363d77dd4207ce3badf88e4d1e038ce72899b2c53812cde5eeb63e24944c781e9dcf28462cc77021f3c1da7c098e7f9005a1e5fec376791dfa0e619c1faebe0e249240b94cfd4fd854183e8de3bf9f1f6755cf3fcdf030e96796f157bc65dbade86341ae9ee73c9fff3bf4df70f076ad39d6f9fa13bef81bf9e246e6f7cf4ccbb4ff7bdb27e5cfff667fcfcf0b3b1071a5d4377366ff2f71c3bd420f97d63f40962eb5fe55fcfc33758768dae775cb95dfbce28e51985cca6fee38358903779bfdddeece864ef13fc72ce53a231f8f11ff55dd603d358c1d82fe65d19bffcb05993936b8e06d3fdc3e56f50bf037bf0b5b107619a57ba06f9afb0d9fcd3eb3d7c39ebc39fb1197ebb66f84d5f75211deef7e059fc397c7b84644ed7b4d57e63845a3a6f16759c325dfa45d7901fdfe48d5c73d507657ad47bd49efcb759cf8e251b30f854e901b6d79c6a8e59b6fde56f80eebe39dabbe6f2e845773d50c6e7f9ce89cee1cb7af2e30336bf7eef1895bd4693f7ab1e50fbfc05f58cd8d8f303b5fb377fa7669878e679f7b6f8d8663529e698e9b1db2bf6a72f6b3f74a2e3a7ff98cee3dba3db9b7c66bd15f60f1fe9ffff2dbafbf6cdd1d794f17b6a8631b589b6b1efbeb35cba37393af629d7a9f4df65ccebbf4f6581ee1c64bf4dedfbb29e847e94c6f2f26fc91cd3350dbdf7bc674959e6c787cc6f5eecf9a717ad02df0c7fdce0635b938b29bf63dc15737ac96f9de8aeff62dfc51b7bf5ed0f3fb6291ddf0c8392ddaa31f4a81f36eb49e8f4e69faed1cfe6142fd277eed5e74bffe5635cfb3df9719f574717bc6b97fd3e708dd3d18b56065d4f92221e1a72fc78519861b78c9f539bcd3f1de3efd939b59f6febdf185bfbbdc9ce2b62e9426fedc7cefafecff839fbbf826ff59b7c454c97fe9e8e53ed77e07d964c8fc14fcebb88cf8b9d7b5e343a5de2842553a8515b4fd2fda9b39e686cff197d6d6c3fb56be29ba3d8077cc1b1cf9fd4e81f37eb79e8aeadebbc9bee9f771ed80b25e76dafbe8bb5ace1033bd70cdfd3f1a1f6e0008ce5e5ef7bfdd33757472ff9b8ea1eba600f992b4f12567307365fc7587dfbe62ac6f0aefcb63a5697dfc4fade5993f0b24e207847a384dae74f37cf0baa73dc654c5eec8b4ee9fa7da30df580bd8c4b3a1e315dcf830dc2e6b7df62c6fb99685eb4d21c03aa7ba57239c72c2f2c728fabfd521da3e9fe21cb2701db657f6ff8e855ff4c6747ec6fb90ca91f8968dc7c0d92a3e13b0d3d02caca3d73199a63d0a091f8cf93d037c90720c7fff3d7e93c4707c2b85d3f64bd85d2b1497f07f87041cb263fa95ed97c2b8cdd4fddebcd3fe9fba16a4f167f73f54d011fdabcaf12df0ca3b7d7744d1868f9ba9acf6bd76f6a7e5cfa26b73373adf6326c2e6e0c33f4cfc718ea8d5bf0dfd3f5bc47edd5b758ff9bbd80382e6ce918692e7159a7bd5efaedf2e8442bcd375621b49e9664d95d6ad6c59e8461db24b5092d7e0fed37ae76385eedd68817a4ad6efa3cea9775a3e9efcd715f977cb5b687bafeb6ba667068317ca82457730c211d9fc9c9b1499afb87feb31f52bbcfb7dbfa52e7c7d11c5deaf6e0ddfb742dd3ceec75398bd33fe3e1132b074effb6b55eef4f13768e5eddc72bf360cedff95884df9bf5fcd3b14fcc78cde4b9d55a815c33fddbe3fd796c8e74dffc1f33c7aa9e9337d6b7cbfeac743fb19e1b72eaf4b92e7e9cf59860afa1421e390d464d3f5f877a931f3f16f2388b79806f9d2f73bcb18b2ff7b71bf963b2799e7cbad11757c6ebf7f53cf6faef37f9aa76bc9e59707cfb2ea666f8edd8a78b1dd2dc3aef0f5df5c14b9d4fc02796e363f1f840f9dd954f22c767c6e39370f8707275880f943fdef84073ed958f2ec7c7e1f181fabb5ef918727c3c1e1fe84dc4954f4f8ecf96c707ea3d78e5d397e303e505373e502de9ca6720c7e7099a8f2eb4828f2df4aee1762e599a1f809ac861630f76eea3fe9ee615e9fed55a40eb4511abd0795ac1eb4992177f5e80de6d15bcd8f923cc8b3b379ca1b7f6b73e1851187bbd9914bf97c561305d70fdf0989ddb737474cd51e2b3d7aa74fc3fdd68f475592f56a7cbf8dc9fa07538a799df9f80fa7fc067e138dbf2f8c16b906b8cb4ebd95b790d4aff7dba7fb89cafc0b9d58f93ddfd5d457ea59673b74bf3efecdf79df55efe215df31eaa18e710e9df524daacb77f1c9b56f62de5bc7fbc1f0beac3d959c517b5477156b3efcda13d6294e6c22f6bb273dfadd2ba7e97e6bc9163eb9fee7356dfde79cf0f5ff4554fded644a3b6f6357e9a7ca5ebf6c21e05cb5e98f8e6ea38cfbe3b6dc72109a971178e47abc9787fda2ea3bb94ffcfcbe3fdfed55c7df9d14adbd864377e1a84bebddcbf3c3e3c7851b873d36f0f831fdffcdf76698cbebd78fc357ece643b7ac62aded8ced7f871f27091e56198bdb7da3f8c5cfbeeff6d5ec7e9df16d4f67fe8ebc3eaf595f9ef8b375bdfbbc6699fcab388ee34376ed002df9ca5397b2e07db564f7ee8f626ef1bfbe96bfc4476ae3d89b3ffffe364b9b1f5d08b1fa674eda536393a763fd53dfff7d336a7cde4edda77dfa5bac865ec6cba73edf0eb6df1b15dd8ab6fd7fcdfd7f849ff71a351e0c6a7edccb8fbf68cbb2f676da532581b7b127ad128ab7f5e7ef7bfcc0e33e34ef7a230f4f6997ca95c47d718fce4fb4ba64c1b73f4e94559eda96e931eb5b37b00d97ac278139efe4edb9861695f75a1eb1bab2c56d33c0ac6c3ce6d62663c38bc2d1eefebd900e7fb19effb23b5e79fbead71be5ff2be4fa8ad7f3ad1dd177dac8debda0f2f73d2c7367b8fc98a5d7bf2e39a3cfd3d1efff0ed791e3b421a5b1e8d0bb679dd2f4bf267ef2681f3a26b8ff19cce876387f1a52ed9e801dea069e16866bed5ec578ef5b15b2ff1dcd6e7cbefc5f4121cbdeb9989585f02f7f4aeeb7bf5c7469f73ac5f16bdd96ff3dd7173f10f044d074533f3778cce094ae7b20f2364847db929e3a5dfba98de81151bf9fbe7c63cd9789f2c315f5668fae6eed30d1a6fa99bb1c29c3f2eef9c73fe5f97df23e8c1e352a7779d5b11fa32e7d88abeaebd3a6cd6ab63f30d7623fe74d69a91bf1bbfe63da16b877bd7381f10340d14cddc0fc53a33e7e58ace74bdd3bc688495b18f94f1cbb1c97f087a0398deb67c8ff1a3d089f1db57f0b7ec7bf6e9377bf81bd61defcd63a9a662ee0a5f7decf738df68e037ecdfebe0ef5fd977fb378fa59a45f51bd63df88ff2fdba8d59ac51ecdf3f9d401e7b1e8fa718fca6c7d4e34cc03199b3c730b887f4e0c83406c783bcb3c71cf6931d53263204653a5bcf6c1f9982befec4198f253ce60bb6ad483003e562eb5ebf5757f061ddabdb3ceaa7375017f8fef4a6f1566257c9bfa0b382cda39e38973e9eb09d870fc606aae998ab1d4d73bfb8fed62c5b338f651a80cdd37dee61630fde5fd6f3da1ebff9fd4b30866a8c251ae4dbb78bfb48f97dadf2d87f5b89c7a771cded0a7be7f7c2aa73005f8e49e89aa37eb94707240b11e9639f7fdc1ee1d914aa557f6dd6447bb1c36fcf080fa51ac6e5dff3b70a2feb871fcf08aff7693edd77f2494d68bf78fef47ab3a3db5b6912f47efcf53cd9d873e88c2597af5a3f72cd30c9ce119fc32ffaaa6b6feb07de7b8a9cc6fcd3332c118dc3664da0bea4571aa1638495bd248b0eb5c9a76b8fa03b74c7f2f98a6b8f626a84df8cbbfcc79752fdb3fc5bce7bf663f97e7cf51bd65aac1dad22173c3aebc9fbc6ee5fd6e284fd4de9fe7ce99b11fbf7afd0ef9f383982762cad47956f586bb1fb5abe2baeefbcc8ff74838fadc59863dd57ed6c817a8c793cce16281761db77e14063a271c6f004e9c1912986c783b2654a403fe9b3659a8132598b07f6f80db7108f33673c74c698736ce568b05c6cdd6bf78f0a3eccf364ede83dc3ba8077e3f73a2b768fbc77a22fb676047deb5163c76fc6cb62f162e6c6292fd0671eb5336ceb8f633a3e90ad5f169c38cee403fdffc8ce91335e602cbf2c261c3e4fa08fbe2cd8f19cd91c9e338fac5cf9f20dac1361e499d9370138f71fb9b295f2c6ca378c18cabe8163fb38e5c856ce9b2b3660c57766ef19638c58b9f3c587c0184ff71f3cf9187660c6792adffa81211f9c0fbbebc311580fb2f73de53b1c57feb7375a0bf85da0bbde82f42e674aa5fb2d57d9aeef8018ef0c33f99aebe84dbe7a8f1e504ecefc93c90bd0bfc9dbe88b04c8cda57f80e6b792fc955e36b7bf5fdf35f1f318bd0fe53ef9db40a0270c283b33af7a7b2dd71f6edf6a6eefbe912bb165f7d8f41f41fad95bacc6b765fdf2334f21fd57adb94edee41f896467ac0999dc10ddabdc6781cc6cbad05a7393f7a9de070a94999727bf3d82f46f723363a9243b97fe6bb92ed4949fe18bd7778dfc3c77bd8572e3224e5ffb08d999713428d7a78a6fe73fd9d9fcbb486ec6fa9cc52740f7169f13703cf3b7c63cba0370ddbfd97a8cb03527df4fe74460cf56c8cda41f23ea74a99f40fb89eb9c9808e773468e72919b371f1281bd59fb99ccde1cdf9e8be29299cbbf556b900dbf2ef203d8ceacfc215b7780dcebead362ffe0ae3990ef5de711511cf2fc02dad35dfdc210f9052b3fccfc02c8a50abfa002bf60edab52bfb062b65fec447ec1dc7bbe55ebc00dbf980afc8295f7a67e01e5bdb7b9ae94fbb2d618565d399ba321da373b3f9d4473349bf601dc5b1772c37369456e8e7f80b40bb963a1dc4cda872301f64d85dcc2b983b947c8e4866817726b22b979f307b4ff28e48663a62237cfdedc787c8263bd2c37c7de8c5a473e8f94eeea33e611d659432a378bf6352e8b6fe1b864d33e80f5969bbd4bfd14c13511ee9d707cab9e8f34f326886e395765d47ad2359c39ef65b51bf1beec65c1392f4d793c823caeebcc11b33713f278d5d8f377ad06c5f0f3237b0ff571ccd61ece3c9eca87d1815d2fc9d620a6df64df8af76982ba59bae6033caef19ad949b85713f118947bc234f510eed78eac33ee8bfc9cf9a65cff63c9ce957bc9911bb56f3bf2cedb2ff2033c4af223f66e221e03a86659d6439833667270e319caa18b7816efe1f8f5d3f5a1dcefa69e3f1e85fbb8478d5dc35b6f41da451c0bf6725cda0778bf75b33d623ff7a8c502db43e73e25f9c57b3a7e4d39db23f1e652e1beee65c1d87f5de5e7cea382bd5d993f687f9eef0b73344e0dfcff63ee6d9b13e779fee1af4402ec9c7dd99692c2d598810582fd8e849d4248dafe0fda42f2e9efc903e449b66527bbbde79a9df33a768964c9922d4bb67e89ef8267c79bddcbce782fbc3b16f9fe25c8df7e22ec46bc774167c862dd91faabd85e26fcfcdc634f7ade7b11e4c1ff3cc267c9c25e2467be327fc85e807a43612fd2739fa02e91eeb5901fddec4576f62bf307eca527b217c4f94f581ff9f308d32ff42e3d038ae9ffaedc5d02c62f3d07567e038d5fbcce4bcf8262fa702da8347ef95ac3bd73958d1fa45f1abfec4c28a6ffbb670a72349f8873a1b07e95c40862bf959e0dc5f47fc3e7a1dbf825e72c5e1d2df55ba8f6568e6fc467ad4fde196e57e98dd9a8c34079a908ba7353ae9b407ce801e453ca0982bc4e20afa206057dd383ee0adccebac27ada04acd715b93ad11d5ce300f2c5d60a9db342adb03eee39ba0ed89c4b68af29d7c044f242f9f08abcdc7cacfb089e8d4bf5392edf9ee84c2a3a07a6f30be457caf3cbbfa395c80b9d532bf2f27927f32bc8ed88c73d87ee965ce7975bf3abbc6787ea28dcb14e407e98faa58bad5f36d721747db269c3d019195757a407906fa56e28e07d129e9dc5dff6446762c17df7cfcabb7e685e05df52f85b5c9dd439e3eba4cdf985f6af7c7eb9b5c808ae9d4e6577fc6b7d6e95eaaa276c5d15d877907553605d12efa9fcf377b6ef48f65601ef645d42d763817d07dc3f721be6d652279cef6ef272ced3e9bc8267f5d2dac4fdd6959cf3f9e39d0bcff0fc3ac784f3dd4d4e6eed369113f2354cddd745d77d013951755d404ef03b795d961ee01c43494e610c213a0f0aed56507fe2d77f269cef6e7272cef5999c923d95fbad2bc937f0c73b17e612f875dc09e7bb9b9cdcda323dc0b5654c5dda45d7a581f80859776eee2fc03decf25ecafff6c0f9b6bc979e457192f02e239f6f0fbc275ac44adc3d318907a1fc4311fff2bfa59c6f5135f0246ec0d6c081b85090d310d498279cda38a23eed70be2dc7fadcb769694c28cac90ac63c47d7c59b760cdcdbafd831f7db03e7dbb21d8bd626614e45c0b7f7e2a4ef03b8f2f2ebd9136eadfc66cbdcb707c681cb1753a777ce0a75fae6fca2eaf00dbf85f34a85df72ee5f3b13981fa2b6ef626bfb8dfcf55c74a75af25e23b163287753a9f38abe97e66064357b57e95e40837f0fcea794eae542d9857baef82e40151346fd2e41720e40df2580e4c6df13a8ebbcd2df8f774741f03d85bf2fd7a10477b973d905fe2ce3bf83731ed75a13fffdcf06ceb394ea44fc6fe5391ad1bd8213face02e0dfc0dbc3c2bfc5f70064b91ad9f70738df52f66ff1db29577c4f5dc65f98b3a9de63806417c623e2fb07c97ccbfc5bf8bd387723197b4f74875df486cc83f33025dfe67f2bcde188ee3d54f18984772a007f06ef33dc6c9c7bff3cf369492e47fcbdc3f9be62e3c2bb12274e3ea8b071e1f8c5391dd13d0a8f939b41dd9370383921e41d0e596e47f4ed8e932fb9dd73e0d7d323cefd0bd4fd0a448e47c4fb84bffb01c629fc5ca5f0de8634d723ba9771e0f0c5dd1949f669ec9d11c8ae05672bd17d0f8f93bb41dde770383923e45d1359ee47f4ed8e934fb9dab5e09e48c4b92782ba0782c80189789ff0775420bb067332b7588cf7062093599c0baadc2f81f667f07d422536117c7f16bf6f108f5d9c134abee5be6f4ee596ecd182f7c7a9dce233a6f87b496e483cf61e27d772935bbc7683df96e5e6e4d873b9253922f1f767f1fb0af1d87b9c9c4d21b770bec5b9a2ca3d18486ee97a26fafe2c7e9f211e7b8f730fa6386b71de14487328826f29e7dbea598b930f71ce9c1c4ce9ac05e2411afb3f8fc67b1d6fda75c6834a4f244e5fa9ec77af9f9e99c43d651c8e8c6ea577e233837a1d7fd2cdebe72e32065ee56cfc5ac2089d70f3dbf9b7b1fb9bfb2dbff6957dfbc91eb9dff2eb0fd9b7659cf5dab7363fc79dcbeb72f9dafcfa4efa6d2ffa539fab124e2c3f3f9efccefbb4fbfb536d9e0aac5a6e8fb6ec772fcb8560dc2bd9b80dc1b84d7e9e3c1b3779138c3be6d6cbb37143f65dc24ab547f7501faacfc4ffb6d5bb6bc93a20e9ad98fda6c933f1478c4f25bf037daac69bef5369fc1535c62dc4c34ef96ebc3aae7d46eb36c74f67fb11ea237677d8866b7ff798e1f8502728e32a7dbf38b77f0fb79b798633f8b6d86f732c9eec770fdf34fc08687ffec9c2a0373b3cdc680132de7e4bcd6a2fd817e77f9fae39fd7fcc21bd17877da4b9f2ec37625e7d06e220956995e713e2c3c2e054c15ab4ea72a73444faabf6d9037597f65613c9c2ebab76fb9d6792bd6bad3e9975d7774b387a6e64d469c5d41c9fd86fe3e8f6775fd7bb9fa93dd764afd014eaf18a910df3f5acbb78bbb9e2515ef77b50a767b74f3e6e1890d77d52682b354cacaa7eabf400dcb19b0cd57e787cccb8625ed37305866f815d209697877150e839c357bfea05cd3bc5a64ecfc9a08d55e727e581d27d8ed99dc450b00f55759aeb2b39372375f1f890d016cd418ed78db0bbe73ccf209f7b2e4645f1fb1c5b5ec5ee73fe2af69fc798a8b9c87f9b9ed1317671a59d9ee9b13a91cf07d6166e7484b678b395da3ca4580941efcfeff27e7877a60ef9d83d1f397bf8eddf3f6dbf540bb8d2badeb386f6ef829f51caef7ea778869bc530bb770ceea1379e24e6f2ec4b7826b10cfc2dd417f6d65fbd246fb3077c65ec805ddd7abf97c61191265e404d07607f548896211b13accf5b5fffdb6f5f969ed1c409a8d8c21789b1b49ece9271413d716ffdd8cb76d9ecf92db3cf5beff0d278268684ce970dea09a2b5928de90bb2c3a24f7ac5961b7dd731360dd14af12584e382b0f2ee8c5dde5bb786fbf3bddb2cd2febf1cbb2ecdd5ba97f7f8cd62abe618ae3c78eb49e267a16bddf51bf74212fb09d929c5fae3de27297fbfff60d622704b3697d8260b2fdfbbf8fd756b054766adc198f9f6bbc830b71bf241c34ba9ff70c1637678b8fdbb844e4cfbd3efca39a72ccf33f1bdf02e8f89eb6bd6ed779f0d1ccbd2585e36af38bd34fb6694c739146014977f3710ea373206b3c3c315535f3a9eeb5cc0721d7f4dac9c17883b56d18f70be521d3db30fcf0c0e2ed8e7b93aae5d18c45b677aaaf8425557d8f91fbc6cf2397ee4eaeb6a23d27125675ed72cf736afe94b604fd76ff37ee3df3b87bc43f87a65bd96bfc9e28cb50ff541afd0aef96f091b0df08b644d10f8f5db7db9d7e4993a8b633616703d3a97ef1d79d6f898f6668fc5efb6bcf21ba8e7873db316d99804b80d5eb9af61fd1bde1d95b7fbf25d91b22cbcf738e7f23d8b8a3c82fb115eb90743656cfcbb055ef95d48e51b4ede289997527dbc32367e5fb873a57f40850fa72e9cf2a1201f5e3eccabde472feb5930aed23bf5cab83875bd645ca5bbd9e571f1ea625ef5ae7165fe793525affa56b9fa0d278fe955efea56be11e8cce4d926af0ee055ef2656ed19caa5bf3d0c5f209b89aaef5ccbb464bd68bde757707da8bf4dadfc9bf88decb9d2f7a8b0056e3fda921ce577b4fcfb4f6f0f03709d8acaef2eb93c503d69bde74a4fda92ecb7f7992819f8f45f61fabcbeb4253d56de798ac61f09c7dfe7d147f6a63dff81d770ce5b50810cdcf5fea1d2efa869ef957e47b0cd0bf40ff96ba17f0e6d798fda73a5476dd3fe1b7d8860db11dcff4bed1fd8d7227e9f5ad0fe457b6072ae12d9ff6f8cfd0bf6cbe757987ee9cda9dc7644f42b7d8e9ae37f93da3eafbe96d83db4d71776cfa12ded599bda7be9ce5bd376a25aaf35d8e605f143adc711a0f70942effc7b7be9bab304f6f59bcf12f9f839ef17d33513a67db5fb586af35cda47384e8ac0feb5e0b8b9b1413a6e90f675dc3dd9b8f9b4ab3d6c9be396db8b708f8269837d6cc1718becc417aded4c3e6e5e5cf5fccaa37d1db7291d3797f6118e5b23b09f2d386e6e7c9b8c1ba67d1df740366e3eed6a4fdbe6b8ab3d8738eb39ef9ec4f90f2736e7f5b5057d93f756308b03c035f7baa722d6737ebcff5ced6d0beda7f2359dfbf6ef5ceb67d4dc8b96f2b585fb4e2f8b05a0f36ae9ed1a873ee20d616a37423f7de2f912e6ddddf90fe7dcc4eb730bdb0def8cf930acdcb302ece67acee2c78fe0bbb2f31fced9ad143fe66fd978f602bf75f39ee7f079ec6a2be03713ce37e2735aa5971127fe87be731fe1efaaf825805cbf85e711412c3ae17c5beeefc3ed8d92c8099e47abe71cfef795b774f0394730f639fe1c59e3eb1dc0bc1aeefc674d38e74b545fa3b30be7f4ca3273bfdd71ce85c5f99f7fa6acbca1533f2f567a1b71cfe4fcef4f9cef61bc95a6dc500e4cd6dfe85ce96f04af150279c1b5b9242ff7db13e75b598fa373a5c791ec7cdb9415fcb67ca6139c7b0f9c732ff6dc7c523a3737e506f7d9426e2edfca9b39ce595cb47e817cab9836229985670fd1b795f772cdbd9f7b8e8d38df15f2f262316b029fdbcbeb16f7db33e75b49cfa3544efcb9bb292bfc2deabc6c4de03a4084eb7b94c8ccf9fe3abfa2b1f738f58426ce4cd38f2ff0be26ed7d74a6bc6f313980545e640ea0e9bf17511cce8f8127bcf3bbfc7c6e71bf2dcd2f37b6afbe8d03f661fe98e7e8bc40d39e27127b16adcff0b7883e48679753772afc5764c760fda9898b03c458e0f943de0be94c79df627214891d637314407c258a29f93980092fbf20cf1f58dc6f4b76ccb9db9fc555c278923fe6393e6f01c455927311bf6e684d787c51f91057251f02c491c2dc35efcd77eabb709e0493a7a8f546e2e421b8bc4f3cde451e42b4ffc2390c597fa4c4a6892cb6e2e74e0e3cbe186ca1c48739bca53d9252bbc6e6559a760de74c10d83fa95dc37c51f91a57255fd3b06b4ece44dc37e85c7bf306d7e2e07af98197ffa9f48085bf3df1be15f70efa38bbd6d867cefedbb5168177307a5e38fef2fa0f7b2f5c7fed46efbf4af7793edcb70723bd0b5df9e626abef9a976fcf7f7fdd3d4ff7ee1b39d3240e7c9b83e3a50e09b6d6dd8959ebe865b388a8338c3d2b39ff0d7b33fffdd375ee7ebd6caabf2bee41916fd7ba8b668787da78211bbaeca9b97e77cdcb916d269fb5b1fd7a718cf8c509bebce775ef5ff04bdf4546ff5297f539ee8087334dfefbbf649e76e6fab035831e5e87f7be3de2e4eaaab67848fe7b1bbf2773f4fde720d7d524be3f83f9bcda9cd030f9ef93e2dc6b8dfbafd2afc9755b27a9797772fb935f9347fb6c43f76f1b36b37bdf6e1681ee7cb290858871f7b756d0634b85f93459c880bba00d1f33f71f3b6ba5ac6f8d71ff55faf2f924210b29c23f7711dde4f7c375e7d4df85d4613e62fc6f5e38ceefffabcc2dedb3701c52a0675f7d7e597fbacfdf72a8cf81be1cff840f66ce89c9f62cb4f1f3eeb798776711da21ede3e59828cefbfac8fc6948f0f31e69cdbbbe1cff840f62de0366d188f91e7edee316f3be9c06338b62f69f5c0e5b71de57115bee43ccfe9ccf7bac35effa72fc133e9879b7c3d580f9887d3b9f7732d29f776a4e8fc45929c831579c777260a375a030ef3d9d796f21c73fe18398779f8673832d15e6bdc5fe4e970f7be6d80a72ac14e7fde94c9db5af30ef86d6bcebcbf14ff820e67d6f5be380c60af3de627f27f1d3c01e1d4dbc1c546dde63ef321bdd0f09226ecfe7ddd499f71672fc133e88b359df5ebe9e674b7c3c4f5aecefc47a32c892627240b91c9ee2bc2ff664b93267787fef6bcdbbbe1cff840f62de7b74b932880fe7f1a0799fb5d8dfed701e9378ae20c7abe2bc4f8f76ecf514e67da033ef2de4f8277c10f31eb191dd272385796fb1bfdbce53648fa60ae7d1a3e2bc8ff7b67f1f299cdf875af3ae2fc73fe18398f70b4dce7c98f3fb661ab0c72ccf313b146f74b7cef0bcdbcc3f91f92183417d0dc4bc3eddfefb5fa3af9337230e85fa61d4e72262cefc96e77237f6dfd7d5db5f187f9b7c4db83e12738ec93f5478e1ea2b9af4156b1c1393056cb43b6272425eb83e6ae76ab47425af9fa88f3fcf3968d4d6125ed49f1e6c447c5de185cfb9ebd057f73d6712511fe81106fb5e7c5da754ed4a47577f61fcbd366b3a8d5f2f33a8978b98177a4dd7a1afee7bf6c05ebe423d8040dfbbe6b454ed4a4b57a8355d71fcad6a2f2b932cf1fbc795177a4dd7a1afec7bd420291facefe9e565f4748559d355c74f5bace92bc336573153e585cfbdebd057f5bd802c49609be878cad45bd3f574f517c6df6fb1a6fb24d96b3039bf2a2fec9aae455f39271aaf627b84ca8966be176bade97aba42ace9aae36f93879925f1428c3a5f567861d7742dfaaabe1707073bb45167feccf7f472307aba42ace9cae33feaafe9b1d7b3c3a788a2d729d5fc8b167d45dfb3fb6434dddb213a9e1a6aade99abaea74fcce5d449de11bfbdd36ffc2f6b8da6d839f420e468f874e1e863aa81af7b7178ebf98b96a918bd1d41b321fa32c47bb9c4c40431b93436ef053c8cbe8f1d0c9cd38531f93df77fbd3bd1bee8216f9194dbd217334ca72b4cad31cd8c81e301d7ef85c8d2e0ff57375388f6716e66ef0cd3f757336da7afb4b72b4cadd90707c244bc499a2c90fbd1fe8f2503f6bb33d1bad3067ed9b7feae671b4f586d90f74e46893cf71e683d9d2c6dc2168f043ef07ba3c94fd737d9c8d8e31e67e47e19f9ab91d6dbd61f6031d39dae4781647124e22cc7da8063ff47ea0cd43d53f8f6cc40eb65abca699efd1d7db5f92a355dec71ead4cdb573a8f28e77e7479a8e77fee0de24f3039f1c23f357340da7a43e581d4e568970bb27bb6263f7c3e4893877a4ec827a34904e169f0fd53372fa4ab37546e48438e36f9a1ddc18ee7174c1ebbc10f9d23d2e6a19c27a2beddb31da5784d3357a4afb74ee5d804816b5dbe7751eb7c5188ca7b34f9a9e48bb478e8e48b5888cab30c99734931075ae48bf4f486cc1729cbd12e5f1452738a792bd8e0a7902fd2e3a1932f0ac901f36ef38fb33ea55816faf9224dbd21f345ca72b4ca17ed997f3f9821d6b5063f7cbe4897877abec8a1e7d9e8a8e29fbaf9226dbdfd25395ae68b5667a2b6ff68e48bf47868e48b027bb4863073b8fea99f2fd2d41b2e5fa42e47ab7c11f167cbc541871f3e5fa4c9433d5fe4d3f8d8a34aeba86ebe48576fa87c91861c6df2457373e6ac224c9cdbe087de0fb47928e78b881f0476a8e49f9af9227dbdfd2539dae48b0e24f686b6837823d6e487dd0fb47928e78b7caf3f5b8e95e235cd7c91bede30f9220d395ae58b969e41974f983c78831f3a5fa4cb43f9fc7eec13e7e982c9bb17fea9992fd2d61b265fa42347bbfb446434b960f2e00d7e0a778a347968dc2b9a4736e6ad7be19fda778b74f5d6a91cce2e62cefaebd65f462b26a0e7f46d11eead65c09c41915f41df0d7f0859487bb87770ebc03de8f6e5d1e3a3710f5d5367a83be2eab45be48968cc4212ce3175ebabce22e57bc32135d90893c7bddaf344271ed4e5a351a3d2d419aa6ea44c3bd68f05ed21755626f5317757ae3a53cc0fc5c19e2dd941c99e63ad9a911e1fe538505f677f87f6bcc5fe4f076c640f31f5a9abce88620c48fcfdd18e8f98fadad59eb5f242ba7cd4ed4c576798f84f83768b9c1019ed0fd4f7907d09529d196a6bbfdd9b59d3038971fd09327b5ee9c47eba7cd4ed4c576788b55f83b6d962ed8f675672d640e4a16f3a53eddb630fd2b3928a3debd48774f9a8affdda3afb3bb4bd166bff349c8d5606e67dc44d67b1dada6f87744096ebbd823d6be58074f9a8db99aece306bbf3aed563d7c7ccf24f17ca8a0b381e2da1f9198f609a6ce75b3e7579db55f978fba9de9ea0cb1f66bd01eb658fb23db5ff56c5325ce54cdfb4c22b29cc7983bf7377bd6a905e9f2515ffbb575d629edcd22a0ceb95d1fe6657a1b05b35ef698333ea9f7615e99e96d17c45ab6b3d67b57b7a78fbe1cff840fea7d787a2f08f316329ff736b91e7f1a1207b54fe672a8f6619e44cc5f070af3aed587b9851cff840fa6966487d4608ec2bcb7e8d3381b8d0312a2f6965c0ed51ccfeb852dd911137fe7f3aed587b9851cff840f629d1fd8ceca64a8bb3fd9bcb7e9c34c2c76b41d1b934bcce550ecc31c2f42361afb987e26f9bcebe576f4e5f8277c30f90916ce2f34c6d4f8f3796fd3a731de1fec708ce97590cba1d88739396ffbd33da6e7413eef5a7d985bc8f14ff8e0cef493883a983e8df9bcb7d8dfedf86948e2a382fd2ae7732eb3a53754f077ad3ecc2de4f8277c30f36e2f8ffd19e6ade975de5becef7668f7e97287b9a396cba1da8779ea13f3a98fb9a796cfbb661e475b8e7fc207738ea3f16e4f4c4c0fbf6cdedbe5705e0db6a4989a472e876a1fe6c980382b13538fc8e75daf0fb3be1cff840f66de67a37540306f09aff3de667ff7ed1e5bae22bc1ccab99b98582b03d3e3329f77bd3eccfa72fc133ea8bc4dbc08c812e1efcef4b47356edf236ce349c5928fcaf83fbbc0ed4f336eb03f3f7287c341a5e0ced1e40fa72fc133e98bc0d0de900879b96cf7b9bbc4db80e6c13851b90cba19ab799066c49424c1f9b7cdef5f236fa72fc133e98bc0d73563143e12ce4f3de266fb37cf09985bacf96cba19ab7399ea9b940dd35cbe75d2f6fa32fc73fe183b997635be4c850f772b2796f95b7f1c9c136d747bc1caa791b1a539f04989a493eef9a7772b4e5f8277c10f36edae624668ec2bcb7ba8f3319ce9c15263ecde550cddbcca3d9320830f17c3eef5a799b1672fc133e987c9ded1f876c89e90d92cf7bbbbccdc00e09e62d602e8746de6634f531ef97f279d7cddbe8caf14ff8e0f236af11c5f46bbece7babbccdc4a4e60487cf9ccaa19cb73990901c90f8d5866ecf9e1672fc133ea8bc8dcf7c8aead994cd7b3bfc2cdbb0fd57cc79349743193fcb27cbc900737ecfe75d133f4b5b8e7fc20797af5b45b311fefcde2a6f133ef5ec18852b90cba19ab799eec9c846e10be4f3ae97b7d197e39ff041cdfb721e130c4e93137c312b7873dbf5e40968487bb87c41831ff69d8d360f9dbc0ac1e5553edc70f1c1ccffe9f6e4d1d71ba607838e1cadde5a91237518266e69f0c3deb9d5e6a1fc06ce8e99350e3131e5d6191eb7ceee5bffbd95aede30efed74e468d393e729a6c8bb710d7e789c645d1eaafeb9b7cd29eefe5ae19f9a3d79f4f5f697e468d193c7ee333ff05138814d7ed8fbd7da3c94cfe1261d5213b54fdffc53af274f0bbd61dedfe8c8d126dfe3d33ec3dd2f6cf0c3bfbfd3e4a1fe2ece2426eeee67e19f9a791f6dbda1dee069c8d1a2274fbcde137f77c4d45e1afcd0e7026d1eaafe69d025ed33141ef1cd3ff57af2b4d0db5f92a3454f1ebb47e3d585aac5b98a3d79f479a8dfa3b40ddb41f5502cfc53ef2db6bede30fb81861c6df2426464f7ece51193df6af043ef07ba3c94fd731e93d1d310937b2cfc532f3fa4af37cc7ea023479b9e3cc4b7636a62eeda35f8a1f7036d1ecaef65696c9b24c4e4d76efea9d993475f6f9dcab17930bcb787535bcc2fea500395f768f2c363f46af250efa93b0e8983cab39c779be9d9b5f431bfb4f586eae1ac21479b1ececbe038b318a6aedde087eecda4cb431d6375c846fb1073e7c07b5e479e75d1c7fcd2d61b265fa423479b7cd1dca42141e53d1afc147a766af250efd9194e7ddc7bc79b7f6ae68bf4f5f697e468d3c3f940e2630f75ffa8c94fa167a71e0f8d9e9d4382bba775f34fcd1ecefa7ac3f5ec5497a345be68e6d03ec5e53d1afcf0182f9a3cd4fb7585c41ca3f22c857feae58bf4f586c278d190a34dbee8f54c9cf11185215fe7877fcfabcb43f57c30b047eb3daa675fe19f9af9227dbdfd2539dae48b4ceacf4d1a2bed3faaf9226d1ecae7f770d223a18db9c757f8a75ebe485f6f987c91861cadf245cb554ce340695d53ce17e9f250f6cf95619bf6506d1dd5cc1769eb0db31fe8c8d1265f34efd9b1ddd7e287bf37accb43399f4b46764c4c25ffd4cc17e9ebad63393edcf0d4ee5d58c842e2d03ec69eb7cef03ff577612c64fe3a2488f7fe9e157c6d5b607d69caf14ff860f24f2ca406f33dfcbcb7c3f83a1213f5ae319743f55d180bd8681760ee91e6f3aef52eac851cff840f6ade9d799f61de815ee7bdc5bd7116a6f7ba31f7a17239d431e0a9cf50ef05f279d77a17d6428e7fc207956f4eefa4a3e2fe74dedbbc0b633e1d107312e3e5507c1766527336da1d6cfc3aaf89e1a52dc73fe1839977e2bff6a98fe9eb92cf7b3bac77935828cc915c0ec57761e6fa48fcc51e833b92cfbbd6bbb01672fc133e98fbc86c447b7489aa2f65f3de667f1fad62e6ef317d89723994b1ba0cdba4034c1fa77cdeb5de85b590e39ff041e5fb97249c590afede627fa7fe6b6cfbaf98fb0cb91c8aefc2ccc9c08e2703cc9d867cde35b1b9b4e5f8277c30f583d9c88e66230ca65036ef2d31b922e2e3ea96991caaefc28e7ddba726aa7699cdbbd6bbb01672fc133e98f7fe249e982444d58db2796f730f38be8fc86885e93799cba18cc175b6fdb981e9cf99cfbbd6bbb01672fc133e98fbe5f668d2234ba03f67c8be697f1d433d83bc70fce5f51ff65eb8feda8d3e42d7248617ebe5f376cfd3bdfb46ce74330ddc37c0fe74c681b493bfc05b2dfff7bc88a8338c3d6b1c316708d55533ba06d087aa3af65f2cbcf3771b5b392ff837f4ff37e5a661f2dfa7b2bc3af5a4b30ded717c590fc97f6f63751b9bc4f76728eec8c64fc077b7d421ff5dc7a29ee76dc94fbdc6d45a977f81477f6b053db66cb736657960055d6e76efdbcd22a8e8127b2f2d04efd9f1fddfdc7fecacd5cd0f7472c26df86960ccb7d425e6ae9a220f6717d1cd31ab4594e64c2f57b50ba9c3a0b31857a7ac3fddffc9ef6396f58ac6a037d99e85c0198def176f5e381e66f97dcd3dab3bbe1af9ad8e748cc2abd7e615d5e653ebfd3cb36804d655a43a9ed4758cce89317f0ad6bca4fee397fc471ddbbe1bbe1a6fedbbd2f1dfe56557e7536b1f5b456cb90fd5e2825cc7714dc7e87bd6d3606651284721f39fb8ec3fea77a1bbe1ab71dfbb231da3ee646bf34aeb312df73a6a4e8fc459e9e8b8a7b9d7f9349c1b0cc28896facfbccd5ed7155ff51c5f573ac6dcebd5e76574b0d7ed6d6b1cd05847c72bcdbdeee94c9db5afe53fcb367b5d477cd5f7bace74fc7779d1f67b5dec5d66a3fb2154db93eab81e4f60ef0cc64f037b7484ea6f32ff31dbec755df155cf3174a563ccfdc216bce2f67b1db19e0cb2047b2bcb74dcd7dbebec1e5dae0c02f55c96fa8fd762afeb8cafc6fbf98e748c7ae3aecd6bd07eafb32336b2fb04eab320d5f1abde5e174f8f76ecf5a0be1132ff998d5aec755df1d5c0adec4ac77f97d7b183bd6ebcb7fdfb08bacf20d5713d9ec0621c3b4f913d9aeae445866df6baaef8aadb71573ac6ec751abc36d3803d3672885a6ff0b3bbbcc0dd3ebe2d47cc9937f2a72a396fe280fda7b873ea85eb63dbfc656772fe085f6c1e7c1d426fbf2476d445ee32bb1b3c52ca7565726ac6dfd93de73974bf5d6247937676d4959c3fc2176547011bed8e4ab9d9cc8eeab9344d3b9a44d407ee98cbe4d4cd5986eb831d4ea0fbf2123bb25bda514772fe085f9c1d517f7a80de5548eca89e2fd4eb2d13bf5e6623e06d9244ce7aae146f47f6c05ebe42ef222576d42a57d99d9c3fc2176547fe6cf43480f03c2476d4459e32244b6a803db46572eac647feca4c78aadbd1aa5d7db72b397f842fc68e523e60df78891dd5f3665ab55db224810dbda191c9a91b1f2d57315b82ef81247644dbd8517772fe085f541dceb0cd44b7ca7654cf0dead8914f92b8432dcf9ec9a9191fd17815db235ba94e94d9519bbc648772fe085f544f76c38eef63a5ba5066475de42487d49ffab6da7d9e54ce469e0edd736712d1582d9793d9d16b9bfc767772fe085f546f9e831dda3af9a37a8e4cabc73b194df736d4234c26a7667c44e279449753a8af85c48e8e6deca83b397f842faae6d6b3c3a708ea15c1cf79de45d419beb1e67d46cd5c24db33089f8a2febb7178ebf98b96a958fa48edadd21b73fddbbe12e689f93ec50de1fe38dcc4d8663c5bb4b37dbea283fb93ed058a96653c8ab9fa30c68682bd51d0bdb6a9ba7ec50de1fe38dcc573a535fade678b3ad4e7296349cc7334be91d4d21af76de727ab4e33df42e10615bed72979dcafb63bc51b67560237b00bd2545d85627794c128e8f64a974aebdc9ab9dcbf4d99e8d564a39a8c2b6dae5333b95f7c778a3f2512675e686523eaab0ad2e729b011bbd0eed18e8f380915737de72e683d9d256babf56d856ab1c67b7f2fe186f8c6dad8fb3d13156bb3f77b3ad2ef29d473662075b33bed4ce795a7393fa20762ec2b65ae53dbb95f7c778636c6b7124e124d28ce53bc981daa39569fb4a67e2425eed3ce8bd41fc89527dafb0ad76b9d04ee5fd31dea89ce8992c69a454eb2b6cab9bbc683c8f74e5d5cf8dda3d5bdbb65ae647bb94f7c778a3f2a43e194d746dab935c29f5ed9eede8c597daf952731293d158a90e58d856bb9c69a7f2fe186f8c6ded0e763cbfa8d50483c0b52edfbba8b3dc69a898cf1b32e77272fb5eabdc290bd572a77f9cf5c9b5eefa1de44ebb93f7c7786373a74c2d775ad85647b953e683f8831879f573a72135415c42846db5cd9d7628ef8ff146e64e437250ebd171b3ad6e72a70e3dcf464abda80a79b573a7e3d01ebd42bdb510b6d53277daa5bc3fc61b655b7be6df0fa07e6c08dbea2877ba3a13b518e0266f8bdc69608fd64af156615b6d73a71dcafb63bc71b953b6bc3734e3ad4e72a7d4b487c452ba475dc8ab9d3b25fe6cb938e8d956bbdc69a7f2fe186f54eed4a7f1b10761ac226cab93dc29f183c00e357d4937de1ad161da4745cbb6dae54e3b95f7c778636c6b6ece9c55a4b96e75913b3d90d81bda8e5e7ca99d3bf5bdfe6c39d68ce55be54ebb95f7c778636c6bd227f1f1a219cb77923b25f17a6f437de311f26ae74e979e4197601f7c846db5cb9d762aef8ff1c6e4b78e7de23c5d94ea89856d7574cf741ed96a3d990a79f5ef9af66cff4933bfd5f6be6987f2fe186fdcbd53329a5c54fb233167fdd5e82da9d5278f9ed337e95df0c763d074c553f99c665b2c04b182f973fbe685ebc03d347b96aaf5c1ea4ecf7f59ce2e72a63e09a90962a5aaf347f7cbeb8aa772dfba9885249c2bdd1763fd69c09c41db7c69777ac6f4cc6b2367077da966ce3820e144a9773a8f3fbe36dd114f75ecec3d5bb283de7a61b7ea4dd59d9e5175e91672ce3be82744076c640fd5eae21cfee8fe549df154c6469e59f378b654ba73705d2f1a7d4cd5ecb93b3dff65393bc88fdabd99353d10b537003cfee81e7a5df154ee6537da1fa8efa9f6974bd78b76b9d10ef58ce9a3d7464ebffdfe67c7fbe4ccab54b7e3f147f73eeb8aa7f27a610fd2bc86d67a415bed7fdde919b3ffb591d3eb60ff9b86b3d1ca507bf7c8e18fefcfd8154ff53e89feab411cad7da1d1db54b13764677afecb7276d2f393c4b44fd46aed3cfed8fdaf339ecaeb85ef99249e6bad176dfb7e76a667c4fed74ace0ef0666c7f629011517af3c7e38fdeffbae2a9bc5e4c22b29cc76a6fefaeebc5b1ddfed7999e31fb9f869c9b45409d73371846cbf47ea1523f859db5debbcdfea3d83c5590dda0545a9b7bcc199f5ae63ebbe2ab814fd2918e311846fabcbac87b1eec901a4c6ddfcd75ac8b61348998bf0e94e28aabffb4c230ea88af465db92b1dff5d5e1d6018c5af17b66447b53b84b98e75f39da3714042b53d3ef79f5618465df155df67bbd23166afd3e7d5058611b1d8d1766ca5fc7dae634d0c23bbc7c2f985c64af589dc7f5ae539bbe2ab81ebd0918e51b80edabcbac871c6336b125147a9c757ae634d0ca3d81e507fba57ebf595fb4fab58bc23beeae7bbce74fc7779758161645f664b6fa8d69f34d7b1766ef36948e2a38effb4c230ea8aaf861d77a463545e539f5707b5623bb4fb74b953ba2b9eeb5817c328a2f16e4f4ca57bdab9ffb4cb6976c457dd8ebbd23126f7a4cfab937ce66cb40e88da5bed5cc7ba184693017156a65adfefcc7fda611875c4572397d9958eff2eaf2e308c2631b156861a3640ae63ed3ca6dd63cb9512ce43ee3fed308c3ae2ab61c71de91895c354e7e54c4f3ba7d9c7522b87e94cc399a5866d4fc38b01f42d45e7306948074ced9deec17d5eb7ed19da155ff51c66573ac6e430f5797592c364ce2a666a9888b98e757398d3802d49a8d6c332f79f5639cc8ef86ae430bbd2f1dfe5d5450ef378a6e642f1de73ae63dd1ce6f2c1671655babf98fb4fbb1c66477cd5f7baae748c7a0ba3cdab931ca64f0eb6b956aa4de63ad6cd619ab6398999a374973ef79f7677353be2ab9ec3ec4ac7981ca63eaf4eee69dafe71c8d4ee89e63ad6cd61cea3d93208d4eec1e6fed32a87d9115f8d3b9a5de9f8eff2ea2887399afa4aef9baf3ad6cf610eec500de728f79fb639cc4ef86ae5303bd1313287a9cbab931ce6c4a4a65a3fd75cc7fa394c9ff954adb757ee3f2d7398ddf0d5c86176a463540e539b5727394c1aafa2d948294f9ceb581b87dd27cbc940272fd21287bd1bbe1a39ccae74fc77797591c39ceec9c856c313bcea583787193ef5ec580d1b32f79f7639cc8ef86ad871473ac6ec753abc822f66056f6e373d3c031ad29e626eefc30d171fccfc9f7e0f4f671a12c55cdbd6191eb7ceeebb835c6677f2fe186f544fa903f317a1526f96c2b63a798f6e9b8b3d53eb7354c8ab199bd3981ca9c39462e4c2b65af5f0ec56de1fe38dea991e336b1caac5e837dbeaa287e7de36a7aaf7aa0b79757b4a2dc941f59e73615bad7a78762bef8ff1c6d8d6534c4316aabdd9bad956073d3ced3ef3039fa9e143dfe4d5ede1494c3aa4a65abc53d856ab9c67b7f2fe186f54cff470e6905033deea22f769127f1728de6d2fe4d5ed29e5d33e73d69aeb569b1e9e1dcbfb63bc31b6454d622abecd286cab831e9eb64197b4cf464a35c7425ecd788b384f2631e74aeff30bdb6ad3c3b363797f8c37c6b6d67be2ef8e6a35f39b6d75d0c3d3eed1787551ec115fc8ab196f11cb366c47ad1f7f615bad72a3ddcafb63bc31b6b5f0c972acd68fbfb0ad2e72a43d3b9e5f666abdff6ef2eaf6f02423bb672f8f4a79e1c2b65af5f0ec56de1fe38db1ad794c464f43b53e8b37dbeaa287674c63db24a152beb6905737676ada31f199d2bde9c2b65af5f0ec56de1fe38db12de2db3135d57aa63f18dedbc3a92bec78ea5043319f77de6da667d76a831d3f0e89a3963bf59ed791675d5a63c7772aef8ff1c6e288acd572a7856d75913b0d66ce7cc042a57b4885bcbafdf897c1716631a57b41856db5ca9d762bef8ff1c6d8d66ac846fb50adf7c6cdb6bac89d1e4938f515f37985bcbaf8472376a0a65aeeb4b0ad56b9d36ee5fd31de38ac071a12b5dc69615b5de01f1d487cec29f640bec9ab9b3ba5be37248a77800bdb6a857fd4adbc3fc61b87f5305b2ade412e6cab8bdce990f9bbbd623eaf905733de9a39b44f1573a7856db5ca9d762bef8ff146f5230e893956ec6b73b3ad2e72a7037bb4de2bf6f42ee4d58cb766a37b833a6abdbd0bdb6a953bed56de1fe38deaff7226cef8a8f626fe665b5de44e4deacf4d1a6bfa926eee349cf448682be17b17b6d52a77daadbc3fc61b635bec4046cc577bb77db3ad2e72a70659127fa6b94e6be74e97ab98c681e69ed82a77daadbc3fc61b635b2bc336eda1e69ed845eeb44746764c4c4d79756bd5a3796cc73b4ddb6a953bed56de1fe38dcacbf7ecd8ee2bdad6871b9eba793b1fb29038b4af34c756f0b56dde79c5be9d0f59480de67b4afadd3ac3ffda63c677c257fded7c573ac6bc9dd7e7d51156fcbccf964a75875cc7ba6fe759c046bb40adcf45ee3faddece77c4570b23be1b1dff5d5e1dbc9d37d981fa4cedfdd955c7dad8f0d303517cd797fb4fabb7f35df1d5b0e38e748caae568f3eae2ed3cf3e98098935843c79a6fe74948fcd73ef5957a0ae6fed3120bbe1bbeea76dc958e317b9d3eaf4e30e0d988f6a81a066bae63cdb7f3e6fa48fcc55e0d8735f79f366fe7bbe2ab510fec4ac77f9757076fe7cdb9619b74a0d66335d7b136e6fb2a66feded7f09f566fe7bbe2ab6ec75de91855ffd3e7d5c1db79eabfc6b6ffaa74a72ed7b1e6db7972988dec683652bacf96fb4f3b8cf78ef8aae39e76a5630cf6a83eaf8eb0dd272651bc9791e958f7edfcb16ffbd454bc9b91fa4fbbb7f31df1d5c274ef46c77f9757176fe7bdb3edcf0d351c805cc7da58eef71119ad94f01572ff69f576be2bbeea76dc958e31394c3e2fcfbafb70df52fb0976e1fa6bf76c7fbae1dd17fb7da37376cde06b97de375e9bcc19f6bcf8fd35fd0d921eddac7b6e24a697fe06d88bbdfe62bfb302ff3677cf0fdf9e19f4b6ceddd7ecf010efac716f0bf784fff863ad8f9cefc2ed661f406b0875a6b16b5e8c9db5baf9a567ddc5dbcde2c33507bf26d6f8e09977a7dd6601e50222e65c92f19a15fdf5c9c7ce0abe5dfffdd50bd727ba597ceca0f7c76fa4b775c87f7f38df967c394e74b2ed1f611efdc507353f219bfe70df48b07bec9d0bbd2cbebdd1fb6b76ef3cf9efe90733f7bdd9e13edbcb927529bcebedacbbcff2dfb9d63adcddbeabea70eb0c63b699d7e7bde7f6ef13fd193beb7fd7bf8bb6ceee3da153cc7fe29fd3efddf3e2db5dd6fffe12b0b779854675ce832fcf4cfcfef8693fd6f8e6f1c7e4f1e1e0f6173dd7ecddfe0ea6f15aa6711be74d4fd7b108691c313a28c62395ebb54e8fa33f899ede16df1ed7066afe17066fdbe7b9401735ffc96de565795fec6b75fba9dae48939ac57febdc8b676d6ddf9c549e5bafd3ef9bbd9e161bfdb2cbe219f4cc7fb68a4ff5ec87cb573ee77d779a9eb1c315fa9feebdfa5728ac6c936e4e34fb8aaafbfd7bfff35b186df2eb86e4c03cf0c7eb1dfc6b0245ff677cbf7d75db88e92f5823a67d1b703e8dbad75176d371fdfeedb62ef82f6b18eb79b8f00fa3699f72df48db9df33ebae5fecc7d3ef3f8f46bcb5c647b7ef253ef56a6f1e06931139ff79bc3f4f9ea76f347c7ab71fcfafc59fc1e5ff7e27ff7b7ff19e5f5fedb7e4f7f7af5eb83bd0dfafe797c7fbe28f7f6ffe1fb457e5e378718ca3682ce439a1cd92b17ca6e3128f274ac6937ef3d84bc7a637a6cb9b503fcb87643c17b249f9c47c3e4f1fc9ff4e468be4f766f2bb97e5f4fc27b12b73baffbfd1fd6952fcf9b247ab0fc036afbc3fff58e7b25f0f5e36e49d3a8bef5dfc9eca6ea7ba9a18199f359fcfefe3a92c879de9cb10e8ab27d4d7723a701fcb3efafa79fdb764ddb98e8964fc3e6d910e7cb10eb6cef0ab12b7d7f490ef7f555bd0b6cd3b63675d3edc7025d2fb39d565bfb04de2d3d364f464d8d1f9d5f62746c64b2c576ddda9e9f0e1cb358750ccf3eef659e005c59867e1fe3b59737edabfb7d69dc936d324aeca7415199fec3ade83c856cabe3296db703ab6856c7d38930384839be9aa320f96978f9d6f63f6d567464fb9af11e9382bebd89b609cbf71e3fc639d31e3fce76b4273de7b1176ace97a3a5a9db3b13e457cde130369731f6e380c3c5f66770f796c9ed85fb236ddf752bbe7aed314f2e5862f96d60f8cfc6dd61180771aaf48f9f2ce92c539f8fadbfc4c1e897c37f1d7fb58a8bbab9da5733d15ad411c9fbde5600a9f75e6d75c8048bfe99c9237596c70ddb30b3b10ae43b02d14f98b524c711dfbff8f628be63837fb136a9c7e3ace281fa7609db88ef329f5eb5cbe4f225aab1f71f36ebf61c79aac7bf746bea65d04eb6f04afbf4dde791c22b5bb2ee291862f46c6f76eb3386d9d6180923fd57f798fa2e64b62634bfb34194d2292eef503704d057867b1888c2fefbc14b20fd73a7fd2fe3af2c2f5d7ae881bc3ad7339cd0e0ffe6e338d9833eccdb39c2c98abf1c2bbffd86fe3f6dbe20c94ff5b7206baf278ca738e4dfbef51677a62bfebe7d3c59e8697e0c5b9ecff246bf8889f23db59e3836bad3e59180c98332f9d8bb3bf77adf1d0edaf7ba2bcd7ce5a0f768f467a462d8d21f8f33cffa4cee583a5b9aff45c0be513b2df99eb34afc7ff9e04696e10a853e7fcf3b37a912f619b7defc5199fb6ceee6b76b8e61eb8dfffbfdd86f4b60e1bf2c7f06178fdc5077b037c2c9763f73cddbb6fe44c37d3c07d9b37f3f3cf8b883ac3d8b3c6e9bc43739a8d7b7aa20ef90fec7b30027321d9779bddfb76b30878f707f9fc7611dd1cd37ba7f07d8c5d481d06d575abdffb9cef9d456887e0ddc5eaf731e7fbe5349859942f77fe3d19c1df53737a24ce4afe3d477eba7cd833c7967fcf919fc44f037b7434a5df73e427d693419614eaff54f97ec691df0ee73189c1fe51d5ef39f2dbce53648fa6fcf9df4c039661da0beee742f9a7cc6fbc707d4cef303e72ef631e09b87e65becf9c39df76c2f5c10e27e0fa591a7b8f37761abf5e6623e0ce7865ec2bced8a941fc15d87bb83c769eddb0e52a664bf0ee6079ec7dced87db2b4cf760ce4992b637f85c71e07073bb423e89e4379ec3c9b21f13ca2cb29f4c620b7b9bb642d7ccbfb8470ec86ed19d43b3f1fbfdb9feedd7017086dc799fa504faf5c866f2f1c7f317325b09fe9d18ef750bf86ba1c5c1b22e1f848967cfb2fe4e0d9d1fa381b1d63e89e565d0eae2d597393fa608ffeba1c5c7bb2472bd386b0251b72706dca27a34904bde5aacbc1b52b731293d158e01341e05a97e4ec2eb2ab5064577f9cf5c94d738302bb0a09d8cf2b9763c89ccb298df3b976350eedd12b546babcb21b0abd599387cbb2ae4e0da954fe3630feaef5397836b57233a4cf767b91c3cbb3a90d81bda0e703faf2107cfae8e7de23c5d04fe719343b05ef56cff49b8c73367fd95be1981d7fa73fa4683bfd6bc79e13a700fe97b0c78bd0ea909bed9cdf5c0fad3803903ae4dcd9c7140c28920aebcca30e7e8910ed8c81e0afcf32a8301cb60f766d6f44062a067634d069e3dd9f17e6f2f29df2f6e32781c19a6e16cb432a077573519061c192212d33e19f1e3fcab0cdc78c99f186444f87b468ec7ca8db797e90ac5df3bafdf737448fd6948e07e0ad5ef7976344aec682e589b723c734ebc492c76b41d1b7a735ffd9ea7bf787fb0c3b120de2cb0db1571e8abdf73e417607257bee7c6dbfeabc196147a175efd9e6b3f5c6ce1dbf9f08ab1ab88b35afd9e673fe13ab04dd179a3c05854c48bac7ccfb51f3e765ef57bde5a3e9a0c67ce8aafff126e96220658f57baefd70f1902adff3cf6bb661fbafd2f9e7da0f1fd725ffbe826fa286a391af81153c07703fe4e317e4eb70a57fbd2216405d0e4e8c24e87dde90831323097a4ed7e5e09efff9fd9beb7270622441bfda861cbc1889df27b42e07d7aef83d37f375add2fb51adc7e0f54c5aee7507da15bfb75b2e47a5b797629fb4ba1cbcd89bdf17aa2107cfaef8fd78ea72f0ec4ad0dba62e07cfaef8bd3c1a72f0ec8adf43a12e0737f6e6f723b8ca717b17aff836bafa3def0c13ae8fc414ecb7a577918a6f3c2bdff3f63bc17bb7eaf7dcfd7e6e12eb899f5f2cbd75517cb753fd9eb74ef3df3054bee7ed77b3a517111fec8959fd9e7b76e3dec5bed596735bec79e1f8cbeb3fecd3da4af34ec7cdae6bb97cd1bd864acebe7987459abbaf8fb1bfb5821e5bf2c7363119d837b5b843703ba7ecffe47d781bb5f4721ec1647b164277a1eb3216797e919c827c7f5dd6372f1c0ff337e5027957115bee43f0ce302c734f22b34fc3b9c1c01eddb0ccd97b47becc821a0147662a9639f62eb3d1fd10ca697064ee8b65b67b74b9320888970fcb9cbd7b11d833bfaec091f9289179bcb7fd7b30e75c9739cb5d3f49ec26f19335884503d39bc8e8716b11303d995db380fad303d46b02a63797d1f367a3a70194bf81e9ad24f4f8f50b989ec4a6cd245658c5d09900a6e749e8d9861ddfc79875e156eb10da1fbfe601d393d9b3d7b3c3a7088a8deaf48adc39c2a6d3dcb10a4d845d73ea247c9a52db3eb0913d80e2743e4d997d5333897731f658a9a90869f26b2b7c9a323b5f1c493889d4e6486aeb67b2a460ed844f536aefdc7a0c9fa6cce677073b9e5f303e5ee4f53136cf50365fa9ddc86c9e53c3e1d394dafc9ef9f703e8bd1c9fa6dce6d9f2de50d3a7d4e6b9751f3e4d99cdcfcd9993c4692a3465363fe993f878519b2399cdf36b457c9af2759e8c2617647c9ad57024f124f31f421682395420b6bad59584312fb7be04c47f598d4562ef71b0674b76806a5e3cb98924a624fefe68c7472877cb93db90c5bddc9a14576e596c6e0fe8f2c9c4f8f94dee58164bd3015982351b9edc0389dcfc3a16576e999d4f22b29cc7983d7d67adf7eea3f4ec1964d578a827636d8ca59a97f01cc6af7d357201cc199fe436fe7a614b76c4cc752eb3e4ec69f75838bfd018e187a53a99d076f8f5328ecc52fbbecc96de101367e532cbce9e118d777b6242fde66099a5674f7e8d8d23b3d4b66362ad0ccc592cc7c097da360de900c6d369e68eaef538a16df3eb7275990feef35a1ea3c7c7333561bc018ecc32db366d73123307972fbbd6f084fb01bf96c7911961dba329d8ab8e23b3dcb67de653e83e114766795e855bffe3c82cb3ede99e8c6cd439bea823c962723ef63d9fa62c26e7d70cf9346531391feb9a4f5312930b308ef9342531b9a0cec8a729b3753ea6299fa62426176059f269ca62727e6d924f5366f37cecba462ea78add2639dfc198657c9a329be7d733f9346536cfc728e2d3949d43f9d8347c9a329be7d740f9346536cfc7a2e0d394d93c1f83804f5366f3fcba299fa6cce6f93dc71b344b7da845b5217e6fed7adc56ee812c38d7f26badf5bdaddc6f589867e4f59ee5c82cab0d097aacc232cb6218417d9623b334d7c2ed41c8915912c3887aedc132cb6218414d9723b334c782e87b05bec36abe73c4bfc7babd31bdc55bd6d8df36df1397c6797f1663f1e66334f71f3b6bc51f5be67ba2f79c8db75bcd778da8375c7519f35a5da9a73824a7b93eb2e4ac2dec8359a94f96fb5983e7253b5c0dc07bf91c99cbbda315df7d71645e49647e3a5367ed63e63797d914cb6cf7ede5eb7926ee4ddb7823269259f0568c23f3ab58e6787ab463af27c6c5afc83c94c87ca1896d81f70d6a3217efca647e02bf2f83e945527acebccf46423c89323d995d87cc9944d417f6daafbf4513d2e3be4983e919327a6499d679b1f424364d02b224816da2e9f525f4f8efd8607a0389fd0da93ff515ec4f66cf7d329aee6d31fe28f4e64d62d39cb76f7c9a08bb5e1f289807e7d294da360de7f1cc12f61b85dec989ed91f75e8e4f5366e3011bbd0eed18b3cede68caecfcc846ec60abcd91d4d6b96fecf834e5f61ecf23459a529ba7beddb3a1b7670d1faabcc793d93cfc2e8f4f1361f3cc07ef4df369ca6ddea1e7195857e1d244d83ce72d1f9fa6d4e6a9690f8985d9776e34a5364ffc2089d35468ca6c9efffe8f4f536af3245eef6d4c5c55d044acf3f3c806ef0c3663b5db5b41613cc97f3308c45659ddd897c4bc310b4928c63badd51125f66e0fa9b332a91803b9f9be501853f2df19f2e42692f30d19ed0fd4f790716f2ab72c368f67d6d385f8083f2fbf4914c7d2dcb7893cb96792338eed7b2689c1b77a3cb965761ed9feaa679b88f92ebd63149e3df9ef19eb63cc6b75b2b3e724623e9c2b6f9cb3b3faa4ccc607b6b332196a4d2fde3e8acf61dc37901c992567cfd81e507fba17e34e546496dab7bd3cf6679838abf45e52688bfc77931c996567cfc980382b13b34ee432cb6d3b5e04448c7bd6786329b46dfe5bcb66ee28add5c96c7b1ab0250931e7edbc3e295dbf6d8b1c2518f68d779942dbe6bfcfe4c82cb3ed79345b0601669e739911b6fd1a51cc19b4f496536cdbdc379d1c99a579159f2c270331ce604566a96dd3e53c469de3abef3fc53124ef1d289fa62c26f76d73b1679818b2a0298bc9f7b639e5dc23e2d294c4e482b7a37c9a9298dc3689bf0b2498ff759a325b37e892f6d90873aeafbc3315d1e4bf37e5d394c5e43d3b9e5f66a8f8f9465366f3318d6d938498334ee56daaec7c07bf51e5d394d97c3073e60326c665a9d394d9fc9184531f65f3d5f7ac92b318e75d2b9fa6cce687ccdfed51365fd094d9fcc01eadf7f05d562e4d99cd6370edeb3465368fc133afd394d93c06c7baf16e5618c320b085abb53a590c83c1d0add4271179c664ff45cd770357541763b22ab3ac3684c152acc82ccfb52471a085c95337f1e574b1c6aa32cb62180ca6564566698ec51e4d7a64c9efbdf3e7b9f88e85775fbb4723c591981deecf2fbe6778e1fa93ff8e987c167a61dfeedbfcf38a39613f0e06245e47cce97179d3701c97fadc87ae394c6866181ba3b1f05bd7f278e3bebcf84f11d94c0397ff86be8c5150e3fbf465fbe271db6ffb1357eedf03b1ce9644c87b3692e87cb9107c3f918e9d6c0463ef8b74d633cb9813359df73863fed859e3d3d669dad8ee3938a5b8269bc5deb502a88efee5f6e79fd77f6f8cb9bf18ba5686c5e285068889b2db3c9cd966ead30d09ea7d68f33b0da1db9f7ece0e0f86f7fc107821d97be09a78f9de99eb889a157bbd9ee5d33b0f2cbc8bdce5fbab6bddf5af3c01997cba79c87452c16a01c754f92dac9fe92775ce9fa871717abbef9ce1916da669ffe8fa7898b5f6ddfe747fc5b32aff16c20f4afefe65b378f7c23b8399afa5fec7a9cf1d9943bebd707cc5feaaff1ed0fbf0fb65b3fe62d63a72ade0ab21a3b308a87917d11b2618afcff4edce8de1868bc00be13efc9c7196bf11d1aecb83a58fd0c38dc7971b193a32f030438a7daa3cb7f53d4fcf1e6eb4b3b9337adbe7e9f7ced9bd63e937be13f108fff7a92303cf2fa833ec6dd37ed7e3b8dccbbb84fd765d7b3f5df3aeb74dc61abfbfd6becbedf53ec35f74eee23f9cefd2bb1e1be253e702e932de39d3f376b30fdc4763efbe2d3ea873f948d61876db0bee4bf8b7d775ddf876fb8bf7ad337cabe05d257264f8a2df5efff5f3ba26bbceddf7ceda7fb8a127c22c8ba843fe4b68be6c16297657d9fe5c6bdd4b310537e44c1d92d17aec9d5ffc7b83b32f35e8156bfd25d83da71886b1678dfd4c8efb4ffb7170b6fd35bc573d4ff7d4fc4cf87ebac9dae78c4f127a609f152f1cfb5b33f86291d1df3ae51ee0308d17ff3e220ebc6fd76995eee39dd866d14bb12cfb0f7b6a66b690e21e6069f5e762dd8feebf6c9856cc9c4b40fbf31ade50c98e36eb381d9bb93e3187f42aebc8e37dd98e0ccf4cf906aeb5f677a3b25fdd5f7f9be2bcee3624d55dcebbb266977efb3a7916f0e6c891ad7d2839d2fd0323474a738490235b5f307214bc397250e752d8ec638143dcd4ddf8e456c6966150cb7fc79b8bc6efb23d85fbbb3a565626631d17b0b46636f45bc14b14cb50c26abccd590ccc6d659d2bfe3c0878d7d7fe14a3203b9f3855ccaf12865f09cb60ba4ff873f6c1247e7d730f46cfeddf8be9a4f88ffcf1b84e7064d6a514a7b1c04bf602737c2a61337c6e9dc5d1b5a05825fbcd8b93e37a16f194c936d3ded621fb747f49c6ebbf1778910299f258f3db7b14d3bad6a9dc3e94afccc7b5b99e0b33be1239aff5861ca314d80bc220dea6981ec5d8b61bf241c3e0b4dd2c86b3c3f5bfc13df7c37d631f9e191c5cab3cff29cd0fd70a7a7f525cc6644ed65fc0f759dfa96c2d0e5e9cfc9c52e829fbf7dfd93ec2b2183dddaf30b45e36bb886e4af694dd7fcef791ec5cb4eb4ff7903d65bf5d677c7f1b67af6cdf753acf0f600f0280c6672d466fc8e73d4f8317871347bf65581b39dfcff4b7321993b1fd36fa5e3886f6f08317aef7dbc8b89e938a7db2cf022f6469ffea4c4735dda7bcee22f668643462e1dc5cf9f4ddfef458caeba4313b4bce8e513ec6c343e5b7025a7bb774d6ae8f85be4d03ea9cd27d028c11ded65fd41cf74a73518c25aed96c7166a8fc863997d376f3106f37b6c85e79baef977164776670dc59af3046ef9bf769976dc659f4b6a3f70c7f8997c3897a256c9bdd077b5ebc6731182ff7e17dda11c8a32fe0d103785c38798efecb7205c82bca25799fe4008c899f3feabf2c5f011e53becce01c4cbe04325f6c684cbcbc50d4bb40329067febcd98fc0ef05f34ca0dfbf717f1f43e39ff1c7d383c6632f1ff8733682e6e02912d88509d99140472624b3bd64dc31d5f08d6fdf7071aa22e3fc07e2b17980ee7bf55f9663ef259e0072afce538e5d6c1eff170372c7763488edecffa03db7ffb29c7a2fb10df0a2e68b4f4d92fc89a0fdc3fbb4d7e76f5077fe4ac06bae2257bc79fc1fb42ef4c96fc198a0f5cdbf178c095a4be6a23119c0984cfb111e1319c3639a8d78634ae69f42762f9f93e0fc0dae73b1fd95db412c900bf21d432817b41ef9f48b64e3ec7378191c5e03b9bd26ba7982f6a3c18b9f7f29d08dbd84fc0aa15783f7edc4e0c8d8db3cfe0f5edfe57361f0be25a327996e4deeb74b9ebded783a35668fb2f9d8f3be35c96190e9d4f738df06bc6ff9febd7ce0cebfdc769e2e3cddcc9632bd1ae1ae9c2728ed2d36cf8f37c4cfeeac42fbcbfd79f706bcd17a7bff2ced91bfb2bb24eb01276ff6eb65f954f4147e7e4ffb0bb04d6ad39cdf4f38bf07ef86fd7a59da9cdf83bd627fbd2ce79cdfcf39bf5f717e0fbe7bfc55cf75fc39a47ddf833ff5fdf7adf77f8fc7874fe62c3ee8effb77d709be5e36e5399bf4bce7d78f9cf7976bedbcd91b895e9cbb2ff7f978a3ef5977f1f656ef181f3cf3eeb4db2c8259631d189fff5c7339d63ec8f01a4b7b7c4a6b7c6479fed9befdd6d87be1ee2339b3a758b7b7bf4fdf41876e8691fcbe751647e60c8a1ab61524e79b8839a457c39bfd98a1cea8196ea817a6b9d5c22eabe7d7134b73fd77bd978d4437cfa447378b0f1ad7fda39a1fda5aeb34e75cff7b996e6c582fd57c425a8bbf7c78f53cd77376d6cdf3b4419adbcccea855ff4b6ca08437eb1d8c6f37bc0c9bb58d876fcf0caeb59e3d7d23dfeef3ba77cbbf96cf2fcfafd7397b674ef0b67d9e576de5f961cfacc535777cfded27dd4cdfb6cee0578e978c3a575e65f7c2b5bf7bace2dbfedf52a48bf4dc7fe57148ceca6c33f9e49e23ab7a4aebc65e7ff2e99ac3d39f65e3bec5d9ed938f6bfdc7eb2ff6bb6bfdb9ec3f957c634957d5bf3f536771acd4111eef5f5d671c3133f8aafc5d9173f83579bee595eafe53b141c85f805a77ea1374f37066ce38a2ced067bf335c5e28875cf9dd12cad367f957f9efb23ffcdfd5c6177a0d5c5c9ad6327691db5f7fb14763bf7b5eeca9b9ff4c7b07c018b9375aae75e797733a125a27d724b0de42efd33349e49aa5bdd44a6b486f5e7867b861a2b3458f3ae4dd75d65fc91a7afd7d753fb8bc6d461f0716d57ae2177be7813ae43fd69f7eef9ce1f1c5b9de237aa8f5d087c748c3cb9e9a279ebca27e09371ab93dc96934fbe1177358f4c047d0a17d168ec3da1b8b3aad08470bec8f50a715e36881fdefebb47a385ae4c046eb4042cbc0d102fb21d46999285a70bffb3aad3e8ed6624f962b73261ed700470bec7f50a735c4d102fbdb177e7ded692ff5c1db9d4d83e3d319266e05efa3b646c8fa1da47bd4f4add4e300e387503ffb9a7c73a47cf6c05ebe5e44f255f150f8f281fd0daaf2e16c14ee5f5f93cf43c947e3556c37ce0055f9aa78293cf938fd0caaf221ed13ec575fec61e51ef5481b4ddf4ac33216d8bb683b05fa175ce5acf62cc0d82ad49f1e901569af3edbb3d18ae78f053e2fce66e17e054d5991760bf6a30764c5daeebd41fc09cf370b0c5facfd42fd099ab2226d18ec3f7f93b5d2731e69c32ce4da7081f38bb661a01fc1cd572b3d0830360cf59b076445db70608fd6814c56ac0d83fd079ab2226d18ec2f0fc88ab461dfebcf96e350262bd686c17e034d59d1eb30d04fbe1c73dc7ac8636cceb692f878cef1afebdbf509d2f7a1fe0237bd957b0a20e404fbc737e424b8393067d63c9e2d79ebc855ce154a4eb89f40434e646c0bf68b6fca19a3e48c98ff6a90fa9ba2869caf2839e1fe010d3991760bf6872fce73454f784cac95a27ef062919c16f22c06f60ba8d342da2cd80fbe4e0b77168b17211b8d7d22a6853b8bc1fd01eab4b0f60af57fafd3429ec5a63e319ffa947326c86921cf62603f803a2dacad42fddecbf90ba38163ca3feb1f98bfe7e620725a485b05dfffd769216d15ecef5ea785b4551a539f70f35b392da4ad82effdebb4f0b6daece75ea785b5d50309c981b7efe6b4b07903e87d7f9d16d256c1feed375a959eed9838cf9986a4d1b3e2b6e617b8ccb898167ecf7fdb8f2a6ff8313925a85f3b202b2ea625261d52939b2729b09b51b114e7fd7e5356a4ed82fdd9015971312db16cc376a6d27945c6b4f07bfda6ac481b06fbb117b993720f76d4b96c1c12876bc3050634ce86e1f7f9b7f8bdf2261f755601faaf03b2e26c98fade9034f0f49bb2226d187e8fdf941569c360bf754056a40d87931e096d5e6ea1c092c6d930fcfebe292bd286c1feea85ac454f7564cd62cdad0fe4b4903103f8debe4e0b9d1703faa9d769216b0dd49c8d76075b2c23b2d600beafafd3c2e612a0fee9755ab898c19c0cec7832e0c50c392d64cc00bea7afd3c2e610a07ee929ad5b8ff8d4076a3dd01b3ddd39be25ec9d9efaebadcf7ba91ed8ec41af5817ac8e3dabc5a3c70cf5544fd782db7bd5fccc3b1e72b04004b933a8bf7a530fa51a225e17825a62451fd75ef020d61c7fef03fbae73f502e284f0f72eb0073b572f30969f46fd11d20b01b129b87b07dc9b9dab1710434410ab417ddab97a81b1fe346a96905e66207e05572f70fff6a65eb21aa4126d4e2f771e6da5f914d53901da6a3e04f778e7d0863130f9b4c17eef3cda8afae6d74601da6afa86fbc0f368abe91bee09cfa10d6395e8d45301da6abe03f78a6fd22e6aa3cafe03f48d17d157f6215e0d96435fd18fc07ef202faaabe04f69617d157d3bfa06ecba1afa67fb8e7bc88bea25f81fde705f4957d8b5bebe5d057f42fb02f7d937e51b755f7af668f7a117d65ffe2d58739f455fd0bea5d2fa0afee5f401f7b117d45ffe2d79439f415fd0bec6f2fa2afa67fb8d7bd80beaa7ff1ebd01cfacafb17d0031f8c89d3ba218c2fc7cf1740fdf0c1b8f556b356398bf06bd7f5983babaf2bcd2da74f3e5f37300e1dbffe02f5cc17e806c45ed4a97783ba513c4bc1bdf4f9ba81f1eaf8e71da8afbe40372036a34e8d1cd48da24fc1fdf69bbac97ba62be625c0defbcdb197eae90afecaafabd7724c194e809a3fc13df9b97a51cb4bc0fdf9b97a51cb4b086af1905e547d09ecdbcfd58b5a5e02eee1cfd58b625e825fbf87f4a2ec47506fffa65ef2feec8a7e04f6f907f39cd75abf821ff16bfed5f52bc72450dc97c0feff5cbd28fa118805c0d58ba21ff1ef09407a51f723002380ab17553f82f002b87a51cdef71ef16407a51f5231047a0a997e29e806a0c0f610a88e8abc5f082fb081cfa6a313c8c3520a0af7686e2e00e88e8abcdafe00e0387be9a5fc1780422fa6aeb198c4d20a0af788612dc7be0d057d33f8c59d0a45fdc6150cc5180f80522fa8a795dfe5d090e7d35ff82710d04f415731430c68188bedafc0aee5770e8abf9178c7d20a2afe65f300e8280bea27f09ee6470e8abe91fc64700e8673dee15e340102ba1b9af97ee6f28c481fc7b1cd5f838c77550cead03180a5cbda8c581309e02572f8a755efedd0f482fca393f086781ab17b53810c65ce0ea45310ee4df1781f4a29aeb03b118aabd0b9aefc79b7811daefc86f1812792c9bd069e25b70c77fb6c57d170e694f622be8b166cf05a1ff037379ebeb5d7b73dec4bad0787b5ed5437ec703c40115d4a52c1a311faa7d57f491df65b021dc0f418e691acc2cf08d1ca417188348e3bd3aa41718275410cb5be380827564482f54492f247e1ad8a3a389d40b8c4fa4f1c61dd20b8c232a786334b2fb048ce121bd1c95f4623b4f913d9a4277a52a7ab9bd8757b145febb788036886324a0bd3e12730eedfd006d451f0ad7073b9c4438da20beaece5b7a8036887324d8f757265962f5ade63f6cb98ad9b28e4bc2a30d62efeabcbf07688338485cda336712d1186bdf6abe43e279449753289f5fa15d79abafe83f9c37fb1cfaca3e14d0d086ea4c1cfaaa7e343ddaf11eca4772e82bfa12ff9d3f87bea23f39f3c16c694bf78c82bea24f597393fa605e92435fd5afb8bd0138f4557dcbeed94af415fdcb9cc4643496ae39953e028afec5e927c0a1afec5f2135c137401cfaaafe350eedd12b540be5d057f62f5e0f020e7d55ff22fe6cb980ea5b1cfa8afe35a2c3341e46d357f42f7edf020e7d45ff5a7a46fa561d4d5f79ffead97ebdb7261813df7a1c28c4f3825e07f5b835bd6700e3b672cf22b6c97c06be75aac7dcd93d0335df9a39e3808413e82d05a81b18e352a73f02a81b18d7955fab1bd070d2c79c4732dda8f9951deff7f6924ad79d722f05a5f30eb7a702a81b18f7955ff7ee13dfeb416f7160dd289ea7fc894146441af394fa2f28d83cbf0f4365ecd73b1e6a7989831d5203ce9557734cd95d06457f1a25fe04dedb81f4a2969710f46e80f4a29697b0e3993589a803e52421bda8fad2d390c447690c5beaf3a0a0177ebf07482faa7989d9681d10f0fe30a417553fb27b6cb9929ec14bbd2114fc88df23a29ee74cef7828fa11735631f3a5f99afc2e83a21f2d1f7c06bf3f86f4a2e847fcbe12905e14fda867fbc72193ef79b95e94fd686087f2fc44a907858a1f717b51407a51f5231aafa2d908aa41437a51f4a3f0a967c7f29c50a56f85520ccfed5fc1a1af7686a23139528749e7b5a0afe64f74490e9c3b991cfa6a672841cf0b0e7db53314f1699f396b85f12be6cd9d27939873698ea8d21f43853ebf4f0687beda198a8cec9ebd3c4acf38057d45ff32ed98f84c7a86adf4d4503ce3737a6b70e82be62896c171663169bc5ad057cc518cd8819a72ffaaf4e1503b83f3fa7170e8abf9d7cca17d8af0af82be9a7fcd46f70675c077101cfa8afec5efe1c1a1afe85fcb554ce340413f8a398ad13cb6e39d9c7ed1ef43210ee4f7fda8c6c7f91d0fb538307be30cde81aeecebf95d06e5dc7a1293c8eb31458f1095fa37b75708a417c53a6fc046b447c17751905e54737e49ecbd97d77b8abe220a7ae1f71781f4a218070e493c310978970fd28b6aaeef3e22a315afb7e4c1ed2f7aaed9e3e9a0827b0163bbde68f9cc19f6047766aab4ccf5d7ce5a43e7bc84d68939acc7d35d15cfe612b0375e5fd5f4df9ad8b0727a3259d3f1d5306cab73b0a9e0abe4f234b07a4b7dcb53ac1094eef2dfc2326fa629360d80d72b1a5f9462fda4b829a93d8869e3e5bed26d62f8de68a26909684c03cf5aefb9b819d77fcf7065cecc19f3fa787db99111b1cd434037dcb9b8fe7b8aad52d0e6cd6bc6ef65f32aa7f77c95c3e0f5bcbdfef6f30f775e9bb2be6ca0fb6365bd19f9583034539f91d1cb317e11f4329c1a8eff5671b26a7a8bb79b8fa0827f03ac49c23b821bf2f1275cfd9a5824bd8fc7a5610e7bd409beb8eb8719fc62cb644dbbfbda59e30f17ec8f7517316b1d656bf365ef3d93a044ef8a6195ec3927ba7988737a27d71a9f3d583fd76f62b679d87b6105bbf7867f54a2f16bf24c02cf1a47f48afd5593f58699f43cddbb6fe44c37d3c07d2bfb4159062c0ecdf59becee204766090e4d4e23ef3525a4c1c5a1b98e23bfbb57edd307d011e1d0d468f9325a221c9a1aad58464b844353a555ebd90dd012e1d0d46849f525c2a1a9d192e94b884353a325d3971087a64a6b26d3971087a6464ba62f210ecdd5de53dc905a2fc3ba0f8a7168ae3e7dbb6bf7c85923e4383457196ebd99247e28c0a1a9c8d793cbc7c7a1a9cab792ca27c0a1a9c827b551210e4d45bebe4c3e110e4d55be57897c421c9a8a7c72fb14e1d05c65b8e182a06c9487437395a3729f4d6aa75c1c9aab2c95fe47125b15e0d0346495dbab0087a629abd4664538340d59e5762bc2a169c88ab05d3e0e4d535684fdf271681ab2ca6d58844373f5d11b2e08ca8679383457592a77c6a436ccc5a1b9ca52e93124b161010e4d43568c0d7371689ab2ca6d588043d390556ec3221c9a86ac721b16e0d0346595dbb00087a6212b661de6e3d01431477667e5496a73021c9a2beff2bd2c89eff37168aebccb7d7cc4728a7068ea72ce657320c2a1a9cb69c8e414e1d0d4e594c7b6221c9aba9c9e4c4e110e4d5dce814c4e110e4d5d4eb9dd8a7068ae3e9adf57929e2d443834355ad2b543844353a325b559110e4d9596f42c26c4a1a9d192ea5e844353a325b757010e4d8d96545f221c9a2a2df9594c844353a325b755010e4d91bf48ef04496d55844353a325b555110e4d8d9654f7221c9a2a2db9ad8a70686ab4a4ba17e1d0d468616c958b4353a325b755010e4d9516226f20c0a1a9d192ea4b844373a575c30591c7b4021c9aeb9a5fb9c3238e2f44383457592a3d5f243925010e4d4356694c2bc2a169ca2a8b698538340d59e5b62bc2a169c82a8d694538344d59a531ad0887a621abdc86453834d7b5fb860b823897f171686ef993f23d19c9594580437395a5d257457656e1e3d0346495dab00887a629abd4864538340d59e5362cc2a169c82ab761010e4d5356a90d8b70681ab2ca6d588443739335bb3f228d19443834355ad29841844353a385c88bf17168aab4e4b506110e4d8d963cc612e0d0d468c97309021c9a1a2da9be443834555af29841844353a325cf21607068aedfd57b8934faac347c4b0987a65a0f047aa1a0eb82c0d8afbd44e46346e0d094cebcb75e22cdbe3050ee0c8343d3ac21a27481c3a1299dfd6fbd44303a41e1d0d4f4c2e9a10cec5d281c9a66dd11a717140e4d4d2f14a9171c0e4d4d2f9cfec950ac86c1a169d62a71be83c2a1a9e9e588d50b0a87a65ac3827b7d71fc538a43d3a86f6269237068aab4d13e84c2a1a9d286fb7c41b43138348d9a2892360687a64a1beb3f381c9a2a6db8c71774e6c4e0d034eaa838fb46e1d05469a37d078543d3aca1a9f88f1c8706acbda2ed5c8e43d3a48ff7230c0e4d933ede9730383460bd1669f3181c9a267db44fa170689af4f17e85c1a1016bbc58df42e0d034e9e3fd0b8343d3acef29f997148706ac0ba3fd4b8e43d3a4afe05f081c9a267d15ff92e3d080b564a47f6170689af4f1fe85c1a169d247fb170a8706ac3f23fd0b8343d3a4afb27fc971681a7560743c8fc2a1816ad6b8b3080a87a65e8fc5fa160e87a6513b46c7f4281c1aa8ce8d3b8fa07068eaba419fa55038340dddc07d76a1f30e068706aa8da37483c3a1a9eb06ed53281c9adb3ded522f11545e028543d3aca7e3fc15854373cb31957a8960f482c2a1a9e9059d97c0e1d0346bf0387b44e1d0d4f482f7250c0e4d4d2fe8bc040e87a659b7c7e905854353d38b821f2170686edf957a89a0fc088543d3acf5e3fc08854373fdaedc4b04b52f6170686a7ac1fb110a87a6793f00672f281c9a9a5e54fc488e4353d38b821f6170689a770a90fb1d0687a6a617bc1f6170689af564f4190a854303de43c09e611138344dfae833140a87a6491f7b86c2e1d080771770f45138344dfa68bf42e1d034e963cf50381c1af0be03923e0687a6491fed5f281c9a66ad1b9fa3c0e0d080772490fe8bc1a169d247fb170a87a6491f9da340e1d080f72a90fb2d0687a6491fed5f281c9a267db47fa17068c0bb18c8f5018343d3a48ff62f140ecd8d7ea997082a0e44e1d034ef6fe0e240140ecdedde4aa9970832b72ec7a1a9e9051d07e270689a773eb0f56f040e4d4d2f0a393f040e4d4d2fe838108743d3bc27828b0351383435bde0737d181c9adb39b3fa7e1ce8b3a2fe8ebcd467a58865adb1bf8d9bbd5080f14b71686e6337f71f3b6b8518330687a6f9e61ce80ba3f4f61cd0435eb387fb7f817529040e4ded2e430cf7cb81724c181c9ae63b759c5e50383435bdc07dbfc0581e814353d38b89d50b0e87a6f9b61da5171c0e4d4d2f70bf2fe80c88c1a1a9e96588d50b0e87a6f11e1eed9f721c9a0a6d4e2f7288360687a6421bef43281c9ac61b7a246d0c0e4d8536a70739b4ef6370682ab4d1fe83c3a169bcbb47d1c6e1d05468737a8f3769e370682ab4d1be83c3a101dfeaa3fd478e43d3a0afe243081c9a067d053fc2e0d080effb91f68ec1a169d0c7fb130a87a6411fef53281c1ab02700d2af3038340dfa0abe85c1a169d0c7fb170a8706ec2380f62f390e4d83be8a7f2170681af415fc0b8343d3a0afe25f081c9a067d05ffc2e0d034e8e3fd0b8543d3a08ff72f140e4d833edebf5038340dfa2afb170287a65e4b87fb2843390e0c0e4de37e0adceb15388ba07068eaf70cd0be85c3a1a9eb06eea10ce5f53038348d3b18d8f32b0e87a6ae1bb45fe17068eaba81fb2783e71d040e4d5d379c9eaf50dd1b834353d70dfe3c85c2a1a9ddf140e725503834b59a3d3a2f81c2a1a9dd65c0fb130a87a676c7033da7281c9a9a5eb079091c0e4d4d2f0abe84c1a1a9e9059d9740e1d0d4f482cf4b6070686a7a51f0230c0e4ded8e07da8f503834b59a3dde8f303834b5bb0c783f42e1d0d4ee78a0fd08854353d30bda8f50383435bda8f8110287a6a617bc1f6170686a7ac1fb110687a6a617bc1fa170681afd0df06728140e4d833efa0c85c3a169d047fb130e87a6411f7d86c2e1d034e8a3cf50381c9a067d7cde1c8543d3a08f3e43e170681af4d167281c0e4d833edebf503834b79a62b9a706fa8c2fc7a169d0c7e7285038340dfaf81c050a87a6411fed5f381c9a067db47fe170681af4d1fe85c3a169d0c7fb170a87a6411fef5f281c9a067d7c8e02854353bbe3818e03513834b59a3d3a0e44e1d0d4ee32a8e4d6113834b53b1ee83a2f0a87a6a617749d17854353d38b42ce0f834353d30b360ec4e1d0d4f4828e0351383435bd28e4fab83834199e83b53ebe38c36f375c35e96ca67b66058617bfbf42b267380c8b803d1ab10b602078e1f8b8ddace3d9e1fefce23f4564330d5ce0ac5ed091d198185eb886b0614ab25cde80b98ca8b3f8d86dec5f93d1fd977c1c46b8732e27319de370b60cce7fc47ae9f175bbf8f61eaf7836753d5778f6dcfefd15a7a6a20fa11e36934f37bcfb82b02976d6ddb9c09411f2bee2d2a8f377f8fcaf183faa6370ad75b87baccc65d367de328c11e6cc3fdd47e3cb3587106e45aa531e3d70ac39a6115efff34faf82ef2499f7c7c1c5f6d711737af8f9aa7dc3d3837780fd134b173d77c8f150ce78546863e708b7ee18a7970df954b0932f7b595a8b2a6bef15ff493ec73cfdec8075309d2b503ece38323bcb71a06a6347d98c92bd94748c5f3f70eb3974b7f3a663a97c783bc18ce5ace2cf529d807685b20fe3c48098826f23caeb8a5c17c9fe62793c7d80f3a26723a8f504d487643dd1f15f8c8d88f422a6d9e53836f34ff6b63eb9f8b564385b1e87def32bda4e92dfcf360f508ea86227c271c8e8a2fd1e158f1d5e1c66b816e0c768da585f468de7c41d0fc75e10ba117fc39da7c5492f56e5c7bede411c7fa9c794d3883b46f19e205e3fc2b33c56558eff16ed620a3edd4844175e435131d08117277612f38567381eee225e52f09feabe2cb25dddf847a45f65dda2e8426b6beb58275d0fc0b334d77e717a15c42ebab148ba2e80636d1f5b849cfdbcf5febc68b7f68bd607315dedbdd33b70f322223d57e20a919e797182c096f9b44b389dae79f900734bfdc5d0b5527cce6fd70aa05a4871dfc81ac75b6b7d04648f77cfc1892ddf5fafbf11d3496491d1c97e23a163d0f002edad653ae96f8418619c7defa61b2bdd6f05faed413d90fb5b67d1db8ee018e1668f4be06cb7d97db0e7c5fbec701f89e7b5d713f125cfa09fdce49b41f1a8191c77d6ebafc9680ffac2758c427961ff2c783c82fa4ad7d482bfb0df7ff4b204fab7dfd6ce9bfe847869149eb79b1f23c77202c792fb2992460fb68374ad2af4fa26d6ab77e841f3725debd074284c07d0cb546823c939d006f6fb7cdf448f67f708da79cd5e16e2b16c269f04ccad676b359a8e03d32962989bedf5246bf2e96509e11bd7ed4626d71cf6e33496b88d6520194bf4b27cc5f8938cce01a453c409b7399f49d626f7b13700e63c8f07d0e341d8cd58663718bd5c647ac1f993642c50eead612f1c1afd75e499775103d73cff7b0196b9bfdb4c63b79fecb7eb906ed6a7526ef6fa6fbf26d62260e1d8709fa11a1e31bc37f2ed1d8cb36b065fbbcafe91ff9bfffeea59771feedb22f0206c85ebf837bbfdb68c8f5f8cbf4f9d00ba0b711bffce5a0fc0b13f2f823fe2717fba7d164063769d3b13c4a8b8e97bf8bd7b34f6e9fb04b31cf394688477bd17679cf6c0640e88fd7272cd327ef9e2c3eb3f9ce8e6f86b6265ff06c8fde186970f375c7dd2f0f24dcdd34d67cc0a62cfbaecff2cdf5fb7cef0b47586c18b23a523fc1eac395beb0138e647a3cf9ce97fdb4d12f3deddf23865ba2cbc04901d17e36527b67905c7f4b2d90534fcfcd83d1a251dc07c6af480f87cb1afc69825399eb37f0364ef51677a62bf8dc8ed7be01877d6f8e05aab4f2fbc83def15ebff759e5acd1e4fde290c0b5ce50cf880f662d02b7d87f8fdb0d39bbd678383b3ca43929de9cbd6ca6dfbbdbf934592bd787ad19f49275fccfa3f1ed8541da5b6162ed93ffff976b0e7bd439f37e7fdc6ea601ed2f3e5c7390c6d25e68ecdd6b1eb1f4279df3faef9fa7df3bf02e46fadb44bf9f5eb836d9661a17317cc7637e9e06bb30f1e7d5f5f7c51f2bb59b4f66051173486febdc7dcd0e0fd5718177f3d3f1fbae59ace925bb3db8fdd74fb73ffd48314d0e0f099dded62125bd557de8c5597c78e1dadf59c1b79bad2991eb8c4f579b2ffe3c64735cfb3d35ef4e3bc7f8e2da8443bedd4723621b16786fa4f087c638eea2adb3feda8dde5f139dedacc0ff8b7af65df362b8ce00a3ebbe178e4bf17e466fd79feebd64ace9ff8abf7dd9dcc657f874e9df933da8f63be83e4bcad70dff97f12ef6a3dcf77611dddca77ec21bd376b308dc8d9d9c4ff7dedb9c6b3fd4b97cb054afa44737d31e600b7bd70adedc83f1b1b3d69f5efc7e9527de59e308ea8572e5cd9ce1916f03e3d3d6d925f313b8f99d9eea5c66fffee2043de618f1ec70b5c9f517d84326e749cd753576e0f3f513bf619b098677e5b7d5b9ca7ef3e24cf79eb94ef70904ef03dd246b72f0c596cd352e9f93cf5ca664ffbed186f6de745e2223de25be61917704ffdb6f31f3edf51711db2cfc2d94f732d7277630d2b8b0f09b5c7fa98cf4d7c44ae34960df4cffbd61a357f953dabedcdef231247624a371b335681c0ddb69c841025eec998fa13907751acfa4e785eb1e35a1b3c087e1f5171feced28f55b2fed2d94ce4df23bc0860b5ad4bc4be44ad75ba9efbeade39d15847f7e57f5c9e39ff8386043ff6fb749d65f364cf784b745beafe6ebf3f59baa1d97bfc9f47ce0eed5e7149b4be8c3b0fcd73916f39f06bbe75dc09c8154fe9bbe003f2ee9d24f62897c9f8e936fd96fe3c0368b3e73d6d07e5a1acb38cb59177b00acdb6792e824b8fd1e3adf5df5f0685cf5d6f017a4ae6ef2bc6cb27da361efc0bc976db57686bafeb6ba670868716ca818173087908c5eff614fcd24f65ff776e63a70a17365a1b77d96e76fae3320cd4d96b7e7c64bcb27ae7d65679bf7571bb0abdbbffdbebf4c46f7fc18bd768ed7e6c15bbff33865eb0c3f7656b02f6a120d7f4dc773cbb502b166f26f93d1d32bddac7b2e3f96afd6c91bfb5b3a9ed2fdc4466c28c8d3e776d54fce35a59caf3a8f8c06a7ee9fcbf1969ce9e43c9ee43cf8b16cbc7d9e66f7fd1bf12379f7c2e06b2b1ee3f5fb7a1c7bfdfbdbf8aa7abcd62cf8b6edf6a7c7ad33dcbb991e8c24b6cefa485665cdf37c123e13353eb1880f14df5df9d84a7c0870762df8406fa5af7cf87b2ec807f0dd820fb4d65ef9acd4f808e707f2a72b1faac647383fd09b882b1f4f89cf4c383f50efc12b9f57353ec2f98162c02b9fa39a5d2fa1f528a395d67f9b7bc4b53e78b1f9eb76f29b0f371c9f5e9c5d12579c6787fb0bb45f947c15aaa715bc84f9178097785d80de6d15bcf8f123cc4b687b4f501c74cdd387dbcd34dea9e9f133bda720b2c3c75e5ab717f03dd10de989f22ccc0abea873cef68bcd7d3a3ff608dc87339a7e7e7f02d857e05a384eb7227e9c3de89b6ee6d7da5b790f4afefed7e439abafc0b1cfdd7e97df0b29e7725c739cc4dfe9df0bbeabddc5bb7dc7cb87faaeb5de7b21fbf833fad8b3cab9e5a114f7df1f26be243f9cc493e625a0c9dc5bd3ef9d05f74848e264cf1c7f95de4b85099f1787ed5d2738a5f96d677c4e7b516fc8d0eb2f02f7f7f9759ee6b93f0975c8f2cf86f4986358e9778ff78795b97e73cdd5eb7a753cbc3c3efc76cd84ffff4e93c7e9843917936d161fd47c7a5d866b83fd9e9c264fe368eb8c4fc9b7cbf0aee7460f4bea0ce3c9e1fc9a8dcd88d966fac10ee7d7c9311bcbe4297b6f35795a7fbae6c747f2db49400266de0593f17acafdfb3119bacef4db7d9c24e399b9fdc5778316dcab2289d9f371f075b530d7df5eb8fba0898eccf1277d9ba6ffff24587cb8ce3a9e3c0d835d94e8c4d8bb87c96932cefefee5f121a3cde7fde99ac3525e249b3b168e3fb7ce65383b3c10e60c4f6e747e5d3a775fd421df2f8f0f23d71cc6ae79d97b51a2bbcb070dd711dda4f9cff4776e94ea61e4f6d7d1d65947d9f826a789657cbbe15d76be847bbd24637aa74e10a5b9a7df359d6c76417a0f20db4f386fc293df2ddeb7a573554eb7cf9cd45793380ac4c3ce7f77caee1a0878c702de4e5e1b107c4f46a2ef8d805981e10a642742d949e03ac1c1352fc7fabceecc75b6261dee4d5e3fe7e47734bc3b31d1f885f2af07bbe7e95e466326d441866d2e18ff101effadc7784e67bfdf6ea6595eb2d103bc41f382a299d956a35f39d6c68a5ee2197fd7ca7e2fa767e3e85d6b2672797b381de6f6d8c484c7dae5ad37fb6dbd738c8fd43e9abdd39b347d14cdd4de1132f77132976c1831468e2d37c798f55b97d3e3fa77f6feb9b14e2e1bef9315d6cb0acdded60abe9a6fa91b7a8c24fde0733d5eb2dfcbe9c5387ad7baab5c5efe1a5b91f793398b8f9dd36bbcc16efadf8ab767e4efc6af71cffa739bc40256b36f7f932645d1cced502e33775daec81cfc795e44143dc657e4182f7b6a9e10f48ef03a56b94fb92fec16feed05fcade09efdf6b17c1fb0f20def8ef77b39a7b2b56eb67a7ef13dc13773e89b0bfff72bf0f782bbfdefe59c45f59bff8fb9f76d4edce7f587df1204d8b9fab02d2585d398810542fc8c249d4248dafebea585e4d5df4308e49f64cb4e76f79e33df39736d8964c9922dcbb23e481d7cf456acaffbc8e76282fede2ad4831678c4221e5621d755f826c1e57832a03961cff81c5aa01c823105e07c18823907ed648a8fa9038fe9e928b0913e688b0b7c3e1832e7025d190c1e172e7b251f9ff341ebea3e5ed60fb02c70fdf447f54d4351160bbe2bf87859b3e0d2c713d6b3f5cc3f80efbeb83dda6fecc17bf5add9e56cd12dd200747e0a1d7bfee946dec137cb677ce0fb6f0bc498cb6978c6a05b782f93d56b15f4f5bb9fb0444ce31adbe5fabed48595d700c938a2d597b3de167b7440633164f2b8e6dd4fb186a4ae1328577dfaf47ab3c3c61e241b7b1e16e538ff7bf656e1e09977c9667dadb50abf3d23dc43e745d70c13ffb1fbe3afe70af4eebafe33fbe426285ffa5de52dc5d766cdd27bc457fb14beace7034ff89ee242839b61e2c4321af34fcf8072ed571aab606397ce92189dd031c2c3b546a94cab53bc5f3938ebc9fbf9fc02d7f2770e79feb3f45bfc3dfbf99b1dfc0db617bbbf3b8558b0bbf522ffd30dd2bdb827f8a6037e83ffbe0bfefe371e23b8bf3b06f20db6171f8af976d71ec5dc08bfd3372fe8ef9f8e208f9d88c7530c7ed343e53831704ee6f81c06f7901c82318dc1f960eff89cc376b245c7c486e0984e16b2e79d6d640adafa93603e96f09c2f705db1424c511c172e7bb5fe28e783dd27bbbfbbc7575016b836fec5467cf7b1237827fa71707f7740db7a59e0fe9bf28a315e586c9cf2026de665318275bdeb1e8af17141d707911f9fc707daff63078f91535ea02f1f2c645e533d2c401b3de0fe9ceae104fac2630789952fdf20327544730baefd8f1da13d30f81b246e4cbf817dfbb1d317d9023236d4bfd3791d227384c5ce171b027dfc65f124d29d81e801f5f3f3f8bc67647c703c7c78fddda9ef0797f73dc53e5657feb7375ac8bbc0c3eb2348ef72a754a86fb98eedfa0e08a5f7bb78d6ae8dafdaa3071ca7e02c7b1e2f44ff36de6a5f2468dc42fad0fa56187fb997cdedefd7774de23866fd06c53e97b781d5dc023a762caeea0e8af987fcdbf98f37acc64a82b12728fd3e48fff216abf66d51beecce53467f50cc85d4c62f1d3bb227a4e306e8dec63d928c19a53b80f69adb787f57fb4041637e12c4c9e77103f46fe37e427d291fbb90fea0981702c60fdbe2f55de3421ce7be3e02b171eea7a717c2d8713fda1f80b82d7dff598aa5b1716371d7fa0da47bf3cf77703ee3db3918a5bb07f7fd9bae77045d0be2fdf39a08ec8df9b831faf9ba22c8d39ded04384fdcd644265bcfb17cde65dca2f5b027d137769e49f58ddb769e03c3fc128be5cf3ef924b2eb8e44cf48fc70d977a039cc6c5a6a1fc23d0738abe5eb88cc0f4576019ce96e76c1657681e515cf7601c59437bb60123f44cf5567bb48c6a85d4ca576819d3dd3bd11f291ab5df4257681c4bda95d4071efd52e0ab12fbac76079e5b39e41da37ff5b3cc8d6689c367cb6cec70dcf6171dce819fc3c6ee13a5d88ed9171e3b47f77bac0b9e9366ef9da819d11d27183b4f371cf65e316ad1fd0f9231fb75cdf227f0469e7e3867dbd306e9c3696ebb8ae23855a7d781d41ef1a0eaf8f28edcc2f0bdf827e29a00de75b6efa2ef45384d63ea47742b75fbe1fa9c74d10dd42ac8ae47ad23d1c5df75e16a473d941745ffa6277fb208feb3e53cd2bc1eba08cc7a0581f0fc9218db9d371e0399dd747f0fc77d5ff8170463be077c41f87740faae7c2ae7673209dd36479b3f39e0ff0b8fa6baa27d9594dca637f10ac3707e979edb183dc715fc62f5a6f8af93f64ec02dafb03abe73df37153ce6d695e5090233d8f1fe0918f9f707693f2d84339cba21cd298f13c0eb13f033174eecff2339c247ffafabb03ad759775ffb1233dc7bd087278af8f20eddc8f2531a490f66ff8bc75d33de13cf7b2184b740fdcfb14c72f3fd38973cae91949b4964acf7507ecfc958d5fbc8e4ace7645fe90fe45b62f8dd14439f0f3da29b67bd919ef80d55864fb179ebf2de6d951bb11ef5da278bef82de2af627b01eeb1727b919ef70e781efc6c2fe059f2662fb2335f913f602fd07dc3cd5ea4e73ed1bdc4d95ea033656e2fb2b3df01ab15bad8cb4c642ff2f39ff07e24cd8d8ad719d919504c7f50ae5d02c62f3b97947e038e5fbccecbce8262fa03f02ea8307ec25a83d65c5dc60fd22f8c5f722614d31fbc2c1c3c4753ba9b128c5fa87f907e61fc92b3a198fe00390fddc62f396721f76817bf85eede8af18df0ac55f87be50cd77911e57a81f399bb1e433537c57b1388cf0ee453cc1943bcec23c8eb769607c737836a05aeeba7f03ecddb81f77531a506d7b5c7205fea5da1ab725758f9d687cf81c03d203097c05e53ba0313c90b7c5b9617cdc77e8167e3e2fd1c3ae699e84c2aaa5f3ecf6fbd36ab3cbf788dd6595e806f595e9cb7fb08f22ecc2ffe6db9bf6a757ed13bbf18bea39cc8eab60fce0ee447b9bffca2de5fd6d721eafd64dd86a1f32bf15e7107df5b96ee0d053e7f149e9dc5e39e89cec4827af76e0cdf854e08b5f26779a1f302ed9ed455b827adcf2fb07f5de717bd031983779cf9dc6235e5e53eb74af7aaf69178af0aac4bd47b5360df11efa9f8f93b5d97647bab80b7ab721f5b5f97c03bd1cc86d1bbd4727f00485ee43c9dce2b78562fac4de8b75f92733e3adeb39c025f45ef39ce7242dfe572a277b73be4ee9672effb45bef705e208d2bd6e5d4ef03bc2bdec0ec93114e414c610a2f3a0305e12e483d1fb9fb39cd077b99cc8b9fe22a7644f45bffd92e41bd0f1fa8fc25c027a8f7b8e93c4fe89de2def90bb65cabdf417f95e1a888f68f7ce40ec00d46117f752f45b1bf9b6b8ee16f211409c24b25dc19867609de86d9f41df59a5f120743e8de56fb4cef30a7e4bba037715eec081b810cf6908ee98bd1d7c7f4db99f76906f8b77a2581e238d09453959015f1fb9bbaedf8b03760ce63b733b46bfb5916f4b762c5a9b843915c1986787f47d007a96c3efb3bd5acfd3ba2da36f0fec31ca97724fefaadcd3d7e697760f5ff35b38af144bde231e9c1dcc8f70b7ff45bddbafe6af7d388f71cd8189df6bace11c4af99e57f0bd3c0723bbb3ff52aa0ba88d7f06e6440af7e562d945eb95a416a08c09a35e4be0aad412407293eb046a3a8fe15af0528d82e8fb1d5ceb5dbc8712d4725f6417e56664fc3ba25a70d1fb1f0fceb314ee89f06fa5391a515dc1798f22d62c00fe5d7f7b58c871cbfc5b98ab917d6fc3df97fc5bf876eaecdf823a75e9f885399b039e3bc96417fbb7b0fec0d981b9974a9d87d0c6c57737c2b1cf4435ec82376465dc22d0b7f16fa5391c51dd834baea980fc19ac67b8d57a88f42ccde548be7790ef4b362eaa95b08f483ee866e342fe929c8ea88e2246f22ba43a0907c909116b3864b91dd1b71d245f729d6f41fdc518a9dd27d557c8733c22dee93c136b3fa0fd1aaab3bfed5942fb16e77a447519e7f80cacefa7d58cb80a3523805d8b723ea27a8f18c9bf90ea391c246744ac3591e57e44df76907ccad5ae05752263e4ed01a90e449e0312f13edb35b54605da9fc17cced5aeb13700d9fe2cce051dd07c4e168f49d76dc1f7aee47d8378ece29cd0796d14cb2dd9a305fbec596e283628cb2ddca785b921f1d86770ae25975bb876c3df16e54672ec17b9653922e1f7aee47d8578ec3324df73931b7fcf2ecf151dd07c4f1687cad633d1f7aee47d8678ec33b80e263f6b616f0aa43914c1b73be4dbf2590bc987b848cea770d602f9baf6a8ff62bf55f1a60fcefaed40e82b75f9dd6337717a93120ec785eeb6d43bd18fc01cd0f6f5b1db7b596f4b67e3d7222e6c80e6b7b36fd901ff16bdbfba7c6b77f7f8b7e81d42f66d1167bdf26d82e69aaff27ee1dfa279d0cbb78b49bf3a5739eee70ccd8fa7bf8b3bc96ba177583a4f05ac5a6cbfbdfcce3bb0677cdc0ccfdf66e35e09c6ede079f2cbb80dc1b87bf83dfb65dc907de7bc9f8ed623d487aa7bf6bf8f724dd9394610f756bcfca6ee53677fa4f854fa3bd0a72abc719f3a8fbb571bb7180f3be5ebc7555cfb0bad9b6d2eee8f1348cfc6e4ff719b75321c9f6db177f48bfdbf43fe77fe79c11dbafbf6cdd1a75becfd6fdeed36d12af01fbbd1663dfb35366fb400196fbf0dcabd60ef0f6edcfd71a2cfd0e9cd0e3c0a3b293ee0f937125e7e04e22015697d15f16f003ed1c63e95b016ab72a734209bb9fdaedc670fd25dda5b6d219205ebab76fb5de218a32ffebbbb777bfe77015be0e7c5aed07a6681639fc2177bfee31bfdabec677baeca5ea229d2e3750f47f81edd1efbbce1515ef77b48a7eb871fcf08af1890d77d52682b554cac927ecbf400dcb15ce6723f3c1c332e9fbbf45c41e19b631788e5c5300e6e63ccf0d5af7a21f34e7109d273326863a5f9b9f020e93ec3ec9eff78880f95757ad1577a6e26ea62fc7c8ecf047390c59e14bbcbf20cf2b9c7312af2df67d8f22a767fc5c450b0ff0cdf9f3417d96fd3333ac52eaeb4cfbf27eb443e1f445bb8d111dbe2cd562af390e2f8ade783d27e98e2b7859d57ac26fcfaf7df9db8701770a375ad9516f35b16f2bb7747c7669ffef3fe52770c62afdc78f6709e9e84e7399601bf8570746efdd50bbc8ff51ef0a5b1037675ebfd5e18c7b82ba183f47b85682d656302f599f7f5bffdf6c092650d27a0640b8ffd1e9556da0f5e382ea827eead1f7bd12e6b3dbf65f699f70e2f8c27588ae93cf66348368816938de9b10fd9e1ad4f7ac996ebbdf529360dd13ac7cce271817eb5ea64bd752bb83f775dffd2ff17b1eb7caefcf53cebf19bc656c018321ee87ac2432fe25f6ecfabd685a4f6c3a3538af587d69314bedf98e19e9babef821c67db8c5cf3ae37dd3d7c6cecf99ec331f3ed772f6bfee919e1ce350bf794398f5fe3e7dbdf257458e04577c5734e491ecf60b16b64317165cdbafd2eeed6702c8bf2be8aea6c8abfabf5cd288e738f631497e47913eaf725c597cc30f5a178af3c9eeb5cc0722d3edeaebca4fa11cf57aa233f0a938d3df902fb3c97c7656cd6ecd3894e21aa2bf2fcbf1dae738ceaeb39b311895fbcfe4ecfbc3fc5dee6557d09ec29fbf694f51bbfeb3a0688e357d46bf19b34cef06d06f5412fd12efb6f111b0df08bf39a20f06bafd86bf2f961cbcdf9652cd07af47e5fac3b3a3af63cedcd2e79b7752cbe81f2ccd19e676312e0361c8bb52e956fd01a15aff87ea8240b52e7f17e5facb328ca23c26e3816df9694c686bf293916eff84bdf207923affc5ea13836fc5efb3c3f98ae917b61af5cf35fe483e5c38ea57af4929e05e32ae4e28be3c2eef5bc724d77715c587df4b1546b5c1a17da13eb58aaf72d7f83e5318fa55addf237029d39886da27d988ea57c7cd99ec15cba778ec1009b29bf732dd192f5a23dbec2eb43e56d6af96fc237b2ef0fa5be47375b88d17eb4b91cc55a5d417da3f7fc06ae53c57797280f5a4fda63a9276d41f6fc7d2649069cfe234c1feb4b5bd0634cc0c5398f3f118fdfc3e8d37ad3be3ff4c1353c46de82e232e0ebfd73a9df51ddde8b3d83609bc7de869ef50ff96bae7f84b6b4476d6aff4b81fdd7fa10c1b623a8ff3bdb3fb4af09fad442f62fdc035f8b380b75fba9f621c26410d93f48bff0e6546e3b22fac53e47f5f1c772db47eed752bb07f6fadcee31dad29eb5de73b9676ddd76c6e55e6bb0cd0be28787728f2348ef3b82def1b794c7d772dffcbacff6a4e3c7de2f1e2bbd6b01bb67329bc769ff86e324b07f2d3c6e3436784562b07cdc73d9b871dae51eb6f571cbed45b84781b4e13eb6d0b885fbab686d2ff54881c78dc655955eb6c0b8b96cdc38eddf70dc0af6b385c78dc6b7af484c9c8f7b2b1b374ebbdcd3b6ee97e59e43f07a8ed57da5eb0a189b637d6dc1fd087b2b98c6014370cdbdeea984f51c8ff72bbd6da1fd54bea663b561977840b89712d616f49d5e1a0b40e7d5b8f0760da14f784378acf4b805ec06f125cabbbbd46ec07313d6e716b41bf48cf9bc2fd559d5ede67ace12c48f503dd479dcf0d9ad103f666fd9307b81dfba1d7de43c768ddba16fbc1dfc8de49c56ea6584c4ffd0775fc87765fc92ba5c27f179048f454bfd8c60f9f0de28a99ce079b474ce117cff857c4fe96974f491731e748eacf08dc1bc1af1fc577a4787e40304bcbf10de0599d16f3bc8b930efe3849f2963e45bda79d12cf536c2cee482ef8fc8f730de4a5d6e2007164bfa1ba53283df15d60a5c5e38ce29c82b90551c23a1e3eda89c6febb282df16cf74f8b9d71c23e75ee2b9d93c2a9d9beb7283fbec4d6efcbc5e7a33879cc545eb17c8b7846923945918538ac65d7a2f57dffbd17d778c7c97639f08d62d705f2d9e6fb16f4b6fe5a03d191def4ce1dc5d9715f996745eaebc93836d5af0bd8bdc23e4f32b1afb0cbe4f88eb3833753f7e02f71769efa3cb7e2cdb9bd073baab9003a8fbef93280e4763e04aff23a5f3b9837d5bc491c1627bb3fc360ed88751be3e7676afe505007b0ec4f62cdc7fe16fe57d902e3187f00c29b463e8fe29aee3e200311678fe90f6424aed18fe9692a370157214407c258a29d11c40a51f9252fec0c1be2dd83156db9fda71228c2751be959e48c2bc05105749ce45f8bd61e51d9c6a3ee44b251f02c491a2dc35da3b398d33e03c09254fb1c3f816cfc328ef739c01f3ce7b4109f65f248721eb8f74acf44782f303a85d8e31be146ca163e5ed1b145b89d667725ea56ed770ce8480fd73acbc7b53cdd77ca9e46b6a768de44c847d83cef222dfc93078cef38be47f8a3d60916f8fd8b7e2de41ef0f5f8ecdc28d79f7c5cd55fcb29ec78e3d483cf37c9e1a74a6c147a19e27fcf69e5767bd94bec9eb9cd88f6bdec5d3dd43c78b46df5eef61eb45ab6f1f1cef69eb18ab0fd738edf97a7cf09f275bf79d1d9df39ef93efb95bee1eb7d1c2abfbbc9e718775f6e6ffc6bfc5c192f64f7e628e0f6f6c735e7a1b7eb56c6f67170d7d661630f8efe7af637f8fd7ab1bbc95fd5e57bfb3c9ce8fcbfbfcef3d4e3f6e4bfcd7a46d7e1a375b4905c5dc916edc9f97fff77b641d7befb25d7d57d600de17c5e654e76e7ffbd4914e75e67dc7f947e55aedb3a19b8c6e9c70b3edec6c9fd11aabfadd98cb1fdf4cda5e67cb288470e616df13f36eb79a8329f3ce211613e7b1b33ecf085aabe35c6fd47e913e6d3e01107ea6cabf3c97b93edb53e5c774e99c1b73cb208f6e8c7ce3aabff5799dbc08f1c9b0784f97df7a2d1e52d87ea7add448ebfc28732e74e8f47a3c881ea65e1798f1bcc7bc84d27e681479723509c777b1e5991d3a3cffb5867def5e5f82b7c28f3bedaf3601231fabc274de6dd8a967d1e50f69f4c8e4471de1793706a3a94fd399b774b6bdeb5e5f82b7c28f3be8cf9621b51f6ed6cde3b0de63d70a259972fe872b0a1dabc3bc664cfeca5c2bccf74e65d5f8ebfc28732ef6cc787ab5061debb0de67d6b99a3d04914e450dcdf9dc5c396db96c2bc2f75e65d5f8ebfc28732ef4f47c75e050af36ee8cfbbd5b3166fc7e98210975ee550dcdf59f2d4b7867b833eef8ec6bc3790e3aff0a19ccdbcd374783f60f478bed760de3bce62d965012107749543717f67e653972d1cb04e089e774f67def5e5f82b7c28f33edfb2c5d298d2fdbddf60de633eb47a6c489763aab8bf5bd12c61c94c61dedf74e65d5f8ebfc28732ef93bd95781d85791f3498f793733e37299c47a78afbbb653fc5d670a2707edfebccbbbe1c7f850f65de475b2bb88f29e7772f5aedb33cc7afc21bdd4ff7fda19bf6f720e5875611d4d740c8cbeefebceefe187d9dbc599743fd306ab9b349c81f6f79ae8317ff795dfd81f137c9d744dc9ef5f890907f28f3a2ddaf68d257bbe3b80f78b4da336346c909c5dc9ee9e66af47425bd3fd1187f1693a8dfada5bc765634867ab30979d173ee1af4d57d2f7482c9ce22c4be17dfb3aeeb94aa5d69e88a9487571cffacc99a1e4c874f7da8978b90177d4dd7a1afec7b4ef2769a423d8060dfeb68aee95abafa03e36f929b89d8c2e932fafed1555dd375e82bfb5eb034ce7ca8bea79997d1d315654d571d7fd0604d5f2c13be6050ff53212f7aee5d83beb2ef2dbb9671e643f53d476f4dd7d21529efae387eafc19a6e75ade43ea1e4fc4abcc86bba167de55c383befcb949ce8c5f77a7a6bba9eaefec0f89be461064e300948e7cb322fe29aae475fd5f7a6f6387612d2993ff53dcd1c8c9eae086bbaf2f817fa6b3a4b66b1b3986c5579d1f3ec1af4557d2ff13a56f4143be43571afb5a6ebe98a9463278fdfed4db66ee4878df32fd18874775be3a79283d1e3a19187e15bda1df75decd88377febb412e46536f7f488e863999d5ce492839e41a3f85bc8c1e0f8d3374e8441625bfffe345a36f6e2c1be46734f546cbd1a8cbd1284f33d95bc976afc38f9eabd1e4a1ee9f3b3eb4fa5cc93f757336ba7a23e56d34e46892bb710cc79e752939e61a3ff27ea0cd43fdac1d8df66c41386be7fea999c7d1d7db1f92a3493e27e4c3b78195506a086afca8fb81360f65ffb467fde9c2a2d477dcfc5333b7a3af37ca7ea02347931c8f39339c604ca987aaf123ef07ba3c94fd73be67d138568bd734f33dda7aa3ec073a7234cafb1cd9c28929f9e91a3f7aee47978772fec71a2e0d2b20e4c473ffd4cd0169ebed0fc9d12c1794cc624d7ef47c90260ff59c90d5b1823184a781faa7765e48576fa4dc90861c4df243c63861c311258f5de347ce11e9f250ce13f93b2b999d28b9f3dc3f357345da7aa3e48be872bcdaabafb4b77de37c1127e53d6afc94f2455a3c74f2451129cfb20e43d73cfdf871937c919edefe901c0df3453c70486f056bfc14f2457a3c34f24591634c28ef3607dc3ea55816faf9224dbdd1f245ea7234ca178d226bf836d0e147cf1769f250f7cf2d0feefb53a57554375fa4ab3752be48438e66f922beb8efaaed3feaf9224d1e1af9a2e591d994da8c9b7f6ae78b74f5f687e468942f720c6bc0ccb10e3f72be4897877abe8805d3c57ca7e29fbaf9226dbd91f2451a7234c9170d9d41fa6656831f793fd0e5a1ec9f33636a2f6335ffd4cc1769eb8db21fe8c8d1245f34eeb1647fa2f47ca8f123ef07da3c54fd73c7126f60d94af19a66be485f6f7f488e46f92296acb616e50d629d1f395fa4cb43395fb4f0bacee2899277bff9a76ebe485b6f947c918e1ccdea893a56f044c983d7f829d414e9f1d0a82b62c3f149edfcae5d5ba4a9375a7d11550ede9b84dceedffacb68c504c143c42387d277ecdd8b56a1bbcbf32bd43c8165f2880733d23b386eafbe7573459a7c34ead0357546aa1157a6dd244f14b0c831f890726f7dd5d958f5eec8e001df53eedd6ff6ac150f6af2d1e8d7a1abb33f43dbd28f059370cb177cc754d61ac5fcd0d41e852c1aabd873a27767a4c7477d9fd1d51965ed57a77ded39a3b5ff07dbbd95ec29f753579d75146340636ace92e98272bf76b5679dbc90361ff5bd5f576784b55f8376839c90d5999a931d23d5465e75b6545bfb9359df89c63d627f828b3d6bc57e9a7c347a78e8eaeccfd0761aacfd563f3d6b28ec97aab9202bd99ecf4a943cfad59e0d9db55f978fba9de9ea8cb2f66bd06e703764454e9f2d5694f711579df514d7fe98076f5d66abacc95afd7b74f9a8db99aece086bbf06ed463d7c58e2f4d870afa0b337c5b57fdc6381d751b1e73427a3ec939a7c34faf8e8eaeccfd0de3758fbc7315bcc124acdfa4d67aabd7c8271970d19a5e6fe6acf03adb55f938f869d69ea8cb2f6d369fbe66aeb3e36eac31c5eaa51287d07e6a1631fd5fb302fd26a17ca5ad6e1f6e84b33dfa32fc75fe1437a1f9e561d51de1266f3de24d7b3b322a7cb29fbe4550ec578cf092611b3497b5836ef5a7d98f5e5f82b7c28f33e8e79b00a15e6bd411f66ab6fd94b8353f696ab1caa399ee12864d18c127f67f3aed387b9811c7f850f659d7f3bf105df5362956cde1bf461b63a3c9a9d9c845263729143b50f3333f9deb22d4a3f936cdeb5723bfa72fc153ea4fe9c111f8e024a6e3a9bf726799d646a8e63c7a6f43ac8e4508ded92edce8a46949e07d9bcebf4616e20c75fe1433cd307932da54f6336ef4dfa3027d662df9b52de205ee550cee73c0d58b257f077ad3eccfa72fc153ea4793f4d17de40c1df9bf4618e9dc4df328352a396c9a1b8bf5b91d573163ea54e2d9b77bd3c8eb61c7f850fa91f6fc08ca79e43a811cae6bd510e673a5c858cf276299343b90f73f0d6e50b87721f91cdbb5e1f666d39fe0a1fd2f9bdcfeca541794b98cd7b933eccb195cc43b6a0dbaf7aeec6eaf0c592d2e3329b77ad3eccfa72fc153ea4794f98b9ec527a763ad1a9cb9be167854ee4f449f85ff6e4cbb797ea791b7b124d4d123edace7d5ee9f600d297e3aff021e128ed78b025e1a665f3de286fc3ed65c249b801991caa799b68155a060967219b77bdbc8db61c7f850f65de27215fb08872cf9ccd7b93bccdc032d99e56cf96c9a19ab7593c04dc24d59a65f3ae95b7d197e3aff0a1acf3fba363cc234a5d4e36ef4df23686658c136ed3e550cedb046c67192bca9d4936ef7a3539da72fc153e94797712276021e55e269bf746f53856b01f70520d5326876a2df6703c98da4b4a3c9fcdbb56de465f8ebfc28732efb378ba08434a3c9fcd7bc3bccd5bec18949acf4c0ef5bc4ddf8a48fd8db379d7cddb68caf157f810f336c34940798b95cd7bb3bc4dc00387d443329343396f33361c83d4e7289b77cdbc8dae1c7f850f296fb36311db51de5c64f3de286fe324cb783a249c473339d4f1b3acae15bc51ceefd9bcebe56db4e5f82b7c88f9bac5b8af707e6f94b77116b384842b709543356f133d75ac84842f90cdbb5ede465b8ebfc28734ef5b36b40c4a7e7e630ff61bdbff69d69367b5e3c19c942fa8f123bfb3d1e6a19357e9d0f22ae13737c37757bb278fbedefe901c8dde5a59c67ccb238a3dd7f851dfda69f350adbb7312b6776c4e89293fdd68fec98dff69bfb7d2d61ba1ee564b8e0675d7ce82ed88b571357e749c644d1ecafef99438c4fab5dc3ff57af2e8eb8d8497ac2147839e3c891f4d6d46c209acf123d75f6bf350c633e6411890b00973ffd4ebc9d3406f7f488e26f91e83057e48aa2facf323bfbfd3e5a1fe2ecee9715aede7cd3f35f33efa7a23bdc1d390a3414f1e663f19cc20611dd7f891f37eba3c94fd73b56581bfa7dc8fe4fea9d793475f6f94f3818e1c0d7af224f3802d463bb53857b1278f3e0f759cf3647922f550ccfd53af274f03bdfd21399ae4853a56323b4d1794fc568d1f753fd0e6a1ec9f43ab632df694dce3cd3f35f343fa7aa3ec073a7234e8c7621956c2024ea9b5abf123e789747928fb270bacc43128f570b97feaf5e4d1d71b653fa0cbe13daf62cf3c35c5fcdaf26045ca7bd4f8d17b38ebf250c7e9b59d2e29cfb27ee87aef0f5f0d30bfb4f5f687e468922f0aa7f6accf23c2bd769d1fb967a72e0f758cd5703f3539a5e6e0e8af2747d7d4aeffd1d71ba53f938e1c4d7a380ff9ce3148798f1a3f7acf4e4d1e1a3d3b9d8891f22cb97f6af670d6d61bad67a7ba1c4d7a388f7bd305adfea8c64fa167a7260f8d9e9dfb0ea94e2bf74fcd1ecefa7afb437234c9170d78e06f69ef516bfcc83d3b7579a8f7eb727a0e2dcf72f34fcd7c91bede48182f1a7234c8174d87f75dc79e51ee786bfce8ef793579289f0fde8ecc1e917af6e5fea9972fd2d71be95daf861c4df2457cc7863ca0d4a7d5f891f345da3c94f3b94e30339c44c93f35f345fa7afb437234c91775d9820553b5754d355fa4cd43395fb458264e122aada39af9227dbd51f2453a7234e9e13c9c2556e26bf1a3d70d6bf250efe3d7b112aba716576bf670d6d61ba97e982e87197e6fe246efc2221e395d1e78147bfe74a32ff57761118f98edf428badad883fff4b1be34e5f82b7c48d83e110f561123bcf7cfe6bd21c6d7acc749ef1a333954b13ca2d59e19a477a0d9bc6bbd0bd397e3aff021cd7bc8877e48a923cde6bdc1bb301639695d37a56f4126873a06fc8ed1de0b64f3aef32eac811c7f850f0dfbdd0938e9bd4036ef0dde85b188056f3d27a0f429b9c8a1fa2e8c074e9f19e3843eef9a185eba72fc153e9479778ce9d0df59f475be21d6bbd371489823991ccaefbe67063349b823d9bcebbc0b6b20c75fe1437af7bd67c17ccbe8eb7c8377616ccf162c9a9a0af6ab8cd5b54c78b0a5f471cae65de75d580339fe0a1f52bebf6b194e9fd2c7299bf706efc2d86e3ab4e2e99082159bc9a15a1f1cbc2556f046a969c8e65d0f9b4b5b8ebfc287d4a7b16f25e33ee55d5836ef0d31b9c606a3dd5ba672a8be0b9b2ebc9805b4bbcbcbbc6bbd0bd397e3aff021bdf7ef59816350de7d67f3dee45d58cf1a8e3b6c41e93799c9a18cc1751fb3e192d29f339b77ad7761fa72fc153e24ecada315ccba507f4e1edd05fe9a413d8362c71e249e398ab93de84c23fee3f456895e3eefa1e345a36faff7b0f5a2d5b70fd89fce386876f207782be6fffce7c9d67d6747673d09dd77c0af33ba501faaf2d83f23d7605d2f51cd0bfe09fdcbedae81dcbbf3ffde240579b5ee93ee8fd01e87ca6a4fcefffb3f751bbb0facf31a5c5f872ee387dfdd6e1de32b1b8ba55e17d8909f7a0ebba92e29eb94228fb5ffb159cfc3666bd3250faca24bdfd87efae6b2a44b2a6620586787fb416f63861dbeb8f9814e4eb8093f0d8cf986baa4d4aa29f2e0bdc9f635abbd2ccc995eaecae05b1e0167315ca7ef5e341a5cee0e4a7aa562d0f778348aa0331aee7b7eecacf797fcbee69ed51a5f9dfc564b3afeb3bcc6e5f9d47b3fbfe7c104bc5791ea38a8e8989c139b475604de79c9fc272efa8f3ab67d3b7c35dedab7a4635a1e4d9b57529e4fad7d6c3109a7a6a31417643a4eaa3aa6d6595bd1b2cfa1de3552ffb18afea39c0b6d89af46bd774b3a26d5646bf3eab4b0d7054e34eb72e8fd9154c733cdbd8eedf87015aac59917ff49ef38b4f7ba96f86af480684bc77f96d7b285bdeee9e8d8ab404bc7d57882dc2fe261cb6d4bc77fba4df6bab6f86af4ad6849c7a4de12fabc82e67b1d4b9efad6700fddedc9746ce8ed7556cf5abc1da7d0fd9bd47f9c067b5d6b7cd56bc9dad231a5be509f57aff95e67759cc5b2cba0deca521d7b7a7b5d32dfb2c5d280dee24bfd2769b0d7b5c557e33d735b3afeb3bcde9aef75c9646f255e4747c7d36a3c41ee91374b5802f68d90f94fbfc95ed7165f753b6e4bc7a4be7afabc16cdf73acb7e8aade104aa6790e978a0b9d79d9cc5f86869e545f64df6bab6f8aadb715b3a26ec753abcbc68b5afe510f5dee05f6a7995ee0227217face54f5572de5db0ff143ea731b7670df397edc9f94ff812f3e0cc06fba24aeca88ddc655a1bbc57ca755de4ace5f3c87664cf7a7c08d4b7cbec28686447adc9f94ff892ec68b567c64c29377bb123ab153b7282c90eaa3197c8a99bb38cb83d8e9d00a89797d95193b8bb4539ff095f9a1dedac680cbdab90d8d1ac0d3b0aa6c3a73ef40652226735574ab62327793b4d87c07b3b891d35cb55b627e73fe14bb223ab6f2dde203c0f891db591a774ba2c58823db42572d67277543b628b94a7ba1d35bbdf6d4dce7fc2976247291f9df8c869c18e965dcb5826d01b1a899c9af94916b2050b2de83d90cc8e1ac547edc9f94ff892eee1ce7cc07763123bf25ab023ab6b25f7895a5d542a67352f4aeeb5cece715da2744f74b1a346f1517b72fe13bea49eeccbc41aaadd0b5deca88d9c64b8b3222b56aae7b9c859cbd3517bee38c12450cce5a476346d121fb528e73fe14beacd338e9d44277fb46fc18ebc8e153dc5500f00899cbab9c81e1b4eb656a41e674f9bc4472dcaf94ff892eedc66b1b39840bd22503b727b93ad1bf9616bb9c86814a9dd21dfc58e3d78e7f57a4e857c24df2ad60efd78d1e89b1bcbe639c916e5fd67bc89b949c756ab5dca6daba5fca43d09d4ee6c6ef236c851ae764ea274ef98db56d33c658bf2fe33deb43c53e84496d29d636e5bade42c777c68f5a177740479b5f3964e344ba6a6d23b9adcb69ae52e5b95f79ff126d9d6646f255be82d29c1b6dac8633a8663cfba4af9915c5eed5c268b467bb650ca1ddc6cab593eb35d79ff196f523e8a6ff970a9948fca6dab8ddce66a3f1dee13a8cf03415eddfc66c8876f032b51aa5fcb6dab51bcd5aebcff8c37c5b6ec597fbab094eae772db6a23df39dfb3681c6bc697ba39cf3d1ff29da519cb37cb7bb62bef3fe34db12d7366380188b74cb0ad5672a047b67062a5bba75c5eed3ca8355c1a56a09477c86dab592eb45579ff196f524ef4becb82b1d25d5f6e5bade44503361cebcaab9f1b4d66b1ae6d35cc8fb62aef3fe34dca935a1d4bdbb6dac895fa3b2b999d94eea87279b5f3a54e60752c5b2f966f96336d57de7fc69b923b35c6091b8e94ee045fedd5976bdef5dacb9d72b57cde3a0c5df3f4e3c78d72a79162ee74c0edd397dbf35ac89db627ef3fe34dcc9df2482d779adb564bb9d38881f88304791be44e7900e212526cab69eeb44579ff196f5aee34728c8952ff8cdcb65ac99d6e7970df57eb457593573f776a3bc7e910e8ad45b1ad66b9d356e5fd67bc49b6358aace11bd48f8d605bede44ef9e2beab56137493b741ee7479646af1d6cdb69ae64edb94f79ff1a6e54e436bb8d28cb75ac99d064eb2ef4058990479b573a78e610d98a954ab9edb56b3dc69abf2fe33dea4dc290ba68b3984b14ab0ad3672a733636a2f634d5fd2ce9db2200cad4873dd6a146fb52bef3fe34db1ada13348fbb868d9561bb9d3718f25fb93667ca99b3bddb1c41b58b65e2cdf2c77daaebcff8c3725771a78bde962a419cbb7913bddf798fd7452bae3cae5d5ce9db264b5b5d4fa61dc6cab59eeb45d79ff196f4aee74e1759d05889d40b0ad76ea4cd9707cd2ccb934a8359dc5965a8fa4dcb61ad69bb629ef3fe34dab3bed58c1936a7fa490dbfd5a6f49ad3e79c143c423a7d3067f3a064d4b3cd5cf69c7f49db862ef0a6eafbe819ea56abdf25ad33309bba6819c6de44c2d83071cc44a55e64fee97d71a4fe5be752c720c3e543a3bbc7bd12a74774df3a5ede9f90fcb59cddd69616738f6d270202c6e75fef4bbe99678aa63678f42168df5d68b66bda9dad333e95e5a5fce6abf49adde79c1766f257ba57b718c3f19aba32d9ecab18dd3e7436ba05673705d2f6a7d4cd5fae7b5a6674a8faa2672b6901f4d667d271af7547b6481fcc93df45ae3a9dccb6e6a4e764ced9dc775bd68961b6d51cf7f58ce6a9e4e67ff4ba6e6f9ccab746f87f1a7ee7fadf154ee41966cb7d6c251ba3fbbad17d57ef06af6dc9e9e29fdcf9ac8d9429f742b72fa6cb1527af788f1a7f7676c89a77a9fc4683a5c7695de93ded68b5a6f53c5de906de999d4a3b1819c6de442c73d16781da5bb768c3f79ff6b8da7ea7a11b3c4e931b57a8aeb7ad1300fda9e9effb09cd59c9ccefe175bc1b263195ae712dd1c686b3c95d78b60dc6543a6f4f6eeba5e54fbacaada736b7aa6ec7f1a72fae66aebd67b536ae12e5faa38956acf3bdc1e7de963182dd30a4ab59e06f3d0b18f0d739f2df1d5c06a6e4bc77f96571b79cf71cc8355a8a5634d0c23279844cc568b2b32ff698461d4165f8d7be596744cc206d6e7d5426c3e1d8e4216cd94ce06998e75f39d7dcb5e1a5c6d8fcffca70986516b7cd5f3ac6de99892ebd4e7d5028691d5e1d1ece4244af9fb4cc79a1846c93ce2c351a0d6dff0e23fcdf29c2df1d5c075684bc77f96571b394eabef0493ad5a8faf4cc79af7c656b2dd59d148a9d757e63f8d308cdae2ab6ec76de998923368c0ab050c232b791ab064afd40f22d3b1766ed35aec7b53b53e1f99ff34c2306a8baf861db7a463525e539b571b1846b193f85b6628d58a673ad6c5309a04cc78eaa9d56967fed32ca7d90e5f8d3c415b3afeb3bc5ac967f699bd34d4fa7d5f74ac8d6114bc75f9c251ba2fcdfca71986514b7c3572992de998b2d735e0d50686516075f862a9840d90e9583f8f99cc43b6d0f19f4618466df1d5b0e396744cca61aaf372a25317e863a995c37422a7af886dbf739f57f5bea5e41ce66ac7836da454db6f4fbe7cbb69cfd096f86ae430dbd2f19fe5d5460e7312f2058bd46a2a321debe630a35568198e520fcbcc7f9ae5305be2ab6ec76de99892c36cc0ab8d1ce6e221e0a65add73a663ed9a4dcb647bae56bf98f94fa31c665b7cd573986de998f416469b571b394cc332c609b77574ac9bc374122760a15acfd58bff34acd56c87affab9ae351dff595eadd469c6d34518aad589663ad6cc61b2e17830b5974ae7abcc7f1ae530dbe2ab51a3d9928e29e7ba06bcdac961f6ad480d1b27d371831ce65beca8e11c65fed33487d90a5f9d1c663b3aa6e5307579b593c30c78e0a8f517cb74ac9dc3dcb188edd47aa966fed32c87d90e5f9d1c664b3afeb3bc5ac1610fd862dc57c2becc74ac8fc36e75ade04d272fd21087bd1dbe5ab9f856744caaf1d5e7d5460e337aea58891a9e60a663ed1ca6b398258ad89099ff34cb61b6c457c38e5bd23165afd3e0b5b107fb8dedffb4d3c373b5e3c15c31b7177e73337c77f57b78864ee47414736d9f6e34ffe4c6ff5ac865b627ef3fe34dea29358998c9957ab3e4b6d5ca7bf4849ba348ad1fee4d5edd1e9e8165ccb75cada7546e5b8d7a4ab52bef3fe34dea99cef68ecd9562f4dcb6dae8e1f99438aa75d5b9bcba3d3cb7963151ad73ce6dab514fa976e5fd67bc29b6b5603b1e71a51ae7dcb65ae8e199f8d1d466915a7f879bbc9a3d3cad1e0fc280abc53b37db6a96f36c57de7fc69bd433dd1938866ebcd546eed33198a158db9ecbabd9c3d33258e087dcd65bb71af5f06c59de7fc69b625b81d3e38a6f3372db6aa18767b2dab2c0dfabdd39dee4d5cd85769d85d3e343a5f7f9b96d3589b75a96f79ff1a6d896fd643063a674679edb560b3d3c9379c01623b51ef1b9bc9a3d3cad8e932c4f8afdf873db6a941b6d57de7fc69b625ba6d5b56cb57efcb96db591239d256cf83450cb43dfe4d5ede1d9b192d969aad667f1665bcd7a78b62bef3fe34db1ada1d5b1167ba53e8bb96db5d1c3930556e2184a75dab9bcba39d3c4492c83454af597b96d358ab7da95f79ff1a6e44e0d2b610157ea99ee3daf62cf3cb5851dbfe5c14a2d9fb77ee87aef0f5f4db0e31ddbe92ae64e8ffe7a7274cdc6d8f1adcafbcf7813714498ad963bcd6dab8ddce972c087db48a90e29975737771a4eed599f474a7541b96d35ca9db62bef3fe34db1ad45b89f9a5ca9f7466e5b6de44e67861331c59e1437797573a77b164d02c5dc696e5b8d72a7edcafbcf7893b01ef8ce31d472a7b96db5817f34ee4d1773b51ec8b9bcbaf8473b96ec3b8a35c037db6a867fd4aebcff8c370deb61c0146b9073db6a23771a46cc1829f605b9c9ab9b3b1df0c0df2ae64e73db6a14cbb72bef3fe34dea47ecf41cc5dc696e5b6de44edf8ecc1ea9f5f4cee5d5cd9df6ade16aabd8db3bb7ad46b9d376e5fd67bc49fd5feebb8e3d53baf3c96dab8ddc29dfb1210fd4debddee4d5cd9d1a4e30339c4473dd6a943b6d57de7fc69b92df8ac61d16594af5fdb96db5913b5d762dc31a68aed3bab9d32e5bb060aab92736cb9db62bef3fe34db1adc532719250734f6c23773aeb5889d5d394573777da61432b6186a66d358ab7da95f79ff126e5e5678995f86ab66586df9b7a0da4cedbf988474e97079ed21c6fecc17ffa6fe779c48355c494fa2c3d7cbad15773ccf836f8aabf9d6f4dc77f96574b58f1433f54aac9bfea58f3ed3c8f567b66a8f59ec8fca7d1dbf9b6f86a61c4b7a263cadbf906bc5a783bcfa3f45d94526d6ba6637d6cf8686c28beebcbfca7c9dbf9d6f86ad8714b3a26dde568f36ae1ed3c8b58f0d673024b43c79a6fe70dc7980efd9da5b1e734c5826f85afc65ed7968eff2caf5630e0f72c986fd53058331debe246da3383996a38ac99ff347a3bdf165f8d773a2de998b2d735e0d5c2db793e5c263cd82af558cd74ac8ff9be60d1d4d4f19f266fe75be3ab6ec76de99874ffa7cdab85b7f36c371d5af174a8545397e958f3edbc31ee5bc9b8af861b9cf94f338cf776f8aaef75ade9f8cff26a07dbdd0a1c43b12e23d5b1eedbf9e9c28b59a0589b71f19f466fe7dbe2ab83e9de8e8e296fe71bf06ae1ed3c4bee63365c2ae100643ad6c672b786e30e5b28e12b64fed3e8ed7c5b7cd5fb1db5a5634a0e13e775748df0db4feb4b5706b7071d2fee7ebbc620bcd1797ef8d9d8834e8adfb4e6a1fb3e4fa6bb87f437447a5b7f3dff91d04b7f03d04b7c73d4d9d8d66dee3cf32ed9ace79faed1ff357e661d673dff847bc2877d6ecfe0ef4cfef96aaea03564ebbcb31ff779d5e1bf6f7e79747becd337c31f37f87873ec49e21aa7ae6f02b980f749e89ec7bbe625fd7946d8d9d877dfd3dd43ccedd3d63743037a7fecf5e69f8e7118c0dfdee7bebc669d8dcdfe7b5d803c12df0c834d0fb2e9f0db33569d97c5fd4d2fbe79779ceeee2f75e7673d45e1fbe679f66b9ced65e3c7879ddb9b775ca353fcb72f6ef3ceedbbb20e3fdd7716fa8fd5799fff78c38f3767bdeab8c9f5df269fdcd8764af37ff6cfe8aee39b7787eabfbbe62af24b34cafadbd88384af6787d7df9d6395ef25fe38fbc5e4c77f9effb88bebbf21341e8b34aee3ccf5741d8b90c66f820e0ae391ca55d329ac3f999ece738eda40792ee38ded7ff8225d947f7fb59583f5f880da4fd9264f217f9f157f2fb2ad8edbbb3fa472c5b7df9fffedd7d81c757df37f904fa6e37db1d3bfe7ebc1d5ceb1efa26c5eaa734898af54ffd5b932533945e30c3d23fcc57f57d7dfecdf171f6f6e74f70dae1bd12ad9ac3fc397f5be20dfe5dfa6bb0783af27e7f562eb02b69a7ffb067dfbe1f6269fafd1ddb76f8ebe20fbe06bf6f91a2da16fcff3fe01ade91b73b4777b5ebe1f4777fd9735fb70ecf98f9f7cbc598ff727eff9edcdea3df4c7c3334d7fe7fc7e3bbe3cdee7ff054f9fe7ff3f1e8e8eaf8ff771fafbc787981b93edff0defbfc6f97fdfd6d0f9148ce3e016f609602c9d336df6fed01f3f76d27189c733398f27fde665711e9be6984cb17eace7b36e9eba173e0ce7f37b7ffeff6f2cfd3d3f8fed609d65799ebc3bd1d387f5787ccbffeb1fd96f60df78cf78c7ddbe5bf2ebb783676cb7dcbceb9dfd753c1c1fd3790856291fb646f99cfeeff7b128c7f122c74aa0af99505fd6fbf6abe8a3af8fddebdfceebce754cdd94df63e724d0412cd1c1a71b0d8a717b450f0fd9fe57b6056ddbecad3aae197e17e21140eff7675d2605db34d8eef8662d96c9cbe37d6c05cb949744aef2ba53d5a139f871c15e31db1f3f5ac58531ffda98774629defe37fefde1f678e885c75f575dbdd8ddfd75bc225b29f98adc86d3b1b167d9fa706ffc1fb0765f743529cec3174fb2b1a33676d6cbc567acc5c5d7525d8ac7595cc762c138bbc471f6dd21659c7f7d4da8cffb62421d6bba9eb2c5c345a78b09ce3b58126d2efc76a3552cb53bf3129ba7be7c9ea3e1fc3c8623ba4eefa0f8bee68bf9fab1a0c8df601d8178a7f18a8c2f7a96cccfc1d7df666772e13a7fb6af2113e9ee6667a95dbe8bd620d867f31ccccd170efc3ade58a4df744e0d696c90edd9053b10ad43882ddcf217c598e29a37f8ff4f6c018cf3d53c52c6195fc639ee4ad789ab3e2f7e7dca6280ae600decd0e6bd1353c79aae7bc365b6a63d09d6df31b8fe02367789430299ddb5128fd47cf1c5beebfae6e9d38d9614f92f6b4b618f6281f375b6312b3ebe59c1b87b911d5c5301de692c22e58b9d9778147eb98fddc05f4f626e0f3af97ac23f5df3f86b6cb2aef73e09ddf7f9f09293057335b16b1cc217fbfadb42ae29fbdb74f770e331bbe41c81f86bbe75a253583d9ffae668e79acb836b8e06e7355c9023eb38f6e48bffee469bf536e4797c7efdf72f671dfef8eb9928efd5e1ebf319393da316c6b0ea9fcfb1ae19bebbc147965780f209e9ef027ec9eba1df3bc62acd0da2fcd797b37a215f12be3ecf0e8e7dfae469be2fcb3da0e3ffec7abdf9277fdfa363d8bcaf12df0ca357c02eb2df76bc68f4edf51eb65eb4faf61febf979ff79b275dfd9d159a7f30ecde965dcd169eb185f50def96841b990ec3bdfd87efae612ab1f44bfe3bdc9f6f5f2861baec730f89647c0bd6ef9fb18f93ee4a61383b58be5ef13ec7b2b5af639d45fbafc7d07f93e70a25997437d48cadf7791efb796390a9d44fabd017f6ff5acc5db71ba90eabf877cdf7116cb2e0b80fe4fe5effbc8f7311f5a3d06f58f2a7f3f40be3f398bf1d112ccbf17adf6694d2b60f3b7fa5cdc6f626ecfd21a46bc1e73d613f87ec81f51db89b83d8e9d005c3f0b639f61630fa6c3a73ef4d6a53876b640c61e2c0db6007be115c78ed80d0bd9828516543b581abb878cddea5ac97d02e5998b639f0ee1b14fcf7a4b26509d4371ec98cdf4d870b2b5a0b7e8d9d8ddde64eb467e28b49b681409c6ff735e6fb9b114d94ee84416d4d32b5bf7ef62c71ebc737ced899c68964c4d7cadcee5c06cc8311c7bd615d8ff4d0ed48eec597fbab0a03aadaa1c982dedf990ef2cc11a9acb81dad3912d9c58e00b3739709bb23a563086de7255e540edca09ac8e65e33ef16aafbedcf4ec2eb22b2eb2ab01b74f5f696e10b7abc831c07e5e996f84a16b9ece713e6e57b6739c0ea1bc5a550edcaef8e2be0bc5a9553970bb62c1743187fafb54e540ed8a05e1797f26c881d9d5b8c792fd09ea3b539503b5ab85d77516601d63550ec17a358b2d718c1072bb9fbe1981d7fa87738c258831fd98dbabeff43d06ec5f060f38f86637d3c3bb17ad427787d9943570eca5e1403d1a2a3230448f2cd8eead642ff0cfab0c4b588664d677a2710fead95891018b9592a9f9746201ee1737191258062b72fa6c01be73afc8f086c830eeb1c0eb4ce5f380d9526c05cb8e65e0f390e1b162f176c8d2150adf3bb3efb1787b67454e17eca750fe1eb3a3be652f0d2e589bb2ef9178dbeaf0687672127c4dc8be47e2edb30d8c63c7c6e3cd0276bb1a0e7df97b2cdec631b9cbdfa3f1f674b80a5920d53f6e3f18b6707e3ebc62ecaae1ac96bf47ed87dbcb840bce1b058c4535bcc8f2f798fde0d879e5ef31fbe958c17ec017b8fe0bb8596a1860e5ef71fbc1f090cadfa3f6e324cb783a94ce3f6a3f28ae4bf67d09df04dc8b701c8d6c0d2ce13928e21764eb70a97fbd1a16404d0e244612f43eafca81c548829ed35539307bc2fb37d7e440622441bfdaaa1c588c24e8135a9503b32bbce7e6f53c5aecfd08c7de688fc14c8e52af3bc5de6e59ac57eaeda5d627ad2607167be37da1aa72607625e8c7539503b32bbcb74d4d0eccaef05e1e553950bbc27b2854e5c0ec0aef477095237f17aff636bafc3db6df45dc9ef5b860bf2dbc8b547be359fe1ecb4fe2efddcadf63f9c9900f9d8e23c82f16debaa8bddb297f8fec7782370ce5efb1fd6ec092b1c1a09e98e5efd1b31b5e8b7dbb5bce6c711e3bf620f1cc517ab752bf5bbfdaf54339970fd56adeea1a4a39fb7a0d8b2c775f1ba3ffb159cf437c6cf7c1f97420aa21c8cf29a341d66ba07a975ecc23f478348aa05ae8aa8c853cbf404e41bebf2aabedc7ce7a9fbd2917c8bb988453d381f62e44e6994466b6e3c35508d6e0c232772532e3770488cc97b785b8cc2c79ea5bc33d94d34064f6c43227f32d5b2c0de86c8ec8dc17cb2cb8574064bebc31c165b6eca7d81a8239e7aacc973b0309bdb39f301bccbfc3f4247322b88b80e9c9ec3a5aedac680cdd6b82f42e6fb845f4acbeb57883f237303d99fef0fb0b989e4c7f8b65c217609f00989e447f4eb24caca14559176e771d227a823b0f989e447f2c99c5ce6202c546557af95d06c1a6d3dcb10a4db95d63f724384da96d4ff656b285e27494a6d4be037e8e7729f658ba5311d2c4ef56709a327d9a33c309c64a7324b7f5fb2e83ef4e509a727b47ef63709a329b37c6091b8e283e9edfb3106c9e47249b2fdddd486c1ebbc3c1694a6d7e1459c337e8bd1c4a9360f3a1355c29e9536ef3e8bd0f4e53a6cfa13348e334159a329b0fbcde7431529a23a9cde37745384df93adfb18227627c9ade1d9c24f16464993c82fb6dd763abdbbd9230e6c5ef9780f8ef72d725999fa93d0a593486eebc30b93b9298d2989ab3640ae56e51b99792b817bf93c2e496d9ba956cb7d6c2a1f8f955ee9e34960edeba0cecf789c9fd26911bbfc7c2e496c6e7c1b8cb868cb2a777b83dfa929f3d97e96d3cd493b13ac6c29d97c86ff0bbaf7a2e2074eca3dcc687671b9f51e63a935972f64ce6111f8e028a1f16eec944b683df972132cbedfb69c0923d25ceca64969d3d2701339e7a106e1122b3f4ec89deb12132cb6ddbeaf005d8f7af2a7386812fb3edd58e075b30d70de48eaef77142db46efe56a6bc4e4cbb7e531fa74f11070186f00915966db4ee2042c84fa4f2032cb6c1bbfcb436426d876df827bd521324b6d7bc722b6a3e4920af77e42db46efff1099a5b61d3d75ac84748ecfeff5a431248a7d8fd394cc8de0ce10a7298b2171ac6b94a62c2617601ce3346531247ecf88d394e510714c539ca6449f022c4b94a62c2617dc4de23465368f63d7556956b0dbc4e73b04b30ca729cd89a1f799384dd93914c7284269ca6c5e804d83d394e85370078ad394e853804581d394d93c8e4180d294da3c7e6f8ad394ad2178cff11acd421f6a410c83f7d6aeee6dc51ec8a2732d7ad75a8bdb8afd86857946acf72c22b3ec6e08efb18ac82cbb1bc2ef671199e5b916b4072122b3248611f4da436496c530f89d2e22b33cc722ef7b05bec3aabfafa5bfc7cadf98dee22dc766ffd5df1317f69d21d87fa63ac6dec60c3b7c818fede27ba2f79cb5b75bf5778d94375c3519b33bd942ef5338fe9b4756e44077615559b3fbc9423f6b50de65cc175bb02e1f91b92391197ff785c85cec2d0bc7920f5b6e83f74d88cc8e58e6c43b4d87f703716fdada1b3181cc82b76288ccc51e83f0d96196b0448c8b5f96792f9179b4b582fb18eebd5696f9f6ae4c6837f8fb3298de58462fe4437f2fc69328d293d9350f9d60b213f7daafbc4513d343dfa4c1f496127a4e97a5f7bc547a129b36965dcb5826f0fb69889e27a187bf6383e9bd49ec2fdc5991a5607f327bf63a56f4148b716580376f329b46debee13409766d4f02280f8ed394daf68e0fadbeb8df28f04e4e6c8fe87b399ca6ccc657fbe9709f50d6d99ca6cccee77b168d63b53992da3afac60ea729b5f7800dc78a3465367f8e8f67278a8f97dee3496d1e7e9787d324d87cc4c0ba699ca6d4e6b73cb8ef43f72a384db9cd636ff9709a529b0f9c64df8172ee384d99cdcf8ca97d8ed35468ca6c1e7fff87d394d9fcbec7eca713c5df739af2759e0dc727627c7a7b2b288ca1f13783406c95de23c6b29897458e21c13baddc234aec3d09b77cc177620ce4fafb4291dc82778698dc5d59dc3b35273b96d0e2de8bdcb2d8dceaa77502045b2fbe4914c7d2e8db444ceebe44ee98254e8f416ff550b965763e8ed9629650f6f4c23b46914de2ef19ab63bcdec94ace9e4e30899804cbb87c3f29b3f1b7135ff03d65ae0b6f1f85f688be814464969d3dad64bbb3a2911077a22cb3d4be4fd38537a0c45985f792425b44df4d22324bcf9ec15b972f1cca3a91c92cb5ed8499cb2ee52c56786329b46df4ad25903b4aef6465b61dad42cb7028e7edec7e5266dbfba363c830ec6bef3245f38cbfcf446496d9361b8e07537b4999e74c66826d0f2701254e29bce514db36f6a61391599e57b1ba56f026c4192ccb2cb3edc9960d2dd239bef4fe531c43a2ef40719ab298dc4ab8398a287393d394c5e44f8983d411e1342531b9e0ed284e531293278ec18c9504f3bf4a5366ebab2d0bfc3de55c5f7a672aa489bf37c569ca62f259c2864f034afc9cd394d93c0bacc43128679cd2db54e9f90e7ea38ad394d9fc72c087db881253e53465363f339c88916cbef49e557216c3deb5e23465361f46cc18916c3ea729b3f9b723b347602d2b4e5366f3145cfb2a4d99cd53f0ccab3465364fc1b1aebd9b15de0d11b085cb77b2b2bb2102866ef97e529e673cefbfa49c721d57541763b27c272b3bcb13b014cb324b732ddd731c48ca53d7f1e574b1c6ca77b292188682a95596599a63395ac1ac2be8bdd32f7c17b9c6a0f372c191f895f6974e5631b73bf83b62a373d30b8feebefdc76e8639717f7c09de7a6c3d095d9cf7ce59b3429f7bfee3be9f695e30362cf1b75f1c1df7d3b7b51877bd6875c0dfd017310a2a7c7ff763f1b8d35ec398dca79740ac33ab27e4dd97e99c3d0bbedf49c7de158c3d11ea6cc18b9813159dcfe1314761c7b14f9f4e7dae3aaff6299cee1e3e7d73f4b5016bad073ffe63f7faf7da98fde7f02bc362895d1bc444e97acf0fa117b1ad672cab7d682f350d06fff1a2ceaff1f3eae899abd83146e03d9b6bdef5f87a1294ec35eb899bd53c446e6f7298ee1ebedc9e7fe509c8c4b69e79d14911ab051c9359fa2da81f2fea6eddc72e655c586ff7ae1bcd432f4afb4757c7b3e736fbf1a2d115cfaaf85b603ce9bf1f7c731bbbbdd5fba6d0fff8728e9b878e71173b37ecafcaef8118c98dfe77f0edc19eaf275f1b7b5093919babc0ed4db6574c30accf745e73b3fae6e62a46faf0c3e37c2e7e23a05d95bf5e1fa4ad879cc7e0e765ad21038a1972dba78a735bdbf3f4ece1463b9dbb97f5fcc38beebadc78a3d2af7e27e2f1edc65d1d1930bfd8baeff3cf74ed5ab3422fef02f69b7d5d7bbb3f6e6ffeb1b107efd3dd43f9bb2bd6d9e3057fd1edb13ef25d5aebe1196ceb827876acebbc3f7cbe9aabaf177bf4ed9be1d635c3ad67acf6379f78ccf16faf6ba46bdffdf8e6f6d38dbc12ded5598e145fd4be4b5e1fbbd735f9e01a779dcdd9ae120166d9f364eb188733cd836f86ef9be71276e6175fcf534c41aff7b0758c0bad97c5fdb7355cc1fb528d5edef7dc35579d14c370cd8e8ecd2e723c768e2fc17dcc6c78aff2a251b039f38dbbdfe7b5cfb18f627a709f95d8b1d97fe7b97959fb9fbcd0031ca1f16d0dc75d64dfaed02ad6e39d423fd55f37f1cc5170b1850bf60b9156e23f8a756f3df64f302d16bae62af01f2b7843053bf2d72c1d1bb74fa1d39b95d691cb6f333b5aaf9294afb1fae236eb94fceaf1fadb14e7b5eb19a9ee2ebc87c535fbfeadf89f88372247baf691e4b8ec1f1439529a1439d2f5654890a3c01b9163eb1662b4020e718da7639fbe8a63cb30a8a5bfc364a8ff2eb52bfc7715acac4cc60a2e60112fb3a6df125ea250862256e375ced61660a3a5752effef59c0bbbaf69be1bbbbbb9c4f2a985f39865f114f211a9df923fbe0397ef5bf5fd6f31fef514c27c57fc4c773d8d8f3bd5b588bfc6815fb661838f631c76630bb9fdc9c7f41b14af69bc315d7338fa778e89df72d6374de5fd2f166b8811dd780e2fa9b4cd97dd6dd5142eb7a4ff5e301f1cd755cb7fcfc85af58ce2b26c515a3b4ae3763b3669f4e742ac48dfcd333c2ddc63e7dfacffb5fd7ff0deeb951f8ed4761b249b13bf279bbd00cbf36ebf960ba7bf838cf09b7fb803f5ffa4e5dd6e2e5e17a4ec9c772f97bb68f84e99a79d9af08b4fe77f07b936dc19e2ef5cfbf2ffb48762eea79d108b2a7cb6f33be2feb87b8205f8d8ef70cf6200068742b317a4dbea3172d0f581c7dc1da585df8feeea6bf95c9781edbcbda8f9d35948399c4dc1efdf7625fcf49373a694d3c8f4e3f7edcbde8a8a2fb332fb737d9bfd8171ac2b9b9f259fb3f5e342fe475d2983d4a6b612e63fc35368bbf85eefbae631e7d15c65b1dcbce8b56db4d9cee13608ce0db83c059cf43682c559bcdcf0ca5df84ae79faf49ed9a79788ec15d1fdbb57c491ed6dec79678360f4fa71e754b0994ffe3cffb8c440680ea7f7b22860db18e1de37df2ef92a2cf7117712988727e03103788c90dc8c7760bf017945b9a4b863006312e48fbcc314d2e93b4aff04cec1ae2f90f92906c684e585cebf8764e808e6ed08fc5e34cf1de0f706fe7b0b1a7f5f30c7d0784ed633aad30e38078b096e170b07b0a327818e1c48e61343e7b98a457dfd068d6f7a2f29865d9d87f70cf5d4f50ed6eaf863edea72b3c53db837bc2c46de4b6201725bc94b6025e9ffc5502cef1dacf0f863c500afc0f9668163b0c0e94d107b5f3ffe0fd25dcc7ee3bc18309fb85ccc7b49a075c1eb0ac6d485c6643d0ac604ac256c281ad31218937344c6d441c6d447c7b43afe3060fead85744ee2f5e3ffa03522b1e27e925982402ec8779622b9205e06dbf58dcb383d84d70ae1f526b7d7b36f80fbd1db77f66522d0cd09f22b825e13f4db6089c838f75e12787d97cfc50afbb663fd96e99663df76317b635d4ca7cbbe6c3ea623ec5bc778b9e8d460d8b7e85ce2fe6da1fc08b693ae93a06e0652bddabc5bcc1314f69623a657cf6069cd2ab8e70d1f0c1fc8f7bdee3a853df223ad25e1eb37246ff671b016794fe1d75dda5f207c4d6d1af97d80fc1eecf7ff71b012e4f7209edcc7810de1df3364fc0c193f43c65fce753cfcbaf47d5ff5abfbeff9bb99d90db9196ec78fdbc3c6ee1f0a73164f870fc7d7c72b5ee2a0ef8e8ebfbcdef8e01a83afd77c3f38ba3df679bdef70ec49e21aa7ae6f2eebebc0faa17fcdab6cccd505afb1b0c79f6939f67c7fcd395e7febdaa3981be1f77477c1babdfd7b8f875ec4bf2e18c9db4f6ece4337b8dd617f6dcee79be749e8f46615bcd93ded8c7ac10d8d799a5bcd63f492edbf6fbfd25c7f6f7690e9c6ebcdb7be1906d3aa7f94f2430f1fdc4e73ced57f97e9e608eaa5924f48efe2cdf058cd7365b892599e7699e636d3336af97c94da40016f367eb1efbedde77ded6ec333ef92cdedae67b4f38cbb2f7f3d0f6bb20f47c7d76b8ed2dc861bdbfff02bb6e299a33dcfee00acdb6fbb5b2ff23fcf7a4df19287a473e555f698dbac53c1b7fd14e9223df7df784ccee7ead0db75b17364594f977be3c4db757fdce834a8d55b3c3ffc784678bdff497c73d4b9de3f17fda7946f2ce8aaf4efcf0f5b6ece4bf708e3c78783b39ebc6fec7ef1dff29c43f2f176cb2b2d2afe53b641c85fea77dd179fd87acf0fa1b39e6cdd8885192e2f94432efd0ecad367f957e9efb2dc30febbf2f8763caee1e2ee1c9bfdc77b931fdf1eec5fec51c73747c1c6ec86ee3b82919bd3fa720d56cce948689d7e1c03d4db9956e2f4263fc5bdd449ef90fcd8edadbef96377eb3fcfb78eb13da43dee770fd7df97f68357f3e44da3c97bb5277e9e8349efb9debde8aeeb46b3c3ad8ee8b9d2431f1ee3ce3dcb1363f20afa25e434327b92d3a8f5c3cf69e43df051dd17df63f99163f3404c6b4ca305f547a8d1b268b4a0fef7355a33122dc798ec99bd94d05ad26841fd106ab41c122db0df7d8d9647a3653e75d9a2f286a246eb8d440bec7f50a3b5a7d182fadbe7b46e3deda53e78abd95c223e9d62b396f14eca6b84acdf41ba47399197f738a0d829d4cfbe225f197b1097cf49de4ed3e19348be2e4d3eb0bf41453e9a8d82fdebabf22524f90276d669e50c5091af4f920fee6750918f669f60bffa5cbe528f7a9a8da66fa5611973ac57ba9dd6fb17dce42cf52c20d92ad09f1e90956aaf2c1aedd902f1c75c56a2cd82fd0a005989760bf5a3876425daae355c1a5680f8662e2bd57ea1fe0480ac441b86facfe7b2967ace136d38426d38c795a5db70bd1fc14dd6520f02920d03fde60159e936bc3c321bdb536eb2526d18ea3f00c84ab461a8bf3c242bcd86772cf10696edc86425da30d86f009095bc0ed7fbc997628e5b0f79cafe774c71709075f3d6ab2020ee5b407f81dcf70b3d05087282fde3eb72766873e0f4f9d01a60ebc8ed8d3ec9efe17e02753969b60bf68bafcbd9a3c93989a6c365d791c83925f93cdc3fa02e2731be85fac3e772e63de149b156baf262b148468b761603fb05d468116d16ea075fa3453b8b3193ef2ddbea8a69d1ce62607f801a2daabd02fddf6bb46867312bb27acec2df896911cf62503f801a2daaad02fdde8bf98b6b8f77d2597f124dabbdb06bb488b60abdffafd122da2ad4dfbd468b68ab01db59069adfca68d16c157cef5fa345b6d57a3ff71a2daaad8e0dc740e3e48c16356f00bcefafd122da2ad4bf3da755ead94ed8c34327723ad59e15f99a7fc30126c6b4e07bfedb7e547ac34fca2901fdda01596931add5e34118a079925c565a4c0bbfdf076425e6bca0feec90aca498d6ea38c9f2e4c8e79518d382eff5015989360cf563cf652df56027e5876ca78bda708e394cb461f07d7e9e4329bec9279d5580feeb80acc473d98e25fb4e150b0a909568c3e07b7c40569a0d83fdd6215969366c38c1cc70122cef77939568c3e0fb7b4056e2b90ceaaf5e90f5d6539d74764fb149b1fb818c16f1ae017a6f5fa345ce8bd5fba9d768d162061e387d668c13312d5acc00beafafd1a2e61280fee9355ab498c109de122b78c362868c162d6600dfd3d76851730840bff40bad5b8ff8b30f547aa0d77bba23be25ea9d7ef1d75b9ff7fc3e30a9bdff54bd172c8ffd72174f1e33d0533d5d0bf2f7aa97336fdaff1cec472fc89d01fdd5013de47788f5f7b63a7789257d643dc213b0373fbef7417dd751bdc0382178be08eac18eeb05c4f2d3b97f84f46228e905eecd8eeb05c4a910c46a409f765c2f20d69fce9d25a497819a5ec0feed75bd5ceeb0609c4ba17fd67ab963b461ac118d7b4e80b6a20f813dde31da3006264e1beaf78ed186b14834ee4601da6afe03f781c768c3f898f89913ea098fd186b14a34ee5301da8abe03f68a07d694db1d9abaffd4fbc68be8abfb1072078bd057f523a89fbc88bea22f81bde545f415fd09bfb745e82bfa14d8735e445fd5afa0fef322faaabe85def522f415fd0bec4b0ff8eeed7e4fc3bf6a3dea45f4d5fd0bb91f46e8abfa17d4bb5e445fd9bf803ef622faaafe85de2923f415fd0bec6f2fa2afe85f60af7b117d45ffc2efa111facafb17d0031f8c892ff78620be9c205f00f4c387e3d6db9db5ca5904bfbbaec6dce97dac9a6fc17df205ba0171e804f72f40cf7c816e60ec459dfb6e50378a6729b097be4037205e9de0bc03f4d517e806c666d4b9230775a3e85360bf7dc067b3def86a7909b0f73e30f6fc3e5de5fc8ddfab97f33497fef18afe04f6e447f5a2969780fbf3e37a51cb4b08eee221bda8fa12d4b71fd78b5a5e02ece18feb45312f81dfdf437a51f523a8b73fb0c6647df815fd08eaf30fe639af77fd2a7e84dff997f492f5aa57f423b0ff3faa17453f02b10070bda8f991a04e00d28bba1fd5310270bda8fa11801780eb4535bf87d616407a51f423104700d0cbed3e59f10c05620a88e82b9ea1f07a0484bee2190ac41a10d1573b43c1b80322fa6a6728410d03425fd1af403c02117db533148c4d20a2af7886c2eb1e10fa8afe05621600fbf7edae5b354701e11788e82bfa175e2b81d057f42f10d740445f314701621c88e82bfa175e5f81d057f42f10fb40445fd1bf401c04117d45ffc26b3210fa8afe05e22340f42f58068af7bc105602b0afe7f51b2af90fbc8ea3b4af67fdfe9573eb008602aa17c57b5e104f01d78b5a1c28a8fd80f4a29af383701670bd28c58130e602ae17b53850502f02e94535d7076131547a17d4df8fd7f122b4df91df3024b25876eb185f757c0b74fcf74771df85f3d82f3d02c963bef83f3097b7bedee537e775ac0b9db7e7253d6477f6300ea8e05e8a079308bafb2ee9e35afb02e258e267632b5af6e13772905e400c229df7ea905e609c503c963f3af62a90dac9b5c603c4bdc16b8fadc5db71baa0da0b884fa4f3c61dd20b8c238a9f01f756e275a0181ed2cb14c4c641f57272cefb22582b55d2cbed3dbca27f22efe201da4af3c9226ecf7a7c08edfd006d351f8ab83d8e9d00cabbd569c3f8ba3a6fe901da8afa668bb48684465b4ddf215bb0d03288b4d5f42d787f5fa70de320a1f63d70824940b56f45dfe9b1e1646b81f9fc12edd25b7d35ffc1deec23f4957d68b573c07b2684bea21f39d12c999a503e12a6afea4bf83b7f84be9afe433e7c1b588974cfc8e9abe97fcf877c57ed5527a4afe857786f0098beb26f25b35889bea27f3981d5b1c037f025faa53e028afe85f41340e82bfb170fe03740087d55ffb29de314bc0b85e9abfb17d68300a1afe85f8e610d9829dd6773fa8afec582f01c0fd3e9abe95fd0b700a6afea5f2c596d2d79cc9ad357debf6671b5b7261c13df7a1c28c4f378af836adc7aa93300715bf1b348c22316816f9daa31f7a53e45696ead81632f0d077ce705ea06c6b8d4e88f00eb06c47515dcd56d774ee051ce2397fa14b5b35432359f4e2c90ae3bc55e0a2ae71db4a702ac1b10f75570efed192c017b0680ba51f4a9d80a961dcb90da4dde7f41252f81f761288d3dbbb357cc4b8c631ec07751951cd3a5f645cd9ffa96bd34b87cafca7b36289dbfd1de0d905ed4f21289d57782c916ca49427a51f5256bb1ef4de5316cdee741c5d6f17e0f905e14f312e33eb39786740dcbf4a2ec47c93c640b696c90f78650f123bc474435cfd9e5caf9bd49c8172c92e76bb2da17c57dc932d91e7e7f0ce945d18ff0be12905e14fd68164f176128dff3b21a0f653f7a8b1d797e22ef41a1e447682f0a482faaf9bd802dc67da8560ad28baa1f398b5942c80995fa5628c5f068ff0a84be5a0c1f58c67ccbe5317c4e5f2d86df5ac604a9c984e9ab9da1443d2f10fa6af36bb0c00fb9ad307e35bfea3a0ba7c787d21c51a93f86027d419f0c98bee219aa6325b3d3547ec6c9e9abe93f7112cb6091749f2af5d4503ce323bd3510fa8a79dda93debf348ba9fe4f4d5fc6bcfa24940f0af521f0eb53338d68f03a1af36bf031ef85b827fe5f4d5fcab6f0d575bf81d04425fcdbf043d3c60fa8afed5650b164c15f4a3e85f1d36b41266c8e9dffa7da8c48178df8fd2be9eddd92bc681e91be750baaf5f6b5f9473ebe798446e37b71e214af7df68af10482f8af7bcab3d0be65be85d14a417e59cdf39f636a5f73d795f11959c13de5f04d28b621cb8ef59816340b57c905e54737dd670dc610bacb7e4e4c77f9effb8587fff7509f702c476cd69b1d07d9fe33533655a01b7071dbe86f6cf33ad53c8df6798ee4a982daeb98a7cac1ff5f96f0036ac94dea34cd6747c650cdbca1c94f1552ef2d4b07a0b7dcb53ac10aca74c597797df627db2a2149ba68ed72b1adff324c5fa199b993da07dda2fb4c9725fe93ed7307c6f34e9b4701a5eb43a727b84e2665cff9ee2caac1f42678df4f1b2073f2feb49e899ab2d3e17d9df531cf2ffe5b49179cdf81d5e09f4aeb45ed648cfdbdbd8ba0305590faf78dffd8cdf652c149aa9cf48e6e18af74aa097e1d4c0f42a385915bdb1cfd76859c2bf01d624618da06784bff8e2e3cd31d27a3c9486fb3edf6eec3eb67e249bf567785ed35c63d071ecf01bb205b737d9f3f5f8b2369ba3a3672c0bf4320cab74cf396dbd6796d13b7d39eb07b07f478e7bc542cf1cc565ecde1bfe5181c6c79b67ac8ece7ab2f54db017e3f59b8e178dbebddec3d68b56df453f28c940c4a1b97d73a91d446416e3d05c6964bda68434301c9a2b8dac16abd2b71ba023c0a1a9d08aa5b4043834155a8994960087a642ab23a325c2a1a9d0ea4a690970682ab40c192d110e4d85564f4a4b804353a1d597d112e1d054680da4b4043834575a17dc90722fc39a0f8a7168ae3e7dabb543d608020ecd75bdb9f56692f8218e4353966f26954f80435392afd2571e904f844353924f6aa3221c9ab27c9e4c3e110e4d49be4a3ff9ba7c421c9a927c52fb14e1d05c65c8714128368ae2d05ce528d5b349ed14c5a1b9ca52ea7f24b1551c87a62eabdc5e0538343559e5362bc2a1a9c92ab75b010e4d5d56b9ed0a70686ab212ec5780435393556ec3021c9aab2c392e08c986311c9aab2ca59a31a90da33834575f2df51892d8308e4353979562c3280e4d4d56820d0b70686ab2ca6d5880435397556ac3221c9a9aac721b16e1d0d464a5acc3280e4d21e6b8d43ac9f73f010ecd9577a12e4bb66fe1383457dec53e3e4239453834553999740e44383455399712398538345539a5b62bc2a1a9c999c8e414e1d054e57c93c929c2a1a9ca298f6f053834b7f3dca5fe447e1613e0d0546849cf62221c9a0a2db9cd0a70682ab4a46731110e4d8596f42c26c2a1a9d022d82b8e4353a1253d8b8970682ab4e46731010e4d8516c156711c9a42fea2cb297903010e4d8596dc56053834155a725b15e0d05468c96d55804353a125b555110e4d8516c556511c9a0a2d82ade23834155a84bc018e4353a125b755010ecd95568e0b228d69453834d735bf54c3238e2f44383457594a3d5f2439251c87a62eab2ca615e2d0d46495c6b4421c9a9aacf29c970087a62eab2ca615e2d0d46495c7b4221c9a9aac721b16e0d0dc7227375c10f9b94c80437395a5542723b661110ecd357e2ff555919c55701c9abaacd273990887a626abdc864538343559a5362cc2a1a9cb2ab561110e4d4d56b90d8b70686ab2cacf65021c9a9bac977a0079cc20c0a1a9d092df350870682ab42879311487a6424b1a338870682ab4a431830887a6428b904bc071682ab4a431830887a6424b1a338870682ab40839040a0ecdd507aabd446a7d566abea5824353b90f047aa190ef0581b15f7b89c8c74cc0a1299c796fbd446a7d61c0dc19018706b84324e9828643939ffdf35e22149d9070682a7a817b2843f9220a0e0d70ef48d20b0d87a6ac1706f7ae06f60e120e4d452f70ff64305623e0d000779534df21e1d094f53285fb71017a21e1d094ef20a9b4693834b5fb4d226d0a0e4d9936d987483834e57b4bb8cf17449b824353bb13a5d126e1d0946993f54dc2a129d326eb9b844353bb47a5d9370987a64c9bec3b241c9afadda88affc87168c0bb57b20fc97168eaf4e97e44c1a1a9dfa7927d89844303ded7d2e8937068eaf4c9fa27e1d0d4e9d3fd8a824303def1127d8b824353a74ff62f120e4dfdde56c9bfa43834e0bd30d9bfe4383475fa74ffa2e0d0d4ef7a15fc8b804303de2513fd8b824353a74fd63f0987a64e9fac7f120e0d78ff4cb37f120e4d9dbec2fe45c0a1a9de1b227d94a17c01058706b8b3269e45283834b5fb75eadcd27068aaba417a2843f72f141c1ae09e9b781ea1e0d0d4eeaac96729120e4d553748ff64e8bc43c1a101eec669ba21e1d0547543f729120ecdad4ebbd04b8462f3241c1ae03e9d76fe26e1d0dc724c855e2214bd9070682a7aa1e625683834c01d3ccd1e49383465bd28f8120587a6a217725e82844303dcdbd3f442c2a129eb45c18f283834d7ef8abd44487e44c1a101eefa697e44c2a1b97e57ec25428a0928383415bd90fd88844303d407d0f63c120e4d592f4a7e24c7a1a9e845c18f083834404d0171bfa3e0d094f542f723120e4dbd4e801ec3537068c03a04e21996824353a74f8ee1493834f5da02720c4fc2a1016b1768f449383475fa64bf22e1d0d4e993d733120e0d58ef40a34fc2a1a9d327eb9f844353af61a0e728283834608d0431af4bc1a1a9d327fb170987a65ef740ce51907068c0ba0ae23990824353a74ff62f120e4d9d3ed9bf483834602d068d3e0987a64e9fac7f120ecd8d7ea19708e99e97824303d46fd0f21f241c9a5bdd4aa197082db74ec0a1a9e8857ccf4bc2a1016a3e88f7df141c9ab25e14727e141c9a8a5ea871200d8706a81321e985864353d68b42ae8f8243733b6796de8f037d5634de9117faace4b1ac63b3ff805e28c0f8a53834d76f7a1b33ecf00561cc041c1ae0cd39d01746e9ed39a087acc603ee9703de4b1170682ab50c16d82f073a1b5370688077ea24bdd070682a351e70bf1c2896a7e0d054f4e210f542c3a101deb693f442c3a1a9d478c0fdbea033200587a6a2973d552f241c9ada7b789a2d927068cab4e15ee4206d020e4d9936d987483834b537f444da141c9a326db80739b8ef137068cab4a9fe43c3a1a9bdbba7d126e1d09469c3bdc701fb26e1d09469937d87844303bed527fb8f1c87a64e5fc587e4383475fa743fa2e0d080effba9f64ec0a1a9d327fb130987a64e9fec53241c1ab02700d1af28383475fa0abe45c0a1a9d3a7fb17058706ec2340f72f290e4d9dbe8a7fc97168eaf415fc8b804353a7afe25f721c9a3a7dba7f517068eaf4e9fe45c1a1a9d327fb170987a64e9fee5f141c9a3a7d95fd4b8e4353abc120c7f3241c9a6a9d01d2eb15388b907068aa750654dfa2e1d0d46a30c8313d0987a6aa1ba4df2b745747c1a1a9ea867c9622e1d0d47403f7d985ce3b141c9aaa6e909eafd0bd370587a6aa1bb24f9170682a77f6f4bc040987a652e341ce4b9070682ab50c647f22e1d054f442ce4bd070682a351ed4758c864353d10bdd9728383415bd90f312341c9a4a8d07790d23e1d054f4a2e047041c9aca9d3ddd8f483834951a0fb21f9170682ab50cf47d89824353d10bdd8f483834951a0fb21f9170682a7a51f123390e4d452f0a7e44c1a1a9d478d0f37b141c9a8a5ee87e44c1a1a9f737209fa148383475fae433140987a64e9f7c8622e1d0d4e953cf50341c9a3a7dea198a864353a74ff62b120e4d9d3ef50c45c3a1a9d3279fa148383475fa64ff22e1d0dcf28bc59e1af433be1487a64e9fec5f241c9a3a7db27f917068eaf4c9390a120e4d9d3ed9bf48383475fa64ff22e1d0d4e993fd8b844353a74ff62f120e4d9d3ed9bf483834953b7b7a1c48c2a1a9d47890e340120e4da5964125b72ec7a1a9e8851c07d270682a351ee4bc100987a6a217859c1f0187a6a217721c48c3a1a9d47890e340120e4d452ff45c1f8a43f37ec173e0f6ece04677dffc779d8e178df69bf52a99eec03e20171c0673b57f59b3032063ecd8f34f7f6dfd1a0fefbfadc5b8eb45ab83888e9446b08ab90d60c3146531bdfa5cae275b6e865d2ff978b31efbd271bcd8bceb9a47219de9623f98ae1fa05c5b412fb86e7df3ee78c5b3a9e9b9c473fee33d5e716a4afa10eac1db75bf5d6300615374dcdefd0d5346c8fb8653a4cedfc1f95f317e54c7f0c56dde29cd25e033178c9179c81fbb5f2ff6e0c78d00dc8a8b4e117af058334c23b2fcfe63377115e6fd25788ad97a125e308068f355fe06d5438cf827912e7dee88e3d921e351a04d9e23d2baf3621f0f5e09a74a62278ffd53712d2aadbd19fe937c8e51fd74b0b902e543c691dad9fa820355193bc966d4eca5a063fafa415acf1da8b6f3aa63a97c0a7642d95b80be79b83f4b7502d915c93e5eecd3bb8a8da8af2b725d78bbee1707620f81dd69d908693d81f521594f74fc97622302bd4868b6390effb11bf9f6e98b6e27fbc174111e5feb7b20662783730ce43d0339a2b29d08c721a34bf57b523c668f0ffc7df505f9319936d19769e339a2e341ec45ae1bc937a8bd9847bd58158d7de7b124fe528f29dfc7e818857b82384edcb98ff2585539fe339bc51428dde7b1882ebccf9262a0091627b612f3b9483cdc46bca4bd2f0b6c573bfe11e8575db724bad0dada38d649d703f02c8dda2f49afa2d845371649d70568ac2dc416d87ede787f369badfda2f54148577bef9cc4685e44a8e7625c21d4331627086c19a79ddb2bfb71cd10ca2d25fe73f875c1e7bcfbda80fd136ebfdd3b6bf6c1ed19e00facf36a9fc2e9eee1fa1b219db32c323ad96f2474563b17c2e42cd1497f23a283ed7b37dda4fbada877f702e881bcf63ff9f3fc0389116ef66801673bdf08f7bef9f66b3c9c88e77501e9f0c6b703fac94dee4e1f90b9b7b1e79dcdf0e36dfa0cfac2758c22be2731df6e07d657baa6def80bfbfd9ff506f8f60d23faa63f215eda0e1c47eec7b4b1d847702c999f12e599c17690ae5537bdc612bdc62f0b685eae6b1d99ce0ea403e9e55d682307f7b103edf7d9be491e4f07b6f3b2bd30b1bd1ebc5d07ca295dd76a321d07a6735b9f73db9b8b7dd73e1e18c16e64e3f11f413f4e63897c2c5bc9fe303e4c49fe24a163c374f273c96dcefb9239ff7a59bc01369861df93e592db8d05eff905bba1e86524d30bcd9f246381e299da3a03d308fcf524717be32aaef9f5df0558e6acebbdb39ff37ecb6dbef5ed53c1feb3bf051f6fdc5c45ce7af5e5437ae8ad62cfb88b5fd60f3f1b7bd029ed1fd9dfa6bb87a36b84dfbeb982fa0edcc6ef1ba3cf022e783efe677fbb01b1f56fe3eff0f5161cbb6faefac271dbdd1fbf7886cfc77c707b1cc2a8b88dd78dee3a2ff6287d9f508a79721adf6e6f7670d21e987e0863bf9c7e8af8e5dc0c13cf3c6d5f171f6fd9df00b9c36fd70cbff9efeece35ef824d3ee7fbcd9a1d5d733498ee1e3edde8f4e946cb839c8ef07be8cef98bafdfc031bfacfdd0890e9ffe39e6edbd417423d75c41767c1b2f8f4ee1eb2334a6f3bab7da6d8cb0f362177400f329d30374ef9ba3528c599423fb1b7016996f9de814beac273f3eacf78e634fbef8ef6eecf68077bcd7ef6d1616cf1a00ef8363acbe5cb0874cb8e7e6ea3bb7f7f9a7d77bf872d6fb5f6333cd49617376f0a2bbdeedbbc76e8fdb93ff36ebd9791defbfd877f1c65e7dfbc38fb78d79176fd69f3feefb7ceb0eb1dfcf3fbd6815f866f8e306692c1dbbf6e8eb9a47ccffbbcc79f5f7e7b1803507e7dfa6faedc67ccd43ef9d857f6acc5eb432f87a92f590bf2f8dfb6287ddfd663d099ddefcd335fabfc6cfe571a1e3b7d94fbea617ed76f2f3fad8fdf1a23074df67bfc6cf673af34fc7c8f556f1a10337c398dbacb3b1efbe2f6bcae4e0d8c72bedfc3ff332c795df07ae71eaba761fb509c7b8fb7a594f423f3aaf5bb93f54c7e1f6269f691f8dddc359679d8dcdfe9c9e4df6e33eaf0eee8ea0ebb51f3beb3cdecfe8f5bc68749cee1e2eff5ffcede136be7c2d2cfefdbc07957f07d67ba77cbfddf8c23bf7cf8b9efdde64eb0d533fc1c6f4e99bab83177713df1cc53e6e3f5bd70cdf53bdf6e65b2fc3c629dbc2e86b63fbdf2f76d8e17637b9aebbe7f3b3b39e40bd50aebc43379aa136e0d8a74f9ececfea9b0feb7399fdfdb059cf43776dfdbad9a43d80eaefaf3c035e891d70be2c74dfe7a1175078177f5b59432fbf3938d128e176ba4f10784fb69eb1fadad8831058e32e73b2eb5e644acefbf78d36b4f7a6f3729e0f2f5a751ce34dcefff9f65bca7c27fef324f44df601e8fd3caee8c54ee3c23c6ec9f4779691071f6f693c09bc0f4affbeabdae84dfe9436c1deb231acbeb98c867db535701c35dba9c961acb0d8f33206600eaa34bcde3ce6eb79b081f6e3f755e29b61f4fa5beab7c7b4b7d0796ecebf03fca7402b708d41275b6f65bafc7ffefabcdef341599f28ffb38f0336f4d9f57af34ffebe3fef09679da5fb6ab6aeddbe29db71f19b8b9ed1bd7afd906273897c1891ff3ac762fed1aae31babd0dd49e5bfe90bf0e3822ed93996c8f66976fe367cb127a1ffec871cdc4f0b63595f72d6f91e00ebd6eb9d75b2bcfd1e3a6f5cf590c68c67bded6afe42d4d54d9e43b66fd4ec1d98f782ad56ce2ed7df3e96f70c012dc486f271d5e7109431f1cc51708efdf97adee3f6ea5bacb7d125cf5f5f67209adb4bde1eacbd4ff732eb376a5f97b3cdeefef4ffb1f7b64d8ae3d8bae87fa9afd367ca6f645776c4f94062709a46a2210dc6ba7163029b1cb0b1c9dc0d24d837e6bfdfb031d880244bb2ab72ef73921dbd7bbacad2d25a5a92961ead17920d9cfe9da97797a043b6d1afeff1e23448fb773e17ef6e144a73bbb725dbd6d9782e582bc6d64cff6e095e9e568bd9f8836c0b5fbf93dfd9b7d9fdace49f786b1bea649c3ee76593de25ca982f378dbc0f02a69ff3b148ef74d534c836ee99062edff4798f7ff3a2cc7ffbce7ef494553cb75b7f53c778697f6bc7e67f9e9cc7772dc7cb9b0559b73fbc68fcee46bded490ed3c3d06fc7181d3ce17c41051df21e8da39350e960ecbb0b1df23ac2d191a874306be94c0752ef7a7774641a1d5c6e890b1dbef951a87428f303f9e647a5d2a1cc0fe49b1f8d4a87323f43bef9c1d905173ab818e80b1dbef939e2f6a3bcaf07139b57e0fc3ed83dd0301164847bc73eec90daff58a4f757bd8b3b2f4a6b15f79e76a175e4a455b12fe0e29e2eb4c8f6239e167d6fc0c6da9f717af4ee6da0c445af23b586d69aaa87036b9d7e43a17b5c792af98e9dceffdc6eaddcd379217bd999d23e00ec397ceaf3ec3f813917f06fe16cb2a5d1239c418fabc5c54fab7c063dae327bf7f4be82b57d5ca527e5ef40652ce7c3998d1e4c23fb734abb6b1ba36847c243e116d9bd1845a1368c7ad1d5bdc528d9fd1dd31f56e0c366e729708de92a9d7b277a94707744143da67672e2d8ad52bc14fa486d5e14f57673fb98e1dbceec29cb45eda961b230a6bb41e7a9e31ae17e2ec92b47862d4f1d87ee246bb7353b7d0bd98b0ff4f2347d7931b766afff91d277e3c3d25caf42f719850b230c9c97a7219a4d43d33f2c47b3febb631fd2b643571d7f983db87237c01f749eb2b10d6630f4a2301a74dafee83496e5e8146fb51cdbf2c73c5afbe9df4d94e9c65526cbe9644dfcf3891aa6bab54de99aebd6c7c2f871d7173ee6ac259dc74196d5d3334a7556095783ce93eed8b2ef45e9ff6efb5323dca119585ad1544d65e2dabd7d3a86fccfb7e6f3a96f226d5bfe70a31f255dcae62e726cf9dd7d5e3f98ddf41e73fc18749ea0abb4568ef2636b76539d811faed14bd2315846e8a3597f95e19f7ef6ddc7490ef06331ebbfa399791a9f7f58baf6e3de554ff74bf29856abf9ac9f614fb7325928d3cc0fe0749ee063c2b3ef8cd57be95e95cb7911666b35dd6302c29acee81f331a14da098df6f96d80d25ea2b6b7a7ebf96cbaa3b49769ed3d65ba9bdbfd0fd718ddceab8aec6c4f7a30758794cf799fedcdca714da1afd2e8a3d94af2a25e551f1aad8fbcb63965fc6becf88b1ce3a77ee646efdd3bbd8ddde500bfefb3cbd467a65bc15dbe72561dbbe412cfe96f4fdf33f49730f5777e3361e077c4c4ef591feff39cb3eae52537fb65bf73ed30d30f863e15a63e4ffacec0b3c7c473598719c648d0e5bb31e6f9d62bfb6b91d6461eff7cb74fdec727b3ef97577dcec66f735bbb8ba5be97a359910f3ed71de3f47d757f80adbff3bb6b35bfc43df65a8672888c5076eff3d5dfadbf2c3e1e7b669ce2c6cf760fb2e5f7d41640f771ddf77d12cfa1ab3e733dace499b82f5ff33cd516cffd15eb18b3f87796311abd609e30f48797e35b19d39a1b179e08df76b1df52fcecdfcafe80d76d083eded1b284a93cbd15badadec384dca6e40f576ad3237fff82fbbe4bf6ed4fdbf8f836243ff8f9957fddaa385336c4ef8f00cb8749a37104d87141b27c2d07372712650e0f383e28638af1f381c8634ab07aa291c734c28e09584fe4f9d397381a47ca7cc88439a7c8ca91f0e322f37ee35757d021fad52d77de339e17bc6fe4f236a6e1edcafec2be152c779e02b33c9e787eda874584a3750c1d7bfcee46de6dac5976660eec721f189ccb98ae9011ee512c4b3777fcfbf62f5a8cc5188b3e1277332dc5cb9cfcb5caf21a0440ade8e36cdb5de49dfb855def01f43e62641f57af57393a706371aaf8d9baca634295290e0b35c264d191dfdd0d7c47257fb4fccf4fb10a2ff2c155e1fbd99f666eb792b93dc6dd17b7f3199406f6a3bc7866efcf55a792a7846bdc1b4bdeee2696e2f8eea9d93b62cb3526bbc57378a0c553e47dace7331854f5818c30c1e5253df7816cf87e7d9724f5330de6b6bcc2f9d0b92fe5f71579e5458bf7540e387bcb7d918ed86f29f1ecee4bd93ffeaa0de92cde0dacc21674ed5e8c94707f3a8b3d4a9b11aecd91fcfd04fb3dc546d80d4ae7d1751bd2592c957dc577ceacbf49ed6753ef13bf07253ba74423a6d10025dba5d42621f3d1557073029fc97308b07c50c61460e743a1cc39564f86e43149f831750f141dd1b0ba6891e70312e69c222b05e2c745e6fdc6ffa8a0437c4fde0d664f785eb0bef16f3bd2da1d589438515fde0df0bab523afdf8c5642a245b48d335a589dd901c2de32b0a5b27d5cc8ba2351d671363eacfe0f2cb28d9cd2c2aee58e1453e81cb13ada91c8eb39934317bb160616c1563eb521f034a6cd2d76ef1f58547d90f06d087663d606bfb607d68aa60b84b191d677266f89304744db39d321fc1adf01aaec1c821c48eb3c1d9f7c78258c0f6f0fcbad01e63cc8e27bca3e1c67fa458c16212e50d6b0fd9dde9a4afe2d97b19de38048fdb5ca77edbbf1dde5e8c18e9372974dc78be9bf18ef6d5e24dcb869fdb770fb5b69fc57b96c0afa795c9345b7635e3b18db278f0dc4e484c18e9d6857cdd665fce1d276613c1eee6c25f2d85572ff4b6cff592c967edbf68abff862e353fb5fef30e7e479fcc7cab153c68debf732eeaa31d3c67b7fd65cc67b9b070a3be6178a9d3c5b62fbbf8cfb85b896e2ab7b266dfc3e6dfc785d3cc735d2eddc749ddeefa1c53aedee19c64e5e472fd2bdad738affbcb2a509e3269ccfd9fac4f57b5e9f31763ef358636abff873ff2ceb9841d6147b3fdb13efcfc6d2b8abf715324e97ed2bb43d51addacf49785e366eda7e9854c89b789f49e54dd16da96a5d126df9d9350679afd7e30a3913ec87d3b9839bc35ca72bf5837ae6e074efbc8f54ad43aa5e60ee7467bd809b2abd20e18aa95e606cca422f940abd20deab5e5fa40490f542abd20be2dd73768d03dfebc5aa422f08766fa61718bbf7a21725db9774c61071e554ced8becf723e82e78a3d9adc770b7bb72ec68ddf4bafc64dba8367e3a6edd325db9e346e62dfad8135b9bf3715e3aedc3b8877846cdcd8be2fe38695f2a6ed1f98fb47316efc9ab91a376d3d62fb2ec65d296f62df2d12d671de470a5f7dc23e427c6b903562dfe775596a8b5d97e4be5b58bce522ef5e299f226eefc3e74e48f791f2fbc8fd3e82edb764ab12b09e74af26dbaa3ba67b5947a2bc97a63496581ae773e61657c2cabb92c67a47d9bf772cf7b30c7322623ad9d943967f363e161ec878c92b0e0b3beb4dd6b6fa9e56859bbd76b034ceeb75c77257aba4f12291f79b8e54795f1b588437eed3f869fbcdaeeace46edfb459269e366b9b70d2cca7bfb69fc381ac5f819ee6e95347098e5151f9536e38e7cc73aad679c0d5dace7ea3b1c1d3f4dcf2ccc5e97effb03abf21eb7236378e93aa6eca3658c166f43d2fa6e11ee5b67d9b3dce776c0a7cb1ef7ee531e3fc33e44c594b33b296d2fadbad77524c2fdeb3c7eea3e5a75b72bd1c7ca9fa6fb95361a0d037fede0ef8e85de57dcf1caf471e75740c66fcb383b496f2ace2e9a3dbfab5eaf547d8929f8dcaef2bed791c83878aa2fb8bb64a12f5577be127d9cbe60de1b0a7da9bcf7d1de25d2b316b78e0a7da9b8fb95e963f405ea347da9beff51df47d23d1ed77f21f7ca3b20bdfff595ef1266fc95f7c0ab6fb0e3a7eef39577417aff6bec5b5069fc0c7b0dc9e72a1f3f0d4f4af7a26af953f71b48c168aedea6c8e3a7ae5b6cffe5f157cb9fd2ff1a7f1fba8cbfe29e457a47cbd62df6edadb46ee977ade2ef6fef70a31d05ebc5f9a7ee3c1fe37353bed7e1e8d826964ee9acc0d2723b585a9737285c9b4507e32b70b9eb52dfd362fc7b609fc50777e7f858baac6f855b9eb7c29bb612f33be0fd5ce2ce9af21b188d5f5cdb2b7e8978ac7dc0de8d4bef7344ba0bfc7df132bf64ffe5747eef7db3aee797eca3e5f858bad7fc92696fb1b44bf34b692be17c4b2ef34bc4bc4dec5b6281e392fcb6651f4b8fe5fdf23a672ae5fdf27e1f627e9fbcd761dcfd95ed5dd136b174afdf0dc9b45dfadd99da76d1a1dc8929feeeee555c3f6e5e296d6d7c5bc677d22dfb3be9fdfc627c5b2e6f8a94b9c5e19671958fbf7b9de796eb5dd5657d57c59c3bacefa69873877ea612efdfa773a7e26ca5d1def2bcc7deef4bd837d15c87896fa931a15dc12fe13e9dd911d8bb7a696f22b63dd0eff9e4f14ad43b3cf99d3426b42bde2b496f74199fb8b5c6f0ee9bf1c9f6ee8bb12398de75eff9c4b6ab7e974df9c4610c653e693604b6ed994faabd44797f22beffc48476059f94fd088f1994f824b63dd0f106f278252a96407ec78d09ed2e7c12df96533eb16fcb0cefd2299f8cefd218fb88edddf9fe7cc1f96197cf52625b87d0b67c96020aaf542c834277d1c1fa895e6c25e29998da0d587ca35f19a395d90d58ec82e90d7ccbfe068eb10b299806e58d3926bc5fb3bc4ffb84b6255b9f189b96d984344c964257627e17bfd7639cdf7e598f896d1d42dbb21ed3f6262aa642a1bbe8c8597c00915ff27b764c7e873feb3239f6c0f1897459dee9b73ceff477f3cbf40e7fbf6ef1b852bf221e51f609f4aadff6ed03e3dbfe1d7e2dd17caaabe2353c3c8672f5ce4b6b5f89c154bdd9a76710875fc02dfd05de67baf45e4ee59d7ae656f802f8785f00665f822d8f2f01866f763f813bdcd4c4fa6a5ffb2850dadbf8f657ef97145fee13ef146ca672fc239a2f3825fe478ef1b84ee95d913adf747b92e657e032fb2ce0d6f77dec6109a3af5adf54aca6aabd836f7f85a157ad6f9a9f7a157d2a6673e3c780e19d6a8f54f81ff878ece5dacf83aee3f4b79b8af54d3eb7683164333c0e537ef7a7adeb0a0c87e6f7b065f6a9c0ac67ac3fc345c7c9fee7a7354dc7722adafb84f6651da7fa4ab8041caad0712a7d3aa643f5a32060336c7e123e812e9b0f4715b6431df7088f975ce69bec7f91da2b542c96e65fc180f1d068bb1cbe1f383b858255d2fc362ab11e9a5f86e3e3e932fa8c6cd97d46707a4dc17ca8fe1e04ec86cd9fc327d065f335a9c27ea8e31ee1f1948b5e93fd443c1fef27c2e407c28001d168bb1c3e2a18bdc66232175b8c140370e2998e055df99760edb1aa7d9bd67e5b11df401d7b0526b423623339df15673425fe38e39b7ec7a4b7afc286a8635f10b096826feade8d6d5be69b84b19ff8aec088e8edb715f115d4b1a77628f5ce418967afc48aaefc60b07668d57e466bbfad88cfa08e7d41f08329ee5aa498824a0c85dcd626b4bdbe6b91f0902d018329ddb5b0f52077ce6cb99bdfd59b9657af1d9921afd4e9bbc10c065e745587e3d46f392f97fea4e0721dbb764f1bccbcddebf3d5dd582bd78525e3dba7b69e2211db92dfaf4e6d5d7b446c4b7e43c8db96eaacdfb44dc81877ce6fa99edf6d5b320e7a6a0b36abdbb92aeac412f3e69cbe1b5850733bd7f354d42f1d9172b49dbe8b2589326eb96adc7046197740c4abf37123cab83de27bf969dc58fd2e6aa55aed431f3bee74fd2daf7ccad27da022b7e2e99bfb3595ae479635957d875b5337b4296b2add73bcbb71d3eb616774d5dbbaf6a7be8a9ade406fe3f2887d38d17be8a8a3bc8e4fefbd5c57c98de5cbdfa328944e75065b926387fbb39f65464be9ff17b2a134b0d17b6a835dfac2e541bb7c0baf73c176e48f81fde8cfa369b0e8c8d13ccbeb7efaa6829682af8354eaab3c9f583ae8dd35cab5169f6ef9cefaa0c9ef3acf1e5676596e351a2fc4bc6ae7ef663070ec6338b0c71f0b452b6a0bd83f76b77d790a5cb9c664878c47d52dd7e0326e787fbeea9326c7f3198ea73b7bfaf094f05c8ff2fc2d56a69ef198cc67e71a90e77392aa2b3735b1aee57bdd1fa6ee58c1f3553e3c4acdb8625eb3facc2c748bda05747e49350e2e633cd557bfd49766a6bdbcdc93713a763d3f190d36d9e735bb8dc703610d5de7183cc92bbd37b3ca6299d9679439c86d4f16bdcb7186eab927d7a8b87c7fae2dcfa3f7e79a18ecfaff7cb23199e622b7474f35d019f4e2dcf7737aa7679649e57cb0eac2a51fba2e5e74e5661e0e8e0ddf17cfebf279f8e119bd603e1bb70867f8e5ef0796597a0bb8f495fb4ae3ceef821e2ce3bb59edbe507acdf321d1697a449a30a1d34c6d197c5b5c1d9d4b7ef512edf65d0ef8abb15373bf97c611dcd70bb89101363f2aae2f583526bc3c2f79fd2fdfbe482ab4eeea045ce9c220f058fb4a6d21fab8b035e8cef9d84bb2bacf215ea59f97dce1a5f1c4f03e07fb0d6f268e375c5f72d5980658ddbfe449bfd2e5fbbceb2c3a8debab7ba81817ae56dec76236ce73eb5ed7fd71d5a994e5ff25e87569aee4459ee3f7645bdd8fe14c83b49fa4eb0c45c78f457ceb1792e97be41a8f2ac59fa4dcfe6d6e8fd7c8d64a7ca0d08bd0d655bd07d358bd23631c626de6e2bbdd220a9379567bbec4c39946f2b6bcfc3db6ee5bd18fa7c0d855caf79c2b7e1247ed7f9c6de29b3debf2dde0ae8ee553995f8d512ef779334ae34cd723a9c6e5d5771daa7c77af59edf8534dfdeaf1e473e163f96a0dfda733ad4af950e7eb2423653e83ef4e74c4decfaec63543ef9e12fa6e39dff38dac58e7ffb5239fe79828afb38e548f2bbdf33e96739bdfca8bac4f9bbc6d9e6fdc55a7c11c5bc7af2cd7529b24b333e473bd6c4adfd7ebb7541b0db72ee6766b43d1df4339d7a467f4d6281f0b6e3ff2ca3167cf4f2b6464b9d9e9715b9b763906eae0d8e3f5694c94ba0d699b98d48618bb7428fb8a5cf142782ff3ca712d57fc50fc2336ed726c49796c64df824dbbfcc65f6e43c28d0e57efe3576323bf6b7bd7f51bca744879d90e57befb57740878583a37015e6728e32ac7a997c745f29b3c5cf9665f8d8bf02e96ce8b4ed065d29b52d666496843c031d336a5f7eceb36649995fd47afdb10de0136ed2b3cfe5a9fb158fae1b59c27be90d9759c6bb9afb82217ede649c3ee0ff14d6ceaf5df516364bde7abbc47175d20e6a32df1518ea3a5c49c1d5ef1fb5439ee9248832927ede6e92a276d89f7223e93890762ff1aa17f525eda921cfbd57571b2f103faf81342ff8cb969bde725760f27c582927920eff757f98eeef5fd2adf115ee709be8399fc31ebb5903fa9efca1cb5a9fe439afedfe621c2eb0e2de6f2f08a3fd7c8796ab1fa4f3b0353fdc1ec81312eae93c6034dffb1fd97624e2b7587d6ff559ea3fbf1f7ab759ff0d694ea3deeac2ff49ed07775cedac34dceda7bddf1af73ade1759e623f3c5fe738c2c8fd36c71176fc74b97771e7fa79cd26d5e327c42f663a83effba2f76a95ce13fb6e11ec246cfe5afcb849b641366e6cdf977cb0cf55e326f67d9dc3f67edc0cfa423da3b07d63f3d862c74d3d5f697bbb523d6e925df5a491fabe8c7b53356e62df2d82dd8acd678b1f37c9be4dc78deffb3cee61a59e10fbbece698b394b01a16f9638c0745fc1dae63121af2dfe3c22c50a66760076cfbdc4cf31ece7447bffe93ab72d6e5f21f5cf107398d903f4b394616f21c6e965b600eebe5a8a5d23f55f1d4378b203a83618692db1c4dd657a83bb37c5843cb778bd21de315faf6b7ddcebcdf99e45b61fb1fe50e9b8b177b7b2fd98c7b291f4051bebb6799208f7b1b3dd8e6b1313dad0ef69c6552e23fc9ac1b63b10da5dd72fb9e7ab4bbd8f506cd198d0b69cdf87981b25e313678f5fdf7328ed0f84f60c398d32be59ef91b73a66627135c6fb9f4fa0cb94d728e399baa7d0c63dc2df0b8bfb3fe54e6912dab2dd17af721b11efe4e4f66e07df1e5f6fe59e6f1c065695df28e519dfaeb45790f9c5eecde5bd97c22bd546228f77c471bfc5f08a6d5bbed391efbd57717302f76697ebde8ce11bbb1e2f7c13e95ec5cc11eee2b4fd0b7fbe5fd5b4a1f14cbd7bd0da5ec5cbdd9ffdc47bec55be232cbf445bcc27dcdb4bfb16b1ed96d0b62ae7d1e126e711fdde7dcf2ba12dd37dd927bc03b0e53dca78c6b7bfcc2f65ec0bc27bc27d9d19cc3e8d3f5f2a731f65e771e5d944bca76fd93100ccb944b5c38936704cbabf33dccf7d52dbd2fc126dfb9bd838cc394ca42b31e302f7fa1c57e8336d7fc6b765c88394d91cb877a762fdd2f418fbfe745f1707a3c7f8fb47652ea44c8ff16d59308a2d3b4681d1e384665312318098842f30e0073ea96d498f49befd879b58388c1e13e94aecb805c6d6c06312d5b574327ef17499f010e3c0818760ec0cdc9b658ca9e373bf76093809034e619824ba651c8248dbed1068173804edfcc5631855f991329d9e54d956c4fdd5f149d809436da16c0de36957e648caf49a1557c1e875c59e457e737ef2497499f01ae3c081d7dceb350133a1e60d4af925b4aba8c193ce2f01ffb9ca018b6feb76486de9b9833ce3b87294e99bab1cd76866ee16cffd95bb8107273df736a387a15ff8f3ccedd661311bed6eda5c787594c7adab9a0fe6f33876ec56e219bd18d92d093b5ea317207bf5e11ae3d0f365c98b7a7b4f7d5a79d174bfd0df1e06b6fcf1eacb37df5df80b5ce5f8e1056fcb9bf162f5deb16138371eb7c898c683d9cdd87c79e7c5f2bbbb7992333feb9f4d2f78dbb933f04b65f91368f8e97fcf93b787c16c113ad1ee7dd16197a199b40f78acee5a179d28fdefed43169faebe55cbaa030e008fe75dcf89dd4ffffb6fdeb91719f74fedff86afc2e7147eb8c6633cf4db01d0b1feb7b7f3a9ce8d504296e07c2a284218ffdabbbd4559bd2f8c09c77cc208450ec31a5bbccd67e3905bde02e3fea9fd33cc278a50c4309f1b2feae5fee1a273eaa828ea450ec63ff876fc48edafcefeff3c730b15b442116058af8bd8999d6239f8e7409c8f5f428765ce8345e4d828609f77b3c6bc4fd728e847909d8f9873de436438310a3cf6790f44e65d9c8f5f428765deed71042247659f775063de2731b25611cbf993f391f0ce3b88261a0a58cee77cde13917917e7e397d0619977ab1f0e0d87e5dccee77d5463dea18ff469c8c187c439ef81138d6464b1cf3bd445e65d9c8f5f428761de1da5bf86f68463de2735e6bd7b70ec69c0c187cc39ef2b60f44227e19877a1f35d9c8f5f428765deada715b201c7bc3be2f39e78c7a1de6e4106bb34e743e19b77a0026b79185a0c76fb79de45cef71a7cfc123a0cf30e93ae06f4b5c23eef5e8d791fafa0355186ecfaab72cebbe458131906583f21fcbc8b9cef35f8f8257458e6dde8cad072f0381e76de9735e6bdbf06892771f0a171ce7b8c74a0429d7dde8722e77b0d3e7e091d162c261a25301971ccfbbac6bcf7562068c71cf7d116e7bc1f9df49ec9717f1f8a9cef35f8f8257458e6ddeec640efb3dcdf63648f4e3847f0568ad10df7def3541accd8f021683bb8bc06545aaefdf8f0d3fa17c2cda6112e1fc6ed5c78d1747dc1b96c39f9f9b2bafdbb26c65f07af4121d2176b16fce18a16e3fb8a60ff7c6f1c1d18217ba4229d01139af543d411c56ac464f513c67fc61cf8dfd6325a66ec0498dc6c745aec98bb40fffc6b6fea83c8c4e508c3afbd24dfa7b8f54a40564c383cdff8334c43784f071ab096b85c2e545aec7bba40fffc6b2f18ea5d0d970308bff646827bba88ac98f674cef1d7c1661c19061385fdfc9870eee942fd73af3d68657458d79e202e2326ab9f307ea5c69e1e420b8640e1a6c58cbd8bf4cfbdf6ac49822c88cbb7835f7b81d09e2e262b26dc9d73fc89f89eee249304e84c98df152dd63d5da87feeb5076490b413164cf4b4f63ca13d5d4c562c7b3aeff8ebe030a10f22c074bfbca2c5baa78bf5cfb9f640cb09fa01d39dffb4f60431183159fd84f1b7c4f774a042bdbf021126cf249d1633ce2ed23fefda83c92876acfe8a75ed0d2d913d5d50564c183bf3f83fbca8b747caa436fee2d84c6fb777f4783018211a22384cd4637ae376d5feca8d16611d2c464c6e8c780c371f353119bb1fb060c877f4787019311a0277e8a9ef242cf8fe63ecd8ad0d12f6a51197db4fe2a3164ee344a3646830f808dfd363c66a4469f0afcffe1a24ab35d7fa14c46c84e5c684dbf0f3510bbb09d00ae913168cf98e1ef379204a83ffaead38f64866b96b17eb5310c711961bcb7920c2471d3c67ba1eeaeb84c587e08e1ef379204c83fbfe8df4650b242cfe1d97f52988ed88cbed27f15107e359231df980c9fff18e1eeb79204c837b7d1a23c5094c2e7b4d10ef11971bcb7920c2472ddca72dc3c064c1a7efe8b1633f8234f8f19f03b49c9805132fd6a72806242a37261c48808f5a58500075538c1e3b1e244a831f134a46310818eeeec5fa14c58584e5f693f8a8850f39019080cd8063dfd363c688446970e3448a9940bdc7829d5fd6a72856242c3716bc889d8f16b28f596efbba78118a98708f3b7a3c7891100d21bc0831e12caff6749bd51ca8811789c98d152fe2e5a3265e14419f2556f08e1e0f5e244643002f4281c314b71986ae71fc58c435f02241b9fd243eeae145b67318ea6b117aec7891200dfef5d98b80be6c71ad4f51bc48546e4c78113f1f35f1a210e853aef347002f12a321801721ab2de36ae690d7a7305e24283736bc889f8f5a7851e0246bc9e1dad7b8f122511adc7891a3801634587cf02eeb53142f1296db4fe2a3165e04833004118b9fcb1d3d66bc489406f7fad49d5616d7cab33e05f12261b9b19c07227cd4c18b024f1d5a3d969c0f77f498f122511adcebd35461b23ef2d96b827891b0dc58ce03113eeae0456b15dadd230b0e7e478f192f12a6c18d17c164ba022cf1a1c5fa14c48bc4e5f693f8a8e94f348a014b1cfd3d3d0e9f22311a027e451208ba5cf77771df2241b9b1f917b1f2b1f1a269e8fa97fc3242774460a008052c6fe38b18d9d37d095f61f50d3f6471516cf18f21b235d1bc3c627404ee87a232fb397dd7c189808202b46679b7bec82ce07d3b42118c462cefee677d8e45d6bf281d81372a419931bd1bf1f75dc3161cdabd1046264b7ebeb3cc38f121d072ec89e270e933107b3312a3c3ad67c23263d8fb05fa966a9cffcad01825438be57dea2cb311a70de86848072d96f7b5b33e0be142a274f8cf7e6199fd9cbe6b6042c94873225365cc4b709219e73b11d457be13788cf909327d96856c3f413afc7a262a3386bd5fa4ef407cef07c92abd6bb0e0d06799f1e6ed4986467a5762c0d12ffaec88ecfda274b8f54c58662c391df8fb566becfd310a9632b479f61a8f73efef47437d223b3c7bb250fe1e413afc7bbfb0cc7e4edf75f01f538581270d396436e47c130281a7c064c4a3cf9ac8de2f4a875fcf4465c6b2f70bf45dc3470404a60c75c8e2b37e96192fee138360220185c71e5f8becfda27404f44c50662c7b3f7bdf12b27bdb7a79982799370acb7eb930a62bb7c39d87398499b70b4becdd3874ec8320de23cec72fa1c3141f9ef905b1c412e6f35e07eb3163144c430e3e78f330fb207264c472869de75d280fb3381fbf840e4b7ecea01f417bca31ef35f23027cb23b2d09ae56cc9f9e0c57834604f14c4e42f93cfbb909d27cec72fa1c382efe8bd10464cb64a3eef35f23027e308e9bd80054bccf9e0ccc30c24148d8e4ec2f22e7e9a77316c479c8f5f4287293f275a031bb060d3f9bcd7c17580e604fd154bae839c0fce3ccce97ddb8c1d9b25e7413eef42791ac5f9f82574d8eef43e887a2c791af379af9387191c8796d7e2d05f6e3c07586b756871ac77a13ccce27cfc123a4cf3de6dc164cdb1deebe461ee0750e9aa2c3e6a391fbc7998632759aca0c2e2a796cfbb188e23ccc72fa1c3948f17a88eb5f0d9e7bd1686a3417ba2b0bc79e47c70e7611eead310b2c42fe5f32e9687599c8f5f4287e9febe9491e5b0c412e6f35e270fb3994063220376fde5c76e9271082df6f52e9687599c8f5f428769de8184ac094bce4edf7d9e86f5709ba98f821553fd2f273aca88bf7e56e8448ec6541fcdee6f17b6680e20713e7e091da63a4afd686830d54dcbe7bd0e6ed30f9105239677c19c0f6edc06d9930431d559c8e75d08b711e7e397d061c16da2690814a63a0bf9bcd7c16dd607471933f9b3e57c70fbe60003aed97ccdf27917b2ebc4f9f8257458701beb294086c356c7349bf73ab88d9338010c113b1fbcb88d0214334136fbbc0bfae408f3f14be8b0e03601f48132657997c9e7bd963f4e3cb4c290c53ecdf9e0c56d2410ac5b88c9bf289f7721bb4e9c8f5f428765de75b335b4272cf67c3eef35711bbd1fb0c402e67c08e036cbd861c96f7c9e7751dc46908f5f42870db7d140c494af399ff75ab88d0f23e833d6674ef9e0c76d0214384c798ef27917c36d84f9f8257498701b537114a69c4df9bcd7aa9f1540cbd458eea3391fdcb88d934ce2a1ce707fcfe75db07e96301fbf840e1b5e278360c9717faf83dbf45750074c7505723eb8711bc71a254cf505cef32e74be8bf3f14be830cd7b570209539da677371abf23e547bd9c3c763f826c78c11d3de61c0ca2348470953113ae32b75bebb9bdf810cec9232c3796581b113e6ac55a25c8e8452c76cb1d3de6583b611abc31700150c62bc412136f877b64841b5738278fb8dc7e121f7572f2ac80d267f38dbba7c75e2759900677cc9d057d46ffb5627d8ae5e411971b53bd647e3eeae4e4818ad37214bef38737278f300dee737a110d6dc8549bb0589f623979c4e5c6e2832dc2471dbcc751a0c2e65f78478f3dfe4e9406775c1c0c162193ef67b13e05711f71b9fd243e6ae4e401b263392a62aa757c478ff55e204c837b7dda5d052a4cf5888bf5299493a786dc58f03f113e6ac4614303c8c0ee73d9b9bc39798469f0fb5106d0ea31e5502cd6a7584e1e71b9b19c07227cd4c1854609d4bb2d167ceb8e1ef379204c83777d4a20191d872c39528af529880f89cbed27f15127274fe2244081110b7677478ff93d40940677cc9402121820167fb8cbfa14ccc9232e3716bc889d8fc362d63fb846dd9a5fbd08da4cb8c71d3df61cce8234f873eaae503065c259bce769ec19c71a35bf44e5c694c359808f3a78d1a485f455c4f2ae7d478f192f12a6c19db373688f341431f81ccc9e646ff3b415f7ff1197db4fe2a30e5eb486513f608ba5bca3c79eb35390067fce4ee43b0a13ce52ac4f31bc485c6e4c393bf9f9a883173981d7826cfe4777f43872768ad110c8d939b4d8fcb48af529861789cb8d2d67273f1f75f0a230824a8f09f7b8a3c75ee345940677be2e142c566cb1c297f529881789cbed27f151072fd2803e5d219d2506eb8e1e7b3caf200dee5c6a7a5b766ca69c7dc5fa14c38bc4e5c614d72bc0471dbc283225180116ffb43b7acc7891280deef5897ca8a380c58faf589f827891b0dc58f022113eeae045131928a0c5b7aff1e245c234b8f15c68c160c8b78f0ae245e272fb497cd4c18b24a883042a42f498fd864569f0e7f11b25205970d9d5827891b8dc98fc87d9f998dbadbfebc585a10805d30832c4af7b46b89fc7dc7161118a1c19051e8bacdedd682b5eeb4b908f5f4287a9b60f8aa0eda8ecf35eb3c697be0859fc22733e78e3c222648f54c414079acfbb505c98381fbf840ed3bc4fd750618a03cde7bd465c98827c27404cfeef391f0235e04d85295ee03cef42b53bc4f9f82574d86abffb902d5e209ff71a71618aa30cf5850fd8f72dceb83018c160a93a014b5e97d3bc8bd6f012e5e397d06199f7c0d1a06226ecf35eafd63b0cc62b969a23391f9c71613044ba23394c7547f27917f31b16e6e397d0618afb1e29d0e82aecf35e232e4c19c940713496bc44391ffcb5ba2c180d0d8ef52e74be8bf3f14be830e1fd9304052b963c4ef9bcd7880b534c0d24a6c6e2cf90f3c1191706fda10ee2a1ce52bf269f77b1da5cc27cfc123a4c791a970908962c7161f9bcd7abc9050247617ab73cf1c11b17d68289a940b6b7cb6cdec5e2c2c4f9f8257498e2fdbd18068825ee3b9ff73a7161de0104239925df64ce07770d2ea09b12b458f273e6f32e141726cec72fa1c3547bab1d437d82cbcf19b90a943d199333e8b9bf7237f0e0ccfaa1bb193da0e83158cc80189ef73c8e1dbb9578462f46760bf76e29320e363df909b4f9f0bf27c98b7a7b4f7d5a79d174bfc0aceb53bf109787ea7aec11fa70d469c28d0bfe0cf957ea5d0dbeed7efadf7f97f915ca079feef31cbc3a51fadf5b7e1deb8003c0d91df9f8b171b7462f9827f958126e9cb72e3d7e9fc7bab264f147e4a4b15056ef0b63526f6f3ae1c03cb254e7462821eb4a968c7e6908eb67475eff8bb7f96c1c5ed68110265c839e400dc1bab2fc0934365ed46b9dde224a73268655a928ea45b8bb18792f5bc4ce6c7d7a3b28cb95b906fd22726c84bba311d70552fbabd73cef9bd899d51c5d017cab211933d5ab17a7155ccfa758fcfc380211f65da54ac6f1ad8c993131c389b16f5e95ebc72caf1f6e3fd986e80ac4da372463261c4d985672339f22e75808a289867079712a650c6e65ccea671d236b15e1308acaf59394d60fbf2f743374f9cfb7c664fc73698d1a38eba08ff4692822e3ecfd44e0ac7394fe1ada132e1b305f3f529db3ae29bafc7adc948c59ceba1ab4acfa679d633dad900d44642c0b9e752b60f4422711593f933a675d537405f256342463a6dc12c2b494fa671d5081b53c0c716f7b953276c4cebac43b0ef5760bf7fe56b97e821a675d5374f97dc91a93f1cfa5e5d53feb92f10a5a1305975bb952c6b7f60473dc7a57861636e772d5fa51eb9c754dd115889f6f48c64c31eec2b4867afdb30e44a30426d83c0b5532d6c4ce3a10231da8109737a272fd2c6b9c758dd1e5c7239b9231535e3d615aad06cebaa393de81238c3f43a58cd782675d6f0582762c828b0cad3a675d4374f9cfbac664fc9368c5c81edd61886231f8992f2f2ea687a8cb5e345ddfe1a75c98f7149b7f8a8c43f743d4a9895f36c7e7a7d065f75fc7f8b356e95113d865e61b3ce2c2ba4e7cdee179ac7a14227db1c6f9b757e8515c4f8f1ae3f353e832fbc3eb5cd8ec498f9246f4c8079189f331afe05314b344a113f47d9cbf7c851e2535f5a8293e3f852e9b1e99b11360e22a2af4e8162f14d323a0016b898b81ace07324aa47c150ef6ab8b8c80a3daa855536c8e7a7d0658ad34896c7a18e8961abd2a326eca360a2404be4fcbec3ee18f5c8916146935b8fe47aefbb8df1f9297499e23eac4cb6fc7ad4847d644d12646163682af814c42795890c949426b71e29b5f4a8393e3f852ecb3b1cb46008707163557ad4807de4249304e87c38fb894f4f508f800c9276c2f54e74d2a35ab864837c7e0a5d063d0a606a53f1bd0b657ad40426394c6db3a4cfe5cf73e2f30ea763cdb9e38308f06139273dd2eae851837c7e0a5d96780727e80722f8d12d462694a32519c58ed5c7e500a8e053148bf4241075635c5e8b0a3d6ad57a27698ecf4fa1cbf2e606f5fe0ae072c991f5e8c38b7a7ba44c1ac3221ddbe1da7b5db5bf72a345580b8f8c7a9cbe438fb163b736a8ae4f65b3fc7e1a6d366c12ad387d970add6a069f0c9d0870bdd914fcd6c028ed7ec0f7ee78d1adba386593fc7e1a6d369c69ea3b09d79b63a15b8d6096fd354856b8383a067e85714b1fe940c3c50532e8564decb2517e3f8d365b8e9051323430b1a40cbad5088e19a015d2275cf848c1af2896e9288e3d92b930a842b7eae199cdf2fb69b499f0a8a8b78616178e50e85613f6963dd28616c0e57960e05714df9cae87fa3ae1f35fbbe8563d8cb3597e3f8d365bae93650b245cfe73856e35616f1923c509b0b56619f815c53cc76b1899b1a02d5f0ff76c96df4fa3cd924f05e9c80782b67c3318685b8681c9f5f654f02b8c831ea0e5c45cef7b856ed5c4421be5f7d368b360a2409f282010c3209ac145810484f915c64603a89ba2ba55131f6d94df4fa3cd849326a35858b79ac04a1533817a8feb8daae057142f5df820191db9de010bddaa879936cbefa7d166c14e9d0048c0e6b2e55bc83e6e5dd56b0c3b45111f9ef76a4fb7aef1a8d6c34e111f763a0b43d7387e2ce2fad86983fc7e1a6d46ec34e2c44e0bdd6a063b8d1c055b7f9081df1ad86904b175091974ab2e76da24bf9f469b0d3b4581c3973fa3d0ad46b0d35e04f425572eaa825f61ec748582b686cbadc5a05b35b1d346f9fd34da4cd8a9ed1c863a261f1b836e35849d86409f72d90005bfe2d829b2da329fdfd545b7ea62a74df2fb69b4d9b0d3c9010ada5bcd60a730185a635cad4c067e85b1d3c049d692237626d6c44e1be5f7d368b360a78e025ad0e0f25b2f74ab097b4b775ac8ea0bae2551ec74a40ced492cb86fd5c34e9be5f7d368b360a73008431009ee5b4d60a781a70ead9ea07d298a9d9a2a4cd647415bbe1e76da2cbf9f469b41b77c98782d608bd9f28d60a796273b16366f3c03bfa2d8e95a8576f7c8f59e58e8563decb4597e3f8d360b760a93e90af0e5e32874ab193f5309045d41cc45dcd714eae65110dfaaeb6fda24bf9f469bcdef7414038b373fd23474fdbbdc9242f95e8181221470f9f890e833d7a0698a26ff3ded294211b65630716e91da0f91addde72ce5cc05db989c996ad7d4e0b3011bde495004236cad546efaccf9f29aa2c99db70e2828406b3e7fb1458cece9be2e5eda9c9c5972e6d5e1133490972a5c210bf9b85adcdcf4d9dfa69ba2c95d3bdbb1278a23b65fdce64fe5ada5dd989c7f329fb7f92645f209294363940c2dae7771127dd6fc548dd1e4b66d82d51a246b2e9f83f37e7197c7944f9f9b93334b8d8f3a7c3661afeb2bdf093cde1c5958facc39f49aa2c9bd5f8c34273255defc72a7fda21e36da9c9c59f2e8d5e1d369e0fc035a76e7153a7f45f37c36469377bf488646f70803aef7b3f37e719b5395539f9b93f34fe6f33607a5c8f917a36029435c2d737efaecf9191ba2c99f27d1d1a035e58a27bdec17b572a5372867a61c8de27c3692f333f014988cb8deda49f459cfbfc66872ef17a60a034f12db2f6ae6fd6c4cce2ce75f1d3ed70d9c7f660cad51c217f347a0cf7cfe3546937bbf00c144028ad0bde836cf2aaf3e3726e79fc4a784ecdeb6991a4693cc8b932f9fc23874ec83780d232bf368e4da9b17c674e5d6cd01da105d81fa240dc99805a3aa41ab09dc33e847d0e63b7773198bd630f241e4c888cfaec8d74fad1a464dd11578576e48c64cb58185693550c30868c09e2888cf873097b128deb93c220badf97c43f3f553a786515374f9edf2c664fc73693550c328194748ef057cb9154f3216ad61040db40636e07a9fc8d74f3d9cb321ba02751d1a9231535d07715a0d609c2059f920ea71e5f8ca652c58c3082443c38c1d9b2bd757be7eead4306a8c2efffdae2919b36006e2b49aa86194006bad0ef9f241e43216c6368f43cb6b09ad9f5a358c1aa2cb7fd63526e39f4bab891a46fd002a5d95cf573c97b1600d231001d5b1165c7edaf9faa989693643975f8f9b9231cb59274eab193c732923cbe17a4fcc652c5cc368a84f43c817af9daf9f7a358c1aa22b806536246396b34e9c5613358c62908c436871c558e43216c63113684c64be3a0ff9faa9f536dc105d81f796a664fc9368f9eef3f43e8fa5108639f551b0e2ab6d6ff7b70bfb3e6f29338669f7a3a181b87cfb9de828d7ce19da105d7e0cb32919b36098356835816146d310287c351173190b6398c89e2428e0ca6199af9f7a18664374f931cca664cc82618ad36a02c36c0103ae39fd9e73198b6298eb83a38c233effc57cfdd4c2301ba2cb7fd63526e39f4bab090cd3499c00867c6f9327190b639801f48132e5cab99aaf9f9abe9acdd0e5d7e3a664cc72afab41ab113f4db335b4275cf7805cc6a218a60482750bf1f9c1e6eba71686d9145d011fcd8664cce2f72b4eab210c73193b7cb5717219d7c030f57ec097df2c5f3f7531cc26e80a6198cdc8f8e7d26a04c3f461047dbe7caeb98c85314c537114be5caaf9faa9896136435700c36c48c64c18a630ad66eab00319044baeda97b98c85314c2799c4435d0417a98761364457048b6f46c64c3ebec2b41ac1301d6b9470d613cc652c8a61f65750077cb521cfeba71686d9105d010cb32919ff245aef6e347e47ca8f667278dafd0872627b73bbb59edb8b0ff11c9e531f05634eac2ddc2323dcb875737836cbefa7d166ca29153912e2cbb358e85623f1e870edd8882bcf51c1af680e4f9020a317f1d9c817ddaa95c3b3617e3f8d36836e054019af105f4ea942b79ac82965419fd7afbae057348767377178fd9c0bddaa95c3b3617e3f8d36836ead80d28f105f4dc08b6e3591c3132a4ecb51f8ea4317fc0ae6f04c16d1d086119fbd73d1ad7a9867b3fc7e1a6d96bc3f28080324686f35827d068e8a387ddb0b7e057378268e02956928b86fd5cae1d930bf9f469b25d7020c1621676c46a15b0dd85bd0ee2a501971bd3916fc8a62a1d3150c166bbef8fc8b6ed5cae1d930bf9f469b41b764c77254a473bd9917bad580bd050d20039b2f477cc1af600ecf641c40abc7978fbfd0ad5ad868c3fc7e1a6d96372a27991c39f3f15f74ab098c14ea4002d69a0b872ef815cde1394aa0de6df1e1c217ddaa95c3b3617e3f8d36d3fb67323a0ef9f22c16bad544bc8c021218202e3fed825f51cc14062071142ebfe942b76ae5f06c98df4fa3cdf24ee824408111d77bc36131eb1f5ca3a9daf1bd08da7c789ef73c8d3de358a776fc0a05533eec74f6247b9ba76dfddaf18df2fb69b459f2863ab6237362a7856e35918fdf0ad7430371f92115fc8a62a79316d25711975f50a15bf5ea1f35cbefa7d166c1e587f6484311972f5fa15b4dd43fd291ef287c785ec1af28763a529c0872e688b8e856bdfa47cdf2fb69b419746b0da37ec0899d5e74ab09ecd409bc1634f8722017fc8ad63f32d5a1c5e9035ce8562decb4617e3f8d368bdf3e4cd612a70f72a15b0dd85b43db511d4e3cafe057143b0d23a8f4f8b0d342b76a61a70df3fb69b459fcdb51b0587162a7856e35606f0df5b6ecd87c39bd0b7e45b1d3e501da3dbedcde856ed5c24e1be6f7d36833e57fd1a72ba473bdf914bad504761a99128c00977f74c1af28768a7ca8a380abbe77a15bf5b0d366f9fd34da2c6f3e4e30529c446cdf6a043bb52689938482fbb428763a9181025a8267623decb4597e3f8d36cb9b0fb46030143c131ba97fa48f12902c04f915c54e471248802aa85bf5ea1f35cbefa7d166c1e5a10e12a8f0e9d6dc6efddd4cec3c8a50308d60c235c7ef6eb4158f9d8f50046d47e592af11eee70dd48c6f822e7fec7c533266899daf41ab995af16ba84cb87cf273198bc6ce47c81ea9882ff744be7e6ac5ce374557a4467c333266a9a3254eab81d8791839595c14df7de12463e1daf0be1320beb8bef3faa9133bdf145d81ba6c4dc9f8e7d26a20765e7194a1bef081c0de281a3b8f0247838a9908ac9f9ab5e09ba1cbafc74dc998e5acab41ab91789d91020dbe1aacb98c0563e76188744772f8eab0e6eba74eec7c637405e2741a9231cb59274eab81d879b886168c8606578ed55cc6c235df65a0389a23b27e6ad58b6c882eff59d7988c7f2ead0662e715530389a9f1d52ece652c183bef04cb04044b2e7fb67cfdd4acf1de0c5d7e3d6e4ac62c679d38ad866abbc730e0f4cb38c9583476be051353819cbe19a7f5532b76be29ba2235dd9b9131c35957835613b1f32ad04d095a5c750072190bd7723f806024f3d557c8d74fadd8f986e8f2c7ce3726e35ab4364f1f73bb256575b766287437e36460b73edc6872e9c7331edfdd4d463f5c44d3fde2193c9846f60d637f3d7961fca8e82ffb06d31f949cd9f8dd892f73777055f8be30c20f37785b7aea78b530426c4ef8f96c15a20ebe1d8a420dd9b83da4e77bcae376311b879775f9fcf4e129a134b71ff743ff69e56ce087fb3c95705880174db7e97817d7f24be6b3f1bbab680fe6733f748d9e349f61e38f939497b9bac6b7ed146bd953c7ef8eb23bd555bba301a5b90dff7ec58c6f6eb712341bed402117c955db0fa6de3eff773cb7176f0bfd6d793acbd27da9ffb1781e7fb856f9cf8e21da8c2eedae6518ee3d652addcefbc2783ca4f25bccc61f977147e166fe3cba9a7fb3f3e4bbea587215e9f6cfb7c846d2551fd7f27b7737305c74e4d6c06adfd06d1fce349de8515a188fbbcb9fe1fbd0ca7d5cc659c8291f0bb58f168b0c8af154f2a5ddad25bcfcaae494ce395107aed75fff1d292b89268bebb9cf75e5453a14e7daadfe5ceba46b4ca345a7fc3d45b736e30faf23677c15eb73fce1e96f4b6736955ccc9d251fef2efbfb621fb9e839a9dd795e6e65ce305f99fc6fdb657c52c7394de6b3f7f06effcdff7ce83fed5da585db37623483efafd164f7fa52f097ffd983f98c426f93ee17bd2db56d07d3d6587d7851f8e02a2dc9b10f38fd083d257c4038bae9bc1b9838bc0d7c73ecf1c7a2980bdf55973b4f59ad90f1a8a66bcad47b87d74e3bf19e974b90f6a9f4577feaedad59fcb3072febf4df4b307bd24cbd9f7e7f309ffb1b27eabe81ce6159fca31da08f3bfbcee39077a57302339671dab7928e6560a5e3aa18cf261d4fd666978d4d6c4c5b44954ff790c9c69a9ee8a8443ac73f5fd27fb7a5f47b988ead23c5e9fff6a285efbc2c0f834ebbf82768cb7fe2ceb59cf660b6da96d7f56b474ee6466fedaade43c67bf0948e2786b38c8e4ca6d37d4fff5df091b5db419abc3a347949f1ab7128af516d30cbff2e794bf93f8d499f1c4ef3d8a5c8c0a4cbc00ef76e54b6db6fe4609ccebf1b5d10d5cd8fc56cbc9ddbad902af74c96b0a49b8e32e8b48fd0025b53376398e98056c1d7f5be732b43377adce36c9eb9f1a8a059bf1873f2fee6aaa86c6f7fcefa8e561f8b681aa776552eab9d6b8ff2f19a345d29af9563b50e6763932af707dd79c7ecbf275985e579386ece63a7cef969cd1cf3b596548fb3bc8ff529e39cb08d73b6da328df357ef099879071bd6b166fba90c9e4f739fad5f3ced18beb0d19edbad3d9a99957a97dbe6d95e95e95f3a06bd4ddaa715dc5abe5b8ba5fd8389ff1afb0886f6c95ea9a24bba4b16f7e0cbb739ae415bbbd97acdce43a2ecce7a96ed4131750fc2afd90283b9ac59797d1e2f4dbea7394555b6c1f9cc2ef480ba0fe175a1c02f4a3645928ffdbf916d8119a7e6ea2ce3344fe33cad59da3e711ee7315bd7397f838c3fd2bc8fd8e6ddeab38e35dbf760bea701dafe1b60f75f8cce9dec904abd6bc21eb95f8b3b579d4aae11ee91c5c27fb6b794cf2805fa8725b026c9a0d38e4130c978c7efa918da992d524597785f8ae6f6713bb0a1ec6dfaa1bb195df61314855b37bda3a9d3d88ba6fb4537c7647158cd73ff63ae4c76976f8b3bc4f9ef1e4ce34c63ac9f30c77bfd5f183ddf3526b7f753c9b1fb5bf4226f9d59f8b1988d2818d978e544c77060a3f75763ba2edd8bf33f3fae5eed4739bd7b1271afcd387c7d1eed4e77f1620c68b64aefb1dbb9bdd80ffd1c57c0e009a7ef6078c2f588ed0374c20689f4f3bb7a092f996a8b8ebc728d7093e17da7bf27b69f6fa6c9c208a3d23df8760cffb59841696ea316918fd93876ec56e219bd381def3d3eff2479516fefa94fab6cde3198463e6edf357ac13cc6e1ceed030e0bc9dba97323944a77ea1bff4162bb8d17f55a99df6907eb8fa1a2a817e1de75afdb9b84f6d3350afa58dfc5ebf680d07e12236b1551f8cedb8f08eda18ff46958dd7e4268df3d38f634a86eefe0db27de71a8b75bb052fe1ea1fd7805ad8982cbff74dd7e4968df5f83c493aadbaf09ed7b2b10b463cafcc7c81e653ead14ff5c5c2c52be6efa213ad53027fb63ea1e65ed4fd764dd41a113f47d5c9db6f2d8a14e1a3bd080b5c4c5ba94c72e93c60e2d47c6e61ebe1a3b416f94890c944982bb3b5f8d3dc18fdd492609d0010e672e8f5dc38f1db49ca01f8008e3e770357692ce781288ba312ec6201ffb47ba17226542d51bc776c8e3b71fd3fd7693e74520e8ced477121c96989f5b6a7fe5468b90a23f3ed28186cbd770cb07518702b442fa84acff051f243d0a91be6c8104e3a775c7074997c66b189931650f2bf820ea535b8681495e0b051f649d4a4631c0d5e0bce383a4570b1f24a323654db4907ddc6677778a5ea188a257b330748d63860d92f50a05d87c5e391faff674eb66763e51af5628686bb8f7e35b3e287a15027d8ab3536ff920ea95a3801634c87655c10749af46cad04ecf67063e487a1578ead0eae1f2cedcf241d42b984c5700e7c778c70779bf82ba79a49ff1d3d0f5b39811ac4e0023b5b1c8362652fb21b2b52c1e03bfbe5004236ccc6ebebe1631b2a77ba24e25e10a59c8c7e568b8e14122c851191aa36488aba574c30324ac2da8af7c27f070391b6f7820d94a4073acae425917671e54020f310a9632c4c5f4ddf03024ac2b10780a4c46643bffc2034997cc185aa3847266e4f55849f6f64441841cd8d7ed49f6b619a3009f8bf6ba3d498f964764a135650ef2f6047b3b194748ef05b898fbebf6047b3bd581a0bfa2d89b45ed767c7b621dfaebf6247b9b5c93fbba3dc9de3635684f145c5cf8757ba2fe106b0b5fee87e71abbf8fb16b1ceea757b92fef44364c188725f286a2c62c74fae1779dd9ea43fe4da79d7ed49fa338a87561852e45fd4cd22e90fa106d8757ba2fe10eb215db727ded70268995af5fc93f4875cd7256f7f55df84b38e46be075ed573e0ab5f70c65ccaf9ebf1f76e622d805b3e48361225f7f92d1f041b899273fa8e0f923e91f337dff141b09128f96a6ff920d948e43ca1777c90f48a9c7333e7e32af723678ec1dcd6bbca75c797dbed7c2f2de7f622d8aca43c69b77c90f48a9217ea960f925e91f3f1dcf141d22b726e9b3b3e487a45cee571cb0749afc83914eef820e915391fc1998f4b5c3cfe2e458c8dbe6e4f3aef5088f44548d96f8bb8480246408af1bc6e4fc227c9f16ed7ed49f8e4740d83f18a822f16b12ef8f5408cdbb96e4f38ef28310cd7ed49e7dd5a0581a350cefbc27f197f7723fa62176fcb275d5c3cf757ee061e9c59f67e73ffb67ed6ebe76b2c9fe6d77085d9dffbb05462f777635456ef0b63421e5b0746f8bca9171f82cb3dc599adf35c03b76fe9651c61113936c2f942dff258e0fc3a854f0ade7fcb2b52fbabd73cf72485df1044130d0538bf513ccfa7985332cf8ed25f437b82c380083c4f2a7826bf11107856e83c031558cbc3101b9b49e039a1f30c8dae0c2d6cbd7c02cf4b3acf94770502cfad0a9e8f4eaa5b38ccf996e713767dacd09b749dc8f85a34d8fee2cafe486f11f8feaaf43a42b6193b012ed704b63fa9aa3f27591e873a2ee70cb63fb9aa3fe2fb05bebf0a9d8621b46008b07902b0fda915fd05303d9313867da178eba0e91ff9cd03df5f953eab50efaf003657eced1abe60e70c3a9d61c73c7d32e835e19d84dc67a56e3bd128191ab85819629f95fa0da3d4de65d09feb3715aa4e12df56c87d56e9f91ae9c8077c7354a9eb409f28d8b713729fd5fa4e7a8f21f759a9f34e002460b3acf10baecfa2f31193ce5fbfdd54e83ce10d87dc67b5cedbce61a853fd3b6ffb64d0f9c901f2c9b352e789ef3ee43e2b751e06616aa7f1f459a5f33e4cbc16b0b9e6a852e7896f45e43e19f6f9510cb03558ee6db5d3db41b7c29e740e99df049b6d757957a2dabce4f7258cfd97bdb154e83b6839f64471b0f9aa497c8f2a6c4a47433a68e1b05b12dfb0e27e43799322f15d659b2743a37b8401c33abff0ed55d9d2d1509fc8380c84c4f7b0e28e4379c722f15da5e7310826125018e67b360e1dfb507df7b4b29d1d9793f1768cc59b1775dd90dfbeeeeed9c674e556dae84003f644414c7bfa89e7aabb2734d01ad880651d16ef6454dd21bf971178aed46f60add5218b9d75e6b9e2ee0922a03ad60257b788c073d5dd93fcc646e0b95ab7937108b179ff6ed7f4a9067ea56edbfd68887f43b9c78eceef7154dd26bfcbddf2ec4447b9da46072d60c035bede009ee74add0ea00f94292eff0481e72add26bfe5117866d0ed658ccf5547e0b952b74dc5514c162ca978f7a39f05c4f73f02cf95baed58a384e91e5fbc2355d9e494daf7c43eab6c724acd73629f553639a5d635b1cf0a9b9c56e398d867854d4eab6d4becb34ad729354d897d56d8e4b45a96c43eab6c724a0d43629f553a4fa95d777b865dd76eabb8df116a9611fbacd2794aad2a629f553a4fa95144ecb3ea1e4aa94d43ecb34ae7293549887d56e93ca51605b1cf2a9da7d42020f659a5f394dcf3c43eab749e9273fcaecf521e6a8a0d43cead7d7bb6957320536c18f25bebedd956ce374cc71949b967f13c57d930941cab049eabde86c8efb3049eabb116620e4202cf15360c25d71e81e72a1b86fca64be0b9126361c87b858dc3ba8f73648fc72a624c2ff6d6ca51b6f7f1c4c5380f009bdfe6768c8bb7f96c1c92c7765a7bb478cebbd8adfb7852a618ae5b1ef3b7ba724e713c6e6e38310a706f6137bc9edfa14bb911f158403f1c1a58bf7c02cfa30a9ec9715f049ee50a9e57c0e8850ef6bd09cf7339af29f6be947435a0afa9b969ef62c4683c5362c5083c6b749e418c74a0427a5dfc2b9ecbf9edb0f725bb1b03bd8fcdbd76c3f325aeac6a9d10e2cbf0fd55ccc92937fe885a4fe2aabf2abd8ea63e884c6aaefddb58347a7fc498347c7f55f20b260ab4d8e557a5d3c89a24c882d8f8696c7f15f2a3c4b161fb1b56c86f689bb193b0eb5f953ec364143b569f5a570617f356a5d384d837729fd57a1d3a11c0e1e0e43e2b75bbbf06c98a9a6f14172747d74762bc1cb9cf2a79da236d6801967db6e8b34a9ec64871022cee43eeb352d7893176c43eabf51d4880b7cf2a9d57cc04ea3d96357e158f57a5f384b83c729fd53a1f390ad66f9adc67a5cef722a02f71ef2ac43e19749e14cb47eeb352e76130b4c638cc9ddc67953c75a795d9693c7d56e93c39fe8fd867a5ce5b9e9cc591f1f459bdcf4b20e832daa79758419a3d498e19c4d856a77744b3c2e6050a0a5045bdd39b77e38af919dabd104626b5063226be90665392e30c897c4f2aecde91e644a6ca68f79ede8d2b741d24ab15b01c96755e8e49a4dbd2a4d84422dfcb0abe4d15069e44cf437df36e5c659f07a60c75c872a617718c549d24c733de8e317fababba7bfa2072e48a5ac6d7efd0553aaea73a8e7d2b27f05c71f7a4c4401278aeb87b82646898b16353eb4e5cbfc956ea77b70593358b9d55c44b527926c74d1278aebc7b0ef56908b1fe69789eab751b48c8a2d73dbb8bb1a4ea3639d612831dc9a81a57f1913d4950c072dfcedfa1ab74db7a0a50450dfbbbb84cea3c93e333093c57e9b60482750b319d07f99b6cb56e6b2062ba8316b19c74dd26c6741278aed46d2799c4439d5a67f08ae74add8eba124898eef157f19f741b9218074aeeb3626e9c04ae1d1b9bc79edc67950d69419fe04744ecb3ca26a7c48e92fbacb2210347451535ffeffaacc210edae029511cbbdfe2ace94da2739de94d867954d0e7520016bcd623f177d56e9bc02121820963bce556c6ad5fd8e10a34aeeb312130bd743835e97e5aecfaa7ba88e7c4761d2f9ab78d68abb1829ae95dc67853c87b6a33a6c3a5ff45921cfa1de961d1bebcb4aeeb34ae719eadadff659a9f30cf5cceffaacda4318ea58dfc5cd526d1896dac2576f7555360c4b0dddeb77e86a9c313d7f59ce8cfbbaa2f8f730861a93573c57bd0db1d452bc7e93adc45a523b70c58253dfd797c3f2cc526bec8ae72a1b86a5a6d615cfd5184b3b86fa84926373556a873edccd6897d591b0de96a0a3a970d60f5d721c76e216b931235769498373cd09bdbd1fea9eec45d31d39c7427fe5299352ced7c77ddae7a9c646fb486f7bdc10c7fda21d41308d912d1163e8cb350a6ee90e02933e6eabafb91d12dfdd3d5d665242a7bdac90b92451dac7d5639f52c60ea932839b72cd896b99c367fc98e7b3f1ca35c2e0ae9d3a6eb9c6e4c13442c9b18fefb87774377a940676fef7f1dd98a557fb78aac5a2f677f89a28d383674c6347e925e8360f6deed380a2c7d8b5de968bd9d301cdfa8133c3ee895b575d845e04aff4f57c97cf7c1e14f4e145d283691c3f164a4e13230f47e91d72993c548de9ea5b4c5f6efa9ddddb0e6c967191ea844df7c898c659fee8bb5cbde3d0511e63e752cfaaf42d6e3c591fb2347fee7f2cecc55b29fff1092f33a681abf657e7da5fb7df63e4be77635976a371e845c7f7724d8c9cc735b2e18717f5ce35c14879a60b9f1bbbb546b33e3e0f3f619c576d287ddff1c3d83f831c0a1ad18f9d080fa49a21c539559edbbb334f481f2e7d9fe66eb73056b1ab4e37f3fb3315dfbf71db8e46a3f5319809f040ac79d0db2f8c305d7b2b4f29e5f22ed57e3befbdaefdf8b13056ef6ee43d98c675bb736db1bcfee287a72e09ed16b1330b1347e96db1f5ecd4a9ef19a186ecc3ceb15bd23ccba3dd4b905de4e82ed5bf3def913b577994e6a93c9272bdab8c8facbea8ab426d609ff772f9c355c76f73bbb5a1d52cf3a25e3057c23d8a6529abdd75553bf3182e9eb39a828967f482535fed5d96cfdec69f4b77fd15e7d316cdc6590d434f7d5a39ca898f81d5de03dd94096755ecd8f0ef94eec06e7d7891bc722bfac3e65979eeaf1c6597cecd6ea184ebd2de88efa3a31d4030c59fdbb77d95fcf15c632a2db25a96f0e0d8f0a40b59dd03d6bea05421fbc320e861fbf294e916d950baad3754d223d953b2b185ae310d167a791f692fcb7ab498c18c2eb28fa1a38eaed6d5f9dbacceeb6c9a64b2cb695fedd99d76e99f270a6d021fb36cef63e2233b3f74063e4efb290b1fd9fec2c247419b341fbd6da1b3eda20ef13dcd956b1cca63cb6b50577e47e2e1eebbd39942feeea656d689c7dbba80a53df35e4fcaf512e93c946b359ee7cc8befe7f67a9f2bfea1d1bed9fbb31a05a7fbc9f4bae657a9865fa99681ef64f4f1e7606abf2245db2d8cc743453f92abe27c21cfe391df9131de96f62205cdfad2dc862bb7549bc1b5c335328e385be5f48d2f9feb7a16f654348d17461838d9f9928df7a1a81749e3e9646bba6a9bded7f3f99dea1187579ec775be17e6b52be97c5ede1b4ebc60f411bd7b4ae8bb46c96e8cc2646ef7df5d23945e53bbfff4dfd833776eb794f90cbe3bd1b13cff599f73fbf8be785e3f98c62a9d93d0c5e50acbf24e4db3bd18bdc8e77b4a3196535eaafc1c99647b66765ee1cea3dbbe6259f5a25e499f4efecff93992df8b529b02979ff0f46d4e77e73df7434a3f070f7b6fb8ef6360dfd8e8b7fc3d3fc5e84526d9d1a75a1b39ddc12cfbb68ac7746cbb85da5f791899399b7ee8d8dbddf99e549c9399bf7c94e5af9e6532ba917d46ebc38b46bbbc0feadc9ce92c94c7183d17b84e66b34728f385398df16d79f52da52fc73e6e4b7cdf8ca51f23bbf777764ee06d17d98de06a51d2fbd2586e74b6b8335c7f33ddba4678f094301952f49524fb452c95e7ed1d3d8fdf08357ad581d52de94cb85e18cb930d44c470bc1d286224d4b93d96e67a668391b00f7560012c0d989069c00e8606099b892519c32f0d4b520796831913053f8a250d432326f7dfc5cd413ca0c9d532316322e142e9f7381ec6e4ef8336e67bca788211e67b44fe3ec18d7f459e631d379eee812cd3116e0e8e6043d60b88d3558b2c2388e5b9ab10c7745bdbfadc8658a7cadb79cf4b0c8ddee115bf968fb3ce8f18c3b70c3ab8b3c1db81e9e103c418be13b007c9e9fffa7879c5b3ce8f04434b81bea6c0c05160e061cf8f81d5f306094e76a64ca12571f1251f3e70fb024c2694314d70633a50c684db4b24ea985e30630ada84318d09635a92c694cebf82d3fbea39e97b8304b747806410e49a1053f8c2ac1d68d1f8c2d172944136464781045a708aa735d42bf53595cd11771e0d3b5ade125064d3c5ad2b06b94252db18be1078ec1d3ef0fb7bf55ca4f2c1b71d1d2b651b92da4e48faa692640aad65d57c68c4b681b33fc9d451096d5bc4b6c4f5dd3d10e79f41770051aeeb4ab9a2cdb4841394cf963641ae72e29c7c56b1671e7846b818ad8781559c91af7ee64b12beea78dcecd5978ea59cc20fa7fc02532d3b23f1dfc784efb1b5955e7d29217c9f10be9708df4b84ef65c2f732fefb1bace3f92dcbfb8e66b7e7effb6367f9bffff7b7dfbebdcfff7eddecbefdf1ff7dfb6bbdfcf6c7b76fbf7d83f3e835fd5ffff9cf6fdf96fe2e9cbbfff4dea2ef91bffefbedf09abc86dfddb9b77edd2cbe2fff9e6f7c6ffec7f7f976fbbadb7e3fbcbadf5f23f775f1fd7def86bef7dd8f9669d7fee6df6fe9bf17afbbb91f6ed3ffb93911493ff8eddbd64f5ebffda1498f0fbf7d8bde16afdffe5064ed77ed8726b77ecffee45f3b3ffb5c91e4c7ff25cbff4b7eb014f50f55fd436efdf387f6fb8f07556e3dfc4392ff90a46fbf7df3b7ff5af87f7ffb63f7f7fef5b76fdb3823a8bf7e7cfbe341fbfde1f1b76fe6e6eddb1f6a4b6b2992f4db3718fa9bf5b73f94dfbe818cb6fcf0e351fdeddbc45f7cfb4396d22f8ce27fcefef5aff7f942faf687f4dbb7f122ed53faeddb4b69fc4fe17a7bf59f6fde7afbed8f1fbf7d6beffc281dc9cbabf7ed0fb9f5bbfaa8a9adc7d66fdfe036fd931f6aebc7832a69da7f7efb062a3e3db3fc9fdfbe75d83f9dfdeb5ffbcd7efbbaf8f6c7ff23fd26fd26fdbfff49e778f5fa7726a05464dfbeafdea2d7eff3c5dffe7cf3fdafbfdf82576fb7a5ccfdb7dfbe99d1fbdbdfbbbfe6bbd5b73fd814e65b26ea7df89ae91d67c3b9bf394fadf0908d37f0b61068fa7df9f6cfe86d91f5307dfd7bebbf6dbefdf14dfea7ac7efbcf65e144e908ff932ead94b1f3c262e52fef84baa4fef3dbb7c57c37fff6c7661f86bf600d9f3ffae7f6a3623d973fbcac6b59fd715ed79afa506341ff7b1e6e2b57b47259d1f27945abaa2269222b3a1b396145cb0fd54bbab4f8fee72ce95c136e57f6ffd98b36e7f9672edbab557459c2dffed21f35d73e6440b5a7841f6e20f9e0453b98fe53e846f003650fa6923f9fc18f81b5d4a0b5de8117f3fb5f4be9cfbf3adddde0e5e919d9add08b7a52e6b8d5edad5da51f9add70eb3e4f93c5737fb5301e6353efb606417b0f3a87e54446cf6677aa79c663bce83c8ddce7e9663009f766473bfd5d0f85de069e1ea575670ff4f672b499bebbc668093aeba5d9917619fd67a8a03803c493b9fdb8ffebc53c0e82ae6f1ae1faaf97beeecc9e56b3a4eb9bcfab9d6bb492bf5efa6f8be7f161e8fff858a80b75b0f19241f418a3f8470cf4f661a0a6fd99cb5743deba1bf0f09a3dba6f1fcd68252d9edb0f83f8515da8de7e9180bdabf63783a4db1a5aeb8ffc3bdf7c5e3e9ac1d3e1b5632e5f2dc9cf0abb2fa53f3b2f4fcadc9eaaa3e851fbebc5cc0a8f43ab1b0f82f601a4c69465a63cc6a6ff942c9ec32db2241fd9adbc5076f6c8b9f1a247d98d460f6e3455f3c2e5453b7d7204be7600baf9bbe93fa5f3f99003938fe6e6294633187a1b149a81063bc1f1e0ccc66fe6f3c969e8af977e56787ce88304e85087a73e52f941a8b7e5f432043b070576d2fefb6f032b95314880ec48e0456bc1a017808e1483584b80eeec80b5dec3c43c0eac513c08ba2d104b12c82e70de0eeaddfdd09a68fae8adefc45232084c75d8391c06413b811d4919045d09c6e97f8fb461476a0d8249025e0e293d09760f47a08ff620592603dd910681990005ec4060eea13e89077a770f82ae32b04687410034e01f8ec3174d19eacb1dd4db69bb78a0a7631a2570e324306d17386a3696a4bd0501d8430b1c07999e7613f872388f7b0bf5d3b7a7f68e0295653cb496d99f398999e4e33c5cfe3e9652da47904c8ab656fe77b273047a76f96aa5b447c9481a66b2ed6eb34b9205b4b49f6147d360e06c6130cae678a08f0ef025bd448f2e7ff6ea9b1fa775f0b45a18cb65ee48f66846e8dd350e0fa6e4c4e3a06f8060eb9bc6e8d15c4b0948bc3d085259a4f3b856800d52dd91a0bedc81583bc2c4db826092ca4b1ee8ce7e68b595816ea67aa0c197430c9e9d38d535a8f74d602de54160cac3c9e1bedfd99b3f484efae62ac716521ef7259dfbc81ee567e3d630dd13ac5de84679c1f9cc016211bbea748ff4f73d9a79d9656b189b4bcf787c7737e3e4af17334ee76b684dd4813e492feccbcb585eceba6a2e41e264321f76b07f26a73a6b76b8652881d3e5399d276598cd090a40e770042f9a0afd4c6f55101f6290fd5daaa3ddecf27b920d4880b43ceb80e6a417dfacdde170595bf1b90fe9ae8f5467a641f7700245c274ed9ddbdfb6d95ee89efbbdd2dbee711078ca406f17dfe9eb3db4bc629c171ea00d75a795ed059bcbbc668f57ceacffec45bdf5dc9eee179da777a44b7eba87bf4cc6ff06d31f7ee6cc638fdf91dd92a676eb7df10c1ecdcd3441b3be953de04d1ef7d3190cd37d0b58923fb08034081c0d58e9bebd3efef5622a2019a5739dc0745fd5cd47d39762f8a2c5f025dd67cd473370d2ff964130924cff6973194fafec4428f9aedd93bc28d4fe3cf39780741f3d989d8bcc12535fb7a0af25c0eaa6675602930c7c68016bf49eed9fcbf50f4f1d7f789df6d25550e4295329dbd34b7bf7e9efe5b37374909e2de93f43154aaedadeb9276719e9d57a3ba4e755264f7cbf47d17e4dffc73ff4d1db8f81929e4dd395b72ee681a6ef8b597ffb67075ad3f5a23feefd38cee2f5e53c00c9281904cb64d8391c61e0ed877a3bd5236d10982de01f62d0d194a1b5de8260bd87fa5a19e823751078ade1cb211ebe68d2d072b630f1f6d0325be77d37ddcb60b0d606d6245d97e9be7b049697eb6df708e35c97adae7ad261b003497aeea56bbfbd83a9ee5a666b909da76b69f82269209dbfc0db8104ec87fa4473f5ae025e340d5acb2db09ce32058ca436399ae5d0de826ec2cc30058dd3d4c9c2de814eb76f8a2c910b78e642786567b0f03a0583a9040ac6920c9fa5607c1f200d5cb1ad9a4b2ee58ec672e489cc3203025f0724886fa680fadb592052d5b600f12473e397c81a3134bf22058a67bf26160750fa773e7200d024f052fd2319d8bf4ec02d9384fe75cc70687813ed90ff5746f1fed61e2a5e7ddb9ed5d1b57378fe91e0983c93673ea4a7a017cd1b4a19efef7e5fb1dec682a4cba977697f11ca43f3ba3500789771804eb038c539ed67b9078ea40070af4d333d2db8264290d82490bfa8704a6e741303a0e74a0c18e260f2df490eb729fb84727e00024205d9d7356aa67a3243d4b61acc530806fa98e0c0db0cb78b0d2b92ee494f3b0bbf070968b729659a6cf95329bebdd78d8cef80e0a3a0cb292400282e51e04edd6401f25993d97ea5e665f8c92616a8f04e919eb24031da4eb42863a48ed0b7510980a38ebdc72fdc351c2d8518e6185be05afbae48360a26476e88bb974d4f5a31998526e67f8e673bab75ed660aed3870fc4a6db1ae88e7be349b7a4db93540fe2ec5c4946990d34ef48a91da9017d110cac742d2f8f83743d076d19fa923a08ba1af02519c65a0b5ae6ee34afebd6c0f2b4d42e04cbb38ea5727234e8a77bd33ab505531d92a0afb58095ca7b9df679c874cc6aef8796999e7309cce62564d0b1e561dc9df646d645c762686577938c0ec8ed80d33e64c6993d98f1313a0c5fa404c4a9cd94ee49ddd3199bd92bd999ac647697eea473d71f25a6065fb46cfd033dd587656ba09bcab0a3c52030b72019657b10f40f38fa427c80740fd64dad248f00f8da1106a31d4cef18c15239d9c54006bea4c1b37d71c74b664bc620e866fb68bae7037dbdcd1e3ad3b34007c7dc363af791f1fca29b31ec6812085682e36fef61b6a775e5ecec0eb27948ef11471867e75232b4d2b5edeca1de4dedb6d6203035e84bd93a4acf2c982cf72019c5853e7af97c7415f8a21d86d73a741ca673937453b9a4e7489ce95860667797f40ccbe6263195b38dceb54eb233aaada6e7663ec7f13c7b5c5a1ea092cab0ab81d3997a80e9991a6bc7a16e6ea1e5a576527a1752d3fd74f87250871d2d81d91997f1a2650f71e99e94ded1829192deaf606aefebebe340375be0259dffc9163706215e82d47e9aa4f390ee29a98c652739cf8d749e9bed656eacf3dc1cce73b3bbcccd5906977365a40c82f53193457a7e078e3cd0417a175586fa158fa7bf4f6d083dbdcfa632993e14369297fd3b735ab47bf158edaf901186ee66b49cdba3473384f6580abbb3a4ffef6bfb72ba9fcfd2fb483f73bc9c64f6d7f42f379c2628b54df56e6aaba6fb69aa1f2dd37f6aa5362e0c8096ed55c9d2379f97f15f2fe6ee74fff414e81736edb0a32940ef5edbd0a7229391abf6777fbdf477ce6c1ccf67cbb7b38d02e276862f5c6c95042c87d65a1904e0082c73092ca09e64b53ec2cedacfe733c91c323b4f1f2842099a8d1ecda0ed97e637fbfb817d0ea683c6788d8cf1daf93db749afef73859e60faed8af67b397f30f394cd9f67f4a479a784a144e1d6d5df652f3abc997231877f5aa5bb9c9ede758174c214da07102d2f77ad696a879ceec8e95e9ede330fe99e317cd154107477e9dd1e58ebe472b78fa51326622d339b7290da6c81a70d322776200dd27564a5fb60b65f69036b7dc61c52fd962cbd7b487573989eebe91d3f486fab529cddcf2d730b323d724a7846ba273bda30ddb3b276e6096fd081b2088092e31727fb36b56b634d0699edb1de030bc8055e718f75cc75b335ccce8245506023ed129e718793ecce7f57dc4fd7296d1dea23ed64631cb4f4ac4b6de5ecbc09960af42f77f32d066fbaac5184b13dfeea3c9efe6d7857ebf87fdab719ee98d96ebfe651f4fcd1bf76afc7dd3fdf376caf2ac5d7e7a715f541d5e407bec715f5c7832acb3fc41e57b4e61e57ce63273caffc2e2b0cef2b39332cef2bc5a795ef2bc5a75fef2bfff3de578a65523cb2f8d3a7e1f820fd692cdfdaed761bbe4c56ddc9b2dd6efb8776bbdd093a6d27fdf7bbbe9ec1f483a7a83f184ba3f663bb23b5dbcfc7f778afa57fde993d99f60cb4db1d2d68b7db7bf3d0d3dead47256ba38efbd664f2ac1db6836eb0847eb8e91cb3bf08a5f174258d94c79d9b1a2ded27dd8ba6ab85315d9bcf8bf7d4c81e4b219cf45e9ff495d76e77daed97c9f869fabc7c6af7fed1797b969eda5fbfafdfd7efebf7f5fbfa7dfdfe07ff3ab035dc46edbfb48ed5d6bf7f8fa5f6f7ef3f9cef876ec76877dbdf8febf4a397f4fffd9e9a26c3f4ff3d6fbf7fffd16e2dcdcef71f7ffde3fba8f38f45a73b513e9b95afdfd7efffea5ff7b307f0f5fbfa7dfdbe7e5fbfafdfd78fe767fe1efcae3bedefff7e4c6def276b949ae6a3063ad6a3f4ffbffff5fbefdf4d59ee3f05ffd540a75fbfafdfd78ff5d7c432fefa7dfdbe7e5fbfafdfd7efebf7737fa92deeabdfbf7f3c64809ab5fcb9e41e97cfdfbfb7d6edf661def556d25fbfff647a5fbfafdfd7affde5c3f2f5fbfa7dfdbe7e5fbfafdf7fd79fb9f11f8e6fddef07e3cff43fed0c4b332b9b4d5e83971fa3f4cba7feb8db9b3439a4ef8f3fdadfffdd1a7bb13993beb0bdafdfd7ef27febe7c5abe7e5fbfafdfd7efebf7f5fbeff7eb40f51f92d66e0fbeffbd6cb7db4febcf1dce5f4ebbddee2dbde30febbf0e9f3b94afdfd7efffd8dfd7bdf7ebf7f5fbfa7dfdbe7e5fbfff56bfe075b075dafaf7763f7324f9ffd97bd3e6c47925f0f7bbcc5bcfff340483eda7eabe6859029bb0c81012925bb74e85c506929085c5c0adf3ddff659199c93393496c568be857759e934cc0969756b7d49b73ecf1fc844f6cc432e936cce642d90f0ac5aeb18f3d008542a1502814afb8c6c068eb08a1ed47bf9294ed4b135f47789e71bd3eb939f658148ad3e3f378358542a140a48b0bed728a8801b27be65daa8d328562bfd875bd9fbd8290429072712bf93ac11b9520aa50ec9c94cbbe42a1502814a7ce48a32fd5b94eb02b9472f9d8e3894745835171e1363aca92502876838a695128140a85e258b8c6c86c5434f44a227a6527a5c90f0404ee3af046a150ec0815d3a25028140ac5b18039987d51772573eca16c00698c667cc154388b42b11b8e294b6ce5e4c1153bf6cd56835c1e71280a8542a1501c1537776f343204c07a39f65036a702e02f54bf14856237a898168542a150288e01cf69be8864917bf31932d5630f41a13811544c8b42a150281407c55df58d85891016e536c8239b3c64a42e517cbc42916aa49f11140a8542a1900c8f5b17629f5cfa7d6682dcbcfadb1f079d95c73d441749b9c18aadc30e4da1909fb67e1ef89eda435728140a85e2e0b0943517da82a2da435728768392258542a150280e845d3eab4f6a04ef88217ebf3ef680768206a1cd9c60c4e6ecd84351286446e5812a140a854271305a2eb6c48658e9d823d92125326f178e3d0885427e540c8b42a19082c7dc94509125c3ee9977a91c7d8ad3000286e5d3096711986db7e7f8dd630f43a1901f9517aa5028140ac5a1b04f2690e527747cec11281427828a695128140a85e250701361714a1b628c60fd94ae47a138262aa645a15028148a83c14d724a41e60026e655889942b113be74535d1af4ba6e57780c9aad06b9dce418eef8ba10664ab6579d018056f54d1b1b2642881c91c23a43dd8cfee341884843f6e3a6ff9ac578f429ba149f8cfe95bb08215da0c74861ae23581e018016f17c335bd26f4afdc96eae5fa1502814c7c7c3e6b187b043087a25ebd88350284e0455e8e83d5c080a8b71645c2f317049151d404a0111c3f59f7591c3232c6d0ef097a3fc7509243ac191ebdf3e4b01b9f9c37a27e851430b5a38ea3b9342f46f350fd18712844826b96a8f992141cff9dbb9150a8542915240ece09c86fa85b06242e5c442e7158aa3711af3c2b68c66fcb9cc41c3fb6a64125fe0ab199c660a1e12acb408047686842fdaec6c72559ca77cd00a8542f1c519f5e9e4254482c5638f6417d0f3eec26cd54eacce8c42712cbe664c8b5dd5fb9967f44d6c8568639103cccdf9b1479590367213afe726c15245b7c24a66d4af4c1e7db0b0dcc8b88d0bb579a150281469c487c969a85f0ad849f75e507dec879d00d145526eb062ebd8e3512812f23536cf5d7a09e175cdd6f25d4407ba21222c1f5f6352cc638f6e478408217941cfc5dbc7dcc0a8bc6800da85d5305b531d09fafab107a85028148ac83e9f76451ea5dcd0330ca8a60a272a14bbe484cdf2f2f8211fbcacb4b0778f01855e647f93afe8788bd62448ba6dcf6786ca255528148a6332ea194f951029ca9e35e9f656cea2a1fa802a14bb24ddeea8e4b8fec024ac04909d40e84047d445a91e7b54a90042c409e8368c6b674ea3d339f678140a85e2eb4101c024a35965520810b121a312a6739cebd41db301f38f3d1685e2a4388d5e43e57115c2abba5e5f9824a0a6ee2101dd25887871eca1a50882486a08ba9dbd5e0d8debe54d7e767d7eec41291492317ad02feaa27b32bb67dea58c3695225d3082be89e8d98b638f64331c00bdb7701bce5774462b147b86f8c133b1a4efd7efce4706f533c57eae0e21c340cc1699638f2ae5b40880b5b2f8a4b4d48d913154f74ba150280e09780e0c45ce9364f36fa7b37416cddab187a1509c2872e68b97dbcf856095b7c2730621bea68bab1ea79ba22123e8d496cea07975ecb128140ac55761d4af4c9e3c0a587c3cf6506240af89ff486a67fa3d03a56f158afd205b1ea85dd5ebf9327a15ed012920513547764ac5312db07abd6570692a2fa542a150ec11bba2731c60e0982b0f11497af5b111321b46374ba7d1e91e7b2c0ac5492345b8a47db3e266d122edd95d88086727322dd4e1ae3c0c44d47fb3d52097c71ecfdfa89a14fc6cb96bbbed8214ef8b42a150c88a5dd56759077d80a988314dd9be749dd930cf7bdd26bb922cfa46a19091d4c7b48cea9d65119d82f61c466b0ad59ffe50d47216fa59a737d0db39659b2b140ac51e11b6f922b2cd4729c9ff829012e2f9c6e5957e53eaabfabb0ac56148af0f0dddf9d0188636c0d9325a3fb8ca363c3cf5471bc02a779b78b94a89ae5028148ad3c4aeebfdec158414c662be3d862d4cb5e8bff7deea85e56ae31a3ac61106a1507c5d5269ebda35bd3fad46b6f893989b94e7eca8d4011099d923e64556d9e60a8542b147ecbaaee953e439eb899b049b07caa7e2264e90116c2f96ce82a736c4523686b5c1f4524426917283155bc71e8f423a5217d332aa3b935ec0105d115f7e1ab5d8a58721d62f80202b7417930b5de58a2a140ac51e79ed53d4e7d38bd0015c97115fedf81cf9e8bfb390d9e8d6964ee342f5be50288e4e8a625adcdcc0689b885c3bf648147fa31638185e9b1d12d68d54fa5d140a85e20411fd3bba044242d137d10b22b35ac4bcc44f18ad5f23fa5a0e422453bc73e165f1521c7494735aa14813a9b0adec9a5e9f66101919aa7dd8d40379b272169792f6b05328140af9096a6d2003e61a8b9186906941e8da45ae230caa36a273061ec3023a066aa30cfaf429b0d940ed782914a9e7e8312ca35965723b07c0b20ac14a3d9e3d27fe787e75a5df97fdbb638f46a1f882d8930bad5389cc3264f7ccbb4cc5c68a42a15028f6c211635a5c63685c3900a0f5c4ef4adda4180a6392e9174b832bf41cebd8a35128140a8542a138398e620bdb757d36bd0630c993eab19f7edcfb95b368a8e6ab0a8542a1502814fbe3e0312da3ba33e3c8105e4c4c5702aae25f70930058d9ee102f47ca8fa15028140a8542b1670e68169769bfd0f02d0c793dfab5a4ea63a783aa99f1833b5199b2d96a903f8bd9522b7a4f6e9c6abfa8aa6929140a8542a1501c86a2663f3a99c3d40e3f7f0108b946b0fe88ca4e4f31544774a0355676b942a15028140ac561394cd082ae55a6034f45b2a41933603664f24ba7d13e7a0d1f8542a15028148a2fc7becd72d7181a0c00427b7e90f32992537f4480bcdb5de2d54ad5965728140a85e224b8b1f22523407491941baca88a55cbc71ef749ed737d76374230e94ad8e6e6fe4e955220a42f880c45536411cec3ca8814283244d20144ec880f1e6ded4203f42a5aa3edf68a5365a02b140a8542a1501c8ffd18840000786d0c8d5b511f91efe52429633937116f3b1acecfce6c3e5ae80f9ff4e971e78f059c300dab63f05c9b878e0677d7885e51ac95c89ecde42c0f09647a39a7d179d8ef99140a8542a15028149fb217b3dcaee9fde938322d4fd7e0a33afa268ee6a10d37f93367e0f77672d8f2c59d39e03acca77df4016e23dbdcaeeee4d03fa9760a888d6abf617a86da225728140a8542a148033b8f61b9ab194f45c850b444f195938c2c9f05cc8687e9d26974bafb3b8b8d59df2c516f35ed46b6b3230ce8adaaa500683ac58ed13aebde96e6d73b1ba942a1f82ad87e78518b26f700d93df32e4f728a5728148a94b0ebc229764daf9f3d203a707562d3b7092681b35ec6195c8d0f7dee51bf3d753c6a826e464bab8be44760f814ac9ed91919d7d031f631468542a15028140ac516ecd0761ed52bd346e80018a754b49c02d6e780be55ee11f37275ecb586bb1a1aad2e01d086d1afc538b7ba5c5b3a83da1c3d4640c5b01c8c800360000363e40372ab8ae8e2393700c91d416e5e470bac92277ab046efd92afa4ec1a380bd0b408f96d1f3f0655ced306dc7314dfbc4a597105ed7e9cc1cc33c675502976073a541886df418d27974ad2641c47af42ed29021a28e3ff251447e058f6e0d3d8b644fb8e07c1f10e922fa5d0f1dc0fa056050b98bee9b1e7280a94e35a0979d61eb5e3bb67c2a768b3b1f1ac39020b78ae80354c14424dde8fd69477f2e46ef0b71a3f7258ba2066cf46645f2e25c223ab00230d1b8c9b8036770ec4b51a415bb95e7ee85c60dfd3c7411872b800027880c4b210551bbc243d1379106881046731813f315788810fd079d4cf4bb8548907b0821bd47dfc4330f09d65e0002e7460b5c7ad6bef359fdebd5c2888dd21fb8b39816d718e9e11820786945d7ee9e8c594e07881c1ae36ab7d87b3cf6607ee1aefac6c2240867c296abfcf55d2a6177615e04e978d7be04a3199fd4e68686a5470221398fe6f6e2c6f79f4234f7cc7d00747b599734b5dd8e7643d6bdc386ba3631909b763d70009b3aa26f4ea33f97c5daefc065ba7874527a13ddef4c74bf6accc63994091f85cbd227b9d88ad430b4e0c5c3aa8d57154024c2995bdac5fc553511c2528bf8f3d9d3957a1fbe2aa3ba333d8f6ceb8c58d015d143ac447f2087305a28446bcb0ce836be4c6cf46c13bcb611e65ad725fe85ca1a2afdf109bb896119f52b2f414001db999d1c2f0de821b391de2f9dc6c5fdb1c7f22eeef8ba10668ab6773e869042f3e73e38d5a27565a7edf68b6509e2c949f6e67cd245b4119bad06b93cf67876c0a8673c5542a4a847f245f65297283f76fb6c1112f41cd8c7f13f22a8816f63c6a6cc43f488875c271701122432e816aa47ba7811cd571593a0770ea585de5816af09faa7337fc989fd0c60d27cbd326b81899013efd39e1f0a1da387a4ebb83e2ba468ef45b15bcae32a84ed557196b7d1a37011d9c84c28cd94ed22d242a4bfa788046fe64b879c170264045236ca6d50fa2321dbee4bb8ab0773e0110d5efce857ba9b511d173e06f4d0ea11bdb54c7b0c884b0142b7aef797550819e944f29ccd2f9dc1d511158e5dd1357d867343cba479df9e9bd871aa41b1bcd9dc30d4468f76e8e8a45c25e8bd6ebeece57aa3676c5ff56c6cdd1ee47d5cdb4afaac326b790c211bfc081c403bedf21013d16fc063e40574c4f355c6199c83d08547198d24f212e1b87d16ba3b591b8eeaceb411bd5f7991563fdac9f892c04d9cf0950ed97cee9cb4577b39873b1f1943dd86b9d1d8cbf10f81fbb472168ddcb187118b32ed171abe854143e4bbdd46f244a4f29310acbb04c2520fd165f78edba39a7eec312544e98f2dd97ef3dc9f177a42979cc80de726b91d7b3e3327c71e492c7eaeab9df36a6ea635ba4bb7a11f5db7730b861c4eb34acfcff8575bf30bcf91089deffd3a19c1f37dcfcd76f3cc9a385a70edb5353e34dad7e428b6d2612048da80dc7a88ded19b203734fa859a3e9bcd2160e421d7ea15ab87d4e5f2c88b888f0b7ff8809333d09c90796d003743905bc78dfff68847f08e2cf4dcbdd1ba5f95167cf7ef3be43417cc3df6f4db2f1052c8a5fdbd74574383b66c08c9143cc4cb301ab7047ee3f7e0263a7313416708f3e97254772643746d2b5f5c3a8d5c8ad7464a7fec4e7f6c2170e5ca38bf681b5a405eb61840bab8b95fb9a4de3ff63036c2f186acbfeb3a3b9b11cde56e788ad533ed9ade5f749183f62076c70f61bbd1b9b9d02fc2fdf41f70737d9d13ef42bbe5134447e49e48aad0b6830514f0aa1ae9f6d168465f327313f1e6d186b07edf6db2c63eb3b624929766ae160a9768e27d21d7181aad0e002c0722b76e2fc3db88178015ccf4d583395a644a81b1c30d190ac8cdd17e7b6def1582e8eca65fc7ae71737785e5358277ce910334e7a7985719ade76c07402f775c636038d3a5b368a6289739befee88c338f550fd145526eb0a20cc12b5bb1537db2453c930693524f7c5f8e7de60ff1e890623bdf3da1f8aee341013d7672ebe651df79b989eca873e19e2b1fecc4953aa2e7ec5009ad7d8ea37e65da0d5a0c66ae986f5164102b5edfdf59644bde73dd86fe6d55ef2fdb23cd79da4faebb4cf2d2add861d2ba41764daf2f5e0000a6c1a172ef1211d91663f4e785c93505d8e582989b049b12d559fa1d0e165a29f28b972b1dc32bd4f4d95905798664a3f562e64be40b7420447c747303a352f772b3bbf6f186a2f4c7c76caf3fdafa79e07bee46671f5aabc7624001ce4e432ea2f7bea3faf2ec94c319affb6734a3d3eb4845dd883df343ee8711f4ecd9ee0eb78e6dd6b5ca741152005de4b1d47777fc93a48c1ee22dd711ade79a3e5bde0f353eabecd8c494435e686806ad786945a22643bda6f7970da1a7c43fa6dc4ea58f557db6b0767c54a9029eff057fc9b80d9a8e75a36b0c8d2bc72073e82007586fec9ffc56ec3b902a782ecde8aba1513cf4b995fed880e4fa6333dd6213ac89d8273b75db1f49a100c620e30c9a29da18901ef2eacf951f7735308a39405feb88df0f1c7b6005482c63dbb5ef3a167054af4c82b0a2c144bcead9dd8cf08b1152c0e2a3c8eb1bf59dc9e47a35309a5bef4d48242fd70ef9d4b6b611c064bae64c45d06349a63c3686d89e71bd1e3eedea78b04cf95ae46f7013751709fa477c7eeea867045a5d9f2da7000063e173517b67e8e125fa26bee8b96141df6c6f353e4a7fec8c78fac34e7ad8bb993f2e7a48f04ade2d805fd03c7a1c3ae98ff3948687ee0a48743f1b32e9e2f7b0ab7a7fb28aecf007310d1d3c969fa067ebdd41a6b78d2b79d4a7cf557f52623d241012110f43d4fbbe23481d3d0a99c7dca0909f95f5d9e436e911649317e2b5e84729ffeb3e5bb9a171fe5a1f6df72328daab335ca7c0df33eff2002f339836badb9ec7be5971b3185d8023eb5e96e7748f7772bbaecf2619e25d582b00c0e2be6d5149e126f1af8d81717efee37ddb294a9fec93bfe993a4ef3a2d68c1a5c84bb0a57f3614b09c5f3a8dce11e79e53832e8ada64ad8524f6468c667cc10286782b7c76ce5106c10d0d0a5bd86eae31349889e855440cc2f90e87a6f817d1fd25377801d89bd5f57a36c13eb86cf242731abf7e3fa6850340c8aa7aff2c9a4fc9a514d7f33e0026b61e8d81d1dc22978ab37661297e92b6b43058c7a9fd3fea3b93ce1c00bb2d446429ca834c271022b67defd2e83c5c16668fbb71d628fd7128fed41f09d6f110ad593d86583a85f82e6a61e05892389225c24394d5878b3f6ac55700e6b387e857e768b60535104c36dd68ed6bd7f5fa54b42a7f39953c6d49b003445826d9eb904d5e3c867fc9031d69ce64e43182a574d48cda160829dc6cb1cf4d10a92bf3da244472c8fa89c2ef12ad85443e8f2d6ac1a72f6538dd78fe58c7973ae62c730be782d21fc7e2557f248869b13d7d9615eb263972953e84e0f9fdc259f872d64b4c310039905917d19933bd8de6227664174ac55ab88d24ab82757cefa8ef4c1fe6264191b09a3c664db11d10ba7896e0a9c9262f5ae0d97fecebbbb981513400bde2e248c3da13a4b78ed1d9e4bb14c097257fe03d280018879904c55e8857d3fb85bb6dd7425f9dc896f63063d3e7b384312d4a7fa481b5fe48b0a4e22662e934c23d2060f650fa189c94e29b722521383cdb1eb862066ab61ae4f2c7bfbbc6c8207340cf4e4129280a2f0974d5dd74de21616e68745a88dcbadfe7c8141f624358e924fa865cf243b5c07d13a3f19a9f81880fa738bf1256cb698fd34dbf0d4b996f8a03d8db774c8b2df67969bd327d0990405626514833149a73efd270e3e5aa2bfd911ad6fa2396eeb7452d4d08112f24dae7f92b95a7acd3a8348f3d8c93245acb495acb1720f35ae3ed38f1953fe160c15c8bb70f296ad471832c4ae3e8d786da773a328ea52549e0954e5eaa540bd6e35dd7066088b7222dec4413f418c3d5663e7d0891c8e417f93714c87c35cfed71fc0ffdce831de836edb40822a9edef4c5f11fa8c01d5ee3e7b7e4a7fa40ca13fe2f92c7886e29998760f5e4573e77848c6326f61a41d0f513a5df433d7251d19e78bdcf5a0388b3741ea5a65f28014b0781af1bd7243335ddb6d1792bc4592c90b04557b5dd49f16b4e04a949676522135fb0140277cb3afcaddafbcde98959bedfc5e8ebdcee7c968c1ed3d84889eb205778ae531029969ac985da53fd2c40ffdf1d9160705009344ff7f730a732fa74b989f2f8f3d8c93645d9721fa6978eca1c467ddd30cc04ccba0098016afb7909b1b18ee75b454568edfb4504bb0af2aa5bca00dc8e8a84ea737c810d991fd4afb87a06f26ee3db4ae95f0b33da294e4f6786c5ae7b36180e40476f9d2055f0184e5cbee40bf5c7df6d92fa63f1ae3ecbce721ba48ca0d5694a388c90f7df2d9bab54c87c6eaf1246a1845ebf4671707aeaf16ebfb60386bcf4a621f50a2fb5be723e352d81ae9686ecb431b5e3efb905dd5fbd979f453ff14d6ce2702841492e4824a292fdc24b0f408fada865bca92c14d44b23ccf698f091aded8e7f9be2ef20da44da8e3af2d37778d9b1b14ceba04c0fad48c5424c463e466ec05ece5b3fc40a53fd2c81bfdf1e9b4018103722c343e854050927e6d91628a00ba443e5c0a18b052a477fd74c414100068f6067afba39a65a3ba33e3c8f0b547b2223dd81026eacd2299bcfc84dc8b65443a84e63078362e92e403b4018632e50ffc46b416d979eea75dd767d992787f767c648503e86497cea0ee7ff649a53f52cb1bfdf1b9cf0dc03a891555ae5d1d3053a27d29d9e060a154bac8013813e34dc74bc14d82cf1ff41672c1371648d02facd5da57328b64809b76c29816c9e405d76b59c4d328d69504df449aa8ff47c696297fe01dc82e635a461a7d29f9005010af8e0a6ade1d04b174db1d62ebd30d47a53fd2cd5bfdf1c15c339a39d3f3f0249a7d12004dc597ef1d0764ab777f9ea2b9090297bc7c301e082ad6434001891c3dddbf1a00a69dcc96914f5ebe261430c4df627668bf7cb5244283b27be65d7ea223e5aa89f93b0420b7c398160a18d8e2782c1d3b22270184889345d8670ff1c231953e49376ff4c907312d14d03f8d7d73e419c4ce695c4a7aa1805ce65e1b47a697ff7b4d4f771518579e687f7ef07129e2c2c09f27a867aee4451ea88698c096e12682547d5e7fa76de08e7af48f6695975b0e802d99972969c301acded588ff747d1930029f99364a7fc8c01bfd41fce09958e4a34f73134f22d69cbb7583707aec619c3c9eccb57c8f8c67bf5fa3c5cddd1596d708bed638f8981409d8a87ea292172988d6517a92bc5782c8245d7751c0fa74566ef0ad7dcec359e5c90580d71e938a9d40016b2f1a096eaa378bc796f5990f42e90f49f897fe787fcfdccddd1b0db1663e896481d9553783fe667d231489501b231b41353c37dfff13cf903b31f7a6a37c8ce22f248935ff859217296004bd843b54d2c683baf8511e7a2cd6f541298475511fd45666f98ea04f10206b3ade2d9dc5bca94a7fc8c1bff4c75ff240fd399c44cf4fb16f61678f3d8853e7be7eb164e27df98b6da9f83b953e7d612db1426eb61ae412e9a3b9309b7f6c56b9f3a09089649738c719a822094962cf95fcc805cc4d5288d743bb95e7ee45f41395549f4248b78e351ff52bd376f40355b6e1aeb0b8899099665d423fadc92250fa432adeea8ff7635a282092138901e126be28ddb75f6801e051521fee91e12662c546f41cedd73fda372b6e16cdd0998e64dd82fb033a8ee69587b949b087147c2300cf65d124034346d03701b94924757f130869925873252f7261e3dcf8b4e38080bf80265d0d9e3744b6df36b1e62ef5736140106dd55d6857f015a0576cf588de5ac5d2074a7f48c6effae3eff58ce829c480d05167596dea2a277cbf7003605925aa3cd60604eccf1a2dbc7a653c8404421c1f69543b829ae803f4e7956cb15cc2f3d029bcf7a94599f60b9d170bc39aacb6aa8dbd040a41c98b643816f663d6c09803b8214394d71edaaa7e2245b7262a25929308873d3e1ee28d53ed332341f89bd21fb2f19bfef87df2b0bddc6c32068f6151de79e50704ab12ef5bc884afdb65b1e63bf6402483b34a61700ebffec1aee6e76e0bfd7941f676b546c86cc816974ea3fd619a390abf79b5581675bd0e33b65d03739324e901aae4452e88e795e2c5b02081c0bd13f533e48440e86c16c352ae8cf38bb6a10524bff351a5051ead356c0b4286a1e700d62f34009862e012612f91098190f521a4d088649b6ce1a7e7264167ba7206cd047d1295fe482b7eb3d45c86882e927283153f4c5cf95d9ffc7103b86ee3285cfbda65c7a3106ea407edba3e9b3e02e87822f1f749b0f1259a1beaf5f85fa180be399278bfe8684cce7ecf55760c6d2c6ea4bc7793bf00fa79afbbc85c85b1e64daaa1afdfcb3be7500de6ab047d7994bc4805cfbe94c2dc2c56d01237c9da2f222b5c83da66fbde1a4c8aeb1ecb27563b9b3f92c82620d831460ffa4da91f2fae6034abbcd4b801787f4d905b4f287c0b71a080685ff788de1a24b63b95fe908f3ff4c71f4e55081d4852282acd4058d92c5e9e0360f311d1239f374e3d353868cbc412cd5e6bf92a1f7d7c284068cf7ffd6e5f16668f558d77f146e6b5a0877833aef68b9324a2433551d742524d6239d783e22c890255f222138550c4b2c6fbb04741d4c494f25ddea006288a5cc36e815c2384e494ea0e110068e25cc7cb310e8bbda4974696cea02d9cf7abe8feb8a14db02964fee613b379d2d0fb2c91330e95fe3829fdf1eeb5cbbae8f81796c3fa6c7abde1b7595abab81f98689dbe914f93a81e87c9b0c24c497b79f392f1510ecf24dee710b9acb5a5b348e283fd81ac3d3285bc6cd2bf5ec98b1444cf37661d8c9f30596d5382bd0de3c38316bd1553d926a290423c86217fb170950fdd41fb66dbc3f59ade75615c0d8aa5471b4214fd2b567fe4179988d52ec179ef26561fa1df51fa4342fea23fde98057735e3a9781afdf9a3ebbd428f6d1ccb09c1473d524f190e8972d9cae3877c2072a3365d007d4920b4f3378bc7db75af081b014c16c9a8bc5a8daec0ab92db8dd7b2b2da320cc14a902bafe445325cc45abcf9f0a1df79b0a3f79f1c6243875abd6bfb49687076cfbccb3de86c8f42e2fae6e54ae72c8c66b1e2d3ee077424aa3aa2df3befdbd5a6b9ebdbecb0a058eb16b599998fe6927bf18f622d786363b574d1767b8e9f542b287d7262fae48d2d4a2d0cbc93d837173129b52dba9972f3cbd65000be3292cccd969f71555fc3c4907ff71a3246c63a013be13e5d6a2090273987f0cde74659f7067c1393b89fbf80bc9030ac68b864881e793ef6607640ccba25340b5e206b9d88d7183b9ad8acd3c256f34ae43e9ec2b2933e472f6dcbd9c4364e4049bf2ffbf72b67d098a2c7b0855cc3cc60e2a031ddec804a7f9c96fe781bd3c208c42b9a997e3c0ad96db63002245f32a68537b265324aa44bc1d7255da71e0b6aa2e716df4ec00026e94a6babd1a536378ba3cd72add7750524eecd8241b29896939697fab88058e3dd266be8d82a6a05591fea2f008c98cf975a3094587f6e5acfdcb7e47fc80246b074b32a2df801d75664e90c9a8bb5bc6c8ed21f27a63fded89e10229e9f8a2dcae16af32f5310f569be1c8c24f6614673f952d5a88c8d8827244bc703116f88ee7c640cc58c2c6911608658bec93b0b3adfecfbbca02db7f06b1d1b6e00f612c4b49cb0bc4088a4e3dcf9acbef603599029115975e52f68dc183f085f733f65c5c344f3ffa85e79e691bd604b3a75fd0b7bd91de8ad896ccb2aa53f4e517fbc0dd42088f8741a75762b407a5bf9d4087a2e9ec6bd880f40f2d034081911bd35be1aa27851a490182e028658ef68ebf706b1e421c2e22e12af0e8049aa0143588ab9d3c680e1bf7a0bcd0ded2a0d6b6127a375f89d886a6bb61ae472c3c3c05cc7c72d741b780e94258e1f83b96126e9d572b2f25329679c45fdadc221300f9dd825e3528ae6eb314b6650408fc95c13d3868027aa05006179eb5cc93400f30c81b6a425d9d2a24f3644e98ff7f4c7fa86dc4de71d0213445bd277f35fd06cd7464f6f6ff1acb8f935ebb424af671baded248eaf4c087f2400daa5e6b16290fbb54ff81977d3f994ce1f1da46320d8aead8f050061a9ae6bd91b69ebb156b319b771b15d902937efde6baf2005bc71e6363af19a45ae394179a11a420e7a7fcc971520d895bb3f46dd4ab0afc7106d79fd22da6212b07abcf1071c80a033302eabb25605f90984f6b23730db89fdc6c746e98f35a7a83f8a9afde864d6062c0f5db6bc8ed62f52ef6ffc209ba416ed5f3985754a122820870dea27d275fd22d9e6b62444735f0f29f2c545b789ed5cd2755b49bf6710bd4ff69b9e7936d7b58989dcb41d29d78114d02bdd6f7d188f91b2a84f201b9bd64f3c2d790130c9f31887c5da1f61f404b929f99e2a8dd566e827d2da0914b0665da1e758f13e6f8c8cbb0aa0879bbada5204853b29e75fa53f0427a93fd6b62700c0c9c49a23dd22d6fc0d27733fe2c230516ddbf2b80a612bfa5e7f9fa33a3eb48e81cbbc1cf38bd60e7bdc7100cc2688554e1910700d9eb717120851d2bcc8c4f5134f4f5eb86963582afde56fb2c7d693d8f513bb2b20d13a6bab6cbe23e25512d54b8490c2e529c47dd66a2b975c4968d329fdf1f338a7a83f5e6f0b80beb35aa46e616e4d82c8e227e5062b1e3f48bfb6837a4eded7ab750e90a1496267ad86ffe288b95a6a5dfc212bac6898a9fdcae3dc09f6cd8a9bc568fdbc8f12c5fb47f493d6ba4dbcccef4257cb5aff0a11bfb6bc309c386e8f4dff723d1e1299f3240100e2d64f2c6a9375fd3a29af97028431fd402ebd8450f4006dee7d58fbc637c99d94538fd21fffe2f4f4c72fdbb399246c32c56c5b3f514035f4e4cd2dd808fe92711b34612c6c55d2756b0c384382c566b7c91a3b8fe6e3d5abc24adc3a49751b2378beedf2dbaeebc0c4f53339fd54043d2759ccc369c98b0d73edb34952f2d8fa04314b4b796d8375cc5e3cbf290d723371a51246eefc0b1e2dbc6e1f8e3d8c8d50fae3c4f5c7af78eaf3c38d678f50137d6307312d8ef6a5625ab889ba8b04fd043e590e96e43eeb0f60d875aa1da6ede9fa7cdd6e48fc7ec1fcc59cee60fc9accb64c741f92f4d03d317999b55950ec7e305f44b65e53e6eb8ddf2319202779fd44aac58b69a1006049ef478690c26cbce7be42fb44e90fc1c9ea8f5ff746d217f4df4008cfc5d92e723799f4734f0208ce37c8fdf424afe5fb1e912d51a9ad9c4173bfb39e478a7b3dfedea080b5a7c47da9de85e7d6b99092023c93a807e8e9c80b05c452edd38f45d72bb1ed80a15f8819a344909b12fb05284092be598c21a27dfd50b8a9090b82dd334fce608a1f1008cff3c71ec456287d72cafac416f51345bf5dd9633822dd4167372b8f683bd1855fa84e0b87d8fb44bf10713fa7e4a38fae298f9e476ef7684b0d342764914aa3b2ee2bbab8d8d5a13c57def72771fdc4d391974ccef399f1412e9abb7a3007c26eab1f7058bb859767a5c6f825de6775824d79ed54083ba6617d16b96737cfb48c789e55d9d79a3c645891338657e98f379cacfea81470e1ed2061320d30c4faae1e13fb52755a204cd6136e8ddcb57cdf816079b1ef7ecd2580ecda2f23a76e2388cece16f020a94f52c84be26f9d84bc1044fbd37d73805c41763f41a2e7eb21ca7bbdf4ae336cdd6b9f89229f946024629824afb14caf7a8bf042d2cd72a53fde70b2fa636e1641e6a0a5b77888851d1d0ac22f559785203712c4b4500030450690d43eeb7f41f3802e19eeff4404d9f14b176d0c0f0974773317428028a90fd2469ea487eee9c84b1e2a1956f8f4d229f872c778c47ebea2ef8b30330e3075ec034662f799e3267a729a416f70000c79b75895fef8c509eb0f1bc1c4f78bd2ca86a8f9b49b1a90a2afd6178a61c10a24aa67eec29dd110ee9753b94904cb8b9c43f801266c0a5c5adb8c3ef70699ab44f1d57f8720377ff59a280786bb7a1495a29aad0691aa8949345fd412d4173e19f9b12f027b42b4cfdee7e8fe0c65f6135462c7f88db48b6949dc0f39853c7e8d48d1e745ce7ae0ffa26acfe47c54af287df2cabff5894c7caa3f2a00609e484c0b1298edc8ccf24ea767dfe750307d58e512c90a953af7e2df50c03958a37dcf75eefcb1804216c77b3ed39e6004abbb118a612d37a3d1fbd69054c8b89930a6457e79b10266939bb8f3ab23772e28baf19fefba0fa8a488be849ffa4d9fe7002286c296b7d7cd2f2ee42c49a7f4c74f4e5e7f44732791785a790384480a3b7b4c5fa84e4bbd896ed3495038910278aec43eebdfa8e417cec2df7f9f46ffaeb49e9624dd32e5062c777324467846e64987e03c97a09ef909c88b67fbdd41861bb16d6d26f7f5f25cdc98164b6ebf409c3ed074a8d733625f5276a5981fbb3daa49dab755e98f1f9cbafe88c4cc3e8d9816b4d1db514c8ba82725e9bb9f148fe126b99f27539fd973e0609b0fdc5c1dea54bb87027ab4bd9b6331824349f739048e96ac8e91ecf24235bc4de65295fa7af9ca8a1da3247b4d4c0ed6e77e0166633341cc636aa15285cafd81d21faf9cbafe70117d7307bd795200070dfabf9650cdc76a6914445748ca0d56dc326982114943071322729f12d73787c091de472fa8eb4cf3edf9614ee622ac64b65d323658bbdab29039c6836693f698965d5e88e717e2c587da755dab8a4b2d49ab4669b6376457f16a0b500d7d99635ab0a8853cc6fcef6815699fe70f1c004be68b50fae31727ae3f020a8872d6f7fc1d08d98e625aa27b22bdfb2e1e1bd5334722bdcf5ac0f0e941bf2ffb8751ab10ca5c0f981ad620bc4c968ff0772044396d19d1d33c71bcaddcf292a4be3752cd1e0abfa3ac9ddc4952bf88bc7d4f291825fd9ec1e77e6208d8f42043da2310a29d3df620b640e98f5f9cbafe68ebe781efb9d1759e461007cf50dc551e68206dba7d52489c3ca0f790da9f2ba08095c39c6934e3233b5a37da72b6df8df442ee1109fabb7ae8d2ee7924ac9ff80b79e585269b1fb82e693dc13500899fafacebae04eb1076a8a9728f302aeb9eb9d21fbf73e2fa23602763805ac09a855d5d8af745f6ccb102304d104b58beb83307628f48ce09ee2774658277111ec456a2168493b5ee96d3362388f466b78764275aefea37a4971786b16b5fe329d44b44c45a3c21bdaf5f2c5928b177809b9fe71a9dbf0084e2790e0e33a8d86487ab7157182fec9e7997c9c58b84be9695d2f451fae44f4e5a9f30085d628b3ae18719d6dee0433c0f9dddb42072dc7e9125685fe5e606857c74af8bd2f95b343f4393e4856a7390bee79fc827a8cc76f7be7c8a8bd074d7368f9c10eced26feedbefff24cf9288744ce7da0a4f5134f415e92d58b74c00da9b435b001e23e5f5a00789475df3c76ae514ee39362e8217a92afbd3879290e3a9226b72afdf183afa13f446df3938869d18e79726e92b284bb63a02d74775159c4ff06d50064eef9b7064027d3033e2f08d196d93603d0f16c37b91c05cb933456704dd2fa8992cb0bd5204c14f346904b7dbd0060c4ac9f6880d4356962d54fc42222de1e663cfbe5401b307b41e98f1f7c11fd7132312d347fd4d37b289f4fc53749f2fa89154ddebca7573858006767073c6364abc81c7f6b2318096cd10fe0191b97122e607f92b47ea2e4f2c201609ae07a39487ebd7a11e35eef5c2732db4ab1f6edb88948a4af1341d0a3325f84d21f3ff81afa43c4531399e79635040fd16afd1d1efa9d075bf46d924dec29609030b76b9da78f92e65efc64d5be1e140fdaa4990236e5764981b66519d29fa43477a7d81f8fbd9698119bad068957047993fa8992cb4f706dc75bcbdb0860da884ce2020354ef0d26cd7caceba51acabd8f4761befa5c1f788825d9b7f1b8a1615fe68b50fae41729d527b188ab3f5c94b7d1d41b7c13b5a32ca96846c3aa7cfbe6f8ba2f666d503f516a9f7574dddac14b694128779f12c203baa35c6b22712de884f5f55ebf23b3bcf0f1aa34a8c49bdf88d33716e2fec8dbd2ae9621e83931e3e4e5ee2b65b57158d43e13459133207f4c8be76872c7b428fdf1e3505f427f887b25692cfd4fa2b9831ea7092f3729b177b50e3c301e6e10d38212d7f2c5d7dccf832fe048b4723cf44977067f9a951b7c47ad9629e0505279f101e2f5ddf90d89e5655df32cded40ac82d996d074c9ceb8af2c6b4784e7ca52f9d5ff83d1c992f42e98f9f7c09fd21625a3459e796b71c29a6053d0a32e67eae9f7d25414c8b7d939f5d9fa3dc3dfe9eba8d4c6b75c0f18b3c72f193bc3ed4ddf4f1fa81d43ec8041b1827212f09e6070a88aebc7e01d14f3ca6ad5a1e3fe40361e6edb806dca1600856cc1225dc24d25603f905c1ba9c5bac4a7ffcce57d01f2e4278e4dcc99d40e148312c00e1fbb99f8bc153652cee3029375831fd0b3c3ed72051fde2b665dbf2ee030a2add043eeb5d400b96d74affbbf02134664fc84ff865dbc8e923173d7413d4373f01794916f3e61299633c846e8c990b6af91957da7df3e8b9ce93d40260f29a453fe02691b3ed90d21f3ff94afa236047cba5dc2104d139d25a831158ca283634db1bb2ab24f17730074d665d14bd279943cfcd3c630391d956a100da6edcc1d2db3261c54a12f320bdbc24ace304a1cc7d4f5faf37d607a906a04bec2710399131f7f1184228efbeed2b04b9719c98d76d51fae3275f487fd8d15c7a9c7de71dc24d44ff68738784f5cc1d88edd3fc0581b9b4b917f83a3727ae4bb33dcc2e1efea43b84915df5d9055f97f9fda11038496a80c92e2f88618278e43512d778a316783ce6f3753490ba865bac7ae682c83e3881e6d804e7b28aa2d21f3ff83afac33d7e3df09de01c3ea6c51d5f174231b7b50f7ce6ad81d04d9efbc94d22732e5bf48e6c5057636b60aecbe84cf985e73cefea5032db32569b05c57e0233557679e121424cdbed2712c7ec68736fc0eaf1c60f219337f713453df6247d9fa58f6181805afbe94568df66ae9d071108ceee9977b9fff94de9935f7c197d12ad6bb8fc755a00c038f4594910a07cfbe63f48d4e76f8d27b3cf9a82e9c32a77f8f1131c48fb92bcfa2477e30e8690d9d2ca4b92ba163fbf23b5bc5854bbbb8cf7e4edba0eac8932771247da0f901188b7cf471051de98163ee9b8842618bfec312d14d0abc8ba17a9f4c72b5f487fd8c8010ad2ea8e1f3044efd06b0baa61e0ca59cf3c9295247dfe7ec2e4d545f526ba4de7f0f18601229558be20646467fb4e5cd6180fba49fd7fa9e505b4f2b8864ebcbd0e6e802675ee2722d6123e5f59fd22dcc45cf69aa01fdf2f62cb6a0bfda462c0f1625eb743e98f5f7c19fd41fce09958383709f625bde47f7378ff9b6f485c3f31c99eb9e8f1c7a277cc91769e4852ff6ea7e7050865f649b908b0bbf94152273941a40962cd6597170a097d6ab6d47d9550d44e8827a46e24cf5e6427485a6b9ad9c9738de4f584bc6263c0256d39a4f4c75bbe86fe401bbd5dd79e3c12dea1d71614d07bbf7e62eae12b2049ea25a2715758770ca07b1bd39ed920ef7537e70d5d4224ddae8900d0b7dff370a99f0b656e6e4613e62a6c202feec31dcb0662c5dc6c35c8e506a3dc2b49f694b98e72f7138fffbce9ece2657da572eea14398acef9ca81bb1cf011d040a10c83915297df215f54905d093ba67d62b140e9e53ce4d44297b8252c359985c4f20eb3037a9bcb1b38298f5d1760f80296ffff2683ee1c6d6b19a14ddba2bde3739f7e120a858497a824a2f2f3e40a2bc71a963eb5ff3e4637d926a105625f6135080c435789c634d9d3b0302466d496d5ba53fbe9efec0a0a2c1ec24625a0ebfdd24654f5086582757e83956822f495e0f2eb18f7e77446b37bc3ecaa977c42eea8f11fc32f5af04f2cb4b90cc768340e2bc48a41af255cceb95bf7e224c93f9112166b84f7a117eeecab147b1114a7fe017d41f76b48e4cb26f9a5ac8d96b7ce70170c13716d10f4cbacd728084fb60028672f7f8e31a1ca17ee2fad4261289f71131a0daf6f5491d4d4ebfd29ac4f513a59717976022db8d1199eb2702bfee1567315587474166bf0026ea01baf683c81fc38204c2043d7cd384d21f5f507fb82e8617d2d5e67e971002f3402f3078dc4afb3e798d0d9e261ea28ba4dc60c5bfbfd441f27d64d96bfb6af3bbe4b5dd7703410f25f649467a7afbfa63103022692ecf46f513659717f0da855cacbd1bbb79664d1c08114bd25e2f0d44fdc4789f05f4e4adc1831c16003c612e2b9ded6b3807839be8a45c7fff05a53fbea0fec087b04b679948f6765617fe58003a967b183f800d7353d25c5033764fb8df90d7a7547fca9d93f6ea68e7177e496921b09b9e7a92be3f6cd35c6249af3792975eb64446d9589fe599225be77e927d0f6b3f6c501f53e2a2827917076ea27a820cb12eeff5be12d9b6e3630f626394fe4079e7d38df4c76bda2405acc91e4f8604033c4ced6a6e121c4aea6399839624a1070000a369d99634f722443cd23ef91a6ea229ed3e2222f819825b8cff790e802143b4254d33e706e849ea58482e2f912e4854b703291c682f644fc4cefd040053ac3d5c39cd54821e4dee270500599bf5fc8202ca9a00aaf4c797d21f6bd6959f20649895f8d9afa180ec50ba50c6dc4f5ce73a6125493d739eaf676e51de2d22fb78f5cc5ff1e8f1f6e9b7874633cb165f1feab3bc08f22c49aa16116902bf98f4f28288097bd6c91cd391a44e890b774643d80672bad3b9b999df27a848fc7c7f517d7cad472f1b1fea0ffba5648e0aa23311bb67de65eaaf4fe99344fae495f5b546ebeae61e0674583cc47abed2393b4cad7e29635a20746d6b9ac047c60d80a1bcb95e5bc4f0ec0807904b7dff6cc4cd7caaa3199f944286a04b7cfd911d5a4fe027935d5ea2f73551ff83e80e497cbd1c62f74886396852e70f80b9410dd90a603ff5b65f1c1ab6e64cb974b69dd21f5f4a7ffcf80ebef68a98487ded3f286b61b77175886944cacd31fad01d647a89422b7d9dcab959b40620590ccfcecf1f3022b54f124cb259bf680a10d80df1938ca2f2130af924e3975c5e223b2c91bc782875ae2bf1758c79bd0482aaa4f1ae02b2510d59ae93acd4f2fb1382de815a7ab9c6c0b8bcade9fd391dcdf8681b5341e98f2fa63f04eb98961ec93422d9034de217e01508c91e6308ec569ebb17d14f4cba753785447dfdd610c97b70130893c4f0ece3fc52c7b490b5172a19a37ae545ac8d8b27b0d6f72e92088de4f242b564b61b05a9af971ba1b5b88859a744f69a9815485acf1c912cdc86138afc03e94d0386582a71bd7f37dccbe1dd5cbfd0d46a7a3f17cdf9f01ccd7f172b8070b04da8bed21fb2934c7facf919c3e2007a8c84d2d99aef602f6b7a3dbb73d97be8ae80f8634b1b4b9afb896ef25c486e12a97511371d72ac7ae66b88d43ec84897d104efbbbbf20d3b2010966ef739aac3e1689824ad5c7679a1eeed3dd38517b5d96a904b7409891903760ab9affa35bea01f2fd70c4247eafae69a9fa11be6c647f6c216f1c2e9818001f874f50c60d29d1cb04cfb8565bfaad7977dc42a5d7988b07a3b7f50c0f6ed366b03a54fa426a13e59f3a6bd3d0508a9a435b0fe8040be59d7357dba93a3b9101456954c716688be62c735fb36850262f23cfde85ecaeba3a7cb1e79ac1bc71c82e7ca9d671f52f05c7a09e1677de9ec4aa11e54a2eb7d11ba5fe28b7e0370b0125a9d32cb4bd81d64b811af9ef979beaf8b3e77f2f646495a6f98491bd302da42771795c566dfa61a56a5aee1f706469af5cad038bf15f5f8373a846b0c0bab664def2f9fc1ab926cc00896c42cf1aead4f4047d437bc7f4a7f48cd06fae3674c8b80838615496bd4bc83ed83052f67755dcbba1b1dc0354606bdade9f5e51c024656d1ba6529f3dcc436cd8594d7679d3c8667c750d060256f3ddd35151ae4661f6db3ddd72f962cace4716dd049df1fe10d1403f72ae177be88bcb425cf75dd20c64fd65c573f611fd0df000f71710a7ef51f78482ee71387f59eebfa6cf13cb260febe9160d77560cd51bdf2cc1f5743e37a59d3fbab0e804996d1abb36ee967c739e5c5e32a30ee922eeb94fe909c4df4076251b31f9dcc1b739e64dcc6c5f568465f32739360f33065c2f78a879700064c1f7303e35c47e45a00bc8b61bbfb58f4d7f3acf0c75ee7c6e6a28ac08367f44dbb8948b0f5023037e7c2a63d0928001809f688d6f3128061c87b0736f211ec14e2e796677c42992fd645bbf19f1e1a6e00ba0fe5b3fadce92d83cbb77b38c3197f2efb2f96f612e9292665cda28f0000ad115d6ff9b375b9ecf292a09ee08fcf7bee48d48f9095206efd446364362a00f3899c1969dc245bed53f07696995e8dd673124f627f0221f62144bcf74d1b3c3bb2bbe7d1946dfcb855dcbc8de4d98e6c8060ebde3f36fa8546506bc33c41fc94d21f72135f7fbce5f769866a18949ed037b1723a6b64119fd38aeecd6595d91ee2a8ef4cace81f79b3aecf32ade836880ffdb8660ef2ea9abfc23592b01e9ab6dc5c0db1ac6946739e8ba4dc60c57404e91f7d0f9dcccacd767e547726b968aeb725edf3e551a8a257c6de794d9f2d3391decf89d8ca2a4240aea2e74e4e67fef88de2c568c66795b6db63d38ff750b7919fe393b4871d435872a9e7cdb8f51311a9216fbcd23abe609b3df4c09e10ed3137302ad1fb414e22f6fc5d088b6cf1c65e8e1dd9f876ab4aebd965f46bbc0074a53fe427befef8c9eff782eb08b7d286d52541dca092f851665d1a173aeb365923c90a0ee62075ded3b6fedc1d429225c3a417172960298c967c4237b7c5bf7e164b283b1700732d1cd58cb9f3813c482f2fe8c6ad2728800089cc3d41014c3b664f500d40e29a349bd64ffc031a7f19a3f80b0cd159d6752dec24f892d21f52134f7fbce18f58a9de406f0b5ba63ad9c70015c722691f11441b02997bfe458a37490ccf7e012b1d0b04c566c0d22508396f3473a697efdba2b2cb0b416e24b1dd08fa12dbaa1c3230aff4e37db8a26153d25873818b98b87ee2ef50133d5bd4f30335956d850d8685d7c916384a7fc84d0cfdf186f7b31f2044bc53ebe2d3816fd0038e9b44dabca71fa4a4900e8468678f3d08c5f61051c3ca7af76fb2cb0b9f24cf1397f87af32e0e5c3fde663f840ca5f683f83963ebbe6b4eb75fec5f13c4d20924a11d19cf812bd7181556f1bcd729d61fd6bcf48422ea82dd33ef52ad1d12f1913e79cbfbd354adb0a83fa287b84df17c455aa08073d8c0a74941def84aaa771be1453e253e7798c349f824bf34dcc4b379d567cf7fd3ad52cbcb596f9069e563d9a236cf69a650c8f2f561fb014de657e3a6bc819efc327407fed36e0e4601f2dbeec32b50c454e9e4aef002107ebed655fae304f8547ffce4fdfa3fa56e06fd39a0573add5c8f2f05db24a625fa9ebc3eeb5a66dd572b0d103d60d624d2694aa1494da91c2023f0575b546679d1e3cb0bcfd820f1beb920a95f4dd698161fb6cafdfc1d5b0b2f44c390fae9d45b3e22537d5699de7eb6b655fae344f84c7ffce083b2df7c75064fe25d90d99ba74894ebb4e6a1df79b0a37787c8bb459492dc4fc165c08888cfaca8695556f23ecfb2e7f75f2ae9e5859b89723f31405be6180f51a724d627ed9bfcecfa3cfaa994a2f92411716b44c6828c6be818c84d727285ee8e0341b4edbb993f2e7ef07e29fd213f1fe98f3ff8c06e7fad3f49f05ad27d02c50f28402e410c0bcd8217c8bbefb7ae259a9adccf9f780ecc8e3d06c5065040af541335e3de9d2f6597170a1824a9db4135e4e6a6fdc63fc62d3841b32abcb8cd56835ceee5247f0261cc9816deb66c99fd04dc800dfbce7d0035355e100647bc1eb28a0f21d4d0c2e6e73d4a953e9194cff4c91f7cdad3ca5c649a77d1b16a2ad44956ac310b8afd04662b3724eff9c7ecddeba26da11a6073ebb42cc5e131823b176e3ea85b25bdbcb809ea7be36b3d7389af979b14635e2fcc4193d94f801ed576ee4774ba8f459f03a25dd9f191bf2814f0eabcae6be1d9079f51fa43523ed51f7ff0592b7b3b5aa39930372049114e459aa0316b84bd616e485e9f1993d7a5d9378e37647d86c841ce96915f120ac8b5d6ede2faeac3647ac9e505e6a69dd07623325f2f62bb10f37a6d984b5c2352b0cb98963770d38172b436a3b2f7934f073e6858be000093bcfb77a53f2424a6fef89d587bea24eb36d655e19f648db7fbb25048de07936ae831498366715d7f97af8edea3ff7d1cc0e7638f4111172bc022c97fb62f2cbbbc204d2c2f1eca6babd66fb225328a578e8eeb049b322bbd8a8689fabac6a7d708ae2dcf012ca9fe27bba2760d03e3e1a3bd52a53f64229efef8834f6358de50d482e2337a886752ef937c2d207437e883c9a4ae570cfcba579cadb71472fdb3b1e121ba48ca0d564c5d2016e1137c8ee489a76e688a7f4310ed4e8f3cd68d4f3f2abbfcb07e71f6991f754d506b0309282091562924f3ab457389b4974a8dd2123d7d8fe3ef0eb1f51232c473696f52aa8090c24007df58bcff77a53fa42181fef89d7873b1a0a4df33a858e8959bb85e1728d20dc11eb321b4fd4dbe2bb12e1a881a45c71ec6bb9059b9c197e8033c7c59f9a13cb263c729bf7e2de4263ec66eb322b3bc0409e4a546b570fde452fefcfe8a9f34575cd69a980cb14eaed07312b9d337629eb33adc44aca72dbf47423cbc84a06205efca97d21f27aa3ffe4dd26b735a7d36bd0000eb61b3132af60e1f017ac430179966cd717d66255857bb1420f410b9993c3e3d2d248ee13934d4c060bdb9aac9ec2edf00135d844baf48fca998b0ccd4ceadf67d67596dea9f8d4f7a79a100560279e160a2ccb99f0962fc020e40101116b2b6c07462d688dc16b2ee65e4215e8a7ddcb4cfbf32409e47333eabbcab1f94fe3819fdf13e04da688fc2047be66fe8d978f9e451c0b6acf3d689d2ad228239ef11bd357844827ee279b29eaf4fc41bf57e324ada11fd6f531a6bfe8bde42bfcc0748b0fc75f418848c60892c9c85dfef0cee6e0a6022bae92b75b9ee9b6be230debc2ab9bc88dad749e4c546ae4bba87fc83b87991c65d61297e9033ef8e9b78d01a554ed52f56320442f297280c4522c0d0e0f1fd7d35a53f7ee88f7ebd135e3c8a5d6676cfbccb342e5612e993f7d9e2bbdd255e042122e8724e6327c1da9f48d0b79eba03bdc973d56eb1b745cf366e80dc7b646827eef97724b4b9c97cef2bf4d8a3809e5dec0ef4d6e4cd344ae62ff35134d7565365ab13ac90854b2edab13e2dbdbc5048242fdc2452d74f8cd6f131af17e62695375e69fdac0ede87cd718362f90210edeb039ff9942000d084c0b1ba1f3c3fa53f4e407fbc4f92bcd077e92ecccb207a8f7499e76a09e1ede85d6564fe48dcb1db2fde04bbe94f1f50a9ebc1eda596ef5e20796741e7d14f3d29c6bb1910223eb6bd7ed1f97d1f8fcccacd761e606e8a1da27a3ad6f610301c26791e5f4c5e209439b61e91f8131ab77e2272c9eb2772e338060bf7faec6c82c8b554194c52c04df2144e4adaeafecc19f81ff8b594fe3809fdf12e9bc5b4fc4e77a15ffa01052c85eb7828c57ee07380d0be42c8596e2e7a57b3ebbc901d027353e27a701420ac48a40bc8ca593487e8317c496decdca6500063947106b50fde27922d93d13304ccbe887ee5479e5b2b56d6695c94937c457279d1102b49625a0822eea727e821a83f25982fa9064b990d9eca1e7a80c643e4158fdd7eb1d459eb2bc5a758a10358caae5c52bbbd593cdec6abb3a1f487ecfae35d76f82cbb4b6cdd7b15c00b33baa7f7bb3bf217874f08a23d02cfb1cedb5e502c4dd779377b399749b029afdf0342d7b6a6e6b1879114a679c541f443eafa246d0884944030b22edd38f90e24eb0c2a2df490d44344b08ef3fc20443248ba0f2cbbbcc085664ee3e50b01006064a6dabbd9cb39069fca97eb8c9e7bd7c28bdc6c35c8e59b3f41c824f61350307d58e58e397e8f11c845fa4beca38bd40c90f67eee09fe1a930166d81d626b43234ae91359f5c9fb6c1dd3f23bdd265e2cc030804673b9adf6d137a51ee94edb0174c0755cbf58cc7fe2e3da1d92ea2212ad0dbb834c2f49f9895420ea93ba04b1e8457acb9079cb0e11273e07333388dfe7454096ce825f12af63d6a2b9d53c70edde6a3ee32c3628432bb9bc04361b68b13e6e9feb5a78237e92f6fda449fd6af2c6b4d49be8369d74146c70aa7ef19a028465d1dfdf38b97dde0de03e20929c45f40bee6c991fa6f487d4fae34ff6b30fd26b7ad78571d52fb26b82be25e6f2bce4efcade89d6cdb53b448f3c536c179c76d52f3a4f3967d03e4cbd4a0e00a1f009c9e9b3e6005893b8b6eebade2585795e3c0443365b2fbaff65b3e70c6a17f16dbddf21b35263fc6282d7f20224e88818b9436c0191c42fbdecf212d9e589ea8db650f6dccfb8b9aed2fb093c86a9cbb771bc80bdf8005e75290a2f7e45db3cd2f168df474abe9cf3fca2fb8ce8399b4d95bfa3f4875cfae323f62d1b8edb2fde06aeedeb8f1022198adaa7a79e471c1f731ebd8ba041486877a15f9cb7abfd62b9372e0d5ece0e3d969176312d89f741d6d0d9ca816af9ee11870d8a5344f0b30491e186b5510f0bef2272ebb16763ebfa3e5a876fbb36b2f13c740add85de1af806107b12fddb9e165c0cb1365f3983f369d26fca2e2f10524898fb694bea17104058d1e2cd0f36cfd733b728ad5f80027248670d59c71bb27e71e52caa0021623b929fba9cf293080e2c5ac3d77255bf585cac4a0bbe1f3f8cd21fd2e88f0fd8790ccbbb942657c57923e32c6a672226239aeb443d633a3bc8f953c33a37761230c44b7f05f36a5bbc8be35650ace8bba9abb2f9d83400b9eb154398a83ef34178ac5d085bc045526eb0e2668e35a73a2c5a0e10bc5ea187384feb5ceb390d9c9b588d74cfcd7e746daf895e2e3a3ecb20042c1fdd8f97e80f7c37d32ecc4d3214fd0092baffbfa0fc7053563fc12b31eb9b73036028bb9f20ed7e44927116cd9106a15d0e91e0b5d8e74cfba0e3c30110ed15848ec6a3f9eb229b71061795839c5be98f9fa4577f7cc411f35acbe3877cf09201bcf1d137e056e456a523346e5780162284e706a203336436e934968e075f6c6da2d81c1b014c9bf6f9f426a480ae30f31bc71d137d861071c02f322cff20f6290e3d82a14643d7730988cd6b2252f437dad7ad5f17b59939ec0c5bf75fadc79e42912a5cf08d05d5709e15b50e3a42b4d36a50fe054e235bfc113d0ea36dfb8bec04a53fde23f5fa2345ba6834e3cf656e6878efda1092a6f00109b14c47bdca8fa1e2dd5a2105ec8c00e79a6e91ccc5ea7ad7eb28c5576554ef2c8be814b4f61da257149b0bf4506a8bc3043db4efc26e19aef32fc5412745fb5a7645d7f419ce0dab15dd0f164d6a6c859f39077d809ee306c5eb145d8a42f1e5b131eb9b258ab96933a01a5e7609fa564dfc2565315596e82de31200edd19c7badd935bea07f6c5bfc7d94fef81ba9d31f296e2f6d97f5d9e49670d3ae8748905e2384788648a12a6473728431512b7a1659dfb4b12b7c6e55f4282ca1b22ad6ce8fb2ee537c41dcf17521cc305bd4c8a220da135faca7d99d9d8347f2565a4040b527bfe5172b0f889e234761caf2b80a613b5b9c8573e43aa9731dd1beb3716e0e004c2262ead0302d301f7bcbe0d24c99ae5728146f58fbd5575a70e3a2c7900bd52b6c5f21b9073162782b3aa91bcdb78be8f76e95e0dc2f4959074ce98f8f49ac3f08abcf1af3e8ce9aec9e7997bbdeec96615ffa2df6338049f55965560e19c2d025e8b13e80894e40011b3aa2aff723d1a54276c4bb778e3f737bc4efc5e83fa2b63f8ffe916623dbdbf24d82a2f12dbd8710898e8c8005004125400e5a0e3c34353b3d35a9148a57069a1332af85d0aa12f4f5197a48ce01e0b55db6f06152f1fe476b5b2a728dc584297a353873f410b3a0234c32d1df6b16f75a193dbdfb400a85e28bf36abb037a199cebb4ec21c2b202886c805801d7a31a92c83ec07308116db14f2cf6f684d1f356ff47fade4286a06508a2d313fa3eb2cd7a39c0b0d2b51a6123fb18a7beb69c28fd912e2ca372613666703b58efcb375b97f5c679debe76ddffe7dbf76f4fb72ffdf1f4db3fffff377e177cfbe7dbb7efdf6ab70ffde8a7fffdeffbb76038bdbfedfca7fbf8000fc3bb97c7b0bfeadf43e7b67bd71ff72078b91d0fbbb7ffc0ed64d29f4e20ec77a0ffd0e9f7e069d6b91f766138eef517ff194c1feea3330cc7fe63f4ffbdfef476783f897e1cafcff5e673dfbf4d86abfeb77f726756fefbb787c75effdb3f7aae207efcef74283e7e96c95aff277bf67fb2b98bb3ec3fd9fc3fb9b3ffe40a56d63cb34c43cb64ffc964be7dff369cfcb7377cf9f68f7f7b3fe97fff36598a33d2fefcdb3f05dd2858dfbfb9e3c7e84486a9e732dfbfd5ee87e3bb6fff64bf7fab8ab3e6726719fdfbb7d6b0f7ed9f6c2693f9feadf4ebc7f67ffffb74dbcb7cfb27f3fd5ba3171d33f3fd5bf3cdc8c9fdddfa42f48c55887e7decde4dbefd637eff86d3e143349266bffbed9f6cde289c15cc6ceeecfbb7da24fa979f17f2bfefdfaaf13f6ac7ff68fbbfff9d8d67937eefdb3fff6fe67be67be6fffb5ff4ac07fd177183a27bf60d068f0f7db8edbd0c6fc7c05f1e47fdee74f2c13bf0fa0a7cfbfecd7d787a7c99f2dbe960fd3a551f7bb3fbbe78c3d6ff16eb9d8abe783b1c7ffb67fa32eb7fdf7050dfbe7f2b3d561f7b9b5c4ff0f89f87c79e38c265ff65327c1c7ffbe75bf63fd9dcb7fffd1491d76bfe5f2446d1a5fd10a2b857f87a9838e2f3bfefdf7ab7d3db6fff7ce376915d646aadab4b52744b834ce72ad4ecd1e2b157ca4edcd262d079e85aeec3e5cc1de9e7d1bfdf5c15ef78f018b83606bc94bdef9558707d361874c7b5fb1ecd0c7bedc643e52218bab6a9d9438c3e67f64af7994ea9f5e377f1bfcb76edbe3b24e5ce43e3be8fafc7b3adcc6dbb31b9b9d0cfc5e7e8627ad36e0cdc527ed0b96a59ee43e3be7b565bdeb649e6f6ca9ab94332ea9ce5333757f90c6f969dee4371767b551bb8439cff3ec6ce43717a7391195eb72f33b7256bf9db7707374ef9e9fa2a1fde2edda0b2d2cf7f8cbd53ba9fdd2ec9f2e62ab4dc712dd3bf5adc77cf06f7376d6fe89606cb9bab6bcb1d5aa36eae3aef958a4f1da7f7d479f066d7b9daea751c66e56c707f7de56976f07fd9bbd2265571f5ff814edd191669e554fd5f34d86218e4b4a82c79734bc04634d8de1117a8badffd5f09bb824b8f67ebeb8bae39a341922779b6dfb3e4bd6305fc72361216b6d48b9d98f2adc009212bcf6723ea30e83efbc5bcb5b5eb17e31c916a81bec029e39708ef4546473cc7a941af80a46e2c538d5f47f2bb327e618084b678ee7044fbb6c42ff0ba1c565ebc8ee4b56d383bfc4e3b400b6bc4c56ee0f840ea6df0fe159f0b1b9b7177655aa67b829ff72ce63077d8010f022f1c8c2d6f6a709ba9c121c5903736a3fe0d7c217602fc3d0a61c4afb2bd31590dcdfac3ad23712be00b73bb3fe441e04696c1ad2de6a5726e14465bbb8b622fa08498d23a59650c7683480867223d774d6d774c97a3f13e8c68762a210a8e84c866f52d94f42590684e195b9e2be92d57a4a299b4f72beb257f82ee307ae444d5f39ad063bfc3efc9f74de49753d3c27b5dbb8f6e44454a3cf06cd30bf159b42350f045f7809f2dd136dfc3c096f41690e0c6667a9462a8c85e69c8f14185a75ea5176fda97115c50bec2ea3134e5586138e446a0f49bf24a31dcc83297e1207ede0369be73033dfa56fa5ced3eef419fec5376365b83eeb307037eeb8ab46f338765721681e7f4bd50150b7a2a2638a1df38d0d9ca3c457e5e1a93eff3113dffaaa5c9ca09077e270612dd528c1eed4a1dffe87c1ed1505e29a6fb3e353504faeabb65683b37a243289e9c819a7dc7b2abe7dbd2a4f4fe842eb6a407ae28b08a01695bda7b56c04750d223c55077b674983b7d151d9dc7f21ca9a9a4cf61b7e1fbecddfdeafe36fe96589213fd25de8ffd4ca41820257b0acd65684b6835ed6bc8618747fba8ef1d498f2ca617c3914041734e29a61a96f61fcd46f4d232e5bf8124ef1c2c837dda2ffd7be5043aa718873564e654e39a8fff7ae47c6e2d834697d7b8dfb992f77969f1a2879681360f3a103d7f712cb113cef34e32aeafbd97e4ff7e36a228d0175a58dea77a17af633335dc7757d2635714026c132886bcb3a5a1e79ac21e9af2c2325594addd61a85457646bd4e64eb029f13f28fdbb4ce7619d7ca9fd1b1a2ab16fee711e30ad9c4639849f4f6d93fadfe8b892bc83fde5f977f4b5a86c7fd82c44ce628df56de51cc01add50ffce6149de623d334cf48c2ff8362b2fa10942abf4efca3e8ae08b65684b97411b9bacaf716d157e81cdfc72bff32df59610db87d2e119db64967178b7599552826b65e02fb49697de124a28b69996a7635a4b686e9b83ab78f782decbced4b29977efc76b659b091cdb49a686edd2d8917a11f6138e6d2568e85b653cd90fc45446e69f39f8b34496982ab20d94c8d5ecf9d4867099f9da95261edea3c188ec515cec4bf57c43a315aae38abd96ed1f856d6b8715e64ea06fddb25db3e07a5042d491bd75eeeccc2f9e2ff227bc3b811ebc8ec0025c49e7ebe92a6f5c6392ac3595b95670a0e188624b36680c0d7a6d05fc068a42266f523d95d97ac45722726310e5b42c9d97d27b6ea1e9b5e70ac135349d8ec2bc5cc1b39779fb8c1cef28ace6db8cc61def317ec632e4c29f2efb27d7f9587b65ec25e7730c32bdb7c07b7622cb5f0e3bc8743cdbe82de148f0672301fbd9311cd16b8be1b74e24044ec0874dfecab4af514e7ff0a4443cebb2ce160687b92ba185cdb4b616c3870a0323684cc229b6af7d7e8fe7e7487ce4f63af42006b4da05fe11fdbeb8accb2a01dc58a646e6a004eace1eef77d6b0ec2f9cf81de4dfc44f1fb7fe7a15798285bc7afff71d01ce7016acd1349c9dc735f35119aa99808109aac9d0ad76abd3a2b9760db849ff8b7e1a33ec5796fd4a737f7458aef3c452add609b899c0638dd826d7e218ea29c7365b19b6493f7578f603d86606665e8b6db27c8be5782e4321f375d4609b67861e639b67867e0f6cf318d4bc1ac0fcbcc8678067787fdc33e7971cee5c6d11faee4cfc279a46efdbf03c2fa7633ec0c90c3da6db5fb9f6578ec79cccd32cc7d01fe2e476cec9cc2fccc92d96e35a6dbe343459720d279f19fae0e4cfc0c9196bfd1486fed39e6e667f84c1fa42f8b1189671374de143792efa782d5b5f8a3e722d9ebf67f43199f9f57c7d86037f1fbe7e441fbf23ef96b8a812855cda8c4acd4c015d8a42be9610ab7311c8348290471f676c484183dedb528f82a3679f20f022f09415cd1f451e3bb6a153d64820e8f4eb087b8eeac209d0de95d0cef68187bd0957d2b7f87d5a1e31039539dd10714ca245ddc3666a707f83be8cec2ee53bacc6d9921e4f251db92228223b8c1a3b11f6ecd0c665d0168adcc261078937d63d6c6d369d6beed91c62b78f2a5ea012a05831d6b1cd709bd94868973d2728a1d8910ef3d9f8ddb3037e0b47cff329a61b2b5396c151f9d83e957b4ec95c9308efabc857e6803da8346afcd76b8226d647a3b0279e78b8e7bcd052841485302a90aea9481d545f58dbc1616d0793d00a0e3b8bd9f8a0af46568ce9568d442a2b958111f02ce380f723ff1c48c493ad44226f8bb0821d1eaf305954b3f79678cedcdeae8db42611e7636ffc247228a10d1c096b27a23820a15831737462ed44f91cbe3478f2314c51e532c2f72abe84ca4890f03ba6a21042538d2dc34540a4c2c2bb17da335643b629604f1a01510e92f109ef14d1cbfd5c19519e161ce60ee379b6a1c70ed35bc1d173a88c4fde29da8cc65d780f413580ffbc7d33a98bcf97918d4a14f62482f81c7e13853d89b8fb1972771251ed4c8be85bc7e97203120d97e83994269eb55a7aae34df394c8a48ac061e5cc93b7bf4dcb146193a26bfbb7d6defc4ef3b85755937e230dda804c1e0163643ede04a461942a2b0c2dc6155c267663c89078b0935f041338a316e7514f6b9ba5e3fa5cff8105bacbc76fac32ceaff1f27e2d3cfb45809d63158e0e7733e257bf42af21992d45198540e7bef3f00f9f873bb495476b35d4546dcec3031ffa2f931457d659fbe32ed3f98279a6af114ff21e883a67f0b87e9a94d3374a79d0dcd975c97d6d53cf4e1307d0a878930cd0f7697f03bfffcb7131eece9df57f84b959179c2264f5dccd7a4c734fd956e7da5a90ff849d413d7ba6396269eee0d499a14c7331c95f11d55c7c5a7436a92327986cfb9b74375288a6dd19d875bf43bb945a7dc52f84533365c4203627bd603814acd2479eef832ff365cb76791b6b44c6d0ebaef3c1039fe6df87332ce7e6466599e71b90adbaea922070973579af36f23f0652ca1d8ed12bba8920568d5675bc6f8bd538967a0099e4a9f53f8f322c3a3c72963af88e6a69921c417f149c496027d79e71adc121ac3d0f10b3f6dc686b4c3e8d104db7c2f1c72599a070beedb4954b029837015b6a1c12db1edfb83ecaf3ffffd86a69bf93532bb343013d96d6c085d10d94f6386faca725fa9ce1fed27bed3a13b1dee43c29be5ee27bcc9c46f10de1cd3e2a94e2676f375d409f1e6a127c2bc79e84398ff66c2bcc41c4db21cfb73ea3bc0fef32a6ccbd2616745cf4fafa3e7ed58e21798e7676c18590687fde76de253cf33b9efc3f8d9fbb6a0bc29963ba6e7294b75674b7c04ba02ff66e2df83ab81487eaf664c2f1d23af06233c465893ac66f1793b64f88dcd026fb0a2f919bb59c2c03924faa5660c4bf3e2e25cb66d9abdc1e85b57d2a344ae0f3d5b729e12d99ee1652799ee11c952cce6d8a77920210a1a745ce8a12c2b315d2b19734096a1adedc00913dc4ad8c0785de88f34531706073413c9773956947c063c27e03704b3330ec859155944af7d756f19add23ae106d3d409781ad3058a74e01a874d65de26cd6734c868f96652475985422bd1752403285dd33c0612dcd9ab619866b8848e4fc560c1b56711379999027a3329ecbbe3391518052bcced121d6b3265f0ef44d99cd20c66a2e7f34cea55d85696740c23a103446d633303721e2de3b09e053aff36deefc87b8a6cedb932a26337285704c0b52ded438bd5a3149fda39526f3135354e193b3490885e6795f1d007fd79684b5c9c6125dffcce8ee01d2b2756023e8251271a749ff70a9b60756e80904bc9bb5997f207e2f31e7441f2b768751cac674561f93a92d5c1186cbf8d27de60fcbc55e367d6165beca0eb84cac2d9abd4be05bac3ed201ec6a06b6dd5b1c780ee64abc61316745ff6cac28bbe89cf8781b40f55b115a95de025ff5507f87375d462d4b1bb51c516a78e284a592ce9990f76af0b4c1bb852465415935964fa7ddd9e31fa16f631dfd47cf643324c3209f5fe7770959acfc7655a9ee3e8cb6a9ec36abe457d659efe68b53a749befb4988fa8798e6edf4fcd27336fd0f3f4538da26fb19d0ef394a9e47c25758abe79e889a26f1efa50f4bf9ba2cfd9a351cfef9c80f2b1dcc73a80f82c7ea2e735a94759238e541665df5b19469ff8742fd8bf5396bd05893badb03ec1ba05cb553e72a2caeff49c95bc73562aff3612b08d806537b11194e56167b1d9b36aec0456fab93a9e2ce16d7ee37186a144d6774db50df6955ab90d60808a6f077c616e312a7288bd50c4f2745d934835a194d280f855059d14a4cd9dc0452ed6ffbe1042537bb799210f90f03aa16bb3803b5383dbbbe6308fcf990c8d5c69be8322f0dc3edae3cfa6125a4249df025f602ce340e3cf2aef5da6cfe0f716d993c55f41ebadc5aa115cc235340ec4973b197be283d33ed68db519bbddc3c63264648b659f96c4b98e7c56a7c84a36d5f0d89eb0b01de00b81cd021eac8a4a1cb048ce63429bfdce967a3e34f6b599a9af12da3a7d9d2ac798a6526f69b38e67611bc7e861fb750147f4d6663854ca6c2ef6bdef8470a56fec241e1325f6d0a06e2d1b68f49653835b29a69664aa4b7067b17afc2de069574aecb8d4ee0b6c46a59d789dcd27b4599d4a700de04d8d6175cd7e1ee7a5f3b58f80e74887b9c5e8ef3673584213f06049af6dbfe5a9a2b0984a786d2a054d393e3e23098f97e888ed40bf534bc3191bb253537b07a28612fe1d6c49656cc0cd6d43cf79b3e6b9cc375842538e6c568e1554aca91c2f3bddb7c3dc0af44db16f6e4afbb4c225d09729cdd398a2f365c66eb6a0bff7123f851bd88c3ab725f484d7f62af2c9996ccaeaef1738464d267961ff9cf0c5512560650d555995c893fa6ab9d3f5cae9ba8aac7d824dc50536e53054159392304f021f9f396741f90e7e2febee9c60e8bf2212b7636d565e5ea0452e7b8a5864262385b9d3179065ca733b509162703bac3fb26cf512c6e4b951ea6b9470b241dd5a4afe909b5445e0352d5c73f054f043c69b292fe5f3a177aea9918a5ee00b6bd8adae39afc85e156b07be40f2375e4732c9ce76193ec232b59e8f9e5b4052dff17a2d169ffd810ffa706ef775f43a027e1a0f3f3d2bacbb7625cf2b742037ccdf557786f2e7305d5d54e6b349be1ead91cf920a702203f33d93d984eea98c8aa0314ce99d543ecb31f6d35ade9bf8bcc4e78ce85fa6b7999aebc46f6392f3d854913163436437cde90c2f1d571e97d770940b80e57f53a5cfc97a4190567457715bb68493c6f6a88a8f627e1cf8009fb7e8752423dbe8ad6d117cd10c7a3e35f6e769d03daced9540bbe52a26663e8712cf02a9b777247dee04bdadc5e4f23eafee28fcf1a232b784d5c6756bb0257d0e312f4434955490e5fa23e7818c1f33fec9e6a318b9eef1818496a5b5e6f6054cd6ec83bec639d224ab202e9d7719db6b44f728cba412f4cda46ee1811e79e78de73fd98bc3e3ecff90b34f2f6c860ba6a61c5aa6b6b69996ff9aee3590d49d1dc0b513d0f3c4c6fdc4765790ac9954c997d7ec9ff04b6831fc160628b28d5ed6ed636f4bbd0534e63b5bd210d6c38dd55867786b78f4bb1fe2b597a3f9af3ec47bddea99e01ebcf87d7971353521b27d3af375fac9ff0bdf88fdff896d37c820061aad30f36d725e3bfa3cf37f137a02cf0d7a1b97d879615bee63ff026c35f20cf72df5336ee19d84decb0ff8364b77ed06fa769cfa538d9592a7fef12ad5ffa92f2c23289233b32258b7df8c739f93c167fd96f3bc735c71599efbcfc501027a0d256d83cf15de5b27025fc6065a42e98092ce0c7afc9979c44ed7aa18c95a4bb8403835b4a52d4d123e310757da72293d27c9efddc82b6af24e9df099c3dea65fb27d4bf845bd8b5e79e893137d125806bdb6fbcb4c7e7ed1825e38350e5cdac9e433fb39c76b2f744a4a83848f2657f20a9cdb06dacc26a96e18815d2dcdfa61db65103515cbbae1e8d99bf4ca75cf7e2e3e69e8c2d20fdb53c3f2ec80a78098ed0b8ac781ce36e0891d30a242d053e76e30f1b2b8e7c9bc8eb13a73483a92b86223afd6f28685e57c5f9edb2b756f9932b257c34afe56da25822ef1af8fcfd9bdbb7194df53bc3fed2a71dcd9a2d06b253e50692ba0d715fb0ae97b48ea921a30cc6ad786a3ef483d84ee48da1ccfbf691fc8b9eaab4dd8f0632fefb69784860d765b35d7efe83b523f33617acc71cd0cb8c6ee6cf8ed57910f6c560eeb3aee9564cd8fcb3d98cfa6eeecaac4f0f2c8bc96b6fdf49d13c33996bf63016dfba6328ffb2586b3145594755014f344b51edd7a7fc31483320f342519ccb123844a89e14931eb284910c805fe5d5b69160e9b8d1db2ccc11ecb2dfb24102714733a72de9bda11fd14c1e487b3ab92a28a71b950ea742e2745b16386f9daea7ca5d83f38a6f3d4a6d927fe43f289b9a77c2233bfa5bd789b7dc28f24a2255f486d7bf1c6a1a702ab71e84360fd6602abe08e2671852868dc3b27ea1fe63535d6c37c309fe957c4f73e9ce773923f518be55d83db7d1b3fef33bccdcdf35c260db8589a4795e2ce17f362ba67d6f10be79264f1e12c1e7809633dc5b16ab1a26b70216ed07d3e8e5bd7e32ec47fcc739bcec69bcfcdff17c6f2f21c85f14fe489ec2c5cc30f477198f2fe9c60c795e7527cee5c2ce5637b887d531400493f00519b5b2c89736fc706e97fb204a2fca24d0e3dfd65e2d7611033365c435fd8daecd0cbe63a32d56fd074cfe272c7e786601184c7b23abdf35dd453fce4051a07740e3f29f6fe38e735ab99049e65a80b680a946290dca6189a431e207da2f9548be4b8263429c7d3bc375128ea047beac6355cfe6d043c3ba5dbeb481e8e697572b133ef999cd3b3cf15f84c16ef78cbde5dcae97ac7670a1aad327e9befe7a5eeb235390b3e8c4ebaac963b4727786aa90b6c292ff16ef84ff29ba4836ffeeeecbb637caac0dc8b38a9c3eabe6d20aa9c033a346504fa68e78e84d82dfecd5a0443d27640d2902de9141c096bb7db394f3bacb7023eb26bb1a082070876f792fd6e1d76d48c1bd5e0cde51b197eae4b86fc4d788d4b568ccb5c3286a7afbaf1e94e2ed91dcb5193993f5cb2874b762f97ace08e2697ecb076d861ee9211f591ba64435a1d69ab9a52cf26f4e80e17b0bcf6b5b9151c50495da426d301c1552945a06862bfb099c3c63285b81242a9bf68a5ee62958e2b798d970e9c5e64a05247ae168dcd3f2750e70e33299b9c456378731942a9174da3eb2e313831419a2f4ce928acf67e02fbfff2975f08891bf17cdab4fce4728fdfe04213624e0f3fc75a1a2e20393d67ddfdaec24b75178a740f54d64cbd628e14692b7f4373b9c942ce1598272969898f4c58ccbf69194fe1d64c98030d59520656b404acb6f7289ff7e34bd4becbc5194de54035745dd6f0ef9d2fc010ead2ccff3a36376b2e22f8c717b2d496c75d7663a524c5047dab2d69fa5173ed1ee6e5b63397dcb64f73d1c58233a686ce365c1e526e73989f2bd0df7ba5b63ce4f2913793f26f70fb304f21dbaf84feb3cfee4c97ca052eb7b87f5f34435bd7b41aba1e4ee81e4848be4497b513d1ff71187e6bf797f9658ab796c5267c23740039a3f2d032b5f7925cbca63cf65618e1c3e5b4da8b3ed074add704f19da4925ee2a724b5b77c6e4a29f189ade6307a384ded9b4c37a463b38b21495bd0ac1c224f4f2d647e014bdcc24b058c12bb861c4ecd4a1a5c76567c200a3b3b50375343fdfb752447d0d4682768797929c4c85b1dc30baf91b3aea3614ddac70d70417e8e653b40540159348c95b85d76316b4338e3e4bca7ad57c819b6187def8a3429ab75189d7a1dc9bafe6285df4402a9115be48c9c2173d4247d034d0d25f2263d835dca9fbcf063bd01ce4f75e2c261e54009f09987d2d4d037cda5847529ce856d7c29ada904371da7a3df4fae95f8e08694a6b2ccb9eaac36a63735c055c76da13f7cde6af4fee9e54835701779ae72991db6bb889f995dfa9386887e2ee4b5f1bd95bfba06f42a8fcc612faad5ba087b75c614f395e5bf52f41fec53bbf3c452d4c760af367b47d88bccfc06d8eb8965e9165d5c499e2ea40ef66a1e7a027b350f7dc05ebf19ec55e68f06e06ba5ae21c3ad6d5fcefaa63439a1d9f7590c27cd35d0e6aef4b2cd1ce6cc81c9f2cbab390b696d47de8be5a4e75b33f876c30dd8c70e1111c8473d30f3f8489edf4f2f13a327e9b3af26b903d59cd7db14751effd3b1b26174ea6ac5fcf17854e5bd59dc11bfb72eee5fd09a2306801620644b44d8ff723902e55c89d7529f8fcf5c8f555e73d16fa2d2b7e5a41e786ca0ade23f53cdbd254a3527d57e29b7d4669d0373ea00ae9b7abb3c6a7def92cf51ee41f2a5e8f531fccc355995be2b99435ee9db92d72d54fab6d4f211e87ac9bd3a416fe1e2b31f956a1cfc1beab34afd711a7311fa611bd31532931b7bbbdc54f3eba53974bf7eed6f43ce4aea240d6cc65ddb7e538d48434e4c5fa01af30d3f96a391d6e4946f404ec1c6fb822ee5f7dc2d4f63c2a095ed0bb2dd48eb8b355767f7a1e1f98ec290fca19afedf3fa917283693b7eb6b1dce6c647e5103b981e082c379d50d2c971d4e9a6bddd1e14c667e83c3d97c59caef7305cbc3e1fcce0e67c61f671d4eda111f0ee7c3e17c389c0f87f3e1703e1cce87c3f97038ffd79a4da5cd0f176931d1b9e2ad4fd2f4b0d4502a6f127a55c3b5ac51e74d4d711a32bcce9fff0b8d441f67ff9e67bfa60966d620d73b6e7ef999edae330d3f8ff8e5b4892ae80b1bcb50d154e237783daf23e0379ddd33bc75da88f303bc56d358f476debbbab1e827e5c5f3c0e7d832e1d1e5d467f99a4ab23f878dbed38732cd7ef5cccdf3193ce3a9e16e414fdf838f379b3ab70fe5e2b1d2de9c6606fdcc8b0e67ae7f4d5d59312c873b3b97abcafe61fba10e75478cb3735b29d91ddb0fd1c555862d8ae579e6712fedef0769160c50e099a90c00d8464e64c0ba3d63340403acf79f7d52712566bd8864042544992cd1b73ee8ef930b898e2e1129cb80d3df7fffebf4d2ffea3b4985c9a8f162dcec79ace7890c768dde0690cb7493e0cd8c0d2968d0d82fa5c8efb15acb0a7a11f093fe49979e171749f3b611f1aff5289f63b517930f0d6dedf6f804f3257a18d3e1480fe335b01ab24d6163613d21caef9816847e62a98f52ed3bf3b54a24e3369b47f5f7926cdceada4ae3dfcb998f69b630c99c4cb2d97f6070ca5fb9b3c31582ba34ae90d497e352ff5052d37795d4b705a3ee28a9993cf8d46951ad569b79348afbfd247589033e2caa8958321984cdc8d659f154dc63598820b12a0a874424e7e2b5546c4c44899fdc71bb5942d2f3a96855973fcff05be262f7f5b92d0abe658244fcacc2b62be9a1231d48bfd3e25e74e029ab624e8dcf7b9948cbdae66573dc2c5d0951b63421a1b614fa7f4befcf3dabbaaaf3c97e1778725f5bbbd2011dab357832175280ddb0bea4383b5565a7e3bdd3fd28d3fec7896a3f7467e82a615d1a5988ebcb7d3defd4ae81e688717a37c17d5b87cf47b7868720bf9a8f1ea2fc3ea25c7db759f72da98f04de9b9864401c452d4fbc80696659fbcfe9efe925115c7d6736578d643fd47b15535343f6a8bab6f2f81a2fa8e4f1fcb81aa4d56c7f8518cf471522fc7213d47f6471d31c75c73e3bec8d9d4fbf87c5dd79ea501c45d34f0f41fddb09eafcfc7f58486fa17995503a72c549838d23015872cfb11091f480845844d927026495d8a932b923e0254925c39fe5cfab3b9b14b26ab465ec3d027dd443070bb73fc702f8c81e6d7afebddeee252198c4364e609aab69f15d057492baf6eb0be8cdfcfd1a095d0cbbc5cafe87a00896c53fcbb6bea3882e6c692ca29fb8f64344ff7e22ba60808721fd30a47f829cbeb696bfae949fe5e91f55c94f73ec3d0b2bf0c41f85fc0f297e2f295e5bc7ff2139ce66f5fefff3f6f62df4f8aeb2dc61d0ca0ed0f67708475e5d2a575b2977053c72a742398667ef8a70df06943ceae41ef2fc5a2efab03c8fa786bb35597dffb0c9890c7f73cde7ef1ca34cee3da85d5b5ed6f8fd6cf284a3671f67e9635ebe9a6f3f2fc3077886fffdefff030000ffff010000ffff634b67d88f861a00`)))
@@ -0,0 +1,323 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/sessions"
+	svc "gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	SAMLPostTmpl = "samlpost.tmpl"
+)
+
+const (
+	// SAMLSessionName is the cookie store session that binds an ongoing SAML
+	// sign-in to the browser. Identity providers post responses cross-site,
+	// so unlike the main one it is sent along with cross-site requests.
+	SAMLSessionName = "saml"
+	// SAML session keys
+	SAMLProviderKey  = "saml-provider"
+	SAMLRequestIDKey = "saml-request-id"
+	SAMLLinkKey      = "saml-link"
+	// SAMLLinkTokenKey keeps the session token of the user linking
+	// the provider, the main session is not available on the post back.
+	SAMLLinkTokenKey = "saml-link-token"
+	// SAMLReturnKey is the cookie store key for the identity provider
+	// request to resume once the user signs in.
+	SAMLReturnKey = "saml-return"
+	// Age limit of an ongoing SAML sign-in.
+	samlSessionMaxAge = 600
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	SAMLSignInRequiredInfoID = "saml_sign_in_required_info_msg"
+	// Error
+	SAMLAssertErrID = "saml_assert_err_msg"
+)
+
+type (
+	// samlPost is rendered as a form the user agent posts to Action.
+	samlPost struct {
+		Action       web.Action
+		SAMLResponse string
+		RelayState   string
+	}
+)
+
+// SAMLAuth web endpoint.
+// Sends the user to the identity provider, the request ID is kept in the
+// SAML session until the response is posted back to SAMLACS.
+// With 'link=true' a signed in user adds the provider to its account.
+func (ep *Endpoint) SAMLAuth(w http.ResponseWriter, r *http.Request) {
+	var req tp.SAMLAuthReq
+	var res tp.SAMLAuthRes
+
+	provider, err := ep.getProvider(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	req = tp.SAMLAuthReq{
+		Provider: provider,
+		Link:     r.URL.Query().Get("link") == "true",
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SAMLAuthRequest(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	s := ep.samlSession(r)
+	s.Values[SAMLProviderKey] = provider
+	s.Values[SAMLRequestIDKey] = res.RequestID
+	s.Values[SAMLLinkKey] = req.Link
+	if req.Link {
+		token, _ := ep.GetSession(r).Values[SessionTokenKey].(string)
+		s.Values[SAMLLinkTokenKey] = token
+	}
+
+	err = s.Save(r, w)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	http.Redirect(w, r, res.URL, http.StatusFound)
+}
+
+// SAMLACS web endpoint, the assertion consumer service.
+// Reached when the identity provider posts the response back.
+// It is exempt from CSRF protection, the response is bound to this
+// browser through the request ID kept in the SAML session instead.
+func (ep *Endpoint) SAMLACS(w http.ResponseWriter, r *http.Request) {
+	var req tp.SAMLSignInReq
+	var res tp.FederatedSignInRes
+
+	provider, err := ep.getProvider(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	// Request ID is single use
+	s := ep.samlSession(r)
+	expected, _ := s.Values[SAMLProviderKey].(string)
+	requestID, _ := s.Values[SAMLRequestIDKey].(string)
+	link, _ := s.Values[SAMLLinkKey].(bool)
+	token, _ := s.Values[SAMLLinkTokenKey].(string)
+	s.Options.MaxAge = -1
+	s.Save(r, w)
+
+	if expected != provider {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, errors.New("unexpected provider"))
+		return
+	}
+
+	if link {
+		r, err = ep.withLinkingSession(r, token)
+		if err != nil {
+			ep.handleError(w, r, UserPathSignIn(), LinkIdentityErrID, err)
+			return
+		}
+	}
+
+	req = tp.SAMLSignInReq{
+		Provider:     provider,
+		SAMLResponse: r.PostFormValue("SAMLResponse"),
+		RequestID:    requestID,
+		Link:         link,
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SAMLSignIn(req, &res)
+
+	if link {
+		u := tp.User{Slug: currentUserSlug(r)}
+
+		// Main session cookie has not been sent, restore it.
+		serr := ep.storeSessionToken(w, r, token)
+		if serr != nil {
+			ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, serr)
+			return
+		}
+
+		if err != nil {
+			ep.handleError(w, r, UserPathSecurity(u), LinkIdentityErrID, err)
+			return
+		}
+
+		m := ep.localize(r, IdentityLinkedInfoID)
+		ep.RedirectWithFlash(w, r, UserPathSecurity(u), m, web.InfoMT)
+		return
+	}
+
+	if err == svc.ErrEmailTaken {
+		ep.handleError(w, r, UserPathSignIn(), FederatedEmailTakenErrID, err)
+		return
+	}
+
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), FederatedSignInErrID, err)
+		return
+	}
+
+	err = ep.storeSessionToken(w, r, res.SessionToken)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+		return
+	}
+
+	if res.VerificationRequired {
+		m := ep.localize(r, SignInVerificationWarnID)
+		ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.WarnMT)
+		return
+	}
+
+	msgID := LoggedInInfoID
+	if res.Provisioned {
+		msgID = ProvisionedInfoID
+	}
+
+	m := ep.localize(r, msgID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
+
+// SAMLMetadata web endpoint.
+// Serves the service provider metadata for the provider in the path
+// or, if none, the identity provider one.
+func (ep *Endpoint) SAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	var req tp.SAMLMetadataReq
+	var res tp.SAMLMetadataRes
+
+	req.Provider, _ = ep.getProvider(r)
+
+	// Service
+	err := ep.service.SAMLMetadata(req, &res)
+	if err != nil {
+		ep.Log().Error(err)
+		http.NotFound(w, r)
+		return
+	}
+
+	// Write response
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Write(res.Metadata)
+}
+
+// SAMLSSO web endpoint of the identity provider.
+// Receives service provider requests through the HTTP-Redirect binding
+// and answers with a form that posts the assertion to the service provider.
+// Users not signed in are sent to sign in and brought back afterwards.
+func (ep *Endpoint) SAMLSSO(w http.ResponseWriter, r *http.Request) {
+	var req tp.SAMLAssertReq
+	var res tp.SAMLAssertRes
+
+	if _, ok := tp.CurrentSessionFrom(r.Context()); !ok {
+		s := ep.GetSession(r)
+		s.Values[SAMLReturnKey] = r.URL.RequestURI()
+		err := s.Save(r, w)
+		if err != nil {
+			ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+			return
+		}
+
+		m := ep.localize(r, SAMLSignInRequiredInfoID)
+		ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.InfoMT)
+		return
+	}
+
+	q := r.URL.Query()
+	req = tp.SAMLAssertReq{
+		SAMLRequest: q.Get("SAMLRequest"),
+		RelayState:  q.Get("RelayState"),
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.SAMLAssert(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SAMLAssertErrID, err)
+		return
+	}
+
+	data := samlPost{
+		Action:       web.Action{Target: res.ACSURL, Method: "POST"},
+		SAMLResponse: res.SAMLResponse,
+		RelayState:   res.RelayState,
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, data, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, SAMLPostTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SAMLAssertErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SAMLAssertErrID, err)
+		return
+	}
+}
+
+// samlSession returns the session binding SAML sign-ins to the browser.
+// Browsers only send SameSite=None cookies over secure connections.
+func (ep *Endpoint) samlSession(r *http.Request) *sessions.Session {
+	s := ep.GetSession(r, SAMLSessionName)
+	s.Options = &sessions.Options{
+		Path:     UserPathSAML(),
+		MaxAge:   samlSessionMaxAge,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	}
+	return s
+}
+
+// withLinkingSession returns r carrying the session of the user
+// that started linking the provider.
+func (ep *Endpoint) withLinkingSession(r *http.Request, token string) (*http.Request, error) {
+	var res tp.ValidateSessionRes
+	err := ep.service.ValidateSession(tp.ValidateSessionReq{Token: token}, &res)
+	if err != nil {
+		return r, err
+	}
+
+	ctx := tp.WithCurrentSession(r.Context(), res.CurrentSession)
+	return r.WithContext(ctx), nil
+}
+
+// signedInPath returns the path to resume after signing in,
+// the pending identity provider request if any.
+func (ep *Endpoint) signedInPath(w http.ResponseWriter, r *http.Request) string {
+	s := ep.GetSession(r)
+	path, _ := s.Values[SAMLReturnKey].(string)
+	if path == "" {
+		return UserPath()
+	}
+
+	delete(s.Values, SAMLReturnKey)
+	s.Save(r, w)
+
+	// Only local paths
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return UserPath()
+	}
+
+	return path
+}
@@ -0,0 +1,223 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	SecurityTmpl = "security.tmpl"
+)
+
+const (
+	SessionCtxKey web.ContextKey = "session"
+	// SessionTokenKey is the cookie store key for the session token.
+	SessionTokenKey = "session-token"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	SessionRevokedInfoID  = "session_revoked_info_msg"
+	SessionsRevokedInfoID = "sessions_revoked_info_msg"
+	SignInVerifiedInfoID  = "sign_in_verified_info_msg"
+	// Warning
+	SignInVerificationWarnID = "sign_in_verification_warn_msg"
+	// Error
+	GetUserSecurityErrID = "get_user_security_err_msg"
+	RevokeSessionErrID   = "revoke_session_err_msg"
+	VerifySignInErrID    = "verify_sign_in_err_msg"
+)
+
+// SessionCtx middleware loads the signed in user session, if any,
+// into request context.
+func (ep *Endpoint) SessionCtx(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		s := ep.GetSession(r)
+
+		token, ok := s.Values[SessionTokenKey].(string)
+		if !ok || token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var res tp.ValidateSessionRes
+		err := ep.service.ValidateSession(tp.ValidateSessionReq{Token: token}, &res)
+		if err != nil {
+			// Expired or revoked: forget it.
+			// Admins whose impersonation ended get their own session back.
+			delete(s.Values, SessionTokenKey)
+			if admin, ok := s.Values[ImpersonatorTokenKey].(string); ok {
+				s.Values[SessionTokenKey] = admin
+				delete(s.Values, ImpersonatorTokenKey)
+			}
+			s.Save(r, w)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := tp.WithCurrentSession(r.Context(), res.CurrentSession)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// ShowUserSecurity web endpoint.
+func (ep *Endpoint) ShowUserSecurity(w http.ResponseWriter, r *http.Request) {
+	var req tp.GetUserSecurityReq
+	var res tp.GetUserSecurityRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetUserSecurityErrID, err)
+		return
+	}
+
+	req = tp.GetUserSecurityReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.GetUserSecurity(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetUserSecurityErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, SecurityTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetUserSecurityErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), GetUserSecurityErrID, err)
+		return
+	}
+}
+
+// RevokeSession web endpoint.
+func (ep *Endpoint) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeSessionReq
+	var res tp.RevokeSessionRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), RevokeSessionErrID, err)
+		return
+	}
+
+	sid, err := ep.getSessionID(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), RevokeSessionErrID, err)
+		return
+	}
+
+	req = tp.RevokeSessionReq{Identifier: id, SessionID: sid}
+	u := tp.User{Slug: id.Slug}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RevokeSession(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSecurity(u), RevokeSessionErrID, err)
+		return
+	}
+
+	m := ep.localize(r, SessionRevokedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSecurity(u), m, web.InfoMT)
+}
+
+// RevokeAllSessions web endpoint.
+func (ep *Endpoint) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	var req tp.RevokeAllSessionsReq
+	var res tp.RevokeAllSessionsRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), RevokeSessionErrID, err)
+		return
+	}
+
+	req = tp.RevokeAllSessionsReq{Identifier: id}
+	u := tp.User{Slug: id.Slug}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RevokeAllSessions(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSecurity(u), RevokeSessionErrID, err)
+		return
+	}
+
+	m := ep.localize(r, SessionsRevokedInfoID)
+	ep.RedirectWithFlash(w, r, UserPathSecurity(u), m, web.InfoMT)
+}
+
+// VerifySignIn web endpoint.
+func (ep *Endpoint) VerifySignIn(w http.ResponseWriter, r *http.Request) {
+	var req tp.VerifySignInReq
+	var res tp.VerifySignInRes
+
+	// Identifier
+	slug, err := ep.getUserSlug(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), VerifySignInErrID, err)
+		return
+	}
+
+	// Token
+	token, err := ep.getToken(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), VerifySignInErrID, err)
+		return
+	}
+
+	req = tp.VerifySignInReq{
+		Identifier: tp.Identifier{
+			Slug:  slug,
+			Token: token,
+		},
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.VerifySignIn(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), VerifySignInErrID, err)
+		return
+	}
+
+	m := ep.localize(r, SignInVerifiedInfoID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
+
+func (ep *Endpoint) getSessionID(r *http.Request) (id string, err error) {
+	ctx := r.Context()
+	id, ok := ctx.Value(SessionCtxKey).(string)
+	if !ok {
+		err := errors.New("no session provided")
+		return "", err
+	}
+
+	return id, nil
+}
+
+// storeSessionToken keeps the session token in the cookie store.
+func (ep *Endpoint) storeSessionToken(w http.ResponseWriter, r *http.Request, token string) error {
+	s := ep.GetSession(r)
+	s.Values[SessionTokenKey] = token
+	delete(s.Values, ImpersonatorTokenKey)
+	return s.Save(r, w)
+}
@@ -0,0 +1,39 @@
+package web
+
+import (
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// Highlight wraps case insensitive occurrences of query terms
+// found in text between mark tags.
+// Text is HTML escaped before being returned.
+func Highlight(text, query string) template.HTML {
+	terms := strings.Fields(query)
+	if len(terms) == 0 || text == "" {
+		return template.HTML(template.HTMLEscapeString(text))
+	}
+
+	for i, t := range terms {
+		terms[i] = regexp.QuoteMeta(t)
+	}
+
+	re, err := regexp.Compile("(?i)" + strings.Join(terms, "|"))
+	if err != nil {
+		return template.HTML(template.HTMLEscapeString(text))
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		sb.WriteString(template.HTMLEscapeString(text[last:loc[0]]))
+		sb.WriteString("<mark>")
+		sb.WriteString(template.HTMLEscapeString(text[loc[0]:loc[1]]))
+		sb.WriteString("</mark>")
+		last = loc[1]
+	}
+	sb.WriteString(template.HTMLEscapeString(text[last:]))
+
+	return template.HTML(sb.String())
+}
@@ -0,0 +1,169 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	svc "gitlab.com/mikrowezel/backend/granica/pkg/auth/service"
+	tp "gitlab.com/mikrowezel/backend/granica/pkg/auth/transport"
+	"gitlab.com/mikrowezel/backend/web"
+)
+
+const (
+	// Defined in 'assets/web/embed/i18n/xx.json'
+	TransferInitiatedInfoID        = "transfer_initiated_info_msg"
+	TransferAwaitingApprovalInfoID = "transfer_awaiting_approval_info_msg"
+	TransferCompletedInfoID        = "transfer_completed_info_msg"
+	TransferCancelledInfoID        = "transfer_cancelled_info_msg"
+	// Error
+	InitiateTransferErrID = "initiate_transfer_err_msg"
+	ConfirmTransferErrID  = "confirm_transfer_err_msg"
+	ApproveTransferErrID  = "approve_transfer_err_msg"
+	CancelTransferErrID   = "cancel_transfer_err_msg"
+	OwnsAccountsErrID     = "owns_accounts_err_msg"
+)
+
+// InitiateTransfer web endpoint.
+func (ep *Endpoint) InitiateTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.InitiateTransferReq
+	var res tp.InitiateTransferRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), InitiateTransferErrID, err)
+		return
+	}
+
+	a := tp.Account{Slug: id.Slug}
+
+	// Input data to request struct
+	err = ep.FormToModel(r, &req)
+	if err != nil {
+		ep.handleError(w, r, AccountPathSlug(a), CannotProcErrID, err)
+		return
+	}
+
+	req.Identifier = id
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.InitiateTransfer(req, &res)
+
+	// Input validation errors
+	// The form only has the new owner field, its error is shown as flash.
+	if es := res.Errors.FieldErrors("To"); len(es) > 0 {
+		ep.handleError(w, r, AccountPathSlug(a), es[0], err)
+		return
+	}
+
+	// Non validation errors
+	if err != nil {
+		ep.handleError(w, r, AccountPathSlug(a), impersonationErrID(err, InitiateTransferErrID), err)
+		return
+	}
+
+	m := ep.localize(r, TransferInitiatedInfoID)
+	ep.RedirectWithFlash(w, r, AccountPathSlug(a), m, web.InfoMT)
+}
+
+// ConfirmTransfer web endpoint.
+// Reached from the link sent to the new owner.
+func (ep *Endpoint) ConfirmTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.ConfirmTransferReq
+	var res tp.ConfirmTransferRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, "/", ConfirmTransferErrID, err)
+		return
+	}
+
+	// Token
+	token, err := ep.getToken(r)
+	if err != nil {
+		ep.handleError(w, r, "/", ConfirmTransferErrID, err)
+		return
+	}
+
+	id.Token = token
+	req = tp.ConfirmTransferReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.ConfirmTransfer(req, &res)
+	if err != nil {
+		ep.handleError(w, r, "/", ConfirmTransferErrID, err)
+		return
+	}
+
+	msgID := TransferCompletedInfoID
+	if res.CompletedAt == "" {
+		msgID = TransferAwaitingApprovalInfoID
+	}
+
+	m := ep.localize(r, msgID)
+	ep.RedirectWithFlash(w, r, "/", m, web.InfoMT)
+}
+
+// CancelTransfer web endpoint.
+func (ep *Endpoint) CancelTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.CancelTransferReq
+	var res tp.CancelTransferRes
+
+	// Identifier
+	id, err := ep.getAccountIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, AccountPath(), CancelTransferErrID, err)
+		return
+	}
+
+	a := tp.Account{Slug: id.Slug}
+	req = tp.CancelTransferReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.CancelTransfer(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AccountPathSlug(a), CancelTransferErrID, err)
+		return
+	}
+
+	m := ep.localize(r, TransferCancelledInfoID)
+	ep.RedirectWithFlash(w, r, AccountPathSlug(a), m, web.InfoMT)
+}
+
+// ApproveTransfer web endpoint.
+func (ep *Endpoint) ApproveTransfer(w http.ResponseWriter, r *http.Request) {
+	var req tp.ApproveTransferReq
+	var res tp.ApproveTransferRes
+
+	slug, err := ep.getAdminAccountSlug(r)
+	if err != nil {
+		ep.handleError(w, r, AdminPathAccounts(), ApproveTransferErrID, err)
+		return
+	}
+
+	req = tp.ApproveTransferReq{Identifier: tp.Identifier{Slug: slug}}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.ApproveTransfer(req, &res)
+	if err != nil {
+		ep.handleError(w, r, AdminPathAccounts(), ApproveTransferErrID, err)
+		return
+	}
+
+	m := ep.localize(r, TransferCompletedInfoID)
+	ep.RedirectWithFlash(w, r, AdminPathAccounts(), m, web.InfoMT)
+}
+
+// ownsAccountsErrID returns the message for err,
+// owners cannot be deleted before transferring their accounts.
+func ownsAccountsErrID(err error, msgID string) string {
+	if errors.Is(err, svc.ErrOwnsAccounts) {
+		return OwnsAccountsErrID
+	}
+	return msgID
+}
@@ -14,6 +14,11 @@ const (
 	userRes = "user"
 )
 
+const (
+	SearchTmpl  = "search.tmpl"
+	DeletedTmpl = "deleted.tmpl"
+)
+
 const (
 	UserCtxKey web.ContextKey = "user"
 	ConfCtxKey web.ContextKey = "conf"
@@ -21,18 +26,23 @@ const (
 
 const (
 	// Defined in 'assets/web/embed/i18n/xx.json'
-	UserCreatedInfoID = "user_created_info_msg"
-	UserUpdatedInfoID = "user_updated_info_msg"
-	UserDeletedInfoID = "user_deleted_info_msg"
-	SignedUpInfoID    = "signed_up_info_msg"
-	ConfirmedInfoID   = "confirmed_info_msg"
-	LoggedInInfoID    = "logged_in_info_msg"
+	UserCreatedInfoID  = "user_created_info_msg"
+	UserUpdatedInfoID  = "user_updated_info_msg"
+	UserDeletedInfoID  = "user_deleted_info_msg"
+	UserRestoredInfoID = "user_restored_info_msg"
+	SignedUpInfoID     = "signed_up_info_msg"
+	ConfirmedInfoID    = "confirmed_info_msg"
+	LoggedInInfoID     = "logged_in_info_msg"
+	// Warning
+	EmailUndeliverableWarnID = "email_undeliverable_warn_msg"
 	// Error
 	CreateUserErrID  = "create_user_err_msg"
 	IndexUsersErrID  = "get_all_users_err_msg"
+	SearchUsersErrID = "search_users_err_msg"
 	GetUserErrID     = "get_user_err_msg"
 	UpdateUserErrID  = "update_user_err_msg"
 	DeleteUserErrID  = "delete_user_err_msg"
+	RestoreUserErrID = "restore_user_err_msg"
 	CredentialsErrID = "credentials_err_msg"
 )
 
@@ -42,6 +52,7 @@ func (ep *Endpoint) IndexUsers(w http.ResponseWriter, r *http.Request) {
 	var res tp.IndexUsersRes
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err := ep.service.IndexUsers(req, &res)
 	if err != nil {
 		// Insted of custom IndexUsersErrID you could use
@@ -68,6 +79,44 @@ func (ep *Endpoint) IndexUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// SearchUsers web endpoint.
+func (ep *Endpoint) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.SearchUsersReq
+	var res tp.SearchUsersRes
+
+	// Input data to request struct
+	err := ep.FormToModel(r, &req.Search)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), CannotProcErrID, err)
+		return
+	}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.SearchUsers(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SearchUsersErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, SearchTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SearchUsersErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), SearchUsersErrID, err)
+		return
+	}
+}
+
 func (ep *Endpoint) NewUser(w http.ResponseWriter, r *http.Request) {
 	// Req & Res
 	res := &tp.CreateUserRes{}
@@ -106,6 +155,7 @@ func (ep *Endpoint) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.CreateUser(req, &res)
 
 	// Input validation errors
@@ -136,9 +186,10 @@ func (ep *Endpoint) ShowUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req = tp.GetUserReq{id}
+	req = tp.GetUserReq{Identifier: id}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.GetUser(req, &res)
 	if err != nil {
 		ep.handleError(w, r, UserPath(), GetUserErrID, err)
@@ -176,9 +227,10 @@ func (ep *Endpoint) EditUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req = tp.GetUserReq{id}
+	req = tp.GetUserReq{Identifier: id}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.GetUser(req, &res)
 	if err != nil {
 		ep.handleError(w, r, UserPath(), GetUserErrID, err)
@@ -219,7 +271,7 @@ func (ep *Endpoint) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req = tp.UpdateUserReq{id, tp.User{}}
+	req = tp.UpdateUserReq{Identifier: id}
 
 	// Input data to request struct
 	err = ep.FormToModel(r, &req.User)
@@ -229,6 +281,7 @@ func (ep *Endpoint) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.UpdateUser(req, &res)
 
 	// Input validation errors
@@ -243,6 +296,12 @@ func (ep *Endpoint) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if res.PendingEmail != "" {
+		m := ep.localize(r, EmailChangeRequestedInfoID)
+		ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+		return
+	}
+
 	m := ep.localize(r, UserUpdatedInfoID)
 	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
 }
@@ -259,9 +318,10 @@ func (ep *Endpoint) InitDeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req = tp.GetUserReq{id}
+	req = tp.GetUserReq{Identifier: id}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.GetUser(req, &res)
 	if err != nil {
 		ep.handleError(w, r, UserPath(), GetUserErrID, err)
@@ -303,15 +363,16 @@ func (ep *Endpoint) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req = tp.DeleteUserReq{
-		tp.Identifier{
+		Identifier: tp.Identifier{
 			Slug: slug,
 		},
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err := ep.service.DeleteUser(req, &res)
 	if err != nil {
-		ep.handleError(w, r, UserPath(), GetUserErrID, err)
+		ep.handleError(w, r, UserPath(), impersonationErrID(err, ownsAccountsErrID(err, GetUserErrID)), err)
 		return
 	}
 
@@ -319,6 +380,63 @@ func (ep *Endpoint) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
 }
 
+// IndexDeletedUsers web endpoint.
+func (ep *Endpoint) IndexDeletedUsers(w http.ResponseWriter, r *http.Request) {
+	var req tp.IndexUsersReq
+	var res tp.IndexUsersRes
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err := ep.service.IndexDeletedUsers(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), IndexUsersErrID, err)
+		return
+	}
+
+	// Wrap response
+	wr := ep.OKRes(w, r, res, "")
+
+	// Template
+	ts, err := ep.TemplateFor(userRes, DeletedTmpl)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), IndexUsersErrID, err)
+		return
+	}
+
+	// Write response
+	err = ts.Execute(w, wr)
+	if err != nil {
+		ep.handleError(w, r, UserPath(), IndexUsersErrID, err)
+		return
+	}
+}
+
+// RestoreUser web endpoint.
+func (ep *Endpoint) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	var req tp.RestoreUserReq
+	var res tp.RestoreUserRes
+
+	// Identifier
+	id, err := ep.getIdentifier(r)
+	if err != nil {
+		ep.handleError(w, r, UserPathDeleted(), RestoreUserErrID, err)
+		return
+	}
+
+	req = tp.RestoreUserReq{Identifier: id}
+
+	// Service
+	req.Origin = tp.MakeOrigin(r)
+	err = ep.service.RestoreUser(req, &res)
+	if err != nil {
+		ep.handleError(w, r, UserPathDeleted(), RestoreUserErrID, err)
+		return
+	}
+
+	m := ep.localize(r, UserRestoredInfoID)
+	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+}
+
 func (ep *Endpoint) InitSignUpUser(w http.ResponseWriter, r *http.Request) {
 	// Req & Res
 	res := &tp.SignUpUserRes{}
@@ -357,6 +475,7 @@ func (ep *Endpoint) SignUpUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.SignUpUser(req, &res)
 
 	// Input validation errors
@@ -379,6 +498,8 @@ func (ep *Endpoint) InitSignInUser(w http.ResponseWriter, r *http.Request) {
 	// Req & Res
 	res := &tp.SignInUserRes{}
 	res.Action = ep.userSignInAction()
+	res.MagicLinkEnabled = ep.service.MagicLinkEnabled()
+	res.Providers = ep.service.IdentityProviders()
 
 	// Wrap response
 	wr := ep.OKRes(w, r, res, "")
@@ -418,13 +539,14 @@ func (ep *Endpoint) ConfirmUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req = tp.GetUserReq{
-		tp.Identifier{
+		Identifier: tp.Identifier{
 			Slug:  slug,
 			Token: token,
 		},
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.ConfirmUser(req, &res)
 	if err != nil {
 		ep.handleError(w, r, UserPath(), GetUserErrID, err)
@@ -448,14 +570,33 @@ func (ep *Endpoint) SignInUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service
+	req.Origin = tp.MakeOrigin(r)
 	err = ep.service.SignInUser(req, &res)
 	if err != nil {
-		ep.handleError(w, r, UserPathSignIn(), CredentialsErrID, err)
+		ep.handleError(w, r, UserPathSignIn(), subscriptionErrID(err, CredentialsErrID), err)
+		return
+	}
+
+	err = ep.storeSessionToken(w, r, res.SessionToken)
+	if err != nil {
+		ep.handleError(w, r, UserPathSignIn(), CannotProcErrID, err)
+		return
+	}
+
+	if res.VerificationRequired {
+		m := ep.localize(r, SignInVerificationWarnID)
+		ep.RedirectWithFlash(w, r, UserPathSignIn(), m, web.WarnMT)
+		return
+	}
+
+	if res.EmailUndeliverable {
+		m := ep.localize(r, EmailUndeliverableWarnID)
+		ep.RedirectWithFlash(w, r, UserPathEdit(res.User), m, web.WarnMT)
 		return
 	}
 
 	m := ep.localize(r, LoggedInInfoID)
-	ep.RedirectWithFlash(w, r, UserPath(), m, web.InfoMT)
+	ep.RedirectWithFlash(w, r, ep.signedInPath(w, r), m, web.InfoMT)
 }
 
 func (ep *Endpoint) rerenderUserForm(w http.ResponseWriter, r *http.Request, res interface{}, template string) {
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"gitlab.com/mikrowezel/backend/granica/internal/saml"
 	"gitlab.com/mikrowezel/backend/web"
 )
 
@@ -46,3 +47,81 @@ func UserPathSignUp() string {
 func UserPathSignIn() string {
 	return web.ResPath(UserRoot) + "/signin"
 }
+
+// UserPathMagicLink
+func UserPathMagicLink() string {
+	return web.ResPath(UserRoot) + "/magic-link"
+}
+
+// UserPathSearch
+func UserPathSearch() string {
+	return web.ResPath(UserRoot) + "/search"
+}
+
+// UserPathDeleted
+func UserPathDeleted() string {
+	return web.ResPath(UserRoot) + "/deleted"
+}
+
+// UserPathRestore
+func UserPathRestore(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/restore"
+}
+
+// UserPathSecurity
+func UserPathSecurity(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/security"
+}
+
+// UserPathSessions
+func UserPathSessions(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/sessions"
+}
+
+// UserPathSession
+func UserPathSession(res web.Identifiable, sessionID string) string {
+	return UserPathSessions(res) + "/" + sessionID
+}
+
+// UserPathAPIKeys
+func UserPathAPIKeys(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/api-keys"
+}
+
+// UserPathAPIKey
+func UserPathAPIKey(res web.Identifiable, id string) string {
+	return UserPathAPIKeys(res) + "/" + id
+}
+
+// UserPathPassword
+func UserPathPassword(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/password"
+}
+
+// UserPathProvider
+func UserPathProvider(name string) string {
+	if saml.IsSource(name) {
+		return UserPathSAML() + "/" + saml.SourceName(name)
+	}
+	return web.ResPath(UserRoot) + "/oidc/" + name
+}
+
+// UserPathSAML
+func UserPathSAML() string {
+	return web.ResPath(UserRoot) + "/saml"
+}
+
+// UserPathIdentity
+func UserPathIdentity(res web.Identifiable, provider string) string {
+	return web.ResPathSlug(UserRoot, res) + "/identities/" + provider
+}
+
+// UserPathImpersonate
+func UserPathImpersonate(res web.Identifiable) string {
+	return web.ResPathSlug(UserRoot, res) + "/impersonate"
+}
+
+// UserPathImpersonation
+func UserPathImpersonation() string {
+	return web.ResPath(UserRoot) + "/impersonation"
+}
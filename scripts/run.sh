@@ -29,13 +29,227 @@ export GRN_PG_BACKOFF_MAXTRIES="3"
 export GRN_USER_CONFIRMATION_PATH="users/%s/%s/confirm"
 export GRN_USER_CONFIRMATION_SEND="false"
 export GRN_USER_CONFIRMATION_DEBUG="true"
+# Mailer
+## ses, smtp, maildir or memory
+export GRN_MAILER_PROVIDER="maildir"
+export GRN_MAILER_MAILDIR_PATH="tmp/maildir"
+## SMTP
+export GRN_MAILER_SMTP_HOST="localhost"
+export GRN_MAILER_SMTP_PORT=1025
+export GRN_MAILER_SMTP_USERNAME=""
+export GRN_MAILER_SMTP_PASSWORD=""
+## auto, always or never
+export GRN_MAILER_SMTP_STARTTLS="auto"
+export GRN_MAILER_SMTP_INSECURE=false
+## Mail previews at /mail-previews, keep disabled in production
+export GRN_APP_MAIL_PREVIEW=true
+## Outbox, dispatcher is disabled if interval is 0
+export GRN_MAILER_OUTBOX_INTERVAL_SECONDS=10
+export GRN_MAILER_OUTBOX_BATCH_SIZE=20
+export GRN_MAILER_OUTBOX_MAX_ATTEMPTS=8
+export GRN_MAILER_OUTBOX_LEASE_SECONDS=300
+export GRN_MAILER_OUTBOX_BACKOFF_BASE_SECONDS=30
+export GRN_MAILER_OUTBOX_BACKOFF_MAX_SECONDS=3600
+export GRN_APP_OUTBOX_LIMIT=200
+## Bounce and complaint webhooks
+export GRN_MAILER_WEBHOOK_SECRET=""
+## Comma separated, no topic is accepted if empty
+export GRN_MAILER_SNS_TOPIC_ARNS=""
+export GRN_MAILER_SNS_AUTOCONFIRM=false
 # Amazon SES MAiler
+export GRN_MAILER_SES_REGION="eu-west-1"
   # These are sample not usable keys
 export AWS_ACCESS_KEY_ID=EIIAHI5FF3A2OG3MJEX5
 export AWS_SECRET_KEY=8BiWmd5Hdgmk2rR4pwG332bHwvLGiJOoxLLtDy12
 
 # Switches
 export GRN_APP_USERNAME_UPDATABLE=false
+export GRN_APP_SEARCH_LIMIT=50
+export GRN_APP_AUDIT_LIMIT=200
+## Comma separated usernames granted the admin role at startup
+export GRN_APP_ADMIN_USERNAMES=""
+# Purge
+export GRN_APP_PURGE_RETENTION_DAYS=30
+export GRN_APP_PURGE_INTERVAL_HOURS=24
+# Account subscriptions (starts at / ends at)
+export GRN_APP_SUBSCRIPTION_INTERVAL_MINUTES=60
+## Days accounts keep working once ended
+export GRN_APP_SUBSCRIPTION_GRACE_DAYS=7
+## Days before expiry owners are warned
+export GRN_APP_SUBSCRIPTION_WARN_DAYS=7
+## accounts/{slug}
+export GRN_ACCOUNT_SHOW_PATH="accounts/%s"
+export GRN_ACCOUNT_EXPIRY_NOTICE_SEND="false"
+export GRN_ACCOUNT_EXPIRY_NOTICE_DEBUG="true"
+# Account ownership transfer
+export GRN_APP_ACCOUNT_TRANSFER_TTL_HOURS=72
+## Transfers confirmed by the new owner wait for an admin approval
+export GRN_APP_ACCOUNT_TRANSFER_APPROVAL=false
+## accounts/{slug}/{token}/confirm-transfer
+export GRN_ACCOUNT_TRANSFER_CONFIRM_PATH="accounts/%s/%s/confirm-transfer"
+export GRN_ACCOUNT_TRANSFER_SEND="false"
+export GRN_ACCOUNT_TRANSFER_DEBUG="true"
+# Sessions
+export GRN_APP_SESSION_TTL_HOURS=336
+export GRN_APP_SESSION_RECENT_LIMIT=20
+## Admins impersonating a user ('admin' role)
+export GRN_APP_IMPERSONATION_TTL_MINUTES=60
+# Admin area (/admin, 'admin' role)
+## Days of sign-up, confirmation and failed sign-in counts in the dashboard
+export GRN_APP_ADMIN_STATS_DAYS=30
+## Most users a bulk action can update at once
+export GRN_APP_ADMIN_BULK_LIMIT=100
+# API keys ('Authorization: ApiKey <key>' on /api/v1)
+## Lifetime in days when none is requested, and the longest allowed
+export GRN_APP_APIKEY_TTL_DAYS=90
+export GRN_APP_APIKEY_MAX_TTL_DAYS=365
+# OAuth token endpoint (client credentials grant for service accounts)
+export GRN_APP_OAUTH_ENABLED=false
+## Defaults to https://<site url>
+# export GRN_APP_OAUTH_ISSUER="https://auth.example.com"
+## Audiences tokens can be requested for, comma separated, the first one is the default
+export GRN_APP_OAUTH_AUDIENCES=""
+export GRN_APP_OAUTH_TOKEN_TTL_MINUTES=15
+## RSA signing key, generated if missing, published on /oauth/jwks
+export GRN_APP_OAUTH_KEY_FILE="oauth.key"
+## Client credential lifetime in days when none is requested, and the longest allowed
+export GRN_APP_OAUTH_CREDENTIAL_TTL_DAYS=365
+export GRN_APP_OAUTH_CREDENTIAL_MAX_TTL_DAYS=730
+# Sign-in risk
+## Offline MaxMind DB (i.e. GeoLite2-City.mmdb), sign-ins are not located if empty
+export GRN_GEO_DB_PATH=""
+export GRN_APP_RISK_ENABLED=true
+export GRN_APP_RISK_THRESHOLD=3
+export GRN_APP_RISK_TRAVEL_KMH=900
+export GRN_APP_RISK_DISTANCE_KM=500
+## users/{slug}/{token}/verify-signin
+export GRN_USER_SIGNIN_VERIFICATION_PATH="users/%s/%s/verify-signin"
+export GRN_USER_SIGNIN_ALERT_SEND="false"
+export GRN_USER_SIGNIN_ALERT_DEBUG="true"
+# Email change
+export GRN_APP_EMAIL_CHANGE_TTL_HOURS=24
+export GRN_APP_EMAIL_CHANGE_CANCEL_WINDOW_HOURS=72
+## users/{slug}/{token}/confirm-email
+export GRN_USER_EMAIL_CHANGE_CONFIRM_PATH="users/%s/%s/confirm-email"
+## users/{slug}/{token}/cancel-email-change
+export GRN_USER_EMAIL_CHANGE_CANCEL_PATH="users/%s/%s/cancel-email-change"
+export GRN_USER_EMAIL_CHANGE_SEND="false"
+export GRN_USER_EMAIL_CHANGE_DEBUG="true"
+# Password change
+## users/{slug}/security
+export GRN_USER_SECURITY_PATH="users/%s/security"
+export GRN_USER_PASSWORD_NOTICE_SEND="false"
+export GRN_USER_PASSWORD_NOTICE_DEBUG="true"
+# Magic link
+export GRN_APP_MAGIC_LINK_ENABLED=false
+## Comma separated tenant IDs, all tenants if empty
+export GRN_APP_MAGIC_LINK_TENANTS=""
+export GRN_APP_MAGIC_LINK_TTL_MINUTES=15
+## users/{slug}/{token}/magic-signin
+export GRN_USER_MAGIC_LINK_PATH="users/%s/%s/magic-signin"
+export GRN_USER_MAGIC_LINK_SEND="false"
+export GRN_USER_MAGIC_LINK_DEBUG="true"
+# Password policy
+export GRN_APP_PASSWORD_MIN_LENGTH=8
+export GRN_APP_PASSWORD_MAX_LENGTH=32
+export GRN_APP_PASSWORD_REQUIRE_LOWER=false
+export GRN_APP_PASSWORD_REQUIRE_UPPER=false
+export GRN_APP_PASSWORD_REQUIRE_DIGIT=false
+export GRN_APP_PASSWORD_REQUIRE_SYMBOL=false
+## 0 (too guessable) to 4 (very unguessable)
+export GRN_APP_PASSWORD_MIN_SCORE=2
+export GRN_APP_PASSWORD_DISALLOW_PERSONAL=true
+## Number of previous passwords that cannot be reused
+export GRN_APP_PASSWORD_HISTORY=5
+export GRN_APP_PASSWORD_CHECK_BREACHED=true
+## JSON file with per tenant overrides, i.e. {"<tenant-id>": {"minLength": 12}}
+export GRN_APP_PASSWORD_POLICY_FILE=""
+## Sorted SHA-1 HASH:COUNT lines (i.e. Pwned Passwords), no check if empty
+export GRN_APP_PASSWORD_BREACHED_PATH=""
+# Identity providers
+## Comma separated provider names, none if empty
+export GRN_APP_OIDC_PROVIDERS=""
+## OpenID Connect provider, endpoints are discovered from issuer
+# export GRN_APP_OIDC_PROVIDERS="google,github"
+# export GRN_APP_OIDC_GOOGLE_LABEL="Google"
+# export GRN_APP_OIDC_GOOGLE_ISSUER="https://accounts.google.com"
+# export GRN_APP_OIDC_GOOGLE_CLIENT_ID=""
+# export GRN_APP_OIDC_GOOGLE_CLIENT_SECRET=""
+## Link to the user owning the verified email on first sign-in
+# export GRN_APP_OIDC_GOOGLE_LINK_BY_EMAIL=false
+## Create users on first sign-in
+# export GRN_APP_OIDC_GOOGLE_PROVISION=true
+## https://{site}/users/oidc/{name}/callback if empty
+# export GRN_APP_OIDC_GOOGLE_REDIRECT_URL=""
+## Plain OAuth2 provider, endpoints and claim names are required
+# export GRN_APP_OIDC_GITHUB_LABEL="GitHub"
+# export GRN_APP_OIDC_GITHUB_AUTH_URL="https://github.com/login/oauth/authorize"
+# export GRN_APP_OIDC_GITHUB_TOKEN_URL="https://github.com/login/oauth/access_token"
+# export GRN_APP_OIDC_GITHUB_USERINFO_URL="https://api.github.com/user"
+# export GRN_APP_OIDC_GITHUB_CLIENT_ID=""
+# export GRN_APP_OIDC_GITHUB_CLIENT_SECRET=""
+# export GRN_APP_OIDC_GITHUB_SCOPES="read:user user:email"
+# export GRN_APP_OIDC_GITHUB_CLAIM_SUBJECT="id"
+# export GRN_APP_OIDC_GITHUB_CLAIM_USERNAME="login"
+# LDAP directories
+export GRN_APP_LDAP_DIRECTORIES=""
+export GRN_APP_LDAP_SYNC_INTERVAL_MINUTES=60
+# export GRN_APP_LDAP_DIRECTORIES="corp"
+# export GRN_APP_LDAP_CORP_URL="ldaps://ldap.example.com"
+# export GRN_APP_LDAP_CORP_START_TLS=false
+# export GRN_APP_LDAP_CORP_BIND_DN="cn=granica,ou=services,dc=example,dc=com"
+# export GRN_APP_LDAP_CORP_BIND_PASSWORD=""
+# export GRN_APP_LDAP_CORP_BASE_DN="ou=people,dc=example,dc=com"
+## '%s' is replaced by the escaped username
+# export GRN_APP_LDAP_CORP_USER_FILTER="(&(objectClass=person)(uid=%s))"
+# export GRN_APP_LDAP_CORP_SYNC_FILTER="(objectClass=person)"
+## before: fall back to local passwords, instead: directory only
+# export GRN_APP_LDAP_CORP_MODE="before"
+## Empty for all tenants
+# export GRN_APP_LDAP_CORP_TENANTS=""
+# export GRN_APP_LDAP_CORP_PROVISION=true
+## Active Directory
+# export GRN_APP_LDAP_CORP_ATTR_ID="objectGUID"
+# export GRN_APP_LDAP_CORP_ATTR_USERNAME="sAMAccountName"
+# export GRN_APP_LDAP_CORP_GROUP_ROLES="admins:admin;staff:staff"
+# SAML identity providers users can sign in with
+## Comma separated provider names, none if empty
+export GRN_APP_SAML_PROVIDERS=""
+# export GRN_APP_SAML_PROVIDERS="acme"
+# export GRN_APP_SAML_ACME_LABEL="ACME"
+# export GRN_APP_SAML_ACME_IDP_ENTITY_ID="https://idp.acme.com/metadata"
+# export GRN_APP_SAML_ACME_IDP_SSO_URL="https://idp.acme.com/sso"
+## PEM, more than one certificate while the provider rotates its key
+# export GRN_APP_SAML_ACME_IDP_CERT_FILE="acme-idp.crt"
+## https://{site}/users/saml/{name}/metadata if empty
+# export GRN_APP_SAML_ACME_ENTITY_ID=""
+## persistent, email or a name ID format URN
+# export GRN_APP_SAML_ACME_NAME_ID_FORMAT="persistent"
+## Link to the user owning the asserted email on first sign-in
+# export GRN_APP_SAML_ACME_LINK_BY_EMAIL=false
+# export GRN_APP_SAML_ACME_PROVISION=true
+# export GRN_APP_SAML_ACME_ATTR_EMAIL="email"
+# export GRN_APP_SAML_ACME_ATTR_USERNAME="username"
+# export GRN_APP_SAML_ACME_ATTR_GIVEN_NAME="givenName"
+# export GRN_APP_SAML_ACME_ATTR_FAMILY_NAME="familyName"
+# export GRN_APP_SAML_ACME_CLOCK_SKEW_SECONDS=120
+# SAML identity provider for SAML-only applications
+export GRN_APP_SAML_IDP_ENABLED=false
+## Generated on first start if missing
+export GRN_APP_SAML_IDP_KEY_FILE="saml-idp.key"
+export GRN_APP_SAML_IDP_CERT_FILE="saml-idp.crt"
+export GRN_APP_SAML_IDP_ASSERTION_TTL_MINUTES=5
+export GRN_APP_SAML_IDP_PEERS=""
+# export GRN_APP_SAML_IDP_PEERS="wiki"
+# export GRN_APP_SAML_IDP_PEER_WIKI_ENTITY_ID="https://wiki.example.com/saml/metadata"
+# export GRN_APP_SAML_IDP_PEER_WIKI_ACS_URL="https://wiki.example.com/saml/acs"
+# export GRN_APP_SAML_IDP_PEER_WIKI_NAME_ID_FORMAT="persistent"
+# SCIM 2.0 provisioning (/scim/v2)
+export GRN_APP_SCIM_TENANTS=""
+# export GRN_APP_SCIM_TENANTS="acme"
+## Hex SHA-256 digests of bearer tokens, comma separated while rotating
+## printf '%s' "$TOKEN" | sha256sum
+# export GRN_APP_SCIM_ACME_TOKEN_DIGESTS=""
 
 go build -o ./bin/granica ./cmd/granica.go
 ./bin/granica